@@ -306,8 +306,11 @@ func (mr *MilvusRoles) setupLogger() {
 func setupPrometheusHTTPServer(r *internalmetrics.MilvusRegistry) {
 	log.Info("setupPrometheusHTTPServer")
 	http.Register(&http.Handler{
-		Path:    http.MetricsPath,
-		Handler: promhttp.HandlerFor(r, promhttp.HandlerOpts{}),
+		Path: http.MetricsPath,
+		Handler: promhttp.HandlerFor(r, promhttp.HandlerOpts{
+			// exemplars are only exposed in OpenMetrics format
+			EnableOpenMetrics: paramtable.Get().TraceCfg.EnableExemplar.GetAsBool(),
+		}),
 	})
 	http.Register(&http.Handler{
 		Path:    http.MetricsDefaultPath,
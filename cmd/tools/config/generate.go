@@ -73,7 +73,7 @@ func collectRecursive(params *paramtable.ComponentParam, data *[]DocContent, val
 				defaultValue = fmt.Sprintf("\"%s\"", defaultValue)
 			}
 			log.Ctx(context.TODO()).Debug("got key", zap.String("key", item.Key), zap.Any("value", defaultValue), zap.String("variable", val.Type().Field(j).Name))
-			*data = append(*data, DocContent{item.Key, defaultValue, item.Version, refreshable, item.Export, item.Doc})
+			*data = append(*data, DocContent{item.Key, defaultValue, item.Version, refreshable, item.Export, paramDoc(item)})
 		} else if t == "paramtable.ParamGroup" {
 			item := subVal.Interface().(paramtable.ParamGroup)
 			log.Ctx(context.TODO()).Debug("got key", zap.String("key", item.KeyPrefix), zap.String("variable", val.Type().Field(j).Name))
@@ -97,6 +97,17 @@ func collectRecursive(params *paramtable.ComponentParam, data *[]DocContent, val
 	}
 }
 
+// paramDoc returns the doc of the param, the deprecated param is noted with the reason.
+func paramDoc(item paramtable.ParamItem) string {
+	if item.Deprecated == "" {
+		return item.Doc
+	}
+	if item.Doc == "" {
+		return fmt.Sprintf("Deprecated, %s", item.Deprecated)
+	}
+	return fmt.Sprintf("Deprecated, %s. %s", item.Deprecated, item.Doc)
+}
+
 func WriteCsv(f io.Writer) {
 	w := csv.NewWriter(f)
 	w.Write([]string{"key", "defaultValue", "sinceVersion", "refreshable", "exportToUser", "comment"})
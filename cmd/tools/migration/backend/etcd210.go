@@ -508,11 +508,9 @@ func (b etcd210) Restore(backupFile string) error {
 		}
 		return path.Join(header.Instance, header.MetaPath, key)
 	}
-	ctx := context.Background()
+	kvs := make(map[string]string, len(saves))
 	for k, v := range saves {
-		if _, err := b.etcdCli.Put(ctx, getRealKey(k), v); err != nil {
-			return err
-		}
+		kvs[getRealKey(k)] = v
 	}
-	return nil
+	return b.restore(context.Background(), kvs)
 }
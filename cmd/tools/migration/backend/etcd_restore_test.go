@@ -0,0 +1,14 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitBatches(t *testing.T) {
+	kvs := map[string]string{"a": "1", "b": "2", "c": "3", "d": "4", "e": "5"}
+	assert.Equal(t, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}, splitBatches(kvs, 2))
+	assert.Equal(t, [][]string{{"a", "b", "c", "d", "e"}}, splitBatches(kvs, 10))
+	assert.Empty(t, splitBatches(map[string]string{}, 2))
+}
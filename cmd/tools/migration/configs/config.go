@@ -63,9 +63,34 @@ func (c *RunConfig) init(base *paramtable.BaseTable) {
 	c.BackupFilePath = c.base.GetWithDefault("config.backupFilePath", "")
 }
 
+// RestoreConfig controls how the backup is written back to the meta store.
+type RestoreConfig struct {
+	// BatchSize is the number of keys written in one transaction, it shall not exceed the max txn ops of etcd.
+	BatchSize int
+	// Parallelism is the number of transactions in flight.
+	Parallelism int
+	// Verify checks the restored values against the backup after restore.
+	Verify bool
+}
+
+func newRestoreConfig(base *paramtable.BaseTable) *RestoreConfig {
+	c := &RestoreConfig{}
+	c.BatchSize, _ = strconv.Atoi(base.GetWithDefault("restore.batchSize", "64"))
+	if c.BatchSize <= 0 {
+		c.BatchSize = 64
+	}
+	c.Parallelism, _ = strconv.Atoi(base.GetWithDefault("restore.parallelism", "4"))
+	if c.Parallelism <= 0 {
+		c.Parallelism = 1
+	}
+	c.Verify, _ = strconv.ParseBool(base.GetWithDefault("restore.verify", "true"))
+	return c
+}
+
 type MilvusConfig struct {
 	MetaStoreCfg *paramtable.MetaStoreConfig
 	EtcdCfg      *paramtable.EtcdConfig
+	RestoreCfg   *RestoreConfig
 }
 
 func newMilvusConfig(base *paramtable.BaseTable) *MilvusConfig {
@@ -80,6 +105,7 @@ func (c *MilvusConfig) init(base *paramtable.BaseTable) {
 
 	c.MetaStoreCfg.Init(base)
 	c.EtcdCfg.Init(base)
+	c.RestoreCfg = newRestoreConfig(base)
 }
 
 func (c *MilvusConfig) String() string {
@@ -0,0 +1,381 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+const tsPrintFormat = "2006-01-02 15:04:05.999 -0700"
+
+type segmentInspector struct {
+	read   readFunc
+	verify bool
+
+	stats    []*storage.PrimaryKeyStats
+	problems []string
+}
+
+type fieldSummary struct {
+	dataType schemapb.DataType
+	files    int
+	rows     int64
+	size     int64
+}
+
+// binlogSummary is the content of an insert binlog or a deltalog.
+type binlogSummary struct {
+	fieldID  int64
+	dataType schemapb.DataType
+	rows     int64
+	size     int64
+	startTs  uint64
+	endTs    uint64
+	checksum string
+	// pk range of the binlog, only collected if verify is enabled and the binlog is of the pk field
+	minPk storage.PrimaryKey
+	maxPk storage.PrimaryKey
+	// number of the pks not hit by the bloom filters
+	bfMissed int64
+}
+
+func (i *segmentInspector) problem(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	i.problems = append(i.problems, msg)
+	fmt.Printf("\t!! %s\n", msg)
+}
+
+// Inspect prints the row counts, pk range, bloom filter parameters and the field sizes of the segment,
+// the problems found are printed at last and an error is returned if there is any.
+func (i *segmentInspector) Inspect(ctx context.Context, segment *metricsinfo.SegmentManifestEntry) error {
+	fmt.Println("================================================================================")
+	if segment.SegmentID > 0 {
+		fmt.Printf("Segment ID: %d\t\tPartition ID: %d\n", segment.SegmentID, segment.PartitionID)
+		fmt.Printf("Channel: %s\t\tLevel: %s\t\tSorted: %t\n", segment.Channel, segment.Level, segment.IsSorted)
+		fmt.Printf("Num of Rows (meta): %d\n", segment.NumOfRows)
+	}
+
+	fmt.Println("Statslogs:")
+	for _, fieldLogs := range segment.Statslogs {
+		for _, file := range fieldLogs.Logs {
+			i.inspectStatslog(ctx, fieldLogs.FieldID, file)
+		}
+	}
+	i.printPkStats()
+
+	fmt.Println("Binlogs:")
+	fields := make(map[int64]*fieldSummary)
+	for _, fieldLogs := range segment.Binlogs {
+		for _, file := range fieldLogs.Logs {
+			summary := i.inspectBinlog(ctx, fieldLogs.FieldID, file)
+			if summary == nil {
+				continue
+			}
+			field, ok := fields[summary.fieldID]
+			if !ok {
+				field = &fieldSummary{dataType: summary.dataType}
+				fields[summary.fieldID] = field
+			}
+			field.files++
+			field.rows += summary.rows
+			field.size += summary.size
+		}
+	}
+	i.printFields(segment, fields)
+
+	fmt.Println("Deltalogs:")
+	var deletes int64
+	for _, file := range segment.Deltalogs {
+		summary := i.inspectFile(ctx, file, false)
+		if summary == nil {
+			continue
+		}
+		deletes += summary.rows
+	}
+	fmt.Printf("Num of Deletes: %d\n", deletes)
+
+	fmt.Println("================================================================================")
+	if len(i.problems) > 0 {
+		fmt.Printf("%d problems found:\n", len(i.problems))
+		for _, problem := range i.problems {
+			fmt.Printf("\t%s\n", problem)
+		}
+		return errors.Newf("%d problems found", len(i.problems))
+	}
+	if i.verify {
+		fmt.Println("verify passed, no problem found")
+	}
+	return nil
+}
+
+func (i *segmentInspector) inspectStatslog(ctx context.Context, fieldID int64, file *metricsinfo.ManifestLogFile) {
+	fmt.Printf("\t%s\n", file.Path)
+	data, err := i.read(ctx, file.Path)
+	if err != nil {
+		i.problem("failed to read statslog %s: %s", file.Path, err.Error())
+		return
+	}
+	i.checkFile(file, data)
+
+	var stats []*storage.PrimaryKeyStats
+	blob := &storage.Blob{Key: file.Path, Value: data}
+	// the compound statslog is a json list of the stats
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		stats, err = storage.DeserializeStatsList(blob)
+	} else {
+		stats, err = storage.DeserializeStats([]*storage.Blob{blob})
+	}
+	if err != nil {
+		i.problem("failed to deserialize statslog %s: %s", file.Path, err.Error())
+		return
+	}
+	for _, stat := range stats {
+		if fieldID > 0 && stat.FieldID != fieldID {
+			i.problem("statslog %s is of field %d, but listed as field %d", file.Path, stat.FieldID, fieldID)
+		}
+		fmt.Printf("\t\tField: %d\tPkType: %s\tMinPk: %v\tMaxPk: %v\n", stat.FieldID,
+			schemapb.DataType(stat.PkType).String(), pkValue(stat.MinPk), pkValue(stat.MaxPk))
+		if stat.BF != nil {
+			fmt.Printf("\t\tBF Type: %s\tBF Cap: %d\tBF K: %d\n", stat.BFType.String(), stat.BF.Cap(), stat.BF.K())
+		}
+	}
+	i.stats = append(i.stats, stats...)
+}
+
+func (i *segmentInspector) printPkStats() {
+	if len(i.stats) == 0 {
+		fmt.Println("PK Range: unknown, no statslog")
+		return
+	}
+	minPk, maxPk := i.stats[0].MinPk, i.stats[0].MaxPk
+	for _, stat := range i.stats[1:] {
+		if stat.MinPk != nil && (minPk == nil || stat.MinPk.LT(minPk)) {
+			minPk = stat.MinPk
+		}
+		if stat.MaxPk != nil && (maxPk == nil || stat.MaxPk.GT(maxPk)) {
+			maxPk = stat.MaxPk
+		}
+	}
+	fmt.Printf("PK Field: %d\tPK Range: [%v, %v]\n", i.stats[0].FieldID, pkValue(minPk), pkValue(maxPk))
+}
+
+func (i *segmentInspector) inspectBinlog(ctx context.Context, fieldID int64, file *metricsinfo.ManifestLogFile) *binlogSummary {
+	summary := i.inspectFile(ctx, file, i.verify)
+	if summary == nil {
+		return nil
+	}
+	if fieldID > 0 && summary.fieldID != fieldID {
+		i.problem("binlog %s is of field %d, but listed as field %d", file.Path, summary.fieldID, fieldID)
+	}
+	if summary.minPk != nil {
+		fmt.Printf("\t\tMinPk: %v\tMaxPk: %v\n", pkValue(summary.minPk), pkValue(summary.maxPk))
+		if !i.inPkRange(summary.minPk) || !i.inPkRange(summary.maxPk) {
+			i.problem("pk range of binlog %s [%v, %v] is out of the range of statslogs", file.Path, pkValue(summary.minPk), pkValue(summary.maxPk))
+		}
+		if summary.bfMissed > 0 {
+			i.problem("%d pks of binlog %s are missed by the bloom filters of statslogs", summary.bfMissed, file.Path)
+		}
+	}
+	return summary
+}
+
+// inspectFile reads the binlog or deltalog, and checks it with the metadata listed in the manifest.
+func (i *segmentInspector) inspectFile(ctx context.Context, file *metricsinfo.ManifestLogFile, decode bool) *binlogSummary {
+	fmt.Printf("\t%s\n", file.Path)
+	data, err := i.read(ctx, file.Path)
+	if err != nil {
+		i.problem("failed to read %s: %s", file.Path, err.Error())
+		return nil
+	}
+	checksum := i.checkFile(file, data)
+
+	summary, err := i.readBinlog(data, decode)
+	if err != nil {
+		i.problem("failed to decode %s: %s", file.Path, err.Error())
+		return nil
+	}
+	summary.checksum = checksum
+	startTime, _ := tsoutil.ParseTS(summary.startTs)
+	endTime, _ := tsoutil.ParseTS(summary.endTs)
+	fmt.Printf("\t\tField: %d\tType: %s\tRows: %d\tSize: %d\tTime Range: [%s, %s]\n", summary.fieldID, summary.dataType.String(),
+		summary.rows, summary.size, startTime.Format(tsPrintFormat), endTime.Format(tsPrintFormat))
+	if summary.checksum != "" {
+		fmt.Printf("\t\tMD5: %s\n", summary.checksum)
+	}
+	if file.EntriesNum > 0 && file.EntriesNum != summary.rows {
+		i.problem("%s has %d rows, but %d rows recorded in meta", file.Path, summary.rows, file.EntriesNum)
+	}
+	return summary
+}
+
+// checkFile checks the size of the file against the meta, and returns the md5 checksum if verify is enabled.
+func (i *segmentInspector) checkFile(file *metricsinfo.ManifestLogFile, data []byte) string {
+	if file.LogSize > 0 && file.LogSize != int64(len(data)) {
+		i.problem("%s has %d bytes, but %d bytes recorded in meta", file.Path, len(data), file.LogSize)
+	}
+	if !i.verify {
+		return ""
+	}
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (i *segmentInspector) readBinlog(data []byte, decode bool) (*binlogSummary, error) {
+	reader, err := storage.NewBinlogReader(data)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	summary := &binlogSummary{
+		fieldID:  reader.FieldID,
+		dataType: reader.PayloadDataType,
+		size:     int64(len(data)),
+		startTs:  reader.StartTimestamp,
+		endTs:    reader.EndTimestamp,
+	}
+	isPk := decode && len(i.stats) > 0 && i.stats[0].FieldID == summary.fieldID
+	for {
+		event, err := reader.NextEventReader()
+		if err != nil {
+			return nil, err
+		}
+		if event == nil {
+			break
+		}
+		rows, err := event.GetPayloadLengthFromReader()
+		if err != nil {
+			return nil, err
+		}
+		summary.rows += int64(rows)
+		if !decode {
+			continue
+		}
+		values, _, _, err := event.GetDataFromPayload()
+		if err != nil {
+			return nil, err
+		}
+		if isPk {
+			i.checkPks(summary, values)
+		}
+	}
+	return summary, nil
+}
+
+// checkPks collects the pk range of the values, and counts the pks which are not hit by any bloom filter.
+func (i *segmentInspector) checkPks(summary *binlogSummary, values any) {
+	update := func(pk storage.PrimaryKey, hit bool) {
+		if summary.minPk == nil || pk.LT(summary.minPk) {
+			summary.minPk = pk
+		}
+		if summary.maxPk == nil || pk.GT(summary.maxPk) {
+			summary.maxPk = pk
+		}
+		if !hit {
+			summary.bfMissed++
+		}
+	}
+	switch values := values.(type) {
+	case []int64:
+		buf := make([]byte, 8)
+		for _, value := range values {
+			common.Endian.PutUint64(buf, uint64(value))
+			update(storage.NewInt64PrimaryKey(value), i.testBF(func(stat *storage.PrimaryKeyStats) bool {
+				return stat.BF.Test(buf)
+			}))
+		}
+	case []string:
+		for _, value := range values {
+			update(storage.NewVarCharPrimaryKey(value), i.testBF(func(stat *storage.PrimaryKeyStats) bool {
+				return stat.BF.TestString(value)
+			}))
+		}
+	}
+}
+
+// testBF returns whether the pk is hit by any bloom filter, it's always true if there is no bloom filter.
+func (i *segmentInspector) testBF(test func(stat *storage.PrimaryKeyStats) bool) bool {
+	tested := false
+	for _, stat := range i.stats {
+		if stat.BF == nil {
+			continue
+		}
+		if test(stat) {
+			return true
+		}
+		tested = true
+	}
+	return !tested
+}
+
+func (i *segmentInspector) inPkRange(pk storage.PrimaryKey) bool {
+	for _, stat := range i.stats {
+		if stat.MinPk != nil && stat.MaxPk != nil && pk.GE(stat.MinPk) && pk.LE(stat.MaxPk) {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *segmentInspector) printFields(segment *metricsinfo.SegmentManifestEntry, fields map[int64]*fieldSummary) {
+	fieldIDs := make([]int64, 0, len(fields))
+	for fieldID := range fields {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	sort.Slice(fieldIDs, func(a, b int) bool { return fieldIDs[a] < fieldIDs[b] })
+
+	var rows, size int64 = -1, 0
+	for _, fieldID := range fieldIDs {
+		field := fields[fieldID]
+		fmt.Printf("Field %d (%s): Files: %d\tRows: %d\tSize: %d\n", fieldID, field.dataType.String(), field.files, field.rows, field.size)
+		if rows >= 0 && field.rows != rows {
+			i.problem("field %d has %d rows, inconsistent with %d rows of field %d", fieldID, field.rows, rows, fieldIDs[0])
+		}
+		if rows < 0 {
+			rows = field.rows
+		}
+		size += field.size
+	}
+	if rows < 0 {
+		fmt.Println("Num of Rows: unknown, no binlog")
+		return
+	}
+	fmt.Printf("Num of Rows: %d\tTotal Size: %d\n", rows, size)
+	if segment.NumOfRows > 0 && segment.NumOfRows != rows {
+		i.problem("segment has %d rows in binlogs, but %d rows recorded in meta", rows, segment.NumOfRows)
+	}
+}
+
+func pkValue(pk storage.PrimaryKey) any {
+	if pk == nil {
+		return nil
+	}
+	return pk.GetValue()
+}
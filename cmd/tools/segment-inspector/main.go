@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+
+	mcc "github.com/milvus-io/milvus/internal/distributed/mixcoord/client"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+var (
+	binlogs   = flag.String("binlogs", "", "Comma separated insert binlog paths to inspect")
+	statslogs = flag.String("statslogs", "", "Comma separated statslog paths to inspect")
+	deltalogs = flag.String("deltalogs", "", "Comma separated deltalog paths to inspect")
+
+	collectionID = flag.Int64("collection", 0, "Collection ID of the segment, the log paths are loaded from the segment manifest of datacoord")
+	segmentID    = flag.Int64("segment", 0, "Segment ID to inspect, the log paths are loaded from the segment manifest of datacoord")
+
+	local  = flag.Bool("local", false, "Read the log paths from local file system instead of the object storage configured in milvus.yaml")
+	verify = flag.Bool("verify", false, "Decode all the logs, check them against the manifest and print the md5 checksums")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: segment-inspector [-binlogs p1,p2] [-statslogs p1,p2] [-deltalogs p1,p2] [-collection id -segment id] [-local] [-verify]\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "the object storage and etcd are configured by milvus.yaml, only the binlogs of storage v1 are supported.\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	ctx := context.Background()
+	if !*local || *segmentID > 0 {
+		paramtable.Init()
+	}
+
+	segment := &metricsinfo.SegmentManifestEntry{SegmentID: *segmentID}
+	if *segmentID > 0 {
+		if *collectionID <= 0 {
+			exit(errors.New("collection is required to load the segment manifest"))
+		}
+		entry, err := getSegmentManifest(ctx, *collectionID, *segmentID)
+		if err != nil {
+			exit(err)
+		}
+		segment = entry
+	}
+	segment.Binlogs = append(segment.Binlogs, toFieldLogs(*binlogs)...)
+	segment.Statslogs = append(segment.Statslogs, toFieldLogs(*statslogs)...)
+	segment.Deltalogs = append(segment.Deltalogs, toLogFiles(*deltalogs)...)
+	if len(segment.Binlogs)+len(segment.Statslogs)+len(segment.Deltalogs) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	read, err := newReader(ctx, *local)
+	if err != nil {
+		exit(err)
+	}
+	inspector := &segmentInspector{read: read, verify: *verify}
+	if err := inspector.Inspect(ctx, segment); err != nil {
+		exit(err)
+	}
+}
+
+func exit(err error) {
+	fmt.Printf("error: %s\n", err.Error())
+	os.Exit(1)
+}
+
+func splitPaths(paths string) []string {
+	return lo.Filter(strings.Split(paths, ","), func(path string, _ int) bool {
+		return len(strings.TrimSpace(path)) > 0
+	})
+}
+
+// toFieldLogs returns the logs with unknown field, the field is read from the log itself.
+func toFieldLogs(paths string) []*metricsinfo.ManifestFieldLogs {
+	files := toLogFiles(paths)
+	if len(files) == 0 {
+		return nil
+	}
+	return []*metricsinfo.ManifestFieldLogs{{Logs: files}}
+}
+
+func toLogFiles(paths string) []*metricsinfo.ManifestLogFile {
+	return lo.Map(splitPaths(paths), func(path string, _ int) *metricsinfo.ManifestLogFile {
+		return &metricsinfo.ManifestLogFile{Path: strings.TrimSpace(path)}
+	})
+}
+
+// getSegmentManifest loads the log paths of the segment by the segment manifest metrics request of datacoord.
+func getSegmentManifest(ctx context.Context, collectionID, segmentID int64) (*metricsinfo.SegmentManifestEntry, error) {
+	client, err := mcc.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	req, err := metricsinfo.ConstructGetMetricsRequest(map[string]interface{}{
+		metricsinfo.MetricTypeKey:                     metricsinfo.SegmentManifestKey,
+		metricsinfo.MetricRequestParamCollectionIDKey: collectionID,
+		metricsinfo.MetricRequestProcessInRoleKey:     typeutil.DataCoordRole,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetMetrics(ctx, req)
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	manifest := &metricsinfo.SegmentManifest{}
+	if err := json.Unmarshal([]byte(resp.GetResponse()), manifest); err != nil {
+		return nil, err
+	}
+	for _, segment := range manifest.Segments {
+		if segment.SegmentID == segmentID {
+			return segment, nil
+		}
+	}
+	return nil, errors.Newf("segment %d not found in the manifest of collection %d, only the flushed segments are listed", segmentID, collectionID)
+}
+
+type readFunc func(ctx context.Context, path string) ([]byte, error)
+
+func newReader(ctx context.Context, local bool) (readFunc, error) {
+	if local {
+		return func(ctx context.Context, path string) ([]byte, error) {
+			return os.ReadFile(path)
+		}, nil
+	}
+	cm, err := storage.NewChunkManagerFactoryWithParam(paramtable.Get()).NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cm.Read, nil
+}
@@ -18,16 +18,23 @@ package compaction
 
 import (
 	"context"
+	sio "io"
 	"path"
+	"sync"
 	"time"
 
+	"github.com/apache/arrow/go/v17/arrow/array"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/conc"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
@@ -146,6 +153,10 @@ func LoadStats(ctx context.Context, chunkManager storage.ChunkManager, schema *s
 	var size uint
 	result := make([]*storage.PkStatistics, 0, len(stats))
 	for _, stat := range stats {
+		if stat.BF == nil {
+			log.Warn("pk stats without bloom filter found", zap.Int64("fieldID", stat.FieldID))
+			return nil, merr.WrapErrServiceInternal("corrupted pk stats", "bloom filter is missing")
+		}
 		pkStat := &storage.PkStatistics{
 			PkFilter: stat.BF,
 			MinPK:    stat.MinPk,
@@ -158,3 +169,153 @@ func LoadStats(ctx context.Context, chunkManager storage.ChunkManager, schema *s
 	log.Info("Successfully load pk stats", zap.Any("time", time.Since(startTs)), zap.Uint("size", size))
 	return result, nil
 }
+
+// RebuildStats rebuilds the pk statistics of a segment by scanning its pk field insert binlogs,
+// it's used as a fallback when the statslog is corrupted or missing.
+// The rebuilt stats are written back as the statslog with logID, the returned field binlog describes it and
+// shall replace the statslogs of the segment in meta. Failing to write it is not fatal, the field binlog is nil then.
+func RebuildStats(ctx context.Context, chunkManager storage.ChunkManager, schema *schemapb.CollectionSchema, segment *datapb.SegmentInfo, logID int64, concurrency int) ([]*storage.PkStatistics, *datapb.FieldBinlog, error) {
+	startTs := time.Now()
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", segment.GetCollectionID()), zap.Int64("segmentID", segment.GetID()))
+
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pkBinlog, ok := lo.Find(segment.GetBinlogs(), func(fieldBinlog *datapb.FieldBinlog) bool {
+		if segment.GetStorageVersion() == storage.StorageV2 {
+			// the binlogs of storage v2 are written by column groups, the pk field is one of the child fields of its group.
+			return lo.Contains(fieldBinlog.GetChildFields(), pkField.GetFieldID())
+		}
+		return fieldBinlog.GetFieldID() == pkField.GetFieldID()
+	})
+	if !ok || len(pkBinlog.GetBinlogs()) == 0 {
+		return nil, nil, merr.WrapErrSegmentLack(segment.GetID(), "no pk binlog found to rebuild stats")
+	}
+	log.Info("begin to rebuild pk stats from binlogs", zap.Int("binlogNum", len(pkBinlog.GetBinlogs())))
+
+	stats, err := storage.NewPrimaryKeyStats(pkField.GetFieldID(), int64(pkField.GetDataType()), segment.GetNumOfRows())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if segment.GetStorageVersion() == storage.StorageV2 {
+		err = updateStatsByColumnGroup(ctx, schema, pkField, segment, pkBinlog, stats)
+	} else {
+		err = updateStatsByBinlogs(ctx, chunkManager, pkField, pkBinlog, stats, concurrency)
+	}
+	if err != nil {
+		log.Warn("failed to read pk binlogs", zap.Error(err))
+		return nil, nil, err
+	}
+
+	blob, err := storage.NewInsertCodec().SerializePkStatsList([]*storage.PrimaryKeyStats{stats}, segment.GetNumOfRows())
+	if err != nil {
+		return nil, nil, err
+	}
+	key := path.Join(chunkManager.RootPath(), common.SegmentStatslogPath,
+		metautil.JoinIDPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), pkField.GetFieldID(), logID))
+	var statslog *datapb.FieldBinlog
+	if err := chunkManager.Write(ctx, key, blob.GetValue()); err != nil {
+		log.Warn("failed to write rebuilt statslog", zap.String("path", key), zap.Error(err))
+	} else {
+		statslog = &datapb.FieldBinlog{
+			FieldID: pkField.GetFieldID(),
+			Binlogs: []*datapb.Binlog{{
+				EntriesNum: segment.GetNumOfRows(),
+				LogPath:    key,
+				LogSize:    int64(len(blob.GetValue())),
+				MemorySize: int64(len(blob.GetValue())),
+				LogID:      logID,
+			}},
+		}
+	}
+
+	log.Info("Successfully rebuild pk stats", zap.Any("time", time.Since(startTs)), zap.String("path", key))
+	return []*storage.PkStatistics{{
+		PkFilter: stats.BF,
+		MinPK:    stats.MinPk,
+		MaxPK:    stats.MaxPk,
+	}}, statslog, nil
+}
+
+// updateStatsByBinlogs updates the pk stats by the pk field binlogs of storage v1, which are read concurrently.
+func updateStatsByBinlogs(ctx context.Context, chunkManager storage.ChunkManager, pkField *schemapb.FieldSchema,
+	pkBinlog *datapb.FieldBinlog, stats *storage.PrimaryKeyStats, concurrency int,
+) error {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	pool := conc.NewPool[any](concurrency)
+	defer pool.Release()
+
+	mu := sync.Mutex{}
+	futures := make([]*conc.Future[any], 0, len(pkBinlog.GetBinlogs()))
+	for _, binlog := range pkBinlog.GetBinlogs() {
+		logPath := binlog.GetLogPath()
+		futures = append(futures, pool.Submit(func() (any, error) {
+			value, err := chunkManager.Read(ctx, logPath)
+			if err != nil {
+				return nil, err
+			}
+			insertData := &storage.InsertData{Data: make(map[storage.FieldID]storage.FieldData)}
+			codec := storage.NewInsertCodec()
+			if _, _, _, err := codec.DeserializeInto([]*storage.Blob{{Key: logPath, Value: value}}, 0, insertData); err != nil {
+				return nil, err
+			}
+			pks, ok := insertData.Data[pkField.GetFieldID()]
+			if !ok {
+				return nil, merr.WrapErrServiceInternal("pk field data not found in binlog", logPath)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			stats.UpdateByMsgs(pks)
+			return nil, nil
+		}))
+	}
+	return conc.AwaitAll(futures...)
+}
+
+// updateStatsByColumnGroup updates the pk stats by the column group binlogs of storage v2 which contain the pk field,
+// only the fields of the column group are read.
+func updateStatsByColumnGroup(ctx context.Context, schema *schemapb.CollectionSchema, pkField *schemapb.FieldSchema,
+	segment *datapb.SegmentInfo, pkBinlog *datapb.FieldBinlog, stats *storage.PrimaryKeyStats,
+) error {
+	groupSchema := typeutil.Clone(schema)
+	groupSchema.Fields = lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return lo.Contains(pkBinlog.GetChildFields(), field.GetFieldID())
+	})
+	groupSchema.StructArrayFields = nil
+	reader, err := storage.NewBinlogRecordReader(ctx, []*datapb.FieldBinlog{pkBinlog}, groupSchema,
+		storage.WithCollectionID(segment.GetCollectionID()),
+		storage.WithVersion(segment.GetStorageVersion()),
+		storage.WithStorageConfig(CreateStorageConfig()),
+		storage.WithNeededFields(typeutil.NewSet(pkField.GetFieldID())),
+	)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for {
+		r, err := reader.Next()
+		if err != nil {
+			if err == sio.EOF {
+				return nil
+			}
+			return err
+		}
+		pkArray := r.Column(pkField.GetFieldID())
+		for i := range r.Len() {
+			switch pkField.GetDataType() {
+			case schemapb.DataType_Int64:
+				stats.Update(storage.NewInt64PrimaryKey(pkArray.(*array.Int64).Value(i)))
+			case schemapb.DataType_VarChar:
+				stats.Update(storage.NewVarCharPrimaryKey(pkArray.(*array.String).Value(i)))
+			default:
+				return merr.WrapErrParameterInvalidMsg("unsupported pk type %s", pkField.GetDataType().String())
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compaction
+
+import (
+	"context"
+	"math"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/storagecommon"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/objectstorage"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestLoadStatsSuite(t *testing.T) {
+	suite.Run(t, new(LoadStatsSuite))
+}
+
+type LoadStatsSuite struct {
+	suite.Suite
+
+	cm     storage.ChunkManager
+	schema *schemapb.CollectionSchema
+}
+
+func (s *LoadStatsSuite) SetupTest() {
+	s.cm = storage.NewLocalChunkManager(objectstorage.RootPath(s.T().TempDir()))
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test_load_stats",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		},
+	}
+}
+
+func (s *LoadStatsSuite) writeInsertBinlogs(segment *datapb.SegmentInfo, pks []int64) {
+	codec := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{ID: segment.GetCollectionID(), Schema: s.schema})
+	blobs, err := codec.Serialize(segment.GetPartitionID(), segment.GetID(), &storage.InsertData{
+		Data: map[storage.FieldID]storage.FieldData{
+			common.RowIDField:     &storage.Int64FieldData{Data: pks},
+			common.TimeStampField: &storage.Int64FieldData{Data: pks},
+			100:                   &storage.Int64FieldData{Data: pks},
+		},
+	})
+	s.Require().NoError(err)
+
+	for _, blob := range blobs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		s.Require().NoError(err)
+		logPath := path.Join(s.cm.RootPath(), common.SegmentInsertLogPath,
+			metautil.JoinIDPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), fieldID, 1))
+		s.Require().NoError(s.cm.Write(context.Background(), logPath, blob.GetValue()))
+		segment.Binlogs = append(segment.Binlogs, &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{LogPath: logPath, EntriesNum: int64(len(pks))}},
+		})
+	}
+}
+
+func (s *LoadStatsSuite) TestRebuildStats() {
+	ctx := context.Background()
+	segment := &datapb.SegmentInfo{ID: 1001, CollectionID: 1, PartitionID: 10, NumOfRows: 3}
+	s.writeInsertBinlogs(segment, []int64{5, 1, 3})
+
+	stats, statslog, err := RebuildStats(ctx, s.cm, s.schema, segment, int64(storage.CompoundStatsType), 2)
+	s.NoError(err)
+	s.Len(stats, 1)
+	s.EqualValues(1, stats[0].MinPK.GetValue())
+	s.EqualValues(5, stats[0].MaxPK.GetValue())
+	s.True(stats[0].PkExist(storage.NewInt64PrimaryKey(3)))
+
+	// rebuilt statslog shall be loadable
+	statsPath := path.Join(s.cm.RootPath(), common.SegmentStatslogPath,
+		metautil.JoinIDPath(1, 10, 1001, 100, int64(storage.CompoundStatsType)))
+	s.Require().NotNil(statslog)
+	s.EqualValues(100, statslog.GetFieldID())
+	s.Equal(statsPath, statslog.GetBinlogs()[0].GetLogPath())
+	s.EqualValues(storage.CompoundStatsType, statslog.GetBinlogs()[0].GetLogID())
+	loaded, err := LoadStats(ctx, s.cm, s.schema, segment.GetID(), []*datapb.FieldBinlog{statslog})
+	s.NoError(err)
+	s.Len(loaded, 1)
+	s.True(loaded[0].PkExist(storage.NewInt64PrimaryKey(5)))
+}
+
+func (s *LoadStatsSuite) TestRebuildStatsStorageV2() {
+	paramtable.Init()
+	ctx := context.Background()
+	paramtable.Get().Save(paramtable.Get().CommonCfg.StorageType.Key, "local")
+	defer paramtable.Get().Reset(paramtable.Get().CommonCfg.StorageType.Key)
+	paramtable.Get().Save(paramtable.Get().LocalStorageCfg.Path.Key, s.cm.RootPath())
+	defer paramtable.Get().Reset(paramtable.Get().LocalStorageCfg.Path.Key)
+
+	segment := &datapb.SegmentInfo{ID: 1004, CollectionID: 1, PartitionID: 10, NumOfRows: 3, StorageVersion: storage.StorageV2}
+	columnGroups := storagecommon.SplitColumns(s.schema.GetFields(), map[int64]storagecommon.ColumnStats{}, storagecommon.NewRemanentShortPolicy(-1))
+	w, err := storage.NewBinlogRecordWriter(ctx, segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), s.schema,
+		allocator.NewLocalAllocator(1, math.MaxInt64), 1024, 1024,
+		storage.WithVersion(storage.StorageV2),
+		storage.WithUploader(s.cm.MultiWrite),
+		storage.WithColumnGroups(columnGroups),
+		storage.WithStorageConfig(CreateStorageConfig()),
+	)
+	s.Require().NoError(err)
+	values := lo.Map([]int64{5, 1, 3}, func(pk int64, _ int) *storage.Value {
+		return &storage.Value{ID: pk, PK: storage.NewInt64PrimaryKey(pk), Timestamp: pk, Value: map[storage.FieldID]any{
+			common.RowIDField: pk, common.TimeStampField: pk, 100: pk,
+		}}
+	})
+	record, err := storage.ValueSerializer(values, s.schema)
+	s.Require().NoError(err)
+	s.Require().NoError(w.Write(record))
+	s.Require().NoError(w.Close())
+	fieldBinlogs, _, _ := w.GetLogs()
+	segment.Binlogs = lo.Values(fieldBinlogs)
+
+	// the pk binlog is resolved by the child fields of its column group.
+	s.Require().Len(segment.GetBinlogs(), 1)
+	s.Contains(segment.GetBinlogs()[0].GetChildFields(), int64(100))
+
+	stats, statslog, err := RebuildStats(ctx, s.cm, s.schema, segment, int64(storage.CompoundStatsType), 2)
+	s.NoError(err)
+	s.Len(stats, 1)
+	s.EqualValues(1, stats[0].MinPK.GetValue())
+	s.EqualValues(5, stats[0].MaxPK.GetValue())
+	s.True(stats[0].PkExist(storage.NewInt64PrimaryKey(3)))
+	s.Require().NotNil(statslog)
+	s.EqualValues(100, statslog.GetFieldID())
+
+	// the column group without the pk field is not taken as the pk binlog.
+	segment.Binlogs[0].ChildFields = []int64{common.RowIDField, common.TimeStampField}
+	_, _, err = RebuildStats(ctx, s.cm, s.schema, segment, int64(storage.CompoundStatsType), 2)
+	s.Error(err)
+}
+
+func (s *LoadStatsSuite) TestRebuildStatsWithoutPkBinlog() {
+	segment := &datapb.SegmentInfo{ID: 1002, CollectionID: 1, PartitionID: 10, NumOfRows: 3}
+	_, _, err := RebuildStats(context.Background(), s.cm, s.schema, segment, int64(storage.CompoundStatsType), 2)
+	s.Error(err)
+}
+
+func (s *LoadStatsSuite) TestLoadCorruptedStats() {
+	ctx := context.Background()
+	statsPath := path.Join(s.cm.RootPath(), common.SegmentStatslogPath, metautil.JoinIDPath(1, 10, 1003, 100, 1))
+	s.Require().NoError(s.cm.Write(ctx, statsPath, []byte("corrupted")))
+
+	_, err := LoadStats(ctx, s.cm, s.schema, 1003, []*datapb.FieldBinlog{{
+		FieldID: 100,
+		Binlogs: []*datapb.Binlog{{LogPath: statsPath}},
+	}})
+	s.Error(err)
+}
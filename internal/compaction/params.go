@@ -31,6 +31,8 @@ type Params struct {
 	PreferSegmentSizeRatio    float64                `json:"prefer_segment_size_ratio,omitempty"`
 	BloomFilterApplyBatchSize int                    `json:"bloom_filter_apply_batch_size,omitempty"`
 	StorageConfig             *indexpb.StorageConfig `json:"storage_config,omitempty"`
+	ValidateResult            bool                   `json:"validate_result,omitempty"`
+	ValidateSamplePKNum       int                    `json:"validate_sample_pk_num,omitempty"`
 }
 
 func GenParams() Params {
@@ -46,6 +48,8 @@ func GenParams() Params {
 		PreferSegmentSizeRatio:    paramtable.Get().DataCoordCfg.ClusteringCompactionPreferSegmentSizeRatio.GetAsFloat(),
 		BloomFilterApplyBatchSize: paramtable.Get().CommonCfg.BloomFilterApplyBatchSize.GetAsInt(),
 		StorageConfig:             CreateStorageConfig(),
+		ValidateResult:            paramtable.Get().DataNodeCfg.ValidateResult.GetAsBool(),
+		ValidateSamplePKNum:       paramtable.Get().DataNodeCfg.ValidateSamplePKNum.GetAsInt(),
 	}
 }
 
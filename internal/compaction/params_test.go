@@ -46,6 +46,8 @@ func TestGetJSONParams(t *testing.T) {
 		PreferSegmentSizeRatio:    paramtable.Get().DataCoordCfg.ClusteringCompactionPreferSegmentSizeRatio.GetAsFloat(),
 		BloomFilterApplyBatchSize: paramtable.Get().CommonCfg.BloomFilterApplyBatchSize.GetAsInt(),
 		StorageConfig:             CreateStorageConfig(),
+		ValidateResult:            paramtable.Get().DataNodeCfg.ValidateResult.GetAsBool(),
+		ValidateSamplePKNum:       paramtable.Get().DataNodeCfg.ValidateSamplePKNum.GetAsInt(),
 	}, result)
 }
 
@@ -56,7 +58,9 @@ func TestGetParamsFromJSON(t *testing.T) {
 		"use_merge_sort": false,
 		"max_segment_merge_sort": 2,
 		"prefer_segment_size_ratio": 0.1,
-		"bloom_filter_apply_batch_size": 1000
+		"bloom_filter_apply_batch_size": 1000,
+		"validate_result": true,
+		"validate_sample_pk_num": 10
 	}`
 
 	expected := Params{
@@ -66,6 +70,8 @@ func TestGetParamsFromJSON(t *testing.T) {
 		MaxSegmentMergeSort:       2,
 		PreferSegmentSizeRatio:    0.1,
 		BloomFilterApplyBatchSize: 1000,
+		ValidateResult:            true,
+		ValidateSamplePKNum:       10,
 	}
 
 	result, err := ParseParamsFromJSON(input)
@@ -96,5 +102,7 @@ func TestGetParamsFromJSON_EmptyJSON(t *testing.T) {
 		PreferSegmentSizeRatio:    paramtable.Get().DataCoordCfg.ClusteringCompactionPreferSegmentSizeRatio.GetAsFloat(),
 		BloomFilterApplyBatchSize: paramtable.Get().CommonCfg.BloomFilterApplyBatchSize.GetAsInt(),
 		StorageConfig:             CreateStorageConfig(),
+		ValidateResult:            paramtable.Get().DataNodeCfg.ValidateResult.GetAsBool(),
+		ValidateSamplePKNum:       paramtable.Get().DataNodeCfg.ValidateSamplePKNum.GetAsInt(),
 	}, result)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -223,7 +224,8 @@ func (s *mixCoordImpl) initKVCreator() {
 		} else {
 			s.metaKVCreator = func() kv.MetaKv {
 				return etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue(),
-					etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+					etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)),
+					etcdkv.WithSharedBulkClient(&paramtable.Get().ServiceParam.EtcdCfg))
 			}
 		}
 	}
@@ -455,6 +457,26 @@ func (s *mixCoordImpl) ShowCollectionIDs(ctx context.Context, req *rootcoordpb.S
 	return s.rootcoordServer.ShowCollectionIDs(ctx, req)
 }
 
+func (s *mixCoordImpl) SetCollectionQuotaOverride(ctx context.Context, req *rootcoordpb.SetCollectionQuotaOverrideRequest) (*commonpb.Status, error) {
+	return s.rootcoordServer.SetCollectionQuotaOverride(ctx, req)
+}
+
+func (s *mixCoordImpl) CreateCollectionTemplate(ctx context.Context, req *rootcoordpb.CreateCollectionTemplateRequest) (*commonpb.Status, error) {
+	return s.rootcoordServer.CreateCollectionTemplate(ctx, req)
+}
+
+func (s *mixCoordImpl) DropCollectionTemplate(ctx context.Context, req *rootcoordpb.DropCollectionTemplateRequest) (*commonpb.Status, error) {
+	return s.rootcoordServer.DropCollectionTemplate(ctx, req)
+}
+
+func (s *mixCoordImpl) ListCollectionTemplates(ctx context.Context, req *rootcoordpb.ListCollectionTemplatesRequest) (*rootcoordpb.ListCollectionTemplatesResponse, error) {
+	return s.rootcoordServer.ListCollectionTemplates(ctx, req)
+}
+
+func (s *mixCoordImpl) GetUsage(ctx context.Context, req *rootcoordpb.GetUsageRequest) (*rootcoordpb.GetUsageResponse, error) {
+	return s.rootcoordServer.GetUsage(ctx, req)
+}
+
 func (s *mixCoordImpl) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return s.rootcoordServer.AlterCollection(ctx, req)
 }
@@ -717,6 +739,8 @@ func (s *mixCoordImpl) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRe
 		return s.GetQcMetrics(ctx, in)
 	} else if len(processRole) > 0 && processRole == typeutil.DataCoordRole {
 		return s.GetDcMetrics(ctx, in)
+	} else if len(processRole) > 0 && processRole == typeutil.RootCoordRole {
+		return s.rootcoordServer.GetMetrics(ctx, in)
 	}
 
 	identifierMap := make(map[string]int)
@@ -1116,6 +1140,10 @@ func (s *mixCoordImpl) MarkSegmentsDropped(ctx context.Context, req *datapb.Mark
 	return s.datacoordServer.MarkSegmentsDropped(ctx, req)
 }
 
+func (s *mixCoordImpl) UpdateSegmentStatslogs(ctx context.Context, req *datapb.UpdateSegmentStatslogsRequest) (*commonpb.Status, error) {
+	return s.datacoordServer.UpdateSegmentStatslogs(ctx, req)
+}
+
 func (s *mixCoordImpl) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return s.datacoordServer.BroadcastAlteredCollection(ctx, req)
 }
@@ -1217,6 +1245,59 @@ func (s *mixCoordImpl) FlushAll(ctx context.Context, req *datapb.FlushAllRequest
 	return s.datacoordServer.FlushAll(ctx, req)
 }
 
+func (s *mixCoordImpl) FlushAndSeal(ctx context.Context, req *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error) {
+	return s.datacoordServer.FlushAndSeal(ctx, req)
+}
+
+func (s *mixCoordImpl) ListQuarantinedSegments(ctx context.Context, req *datapb.ListQuarantinedSegmentsRequest) (*datapb.ListQuarantinedSegmentsResponse, error) {
+	return s.datacoordServer.ListQuarantinedSegments(ctx, req)
+}
+
+func (s *mixCoordImpl) OperateQuarantinedSegment(ctx context.Context, req *datapb.OperateQuarantinedSegmentRequest) (*commonpb.Status, error) {
+	return s.datacoordServer.OperateQuarantinedSegment(ctx, req)
+}
+
+func (s *mixCoordImpl) RebuildStatslogs(ctx context.Context, req *datapb.RebuildStatslogsRequest) (*datapb.RebuildStatslogsResponse, error) {
+	return s.datacoordServer.RebuildStatslogs(ctx, req)
+}
+
+func (s *mixCoordImpl) GetStatslogRebuildJob(ctx context.Context, req *datapb.GetStatslogRebuildJobRequest) (*datapb.GetStatslogRebuildJobResponse, error) {
+	return s.datacoordServer.GetStatslogRebuildJob(ctx, req)
+}
+
+func (s *mixCoordImpl) ReportChannelHealth(ctx context.Context, req *datapb.ReportChannelHealthRequest) (*commonpb.Status, error) {
+	return s.datacoordServer.ReportChannelHealth(ctx, req)
+}
+
+// ListBackgroundJobs merges the background jobs of the datacoord and querycoord,
+// the jobs are sorted by the start time, then by the type and the job id.
+func (s *mixCoordImpl) ListBackgroundJobs(ctx context.Context, req *datapb.ListBackgroundJobsRequest) (*datapb.ListBackgroundJobsResponse, error) {
+	var jobs []*datapb.BackgroundJob
+	for _, list := range []func(context.Context, *datapb.ListBackgroundJobsRequest) (*datapb.ListBackgroundJobsResponse, error){
+		s.datacoordServer.ListBackgroundJobs, s.queryCoordServer.ListBackgroundJobs,
+	} {
+		resp, err := list(ctx, req)
+		if err := merr.CheckRPCCall(resp, err); err != nil {
+			return &datapb.ListBackgroundJobsResponse{Status: merr.Status(err)}, nil
+		}
+		jobs = append(jobs, resp.GetJobs()...)
+	}
+
+	sort.SliceStable(jobs, func(i, j int) bool {
+		if jobs[i].GetStartTime() != jobs[j].GetStartTime() {
+			return jobs[i].GetStartTime() < jobs[j].GetStartTime()
+		}
+		if jobs[i].GetType() != jobs[j].GetType() {
+			return jobs[i].GetType() < jobs[j].GetType()
+		}
+		return jobs[i].GetJobID() < jobs[j].GetJobID()
+	})
+	return &datapb.ListBackgroundJobsResponse{
+		Status: merr.Success(),
+		Jobs:   jobs,
+	}, nil
+}
+
 // AddFileResource add file resource
 func (s *mixCoordImpl) AddFileResource(ctx context.Context, req *milvuspb.AddFileResourceRequest) (*commonpb.Status, error) {
 	return s.datacoordServer.AddFileResource(ctx, req)
@@ -102,6 +102,26 @@ func (s *StreamingNodeManager) GetLatestWALLocated(ctx context.Context, vchannel
 	return serverID, nil
 }
 
+// RecoverWAL marks the wal of the vchannel as unavailable, so the wal is assigned again
+// and recovered with its flusher by the streaming node.
+func (s *StreamingNodeManager) RecoverWAL(ctx context.Context, vchannel string) error {
+	pchannel := funcutil.ToPhysicalChannel(vchannel)
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	assignment, err := balancer.GetLatestChannelAssignment()
+	if err != nil {
+		return err
+	}
+	for _, relation := range assignment.Relations {
+		if relation.Channel.Name == pchannel {
+			return balancer.MarkAsUnavailable(ctx, []types.PChannelInfo{relation.Channel})
+		}
+	}
+	return errors.Errorf("channel: %s not found", vchannel)
+}
+
 // CheckIfStreamingServiceReady checks if the streaming service is ready.
 func (s *StreamingNodeManager) CheckIfStreamingServiceReady(ctx context.Context) error {
 	n := NewStreamingReadyNotifier()
@@ -76,6 +76,18 @@ func TestStreamingNodeManager(t *testing.T) {
 	assert.Equal(t, len(streamingNodes), 1)
 
 	assert.NoError(t, m.RegisterStreamingEnabledListener(context.Background(), NewStreamingReadyNotifier()))
+
+	b.EXPECT().GetLatestChannelAssignment().Return(&balancer.WatchChannelAssignmentsCallbackParam{
+		Relations: []types.PChannelInfoAssigned{
+			{
+				Channel: types.PChannelInfo{Name: "a_test", Term: 1},
+				Node:    types.StreamingNodeInfo{ServerID: 1, Address: "localhost:1"},
+			},
+		},
+	}, nil)
+	b.EXPECT().MarkAsUnavailable(mock.Anything, []types.PChannelInfo{{Name: "a_test", Term: 1}}).Return(nil)
+	assert.NoError(t, m.RecoverWAL(context.Background(), "a_test_1v0"))
+	assert.Error(t, m.RecoverWAL(context.Background(), "b_test_1v0"))
 }
 
 func TestStreamingReadyNotifier(t *testing.T) {
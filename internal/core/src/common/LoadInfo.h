@@ -17,6 +17,7 @@
 #pragma once
 
 #include <map>
+#include <optional>
 #include <string>
 #include <vector>
 
@@ -40,8 +41,8 @@ struct LoadFieldDataInfo {
     int64_t storage_version = 0;
     milvus::proto::common::LoadPriority load_priority =
         milvus::proto::common::LoadPriority::HIGH;
-    CacheWarmupPolicy warmup_policy =
-        CacheWarmupPolicy::CacheWarmupPolicy_Disable;
+    // overrides the configured cache warmup policy of the fields if set
+    std::optional<CacheWarmupPolicy> warmup_policy;
     std::vector<int64_t> child_field_ids;
 };
 
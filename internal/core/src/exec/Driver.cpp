@@ -33,6 +33,7 @@
 #include "exec/operator/RandomSampleNode.h"
 #include "exec/operator/GroupByNode.h"
 #include "exec/Task.h"
+#include "futures/Cancellation.h"
 #include "plan/PlanNode.h"
 
 namespace milvus {
@@ -196,6 +197,8 @@ Driver::Next(std::shared_ptr<BlockingState>& blocking_state) {
 #define CALL_OPERATOR(call_func, operator, method_name)            \
     try {                                                          \
         call_func;                                                 \
+    } catch (const folly::FutureCancellation&) {                   \
+        throw;                                                     \
     } catch (std::exception & e) {                                 \
         std::string stack_trace = milvus::impl::EasyStackTrace();  \
         auto err_msg = fmt::format(                                \
@@ -56,6 +56,12 @@ FunctionFactory::RegisterAllFunctions() {
     RegisterFilterFunction("starts_with",
                            {DataType::VARCHAR, DataType::VARCHAR},
                            function::StartsWithVarchar);
+    RegisterFilterFunction("lower_equal",
+                           {DataType::VARCHAR, DataType::VARCHAR},
+                           function::LowerEqualVarchar);
+    RegisterFilterFunction("upper_equal",
+                           {DataType::VARCHAR, DataType::VARCHAR},
+                           function::UpperEqualVarchar);
     LOG_INFO("{} functions registered", GetFilterFunctionNum());
 }
 
@@ -237,3 +237,45 @@ TEST_F(FunctionTest, StartsWithIncorrectArgs) {
     milvus::RowVector three_args(arg_vec);
     EXPECT_ANY_THROW(StartsWithVarchar(three_args, result));
 }
+
+TEST_F(FunctionTest, CaseConvertedEqual) {
+    const int row_count = 4;
+    std::vector<milvus::VectorPtr> arg_vec;
+
+    auto col1 = std::make_shared<milvus::ColumnVector>(milvus::DataType::STRING,
+                                                       row_count);
+    auto* col1_data = col1->RawAsValues<std::string>();
+    col1_data[0] = "Milvus";
+    col1_data[1] = "MILVUS";
+    col1_data[2] = "milvus-db";
+    col1_data[3] = "milvus";
+    TargetBitmapView valid_bitmap_col1(col1->GetValidRawData(), col1->size());
+    valid_bitmap_col1[3] = false;
+    arg_vec.push_back(col1);
+
+    const std::string constant_str = "milvus";
+    arg_vec.push_back(std::make_shared<milvus::ConstantVector<std::string>>(
+        milvus::DataType::STRING, row_count, constant_str));
+    milvus::RowVector args(arg_vec);
+
+    VectorPtr result;
+    LowerEqualVarchar(args, result);
+    auto result_vec = std::dynamic_pointer_cast<milvus::ColumnVector>(result);
+    ASSERT_NE(result_vec, nullptr);
+    TargetBitmapView bitmap(result_vec->GetRawData(), result_vec->size());
+    EXPECT_TRUE(bitmap[0]);
+    EXPECT_TRUE(bitmap[1]);
+    EXPECT_FALSE(bitmap[2]);
+    EXPECT_FALSE(result_vec->ValidAt(3));
+
+    UpperEqualVarchar(args, result);
+    result_vec = std::dynamic_pointer_cast<milvus::ColumnVector>(result);
+    ASSERT_NE(result_vec, nullptr);
+    TargetBitmapView upper_bitmap(result_vec->GetRawData(), result_vec->size());
+    EXPECT_FALSE(upper_bitmap[0]);
+    EXPECT_FALSE(upper_bitmap[1]);
+
+    std::vector<milvus::VectorPtr> single_arg_vec{col1};
+    milvus::RowVector single_args(single_arg_vec);
+    EXPECT_ANY_THROW(LowerEqualVarchar(single_args, result));
+}
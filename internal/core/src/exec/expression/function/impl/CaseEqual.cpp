@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "exec/expression/function/FunctionImplUtils.h"
+#include "exec/expression/function/impl/StringFunctions.h"
+
+#include <algorithm>
+#include <cctype>
+#include <string>
+#include "common/EasyAssert.h"
+#include "exec/expression/function/FunctionFactory.h"
+
+namespace milvus {
+namespace exec {
+namespace expression {
+namespace function {
+
+namespace {
+
+int
+ToLower(int c) {
+    return std::tolower(c);
+}
+
+int
+ToUpper(int c) {
+    return std::toupper(c);
+}
+
+// only ASCII characters are converted, others are compared as they are.
+template <int (*Convert)(int)>
+bool
+CaseConvertedEqual(const std::string& str, const std::string& target) {
+    if (str.size() != target.size()) {
+        return false;
+    }
+    return std::equal(
+        str.begin(), str.end(), target.begin(), [](char a, char b) {
+            return static_cast<char>(Convert(static_cast<unsigned char>(a))) ==
+                   b;
+        });
+}
+
+template <int (*Convert)(int)>
+void
+CaseConvertedEqualVarchar(const RowVector& args, FilterFunctionReturn& result) {
+    if (args.childrens().size() != 2) {
+        ThrowInfo(ExprInvalid,
+                  "invalid argument count, expect 2, actual {}",
+                  args.childrens().size());
+    }
+    auto strs = std::dynamic_pointer_cast<SimpleVector>(args.child(0));
+    Assert(strs != nullptr);
+    CheckVarcharOrStringType(strs);
+    auto targets = std::dynamic_pointer_cast<SimpleVector>(args.child(1));
+    Assert(targets != nullptr);
+    CheckVarcharOrStringType(targets);
+
+    TargetBitmap bitmap(strs->size(), false);
+    TargetBitmap valid_bitmap(strs->size(), true);
+    for (size_t i = 0; i < strs->size(); ++i) {
+        if (strs->ValidAt(i) && targets->ValidAt(i)) {
+            auto* str_ptr = reinterpret_cast<std::string*>(
+                strs->RawValueAt(i, sizeof(std::string)));
+            auto* target_ptr = reinterpret_cast<std::string*>(
+                targets->RawValueAt(i, sizeof(std::string)));
+            bitmap.set(i, CaseConvertedEqual<Convert>(*str_ptr, *target_ptr));
+        } else {
+            valid_bitmap[i] = false;
+        }
+    }
+    result = std::make_shared<ColumnVector>(std::move(bitmap),
+                                            std::move(valid_bitmap));
+}
+
+}  // namespace
+
+void
+LowerEqualVarchar(const RowVector& args, FilterFunctionReturn& result) {
+    CaseConvertedEqualVarchar<ToLower>(args, result);
+}
+
+void
+UpperEqualVarchar(const RowVector& args, FilterFunctionReturn& result) {
+    CaseConvertedEqualVarchar<ToUpper>(args, result);
+}
+
+}  // namespace function
+}  // namespace expression
+}  // namespace exec
+}  // namespace milvus
@@ -29,6 +29,12 @@ EmptyVarchar(const RowVector& args, FilterFunctionReturn& result);
 void
 StartsWithVarchar(const RowVector& args, FilterFunctionReturn& result);
 
+void
+LowerEqualVarchar(const RowVector& args, FilterFunctionReturn& result);
+
+void
+UpperEqualVarchar(const RowVector& args, FilterFunctionReturn& result);
+
 }  // namespace function
 }  // namespace expression
 }  // namespace exec
@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#pragma once
+
+#include <folly/CancellationToken.h>
+#include <folly/futures/FutureException.h>
+
+#include <utility>
+
+namespace milvus::futures {
+
+/// @brief the cancellation token of the async function running on the current thread,
+/// it's used by the long running loops which don't have the token at hand, e.g. the chunk loop of search.
+inline thread_local const folly::CancellationToken* current_cancel_token =
+    nullptr;
+
+/// @brief CancellationScope binds the cancellation token to the current thread until it's destructed.
+class CancellationScope {
+ public:
+    explicit CancellationScope(folly::CancellationToken token)
+        : token_(std::move(token)), prev_(current_cancel_token) {
+        current_cancel_token = &token_;
+    }
+
+    ~CancellationScope() {
+        current_cancel_token = prev_;
+    }
+
+    CancellationScope(const CancellationScope&) = delete;
+    CancellationScope&
+    operator=(const CancellationScope&) = delete;
+
+ private:
+    folly::CancellationToken token_;
+    const folly::CancellationToken* prev_;
+};
+
+/// @brief throw a FutureCancellation exception if the token bound to the current thread is cancelled.
+inline void
+ThrowIfCurrentCancelled() {
+    if (current_cancel_token != nullptr &&
+        current_cancel_token->isCancellationRequested()) {
+        throw folly::FutureCancellation();
+    }
+}
+
+}  // namespace milvus::futures
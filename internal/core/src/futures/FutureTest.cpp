@@ -10,6 +10,7 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include <gtest/gtest.h>
+#include "futures/Cancellation.h"
 #include "futures/Future.h"
 #include <folly/executors/CPUThreadPoolExecutor.h>
 #include <stdlib.h>
@@ -208,4 +209,18 @@ TEST(Futures, Future) {
         ASSERT_EQ(s.error_code, milvus::FollyCancel);
         free((char*)(s.error_msg));
     }
-}
\ No newline at end of file
+}
+
+TEST(Futures, CancellationScope) {
+    ASSERT_NO_THROW(ThrowIfCurrentCancelled());
+
+    folly::CancellationSource source;
+    {
+        CancellationScope scope(source.getToken());
+        ASSERT_NO_THROW(ThrowIfCurrentCancelled());
+        source.requestCancellation();
+        ASSERT_THROW(ThrowIfCurrentCancelled(), folly::FutureCancellation);
+    }
+    // the token is unbound once the scope is destructed
+    ASSERT_NO_THROW(ThrowIfCurrentCancelled());
+}
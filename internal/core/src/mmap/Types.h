@@ -17,6 +17,7 @@
 
 #include <unistd.h>
 #include <memory>
+#include <optional>
 #include <string>
 #include <vector>
 
@@ -45,5 +46,7 @@ struct FieldDataInfo {
     std::string mmap_dir_path;
     std::shared_ptr<ArrowReaderChannel> arrow_reader_channel;
     bool in_load_list = false;
+    // overrides the configured cache warmup policy if set
+    std::optional<CacheWarmupPolicy> warmup_policy;
 };
 }  // namespace milvus
@@ -22,6 +22,7 @@
 #include "query/SearchBruteForce.h"
 #include "query/SearchOnIndex.h"
 #include "exec/operator/Utils.h"
+#include "futures/Cancellation.h"
 
 namespace milvus::query {
 
@@ -176,6 +177,7 @@ SearchOnGrowing(const segcore::SegmentGrowingImpl& segment,
 
         for (int chunk_id = current_chunk_id; chunk_id < max_chunk;
              ++chunk_id) {
+            futures::ThrowIfCurrentCancelled();
             auto chunk_data = vec_ptr->get_chunk_data(chunk_id);
 
             auto element_begin = chunk_id * vec_size_per_chunk;
@@ -23,6 +23,7 @@
 #include "query/SearchOnSealed.h"
 #include "query/helper.h"
 #include "exec/operator/Utils.h"
+#include "futures/Cancellation.h"
 
 namespace milvus::query {
 
@@ -152,6 +153,7 @@ SearchOnSealedColumn(const Schema& schema,
 
     auto offset = 0;
     for (int i = 0; i < num_chunk; ++i) {
+        futures::ThrowIfCurrentCancelled();
         auto pw = column->DataOfChunk(op_context, i);
         auto vec_data = pw.get();
         auto chunk_size = column->chunk_row_nums(i);
@@ -405,6 +405,7 @@ ChunkedSegmentSealedImpl::load_column_group_data_internal(
                                                num_rows,
                                                mmap_dir_path,
                                                merged_in_load_list);
+        column_group_info.warmup_policy = load_info.warmup_policy;
         LOG_INFO(
             "[StorageV2] segment {} loads column group {} with field ids "
             "{} "
@@ -519,6 +520,7 @@ ChunkedSegmentSealedImpl::load_field_data_internal(
                           num_rows,
                           mmap_dir_path,
                           schema_->ShouldLoadField(field_id));
+        field_data_info.warmup_policy = load_info.warmup_policy;
         LOG_INFO("segment {} loads field {} with num_rows {}, sorted by pk {}",
                  this->get_segment_id(),
                  field_id.get(),
@@ -2864,6 +2866,10 @@ ChunkedSegmentSealedImpl::Load(milvus::tracer::TraceContext& trace_ctx) {
                 // Convert proto FieldIndexInfo to LoadIndexInfo
                 auto load_index_info =
                     ConvertFieldIndexInfoToLoadIndexInfo(index_info_ptr);
+                if (segment_load_info_.prefetch()) {
+                    load_index_info.warmup_policy =
+                        CacheWarmupPolicy::CacheWarmupPolicy_Sync;
+                }
 
                 LOG_INFO("Loading index for segment {} field {} with {} files",
                          id_,
@@ -2911,6 +2917,10 @@ ChunkedSegmentSealedImpl::Load(milvus::tracer::TraceContext& trace_ctx) {
         LoadFieldDataInfo load_field_data_info;
         load_field_data_info.storage_version =
             segment_load_info_.storageversion();
+        if (segment_load_info_.prefetch()) {
+            load_field_data_info.warmup_policy =
+                CacheWarmupPolicy::CacheWarmupPolicy_Sync;
+        }
 
         const auto& field_binlog = segment_load_info_.binlog_paths(i);
         auto field_id = FieldId(field_binlog.fieldid());
@@ -73,6 +73,7 @@ TEST(CPackedTest, PackedWriterAndReader) {
                                1,
                                part_upload_size,
                                cgs,
+                               CColumnCompressions{nullptr, nullptr, 0},
                                &c_packed_writer,
                                nullptr);
     EXPECT_EQ(c_status.error_code, 0);
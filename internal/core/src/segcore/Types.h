@@ -18,6 +18,7 @@
 
 #include <iostream>
 #include <map>
+#include <optional>
 #include <string>
 #include <vector>
 
@@ -56,6 +57,8 @@ struct LoadIndexInfo {
         // so the size may be not accurate (generated by the compressed-index-file-size multiplied with a compress-ratio)
     int64_t num_rows;
     int64_t dim;
+    // overrides the configured cache warmup policy of the index if set
+    std::optional<CacheWarmupPolicy> warmup_policy;
 };
 
 }  // namespace milvus::segcore
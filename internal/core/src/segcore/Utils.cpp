@@ -1192,6 +1192,30 @@ getCacheWarmupPolicy(bool is_vector, bool is_index, bool in_load_list) {
     }
 }
 
+CacheWarmupPolicy
+getCacheWarmupPolicy(bool is_vector,
+                     bool is_index,
+                     bool in_load_list,
+                     const std::optional<CacheWarmupPolicy>& override_policy) {
+    if (in_load_list && override_policy.has_value()) {
+        return override_policy.value();
+    }
+    return getCacheWarmupPolicy(is_vector, is_index, in_load_list);
+}
+
+CacheWarmupPolicy
+parseCacheWarmupPolicy(const std::string& policy) {
+    if (policy == "sync") {
+        return CacheWarmupPolicy::CacheWarmupPolicy_Sync;
+    }
+    if (policy == "disable") {
+        return CacheWarmupPolicy::CacheWarmupPolicy_Disable;
+    }
+    ThrowInfo(ErrorCode::InvalidParameter,
+              "invalid cache warmup policy: {}",
+              policy);
+}
+
 milvus::cachinglayer::CellDataType
 getCellDataType(bool is_vector, bool is_index) {
     if (is_index) {
@@ -13,6 +13,7 @@
 
 #include <memory>
 #include <cstdlib>
+#include <optional>
 #include <string>
 #include <vector>
 
@@ -141,6 +142,18 @@ upper_bound(const ConcurrentVector<Timestamp>& timestamps,
 CacheWarmupPolicy
 getCacheWarmupPolicy(bool is_vector, bool is_index, bool in_load_list = true);
 
+// Get the cache warmup policy, the per-load override takes precedence
+// over the globally configured one if the content is in the load list.
+CacheWarmupPolicy
+getCacheWarmupPolicy(bool is_vector,
+                     bool is_index,
+                     bool in_load_list,
+                     const std::optional<CacheWarmupPolicy>& override_policy);
+
+// Parse the cache warmup policy, "sync" or "disable".
+CacheWarmupPolicy
+parseCacheWarmupPolicy(const std::string& policy);
+
 milvus::cachinglayer::CellDataType
 getCellDataType(bool is_vector, bool is_index);
 
@@ -423,6 +423,11 @@ FinishLoadIndexInfo(CLoadIndexInfo c_load_index_info,
             load_index_info->schema = info_proto->field();
             load_index_info->index_size = info_proto->index_file_size();
             load_index_info->num_rows = info_proto->num_rows();
+            if (!info_proto->warmup_policy().empty()) {
+                load_index_info->warmup_policy =
+                    milvus::segcore::parseCacheWarmupPolicy(
+                        info_proto->warmup_policy());
+            }
             auto field_schema =
                 milvus::FieldMeta::ParseFrom(load_index_info->schema);
             size_t dim = IsVectorDataType(field_schema.get_data_type()) &&
@@ -13,6 +13,7 @@
 // limitations under the License.
 
 #include "common/common_type_c.h"
+#include "parquet/arrow/schema.h"
 #include "parquet/encryption/encryption.h"
 #include "parquet/properties.h"
 #include "parquet/types.h"
@@ -31,10 +32,50 @@
 #include <arrow/record_batch.h>
 #include <arrow/memory_pool.h>
 #include <arrow/device.h>
+#include <unordered_map>
 #include "common/EasyAssert.h"
 #include "common/type_c.h"
 #include "monitor/scope_metric.h"
 
+namespace {
+
+// ApplyColumnCompressions sets the compression of the leaf columns
+// of the configured top level columns.
+void
+ApplyColumnCompressions(parquet::WriterProperties::Builder& builder,
+                        const std::shared_ptr<arrow::Schema>& schema,
+                        const CColumnCompressions& column_compressions) {
+    if (column_compressions.num_columns == 0) {
+        return;
+    }
+    std::unordered_map<std::string, int32_t> levels;
+    for (int64_t i = 0; i < column_compressions.num_columns; i++) {
+        levels[column_compressions.columns[i]] = column_compressions.levels[i];
+    }
+
+    std::shared_ptr<parquet::SchemaDescriptor> parquet_schema;
+    auto status = parquet::arrow::ToParquetSchema(
+        schema.get(), *parquet::default_writer_properties(), &parquet_schema);
+    AssertInfo(status.ok(),
+               "[StorageV2] failed to convert the schema for compression: {}",
+               status.ToString());
+    for (int i = 0; i < parquet_schema->num_columns(); i++) {
+        auto path = parquet_schema->Column(i)->path();
+        auto it = levels.find(path->ToDotVector().front());
+        if (it == levels.end()) {
+            continue;
+        }
+        if (it->second == 0) {
+            builder.compression(path, arrow::Compression::UNCOMPRESSED);
+            continue;
+        }
+        builder.compression(path, arrow::Compression::ZSTD);
+        builder.compression_level(path, it->second);
+    }
+}
+
+}  // namespace
+
 CStatus
 NewPackedWriterWithStorageConfig(struct ArrowSchema* schema,
                                  const int64_t buffer_size,
@@ -42,6 +83,7 @@ NewPackedWriterWithStorageConfig(struct ArrowSchema* schema,
                                  int64_t num_paths,
                                  int64_t part_upload_size,
                                  CColumnGroups column_groups,
+                                 CColumnCompressions column_compressions,
                                  CStorageConfig c_storage_config,
                                  CPackedWriter* c_packed_writer,
                                  CPluginContext* c_plugin_context) {
@@ -107,6 +149,7 @@ NewPackedWriterWithStorageConfig(struct ArrowSchema* schema,
                     ->build());
         }
 
+        ApplyColumnCompressions(builder, trueSchema, column_compressions);
         auto writer_properties = builder.build();
         auto writer = std::make_unique<milvus_storage::PackedRecordBatchWriter>(
             trueFs,
@@ -131,6 +174,7 @@ NewPackedWriter(struct ArrowSchema* schema,
                 int64_t num_paths,
                 int64_t part_upload_size,
                 CColumnGroups column_groups,
+                CColumnCompressions column_compressions,
                 CPackedWriter* c_packed_writer,
                 CPluginContext* c_plugin_context) {
     SCOPE_CGO_CALL_METRIC();
@@ -176,6 +220,7 @@ NewPackedWriter(struct ArrowSchema* schema,
                     ->build());
         }
 
+        ApplyColumnCompressions(builder, trueSchema, column_compressions);
         auto writer_properties = builder.build();
         auto writer = std::make_unique<milvus_storage::PackedRecordBatchWriter>(
             trueFs,
@@ -25,6 +25,15 @@ extern "C" {
 
 typedef void* CPackedWriter;
 
+// CColumnCompressions is the compression of the top level columns,
+// the columns not listed use the default compression of the writer.
+typedef struct CColumnCompressions {
+    const char** columns;
+    // the zstd level of the columns, 0 means uncompressed
+    const int32_t* levels;
+    int64_t num_columns;
+} CColumnCompressions;
+
 CStatus
 NewPackedWriterWithStorageConfig(struct ArrowSchema* schema,
                                  const int64_t buffer_size,
@@ -32,6 +41,7 @@ NewPackedWriterWithStorageConfig(struct ArrowSchema* schema,
                                  int64_t num_paths,
                                  int64_t part_upload_size,
                                  CColumnGroups column_groups,
+                                 CColumnCompressions column_compressions,
                                  CStorageConfig c_storage_config,
                                  CPackedWriter* c_packed_writer,
                                  CPluginContext* c_plugin_context);
@@ -43,6 +53,7 @@ NewPackedWriter(struct ArrowSchema* schema,
                 int64_t num_paths,
                 int64_t part_upload_size,
                 CColumnGroups column_groups,
+                CColumnCompressions column_compressions,
                 CPackedWriter* c_packed_writer,
                 CPluginContext* c_plugin_context);
 
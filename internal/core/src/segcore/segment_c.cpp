@@ -36,6 +36,7 @@
 #include "segcore/Utils.h"
 #include "storage/Event.h"
 #include "storage/Util.h"
+#include "futures/Cancellation.h"
 #include "futures/Future.h"
 #include "futures/Executor.h"
 #include "segcore/SegmentSealed.h"
@@ -209,6 +210,10 @@ AsyncSearch(CTraceContext c_trace,
             trace_ctx.spanID = c_trace.spanID;
             trace_ctx.traceFlags = c_trace.traceFlags;
 
+            // the search may be cancelled while it's queued in the executor
+            cancel_token.throwIfCancelled();
+            milvus::futures::CancellationScope cancel_scope(cancel_token);
+
             auto span = milvus::tracer::StartSpan("SegCoreSearch", &trace_ctx);
             milvus::tracer::SetRootSpan(span);
 
@@ -283,6 +288,9 @@ AsyncRetrieve(CTraceContext c_trace,
                 c_trace.traceID, c_trace.spanID, c_trace.traceFlags};
             milvus::tracer::AutoSpan span("SegCoreRetrieve", &trace_ctx, true);
 
+            cancel_token.throwIfCancelled();
+            milvus::futures::CancellationScope cancel_scope(cancel_token);
+
             segment->LazyCheckSchema(plan->schema_);
 
             auto retrieve_result = segment->Retrieve(&trace_ctx,
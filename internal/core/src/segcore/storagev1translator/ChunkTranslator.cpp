@@ -90,7 +90,8 @@ ChunkTranslator::ChunkTranslator(
             milvus::segcore::getCacheWarmupPolicy(
                 IsVectorDataType(field_meta.get_data_type()),
                 /* is_index */ false,
-                /* in_load_list*/ field_data_info.in_load_list),
+                /* in_load_list*/ field_data_info.in_load_list,
+                field_data_info.warmup_policy),
             /* support_eviction */ true),
       load_priority_(load_priority) {
     AssertInfo(!SystemProperty::Instance().IsSystem(FieldId(field_id_)),
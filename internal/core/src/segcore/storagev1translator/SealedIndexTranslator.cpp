@@ -49,7 +49,9 @@ SealedIndexTranslator::SealedIndexTranslator(
               : milvus::segcore::getCacheWarmupPolicy(
                     /* is_vector */ IsVectorDataType(
                         load_index_info->field_type),
-                    /* is_index */ true),
+                    /* is_index */ true,
+                    /* in_load_list */ true,
+                    load_index_info->warmup_policy),
           /* support_eviction */
           // if index data supports lazy load internally, we don't need to support eviction for index metadata
           // currently only vector index is possible to support lazy load
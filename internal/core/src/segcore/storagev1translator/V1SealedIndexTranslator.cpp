@@ -39,7 +39,9 @@ V1SealedIndexTranslator::V1SealedIndexTranslator(
                 /* is_index */ true),
             milvus::segcore::getCacheWarmupPolicy(
                 /* is_vector */ IsVectorDataType(load_index_info->field_type),
-                /* is_index */ true),
+                /* is_index */ true,
+                /* in_load_list */ true,
+                load_index_info->warmup_policy),
             /* support_eviction */ false) {
 }
 
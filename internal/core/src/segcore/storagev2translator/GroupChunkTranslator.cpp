@@ -83,7 +83,9 @@ GroupChunkTranslator::GroupChunkTranslator(
                     }
                     return false;
                 }(),
-                /* is_index */ false),
+                /* is_index */ false,
+                /* in_load_list */ true,
+                column_group_info.warmup_policy),
             /* support_eviction */ true) {
     auto fs = milvus_storage::ArrowFileSystemSingleton::GetInstance()
                   .GetArrowFileSystem();
@@ -0,0 +1,190 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+// listBackgroundJobs returns the compactions, index builds, imports and gc runs of the datacoord,
+// the jobs of all collections are returned if collectionID <= 0.
+func (s *Server) listBackgroundJobs(ctx context.Context, collectionID int64) []*datapb.BackgroundJob {
+	var jobs []*datapb.BackgroundJob
+	jobs = append(jobs, s.listCompactionJobs(collectionID)...)
+	jobs = append(jobs, s.listIndexBuildJobs(ctx, collectionID)...)
+
+	var filters []ImportJobFilter
+	if collectionID > 0 {
+		filters = append(filters, WithCollectionID(collectionID))
+	}
+	for _, job := range s.importMeta.GetJobBy(ctx, filters...) {
+		progress, state, _, _, reason := GetJobProgress(ctx, job.GetJobID(), s.importMeta, s.meta)
+		startTime := job.GetCreateTime()
+		if t, err := time.Parse(time.RFC3339, startTime); err == nil {
+			startTime = t.Format(time.DateTime)
+		}
+		jobs = append(jobs, &datapb.BackgroundJob{
+			Type:         metricsinfo.BackgroundJobImport,
+			JobID:        job.GetJobID(),
+			CollectionID: job.GetCollectionID(),
+			State:        importJobState(state),
+			Progress:     progress,
+			StartTime:    startTime,
+			Error:        reason,
+			Detail:       state.String(),
+		})
+	}
+
+	if s.garbageCollector != nil && collectionID <= 0 {
+		jobs = append(jobs, s.garbageCollector.GetRuns()...)
+	}
+	return jobs
+}
+
+// listCompactionJobs returns the compactions grouped by the trigger,
+// the progress is the percentage of the finished tasks of the trigger.
+func (s *Server) listCompactionJobs(collectionID int64) []*datapb.BackgroundJob {
+	triggers := s.meta.compactionTaskMeta.GetCompactionTasks()
+	if collectionID > 0 {
+		triggers = s.meta.compactionTaskMeta.GetCompactionTasksByCollection(collectionID)
+	}
+
+	jobs := make([]*datapb.BackgroundJob, 0, len(triggers))
+	for triggerID, tasks := range triggers {
+		if len(tasks) == 0 {
+			continue
+		}
+		var pending, failed, finished int
+		var failReason string
+		startTime := tasks[0].GetStartTime()
+		for _, task := range tasks {
+			switch task.GetState() {
+			case datapb.CompactionTaskState_pipelining:
+				pending++
+			case datapb.CompactionTaskState_failed, datapb.CompactionTaskState_timeout:
+				failed++
+				failReason += fmt.Sprintf("%d: %s;", task.GetPlanID(), task.GetFailReason())
+			case datapb.CompactionTaskState_completed, datapb.CompactionTaskState_cleaned:
+				finished++
+			}
+			startTime = min(startTime, task.GetStartTime())
+		}
+
+		job := &datapb.BackgroundJob{
+			Type:         metricsinfo.BackgroundJobCompaction,
+			JobID:        triggerID,
+			CollectionID: tasks[0].GetCollectionID(),
+			Progress:     int64((finished + failed) * 100 / len(tasks)),
+			StartTime:    time.Unix(startTime, 0).Format(time.DateTime),
+			Error:        failReason,
+			Detail: fmt.Sprintf("%s, %d tasks, %d pending, %d finished, %d failed",
+				tasks[0].GetType(), len(tasks), pending, finished, failed),
+		}
+		switch {
+		case pending == len(tasks):
+			job.State = metricsinfo.BackgroundJobPending
+		case finished+failed < len(tasks):
+			job.State = metricsinfo.BackgroundJobRunning
+		case failed > 0:
+			job.State = metricsinfo.BackgroundJobFailed
+		default:
+			job.State = metricsinfo.BackgroundJobCompleted
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
+
+// listIndexBuildJobs returns the index builds of the indexes, the progress is the percentage of the indexed rows.
+func (s *Server) listIndexBuildJobs(ctx context.Context, collectionID int64) []*datapb.BackgroundJob {
+	collectionIDs := lo.Map(s.meta.GetCollections(), func(collection *collectionInfo, _ int) int64 { return collection.ID })
+	if collectionID > 0 {
+		collectionIDs = []int64{collectionID}
+	}
+
+	var jobs []*datapb.BackgroundJob
+	for _, collectionID := range collectionIDs {
+		indexes := s.meta.indexMeta.GetIndexesForCollection(collectionID, "")
+		if len(indexes) == 0 {
+			continue
+		}
+		segments := s.selectSegmentIndexesStats(ctx, WithCollection(collectionID), SegmentFilterFunc(func(info *SegmentInfo) bool {
+			return info.GetLevel() != datapb.SegmentLevel_L0 && (isFlush(info) || info.GetState() == commonpb.SegmentState_Dropped)
+		}))
+		for _, index := range indexes {
+			indexInfo := &indexpb.IndexInfo{
+				CollectionID: collectionID,
+				IndexID:      index.IndexID,
+			}
+			s.completeIndexInfo(indexInfo, index, segments, false, index.CreateTime)
+
+			progress := int64(100)
+			if indexInfo.GetTotalRows() > 0 {
+				progress = indexInfo.GetIndexedRows() * 100 / indexInfo.GetTotalRows()
+			}
+			jobs = append(jobs, &datapb.BackgroundJob{
+				Type:         metricsinfo.BackgroundJobIndexBuild,
+				JobID:        index.IndexID,
+				CollectionID: collectionID,
+				State:        indexBuildJobState(indexInfo.GetState()),
+				Progress:     progress,
+				StartTime:    tsoutil.PhysicalTimeFormat(index.CreateTime),
+				Error:        indexInfo.GetIndexStateFailReason(),
+				Detail: fmt.Sprintf("index %s, %d of %d rows indexed, %s",
+					index.IndexName, indexInfo.GetIndexedRows(), indexInfo.GetTotalRows(), indexInfo.GetState()),
+			})
+		}
+	}
+	return jobs
+}
+
+func indexBuildJobState(state commonpb.IndexState) string {
+	switch state {
+	case commonpb.IndexState_Unissued:
+		return metricsinfo.BackgroundJobPending
+	case commonpb.IndexState_Finished:
+		return metricsinfo.BackgroundJobCompleted
+	case commonpb.IndexState_Failed:
+		return metricsinfo.BackgroundJobFailed
+	default:
+		return metricsinfo.BackgroundJobRunning
+	}
+}
+
+func importJobState(state internalpb.ImportJobState) string {
+	switch state {
+	case internalpb.ImportJobState_Pending:
+		return metricsinfo.BackgroundJobPending
+	case internalpb.ImportJobState_Completed:
+		return metricsinfo.BackgroundJobCompleted
+	case internalpb.ImportJobState_Failed:
+		return metricsinfo.BackgroundJobFailed
+	default:
+		return metricsinfo.BackgroundJobRunning
+	}
+}
@@ -0,0 +1,118 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	mockkv "github.com/milvus-io/milvus/internal/kv/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestServer_ListBackgroundJobs(t *testing.T) {
+	ctx := context.Background()
+	compactionTaskMeta := newTestCompactionTaskMeta(t)
+	for _, task := range []*datapb.CompactionTask{
+		{PlanID: 1, TriggerID: 1, CollectionID: 100, State: datapb.CompactionTaskState_executing},
+		{PlanID: 2, TriggerID: 1, CollectionID: 100, State: datapb.CompactionTaskState_completed},
+		{PlanID: 3, TriggerID: 2, CollectionID: 101, State: datapb.CompactionTaskState_failed, FailReason: "mock error"},
+	} {
+		task.Type = datapb.CompactionType_MixCompaction
+		task.StartTime = time.Now().Unix()
+		compactionTaskMeta.SaveCompactionTask(ctx, task)
+	}
+
+	indexMeta := newSegmentIndexMeta(&datacoord.Catalog{MetaKv: mockkv.NewMetaKv(t)})
+	indexMeta.indexes[100] = map[int64]*model.Index{
+		10: {CollectionID: 100, FieldID: 101, IndexID: 10, IndexName: "idx", CreateTime: 1000},
+	}
+	indexMeta.updateSegmentIndex(&model.SegmentIndex{
+		SegmentID: 1000, CollectionID: 100, IndexID: 10, BuildID: 1, NumRows: 100, IndexState: commonpb.IndexState_Finished,
+	})
+	segments := NewSegmentsInfo()
+	for _, id := range []int64{1000, 1001} {
+		segments.SetSegment(id, &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID: id, CollectionID: 100, NumOfRows: 100, State: commonpb.SegmentState_Flushed,
+		}})
+	}
+	collections := typeutil.NewConcurrentMap[int64, *collectionInfo]()
+	collections.Insert(100, &collectionInfo{ID: 100})
+	collections.Insert(101, &collectionInfo{ID: 101})
+
+	importMeta := NewMockImportMeta(t)
+	importMeta.EXPECT().GetJobBy(mock.Anything, mock.Anything).Return(nil).Maybe()
+	importMeta.EXPECT().GetJobBy(mock.Anything).Return(nil).Maybe()
+
+	gc := newGarbageCollector(nil, nil, GcOption{})
+	gc.recordRun("meta", time.Now(), metricsinfo.BackgroundJobRunning)
+
+	s := &Server{
+		meta: &meta{
+			collections:        collections,
+			segments:           segments,
+			compactionTaskMeta: compactionTaskMeta,
+			indexMeta:          indexMeta,
+		},
+		importMeta:       importMeta,
+		garbageCollector: gc,
+	}
+	list := func(req *datapb.ListBackgroundJobsRequest) map[string]*datapb.BackgroundJob {
+		resp, err := s.ListBackgroundJobs(ctx, req)
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		jobs := make(map[string]*datapb.BackgroundJob)
+		for _, job := range resp.GetJobs() {
+			jobs[job.GetType()] = job
+		}
+		return jobs
+	}
+
+	s.stateCode.Store(commonpb.StateCode_Initializing)
+	resp, err := s.ListBackgroundJobs(ctx, &datapb.ListBackgroundJobsRequest{})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+	jobs := list(&datapb.ListBackgroundJobsRequest{CollectionID: 100})
+	assert.Len(t, jobs, 2)
+	assert.EqualValues(t, 1, jobs[metricsinfo.BackgroundJobCompaction].GetJobID())
+	assert.Equal(t, metricsinfo.BackgroundJobRunning, jobs[metricsinfo.BackgroundJobCompaction].GetState())
+	assert.EqualValues(t, 50, jobs[metricsinfo.BackgroundJobCompaction].GetProgress())
+	assert.EqualValues(t, 10, jobs[metricsinfo.BackgroundJobIndexBuild].GetJobID())
+	assert.Equal(t, metricsinfo.BackgroundJobRunning, jobs[metricsinfo.BackgroundJobIndexBuild].GetState())
+	assert.EqualValues(t, 50, jobs[metricsinfo.BackgroundJobIndexBuild].GetProgress())
+
+	jobs = list(&datapb.ListBackgroundJobsRequest{State: metricsinfo.BackgroundJobFailed})
+	assert.Len(t, jobs, 1)
+	assert.EqualValues(t, 2, jobs[metricsinfo.BackgroundJobCompaction].GetJobID())
+	assert.EqualValues(t, 100, jobs[metricsinfo.BackgroundJobCompaction].GetProgress())
+	assert.Contains(t, jobs[metricsinfo.BackgroundJobCompaction].GetError(), "mock error")
+
+	jobs = list(&datapb.ListBackgroundJobsRequest{State: metricsinfo.BackgroundJobRunning})
+	assert.Len(t, jobs, 3)
+	assert.Equal(t, "meta", jobs[metricsinfo.BackgroundJobGC].GetDetail())
+}
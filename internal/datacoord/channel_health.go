@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+)
+
+// channelHealthRegistry keeps the unhealthy vchannels reported by the streaming nodes.
+// It's kept in memory only, the streaming node reports the health again after the flowgraph is restarted.
+// The zero value is ready to use.
+type channelHealthRegistry struct {
+	mu      sync.RWMutex
+	reports map[string]*datapb.ReportChannelHealthRequest
+}
+
+// Report updates the health of the vchannel, the healthy vchannel is removed from the registry.
+func (r *channelHealthRegistry) Report(req *datapb.ReportChannelHealthRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if req.GetState() == datapb.ChannelHealthState_ChannelHealthy {
+		delete(r.reports, req.GetVchannel())
+		return
+	}
+	if r.reports == nil {
+		r.reports = make(map[string]*datapb.ReportChannelHealthRequest)
+	}
+	r.reports[req.GetVchannel()] = req
+}
+
+// Remove removes the vchannel from the registry, it's called when the vchannel is dropped.
+func (r *channelHealthRegistry) Remove(vchannel string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.reports, vchannel)
+}
+
+// FillChannels fills the health of the channel metrics,
+// the unhealthy vchannels which are not reported by any node are appended.
+func (r *channelHealthRegistry) FillChannels(channels []*metricsinfo.Channel) []*metricsinfo.Channel {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	filled := make(map[string]struct{}, len(r.reports))
+	for _, channel := range channels {
+		if report, ok := r.reports[channel.Name]; ok {
+			fillChannelHealth(channel, report)
+			filled[channel.Name] = struct{}{}
+		}
+	}
+	for vchannel, report := range r.reports {
+		if _, ok := filled[vchannel]; ok {
+			continue
+		}
+		channel := &metricsinfo.Channel{
+			Name:   vchannel,
+			NodeID: report.GetBase().GetSourceID(),
+		}
+		fillChannelHealth(channel, report)
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+func fillChannelHealth(channel *metricsinfo.Channel, report *datapb.ReportChannelHealthRequest) {
+	channel.HealthState = report.GetState().String()
+	channel.HealthFailures = report.GetFailures()
+	channel.HealthReason = report.GetReason()
+}
@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+)
+
+func TestChannelHealthRegistry(t *testing.T) {
+	r := &channelHealthRegistry{}
+	channels := r.FillChannels([]*metricsinfo.Channel{{Name: "ch1"}})
+	assert.Len(t, channels, 1)
+	assert.Empty(t, channels[0].HealthState)
+
+	r.Report(&datapb.ReportChannelHealthRequest{
+		Base:     &commonpb.MsgBase{SourceID: 2},
+		Vchannel: "ch1",
+		State:    datapb.ChannelHealthState_ChannelDegraded,
+		Failures: 1,
+		Reason:   "flowgraph failed",
+	})
+	r.Report(&datapb.ReportChannelHealthRequest{
+		Base:     &commonpb.MsgBase{SourceID: 3},
+		Vchannel: "ch2",
+		State:    datapb.ChannelHealthState_ChannelQuarantined,
+		Failures: 4,
+	})
+	channels = r.FillChannels([]*metricsinfo.Channel{{Name: "ch1"}})
+	assert.Len(t, channels, 2)
+	assert.Equal(t, datapb.ChannelHealthState_ChannelDegraded.String(), channels[0].HealthState)
+	assert.Equal(t, int64(1), channels[0].HealthFailures)
+	assert.Equal(t, "flowgraph failed", channels[0].HealthReason)
+	assert.Equal(t, "ch2", channels[1].Name)
+	assert.Equal(t, int64(3), channels[1].NodeID)
+	assert.Equal(t, datapb.ChannelHealthState_ChannelQuarantined.String(), channels[1].HealthState)
+
+	// the healthy and dropped vchannels are removed.
+	r.Report(&datapb.ReportChannelHealthRequest{Vchannel: "ch1", State: datapb.ChannelHealthState_ChannelHealthy})
+	r.Remove("ch2")
+	channels = r.FillChannels([]*metricsinfo.Channel{{Name: "ch1"}})
+	assert.Len(t, channels, 1)
+	assert.Empty(t, channels[0].HealthState)
+}
+
+func TestServer_ReportChannelHealth(t *testing.T) {
+	s := &Server{}
+	s.stateCode.Store(commonpb.StateCode_Abnormal)
+	status, err := s.ReportChannelHealth(context.TODO(), &datapb.ReportChannelHealthRequest{Vchannel: "ch1"})
+	assert.NoError(t, err)
+	assert.Error(t, merr.Error(status))
+
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+	status, err = s.ReportChannelHealth(context.TODO(), &datapb.ReportChannelHealthRequest{
+		Vchannel: "ch1",
+		State:    datapb.ChannelHealthState_ChannelDegraded,
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, merr.Error(status))
+	channels := s.channelHealth.FillChannels(nil)
+	assert.Len(t, channels, 1)
+	assert.Equal(t, "ch1", channels[0].Name)
+}
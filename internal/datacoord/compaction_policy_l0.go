@@ -153,6 +153,7 @@ func (policy *l0CompactionPolicy) triggerOneCollection(ctx context.Context, coll
 		return isSegmentHealthy(segment) &&
 			isFlushed(segment) &&
 			!segment.isCompacting && // not compacting now
+			!segment.isQuarantined() && // not quarantined
 			!segment.GetIsImporting() && // not importing now
 			segment.GetLevel() == datapb.SegmentLevel_L0
 	}))
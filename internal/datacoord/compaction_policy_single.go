@@ -254,6 +254,7 @@ func (policy *singleCompactionPolicy) triggerOneCollection(ctx context.Context,
 		return isSegmentHealthy(segment) &&
 			isFlushed(segment) &&
 			!segment.isCompacting && // not compacting now
+			!segment.isQuarantined() && // not quarantined
 			!segment.GetIsImporting() && // not importing now
 			segment.GetLevel() == datapb.SegmentLevel_L2 && // only support L2 for now
 			!segment.GetIsInvisible()
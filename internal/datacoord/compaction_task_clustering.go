@@ -230,6 +230,11 @@ func (t *clusteringCompactionTask) DropTaskOnWorker(cluster session.Cluster) {
 	}
 }
 
+// GetTaskChannel returns the vchannel of the compaction task.
+func (t *clusteringCompactionTask) GetTaskChannel() string {
+	return t.GetTaskProto().GetChannel()
+}
+
 func (t *clusteringCompactionTask) GetTaskProto() *datapb.CompactionTask {
 	task := t.taskProto.Load()
 	if task == nil {
@@ -182,6 +182,11 @@ func (t *l0CompactionTask) DropTaskOnWorker(cluster session.Cluster) {
 	}
 }
 
+// GetTaskChannel returns the vchannel of the compaction task.
+func (t *l0CompactionTask) GetTaskChannel() string {
+	return t.GetTaskProto().GetChannel()
+}
+
 func (t *l0CompactionTask) GetTaskProto() *datapb.CompactionTask {
 	task := t.taskProto.Load()
 	if task == nil {
@@ -171,6 +171,8 @@ func (t *mixCompactionTask) QueryTaskOnWorker(cluster session.Cluster) {
 		}
 	case datapb.CompactionTaskState_failed:
 		log.Info("mixCompactionTask fail in datanode")
+		// the compaction may fail for the lost binlogs, let the quarantine detector validate the input segments
+		t.meta.SuspectSegments(t.GetTaskProto().GetInputSegments()...)
 		err := t.updateAndSaveTaskMeta(setState(datapb.CompactionTaskState_failed))
 		if err != nil {
 			log.Warn("fail to updateAndSaveTaskMeta")
@@ -194,6 +196,11 @@ func (t *mixCompactionTask) DropTaskOnWorker(cluster session.Cluster) {
 	}
 }
 
+// GetTaskChannel returns the vchannel of the compaction task.
+func (t *mixCompactionTask) GetTaskChannel() string {
+	return t.GetTaskProto().GetChannel()
+}
+
 func (t *mixCompactionTask) GetTaskProto() *datapb.CompactionTask {
 	task := t.taskProto.Load()
 	if task == nil {
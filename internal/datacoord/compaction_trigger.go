@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/lifetime"
 	"github.com/milvus-io/milvus/pkg/v2/util/logutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -50,6 +51,7 @@ type trigger interface {
 	start()
 	stop()
 	TriggerCompaction(ctx context.Context, signal *compactionSignal) (signalID UniqueID, err error)
+	InspectCandidates(ctx context.Context, collectionID int64) ([]*metricsinfo.SegmentCandidate, error)
 }
 
 type compactionSignal struct {
@@ -549,6 +551,7 @@ func (t *compactionTrigger) getCandidates(signal *compactionSignal) ([]chanPartS
 			return isSegmentHealthy(segment) &&
 				isFlushed(segment) &&
 				!segment.isCompacting && // not compacting now
+				!segment.isQuarantined() && // not quarantined
 				!segment.GetIsImporting() && // not importing now
 				segment.GetLevel() != datapb.SegmentLevel_L0 && // ignore level zero segments
 				segment.GetLevel() != datapb.SegmentLevel_L2 && // ignore l2 segment
@@ -628,50 +631,46 @@ func isExpandableSmallSegment(segment *SegmentInfo, expectedSize int64) bool {
 }
 
 func hasTooManyDeletions(segment *SegmentInfo) bool {
+	reasons := getTooManyDeletionsReasons(segment)
+	if len(reasons) > 0 {
+		log.Ctx(context.TODO()).Info("segment has too many deletions",
+			zap.Int64("segmentID", segment.ID),
+			zap.Int64("numRows", segment.GetNumOfRows()),
+			zap.Strings("reasons", reasons),
+		)
+	}
+	return len(reasons) > 0
+}
+
+// getTooManyDeletionsReasons returns the deltalog count, size, and deleted rowcount ratio thresholds exceeded by the segment.
+func getTooManyDeletionsReasons(segment *SegmentInfo) []string {
 	deltaLogCount := 0
-	totalDeletedRows := 0
+	totalDeletedRows := int64(0)
 	totalDeleteLogSize := int64(0)
 	for _, deltaLogs := range segment.GetDeltalogs() {
 		for _, l := range deltaLogs.GetBinlogs() {
-			totalDeletedRows += int(l.GetEntriesNum())
+			totalDeletedRows += l.GetEntriesNum()
 			totalDeleteLogSize += l.GetMemorySize()
 		}
 		deltaLogCount += len(deltaLogs.GetBinlogs())
 	}
 
+	var reasons []string
 	// Too many deltalog files, accumulates IO count.
-	if deltaLogCount > Params.DataCoordCfg.SingleCompactionDeltalogMaxNum.GetAsInt() {
-		log.Ctx(context.TODO()).Info("delta logs file count exceeds threshold",
-			zap.Int64("segmentID", segment.ID),
-			zap.Int("delta log count", deltaLogCount),
-			zap.Int("file number threshold", Params.DataCoordCfg.SingleCompactionDeltalogMaxNum.GetAsInt()),
-		)
-		return true
+	if maxNum := Params.DataCoordCfg.SingleCompactionDeltalogMaxNum.GetAsInt(); deltaLogCount > maxNum {
+		reasons = append(reasons, fmt.Sprintf("delta log count %d exceeds %d", deltaLogCount, maxNum))
 	}
 
 	// The proportion of deleted rows is too large, int64 PK tends to accumulates deleted row counts.
-	if float64(totalDeletedRows)/float64(segment.GetNumOfRows()) >= Params.DataCoordCfg.SingleCompactionRatioThreshold.GetAsFloat() {
-		log.Ctx(context.TODO()).Info("deleted entities rows proportion exceeds threshold",
-			zap.Int64("segmentID", segment.ID),
-			zap.Int64("number of rows", segment.GetNumOfRows()),
-			zap.Int("deleted rows", totalDeletedRows),
-			zap.Float64("proportion threshold", Params.DataCoordCfg.SingleCompactionRatioThreshold.GetAsFloat()),
-		)
-		return true
+	if ratio := Params.DataCoordCfg.SingleCompactionRatioThreshold.GetAsFloat(); float64(totalDeletedRows)/float64(segment.GetNumOfRows()) >= ratio {
+		reasons = append(reasons, fmt.Sprintf("deleted rows %d of %d reach the ratio %v", totalDeletedRows, segment.GetNumOfRows(), ratio))
 	}
 
 	// Delete size is too large, varchar PK tends to accumulates deltalog size.
-	if totalDeleteLogSize > Params.DataCoordCfg.SingleCompactionDeltaLogMaxSize.GetAsInt64() {
-		log.Ctx(context.TODO()).Info("total delete entries size exceeds threshold",
-			zap.Int64("segmentID", segment.ID),
-			zap.Int64("numRows", segment.GetNumOfRows()),
-			zap.Int64("delete entries size", totalDeleteLogSize),
-			zap.Int64("size threshold", Params.DataCoordCfg.SingleCompactionDeltaLogMaxSize.GetAsInt64()),
-		)
-		return true
+	if maxSize := Params.DataCoordCfg.SingleCompactionDeltaLogMaxSize.GetAsInt64(); totalDeleteLogSize > maxSize {
+		reasons = append(reasons, fmt.Sprintf("delta log size %d exceeds %d", totalDeleteLogSize, maxSize))
 	}
-
-	return false
+	return reasons
 }
 
 func (t *compactionTrigger) ShouldCompactExpiry(fromTs uint64, compactTime *compactTime, segment *SegmentInfo) bool {
@@ -697,12 +696,26 @@ func (t *compactionTrigger) ShouldCompactExpiry(fromTs uint64, compactTime *comp
 }
 
 func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compactTime *compactTime) bool {
+	reasons := t.getSingleCompactionReasons(segment, compactTime)
+	if len(reasons) > 0 {
+		log.Ctx(context.TODO()).Info("single compaction threshold is reached, trigger compaction",
+			zap.Int64("segmentID", segment.ID),
+			zap.Bool("createdByCompaction", segment.CreatedByCompaction),
+			zap.Int64s("compactionFrom", segment.CompactionFrom),
+			zap.Strings("reasons", reasons))
+	}
+	return len(reasons) > 0
+}
+
+// getSingleCompactionReasons returns the thresholds reached to compact the segment alone,
+// it's shared by the compaction trigger and the segment candidates inspection.
+func (t *compactionTrigger) getSingleCompactionReasons(segment *SegmentInfo, compactTime *compactTime) []string {
 	// no longer restricted binlog numbers because this is now related to field numbers
-	log := log.Ctx(context.TODO())
+	var reasons []string
 
 	// if expire time is enabled, put segment into compaction candidate
 	totalExpiredSize := int64(0)
-	totalExpiredRows := 0
+	totalExpiredRows := int64(0)
 	var earliestFromTs uint64 = math.MaxUint64
 	for _, binlogs := range segment.GetBinlogs() {
 		for _, l := range binlogs.GetBinlogs() {
@@ -713,34 +726,30 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compa
 					zap.Int64("binlogID", l.GetLogID()),
 					zap.Uint64("binlogTimestampTo", l.TimestampTo),
 					zap.Uint64("compactExpireTime", compactTime.expireTime))
-				totalExpiredRows += int(l.GetEntriesNum())
+				totalExpiredRows += l.GetEntriesNum()
 				totalExpiredSize += l.GetMemorySize()
 			}
 			earliestFromTs = min(earliestFromTs, l.TimestampFrom)
 		}
 	}
 	if t.ShouldCompactExpiry(earliestFromTs, compactTime, segment) {
-		return true
+		reasons = append(reasons, fmt.Sprintf("earliest data is expired beyond the tolerance %dh",
+			Params.DataCoordCfg.CompactionExpiryTolerance.GetAsInt()))
 	}
-
-	if float64(totalExpiredRows)/float64(segment.GetNumOfRows()) >= Params.DataCoordCfg.SingleCompactionRatioThreshold.GetAsFloat() ||
-		totalExpiredSize > Params.DataCoordCfg.SingleCompactionExpiredLogMaxSize.GetAsInt64() {
-		log.Info("total expired entities is too much, trigger compaction", zap.Int64("segmentID", segment.ID),
-			zap.Int("expiredRows", totalExpiredRows), zap.Int64("expiredLogSize", totalExpiredSize),
-			zap.Bool("createdByCompaction", segment.CreatedByCompaction), zap.Int64s("compactionFrom", segment.CompactionFrom))
-		return true
+	if ratio := Params.DataCoordCfg.SingleCompactionRatioThreshold.GetAsFloat(); float64(totalExpiredRows)/float64(segment.GetNumOfRows()) >= ratio {
+		reasons = append(reasons, fmt.Sprintf("expired rows %d of %d reach the ratio %v", totalExpiredRows, segment.GetNumOfRows(), ratio))
+	}
+	if maxSize := Params.DataCoordCfg.SingleCompactionExpiredLogMaxSize.GetAsInt64(); totalExpiredSize > maxSize {
+		reasons = append(reasons, fmt.Sprintf("expired log size %d exceeds %d", totalExpiredSize, maxSize))
 	}
 
 	// check if deltalog count, size, and deleted rowcount ratio exceeds threshold
-	if hasTooManyDeletions(segment) {
-		return true
-	}
+	reasons = append(reasons, getTooManyDeletionsReasons(segment)...)
 
 	if t.ShouldRebuildSegmentIndex(segment) {
-		return true
+		reasons = append(reasons, "index version is older than the current engine version")
 	}
-
-	return false
+	return reasons
 }
 
 func (t *compactionTrigger) ShouldRebuildSegmentIndex(segment *SegmentInfo) bool {
@@ -2425,6 +2425,68 @@ func (s *CompactionTriggerSuite) TestHandleSignal() {
 	})
 }
 
+func (s *CompactionTriggerSuite) TestInspectCandidates() {
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.IndexBasedCompaction.Key, "false")
+	defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.IndexBasedCompaction.Key)
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:  s.vecFieldID,
+				DataType: schemapb.DataType_FloatVector,
+			},
+		},
+	}
+
+	s.Run("auto_compaction_disabled", func() {
+		defer s.SetupTest()
+		s.inspector.EXPECT().isFull().Return(false)
+		s.handler.EXPECT().GetCollection(mock.Anything, s.collectionID).Return(&collectionInfo{
+			ID:         s.collectionID,
+			Properties: map[string]string{common.CollectionAutoCompactionKey: "false"},
+			Schema:     schema,
+		}, nil)
+
+		candidates, err := s.tr.InspectCandidates(context.TODO(), s.collectionID)
+		s.NoError(err)
+		s.Len(candidates, 6)
+		for _, candidate := range candidates {
+			s.False(candidate.Selected)
+			s.Contains(candidate.Reasons, "auto compaction is disabled for the collection")
+		}
+	})
+
+	s.Run("small_segments_merged", func() {
+		defer s.SetupTest()
+		s.meta.segments.segments[6].IsSorted = false
+		s.inspector.EXPECT().isFull().Return(false)
+		s.handler.EXPECT().GetCollection(mock.Anything, s.collectionID).Return(&collectionInfo{
+			ID:     s.collectionID,
+			Schema: schema,
+		}, nil)
+
+		candidates, err := s.tr.InspectCandidates(context.TODO(), s.collectionID)
+		s.NoError(err)
+		s.Len(candidates, 6)
+		for _, candidate := range candidates {
+			s.NotEmpty(candidate.Reasons)
+			if candidate.SegmentID == 6 {
+				s.False(candidate.Selected)
+				s.Equal([]string{"segment is not sorted yet"}, candidate.Reasons)
+			} else {
+				s.True(candidate.Selected)
+			}
+		}
+	})
+
+	s.Run("collection_not_found", func() {
+		defer s.SetupTest()
+		s.handler.EXPECT().GetCollection(mock.Anything, s.collectionID).Return(nil, errors.New("mocked"))
+		_, err := s.tr.InspectCandidates(context.TODO(), s.collectionID)
+		s.Error(err)
+	})
+}
+
 func (s *CompactionTriggerSuite) TestHandleGlobalSignal() {
 	schema := &schemapb.CollectionSchema{
 		Fields: []*schemapb.FieldSchema{
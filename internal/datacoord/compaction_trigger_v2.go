@@ -26,9 +26,11 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/datacoord/allocator"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/logutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
@@ -649,7 +651,27 @@ func (m *CompactionTriggerManager) SubmitSingleViewToScheduler(ctx context.Conte
 	)
 }
 
+// getAdaptiveSegmentSize returns the segment max size in bytes set by the collection property or
+// derived from the index types, ok is false if neither is set.
+func getAdaptiveSegmentSize(meta *meta, collectionID int64, schema *schemapb.CollectionSchema) (int64, bool) {
+	if collection := meta.GetCollection(collectionID); collection != nil {
+		size, err := common.GetCollectionSegmentMaxSize(funcutil.Map2KeyValuePair(collection.Properties))
+		if err != nil {
+			log.Warn("invalid segment max size of collection, ignore it", zap.Int64("collectionID", collectionID), zap.Error(err))
+		} else if size > 0 {
+			return size * 1024 * 1024, true
+		}
+	}
+	if size, ok := meta.indexMeta.GetSegmentMaxSizeByIndexType(collectionID, schema); ok {
+		return size * 1024 * 1024, true
+	}
+	return 0, false
+}
+
 func getExpectedSegmentSize(meta *meta, collectionID int64, schema *schemapb.CollectionSchema) int64 {
+	if size, ok := getAdaptiveSegmentSize(meta, collectionID, schema); ok {
+		return size
+	}
 	allDiskIndex := meta.indexMeta.AllDenseWithDiskIndex(collectionID, schema)
 	if allDiskIndex {
 		// Only if all dense vector fields index type are DiskANN, recalc segment max size here.
@@ -22,6 +22,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 func TestCompactionTriggerManagerSuite(t *testing.T) {
@@ -168,6 +169,7 @@ func (s *CompactionTriggerManagerSuite) TestGetExpectedSegmentSize() {
 	defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.DiskSegmentMaxSize.Key)
 
 	s.triggerManager.meta = &meta{
+		collections: typeutil.NewConcurrentMap[UniqueID, *collectionInfo](),
 		indexMeta: &indexMeta{
 			indexes: map[UniqueID]map[UniqueID]*model.Index{
 				collectionID: {
@@ -225,6 +227,7 @@ func (s *CompactionTriggerManagerSuite) TestGetExpectedSegmentSize() {
 
 	s.Run("HNSW & DISKANN", func() {
 		s.triggerManager.meta = &meta{
+			collections: typeutil.NewConcurrentMap[UniqueID, *collectionInfo](),
 			indexMeta: &indexMeta{
 				indexes: map[UniqueID]map[UniqueID]*model.Index{
 					collectionID: {
@@ -280,6 +283,7 @@ func (s *CompactionTriggerManagerSuite) TestGetExpectedSegmentSize() {
 
 	s.Run("some vector has no index", func() {
 		s.triggerManager.meta = &meta{
+			collections: typeutil.NewConcurrentMap[UniqueID, *collectionInfo](),
 			indexMeta: &indexMeta{
 				indexes: map[UniqueID]map[UniqueID]*model.Index{
 					collectionID: {
@@ -318,6 +322,59 @@ func (s *CompactionTriggerManagerSuite) TestGetExpectedSegmentSize() {
 
 		s.Equal(int64(100*1024*1024), getExpectedSegmentSize(s.triggerManager.meta, collection.ID, collection.Schema))
 	})
+
+	s.Run("size by index type", func() {
+		paramtable.Get().Save(paramtable.Get().DataCoordCfg.SegmentMaxSizeByIndexType.Key, `{"hnsw": 50}`)
+		defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.SegmentMaxSizeByIndexType.Key)
+
+		collection := &collectionInfo{
+			ID: collectionID,
+			Schema: &schemapb.CollectionSchema{
+				Name: "coll1",
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: fieldID, Name: "field0", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+					{FieldID: fieldID + 1, Name: "field1", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}},
+				},
+			},
+		}
+		s.Equal(int64(50*1024*1024), getExpectedSegmentSize(s.triggerManager.meta, collection.ID, collection.Schema))
+
+		// the field without index falls back to dataCoord.segment.maxSize
+		collection.Schema.Fields = append(collection.Schema.Fields,
+			&schemapb.FieldSchema{FieldID: fieldID + 2, Name: "field2", DataType: schemapb.DataType_Float16Vector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}})
+		s.Equal(int64(50*1024*1024), getExpectedSegmentSize(s.triggerManager.meta, collection.ID, collection.Schema))
+
+		paramtable.Get().Save(paramtable.Get().DataCoordCfg.SegmentMaxSizeByIndexType.Key, `{"HNSW": 500}`)
+		s.Equal(int64(100*1024*1024), getExpectedSegmentSize(s.triggerManager.meta, collection.ID, collection.Schema))
+
+		// not configured index type
+		paramtable.Get().Save(paramtable.Get().DataCoordCfg.SegmentMaxSizeByIndexType.Key, `{"IVF_FLAT": 50}`)
+		s.Equal(int64(100*1024*1024), getExpectedSegmentSize(s.triggerManager.meta, collection.ID, collection.Schema))
+	})
+
+	s.Run("collection property", func() {
+		paramtable.Get().Save(paramtable.Get().DataCoordCfg.SegmentMaxSizeByIndexType.Key, `{"HNSW": 50}`)
+		defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.SegmentMaxSizeByIndexType.Key)
+
+		collection := &collectionInfo{
+			ID: collectionID,
+			Schema: &schemapb.CollectionSchema{
+				Name: "coll1",
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: fieldID, Name: "field0", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+					{FieldID: fieldID + 1, Name: "field1", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}},
+				},
+			},
+			Properties: map[string]string{common.CollectionSegmentMaxSizeKey: "300"},
+		}
+		s.triggerManager.meta.collections.Insert(collectionID, collection)
+		defer s.triggerManager.meta.collections.Remove(collectionID)
+		s.Equal(int64(300*1024*1024), getExpectedSegmentSize(s.triggerManager.meta, collection.ID, collection.Schema))
+
+		// invalid property is ignored
+		collection.Properties[common.CollectionSegmentMaxSizeKey] = "-1"
+		s.Equal(int64(50*1024*1024), getExpectedSegmentSize(s.triggerManager.meta, collection.ID, collection.Schema))
+	})
 }
 
 func TestCompactionAndImport(t *testing.T) {
@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const flushAndSealCheckInterval = 200 * time.Millisecond
+
+// flushAndSeal seals all the growing segments of the collection and waits for them to be flushed.
+// All the data before the returned barrier ts is persisted in the returned flushed segments.
+func (s *Server) flushAndSeal(ctx context.Context, collectionID int64) (*datapb.FlushAndSealResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().DataCoordCfg.FlushAndSealTimeout.GetAsDuration(time.Second))
+	defer cancel()
+
+	coll, err := s.handler.GetCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if coll == nil {
+		return nil, merr.WrapErrCollectionNotFound(collectionID)
+	}
+
+	barrierTs, err := s.allocator.AllocTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sealedSegmentIDs []int64
+	if streamingutil.IsStreamingServiceEnabled() {
+		// the growing segments are managed by the streamingnode
+		for _, vchannel := range coll.VChannelNames {
+			segmentIDs, err := streaming.ManualFlush(ctx, collectionID, vchannel, barrierTs)
+			if err != nil {
+				return nil, err
+			}
+			sealedSegmentIDs = append(sealedSegmentIDs, segmentIDs...)
+		}
+	}
+	flushResult, err := s.flushCollection(ctx, collectionID, barrierTs, nil)
+	if err != nil {
+		return nil, err
+	}
+	sealedSegmentIDs = append(sealedSegmentIDs, flushResult.GetSegmentIDs()...)
+
+	if err := s.waitForFlushed(ctx, barrierTs, coll.VChannelNames, sealedSegmentIDs); err != nil {
+		log.Warn("failed to wait for the sealed segments flushed", zap.Int64s("segmentIDs", sealedSegmentIDs), zap.Error(err))
+		return nil, err
+	}
+
+	segments := s.meta.SelectSegments(ctx, WithCollection(collectionID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) &&
+			isFlushState(segment.GetState()) &&
+			!segment.GetIsImporting() &&
+			segment.GetStartPosition().GetTimestamp() <= barrierTs
+	}))
+	barrier := &datapb.FlushAndSealResponse{
+		Status:       merr.Success(),
+		CollectionID: collectionID,
+		BarrierTs:    barrierTs,
+		SegmentIDs:   make([]int64, 0, len(segments)),
+	}
+	for _, segment := range segments {
+		barrier.SegmentIDs = append(barrier.SegmentIDs, segment.GetID())
+	}
+	sort.Slice(barrier.SegmentIDs, func(i, j int) bool { return barrier.SegmentIDs[i] < barrier.SegmentIDs[j] })
+	log.Info("flush and seal done", zap.Uint64("barrierTs", barrierTs), zap.Int("segmentNum", len(barrier.SegmentIDs)))
+	return barrier, nil
+}
+
+// waitForFlushed waits until the sealed segments are flushed and the checkpoints of the channels reach the flush ts.
+func (s *Server) waitForFlushed(ctx context.Context, flushTs uint64, channels []string, segmentIDs []int64) error {
+	ticker := time.NewTicker(flushAndSealCheckInterval)
+	defer ticker.Stop()
+	for !s.isFlushedBefore(ctx, flushTs, channels, segmentIDs) {
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "wait for segments flushed")
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+func (s *Server) isFlushedBefore(ctx context.Context, flushTs uint64, channels []string, segmentIDs []int64) bool {
+	for _, segmentID := range segmentIDs {
+		// segment is nil if it was compacted, or it's an empty segment and is set to dropped
+		if segment := s.meta.GetHealthySegment(ctx, segmentID); segment != nil && !isFlushState(segment.GetState()) {
+			return false
+		}
+	}
+	for _, channel := range channels {
+		if s.meta.GetChannelCheckpoint(channel).GetTimestamp() < flushTs {
+			return false
+		}
+	}
+	return true
+}
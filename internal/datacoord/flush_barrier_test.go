@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+func TestServer_flushAndSeal(t *testing.T) {
+	ctx := context.Background()
+	meta, err := newMemoryMeta(t)
+	require.NoError(t, err)
+	meta.AddCollection(&collectionInfo{ID: 1, VChannelNames: []string{"ch1"}})
+
+	segmentManager := NewMockManager(t)
+	segmentManager.EXPECT().SealAllSegments(mock.Anything, "ch1", mock.Anything).Return([]int64{10}, nil)
+	s := &Server{
+		meta:           meta,
+		allocator:      newMockAllocator(t),
+		handler:        newMockHandlerWithMeta(meta),
+		segmentManager: segmentManager,
+	}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	addSegment := func(id int64, state commonpb.SegmentState) {
+		err := meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  1,
+			PartitionID:   2,
+			InsertChannel: "ch1",
+			State:         state,
+			NumOfRows:     100,
+			StartPosition: &msgpb.MsgPosition{Timestamp: 0},
+		}))
+		require.NoError(t, err)
+	}
+	addSegment(10, commonpb.SegmentState_Sealed)
+	addSegment(11, commonpb.SegmentState_Flushed)
+	addSegment(12, commonpb.SegmentState_Dropped)
+
+	t.Run("invalid request", func(t *testing.T) {
+		resp, err := s.FlushAndSeal(ctx, &datapb.FlushAndSealRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	})
+
+	t.Run("normal", func(t *testing.T) {
+		go func() {
+			time.Sleep(500 * time.Millisecond)
+			assert.NoError(t, meta.SetState(ctx, 10, commonpb.SegmentState_Flushed))
+			assert.NoError(t, meta.UpdateChannelCheckpoint(ctx, "ch1", &msgpb.MsgPosition{
+				ChannelName: "ch1",
+				MsgID:       []byte{1},
+				Timestamp:   1000,
+			}))
+		}()
+
+		resp, err := s.FlushAndSeal(ctx, &datapb.FlushAndSealRequest{CollectionID: 1})
+		require.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.EqualValues(t, 1, resp.GetCollectionID())
+		assert.NotZero(t, resp.GetBarrierTs())
+		assert.Equal(t, []int64{10, 11}, resp.GetSegmentIDs())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		addSegment(13, commonpb.SegmentState_Growing)
+		segmentManager.ExpectedCalls = nil
+		segmentManager.EXPECT().SealAllSegments(mock.Anything, "ch1", mock.Anything).Return([]int64{13}, nil)
+
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		_, err := s.flushAndSeal(ctx, 1)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("not healthy", func(t *testing.T) {
+		s.stateCode.Store(commonpb.StateCode_Abnormal)
+		defer s.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := s.FlushAndSeal(ctx, &datapb.FlushAndSealRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+}
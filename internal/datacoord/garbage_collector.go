@@ -42,10 +42,15 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/hardware"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
+// maxReportedOrphanIndexFiles is the max number of the orphan index files listed in the index gc report.
+const maxReportedOrphanIndexFiles = 1000
+
 // GcOption garbage collection options
 type GcOption struct {
 	cli              storage.ChunkManager // client
@@ -57,6 +62,7 @@ type GcOption struct {
 
 	broker           broker.Broker
 	removeObjectPool *conc.Pool[struct{}]
+	segReferManager  *segmentReferenceManager // reference locks pinning the dropped segments, optional
 }
 
 // garbageCollector handles garbage files in object storage
@@ -76,6 +82,12 @@ type garbageCollector struct {
 	pauseUntil atomic.Time
 
 	systemMetricsListener *hardware.SystemMetricsListener
+
+	indexGCReportMu sync.Mutex
+	indexGCReport   metricsinfo.IndexGCReport
+
+	// runs records the current or last run of each recycle loop, keyed by the loop name
+	runs *typeutil.ConcurrentMap[string, *datapb.BackgroundJob]
 }
 
 type gcCmd struct {
@@ -128,6 +140,7 @@ func newGarbageCollector(meta *meta, handler Handler, opt GcOption) *garbageColl
 		option:                opt,
 		cmdCh:                 make(chan gcCmd),
 		systemMetricsListener: newSystemMetricsListener(&opt),
+		runs:                  typeutil.NewConcurrentMap[string, *datapb.BackgroundJob](),
 	}
 }
 
@@ -283,12 +296,38 @@ func (gc *garbageCollector) runRecycleTaskWithPauser(ctx context.Context, name s
 			}
 			logger.Info("garbage collector recycle task start...")
 			start := time.Now()
+			gc.recordRun(name, start, metricsinfo.BackgroundJobRunning)
 			task(ctx)
+			gc.recordRun(name, start, metricsinfo.BackgroundJobCompleted)
 			logger.Info("garbage collector recycle task done", zap.Duration("timeCost", time.Since(start)))
 		}
 	}
 }
 
+func (gc *garbageCollector) recordRun(name string, start time.Time, state string) {
+	progress := int64(0)
+	if state == metricsinfo.BackgroundJobCompleted {
+		progress = 100
+	}
+	gc.runs.Insert(name, &datapb.BackgroundJob{
+		Type:      metricsinfo.BackgroundJobGC,
+		State:     state,
+		Progress:  progress,
+		StartTime: typeutil.TimestampToString(uint64(start.UnixMilli())),
+		Detail:    name,
+	})
+}
+
+// GetRuns returns the current or last run of the recycle loops.
+func (gc *garbageCollector) GetRuns() []*datapb.BackgroundJob {
+	ret := make([]*datapb.BackgroundJob, 0, gc.runs.Len())
+	gc.runs.Range(func(_ string, run *datapb.BackgroundJob) bool {
+		ret = append(ret, run)
+		return true
+	})
+	return ret
+}
+
 // close stop the garbage collector.
 func (gc *garbageCollector) close() {
 	gc.stopOnce.Do(func() {
@@ -439,10 +478,26 @@ func (gc *garbageCollector) checkDroppedSegmentGC(segment *SegmentInfo,
 	indexSet typeutil.UniqueSet,
 	cpTimestamp Timestamp,
 ) bool {
+	return gc.getDroppedSegmentGCBlocker(segment, childSegment, indexSet, cpTimestamp) == ""
+}
+
+// getDroppedSegmentGCBlocker returns the reason why the dropped segment can't be recycled yet,
+// empty means the segment is ready to be recycled.
+func (gc *garbageCollector) getDroppedSegmentGCBlocker(segment *SegmentInfo,
+	childSegment *SegmentInfo,
+	indexSet typeutil.UniqueSet,
+	cpTimestamp Timestamp,
+) string {
 	log := log.With(zap.Int64("segmentID", segment.ID))
 
 	if !gc.isExpire(segment.GetDroppedAt()) {
-		return false
+		return fmt.Sprintf("dropped at %s, within the drop tolerance %s",
+			time.Unix(0, int64(segment.GetDroppedAt())).Format(time.RFC3339), gc.option.dropTolerance)
+	}
+	if gc.option.segReferManager != nil && gc.option.segReferManager.HasSegmentLock(segment.GetID()) {
+		log.WithRateGroup("GC_FAIL_SEGMENT_REFERRED", 1, 60).
+			RatedInfo(60, "skipping GC when segment is referred by lock")
+		return "referred by segment lock"
 	}
 	isCompacted := childSegment != nil || segment.GetCompacted()
 	if isCompacted {
@@ -453,7 +508,7 @@ func (gc *garbageCollector) checkDroppedSegmentGC(segment *SegmentInfo,
 			log.WithRateGroup("GC_FAIL_COMPACT_TO_NOT_INDEXED", 1, 60).
 				RatedInfo(60, "skipping GC when compact target segment is not indexed",
 					zap.Int64("child segment ID", childSegment.GetID()))
-			return false
+			return fmt.Sprintf("compacted to segment %d which is not indexed yet", childSegment.GetID())
 		}
 	}
 
@@ -468,9 +523,38 @@ func (gc *garbageCollector) checkDroppedSegmentGC(segment *SegmentInfo,
 				zap.Uint64("dmlPosTs", segment.GetDmlPosition().GetTimestamp()),
 				zap.Uint64("channelCpTs", cpTimestamp),
 			)
-		return false
+		return fmt.Sprintf("dml position %d is after channel checkpoint %d",
+			segment.GetDmlPosition().GetTimestamp(), cpTimestamp)
 	}
-	return true
+	return ""
+}
+
+// getTimeTravelWatermark returns the oldest timestamp pinned by the running search and query requests,
+// zero means the dropped segments are not protected by the running requests.
+// If the watermark is unavailable, the dropped segments are only retained by dataCoord.gc.dropTolerance.
+func (gc *garbageCollector) getTimeTravelWatermark(ctx context.Context) uint64 {
+	if !paramtable.Get().DataCoordCfg.GCRespectTimeTravelWatermark.GetAsBool() {
+		return 0
+	}
+	watermark, err := gc.handler.GetTimeTravelWatermark(ctx)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to get time travel watermark, fall back to the drop tolerance", zap.Error(err))
+		return 0
+	}
+	return watermark
+}
+
+// getTimeTravelGCBlocker returns the reason if the dropped segment may still be read by the running requests,
+// which pin a timestamp before the segment is dropped.
+func getTimeTravelGCBlocker(segment *SegmentInfo, watermark uint64) string {
+	if watermark == 0 {
+		return ""
+	}
+	physical := tsoutil.PhysicalTime(watermark)
+	if time.Unix(0, int64(segment.GetDroppedAt())).Before(physical) {
+		return ""
+	}
+	return fmt.Sprintf("may be read by the running requests at time travel watermark %s", physical.Format(time.RFC3339))
 }
 
 // recycleDroppedSegments scans all segments and remove those dropped segments from meta and oss.
@@ -528,6 +612,12 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 		loadedSegments.Insert(segmentID)
 	}
 
+	watermark := gc.getTimeTravelWatermark(ctx)
+
+	if gc.option.segReferManager != nil {
+		gc.option.segReferManager.removeExpiredLocks()
+	}
+
 	log.Info("start to GC segments", zap.Int("drop_num", len(drops)))
 	for segmentID, segment := range drops {
 		if ctx.Err() != nil {
@@ -544,6 +634,10 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 		if !gc.checkDroppedSegmentGC(segment, compactTo[segment.GetID()], indexedSet, channelCPs[segInsertChannel]) {
 			continue
 		}
+		if reason := getTimeTravelGCBlocker(segment, watermark); reason != "" {
+			log.Info("skip GC segment since it "+reason, zap.Uint64("watermark", watermark))
+			continue
+		}
 
 		cloned := segment.Clone()
 		binlog.DecompressBinLogs(cloned.SegmentInfo)
@@ -563,6 +657,12 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 			zap.Int("bm25_logs", len(cloned.GetBm25Statslogs())),
 			zap.Int("text_logs", len(cloned.GetTextStatsLogs())),
 			zap.Int("json_key_logs", len(cloned.GetJsonKeyStats())))
+		// the segment may be restored by the repair after it's selected, so it's fenced before any log is removed.
+		if !gc.meta.FenceDroppedSegmentGC(segmentID) {
+			log.Info("skip GC segment since it is not dropped anymore")
+			cloned = nil
+			continue
+		}
 		if err := gc.removeObjectFiles(ctx, logs); err != nil {
 			log.Warn("GC segment remove logs failed", zap.Error(err))
 			cloned = nil
@@ -747,6 +847,10 @@ func (gc *garbageCollector) recycleUnusedSegIndexes(ctx context.Context) {
 	log.Info("start recycleUnusedSegIndexes...")
 	defer func() { log.Info("recycleUnusedSegIndexes done", zap.Duration("timeCost", time.Since(start))) }()
 
+	dryRun := paramtable.Get().DataCoordCfg.GCIndexDryRun.GetAsBool()
+	items := make([]*metricsinfo.IndexGCSegmentItem, 0)
+	defer func() { gc.reportSegmentIndexGC(dryRun, items) }()
+
 	segIndexes := gc.meta.indexMeta.GetAllSegIndexes()
 	for _, segIdx := range segIndexes {
 		if ctx.Err() != nil {
@@ -754,41 +858,75 @@ func (gc *garbageCollector) recycleUnusedSegIndexes(ctx context.Context) {
 			return
 		}
 
-		// 1. segment belongs to is deleted.
-		// 2. index is deleted.
-		if gc.meta.GetSegment(ctx, segIdx.SegmentID) == nil || !gc.meta.indexMeta.IsIndexExist(segIdx.CollectionID, segIdx.IndexID) {
-			indexFiles := gc.getAllIndexFilesOfIndex(segIdx)
-			log := log.With(zap.Int64("collectionID", segIdx.CollectionID),
-				zap.Int64("partitionID", segIdx.PartitionID),
-				zap.Int64("segmentID", segIdx.SegmentID),
-				zap.Int64("indexID", segIdx.IndexID),
-				zap.Int64("buildID", segIdx.BuildID),
-				zap.Int64("nodeID", segIdx.NodeID),
-				zap.Int("indexFiles", len(indexFiles)))
-			log.Info("GC Segment Index file start...")
-
-			// Remove index files first.
-			if err := gc.removeObjectFiles(ctx, indexFiles); err != nil {
-				log.Warn("fail to remove index files for index", zap.Error(err))
-				continue
-			}
+		reason := gc.getUnusedSegIndexReason(ctx, segIdx)
+		if reason == "" {
+			continue
+		}
+		indexFiles := gc.getAllIndexFilesOfIndex(segIdx)
+		log := log.With(zap.Int64("collectionID", segIdx.CollectionID),
+			zap.Int64("partitionID", segIdx.PartitionID),
+			zap.Int64("segmentID", segIdx.SegmentID),
+			zap.Int64("indexID", segIdx.IndexID),
+			zap.Int64("buildID", segIdx.BuildID),
+			zap.Int64("nodeID", segIdx.NodeID),
+			zap.Int("indexFiles", len(indexFiles)),
+			zap.String("reason", reason))
+		item := &metricsinfo.IndexGCSegmentItem{
+			CollectionID: segIdx.CollectionID,
+			SegmentID:    segIdx.SegmentID,
+			IndexID:      segIdx.IndexID,
+			BuildID:      segIdx.BuildID,
+			State:        segIdx.IndexState.String(),
+			Reason:       reason,
+		}
+		if dryRun {
+			log.Info("GC Segment Index skipped in dry run mode")
+			items = append(items, item)
+			continue
+		}
+		log.Info("GC Segment Index file start...")
 
-			// Remove meta from index meta.
-			if err := gc.meta.indexMeta.RemoveSegmentIndex(ctx, segIdx.BuildID); err != nil {
-				log.Warn("delete index meta from etcd failed, wait to retry", zap.Error(err))
-				continue
-			}
-			log.Info("index meta recycle success")
+		// Remove index files first.
+		if err := gc.removeObjectFiles(ctx, indexFiles); err != nil {
+			log.Warn("fail to remove index files for index", zap.Error(err))
+			continue
 		}
+
+		// Remove meta from index meta.
+		if err := gc.meta.indexMeta.RemoveSegmentIndex(ctx, segIdx.BuildID); err != nil {
+			log.Warn("delete index meta from etcd failed, wait to retry", zap.Error(err))
+			continue
+		}
+		items = append(items, item)
+		log.Info("index meta recycle success")
 	}
 }
 
+// getUnusedSegIndexReason returns the reason why the segment index could be recycled, empty if it's still in use.
+func (gc *garbageCollector) getUnusedSegIndexReason(ctx context.Context, segIdx *model.SegmentIndex) string {
+	segment := gc.meta.GetSegment(ctx, segIdx.SegmentID)
+	switch {
+	case segment == nil:
+		return "segment not exist"
+	case !gc.meta.indexMeta.IsIndexExist(segIdx.CollectionID, segIdx.IndexID):
+		return "index dropped"
+	case segIdx.IndexState == commonpb.IndexState_Failed && !isSegmentHealthy(segment):
+		// the failed build will never be retried since the segment is dropped
+		return "build failed on dropped segment"
+	}
+	return ""
+}
+
 // recycleUnusedIndexFiles is used to delete those index files that no longer exist in the meta.
 func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 	start := time.Now()
 	log := log.Ctx(ctx).With(zap.String("gcName", "recycleUnusedIndexFiles"), zap.Time("startAt", start))
 	log.Info("start recycleUnusedIndexFiles...")
 
+	gracePeriod := paramtable.Get().DataCoordCfg.GCIndexOrphanGracePeriod.GetAsDuration(time.Second)
+	dryRun := paramtable.Get().DataCoordCfg.GCIndexDryRun.GetAsBool()
+	report := &metricsinfo.IndexGCReport{DryRun: dryRun}
+
 	prefix := path.Join(gc.option.cli.RootPath(), common.SegmentIndexPath) + "/"
 	// list dir first
 	keyCount := 0
@@ -811,18 +949,13 @@ func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 			logger.Info("garbageCollector can not recycle index files")
 			return true
 		}
+		var filesMap map[string]struct{}
 		if segIdx == nil {
-			// buildID no longer exists in meta, remove all index files
+			// buildID no longer exists in meta, all index files are orphans
 			logger.Info("garbageCollector recycleUnusedIndexFiles find meta has not exist, remove index files")
-			err = gc.option.cli.RemoveWithPrefix(ctx, key)
-			if err != nil {
-				logger.Warn("garbageCollector recycleUnusedIndexFiles remove index files failed", zap.Error(err))
-				return true
-			}
-			logger.Info("garbageCollector recycleUnusedIndexFiles remove index files success")
-			return true
+		} else {
+			filesMap = gc.getAllIndexFilesOfIndex(segIdx)
 		}
-		filesMap := gc.getAllIndexFilesOfIndex(segIdx)
 
 		logger.Info("recycle index files", zap.Int("meta files num", len(filesMap)))
 		deletedFilesNum := atomic.NewInt32(0)
@@ -832,21 +965,34 @@ func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 		err = gc.option.cli.WalkWithPrefix(ctx, key, true, func(indexFile *storage.ChunkObjectInfo) bool {
 			fileNum++
 			file := indexFile.FilePath
-			if _, ok := filesMap[file]; !ok {
-				future := gc.option.removeObjectPool.Submit(func() (struct{}, error) {
-					logger := logger.With(zap.String("file", file))
-					logger.Info("garbageCollector recycleUnusedIndexFiles remove file...")
-
-					if err := gc.option.cli.Remove(ctx, file); err != nil {
-						logger.Warn("garbageCollector recycleUnusedIndexFiles remove file failed", zap.Error(err))
-						return struct{}{}, err
-					}
-					deletedFilesNum.Inc()
-					logger.Info("garbageCollector recycleUnusedIndexFiles remove file success")
-					return struct{}{}, nil
-				})
-				futures = append(futures, future)
+			if _, ok := filesMap[file]; ok {
+				return true
+			}
+			if time.Since(indexFile.ModifyTime) < gracePeriod {
+				// the file may be written by an in-flight build, wait for the grace period
+				report.SkippedFileNum++
+				return true
+			}
+			report.OrphanFileNum++
+			if len(report.OrphanFiles) < maxReportedOrphanIndexFiles {
+				report.OrphanFiles = append(report.OrphanFiles, file)
+			}
+			if dryRun {
+				return true
 			}
+			future := gc.option.removeObjectPool.Submit(func() (struct{}, error) {
+				logger := logger.With(zap.String("file", file))
+				logger.Info("garbageCollector recycleUnusedIndexFiles remove file...")
+
+				if err := gc.option.cli.Remove(ctx, file); err != nil {
+					logger.Warn("garbageCollector recycleUnusedIndexFiles remove file failed", zap.Error(err))
+					return struct{}{}, err
+				}
+				deletedFilesNum.Inc()
+				logger.Info("garbageCollector recycleUnusedIndexFiles remove file success")
+				return struct{}{}, nil
+			})
+			futures = append(futures, future)
 			return true
 		})
 		// Wait for all remove tasks done.
@@ -868,7 +1014,35 @@ func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 		log.Warn("garbageCollector recycleUnusedIndexFiles failed", zap.Error(err))
 		return
 	}
-	log.Info("recycleUnusedIndexFiles done")
+	gc.reportIndexFileGC(report)
+	log.Info("recycleUnusedIndexFiles done", zap.Bool("dryRun", dryRun),
+		zap.Int64("orphanFileNum", report.OrphanFileNum), zap.Int64("skippedFileNum", report.SkippedFileNum))
+}
+
+func (gc *garbageCollector) reportSegmentIndexGC(dryRun bool, items []*metricsinfo.IndexGCSegmentItem) {
+	gc.indexGCReportMu.Lock()
+	defer gc.indexGCReportMu.Unlock()
+	gc.indexGCReport.DryRun = dryRun
+	gc.indexGCReport.SegmentIndexGCTime = time.Now().UnixMilli()
+	gc.indexGCReport.SegmentIndexes = items
+}
+
+func (gc *garbageCollector) reportIndexFileGC(report *metricsinfo.IndexGCReport) {
+	gc.indexGCReportMu.Lock()
+	defer gc.indexGCReportMu.Unlock()
+	gc.indexGCReport.DryRun = report.DryRun
+	gc.indexGCReport.IndexFileGCTime = time.Now().UnixMilli()
+	gc.indexGCReport.OrphanFiles = report.OrphanFiles
+	gc.indexGCReport.OrphanFileNum = report.OrphanFileNum
+	gc.indexGCReport.SkippedFileNum = report.SkippedFileNum
+}
+
+// GetIndexGCReport returns the segment index meta and index files recycled, or to recycle in dry run mode, by the last gc.
+func (gc *garbageCollector) GetIndexGCReport() *metricsinfo.IndexGCReport {
+	gc.indexGCReportMu.Lock()
+	defer gc.indexGCReportMu.Unlock()
+	report := gc.indexGCReport
+	return &report
 }
 
 // getAllIndexFilesOfIndex returns the all index files of index.
@@ -54,6 +54,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/lock"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
@@ -762,7 +763,7 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 				return nil
 			})
 
-		cm.EXPECT().RemoveWithPrefix(mock.Anything, mock.Anything).Return(nil)
+		// the files of the build not in meta are removed one by one after the grace period, instead of by prefix
 		cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(nil)
 		gc := newGarbageCollector(
 			createMetaTableForRecycleUnusedIndexFiles(&datacoord.Catalog{MetaKv: kvmocks.NewMetaKv(t)}),
@@ -772,6 +773,8 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 			})
 
 		gc.recycleUnusedIndexFiles(context.TODO())
+		cm.AssertNotCalled(t, "RemoveWithPrefix", mock.Anything, mock.Anything)
+		cm.AssertCalled(t, "Remove", mock.Anything, mock.Anything)
 	})
 
 	t.Run("list fail", func(t *testing.T) {
@@ -801,7 +804,6 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 				}
 				return nil
 			})
-		cm.EXPECT().RemoveWithPrefix(mock.Anything, mock.Anything).Return(nil)
 		gc := newGarbageCollector(
 			createMetaTableForRecycleUnusedIndexFiles(&datacoord.Catalog{MetaKv: kvmocks.NewMetaKv(t)}),
 			nil,
@@ -810,19 +812,49 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 			})
 		gc.recycleUnusedIndexFiles(context.TODO())
 	})
+}
 
-	t.Run("remove with prefix fail", func(t *testing.T) {
-		cm := &mocks.ChunkManager{}
+func TestGarbageCollector_recycleOrphanIndexFiles(t *testing.T) {
+	paramtable.Init()
+	now := time.Now()
+	walk := func(ctx context.Context, prefix string, recursive bool, cowf storage.ChunkObjectWalkFunc) error {
+		if !recursive {
+			cowf(&storage.ChunkObjectInfo{FilePath: "root/index_files/602/"})
+			return nil
+		}
+		cowf(&storage.ChunkObjectInfo{FilePath: "root/index_files/602/1/old", ModifyTime: now.Add(-48 * time.Hour)})
+		cowf(&storage.ChunkObjectInfo{FilePath: "root/index_files/602/1/new", ModifyTime: now})
+		return nil
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		paramtable.Get().Save(paramtable.Get().DataCoordCfg.GCIndexDryRun.Key, "true")
+		defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.GCIndexDryRun.Key)
+
+		cm := mocks.NewChunkManager(t)
 		cm.EXPECT().RootPath().Return("root")
-		cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(errors.New("error"))
-		cm.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
-			func(ctx context.Context, s string, b bool, cowf storage.ChunkObjectWalkFunc) error {
-				for _, file := range []string{"a/b/c/", "a/b/600/", "a/b/601/", "a/b/602/"} {
-					cowf(&storage.ChunkObjectInfo{FilePath: file})
-				}
-				return nil
+		cm.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(walk)
+		gc := newGarbageCollector(
+			createMetaTableForRecycleUnusedIndexFiles(&datacoord.Catalog{MetaKv: kvmocks.NewMetaKv(t)}),
+			nil,
+			GcOption{
+				cli: cm,
 			})
-		cm.EXPECT().RemoveWithPrefix(mock.Anything, mock.Anything).Return(errors.New("error"))
+		gc.recycleUnusedIndexFiles(context.TODO())
+
+		report := gc.GetIndexGCReport()
+		assert.True(t, report.DryRun)
+		assert.EqualValues(t, 1, report.OrphanFileNum)
+		assert.EqualValues(t, 1, report.SkippedFileNum)
+		assert.Equal(t, []string{"root/index_files/602/1/old"}, report.OrphanFiles)
+		assert.NotZero(t, report.IndexFileGCTime)
+	})
+
+	t.Run("remove after grace period", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(walk)
+		cm.EXPECT().Remove(mock.Anything, "root/index_files/602/1/old").Return(nil).Once()
 		gc := newGarbageCollector(
 			createMetaTableForRecycleUnusedIndexFiles(&datacoord.Catalog{MetaKv: kvmocks.NewMetaKv(t)}),
 			nil,
@@ -830,9 +862,37 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 				cli: cm,
 			})
 		gc.recycleUnusedIndexFiles(context.TODO())
+
+		report := gc.GetIndexGCReport()
+		assert.False(t, report.DryRun)
+		assert.EqualValues(t, 1, report.OrphanFileNum)
 	})
 }
 
+func TestGarbageCollector_getUnusedSegIndexReason(t *testing.T) {
+	ctx := context.Background()
+	m := &meta{
+		segments: NewSegmentsInfo(),
+		indexMeta: &indexMeta{
+			indexes: map[UniqueID]map[UniqueID]*model.Index{
+				100: {
+					1: {CollectionID: 100, IndexID: 1},
+					2: {CollectionID: 100, IndexID: 2, IsDeleted: true},
+				},
+			},
+		},
+	}
+	m.segments.SetSegment(10, &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 10, State: commonpb.SegmentState_Flushed}})
+	m.segments.SetSegment(11, &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 11, State: commonpb.SegmentState_Dropped}})
+	gc := &garbageCollector{meta: m}
+
+	assert.Equal(t, "", gc.getUnusedSegIndexReason(ctx, &model.SegmentIndex{CollectionID: 100, SegmentID: 10, IndexID: 1, IndexState: commonpb.IndexState_Failed}))
+	assert.Equal(t, "", gc.getUnusedSegIndexReason(ctx, &model.SegmentIndex{CollectionID: 100, SegmentID: 11, IndexID: 1, IndexState: commonpb.IndexState_Finished}))
+	assert.Equal(t, "segment not exist", gc.getUnusedSegIndexReason(ctx, &model.SegmentIndex{CollectionID: 100, SegmentID: 12, IndexID: 1}))
+	assert.Equal(t, "index dropped", gc.getUnusedSegIndexReason(ctx, &model.SegmentIndex{CollectionID: 100, SegmentID: 10, IndexID: 2}))
+	assert.Equal(t, "build failed on dropped segment", gc.getUnusedSegIndexReason(ctx, &model.SegmentIndex{CollectionID: 100, SegmentID: 11, IndexID: 1, IndexState: commonpb.IndexState_Failed}))
+}
+
 func TestGarbageCollector_clearETCD(t *testing.T) {
 	catalog := catalogmocks.NewDataCoordCatalog(t)
 	catalog.On("ChannelExists",
@@ -1736,6 +1796,7 @@ func (s *GarbageCollectorSuite) TestRunRecycleTaskWithPauser() {
 func (s *GarbageCollectorSuite) TestAvoidGCLoadedSegments() {
 	handler := NewNMockHandler(s.T())
 	handler.EXPECT().ListLoadedSegments(mock.Anything).Return([]int64{1}, nil).Once()
+	handler.EXPECT().GetTimeTravelWatermark(mock.Anything).Return(0, nil).Once()
 	gc := newGarbageCollector(s.meta, handler, GcOption{
 		cli:              s.cli,
 		enabled:          true,
@@ -1758,6 +1819,79 @@ func (s *GarbageCollectorSuite) TestAvoidGCLoadedSegments() {
 	s.NotNil(seg)
 }
 
+func (s *GarbageCollectorSuite) TestAvoidGCTimeTravelSegments() {
+	now := time.Now()
+	handler := NewNMockHandler(s.T())
+	handler.EXPECT().ListLoadedSegments(mock.Anything).Return(nil, nil)
+	handler.EXPECT().GetTimeTravelWatermark(mock.Anything).Return(tsoutil.ComposeTSByTime(now.Add(-60*time.Hour), 0), nil).Once()
+	gc := newGarbageCollector(s.meta, handler, GcOption{
+		cli:              s.cli,
+		enabled:          true,
+		checkInterval:    time.Millisecond * 10,
+		scanInterval:     time.Hour * 7 * 24,
+		missingTolerance: time.Hour * 24,
+		dropTolerance:    time.Hour * 24,
+	})
+
+	for _, segment := range []*datapb.SegmentInfo{
+		{ID: 1, State: commonpb.SegmentState_Dropped, DroppedAt: uint64(now.Add(-48 * time.Hour).UnixNano())},
+		{ID: 2, State: commonpb.SegmentState_Dropped, DroppedAt: uint64(now.Add(-72 * time.Hour).UnixNano())},
+	} {
+		s.NoError(s.meta.AddSegment(context.TODO(), &SegmentInfo{SegmentInfo: segment}))
+	}
+
+	// segment 1 is dropped after the watermark, so it may be read by the running requests
+	gc.recycleDroppedSegments(context.TODO())
+	s.NotNil(s.meta.GetSegment(context.TODO(), 1))
+	s.Nil(s.meta.GetSegment(context.TODO(), 2))
+
+	// the segment is kept if the watermark is not respected
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.GCRespectTimeTravelWatermark.Key, "false")
+	s.Zero(gc.getTimeTravelWatermark(context.TODO()))
+	paramtable.Get().Reset(paramtable.Get().DataCoordCfg.GCRespectTimeTravelWatermark.Key)
+
+	// the segment is only retained by the drop tolerance if the watermark is unknown
+	handler.EXPECT().GetTimeTravelWatermark(mock.Anything).Return(0, errors.New("mock error")).Once()
+	gc.recycleDroppedSegments(context.TODO())
+	s.Nil(s.meta.GetSegment(context.TODO(), 1))
+}
+
+func (s *GarbageCollectorSuite) TestInspectDroppedSegments() {
+	handler := NewNMockHandler(s.T())
+	handler.EXPECT().ListLoadedSegments(mock.Anything).Return([]int64{1}, nil).Once()
+	handler.EXPECT().GetTimeTravelWatermark(mock.Anything).Return(0, nil).Once()
+	gc := newGarbageCollector(s.meta, handler, GcOption{
+		cli:              s.cli,
+		enabled:          true,
+		checkInterval:    time.Millisecond * 10,
+		scanInterval:     time.Hour * 7 * 24,
+		missingTolerance: time.Hour * 24,
+		dropTolerance:    time.Hour * 24,
+	})
+
+	for _, segment := range []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: 100, State: commonpb.SegmentState_Dropped, DroppedAt: 0},
+		{ID: 2, CollectionID: 100, State: commonpb.SegmentState_Dropped, DroppedAt: 0},
+		{ID: 3, CollectionID: 100, State: commonpb.SegmentState_Dropped, DroppedAt: uint64(time.Now().UnixNano())},
+		{ID: 4, CollectionID: 100, State: commonpb.SegmentState_Flushed},
+		{ID: 5, CollectionID: 200, State: commonpb.SegmentState_Dropped, DroppedAt: 0},
+	} {
+		s.NoError(s.meta.AddSegment(context.TODO(), &SegmentInfo{SegmentInfo: segment}))
+	}
+
+	candidates, err := gc.inspectDroppedSegments(context.TODO(), 100)
+	s.NoError(err)
+	s.Len(candidates, 3)
+	selected := make(map[int64]bool)
+	for _, candidate := range candidates {
+		selected[candidate.SegmentID] = candidate.Selected
+		s.NotEmpty(candidate.Reasons)
+	}
+	s.Equal(map[int64]bool{1: false, 2: true, 3: false}, selected)
+	// nothing is recycled by inspection
+	s.NotNil(s.meta.GetSegment(context.TODO(), 2))
+}
+
 func TestGarbageCollector(t *testing.T) {
 	suite.Run(t, new(GarbageCollectorSuite))
 }
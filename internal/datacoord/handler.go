@@ -45,6 +45,8 @@ type Handler interface {
 	GetCollection(ctx context.Context, collectionID UniqueID) (*collectionInfo, error)
 	GetCurrentSegmentsView(ctx context.Context, channel RWChannel, partitionIDs ...UniqueID) *SegmentsView
 	ListLoadedSegments(ctx context.Context) ([]int64, error)
+	GetTimeTravelWatermark(ctx context.Context) (uint64, error)
+	RefreshCollectionTarget(ctx context.Context, collectionID int64) error
 }
 
 type SegmentsView struct {
@@ -206,6 +208,24 @@ func (h *ServerHandler) GetQueryVChanPositions(channel RWChannel, partitionIDs .
 
 	flushedIDs, droppedIDs = retrieveSegment(validSegmentInfos, flushedIDs, droppedIDs, segmentIndexed)
 
+	// the quarantined segments are excluded from the query target as from compaction and index building,
+	// their binlogs are unreadable, so the querynodes would keep failing to load them
+	quarantinedIDs := make([]int64, 0)
+	for _, s := range segments {
+		if s.isQuarantined() && (flushedIDs.Contain(s.GetID()) || levelZeroIDs.Contain(s.GetID())) {
+			quarantinedIDs = append(quarantinedIDs, s.GetID())
+		}
+	}
+	if len(quarantinedIDs) > 0 {
+		flushedIDs.Remove(quarantinedIDs...)
+		levelZeroIDs.Remove(quarantinedIDs...)
+		log.Warn("GetQueryVChanPositions: quarantined segments are excluded",
+			zap.Int64("collectionID", channel.GetCollectionID()),
+			zap.String("channel", channel.GetName()),
+			zap.Int64s("quarantinedSegments", quarantinedIDs),
+		)
+	}
+
 	log.Info("GetQueryVChanPositions",
 		zap.Int64("collectionID", channel.GetCollectionID()),
 		zap.String("channel", channel.GetName()),
@@ -593,3 +613,15 @@ func (h *ServerHandler) FinishDropChannel(channel string, collectionID int64) er
 func (h *ServerHandler) ListLoadedSegments(ctx context.Context) ([]int64, error) {
 	return h.s.listLoadedSegments(ctx)
 }
+
+// GetTimeTravelWatermark returns the oldest timestamp pinned by the running search and query requests,
+// zero means there is no running request.
+func (h *ServerHandler) GetTimeTravelWatermark(ctx context.Context) (uint64, error) {
+	return h.s.getTimeTravelWatermark(ctx)
+}
+
+// RefreshCollectionTarget asks QueryCoord to pull the latest target of the collection,
+// so that the newly flushed segments are handed off to the query cluster.
+func (h *ServerHandler) RefreshCollectionTarget(ctx context.Context, collectionID int64) error {
+	return h.s.refreshCollectionTarget(ctx, collectionID)
+}
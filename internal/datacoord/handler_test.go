@@ -286,6 +286,23 @@ func TestGetQueryVChanPositions(t *testing.T) {
 		assert.EqualValues(t, vchannel, infos.ChannelName)
 		assert.EqualValues(t, 0, len(infos.GetLevelZeroSegmentIds()))
 	})
+
+	t.Run("exclude quarantined segments", func(t *testing.T) {
+		infos := svr.handler.GetQueryVChanPositions(&channelMeta{Name: "ch1", CollectionID: 0})
+		assert.Contains(t, infos.GetFlushedSegmentIds(), int64(1))
+		assert.Contains(t, infos.GetLevelZeroSegmentIds(), int64(4))
+
+		assert.True(t, svr.meta.QuarantineSegment(context.TODO(), 1, "mock"))
+		assert.True(t, svr.meta.QuarantineSegment(context.TODO(), 4, "mock"))
+		infos = svr.handler.GetQueryVChanPositions(&channelMeta{Name: "ch1", CollectionID: 0})
+		assert.NotContains(t, infos.GetFlushedSegmentIds(), int64(1))
+		assert.NotContains(t, infos.GetLevelZeroSegmentIds(), int64(4))
+
+		assert.True(t, svr.meta.RestoreSegment(context.TODO(), 1))
+		assert.True(t, svr.meta.RestoreSegment(context.TODO(), 4))
+		infos = svr.handler.GetQueryVChanPositions(&channelMeta{Name: "ch1", CollectionID: 0})
+		assert.Contains(t, infos.GetFlushedSegmentIds(), int64(1))
+	})
 }
 
 func TestGetQueryVChanPositions_PartitionStats(t *testing.T) {
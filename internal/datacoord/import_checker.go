@@ -209,8 +209,34 @@ func (c *importChecker) getLackFilesForImports(job ImportJob) []*datapb.ImportFi
 	return lo.Values(lacks)
 }
 
+// reachCollectionJobLimit returns true if the executing import jobs of the collection reach the limit,
+// the pending jobs of the collection are started in the order of job id once the executing ones finish.
+func (c *importChecker) reachCollectionJobLimit(job ImportJob) bool {
+	limit := Params.DataCoordCfg.MaxImportJobNumPerCollection.GetAsInt()
+	if limit <= 0 {
+		return false
+	}
+	executing := c.importMeta.CountJobBy(c.ctx, WithCollectionID(job.GetCollectionID()),
+		WithJobStates(internalpb.ImportJobState_PreImporting, internalpb.ImportJobState_Importing,
+			internalpb.ImportJobState_Sorting, internalpb.ImportJobState_IndexBuilding))
+	if executing >= limit {
+		return true
+	}
+	// the earlier pending jobs of the collection go first
+	earlier := c.importMeta.CountJobBy(c.ctx, WithCollectionID(job.GetCollectionID()),
+		WithJobStates(internalpb.ImportJobState_Pending), func(j ImportJob) bool {
+			return j.GetJobID() < job.GetJobID()
+		})
+	return executing+earlier >= limit
+}
+
 func (c *importChecker) checkPendingJob(job ImportJob) {
 	log := log.With(zap.Int64("jobID", job.GetJobID()))
+	if c.reachCollectionJobLimit(job) {
+		log.RatedInfo(60, "import job keeps pending, the executing jobs of the collection reach the limit",
+			zap.Int64("collectionID", job.GetCollectionID()))
+		return
+	}
 	lacks := c.getLackFilesForPreImports(job)
 	if len(lacks) == 0 {
 		return
@@ -437,7 +463,11 @@ func (c *importChecker) checkIndexBuildingJob(job ImportJob) {
 	metrics.ImportJobLatency.WithLabelValues(metrics.ImportStageWaitL0Import).Observe(float64(buildIndexDuration.Milliseconds()))
 	log.Info("import job l0 import done", zap.Duration("jobTimeCost/l0Import", waitL0ImportDuration))
 
-	if c.updateSegmentState(originSegmentIDs, statsSegmentIDs) {
+	if importutilv2.IsDirectSealed(job.GetOptions()) {
+		if c.registerSealedSegments(job, originSegmentIDs, statsSegmentIDs) {
+			return
+		}
+	} else if c.updateSegmentState(originSegmentIDs, statsSegmentIDs) {
 		return
 	}
 	// all finished, update import job state to `Completed`.
@@ -503,6 +533,44 @@ func (c *importChecker) updateSegmentState(originSegmentIDs, statsSegmentIDs []i
 	return false
 }
 
+// registerSealedSegments registers the imported segments as sealed directly after the binlogs are validated,
+// the positions allocated with the import data ts are kept, so the channel checkpoints are not involved at all.
+// QueryCoord is notified to refresh the collection target afterwards, so the segments are handed off
+// to the query cluster before the job is completed.
+func (c *importChecker) registerSealedSegments(job ImportJob, originSegmentIDs, statsSegmentIDs []int64) bool {
+	log := log.With(zap.Int64("jobID", job.GetJobID()))
+	isImportingSegments := lo.FilterMap(append(originSegmentIDs, statsSegmentIDs...), func(segmentID int64, _ int) (*SegmentInfo, bool) {
+		segment := c.meta.GetSegment(c.ctx, segmentID)
+		return segment, segment != nil && segment.GetIsImporting()
+	})
+	for _, segment := range isImportingSegments {
+		if !isSegmentHealthy(segment) {
+			continue
+		}
+		if err := ValidateImportSegmentBinlogs(segment); err != nil {
+			updateErr := c.importMeta.UpdateJob(c.ctx, job.GetJobID(), UpdateJobState(internalpb.ImportJobState_Failed), UpdateJobReason(err.Error()))
+			if updateErr != nil {
+				log.Warn("failed to update job state to Failed", zap.Error(updateErr))
+			}
+			log.Warn("invalid binlogs of import segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			return true
+		}
+	}
+	for _, segment := range isImportingSegments {
+		err := c.meta.UpdateSegmentsInfo(c.ctx, UpdateIsImporting(segment.GetID(), false))
+		if err != nil {
+			log.Warn("register sealed import segment failed", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			return true
+		}
+	}
+	if err := c.handler.RefreshCollectionTarget(c.ctx, job.GetCollectionID()); err != nil {
+		log.Warn("failed to notify querycoord to hand off the sealed import segments", zap.Error(err))
+		return true
+	}
+	log.Info("import segments registered as sealed directly", zap.Int("num", len(isImportingSegments)))
+	return false
+}
+
 func (c *importChecker) checkFailedJob(job ImportJob) {
 	c.tryFailingTasks(job)
 }
@@ -41,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 type ImportCheckerSuite struct {
@@ -416,6 +417,48 @@ func (s *ImportCheckerSuite) TestCheckFailure() {
 	s.Equal(1, len(tasks))
 }
 
+func (s *ImportCheckerSuite) TestRegisterSealedSegments() {
+	catalog := s.importMeta.(*importMeta).catalog.(*mocks.DataCoordCatalog)
+	catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().AlterSegments(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().SaveImportJob(mock.Anything, mock.Anything).Return(nil)
+
+	position := &msgpb.MsgPosition{ChannelName: "ch0", Timestamp: 100}
+	addSegment := func(id int64, rows int64, binlogs ...*datapb.FieldBinlog) {
+		err := s.checker.meta.AddSegment(context.Background(), NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            id,
+			State:         commonpb.SegmentState_Flushed,
+			IsImporting:   true,
+			InsertChannel: "ch0",
+			NumOfRows:     rows,
+			Binlogs:       binlogs,
+			StartPosition: position,
+			DmlPosition:   position,
+		}))
+		s.NoError(err)
+	}
+	addSegment(10, 100, &datapb.FieldBinlog{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1, EntriesNum: 100}}})
+	addSegment(11, 100)
+
+	handler := s.checker.handler.(*NMockHandler)
+	job := s.importMeta.GetJob(context.TODO(), s.jobID)
+	handler.EXPECT().RefreshCollectionTarget(mock.Anything, job.GetCollectionID()).Return(errors.New("mock error")).Once()
+	s.True(s.checker.registerSealedSegments(job, []int64{10}, nil))
+	segment := s.checker.meta.GetSegment(context.TODO(), 10)
+	s.False(segment.GetIsImporting())
+
+	// the handoff notification is retried even though the segment has been registered
+	handler.EXPECT().RefreshCollectionTarget(mock.Anything, job.GetCollectionID()).Return(nil).Once()
+	s.False(s.checker.registerSealedSegments(job, []int64{10}, nil))
+	segment = s.checker.meta.GetSegment(context.TODO(), 10)
+	s.Equal(position.GetTimestamp(), segment.GetDmlPosition().GetTimestamp())
+	s.Nil(s.checker.meta.GetChannelCheckpoint("ch0"))
+
+	s.True(s.checker.registerSealedSegments(job, []int64{11}, nil))
+	s.True(s.checker.meta.GetSegment(context.TODO(), 11).GetIsImporting())
+	s.Equal(internalpb.ImportJobState_Failed, s.importMeta.GetJob(context.TODO(), s.jobID).GetState())
+}
+
 func (s *ImportCheckerSuite) TestCheckGC() {
 	mockErr := errors.New("mock err")
 
@@ -552,6 +595,36 @@ func (s *ImportCheckerSuite) TestCheckCollection() {
 	s.Equal(internalpb.ImportJobState_Failed, s.importMeta.GetJob(context.TODO(), s.jobID).GetState())
 }
 
+func (s *ImportCheckerSuite) TestCollectionJobLimit() {
+	catalog := s.importMeta.(*importMeta).catalog.(*mocks.DataCoordCatalog)
+	catalog.EXPECT().SaveImportJob(mock.Anything, mock.Anything).Return(nil)
+
+	job := s.importMeta.GetJob(context.TODO(), s.jobID).(*importJob)
+	job2 := &importJob{
+		ImportJob: typeutil.Clone(job.ImportJob),
+		tr:        timerecord.NewTimeRecorder("import job"),
+	}
+	job2.ImportJob.JobID = s.jobID + 1
+	s.NoError(s.importMeta.AddJob(context.TODO(), job2))
+
+	// no limit
+	s.False(s.checker.reachCollectionJobLimit(job2))
+
+	Params.Save(Params.DataCoordCfg.MaxImportJobNumPerCollection.Key, "1")
+	defer Params.Reset(Params.DataCoordCfg.MaxImportJobNumPerCollection.Key)
+	// the earlier pending job goes first
+	s.False(s.checker.reachCollectionJobLimit(job))
+	s.True(s.checker.reachCollectionJobLimit(job2))
+
+	s.NoError(s.importMeta.UpdateJob(context.TODO(), s.jobID, UpdateJobState(internalpb.ImportJobState_Importing)))
+	s.True(s.checker.reachCollectionJobLimit(job2))
+	s.checker.checkPendingJob(job2)
+	s.Equal(0, len(s.importMeta.GetTaskBy(context.TODO(), WithJob(job2.GetJobID()))))
+
+	Params.Save(Params.DataCoordCfg.MaxImportJobNumPerCollection.Key, "2")
+	s.False(s.checker.reachCollectionJobLimit(job2))
+}
+
 func TestImportChecker(t *testing.T) {
 	suite.Run(t, new(ImportCheckerSuite))
 }
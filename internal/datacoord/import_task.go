@@ -17,11 +17,52 @@
 package datacoord
 
 import (
+	"sync"
+	"time"
+
 	"github.com/milvus-io/milvus/internal/datacoord/task"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
 )
 
+// retryBackoff delays the retry of the failed pre-import/import task exponentially,
+// and prefers the other nodes than the failed one within another backoff after that.
+type retryBackoff struct {
+	mu           sync.Mutex
+	failedTimes  int
+	failedNodeID int64
+	backoff      time.Duration
+	nextRetry    time.Time
+}
+
+func (b *retryBackoff) onFailed(nodeID int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failedTimes++
+	b.failedNodeID = nodeID
+	b.backoff = Params.DataCoordCfg.ImportTaskRetryBackoffBase.GetAsDuration(time.Second)
+	maxBackoff := Params.DataCoordCfg.ImportTaskRetryBackoffMax.GetAsDuration(time.Second)
+	for i := 1; i < b.failedTimes && b.backoff < maxBackoff; i++ {
+		b.backoff *= 2
+	}
+	b.backoff = min(b.backoff, maxBackoff)
+	b.nextRetry = time.Now().Add(b.backoff)
+}
+
+// allow returns whether the task could be retried on the node now.
+func (b *retryBackoff) allow(nodeID int64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failedTimes == 0 {
+		return true
+	}
+	now := time.Now()
+	if now.Before(b.nextRetry) {
+		return false
+	}
+	return nodeID != b.failedNodeID || now.After(b.nextRetry.Add(b.backoff))
+}
+
 type TaskType int
 
 const (
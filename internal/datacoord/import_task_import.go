@@ -50,6 +50,7 @@ type importTask struct {
 	tr         *timerecord.TimeRecorder
 	times      *taskcommon.Times
 	retryTimes int64
+	backoff    retryBackoff
 }
 
 func (t *importTask) GetJobID() int64 {
@@ -129,6 +130,9 @@ func (t *importTask) GetTaskSlot() int64 {
 }
 
 func (t *importTask) CreateTaskOnWorker(nodeID int64, cluster session.Cluster) {
+	if !t.backoff.allow(nodeID) {
+		return
+	}
 	log.Info("processing pending import task...", WrapTaskLog(t)...)
 	job := t.importMeta.GetJob(context.TODO(), t.GetJobID())
 	req, err := AssembleImportRequest(t, job, t.meta, t.alloc)
@@ -140,6 +144,7 @@ func (t *importTask) CreateTaskOnWorker(nodeID int64, cluster session.Cluster) {
 	if err != nil {
 		log.Warn("import failed", WrapTaskLog(t, zap.Error(err))...)
 		t.retryTimes++
+		t.backoff.onFailed(nodeID)
 		return
 	}
 	err = t.importMeta.UpdateTask(context.TODO(), t.GetTaskID(),
@@ -161,6 +166,7 @@ func (t *importTask) QueryTaskOnWorker(cluster session.Cluster) {
 	}
 	resp, err := cluster.QueryImport(t.GetNodeID(), req)
 	if err != nil || resp.GetState() == datapb.ImportTaskStateV2_Retry {
+		t.backoff.onFailed(t.GetNodeID())
 		updateErr := t.importMeta.UpdateTask(context.TODO(), t.GetTaskID(), UpdateState(datapb.ImportTaskStateV2_Pending))
 		if updateErr != nil {
 			log.Warn("failed to update import task state to pending", WrapTaskLog(t, zap.Error(updateErr))...)
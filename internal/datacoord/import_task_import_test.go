@@ -51,6 +51,34 @@ func TestImportTask_TaskTime(t *testing.T) {
 	assert.Equal(t, task.GetTaskTime(taskcommon.TimeQueue), queueTime)
 }
 
+func TestImportTask_RetryBackoff(t *testing.T) {
+	Params.Save(Params.DataCoordCfg.ImportTaskRetryBackoffBase.Key, "1")
+	defer Params.Reset(Params.DataCoordCfg.ImportTaskRetryBackoffBase.Key)
+	Params.Save(Params.DataCoordCfg.ImportTaskRetryBackoffMax.Key, "3")
+	defer Params.Reset(Params.DataCoordCfg.ImportTaskRetryBackoffMax.Key)
+
+	task := &importTask{}
+	assert.True(t, task.backoff.allow(1))
+
+	task.backoff.onFailed(1)
+	assert.Equal(t, time.Second, task.backoff.backoff)
+	assert.False(t, task.backoff.allow(1))
+	assert.False(t, task.backoff.allow(2))
+
+	// the other nodes are preferred after the backoff
+	task.backoff.nextRetry = time.Now().Add(-time.Millisecond)
+	assert.False(t, task.backoff.allow(1))
+	assert.True(t, task.backoff.allow(2))
+	task.backoff.nextRetry = time.Now().Add(-2 * time.Second)
+	assert.True(t, task.backoff.allow(1))
+
+	task.backoff.onFailed(2)
+	assert.Equal(t, 2*time.Second, task.backoff.backoff)
+	task.backoff.onFailed(2)
+	task.backoff.onFailed(2)
+	assert.Equal(t, 3*time.Second, task.backoff.backoff)
+}
+
 func TestImportTask_GetTaskType(t *testing.T) {
 	task := &importTask{}
 	assert.Equal(t, task.GetTaskType(), taskcommon.Import)
@@ -44,6 +44,7 @@ type preImportTask struct {
 	tr         *timerecord.TimeRecorder
 	times      *taskcommon.Times
 	retryTimes int64
+	backoff    retryBackoff
 }
 
 func (p *preImportTask) GetJobID() int64 {
@@ -107,6 +108,9 @@ func (p *preImportTask) GetTaskVersion() int64 {
 }
 
 func (p *preImportTask) CreateTaskOnWorker(nodeID int64, cluster session.Cluster) {
+	if !p.backoff.allow(nodeID) {
+		return
+	}
 	log.Info("processing pending preimport task...", WrapTaskLog(p)...)
 	job := p.importMeta.GetJob(context.TODO(), p.GetJobID())
 	req := AssemblePreImportRequest(p, job)
@@ -115,6 +119,7 @@ func (p *preImportTask) CreateTaskOnWorker(nodeID int64, cluster session.Cluster
 	if err != nil {
 		log.Warn("preimport failed", WrapTaskLog(p, zap.Error(err))...)
 		p.retryTimes++
+		p.backoff.onFailed(nodeID)
 		return
 	}
 	err = p.importMeta.UpdateTask(context.TODO(), p.GetTaskID(),
@@ -136,6 +141,7 @@ func (p *preImportTask) QueryTaskOnWorker(cluster session.Cluster) {
 	}
 	resp, err := cluster.QueryPreImport(p.GetNodeID(), req)
 	if err != nil || resp.GetState() == datapb.ImportTaskStateV2_Retry {
+		p.backoff.onFailed(p.GetNodeID())
 		updateErr := p.importMeta.UpdateTask(context.TODO(), p.GetTaskID(), UpdateState(datapb.ImportTaskStateV2_Pending))
 		if updateErr != nil {
 			log.Warn("failed to update preimport task state to pending", WrapTaskLog(p, zap.Error(updateErr))...)
@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datacoord/session"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/importutilv2"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
@@ -138,6 +139,16 @@ func NewImportTasks(fileGroups [][]*datapb.ImportFileStats,
 	return tasks, nil
 }
 
+// getImportStorageVersion returns the storage version of the import segments,
+// storage v2 is used only if all the nodes are able to read and write it.
+func getImportStorageVersion() int64 {
+	if Params.CommonCfg.EnableStorageV2.GetAsBool() &&
+		sessionutil.GetFeatureGate().IsEnabled(sessionutil.CapabilityStorageV2) {
+		return storage.StorageV2
+	}
+	return storage.StorageV1
+}
+
 func GetSegmentMaxSize(job ImportJob, meta *meta) int {
 	if importutilv2.IsL0Import(job.GetOptions()) {
 		return paramtable.Get().DataNodeCfg.FlushDeleteBufferBytes.GetAsInt()
@@ -171,10 +182,7 @@ func AssignSegments(job ImportJob, task ImportTask, alloc allocator.Allocator, m
 		segmentLevel = datapb.SegmentLevel_L0
 	}
 
-	storageVersion := storage.StorageV1
-	if Params.CommonCfg.EnableStorageV2.GetAsBool() {
-		storageVersion = storage.StorageV2
-	}
+	storageVersion := getImportStorageVersion()
 
 	// alloc new segments
 	segments := make([]int64, 0)
@@ -283,6 +291,35 @@ func AllocImportSegment(ctx context.Context,
 	return segment, nil
 }
 
+// ValidateImportSegmentBinlogs checks the binlogs of the flushed import segment are complete,
+// which is required before the segment is registered as sealed directly.
+func ValidateImportSegmentBinlogs(segment *SegmentInfo) error {
+	if segment.GetState() != commonpb.SegmentState_Flushed {
+		return merr.WrapErrImportFailed(fmt.Sprintf("import segment %d is not flushed, state=%s", segment.GetID(), segment.GetState()))
+	}
+	if segment.GetLevel() == datapb.SegmentLevel_L0 || segment.GetNumOfRows() == 0 {
+		return nil
+	}
+	if len(segment.GetBinlogs()) == 0 {
+		return merr.WrapErrImportFailed(fmt.Sprintf("no binlogs found for import segment %d", segment.GetID()))
+	}
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		var rows int64
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if binlog.GetLogID() == 0 && binlog.GetLogPath() == "" {
+				return merr.WrapErrImportFailed(fmt.Sprintf("invalid binlog of field %d for import segment %d",
+					fieldBinlog.GetFieldID(), segment.GetID()))
+			}
+			rows += binlog.GetEntriesNum()
+		}
+		if rows != segment.GetNumOfRows() {
+			return merr.WrapErrImportFailed(fmt.Sprintf("the rows of field %d binlogs mismatch for import segment %d, expected=%d, actual=%d",
+				fieldBinlog.GetFieldID(), segment.GetID(), segment.GetNumOfRows(), rows))
+		}
+	}
+	return nil
+}
+
 func AssemblePreImportRequest(task ImportTask, job ImportJob) *datapb.PreImportRequest {
 	importFiles := lo.Map(task.(*preImportTask).GetFileStats(),
 		func(fileStats *datapb.ImportFileStats, _ int) *internalpb.ImportFile {
@@ -359,10 +396,7 @@ func AssembleImportRequest(task ImportTask, job ImportJob, meta *meta, alloc all
 		return fileStat.GetImportFile()
 	})
 
-	storageVersion := storage.StorageV1
-	if Params.CommonCfg.EnableStorageV2.GetAsBool() {
-		storageVersion = storage.StorageV2
-	}
+	storageVersion := getImportStorageVersion()
 	req := &datapb.ImportRequest{
 		ClusterID:       Params.CommonCfg.ClusterPrefix.GetValue(),
 		JobID:           task.GetJobID(),
@@ -1052,6 +1052,31 @@ func TestImportUtil_ValidateBinlogImportRequest(t *testing.T) {
 	})
 }
 
+func TestImportUtil_ValidateImportSegmentBinlogs(t *testing.T) {
+	newSegment := func(state commonpb.SegmentState, rows int64, binlogs ...*datapb.FieldBinlog) *SegmentInfo {
+		return NewSegmentInfo(&datapb.SegmentInfo{
+			ID:        1,
+			State:     state,
+			Level:     datapb.SegmentLevel_L1,
+			NumOfRows: rows,
+			Binlogs:   binlogs,
+		})
+	}
+
+	assert.Error(t, ValidateImportSegmentBinlogs(newSegment(commonpb.SegmentState_Importing, 100)))
+	assert.NoError(t, ValidateImportSegmentBinlogs(newSegment(commonpb.SegmentState_Flushed, 0)))
+	assert.Error(t, ValidateImportSegmentBinlogs(newSegment(commonpb.SegmentState_Flushed, 100)))
+
+	valid := &datapb.FieldBinlog{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1, EntriesNum: 60}, {LogID: 2, EntriesNum: 40}}}
+	assert.NoError(t, ValidateImportSegmentBinlogs(newSegment(commonpb.SegmentState_Flushed, 100, valid)))
+
+	mismatch := &datapb.FieldBinlog{FieldID: 101, Binlogs: []*datapb.Binlog{{LogID: 3, EntriesNum: 60}}}
+	assert.Error(t, ValidateImportSegmentBinlogs(newSegment(commonpb.SegmentState_Flushed, 100, valid, mismatch)))
+
+	noLog := &datapb.FieldBinlog{FieldID: 101, Binlogs: []*datapb.Binlog{{EntriesNum: 100}}}
+	assert.Error(t, ValidateImportSegmentBinlogs(newSegment(commonpb.SegmentState_Flushed, 100, valid, noLog)))
+}
+
 // TestImportUtil_ListBinlogImportRequestFiles tests listing binlog files from import request
 func TestImportUtil_ListBinlogImportRequestFiles(t *testing.T) {
 	ctx := context.Background()
@@ -0,0 +1,99 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+)
+
+// getIndexCompleteness reports the flushed segments of the collection which lack any of the declared indexes.
+// The quarantined and importing segments are skipped, they are not visible to the search until they're recovered.
+func (s *Server) getIndexCompleteness(ctx context.Context, collectionID int64) *metricsinfo.IndexCompleteness {
+	ret := &metricsinfo.IndexCompleteness{
+		CollectionID: collectionID,
+		Complete:     true,
+	}
+	indexes := s.meta.indexMeta.GetIndexesForCollection(collectionID, "")
+	segments := s.meta.SelectSegments(ctx, WithCollection(collectionID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return isFlush(segment) && segment.GetLevel() != datapb.SegmentLevel_L0 &&
+			!segment.GetIsImporting() && !segment.isQuarantined()
+	}))
+
+	var (
+		pendingRows  int64 // rows to build, counted once for each missing index
+		builtRows    int64
+		buildSeconds uint64
+	)
+	for _, segment := range segments {
+		ret.TotalRows += segment.GetNumOfRows()
+		segIndexes := s.meta.indexMeta.GetSegmentIndexes(collectionID, segment.GetID())
+		var missing []string
+		for _, index := range indexes {
+			segIndex, ok := segIndexes[index.IndexID]
+			if ok && segIndex.IndexState == commonpb.IndexState_Finished {
+				if segIndex.FinishedUTCTime > segIndex.CreatedUTCTime {
+					builtRows += segIndex.NumRows
+					buildSeconds += segIndex.FinishedUTCTime - segIndex.CreatedUTCTime
+				}
+				continue
+			}
+			missing = append(missing, index.IndexName)
+			pendingRows += segment.GetNumOfRows()
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		ret.UnindexedRows += segment.GetNumOfRows()
+		ret.UnindexedSegments = append(ret.UnindexedSegments, &metricsinfo.UnindexedSegment{
+			SegmentID:      segment.GetID(),
+			PartitionID:    segment.GetPartitionID(),
+			NumRows:        segment.GetNumOfRows(),
+			MissingIndexes: missing,
+		})
+	}
+
+	ret.Complete = len(ret.UnindexedSegments) == 0
+	if !ret.Complete && builtRows > 0 {
+		// the tasks are built concurrently, so it's an upper bound of the time
+		eta := time.Duration(float64(pendingRows) * float64(buildSeconds) / float64(builtRows) * float64(time.Second))
+		ret.EstimatedTime = eta.Round(time.Second).String()
+	}
+	return ret
+}
+
+// getIndexCompletenessJSON reports the index completeness of the collection,
+// e.g. {"metric_type": "index_completeness", "collection_id": 1}
+func (s *Server) getIndexCompletenessJSON(ctx context.Context, jsonReq gjson.Result) (string, error) {
+	collectionID := metricsinfo.GetCollectionIDFromRequest(jsonReq)
+	if collectionID <= 0 {
+		return "", merr.WrapErrParameterInvalidMsg("collection_id is required")
+	}
+	bs, err := json.Marshal(s.getIndexCompleteness(ctx, collectionID))
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+)
+
+func TestServer_getIndexCompleteness(t *testing.T) {
+	ctx := context.Background()
+	meta, err := newMemoryMeta(t)
+	require.NoError(t, err)
+	s := &Server{meta: meta}
+
+	addSegment := func(id int64, state commonpb.SegmentState) {
+		err := meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  1,
+			PartitionID:   2,
+			InsertChannel: "ch1",
+			State:         state,
+			NumOfRows:     100,
+		}))
+		require.NoError(t, err)
+	}
+	addSegment(10, commonpb.SegmentState_Flushed)
+	addSegment(11, commonpb.SegmentState_Flushed)
+	addSegment(12, commonpb.SegmentState_Growing)
+
+	// no index declared
+	completeness := s.getIndexCompleteness(ctx, 1)
+	assert.True(t, completeness.Complete)
+	assert.EqualValues(t, 200, completeness.TotalRows)
+
+	require.NoError(t, meta.indexMeta.CreateIndex(ctx, &model.Index{CollectionID: 1, FieldID: 100, IndexID: 1000, IndexName: "vec_idx"}))
+	require.NoError(t, meta.indexMeta.CreateIndex(ctx, &model.Index{CollectionID: 1, FieldID: 101, IndexID: 1001, IndexName: "scalar_idx"}))
+	for _, segIndex := range []*model.SegmentIndex{
+		{SegmentID: 10, CollectionID: 1, IndexID: 1000, BuildID: 1, NumRows: 100, IndexState: commonpb.IndexState_Finished, CreatedUTCTime: 100, FinishedUTCTime: 110},
+		{SegmentID: 10, CollectionID: 1, IndexID: 1001, BuildID: 2, NumRows: 100, IndexState: commonpb.IndexState_Finished, CreatedUTCTime: 100, FinishedUTCTime: 110},
+		{SegmentID: 11, CollectionID: 1, IndexID: 1000, BuildID: 3, NumRows: 100, IndexState: commonpb.IndexState_InProgress, CreatedUTCTime: 100},
+	} {
+		meta.indexMeta.updateSegmentIndex(segIndex)
+	}
+
+	result, err := s.getIndexCompletenessJSON(ctx, gjson.Parse(`{"collection_id": 1}`))
+	require.NoError(t, err)
+	completeness = &metricsinfo.IndexCompleteness{}
+	require.NoError(t, json.Unmarshal([]byte(result), completeness))
+	assert.False(t, completeness.Complete)
+	assert.EqualValues(t, 100, completeness.UnindexedRows)
+	require.Len(t, completeness.UnindexedSegments, 1)
+	assert.EqualValues(t, 11, completeness.UnindexedSegments[0].SegmentID)
+	assert.ElementsMatch(t, []string{"vec_idx", "scalar_idx"}, completeness.UnindexedSegments[0].MissingIndexes)
+	// 2 missing indexes of 100 rows, 10s for each 100 rows
+	assert.Equal(t, "20s", completeness.EstimatedTime)
+
+	// quarantined segments are skipped
+	assert.True(t, meta.QuarantineSegment(ctx, 11, "key not found"))
+	assert.True(t, s.getIndexCompleteness(ctx, 1).Complete)
+
+	_, err = s.getIndexCompletenessJSON(ctx, gjson.Parse(`{}`))
+	assert.Error(t, err)
+}
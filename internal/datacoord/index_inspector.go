@@ -154,6 +154,10 @@ func (i *indexInspector) createIndexesForSegment(ctx context.Context, segment *S
 		log.Ctx(ctx).Debug("segment is level zero, skip create indexes", zap.Int64("segmentID", segment.GetID()))
 		return nil
 	}
+	if segment.isQuarantined() {
+		log.Ctx(ctx).Debug("segment is quarantined, skip create indexes", zap.Int64("segmentID", segment.GetID()))
+		return nil
+	}
 
 	indexes := i.meta.indexMeta.GetIndexesForCollection(segment.CollectionID, "")
 	indexIDToSegIndexes := i.meta.indexMeta.GetSegmentIndexes(segment.CollectionID, segment.ID)
@@ -220,21 +224,26 @@ func (i *indexInspector) createIndexForSegment(ctx context.Context, segment *Seg
 func (i *indexInspector) reloadFromMeta() {
 	segments := i.meta.GetAllSegmentsUnsafe()
 	for _, segment := range segments {
-		for _, segIndex := range i.meta.indexMeta.GetSegmentIndexes(segment.GetCollectionID(), segment.ID) {
-			if segIndex.IsDeleted || (segIndex.IndexState != commonpb.IndexState_Unissued &&
-				segIndex.IndexState != commonpb.IndexState_Retry &&
-				segIndex.IndexState != commonpb.IndexState_InProgress) {
-				continue
-			}
+		i.reloadSegment(segment)
+	}
+}
 
-			i.scheduler.Enqueue(newIndexBuildTask(
-				model.CloneSegmentIndex(segIndex),
-				calculateIndexTaskSlot(segment.getSegmentSize()),
-				i.meta,
-				i.handler,
-				i.storageCli,
-				i.indexEngineVersionManager,
-			))
+// reloadSegment enqueues the unfinished index tasks of the segment.
+func (i *indexInspector) reloadSegment(segment *SegmentInfo) {
+	for _, segIndex := range i.meta.indexMeta.GetSegmentIndexes(segment.GetCollectionID(), segment.ID) {
+		if segIndex.IsDeleted || (segIndex.IndexState != commonpb.IndexState_Unissued &&
+			segIndex.IndexState != commonpb.IndexState_Retry &&
+			segIndex.IndexState != commonpb.IndexState_InProgress) {
+			continue
 		}
+
+		i.scheduler.Enqueue(newIndexBuildTask(
+			model.CloneSegmentIndex(segIndex),
+			calculateIndexTaskSlot(segment.getSegmentSize()),
+			i.meta,
+			i.handler,
+			i.storageCli,
+			i.indexEngineVersionManager,
+		))
 	}
 }
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -1132,6 +1133,51 @@ func (m *indexMeta) AllDenseWithDiskIndex(collectionID int64, schema *schemapb.C
 	return len(vectorFields) == len(vectorFieldsWithDiskIndex)
 }
 
+// GetSegmentMaxSizeByIndexType returns the segment max size in MB derived from the index types of the dense vector fields,
+// the smallest size of the fields is returned. ok is false if none of the index types is configured in
+// dataCoord.segment.maxSizeByIndexType.
+func (m *indexMeta) GetSegmentMaxSizeByIndexType(collectionID int64, schema *schemapb.CollectionSchema) (int64, bool) {
+	sizeByIndexType := make(map[string]int64)
+	for indexType, value := range Params.DataCoordCfg.SegmentMaxSizeByIndexType.GetAsJSONMap() {
+		size, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || size <= 0 {
+			log.Warn("invalid segment max size of index type, skip it", zap.String("indexType", indexType), zap.String("value", value))
+			continue
+		}
+		sizeByIndexType[strings.ToUpper(indexType)] = size
+	}
+	if len(sizeByIndexType) == 0 {
+		return 0, false
+	}
+
+	indexInfos := m.GetIndexesForCollection(collectionID, "")
+	fieldIndexTypes := lo.SliceToMap(indexInfos, func(t *model.Index) (int64, indexparamcheck.IndexType) {
+		return t.FieldID, GetIndexType(t.IndexParams)
+	})
+
+	var maxSize int64
+	configured := false
+	for _, field := range typeutil.GetDenseVectorFieldSchemas(schema) {
+		indexType, hasIndex := fieldIndexTypes[field.FieldID]
+		size, ok := sizeByIndexType[strings.ToUpper(indexType)]
+		switch {
+		case hasIndex && ok:
+			configured = true
+		case hasIndex && vecindexmgr.GetVecIndexMgrInstance().IsDiskVecIndex(indexType):
+			size = Params.DataCoordCfg.DiskSegmentMaxSize.GetAsInt64()
+		default:
+			size = Params.DataCoordCfg.SegmentMaxSize.GetAsInt64()
+		}
+		if maxSize == 0 || size < maxSize {
+			maxSize = size
+		}
+	}
+	if !configured {
+		return 0, false
+	}
+	return maxSize, true
+}
+
 func (m *indexMeta) HasIndex(collectionID int64) bool {
 	m.fieldIndexLock.RLock()
 	defer m.fieldIndexLock.RUnlock()
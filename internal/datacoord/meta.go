@@ -45,6 +45,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/conc"
+	"github.com/milvus-io/milvus/pkg/v2/util/faultinject"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/lock"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
@@ -68,6 +69,7 @@ type CompactionMeta interface {
 	CompleteCompactionMutation(ctx context.Context, t *datapb.CompactionTask, result *datapb.CompactionPlanResult) ([]*SegmentInfo, *segMetricMutation, error)
 	ValidateSegmentStateBeforeCompleteCompactionMutation(t *datapb.CompactionTask) error
 	CleanPartitionStatsInfo(ctx context.Context, info *datapb.PartitionStatsInfo) error
+	SuspectSegments(segmentIDs ...int64)
 
 	SaveCompactionTask(ctx context.Context, task *datapb.CompactionTask) error
 	DropCompactionTask(ctx context.Context, task *datapb.CompactionTask) error
@@ -100,6 +102,12 @@ type meta struct {
 	compactionTaskMeta *compactionTaskMeta
 	statsTaskMeta      *statsTaskMeta
 
+	// the segments to be validated by the quarantine detector
+	suspectedSegments typeutil.ConcurrentSet[UniqueID]
+	// the dropped segments whose logs are being removed by the garbage collector, guarded by segMu.
+	// they are never restored again, e.g. by the repair from the compaction parents.
+	gcFencedSegments typeutil.UniqueSet
+
 	// File Resource Meta
 	resourceMeta map[string]*model.FileResource
 	resourceLock lock.RWMutex
@@ -570,6 +578,20 @@ func (m *meta) GetAllCollectionNumRows() map[int64]int64 {
 	return ret
 }
 
+// GetAllCollectionIndexSize returns the serialized size of the finished indexes of the healthy segments for each collection.
+func (m *meta) GetAllCollectionIndexSize() map[int64]int64 {
+	ret := make(map[int64]int64)
+	segments := m.SelectSegments(context.TODO(), SegmentFilterFunc(isSegmentHealthy))
+	for _, segment := range segments {
+		for _, segIdx := range m.indexMeta.GetSegmentIndexes(segment.GetCollectionID(), segment.GetID()) {
+			if segIdx.IndexState == commonpb.IndexState_Finished && !segIdx.IsDeleted {
+				ret[segment.GetCollectionID()] += int64(segIdx.IndexSerializedSize)
+			}
+		}
+	}
+	return ret
+}
+
 // AddSegment records segment info, persisting info into kv store
 func (m *meta) AddSegment(ctx context.Context, segment *SegmentInfo) error {
 	log := log.Ctx(ctx).With(zap.String("channel", segment.GetInsertChannel()))
@@ -580,6 +602,9 @@ func (m *meta) AddSegment(ctx context.Context, segment *SegmentInfo) error {
 		log.Info("segment is already exists, ignore the operation", zap.Int64("segmentID", segment.ID))
 		return nil
 	}
+	if err := faultinject.Inject(ctx, faultinject.DataCoordMetaWrite); err != nil {
+		return err
+	}
 	if err := m.catalog.AddSegment(ctx, segment.SegmentInfo); err != nil {
 		log.Error("meta update: adding segment failed",
 			zap.Int64("segmentID", segment.GetID()),
@@ -593,6 +618,29 @@ func (m *meta) AddSegment(ctx context.Context, segment *SegmentInfo) error {
 	return nil
 }
 
+// FenceDroppedSegmentGC marks the dropped segment as being garbage collected before its logs are removed,
+// false is returned if the segment is not dropped anymore.
+func (m *meta) FenceDroppedSegmentGC(segmentID UniqueID) bool {
+	m.segMu.Lock()
+	defer m.segMu.Unlock()
+	segment := m.segments.GetSegment(segmentID)
+	if segment == nil || segment.GetState() != commonpb.SegmentState_Dropped {
+		return false
+	}
+	if m.gcFencedSegments == nil {
+		m.gcFencedSegments = typeutil.NewUniqueSet()
+	}
+	m.gcFencedSegments.Insert(segmentID)
+	return true
+}
+
+// isSegmentGCFenced returns whether the logs of the segment may be removed by the garbage collector.
+func (m *meta) isSegmentGCFenced(segmentID UniqueID) bool {
+	m.segMu.RLock()
+	defer m.segMu.RUnlock()
+	return m.gcFencedSegments.Contain(segmentID)
+}
+
 // DropSegment remove segment with provided id, etcd persistence also removed
 func (m *meta) DropSegment(ctx context.Context, segmentID UniqueID) error {
 	log := log.Ctx(ctx)
@@ -614,6 +662,7 @@ func (m *meta) DropSegment(ctx context.Context, segmentID UniqueID) error {
 	metrics.DataCoordNumSegments.WithLabelValues(segment.GetState().String(), segment.GetLevel().String(), getSortStatus(segment.GetIsSorted())).Dec()
 
 	m.segments.DropSegment(segmentID)
+	m.gcFencedSegments.Remove(segmentID)
 	log.Info("meta update: dropping segment - complete",
 		zap.Int64("segmentID", segmentID))
 	return nil
@@ -906,6 +955,8 @@ func UpdateStatusOperator(segmentID int64, status commonpb.SegmentState) UpdateO
 		if status == commonpb.SegmentState_Dropped {
 			segment.DroppedAt = uint64(time.Now().UnixNano())
 		}
+		// the re-flushed segment is recovered as usual once it's flushed again
+		segment.IsReflushing = false
 		return true
 	}
 }
@@ -1067,6 +1118,24 @@ func UpdateBinlogsOperator(segmentID int64, binlogs, statslogs, deltalogs, bm25l
 	}
 }
 
+// ReplaceStatslogsOperator replaces the statslogs of the segment, the replaced ones are left to be garbage collected.
+func ReplaceStatslogsOperator(segmentID int64, statslogs []*datapb.FieldBinlog) UpdateOperator {
+	return func(modPack *updateSegmentPack) bool {
+		segment := modPack.Get(segmentID)
+		if segment == nil {
+			log.Ctx(context.TODO()).Warn("meta update: replace statslogs failed - segment not found",
+				zap.Int64("segmentID", segmentID))
+			return false
+		}
+
+		segment.Statslogs = mergeFieldBinlogs(nil, statslogs)
+		modPack.increments[segmentID] = metastore.BinlogsIncrement{
+			Segment: segment.SegmentInfo,
+		}
+		return true
+	}
+}
+
 func UpdateBinlogsFromSaveBinlogPathsOperator(segmentID int64, binlogs, statslogs, deltalogs, bm25logs []*datapb.FieldBinlog) UpdateOperator {
 	return func(modPack *updateSegmentPack) bool {
 		modPack.fromSaveBinlogPathSegmentID = segmentID
@@ -1270,6 +1339,9 @@ func (m *meta) UpdateSegmentsInfo(ctx context.Context, operators ...UpdateOperat
 	segments := lo.MapToSlice(updatePack.segments, func(_ int64, segment *SegmentInfo) *datapb.SegmentInfo { return segment.SegmentInfo })
 	increments := lo.Values(updatePack.increments)
 
+	if err := faultinject.Inject(ctx, faultinject.DataCoordMetaWrite); err != nil {
+		return err
+	}
 	if err := m.catalog.AlterSegments(ctx, segments, increments...); err != nil {
 		log.Ctx(ctx).Error("meta update: update flush segments info - failed to store flush segment info into Etcd",
 			zap.Error(err))
@@ -2055,6 +2127,18 @@ func (m *meta) GetChannelCheckpoints() map[string]*msgpb.MsgPosition {
 	return checkpoints
 }
 
+// GetChannelCheckpointLags returns how far the checkpoint of each channel lags behind now.
+func (m *meta) GetChannelCheckpointLags() map[string]time.Duration {
+	m.channelCPs.RLock()
+	defer m.channelCPs.RUnlock()
+
+	lags := make(map[string]time.Duration, len(m.channelCPs.checkpoints))
+	for channel, cp := range m.channelCPs.checkpoints {
+		lags[channel] = time.Since(tsoutil.PhysicalTime(cp.GetTimestamp()))
+	}
+	return lags
+}
+
 func (m *meta) GcConfirm(ctx context.Context, collectionID, partitionID UniqueID) bool {
 	return m.catalog.GcConfirm(ctx, collectionID, partitionID)
 }
@@ -19,6 +19,7 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/cockroachdb/errors"
@@ -30,9 +31,12 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/componentutil"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/hardware"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
@@ -60,6 +64,11 @@ func (s *Server) getCollectionMetrics(ctx context.Context) *metricsinfo.DataCoor
 		}
 		ret.Collections[collectionID].NumEntitiesTotal = total
 	}
+	for collectionID, size := range s.meta.GetAllCollectionIndexSize() {
+		if info, ok := ret.Collections[collectionID]; ok {
+			info.IndexSize = size
+		}
+	}
 	return ret
 }
 
@@ -74,6 +83,9 @@ func (s *Server) getChannelsJSON(ctx context.Context, req *milvuspb.GetMetricsRe
 			log.Warn("channel not found in meta cache", zap.String("channel", channel.Name))
 		}
 	}
+	if err == nil {
+		channels = s.channelHealth.FillChannels(channels)
+	}
 	return metricsinfo.MarshalGetMetricsValues(channels, err)
 }
 
@@ -371,3 +383,92 @@ func getMetrics[T any](s *Server, ctx context.Context, req *milvuspb.GetMetricsR
 	err := errorGroup.Wait()
 	return metrics, err
 }
+
+// getIndexGCReportJSON returns the report of the last index garbage collection.
+func (s *Server) getIndexGCReportJSON() (string, error) {
+	bs, err := json.Marshal(s.garbageCollector.GetIndexGCReport())
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// getImportJobsJSON lists the import jobs, filtered by the collection_id and state of the request,
+// e.g. {"metric_type": "import_jobs", "collection_id": 1, "state": "Pending"}
+func (s *Server) getImportJobsJSON(ctx context.Context, jsonReq gjson.Result) (string, error) {
+	var filters []ImportJobFilter
+	if collectionID := metricsinfo.GetCollectionIDFromRequest(jsonReq); collectionID > 0 {
+		filters = append(filters, WithCollectionID(collectionID))
+	}
+	if v := jsonReq.Get(metricsinfo.MetricRequestParamStateKey); v.Exists() {
+		state, ok := internalpb.ImportJobState_value[v.String()]
+		if !ok {
+			return "", merr.WrapErrParameterInvalidMsg("unknown import job state %s", v.String())
+		}
+		filters = append(filters, WithJobStates(internalpb.ImportJobState(state)))
+	}
+
+	jobs := s.importMeta.GetJobBy(ctx, filters...)
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].GetJobID() < jobs[j].GetJobID()
+	})
+	ret := make([]*metricsinfo.ImportJob, 0, len(jobs))
+	for _, job := range jobs {
+		progress, state, importedRows, totalRows, reason := GetJobProgress(ctx, job.GetJobID(), s.importMeta, s.meta)
+		ret = append(ret, &metricsinfo.ImportJob{
+			JobID:          job.GetJobID(),
+			CollectionID:   job.GetCollectionID(),
+			CollectionName: job.GetCollectionName(),
+			State:          state.String(),
+			Reason:         reason,
+			Progress:       progress,
+			ImportedRows:   importedRows,
+			TotalRows:      totalRows,
+			Files:          len(job.GetFiles()),
+			CreatedTime:    job.GetCreateTime(),
+			CompleteTime:   job.GetCompleteTime(),
+		})
+	}
+	bs, err := json.Marshal(ret)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// getConfigDriftJSON returns the configurations which differ across the datanodes.
+func (s *Server) getConfigDriftJSON(ctx context.Context) (string, error) {
+	var (
+		mu          sync.Mutex
+		wg          sync.WaitGroup
+		nodeConfigs = make(map[int64][]*commonpb.KeyValuePair)
+		failedNodes []int64
+	)
+	req := componentutil.NewShowConfigurationsDetailRequest("")
+	for _, node := range s.nodeManager.GetClientIDs() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var resp *internalpb.ShowConfigurationsResponse
+			cli, err := s.nodeManager.GetClient(node)
+			if err == nil {
+				resp, err = cli.ShowConfigurations(ctx, req)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if err := merr.CheckRPCCall(resp, err); err != nil {
+				log.Ctx(ctx).Warn("failed to show configurations of DataNode", zap.Int64("nodeID", node), zap.Error(err))
+				failedNodes = append(failedNodes, node)
+				return
+			}
+			nodeConfigs[node] = resp.GetConfiguations()
+		}()
+	}
+	wg.Wait()
+
+	bs, err := json.Marshal(componentutil.DiffConfigurations(typeutil.DataNodeRole, nodeConfigs, failedNodes))
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
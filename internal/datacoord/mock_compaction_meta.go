@@ -818,6 +818,52 @@ func (_c *MockCompactionMeta_SetSegmentsCompacting_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// SuspectSegments provides a mock function with given fields: segmentIDs
+func (_m *MockCompactionMeta) SuspectSegments(segmentIDs ...int64) {
+	_va := make([]interface{}, len(segmentIDs))
+	for _i := range segmentIDs {
+		_va[_i] = segmentIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// MockCompactionMeta_SuspectSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspectSegments'
+type MockCompactionMeta_SuspectSegments_Call struct {
+	*mock.Call
+}
+
+// SuspectSegments is a helper method to define mock.On call
+//   - segmentIDs ...int64
+func (_e *MockCompactionMeta_Expecter) SuspectSegments(segmentIDs ...interface{}) *MockCompactionMeta_SuspectSegments_Call {
+	return &MockCompactionMeta_SuspectSegments_Call{Call: _e.mock.On("SuspectSegments",
+		append([]interface{}{}, segmentIDs...)...)}
+}
+
+func (_c *MockCompactionMeta_SuspectSegments_Call) Run(run func(segmentIDs ...int64)) *MockCompactionMeta_SuspectSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]int64, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(int64)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCompactionMeta_SuspectSegments_Call) Return() *MockCompactionMeta_SuspectSegments_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCompactionMeta_SuspectSegments_Call) RunAndReturn(run func(...int64)) *MockCompactionMeta_SuspectSegments_Call {
+	_c.Run(run)
+	return _c
+}
+
 // UpdateSegmentsInfo provides a mock function with given fields: ctx, operators
 func (_m *MockCompactionMeta) UpdateSegmentsInfo(ctx context.Context, operators ...UpdateOperator) error {
 	_va := make([]interface{}, len(operators))
@@ -350,6 +350,62 @@ func (_c *NMockHandler_GetQueryVChanPositions_Call) RunAndReturn(run func(RWChan
 	return _c
 }
 
+// GetTimeTravelWatermark provides a mock function with given fields: ctx
+func (_m *NMockHandler) GetTimeTravelWatermark(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimeTravelWatermark")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (uint64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NMockHandler_GetTimeTravelWatermark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTravelWatermark'
+type NMockHandler_GetTimeTravelWatermark_Call struct {
+	*mock.Call
+}
+
+// GetTimeTravelWatermark is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NMockHandler_Expecter) GetTimeTravelWatermark(ctx interface{}) *NMockHandler_GetTimeTravelWatermark_Call {
+	return &NMockHandler_GetTimeTravelWatermark_Call{Call: _e.mock.On("GetTimeTravelWatermark", ctx)}
+}
+
+func (_c *NMockHandler_GetTimeTravelWatermark_Call) Run(run func(ctx context.Context)) *NMockHandler_GetTimeTravelWatermark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NMockHandler_GetTimeTravelWatermark_Call) Return(_a0 uint64, _a1 error) *NMockHandler_GetTimeTravelWatermark_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NMockHandler_GetTimeTravelWatermark_Call) RunAndReturn(run func(context.Context) (uint64, error)) *NMockHandler_GetTimeTravelWatermark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListLoadedSegments provides a mock function with given fields: ctx
 func (_m *NMockHandler) ListLoadedSegments(ctx context.Context) ([]int64, error) {
 	ret := _m.Called(ctx)
@@ -408,6 +464,53 @@ func (_c *NMockHandler_ListLoadedSegments_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// RefreshCollectionTarget provides a mock function with given fields: ctx, collectionID
+func (_m *NMockHandler) RefreshCollectionTarget(ctx context.Context, collectionID int64) error {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshCollectionTarget")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NMockHandler_RefreshCollectionTarget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshCollectionTarget'
+type NMockHandler_RefreshCollectionTarget_Call struct {
+	*mock.Call
+}
+
+// RefreshCollectionTarget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+func (_e *NMockHandler_Expecter) RefreshCollectionTarget(ctx interface{}, collectionID interface{}) *NMockHandler_RefreshCollectionTarget_Call {
+	return &NMockHandler_RefreshCollectionTarget_Call{Call: _e.mock.On("RefreshCollectionTarget", ctx, collectionID)}
+}
+
+func (_c *NMockHandler_RefreshCollectionTarget_Call) Run(run func(ctx context.Context, collectionID int64)) *NMockHandler_RefreshCollectionTarget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *NMockHandler_RefreshCollectionTarget_Call) Return(_a0 error) *NMockHandler_RefreshCollectionTarget_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NMockHandler_RefreshCollectionTarget_Call) RunAndReturn(run func(context.Context, int64) error) *NMockHandler_RefreshCollectionTarget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewNMockHandler creates a new instance of NMockHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNMockHandler(t interface {
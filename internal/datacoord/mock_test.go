@@ -154,6 +154,10 @@ func (m *mockMixCoord) GetGcStatus(context.Context) (*datapb.GetGcStatusResponse
 	return &datapb.GetGcStatusResponse{}, nil
 }
 
+func (m *mockMixCoord) UpdateSegmentStatslogs(ctx context.Context, req *datapb.UpdateSegmentStatslogsRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 func (m *mockMixCoord) DescribeDatabase(ctx context.Context, in *rootcoordpb.DescribeDatabaseRequest) (*rootcoordpb.DescribeDatabaseResponse, error) {
 	return &rootcoordpb.DescribeDatabaseResponse{
 		Status:           merr.Success(),
@@ -215,6 +219,34 @@ func (m *mockMixCoord) FlushAll(ctx context.Context, req *datapb.FlushAllRequest
 	panic("implement me")
 }
 
+func (m *mockMixCoord) FlushAndSeal(ctx context.Context, req *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockMixCoord) ListQuarantinedSegments(ctx context.Context, req *datapb.ListQuarantinedSegmentsRequest) (*datapb.ListQuarantinedSegmentsResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockMixCoord) OperateQuarantinedSegment(ctx context.Context, req *datapb.OperateQuarantinedSegmentRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockMixCoord) RebuildStatslogs(ctx context.Context, req *datapb.RebuildStatslogsRequest) (*datapb.RebuildStatslogsResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockMixCoord) GetStatslogRebuildJob(ctx context.Context, req *datapb.GetStatslogRebuildJobRequest) (*datapb.GetStatslogRebuildJobResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockMixCoord) ReportChannelHealth(ctx context.Context, req *datapb.ReportChannelHealthRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockMixCoord) ListBackgroundJobs(ctx context.Context, req *datapb.ListBackgroundJobsRequest) (*datapb.ListBackgroundJobsResponse, error) {
+	panic("implement me")
+}
+
 func newMockMixCoord() *mockMixCoord {
 	return &mockMixCoord{state: commonpb.StateCode_Healthy}
 }
@@ -297,6 +329,26 @@ func (m *mockMixCoord) ShowCollectionIDs(ctx context.Context, req *rootcoordpb.S
 	}, nil
 }
 
+func (m *mockMixCoord) SetCollectionQuotaOverride(ctx context.Context, req *rootcoordpb.SetCollectionQuotaOverrideRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockMixCoord) CreateCollectionTemplate(ctx context.Context, req *rootcoordpb.CreateCollectionTemplateRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockMixCoord) DropCollectionTemplate(ctx context.Context, req *rootcoordpb.DropCollectionTemplateRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockMixCoord) ListCollectionTemplates(ctx context.Context, req *rootcoordpb.ListCollectionTemplatesRequest) (*rootcoordpb.ListCollectionTemplatesResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockMixCoord) GetUsage(ctx context.Context, req *rootcoordpb.GetUsageRequest) (*rootcoordpb.GetUsageResponse, error) {
+	panic("implement me")
+}
+
 func (m *mockMixCoord) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
 	panic("not implemented") // TODO: Implement
 }
@@ -988,6 +1040,14 @@ func (h *mockHandler) ListLoadedSegments(ctx context.Context) ([]int64, error) {
 	return nil, nil
 }
 
+func (h *mockHandler) GetTimeTravelWatermark(ctx context.Context) (uint64, error) {
+	return 0, nil
+}
+
+func (h *mockHandler) RefreshCollectionTarget(ctx context.Context, collectionID int64) error {
+	return nil
+}
+
 func newMockHandlerWithMeta(meta *meta) *mockHandler {
 	return &mockHandler{
 		meta: meta,
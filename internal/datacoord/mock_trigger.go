@@ -5,6 +5,7 @@ package datacoord
 import (
 	context "context"
 
+	metricsinfo "github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,6 +22,65 @@ func (_m *MockTrigger) EXPECT() *MockTrigger_Expecter {
 	return &MockTrigger_Expecter{mock: &_m.Mock}
 }
 
+// InspectCandidates provides a mock function with given fields: ctx, collectionID
+func (_m *MockTrigger) InspectCandidates(ctx context.Context, collectionID int64) ([]*metricsinfo.SegmentCandidate, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InspectCandidates")
+	}
+
+	var r0 []*metricsinfo.SegmentCandidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*metricsinfo.SegmentCandidate, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*metricsinfo.SegmentCandidate); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*metricsinfo.SegmentCandidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTrigger_InspectCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InspectCandidates'
+type MockTrigger_InspectCandidates_Call struct {
+	*mock.Call
+}
+
+// InspectCandidates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+func (_e *MockTrigger_Expecter) InspectCandidates(ctx interface{}, collectionID interface{}) *MockTrigger_InspectCandidates_Call {
+	return &MockTrigger_InspectCandidates_Call{Call: _e.mock.On("InspectCandidates", ctx, collectionID)}
+}
+
+func (_c *MockTrigger_InspectCandidates_Call) Run(run func(ctx context.Context, collectionID int64)) *MockTrigger_InspectCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTrigger_InspectCandidates_Call) Return(_a0 []*metricsinfo.SegmentCandidate, _a1 error) *MockTrigger_InspectCandidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTrigger_InspectCandidates_Call) RunAndReturn(run func(context.Context, int64) ([]*metricsinfo.SegmentCandidate, error)) *MockTrigger_InspectCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TriggerCompaction provides a mock function with given fields: ctx, signal
 func (_m *MockTrigger) TriggerCompaction(ctx context.Context, signal *compactionSignal) (int64, error) {
 	ret := _m.Called(ctx, signal)
@@ -0,0 +1,248 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// getSegmentCandidatesJSON explains which segments of the collection are selected by compaction and garbage collection.
+// Request example: {"metric_type": "segment_candidates", "collection_id": 1}
+func (s *Server) getSegmentCandidatesJSON(ctx context.Context, jsonReq gjson.Result) (string, error) {
+	collectionID := metricsinfo.GetCollectionIDFromRequest(jsonReq)
+	if collectionID <= 0 {
+		return "", merr.WrapErrParameterInvalidMsg("collection_id is required for segment candidates")
+	}
+
+	compaction, err := s.compactionTrigger.InspectCandidates(ctx, collectionID)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to inspect compaction candidates", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return "", err
+	}
+	gc, err := s.garbageCollector.inspectDroppedSegments(ctx, collectionID)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to inspect gc candidates", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return "", err
+	}
+
+	bs, err := json.Marshal(&metricsinfo.SegmentCandidates{
+		CollectionID: collectionID,
+		Compaction:   compaction,
+		GC:           gc,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+func newSegmentCandidate(segment *SegmentInfo) *metricsinfo.SegmentCandidate {
+	return &metricsinfo.SegmentCandidate{
+		SegmentID:   segment.GetID(),
+		PartitionID: segment.GetPartitionID(),
+		Channel:     segment.GetInsertChannel(),
+		State:       segment.GetState().String(),
+		Level:       segment.GetLevel().String(),
+		NumOfRows:   segment.GetNumOfRows(),
+		Size:        segment.getSegmentSize(),
+	}
+}
+
+// InspectCandidates evaluates the healthy segments of the collection the same way as the mix compaction trigger does,
+// without submitting any plan, and returns the reasons why each segment is selected or not.
+func (t *compactionTrigger) InspectCandidates(ctx context.Context, collectionID int64) ([]*metricsinfo.SegmentCandidate, error) {
+	coll, err := t.getCollection(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	ct, err := getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), coll)
+	if err != nil {
+		return nil, err
+	}
+	expectedSize := getExpectedSegmentSize(t.meta, coll.ID, coll.Schema)
+	smallSize := int64(float64(expectedSize) * Params.DataCoordCfg.SegmentSmallProportion.GetAsFloat())
+
+	var commonReasons []string
+	if !isCollectionAutoCompactionEnabled(coll) {
+		commonReasons = append(commonReasons, "auto compaction is disabled for the collection")
+	}
+	if t.inspector != nil && t.inspector.isFull() {
+		commonReasons = append(commonReasons, "compaction queue is full, auto compaction is skipped")
+	}
+
+	segments := t.meta.SelectSegments(ctx, WithCollection(collectionID), SegmentFilterFunc(isSegmentHealthy))
+	indexed := typeutil.NewUniqueSet()
+	if Params.DataCoordCfg.IndexBasedCompaction.GetAsBool() {
+		for _, segment := range FilterInIndexedSegments(ctx, t.handler, t.meta, false, segments...) {
+			indexed.Insert(segment.GetID())
+		}
+	}
+
+	results := make([]*metricsinfo.SegmentCandidate, 0, len(segments))
+	candidates := make(map[int64]*metricsinfo.SegmentCandidate)
+	var compactable []*SegmentInfo
+	for _, segment := range segments {
+		candidate := newSegmentCandidate(segment)
+		results = append(results, candidate)
+		candidate.Reasons = getNotCompactableReasons(segment)
+		if Params.DataCoordCfg.IndexBasedCompaction.GetAsBool() && !indexed.Contain(segment.GetID()) {
+			candidate.Reasons = append(candidate.Reasons, "not indexed yet while index based compaction is enabled")
+		}
+		if len(candidate.Reasons) > 0 {
+			continue
+		}
+		candidate.Reasons = append(candidate.Reasons, commonReasons...)
+		candidate.Reasons = append(candidate.Reasons, t.getSingleCompactionReasons(segment, ct)...)
+		if len(candidate.Reasons) == len(commonReasons) {
+			if segment.getSegmentSize() < smallSize {
+				candidate.Reasons = append(candidate.Reasons, fmt.Sprintf("small segment, size %d is less than %d, "+
+					"merged once at least %d small segments of the same partition and channel could fill up the expected size %d",
+					segment.getSegmentSize(), smallSize, Params.DataCoordCfg.MinSegmentToMerge.GetAsInt64(), expectedSize))
+			} else {
+				candidate.Reasons = append(candidate.Reasons, fmt.Sprintf("size %d is not less than the small segment size %d, "+
+					"and no single compaction threshold is reached", segment.getSegmentSize(), smallSize))
+			}
+		}
+		candidates[segment.GetID()] = candidate
+		compactable = append(compactable, segment)
+	}
+
+	if len(commonReasons) > 0 {
+		return results, nil
+	}
+	type category struct {
+		partitionID int64
+		channelName string
+	}
+	groups := lo.GroupBy(compactable, func(segment *SegmentInfo) category {
+		return category{partitionID: segment.GetPartitionID(), channelName: segment.GetInsertChannel()}
+	})
+	for group, segments := range groups {
+		signal := NewCompactionSignal().WithCollectionID(collectionID).WithPartitionID(group.partitionID).WithChannel(group.channelName)
+		for _, plan := range t.generatePlans(segments, signal, ct, expectedSize) {
+			for _, segmentID := range plan.B {
+				candidates[segmentID].Selected = true
+			}
+		}
+	}
+	return results, nil
+}
+
+// getNotCompactableReasons returns the reasons why the segment is filtered out by the mix compaction trigger.
+func getNotCompactableReasons(segment *SegmentInfo) []string {
+	var reasons []string
+	if !isFlushed(segment) {
+		reasons = append(reasons, fmt.Sprintf("segment is %s, not flushed", segment.GetState().String()))
+	}
+	if segment.isCompacting {
+		reasons = append(reasons, "segment is compacting")
+	}
+	if segment.isQuarantined() {
+		reasons = append(reasons, fmt.Sprintf("segment is quarantined, %s", segment.GetQuarantineReason()))
+	}
+	if segment.GetIsImporting() {
+		reasons = append(reasons, "segment is importing")
+	}
+	if segment.GetLevel() == datapb.SegmentLevel_L0 || segment.GetLevel() == datapb.SegmentLevel_L2 {
+		reasons = append(reasons, fmt.Sprintf("%s segment is not handled by mix compaction", segment.GetLevel().String()))
+	}
+	if segment.GetIsInvisible() {
+		reasons = append(reasons, "segment is invisible")
+	}
+	if !segment.GetIsSorted() {
+		reasons = append(reasons, "segment is not sorted yet")
+	}
+	return reasons
+}
+
+// inspectDroppedSegments evaluates the dropped segments of the collection the same way as recycleDroppedSegments does,
+// without removing anything, and returns the reasons why each segment is recycled or not.
+func (gc *garbageCollector) inspectDroppedSegments(ctx context.Context, collectionID int64) ([]*metricsinfo.SegmentCandidate, error) {
+	all := gc.meta.SelectSegments(ctx, WithCollection(collectionID))
+	drops := make([]*SegmentInfo, 0)
+	compactTo := make(map[int64]*SegmentInfo)
+	for _, segment := range all {
+		if segment.GetState() == commonpb.SegmentState_Dropped {
+			drops = append(drops, segment)
+		}
+		for _, from := range segment.GetCompactionFrom() {
+			compactTo[from] = segment
+		}
+	}
+	if len(drops) == 0 {
+		return nil, nil
+	}
+
+	droppedCompactTo := make(map[int64]*SegmentInfo)
+	for _, segment := range drops {
+		if to, ok := compactTo[segment.GetID()]; ok {
+			droppedCompactTo[to.GetID()] = to
+		}
+	}
+	indexedSet := make(typeutil.UniqueSet)
+	for _, segment := range FilterInIndexedSegments(ctx, gc.handler, gc.meta, false, lo.Values(droppedCompactTo)...) {
+		indexedSet.Insert(segment.GetID())
+	}
+
+	loadedSegments, err := gc.handler.ListLoadedSegments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	loadedSet := typeutil.NewUniqueSet(loadedSegments...)
+	watermark := gc.getTimeTravelWatermark(ctx)
+
+	channelCPs := make(map[string]uint64)
+	results := make([]*metricsinfo.SegmentCandidate, 0, len(drops))
+	for _, segment := range drops {
+		candidate := newSegmentCandidate(segment)
+		results = append(results, candidate)
+		if loadedSet.Contain(segment.GetID()) {
+			candidate.Reasons = append(candidate.Reasons, "segment is still loaded")
+			continue
+		}
+		channel := segment.GetInsertChannel()
+		if _, ok := channelCPs[channel]; !ok {
+			channelCPs[channel] = gc.meta.GetChannelCheckpoint(channel).GetTimestamp()
+		}
+		if reason := gc.getDroppedSegmentGCBlocker(segment, compactTo[segment.GetID()], indexedSet, channelCPs[channel]); reason != "" {
+			candidate.Reasons = append(candidate.Reasons, reason)
+			continue
+		}
+		if reason := getTimeTravelGCBlocker(segment, watermark); reason != "" {
+			candidate.Reasons = append(candidate.Reasons, reason)
+			continue
+		}
+		candidate.Selected = true
+		candidate.Reasons = append(candidate.Reasons, "dropped segment is ready to be recycled")
+	}
+	return results, nil
+}
@@ -451,6 +451,9 @@ func (s *SegmentManager) estimateMaxNumOfRows(collectionID UniqueID) (int, error
 	if collMeta == nil {
 		return -1, fmt.Errorf("failed to get collection %d", collectionID)
 	}
+	if size, ok := getAdaptiveSegmentSize(s.meta, collectionID, collMeta.Schema); ok {
+		return calBySegmentSizePolicy(collMeta.Schema, size)
+	}
 	return s.estimatePolicy(collMeta.Schema)
 }
 
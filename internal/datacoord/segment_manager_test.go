@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -38,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/etcd"
@@ -177,6 +179,35 @@ func TestAllocSegment(t *testing.T) {
 	})
 }
 
+func TestEstimateMaxNumOfRowsWithSegmentMaxSize(t *testing.T) {
+	ctx := context.Background()
+	paramtable.Init()
+	mockAllocator := newMockAllocator(t)
+	meta, err := newMemoryMeta(t)
+	assert.NoError(t, err)
+	segmentManager, _ := newSegmentManager(meta, mockAllocator)
+
+	schema := newTestSchema()
+	collID, err := mockAllocator.AllocID(ctx)
+	assert.NoError(t, err)
+	meta.AddCollection(&collectionInfo{ID: collID, Schema: schema})
+
+	defaultRows, err := segmentManager.estimateMaxNumOfRows(collID)
+	assert.NoError(t, err)
+
+	meta.AddCollection(&collectionInfo{
+		ID:         collID,
+		Schema:     schema,
+		Properties: map[string]string{common.CollectionSegmentMaxSizeKey: strconv.FormatInt(Params.DataCoordCfg.SegmentMaxSize.GetAsInt64()/2, 10)},
+	})
+	rows, err := segmentManager.estimateMaxNumOfRows(collID)
+	assert.NoError(t, err)
+	assert.Less(t, rows, defaultRows)
+	expected, err := calBySegmentSizePolicy(schema, Params.DataCoordCfg.SegmentMaxSize.GetAsInt64()/2*1024*1024)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, rows)
+}
+
 func TestLastExpireReset(t *testing.T) {
 	// set up meta on dc
 	ctx := context.Background()
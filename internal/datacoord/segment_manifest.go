@@ -0,0 +1,177 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// getSegmentManifestJSON returns the segment manifest of the collection at the barrier ts,
+// so that the external engines could read the segment files directly.
+// The barrier ts of FlushAndSeal could be used as the ts to get a consistent cut.
+// Request example: {"metric_type": "segment_manifest", "collection_id": 1, "ts": 0, "signed_url": true}
+func (s *Server) getSegmentManifestJSON(ctx context.Context, jsonReq gjson.Result) (string, error) {
+	collectionID := metricsinfo.GetCollectionIDFromRequest(jsonReq)
+	if collectionID <= 0 {
+		return "", merr.WrapErrParameterInvalidMsg("collection_id is required for segment manifest")
+	}
+
+	barrierTs := jsonReq.Get(metricsinfo.MetricRequestParamTsKey).Uint()
+	if barrierTs == 0 {
+		ts, err := s.allocator.AllocTimestamp(ctx)
+		if err != nil {
+			return "", err
+		}
+		barrierTs = ts
+	}
+	signURL := jsonReq.Get(metricsinfo.MetricRequestParamSignedURLKey).Bool()
+
+	manifest, err := s.buildSegmentManifest(ctx, collectionID, barrierTs, signURL)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to build segment manifest", zap.Int64("collectionID", collectionID),
+			zap.Uint64("barrierTs", barrierTs), zap.Error(err))
+		return "", err
+	}
+	bs, err := json.Marshal(manifest)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// buildSegmentManifest collects the flushed segments which started before the barrier ts.
+// It fails if there are unflushed segments containing data before the barrier ts,
+// since the manifest would be incomplete.
+func (s *Server) buildSegmentManifest(ctx context.Context, collectionID int64, barrierTs uint64, signURL bool) (*metricsinfo.SegmentManifest, error) {
+	segments := s.meta.SelectSegments(ctx, WithCollection(collectionID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) &&
+			!segment.GetIsImporting() &&
+			segment.GetStartPosition().GetTimestamp() <= barrierTs
+	}))
+
+	var signer storage.URLSigner
+	if signURL {
+		var ok bool
+		signer, ok = s.meta.chunkManager.(storage.URLSigner)
+		if !ok {
+			return nil, merr.WrapErrServiceUnavailable("signed url is not supported by the storage")
+		}
+	}
+	expiry := paramtable.Get().DataCoordCfg.SegmentManifestSignedURLExpiry.GetAsDuration(time.Second)
+
+	convert := func(logs []*datapb.Binlog) ([]*metricsinfo.ManifestLogFile, error) {
+		ret := make([]*metricsinfo.ManifestLogFile, 0, len(logs))
+		for _, l := range logs {
+			file := &metricsinfo.ManifestLogFile{
+				Path:          l.GetLogPath(),
+				EntriesNum:    l.GetEntriesNum(),
+				LogSize:       l.GetLogSize(),
+				TimestampFrom: l.GetTimestampFrom(),
+				TimestampTo:   l.GetTimestampTo(),
+			}
+			if signer != nil {
+				url, err := signer.SignURL(ctx, l.GetLogPath(), expiry)
+				if err != nil {
+					return nil, err
+				}
+				file.SignedURL = url
+			}
+			ret = append(ret, file)
+		}
+		return ret, nil
+	}
+	convertFieldLogs := func(fieldBinlogs []*datapb.FieldBinlog) ([]*metricsinfo.ManifestFieldLogs, error) {
+		ret := make([]*metricsinfo.ManifestFieldLogs, 0, len(fieldBinlogs))
+		for _, fieldBinlog := range fieldBinlogs {
+			logs, err := convert(fieldBinlog.GetBinlogs())
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, &metricsinfo.ManifestFieldLogs{
+				FieldID: fieldBinlog.GetFieldID(),
+				Logs:    logs,
+			})
+		}
+		return ret, nil
+	}
+
+	manifest := &metricsinfo.SegmentManifest{
+		CollectionID: collectionID,
+		BarrierTs:    barrierTs,
+		Segments:     make([]*metricsinfo.SegmentManifestEntry, 0, len(segments)),
+	}
+	for _, segment := range segments {
+		if !isFlushState(segment.GetState()) {
+			return nil, merr.WrapErrSegmentLack(segment.GetID(),
+				fmt.Sprintf("segment is %s, please flush the collection before exporting manifest", segment.GetState().String()))
+		}
+		cloned := segment.Clone()
+		if err := binlog.DecompressBinLogs(cloned.SegmentInfo); err != nil {
+			return nil, err
+		}
+
+		entry := &metricsinfo.SegmentManifestEntry{
+			SegmentID:   cloned.GetID(),
+			PartitionID: cloned.GetPartitionID(),
+			Channel:     cloned.GetInsertChannel(),
+			NumOfRows:   cloned.GetNumOfRows(),
+			Level:       cloned.GetLevel().String(),
+			IsSorted:    cloned.GetIsSorted(),
+		}
+		var err error
+		if entry.Binlogs, err = convertFieldLogs(cloned.GetBinlogs()); err != nil {
+			return nil, err
+		}
+		if entry.Statslogs, err = convertFieldLogs(cloned.GetStatslogs()); err != nil {
+			return nil, err
+		}
+		if entry.Bm25Logs, err = convertFieldLogs(cloned.GetBm25Statslogs()); err != nil {
+			return nil, err
+		}
+		for _, deltaFieldLogs := range cloned.GetDeltalogs() {
+			logs, err := convert(deltaFieldLogs.GetBinlogs())
+			if err != nil {
+				return nil, err
+			}
+			entry.Deltalogs = append(entry.Deltalogs, logs...)
+		}
+		manifest.Segments = append(manifest.Segments, entry)
+	}
+	// pin the segments, so that they won't be garbage collected after compaction while the manifest is being read.
+	if s.segReferManager != nil && len(manifest.Segments) > 0 {
+		segmentIDs := lo.Map(manifest.Segments, func(entry *metricsinfo.SegmentManifestEntry, _ int) int64 {
+			return entry.SegmentID
+		})
+		s.segReferManager.AddSegmentsLock(fmt.Sprintf("manifest-%d-%d", collectionID, barrierTs), segmentIDs, expiry)
+	}
+	return manifest, nil
+}
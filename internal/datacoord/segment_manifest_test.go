@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+)
+
+func TestServer_getSegmentManifestJSON(t *testing.T) {
+	ctx := context.Background()
+	meta, err := newMemoryMeta(t)
+	require.NoError(t, err)
+	s := &Server{meta: meta, allocator: newMockAllocator(t), segReferManager: newSegmentReferenceManager()}
+
+	addSegment := func(id int64, state commonpb.SegmentState, startTs uint64) {
+		err := meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  1,
+			PartitionID:   2,
+			InsertChannel: "ch1",
+			State:         state,
+			NumOfRows:     100,
+			StartPosition: &msgpb.MsgPosition{Timestamp: startTs},
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1, EntriesNum: 100}}},
+			},
+			Deltalogs: []*datapb.FieldBinlog{
+				{FieldID: 0, Binlogs: []*datapb.Binlog{{LogID: 2, EntriesNum: 1}}},
+			},
+		}))
+		require.NoError(t, err)
+	}
+	addSegment(10, commonpb.SegmentState_Flushed, 100)
+	addSegment(11, commonpb.SegmentState_Dropped, 100)
+	addSegment(12, commonpb.SegmentState_Growing, 1000)
+
+	t.Run("collection id required", func(t *testing.T) {
+		_, err := s.getSegmentManifestJSON(ctx, gjson.Parse(`{}`))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("manifest at barrier ts", func(t *testing.T) {
+		result, err := s.getSegmentManifestJSON(ctx, gjson.Parse(`{"collection_id": 1, "ts": 500}`))
+		assert.NoError(t, err)
+		manifest := &metricsinfo.SegmentManifest{}
+		assert.NoError(t, json.Unmarshal([]byte(result), manifest))
+		assert.EqualValues(t, 500, manifest.BarrierTs)
+		require.Len(t, manifest.Segments, 1)
+		entry := manifest.Segments[0]
+		assert.EqualValues(t, 10, entry.SegmentID)
+		require.Len(t, entry.Binlogs, 1)
+		require.Len(t, entry.Binlogs[0].Logs, 1)
+		assert.NotEmpty(t, entry.Binlogs[0].Logs[0].Path)
+		assert.Empty(t, entry.Binlogs[0].Logs[0].SignedURL)
+		require.Len(t, entry.Deltalogs, 1)
+		assert.NotEmpty(t, entry.Deltalogs[0].Path)
+		// segments in manifest are pinned from gc
+		assert.True(t, s.segReferManager.HasSegmentLock(10))
+	})
+
+	t.Run("unflushed segment before barrier", func(t *testing.T) {
+		_, err := s.getSegmentManifestJSON(ctx, gjson.Parse(`{"collection_id": 1, "ts": 2000}`))
+		assert.ErrorIs(t, err, merr.ErrSegmentLack)
+	})
+
+	t.Run("signed url not supported", func(t *testing.T) {
+		_, err := s.getSegmentManifestJSON(ctx, gjson.Parse(`{"collection_id": 1, "ts": 500, "signed_url": true}`))
+		assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
+	})
+}
@@ -0,0 +1,328 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/logutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+)
+
+const (
+	quarantineActionList = "list"
+	// quarantineActionPlanRepair only reports the alternative source to repair the segment
+	quarantineActionPlanRepair = "plan_repair"
+
+	// suspectedSegmentCheckInterval is the interval to validate the logs of the suspected segments
+	suspectedSegmentCheckInterval = 10 * time.Second
+)
+
+func (s *SegmentInfo) isQuarantined() bool {
+	return s.GetQuarantineReason() != ""
+}
+
+// SuspectSegments marks the segments to be validated by the quarantine detector,
+// e.g. the segments failed to be indexed or compacted, which may be caused by the lost logs.
+func (m *meta) SuspectSegments(segmentIDs ...int64) {
+	m.suspectedSegments.Upsert(segmentIDs...)
+}
+
+// QuarantineSegment excludes the segment from compaction and index building and persists the quarantine,
+// it returns false if the segment is not found, already quarantined or the meta fails to be saved.
+func (m *meta) QuarantineSegment(ctx context.Context, segmentID int64, reason string) bool {
+	m.segMu.Lock()
+	defer m.segMu.Unlock()
+	segment := m.segments.GetSegment(segmentID)
+	if segment == nil || !isSegmentHealthy(segment) || segment.isQuarantined() {
+		return false
+	}
+	cloned := segment.Clone()
+	cloned.QuarantineReason = reason
+	cloned.QuarantineTime = time.Now().Unix()
+	if err := m.catalog.AlterSegments(ctx, []*datapb.SegmentInfo{cloned.SegmentInfo}); err != nil {
+		log.Ctx(ctx).Warn("failed to save the quarantine of segment", zap.Int64("segmentID", segmentID), zap.Error(err))
+		return false
+	}
+	m.segments.SetSegment(segmentID, cloned)
+	log.Ctx(ctx).Warn("segment is quarantined", zap.Int64("collectionID", segment.GetCollectionID()),
+		zap.Int64("segmentID", segmentID), zap.String("reason", reason))
+	return true
+}
+
+// RestoreSegment brings the quarantined segment back, it returns false if the segment is not quarantined
+// or the meta fails to be saved.
+func (m *meta) RestoreSegment(ctx context.Context, segmentID int64) bool {
+	m.segMu.Lock()
+	defer m.segMu.Unlock()
+	segment := m.segments.GetSegment(segmentID)
+	if segment == nil || !segment.isQuarantined() {
+		return false
+	}
+	cloned := segment.Clone()
+	cloned.QuarantineReason = ""
+	cloned.QuarantineTime = 0
+	cloned.IsReflushing = false
+	if err := m.catalog.AlterSegments(ctx, []*datapb.SegmentInfo{cloned.SegmentInfo}); err != nil {
+		log.Ctx(ctx).Warn("failed to save the restore of segment", zap.Int64("segmentID", segmentID), zap.Error(err))
+		return false
+	}
+	m.segments.SetSegment(segmentID, cloned)
+	log.Ctx(ctx).Info("quarantined segment is restored", zap.Int64("collectionID", segment.GetCollectionID()),
+		zap.Int64("segmentID", segmentID))
+	return true
+}
+
+// DropQuarantinedSegmentOperator drops the quarantined segment and clears its quarantine.
+func DropQuarantinedSegmentOperator(segmentID int64) UpdateOperator {
+	return func(modPack *updateSegmentPack) bool {
+		segment := modPack.Get(segmentID)
+		if segment == nil || !segment.isQuarantined() {
+			log.Ctx(context.TODO()).Warn("meta update: drop quarantined segment fail - segment not quarantined",
+				zap.Int64("segmentID", segmentID))
+			return false
+		}
+		updateSegStateAndPrepareMetrics(segment, commonpb.SegmentState_Dropped, modPack.metricMutation)
+		segment.DroppedAt = uint64(time.Now().UnixNano())
+		segment.QuarantineReason = ""
+		segment.QuarantineTime = 0
+		segment.IsReflushing = false
+		return true
+	}
+}
+
+// listQuarantinedSegments lists the quarantined segments of the collection, or all collections if collectionID <= 0.
+func (m *meta) listQuarantinedSegments(ctx context.Context, collectionID int64) []*SegmentInfo {
+	filters := []SegmentFilter{SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) && segment.isQuarantined()
+	})}
+	if collectionID > 0 {
+		filters = append(filters, WithCollection(collectionID))
+	}
+	segments := m.SelectSegments(ctx, filters...)
+	sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+	return segments
+}
+
+// ListQuarantinedSegments lists the quarantined segments for the metrics.
+func (m *meta) ListQuarantinedSegments(ctx context.Context, collectionID int64) []*metricsinfo.QuarantinedSegment {
+	segments := m.listQuarantinedSegments(ctx, collectionID)
+	ret := make([]*metricsinfo.QuarantinedSegment, 0, len(segments))
+	for _, segment := range segments {
+		ret = append(ret, &metricsinfo.QuarantinedSegment{
+			SegmentID:      segment.GetID(),
+			CollectionID:   segment.GetCollectionID(),
+			PartitionID:    segment.GetPartitionID(),
+			Channel:        segment.GetInsertChannel(),
+			State:          segment.GetState().String(),
+			Reason:         segment.GetQuarantineReason(),
+			QuarantineTime: time.Unix(segment.GetQuarantineTime(), 0).Format(time.RFC3339),
+		})
+	}
+	return ret
+}
+
+// validateSegmentLogs checks that all the binlogs, statslogs and deltalogs of the segment exist.
+func (m *meta) validateSegmentLogs(ctx context.Context, segment *SegmentInfo) error {
+	for logPath := range getLogs(segment) {
+		exist, err := m.chunkManager.Exist(ctx, logPath)
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return merr.WrapErrIoKeyNotFound(logPath, fmt.Sprintf("log of segment %d is lost", segment.GetID()))
+		}
+	}
+	return nil
+}
+
+// isQuarantineCandidate returns true if the logs of the segment are expected to be readable.
+func isQuarantineCandidate(segment *SegmentInfo) bool {
+	return isSegmentHealthy(segment) && isFlushed(segment) && !segment.GetIsImporting() && !segment.isQuarantined()
+}
+
+// detectUnreadableSegment validates the logs of the segment and quarantines it if any of the logs is lost,
+// the other errors, e.g. the object storage is unavailable, don't quarantine the segment.
+func (s *Server) detectUnreadableSegment(ctx context.Context, segment *SegmentInfo) {
+	err := s.meta.validateSegmentLogs(ctx, segment)
+	if err == nil {
+		return
+	}
+	if !errors.Is(err, merr.ErrIoKeyNotFound) {
+		log.Ctx(ctx).Warn("failed to validate the logs of segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		return
+	}
+	s.meta.QuarantineSegment(ctx, segment.GetID(), err.Error())
+}
+
+// detectSuspectedSegments validates the segments suspected by the failed index building or compaction.
+func (s *Server) detectSuspectedSegments(ctx context.Context) {
+	for _, segmentID := range s.meta.suspectedSegments.Collect() {
+		s.meta.suspectedSegments.Remove(segmentID)
+		segment := s.meta.GetSegment(ctx, segmentID)
+		if segment == nil || !isQuarantineCandidate(segment) {
+			continue
+		}
+		s.detectUnreadableSegment(ctx, segment)
+	}
+}
+
+// scanUnreadableSegments validates a batch of the flushed segments whose ID is greater than the cursor,
+// it returns the cursor of the next batch, which goes back to 0 once all the segments are scanned.
+func (s *Server) scanUnreadableSegments(ctx context.Context, cursor int64) int64 {
+	batchSize := Params.DataCoordCfg.QuarantineScanBatchSize.GetAsInt()
+	if batchSize <= 0 {
+		return cursor
+	}
+	segments := s.meta.SelectSegments(ctx, SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return segment.GetID() > cursor && isQuarantineCandidate(segment)
+	}))
+	sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+	if len(segments) <= batchSize {
+		cursor = 0
+	} else {
+		segments = segments[:batchSize]
+		cursor = segments[len(segments)-1].GetID()
+	}
+	for _, segment := range segments {
+		if ctx.Err() != nil {
+			return cursor
+		}
+		s.detectUnreadableSegment(ctx, segment)
+	}
+	return cursor
+}
+
+// startQuarantineDetector validates the suspected segments in time and scans all the flushed segments in batches,
+// the segments with lost logs are quarantined.
+func (s *Server) startQuarantineDetector(ctx context.Context) {
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		suspectTicker := time.NewTicker(suspectedSegmentCheckInterval)
+		defer suspectTicker.Stop()
+		scanTicker := time.NewTicker(Params.DataCoordCfg.QuarantineScanInterval.GetAsDuration(time.Second))
+		defer scanTicker.Stop()
+		var cursor int64
+		for {
+			select {
+			case <-ctx.Done():
+				log.Ctx(s.ctx).Info("quarantine detector shutdown")
+				return
+			case <-suspectTicker.C:
+				s.detectSuspectedSegments(ctx)
+			case <-scanTicker.C:
+				cursor = s.scanUnreadableSegments(ctx, cursor)
+			}
+		}
+	}()
+}
+
+// getQuarantinedSegmentsJSON lists the quarantined segments or plans the repair of the quarantined segment,
+// the quarantined segments are validated, restored, repaired or dropped by the OperateQuarantinedSegment rpc.
+// The actions are:
+//   - plan_repair: report the alternative source to rebuild the segment, nothing is changed.
+//
+// Request example: {"metric_type": "quarantined_segments", "action": "plan_repair", "segment_id": 1}
+func (s *Server) getQuarantinedSegmentsJSON(ctx context.Context, jsonReq gjson.Result) (string, error) {
+	action := jsonReq.Get(metricsinfo.MetricRequestParamActionKey).String()
+	if action == "" {
+		action = quarantineActionList
+	}
+	if action == quarantineActionPlanRepair {
+		segmentID := jsonReq.Get(metricsinfo.MetricRequestParamSegmentIDKey).Int()
+		segment := s.meta.GetSegment(ctx, segmentID)
+		if segment == nil || !segment.isQuarantined() {
+			return "", merr.WrapErrParameterInvalidMsg("segment %d is not quarantined", segmentID)
+		}
+		bs, err := json.Marshal(s.planSegmentRepair(ctx, segment))
+		if err != nil {
+			return "", err
+		}
+		return string(bs), nil
+	}
+	if action != quarantineActionList {
+		return "", merr.WrapErrParameterInvalidMsg("unknown action %s of quarantined segment", action)
+	}
+
+	bs, err := json.Marshal(s.meta.ListQuarantinedSegments(ctx, metricsinfo.GetCollectionIDFromRequest(jsonReq)))
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+func (s *Server) operateQuarantinedSegment(ctx context.Context, segmentID int64, action datapb.QuarantineAction) error {
+	log := log.Ctx(ctx).With(zap.Int64("segmentID", segmentID), zap.String("action", action.String()))
+	segment := s.meta.GetSegment(ctx, segmentID)
+	if segment == nil || !isSegmentHealthy(segment) || !segment.isQuarantined() {
+		return merr.WrapErrParameterInvalidMsg("segment %d is not quarantined", segmentID)
+	}
+
+	switch action {
+	case datapb.QuarantineAction_QuarantineValidate:
+		if err := s.meta.validateSegmentLogs(ctx, segment); err != nil {
+			log.Warn("quarantined segment is still unreadable", zap.Error(err))
+			return err
+		}
+		if err := s.restoreQuarantinedSegment(ctx, segment); err != nil {
+			return err
+		}
+	case datapb.QuarantineAction_QuarantineRestore:
+		if err := s.restoreQuarantinedSegment(ctx, segment); err != nil {
+			return err
+		}
+	case datapb.QuarantineAction_QuarantineRepair:
+		if err := s.repairQuarantinedSegment(ctx, segment); err != nil {
+			log.Warn("failed to repair quarantined segment", zap.Error(err))
+			return err
+		}
+	case datapb.QuarantineAction_QuarantineDrop:
+		if err := s.meta.UpdateSegmentsInfo(ctx, DropQuarantinedSegmentOperator(segmentID)); err != nil {
+			log.Warn("failed to drop quarantined segment", zap.Error(err))
+			return err
+		}
+	default:
+		return merr.WrapErrParameterInvalidMsg("unknown action %s of quarantined segment", action.String())
+	}
+	log.Info("quarantined segment is operated")
+	return nil
+}
+
+func (s *Server) restoreQuarantinedSegment(ctx context.Context, segment *SegmentInfo) error {
+	if !s.meta.RestoreSegment(ctx, segment.GetID()) {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("failed to restore segment %d", segment.GetID()))
+	}
+	// the index tasks of the segment are removed from the scheduler once it's quarantined
+	if s.indexInspector != nil {
+		s.indexInspector.reloadSegment(s.meta.GetSegment(ctx, segment.GetID()))
+	}
+	return nil
+}
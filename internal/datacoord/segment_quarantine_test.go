@@ -0,0 +1,192 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestServer_quarantinedSegments(t *testing.T) {
+	ctx := context.Background()
+	kv := NewMetaMemoryKV()
+	catalog := datacoord.NewCatalog(kv, "", "")
+	mockBroker := broker.NewMockBroker(t)
+	mockBroker.EXPECT().ShowCollectionIDs(mock.Anything).Return(nil, nil)
+	meta, err := newMeta(ctx, catalog, nil, mockBroker)
+	require.NoError(t, err)
+	cm := mocks.NewChunkManager(t)
+	meta.chunkManager = cm
+	s := &Server{meta: meta}
+
+	addSegment := func(id int64) {
+		err := meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  1,
+			PartitionID:   2,
+			InsertChannel: "ch1",
+			State:         commonpb.SegmentState_Flushed,
+			NumOfRows:     100,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1, LogPath: "binlog"}}},
+			},
+		}))
+		require.NoError(t, err)
+	}
+	addSegment(10)
+	addSegment(11)
+	addSegment(12)
+
+	list := func(req string) []*metricsinfo.QuarantinedSegment {
+		result, err := s.getQuarantinedSegmentsJSON(ctx, gjson.Parse(req))
+		require.NoError(t, err)
+		var segments []*metricsinfo.QuarantinedSegment
+		require.NoError(t, json.Unmarshal([]byte(result), &segments))
+		return segments
+	}
+
+	assert.True(t, meta.QuarantineSegment(ctx, 10, "key not found"))
+	assert.False(t, meta.QuarantineSegment(ctx, 10, "key not found"))
+	assert.False(t, meta.QuarantineSegment(ctx, 100, "key not found"))
+	assert.True(t, meta.QuarantineSegment(ctx, 11, "key not found"))
+	assert.True(t, meta.QuarantineSegment(ctx, 12, "key not found"))
+
+	segments := list(`{"collection_id": 1}`)
+	assert.Len(t, segments, 3)
+	assert.Equal(t, "key not found", segments[0].Reason)
+	assert.Len(t, list(`{"collection_id": 2}`), 0)
+
+	// quarantined segments are excluded from compaction
+	assert.Contains(t, getNotCompactableReasons(meta.GetSegment(ctx, 10)), "segment is quarantined, key not found")
+
+	t.Run("persisted", func(t *testing.T) {
+		mockBroker := broker.NewMockBroker(t)
+		mockBroker.EXPECT().ShowCollectionIDs(mock.Anything).Return(nil, nil)
+		reloaded, err := newMeta(ctx, datacoord.NewCatalog(kv, "", ""), nil, mockBroker)
+		require.NoError(t, err)
+		assert.Len(t, reloaded.listQuarantinedSegments(ctx, 1), 3)
+		assert.Equal(t, "key not found", reloaded.GetSegment(ctx, 10).GetQuarantineReason())
+	})
+
+	t.Run("invalid action", func(t *testing.T) {
+		_, err := s.getQuarantinedSegmentsJSON(ctx, gjson.Parse(`{"action": "drop", "segment_id": 10}`))
+		assert.Error(t, err)
+		assert.Error(t, s.operateQuarantinedSegment(ctx, 10, datapb.QuarantineAction_QuarantineActionUnknown))
+		assert.Error(t, s.operateQuarantinedSegment(ctx, 100, datapb.QuarantineAction_QuarantineRestore))
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		cm.EXPECT().Exist(mock.Anything, "binlog").Return(false, nil).Once()
+		assert.Error(t, s.operateQuarantinedSegment(ctx, 10, datapb.QuarantineAction_QuarantineValidate))
+		assert.True(t, meta.GetSegment(ctx, 10).isQuarantined())
+
+		cm.EXPECT().Exist(mock.Anything, "binlog").Return(true, nil).Once()
+		assert.NoError(t, s.operateQuarantinedSegment(ctx, 10, datapb.QuarantineAction_QuarantineValidate))
+		assert.False(t, meta.GetSegment(ctx, 10).isQuarantined())
+		assert.Len(t, list(`{}`), 2)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		resp, err := s.OperateQuarantinedSegment(ctx, &datapb.OperateQuarantinedSegmentRequest{
+			SegmentID: 11,
+			Action:    datapb.QuarantineAction_QuarantineRestore,
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp))
+		assert.False(t, meta.GetSegment(ctx, 11).isQuarantined())
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		resp, err := s.ListQuarantinedSegments(ctx, &datapb.ListQuarantinedSegmentsRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Len(t, resp.GetSegments(), 1)
+		assert.EqualValues(t, 12, resp.GetSegments()[0].GetID())
+		assert.Empty(t, resp.GetSegments()[0].GetBinlogs())
+
+		assert.NoError(t, s.operateQuarantinedSegment(ctx, 12, datapb.QuarantineAction_QuarantineDrop))
+		assert.Equal(t, commonpb.SegmentState_Dropped, meta.GetSegment(ctx, 12).GetState())
+		assert.False(t, meta.GetSegment(ctx, 12).isQuarantined())
+		assert.Len(t, list(`{}`), 0)
+	})
+}
+
+func TestServer_detectUnreadableSegments(t *testing.T) {
+	ctx := context.Background()
+	meta, err := newMemoryMeta(t)
+	require.NoError(t, err)
+	cm := mocks.NewChunkManager(t)
+	meta.chunkManager = cm
+	s := &Server{meta: meta}
+
+	for id := int64(1); id <= 3; id++ {
+		err := meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  1,
+			PartitionID:   2,
+			InsertChannel: "ch1",
+			State:         commonpb.SegmentState_Flushed,
+			NumOfRows:     100,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: id, LogPath: fmt.Sprintf("binlog%d", id)}}},
+			},
+		}))
+		require.NoError(t, err)
+	}
+
+	t.Run("suspected", func(t *testing.T) {
+		meta.SuspectSegments(1, 2, 100)
+		cm.EXPECT().Exist(mock.Anything, "binlog1").Return(false, nil).Once()
+		// the segment is not quarantined if the object storage is unavailable
+		cm.EXPECT().Exist(mock.Anything, "binlog2").Return(false, errors.New("mock")).Once()
+		s.detectSuspectedSegments(ctx)
+		assert.True(t, meta.GetSegment(ctx, 1).isQuarantined())
+		assert.False(t, meta.GetSegment(ctx, 2).isQuarantined())
+		assert.Empty(t, meta.suspectedSegments.Collect())
+	})
+
+	t.Run("scan", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.QuarantineScanBatchSize.Key, "1")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.QuarantineScanBatchSize.Key)
+
+		cm.EXPECT().Exist(mock.Anything, "binlog2").Return(true, nil).Once()
+		cursor := s.scanUnreadableSegments(ctx, 0)
+		assert.EqualValues(t, 2, cursor)
+
+		cm.EXPECT().Exist(mock.Anything, "binlog3").Return(false, nil).Once()
+		cursor = s.scanUnreadableSegments(ctx, cursor)
+		assert.EqualValues(t, 0, cursor)
+		assert.True(t, meta.GetSegment(ctx, 3).isQuarantined())
+	})
+}
@@ -0,0 +1,82 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// segmentReferLock pins a batch of segments until it's released or expired.
+type segmentReferLock struct {
+	segmentIDs typeutil.UniqueSet
+	expireAt   time.Time
+}
+
+// segmentReferenceManager manages the reference locks of segments,
+// the locked segments won't be garbage collected even if they are dropped until the locks expire.
+// The locks are kept in memory only, they are lost after datacoord restarts,
+// so the callers shall rely on the gc retention for the crash window.
+type segmentReferenceManager struct {
+	mu sync.RWMutex
+	// lockID -> lock
+	locks map[string]*segmentReferLock
+}
+
+func newSegmentReferenceManager() *segmentReferenceManager {
+	return &segmentReferenceManager{
+		locks: make(map[string]*segmentReferLock),
+	}
+}
+
+// AddSegmentsLock pins the segments with the lock id for the ttl,
+// adding the lock with an existing id replaces the previous one.
+func (m *segmentReferenceManager) AddSegmentsLock(lockID string, segmentIDs []int64, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.locks[lockID] = &segmentReferLock{
+		segmentIDs: typeutil.NewUniqueSet(segmentIDs...),
+		expireAt:   time.Now().Add(ttl),
+	}
+}
+
+// HasSegmentLock returns whether the segment is pinned by any unexpired lock.
+func (m *segmentReferenceManager) HasSegmentLock(segmentID int64) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	now := time.Now()
+	for _, lock := range m.locks {
+		if now.Before(lock.expireAt) && lock.segmentIDs.Contain(segmentID) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeExpiredLocks cleans the expired locks, it's called by gc periodically.
+func (m *segmentReferenceManager) removeExpiredLocks() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	for lockID, lock := range m.locks {
+		if !now.Before(lock.expireAt) {
+			delete(m.locks, lockID)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	catalogmocks "github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestSegmentReferenceManager(t *testing.T) {
+	m := newSegmentReferenceManager()
+	m.AddSegmentsLock("lock1", []int64{1, 2}, time.Hour)
+	m.AddSegmentsLock("lock2", []int64{3}, -time.Second)
+	assert.True(t, m.HasSegmentLock(1))
+	assert.True(t, m.HasSegmentLock(2))
+	// expired lock
+	assert.False(t, m.HasSegmentLock(3))
+	assert.False(t, m.HasSegmentLock(4))
+
+	m.removeExpiredLocks()
+	assert.Len(t, m.locks, 1)
+
+	// the lock is replaced by the one with the same id
+	m.AddSegmentsLock("lock1", []int64{1}, -time.Second)
+	assert.False(t, m.HasSegmentLock(1))
+}
+
+func TestGarbageCollector_checkDroppedSegmentGCWithRetention(t *testing.T) {
+	paramtable.Init()
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ChannelExists(mock.Anything, mock.Anything).Return(false).Maybe()
+
+	referManager := newSegmentReferenceManager()
+	gc := newGarbageCollector(&meta{catalog: catalog}, newMockHandler(), GcOption{
+		dropTolerance:   0,
+		segReferManager: referManager,
+	})
+
+	droppedAt := uint64(time.Now().Add(-time.Minute).UnixNano())
+	compacted := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+		ID:            1,
+		InsertChannel: "ch",
+		Compacted:     true,
+		DroppedAt:     droppedAt,
+	}}
+	assert.True(t, gc.checkDroppedSegmentGC(compacted, nil, typeutil.NewUniqueSet(), 0))
+
+	// the compacted segments stay readable within the drop tolerance
+	gc.option.dropTolerance = time.Hour
+	assert.False(t, gc.checkDroppedSegmentGC(compacted, nil, typeutil.NewUniqueSet(), 0))
+	gc.option.dropTolerance = 0
+
+	dropped := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+		ID:            2,
+		InsertChannel: "ch",
+		DroppedAt:     droppedAt,
+	}}
+	assert.True(t, gc.checkDroppedSegmentGC(dropped, nil, typeutil.NewUniqueSet(), 0))
+
+	// referred segment shall not be collected
+	referManager.AddSegmentsLock("lock", []int64{2}, time.Hour)
+	assert.False(t, gc.checkDroppedSegmentGC(dropped, nil, typeutil.NewUniqueSet(), 0))
+	referManager.AddSegmentsLock("lock", []int64{2}, -time.Second)
+	assert.True(t, gc.checkDroppedSegmentGC(dropped, nil, typeutil.NewUniqueSet(), 0))
+}
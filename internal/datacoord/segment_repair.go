@@ -0,0 +1,354 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/coordinator/snmanager"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+const (
+	// the quarantined segment is replaced by its pre-compaction parents which are not garbage collected yet
+	segmentRepairSourceCompactionParents = "compaction_parents"
+	// the data of the quarantined segment is still retained by the message stream
+	segmentRepairSourceMessageStream = "message_stream"
+)
+
+// planSegmentRepair locates the alternative source to rebuild the unreadable segment.
+func (s *Server) planSegmentRepair(ctx context.Context, segment *SegmentInfo) *metricsinfo.SegmentRepairPlan {
+	plan := &metricsinfo.SegmentRepairPlan{SegmentID: segment.GetID()}
+
+	parents, reason := s.meta.getRepairableCompactionParents(ctx, segment)
+	if reason == "" {
+		plan.Source = segmentRepairSourceCompactionParents
+		plan.SourceSegmentIDs = lo.Map(parents, func(parent *SegmentInfo, _ int) int64 { return parent.GetID() })
+		plan.Repairable = true
+		return plan
+	}
+	plan.Reasons = append(plan.Reasons, reason)
+
+	if reason := s.meta.getReflushBlocker(ctx, segment); reason != "" {
+		plan.Reasons = append(plan.Reasons, reason)
+		return plan
+	}
+	// The segment is reset to growing and re-flushed once the flusher of the vchannel is recovered,
+	// the wal of the vchannel is recovered explicitly by the repair.
+	plan.Source = segmentRepairSourceMessageStream
+	plan.Repairable = true
+	return plan
+}
+
+// getUnreadableDeltalog returns the first deltalog of the segment which can't be read, empty if all of them are readable.
+func (m *meta) getUnreadableDeltalog(ctx context.Context, segment *SegmentInfo) string {
+	for _, deltalog := range segment.GetDeltalogs() {
+		for _, l := range deltalog.GetBinlogs() {
+			exist, err := m.chunkManager.Exist(ctx, l.GetLogPath())
+			if err != nil || !exist {
+				return l.GetLogPath()
+			}
+		}
+	}
+	return ""
+}
+
+// getRepairableCompactionParents returns the pre-compaction parents of the segment if all of them are still readable,
+// otherwise the reason why the segment can't be repaired from them.
+func (m *meta) getRepairableCompactionParents(ctx context.Context, segment *SegmentInfo) ([]*SegmentInfo, string) {
+	if len(segment.GetCompactionFrom()) == 0 {
+		return nil, "segment is not created by compaction"
+	}
+	// the deletes applied to the segment after the compaction are carried to the parents
+	if path := m.getUnreadableDeltalog(ctx, segment); path != "" {
+		return nil, fmt.Sprintf("deltalog %s of segment is unreadable", path)
+	}
+
+	parents := make([]*SegmentInfo, 0, len(segment.GetCompactionFrom()))
+	for _, parentID := range segment.GetCompactionFrom() {
+		parent := m.GetSegment(ctx, parentID)
+		if parent == nil {
+			return nil, fmt.Sprintf("compaction parent %d is garbage collected", parentID)
+		}
+		if parent.GetState() != commonpb.SegmentState_Dropped {
+			return nil, fmt.Sprintf("compaction parent %d is %s", parentID, parent.GetState())
+		}
+		if m.isSegmentGCFenced(parentID) {
+			return nil, fmt.Sprintf("compaction parent %d is being garbage collected", parentID)
+		}
+		// the parents of a split compaction can't replace one of the children only
+		if children, _ := m.GetCompactionTo(parentID); len(children) != 1 {
+			return nil, fmt.Sprintf("compaction parent %d is compacted to %d segments", parentID, len(children))
+		}
+		if err := m.validateSegmentLogs(ctx, parent); err != nil {
+			return nil, fmt.Sprintf("compaction parent %d is unreadable, %s", parentID, err.Error())
+		}
+		parents = append(parents, parent)
+	}
+	return parents, ""
+}
+
+// getReflushBlocker returns the reason why the segment can't be re-flushed from the message stream, empty if it can.
+// Only the rows written by the streaming node into the segment itself can be consumed again,
+// the deletes of the segment are kept in its deltalogs.
+func (m *meta) getReflushBlocker(ctx context.Context, segment *SegmentInfo) string {
+	switch {
+	case len(segment.GetCompactionFrom()) > 0:
+		return "segment is created by compaction, its rows are written into the message stream by other segments"
+	case segment.GetLevel() == datapb.SegmentLevel_L0:
+		return "level zero segment can't be re-flushed"
+	case !segment.GetIsCreatedByStreaming():
+		return "segment is not created by the streaming node"
+	case len(segment.GetStartPosition().GetMsgID()) == 0:
+		return "start position of segment is unknown"
+	}
+	if reason := getReflushRetentionBlocker(segment); reason != "" {
+		return reason
+	}
+	if path := m.getUnreadableDeltalog(ctx, segment); path != "" {
+		return fmt.Sprintf("deltalog %s of segment is unreadable", path)
+	}
+	return ""
+}
+
+// getReflushRetentionBlocker returns the reason if the start position of the segment is out of the message stream retention,
+// it's checked again when the reflush runs since the message stream may be truncated after the repair is planned.
+func getReflushRetentionBlocker(segment *SegmentInfo) string {
+	retention := paramtable.Get().StreamingCfg.WALTruncateRetentionInterval.GetAsDurationByParse()
+	if time.Since(tsoutil.PhysicalTime(segment.GetStartPosition().GetTimestamp())) >= retention {
+		return fmt.Sprintf("the start position of segment is out of the message stream retention %s", retention)
+	}
+	return ""
+}
+
+// RepairSegmentFromCompactionParents drops the quarantined segment and restores its pre-compaction parents
+// in a single meta transaction, so the data is never visible twice or lost.
+func (m *meta) RepairSegmentFromCompactionParents(ctx context.Context, segmentID int64, parentIDs []int64) error {
+	m.segMu.Lock()
+	defer m.segMu.Unlock()
+	segment := m.segments.GetSegment(segmentID)
+	if segment == nil || !isSegmentHealthy(segment) || !segment.isQuarantined() {
+		return merr.WrapErrParameterInvalidMsg("segment %d is not quarantined", segmentID)
+	}
+
+	metricMutation := &segMetricMutation{stateChange: make(map[string]map[string]map[string]int)}
+	updated := make([]*SegmentInfo, 0, len(parentIDs)+1)
+	for _, parentID := range parentIDs {
+		parent := m.segments.GetSegment(parentID)
+		// the logs of the fenced parent may be removed by the garbage collector already
+		if parent == nil || parent.GetState() != commonpb.SegmentState_Dropped || m.gcFencedSegments.Contain(parentID) {
+			return merr.WrapErrParameterInvalidMsg("compaction parent %d of segment %d is not available", parentID, segmentID)
+		}
+		cloned := parent.Clone()
+		updateSegStateAndPrepareMetrics(cloned, commonpb.SegmentState_Flushed, metricMutation)
+		cloned.DroppedAt = 0
+		cloned.Compacted = false
+		deltalogs := lo.Map(segment.GetDeltalogs(), func(deltalog *datapb.FieldBinlog, _ int) *datapb.FieldBinlog {
+			return proto.Clone(deltalog).(*datapb.FieldBinlog)
+		})
+		cloned.Deltalogs = mergeFieldBinlogs(cloned.GetDeltalogs(), deltalogs)
+		updated = append(updated, cloned)
+	}
+	dropped := segment.Clone()
+	dropped.QuarantineReason = ""
+	dropped.QuarantineTime = 0
+	updateSegStateAndPrepareMetrics(dropped, commonpb.SegmentState_Dropped, metricMutation)
+	updated = append(updated, dropped)
+
+	if err := m.catalog.AlterSegments(ctx, lo.Map(updated, func(segment *SegmentInfo, _ int) *datapb.SegmentInfo {
+		return segment.SegmentInfo
+	})); err != nil {
+		log.Ctx(ctx).Warn("failed to repair segment from compaction parents",
+			zap.Int64("segmentID", segmentID), zap.Int64s("parents", parentIDs), zap.Error(err))
+		return err
+	}
+	metricMutation.commit()
+	for _, segment := range updated {
+		m.segments.SetSegment(segment.GetID(), segment)
+	}
+	log.Ctx(ctx).Info("segment is repaired from compaction parents", zap.Int64("collectionID", segment.GetCollectionID()),
+		zap.Int64("segmentID", segmentID), zap.Int64s("parents", parentIDs))
+	return nil
+}
+
+// MarkSegmentReflushing marks the quarantined segment to be re-flushed from the message stream,
+// the segment is kept as is until the flusher of its vchannel is recovered.
+func (m *meta) MarkSegmentReflushing(ctx context.Context, segmentID int64) error {
+	m.segMu.Lock()
+	defer m.segMu.Unlock()
+	segment := m.segments.GetSegment(segmentID)
+	if segment == nil || !isSegmentHealthy(segment) || !segment.isQuarantined() {
+		return merr.WrapErrParameterInvalidMsg("segment %d is not quarantined", segmentID)
+	}
+	if segment.GetIsReflushing() {
+		return nil
+	}
+	cloned := segment.Clone()
+	cloned.IsReflushing = true
+	if err := m.catalog.AlterSegments(ctx, []*datapb.SegmentInfo{cloned.SegmentInfo}); err != nil {
+		log.Ctx(ctx).Warn("failed to mark segment reflushing", zap.Int64("segmentID", segmentID), zap.Error(err))
+		return err
+	}
+	m.segments.SetSegment(segmentID, cloned)
+	log.Ctx(ctx).Info("segment is marked to be re-flushed from the message stream", zap.Int64("collectionID", segment.GetCollectionID()),
+		zap.Int64("segmentID", segmentID), zap.String("vchannel", segment.GetInsertChannel()))
+	return nil
+}
+
+// AbortSegmentReflush keeps the segment quarantined for the reason and clears its reflush mark.
+func (m *meta) AbortSegmentReflush(ctx context.Context, segmentID int64, reason string) error {
+	m.segMu.Lock()
+	defer m.segMu.Unlock()
+	segment := m.segments.GetSegment(segmentID)
+	if segment == nil || !segment.isQuarantined() || !segment.GetIsReflushing() {
+		return nil
+	}
+	cloned := segment.Clone()
+	cloned.IsReflushing = false
+	cloned.QuarantineReason = reason
+	if err := m.catalog.AlterSegments(ctx, []*datapb.SegmentInfo{cloned.SegmentInfo}); err != nil {
+		log.Ctx(ctx).Warn("failed to abort segment reflush", zap.Int64("segmentID", segmentID), zap.Error(err))
+		return err
+	}
+	m.segments.SetSegment(segmentID, cloned)
+	log.Ctx(ctx).Warn("segment reflush is aborted", zap.Int64("collectionID", segment.GetCollectionID()),
+		zap.Int64("segmentID", segmentID), zap.String("reason", reason))
+	return nil
+}
+
+// startReflush resets the segments of the vchannel marked to be re-flushed when the flusher of the vchannel is recovering,
+// the segment whose start position is out of the message stream retention now is kept quarantined.
+func (m *meta) startReflush(ctx context.Context, vchannel string) error {
+	segments := m.SelectSegments(ctx, WithChannel(vchannel), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) && segment.isQuarantined() && segment.GetIsReflushing()
+	}))
+	for _, segment := range segments {
+		if reason := getReflushRetentionBlocker(segment); reason != "" {
+			if err := m.AbortSegmentReflush(ctx, segment.GetID(), reason); err != nil {
+				return err
+			}
+			continue
+		}
+		// the indexes built on the unreadable logs are dropped, they're built again after the segment is flushed.
+		for _, segIdx := range m.indexMeta.GetSegmentIndexes(segment.GetCollectionID(), segment.GetID()) {
+			if err := m.indexMeta.RemoveSegmentIndex(ctx, segIdx.BuildID); err != nil {
+				return err
+			}
+		}
+		if err := m.ResetSegmentForReflush(ctx, segment.GetID()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResetSegmentForReflush resets the quarantined segment to growing without the insert logs,
+// so the rows of the segment are consumed from the message stream and flushed again by the recovered flusher.
+// The deltalogs are kept since the deletes are not re-consumed.
+func (m *meta) ResetSegmentForReflush(ctx context.Context, segmentID int64) error {
+	m.segMu.Lock()
+	defer m.segMu.Unlock()
+	segment := m.segments.GetSegment(segmentID)
+	if segment == nil || !isSegmentHealthy(segment) || !segment.isQuarantined() {
+		return merr.WrapErrParameterInvalidMsg("segment %d is not quarantined", segmentID)
+	}
+
+	metricMutation := &segMetricMutation{stateChange: make(map[string]map[string]map[string]int)}
+	cloned := segment.Clone()
+	updateSegStateAndPrepareMetrics(cloned, commonpb.SegmentState_Growing, metricMutation)
+	// the rows are counted again once the segment is flushed
+	metricMutation.rowCountChange -= cloned.GetNumOfRows()
+	cloned.NumOfRows = 0
+	cloned.Binlogs = nil
+	cloned.Statslogs = nil
+	cloned.Bm25Statslogs = nil
+	cloned.TextStatsLogs = nil
+	cloned.JsonKeyStats = nil
+	cloned.ManifestPath = ""
+	cloned.DmlPosition = nil
+	cloned.IsSorted = false
+	cloned.IsInvisible = false
+	cloned.QuarantineReason = ""
+	cloned.QuarantineTime = 0
+	cloned.IsReflushing = true
+
+	if err := m.catalog.AlterSegments(ctx, []*datapb.SegmentInfo{cloned.SegmentInfo}); err != nil {
+		log.Ctx(ctx).Warn("failed to reset segment for reflush", zap.Int64("segmentID", segmentID), zap.Error(err))
+		return err
+	}
+	metricMutation.commit()
+	m.segments.SetSegment(segmentID, cloned)
+	log.Ctx(ctx).Info("segment is reset to be re-flushed from the message stream", zap.Int64("collectionID", segment.GetCollectionID()),
+		zap.Int64("segmentID", segmentID), zap.String("vchannel", segment.GetInsertChannel()),
+		zap.Uint64("startPosition", segment.GetStartPosition().GetTimestamp()))
+	return nil
+}
+
+// getReflushPosition returns the reflushing segments of the vchannel and the earliest start position of them.
+func (m *meta) getReflushPosition(ctx context.Context, vchannel string) ([]int64, *msgpb.MsgPosition) {
+	segments := m.SelectSegments(ctx, WithChannel(vchannel), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return segment.GetState() == commonpb.SegmentState_Growing && segment.GetIsReflushing()
+	}))
+	var position *msgpb.MsgPosition
+	for _, segment := range segments {
+		if position == nil || segment.GetStartPosition().GetTimestamp() < position.GetTimestamp() {
+			position = segment.GetStartPosition()
+		}
+	}
+	return lo.Map(segments, func(segment *SegmentInfo, _ int) int64 { return segment.GetID() }), position
+}
+
+// repairQuarantinedSegment replaces the quarantined segment with the data rebuilt from the alternative source.
+func (s *Server) repairQuarantinedSegment(ctx context.Context, segment *SegmentInfo) error {
+	plan := s.planSegmentRepair(ctx, segment)
+	if !plan.Repairable {
+		return merr.WrapErrParameterInvalidMsg("segment %d is not repairable, %s", segment.GetID(), strings.Join(plan.Reasons, "; "))
+	}
+	if plan.Source == segmentRepairSourceMessageStream {
+		if err := s.meta.MarkSegmentReflushing(ctx, segment.GetID()); err != nil {
+			return err
+		}
+		// the segment is reset and re-flushed when the flusher of its vchannel is recovering,
+		// the repair could be retried if the wal fails to be recovered.
+		return snmanager.StaticStreamingNodeManager.RecoverWAL(ctx, segment.GetInsertChannel())
+	}
+	if err := s.meta.RepairSegmentFromCompactionParents(ctx, segment.GetID(), plan.SourceSegmentIDs); err != nil {
+		return err
+	}
+	if s.indexInspector != nil {
+		for _, parentID := range plan.SourceSegmentIDs {
+			if parent := s.meta.GetSegment(ctx, parentID); parent != nil {
+				s.indexInspector.reloadSegment(parent)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/coordinator/snmanager"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/mocks/streamingcoord/server/mock_balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/balance"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+func TestServer_repairQuarantinedSegment(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	meta, err := newMemoryMeta(t)
+	require.NoError(t, err)
+	cm := mocks.NewChunkManager(t)
+	meta.chunkManager = cm
+	s := &Server{meta: meta}
+
+	addSegment := func(id int64, state commonpb.SegmentState, compactionFrom ...int64) {
+		err := meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:             id,
+			CollectionID:   1,
+			PartitionID:    2,
+			InsertChannel:  "ch1",
+			State:          state,
+			NumOfRows:      100,
+			Compacted:      state == commonpb.SegmentState_Dropped,
+			CompactionFrom: compactionFrom,
+			StartPosition:  &msgpb.MsgPosition{Timestamp: tsoutil.ComposeTSByTime(time.Now(), 0)},
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: id, LogPath: "binlog"}}},
+			},
+		}))
+		require.NoError(t, err)
+	}
+	addSegment(10, commonpb.SegmentState_Dropped)
+	addSegment(11, commonpb.SegmentState_Dropped)
+	addSegment(12, commonpb.SegmentState_Flushed, 10, 11)
+	addSegment(20, commonpb.SegmentState_Flushed)
+	require.NoError(t, meta.UpdateSegmentsInfo(ctx, AddBinlogsOperator(12, nil, nil, []*datapb.FieldBinlog{
+		{FieldID: 0, Binlogs: []*datapb.Binlog{{LogID: 1, LogPath: "deltalog"}}},
+	}, nil)))
+	assert.True(t, meta.QuarantineSegment(ctx, 12, "key not found"))
+	assert.True(t, meta.QuarantineSegment(ctx, 20, "key not found"))
+
+	t.Run("not repairable", func(t *testing.T) {
+		p := s.planSegmentRepair(ctx, meta.GetSegment(ctx, 20))
+		assert.False(t, p.Repairable)
+		assert.Empty(t, p.Source)
+		assert.Len(t, p.Reasons, 2)
+
+		err := s.operateQuarantinedSegment(ctx, 20, datapb.QuarantineAction_QuarantineRepair)
+		assert.Error(t, err)
+		assert.True(t, meta.GetSegment(ctx, 20).isQuarantined())
+
+		// the repair is never done by the metrics.
+		_, err = s.getQuarantinedSegmentsJSON(ctx, gjson.Parse(`{"action": "repair", "segment_id": 20}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("unreadable parents", func(t *testing.T) {
+		cm.EXPECT().Exist(mock.Anything, "deltalog").Return(true, nil).Once()
+		cm.EXPECT().Exist(mock.Anything, "binlog").Return(false, nil).Once()
+		p := s.planSegmentRepair(ctx, meta.GetSegment(ctx, 12))
+		assert.False(t, p.Repairable)
+	})
+
+	t.Run("repair from compaction parents", func(t *testing.T) {
+		cm.EXPECT().Exist(mock.Anything, mock.Anything).Return(true, nil)
+		result, err := s.getQuarantinedSegmentsJSON(ctx, gjson.Parse(`{"action": "plan_repair", "segment_id": 12}`))
+		require.NoError(t, err)
+		p := &metricsinfo.SegmentRepairPlan{}
+		require.NoError(t, json.Unmarshal([]byte(result), p))
+		assert.True(t, p.Repairable)
+		assert.Equal(t, segmentRepairSourceCompactionParents, p.Source)
+		assert.ElementsMatch(t, []int64{10, 11}, p.SourceSegmentIDs)
+		assert.True(t, meta.GetSegment(ctx, 12).isQuarantined())
+
+		err = s.operateQuarantinedSegment(ctx, 12, datapb.QuarantineAction_QuarantineRepair)
+		require.NoError(t, err)
+
+		segment := meta.GetSegment(ctx, 12)
+		assert.Equal(t, commonpb.SegmentState_Dropped, segment.GetState())
+		assert.False(t, segment.isQuarantined())
+		for _, id := range []int64{10, 11} {
+			parent := meta.GetSegment(ctx, id)
+			assert.Equal(t, commonpb.SegmentState_Flushed, parent.GetState())
+			assert.False(t, parent.GetCompacted())
+			assert.Zero(t, parent.GetDroppedAt())
+			assert.Equal(t, "deltalog", parent.GetDeltalogs()[0].GetBinlogs()[0].GetLogPath())
+		}
+
+		_, err = s.getQuarantinedSegmentsJSON(ctx, gjson.Parse(`{"action": "plan_repair", "segment_id": 12}`))
+		assert.Error(t, err)
+	})
+}
+
+func TestMeta_RepairSegmentFromFencedParents(t *testing.T) {
+	ctx := context.Background()
+	meta, err := newMemoryMeta(t)
+	require.NoError(t, err)
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().Exist(mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	meta.chunkManager = cm
+
+	for _, segment := range []*datapb.SegmentInfo{
+		{ID: 10, CollectionID: 1, State: commonpb.SegmentState_Dropped, Compacted: true},
+		{ID: 11, CollectionID: 1, State: commonpb.SegmentState_Flushed, CompactionFrom: []int64{10}},
+	} {
+		require.NoError(t, meta.AddSegment(ctx, NewSegmentInfo(segment)))
+	}
+	assert.True(t, meta.QuarantineSegment(ctx, 11, "key not found"))
+
+	// the segment which is not dropped can't be fenced.
+	assert.False(t, meta.FenceDroppedSegmentGC(11))
+	assert.True(t, meta.FenceDroppedSegmentGC(10))
+	assert.True(t, meta.isSegmentGCFenced(10))
+
+	_, reason := meta.getRepairableCompactionParents(ctx, meta.GetSegment(ctx, 11))
+	assert.Contains(t, reason, "being garbage collected")
+	err = meta.RepairSegmentFromCompactionParents(ctx, 11, []int64{10})
+	assert.Error(t, err)
+	assert.Equal(t, commonpb.SegmentState_Dropped, meta.GetSegment(ctx, 10).GetState())
+
+	require.NoError(t, meta.DropSegment(ctx, 10))
+	assert.False(t, meta.isSegmentGCFenced(10))
+}
+
+func TestServer_reflushQuarantinedSegment(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	snmanager.ResetStreamingNodeManager()
+	b := mock_balancer.NewMockBalancer(t)
+	b.EXPECT().WatchChannelAssignments(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, cb balancer.WatchChannelAssignmentsCallback) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}).Maybe()
+	b.EXPECT().GetLatestChannelAssignment().Return(&balancer.WatchChannelAssignmentsCallbackParam{
+		Relations: []types.PChannelInfoAssigned{
+			{
+				Channel: types.PChannelInfo{Name: "ch1", Term: 1},
+				Node:    types.StreamingNodeInfo{ServerID: 1, Address: "localhost:1"},
+			},
+		},
+	}, nil)
+	b.EXPECT().MarkAsUnavailable(mock.Anything, []types.PChannelInfo{{Name: "ch1", Term: 1}}).Return(nil).Times(2)
+	balance.Register(b)
+	meta, err := newMemoryMeta(t)
+	require.NoError(t, err)
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().Exist(mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	meta.chunkManager = cm
+	s := &Server{meta: meta}
+
+	startPosition := &msgpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{1}, Timestamp: tsoutil.ComposeTSByTime(time.Now(), 0)}
+	for _, segment := range []*datapb.SegmentInfo{
+		{
+			ID: 30, CollectionID: 1, PartitionID: 2, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed,
+			NumOfRows: 100, IsCreatedByStreaming: true, IsSorted: true, StartPosition: startPosition,
+			DmlPosition: &msgpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{2}, Timestamp: startPosition.GetTimestamp() + 1},
+			Binlogs:     []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1, LogPath: "binlog"}}}},
+			Deltalogs:   []*datapb.FieldBinlog{{FieldID: 0, Binlogs: []*datapb.Binlog{{LogID: 2, LogPath: "deltalog"}}}},
+		},
+		{
+			ID: 31, CollectionID: 1, PartitionID: 2, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed,
+			NumOfRows: 100, StartPosition: startPosition,
+		},
+		{
+			ID: 32, CollectionID: 1, PartitionID: 2, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed,
+			NumOfRows: 100, IsCreatedByStreaming: true, IsSorted: true, StartPosition: startPosition,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 3, LogPath: "binlog"}}}},
+		},
+	} {
+		require.NoError(t, meta.AddSegment(ctx, NewSegmentInfo(segment)))
+	}
+	require.NoError(t, meta.indexMeta.AddSegmentIndex(ctx, &model.SegmentIndex{
+		SegmentID: 30, CollectionID: 1, PartitionID: 2, IndexID: 1, BuildID: 1000, IndexState: commonpb.IndexState_Finished,
+	}))
+	assert.True(t, meta.QuarantineSegment(ctx, 30, "key not found"))
+	assert.True(t, meta.QuarantineSegment(ctx, 31, "key not found"))
+	assert.True(t, meta.QuarantineSegment(ctx, 32, "key not found"))
+
+	// the segment not created by streaming node can't be re-flushed.
+	p := s.planSegmentRepair(ctx, meta.GetSegment(ctx, 31))
+	assert.False(t, p.Repairable)
+
+	p = s.planSegmentRepair(ctx, meta.GetSegment(ctx, 30))
+	assert.True(t, p.Repairable)
+	assert.Equal(t, segmentRepairSourceMessageStream, p.Source)
+
+	// the segment is kept as is until the flusher of the vchannel is recovered.
+	err = s.operateQuarantinedSegment(ctx, 30, datapb.QuarantineAction_QuarantineRepair)
+	require.NoError(t, err)
+	segment := meta.GetSegment(ctx, 30)
+	assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+	assert.True(t, segment.GetIsReflushing())
+	assert.True(t, segment.isQuarantined())
+	assert.Len(t, segment.GetBinlogs(), 1)
+	assert.Len(t, meta.indexMeta.GetSegmentIndexes(1, 30), 1)
+	ids, _ := meta.getReflushPosition(ctx, "ch1")
+	assert.Empty(t, ids)
+
+	// the reflush is aborted if the start position is out of the message stream retention when the flusher is recovering.
+	require.NoError(t, s.operateQuarantinedSegment(ctx, 32, datapb.QuarantineAction_QuarantineRepair))
+	segment32 := meta.GetSegment(ctx, 32).Clone()
+	segment32.StartPosition = &msgpb.MsgPosition{ChannelName: "ch1", MsgID: []byte{1}, Timestamp: tsoutil.ComposeTSByTime(time.Now().Add(-30*24*time.Hour), 0)}
+	meta.segments.SetSegment(32, segment32)
+
+	require.NoError(t, meta.startReflush(ctx, "ch1"))
+	segment32 = meta.GetSegment(ctx, 32)
+	assert.Equal(t, commonpb.SegmentState_Flushed, segment32.GetState())
+	assert.False(t, segment32.GetIsReflushing())
+	assert.True(t, segment32.isQuarantined())
+	assert.Contains(t, segment32.GetQuarantineReason(), "retention")
+	assert.Len(t, segment32.GetBinlogs(), 1)
+
+	segment = meta.GetSegment(ctx, 30)
+	assert.Equal(t, commonpb.SegmentState_Growing, segment.GetState())
+	assert.True(t, segment.GetIsReflushing())
+	assert.False(t, segment.isQuarantined())
+	assert.Zero(t, segment.GetNumOfRows())
+	assert.Empty(t, segment.GetBinlogs())
+	assert.Nil(t, segment.GetDmlPosition())
+	assert.Len(t, segment.GetDeltalogs(), 1)
+	assert.Empty(t, meta.indexMeta.GetSegmentIndexes(1, 30))
+
+	ids, position := meta.getReflushPosition(ctx, "ch1")
+	assert.Equal(t, []int64{30}, ids)
+	assert.Equal(t, startPosition.GetTimestamp(), position.GetTimestamp())
+
+	// the segment is recovered as usual once it's flushed again.
+	require.NoError(t, meta.UpdateSegmentsInfo(ctx, UpdateStatusOperator(30, commonpb.SegmentState_Flushed)))
+	assert.False(t, meta.GetSegment(ctx, 30).GetIsReflushing())
+	ids, position = meta.getReflushPosition(ctx, "ch1")
+	assert.Empty(t, ids)
+	assert.Nil(t, position)
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datacoord/session"
 	"github.com/milvus-io/milvus/internal/datacoord/task"
 	datanodeclient "github.com/milvus-io/milvus/internal/distributed/datanode/client"
+	"github.com/milvus-io/milvus/internal/json"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/kv/tikv"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
@@ -61,6 +62,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/taskcommon"
 	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/expr"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
@@ -131,6 +133,8 @@ type Server struct {
 	importInspector  ImportInspector
 	importChecker    ImportChecker
 
+	statslogRebuildMeta *statslogRebuildMeta
+
 	compactionTrigger        trigger
 	compactionInspector      CompactionInspector
 	compactionTriggerManager TriggerManager
@@ -154,7 +158,7 @@ type Server struct {
 	mixCoordCreator mixCoordCreatorFunc
 	// indexCoord             types.IndexCoord
 
-	// segReferManager  *SegmentReferenceManager
+	segReferManager           *segmentReferenceManager
 	indexEngineVersionManager IndexEngineVersionManager
 
 	statsInspector   *statsInspector
@@ -166,6 +170,8 @@ type Server struct {
 	broker broker.Broker
 
 	metricsRequest *metricsinfo.MetricsRequest
+
+	channelHealth channelHealthRegistry
 }
 
 type CollectionNameInfo struct {
@@ -208,6 +214,7 @@ func CreateServer(ctx context.Context, factory dependency.Factory, opts ...Optio
 		dataNodeCreator:     defaultDataNodeCreatorFunc,
 		metricsCacheManager: metricsinfo.NewMetricsCacheManager(),
 		metricsRequest:      metricsinfo.NewMetricsRequest(),
+		segReferManager:     newSegmentReferenceManager(),
 	}
 
 	for _, opt := range opts {
@@ -304,12 +311,17 @@ func (s *Server) initDataCoord() error {
 	}
 	log.Info("init service discovery done")
 
-	s.globalScheduler = task.NewGlobalTaskScheduler(s.ctx, s.cluster2)
+	s.globalScheduler = task.NewGlobalTaskScheduler(s.ctx, s.cluster2,
+		task.WithParallelismController(task.NewParallelismController(taskcommon.Compaction, s.meta.GetChannelCheckpointLags)))
 
 	s.importMeta, err = NewImportMeta(s.ctx, s.meta.catalog, s.allocator, s.meta)
 	if err != nil {
 		return err
 	}
+	s.statslogRebuildMeta, err = newStatslogRebuildMeta(s.ctx, s.meta.catalog)
+	if err != nil {
+		return err
+	}
 	s.initCompaction()
 	log.Info("init compaction done")
 
@@ -499,6 +511,7 @@ func (s *Server) initGarbageCollection(cli storage.ChunkManager) {
 		scanInterval:     Params.DataCoordCfg.GCScanIntervalInHour.GetAsDuration(time.Hour),
 		missingTolerance: Params.DataCoordCfg.GCMissingTolerance.GetAsDuration(time.Second),
 		dropTolerance:    Params.DataCoordCfg.GCDropTolerance.GetAsDuration(time.Second),
+		segReferManager:  s.segReferManager,
 	})
 }
 
@@ -548,12 +561,14 @@ func (s *Server) initServiceDiscovery() error {
 // Note: may apply same node multiple times, so rewatchQueryNodes must be idempotent
 func (s *Server) rewatchQueryNodes(sessions map[string]*sessionutil.Session) error {
 	s.indexEngineVersionManager.Startup(sessions)
+	sessionutil.GetFeatureGate().Startup(typeutil.QueryNodeRole, sessions)
 	return nil
 }
 
 // rewatchDataNodes is used to rewatch data nodes when datacoord is started or reconnected to etcd
 // Note: may apply same node multiple times, so rewatchDataNodes must be idempotent
 func (s *Server) rewatchDataNodes(sessions map[string]*sessionutil.Session) error {
+	sessionutil.GetFeatureGate().Startup(typeutil.DataNodeRole, sessions)
 	legacyVersion, err := semver.Parse(paramtable.Get().DataCoordCfg.LegacyVersionWithoutRPCWatch.GetValue())
 	if err != nil {
 		log.Warn("DataCoord failed to init service discovery", zap.Error(err))
@@ -612,7 +627,8 @@ func (s *Server) initKV() error {
 		return nil
 	}
 	s.watchClient = etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue(),
-		etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+		etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)),
+		etcdkv.WithSharedBulkClient(&paramtable.Get().ServiceParam.EtcdCfg))
 	metaType := Params.MetaStoreCfg.MetaStoreType.GetValue()
 	log.Info("data coordinator connecting to metadata store", zap.String("metaType", metaType))
 	if metaType == util.MetaStoreTypeTiKV {
@@ -622,7 +638,8 @@ func (s *Server) initKV() error {
 	} else if metaType == util.MetaStoreTypeEtcd {
 		s.metaRootPath = Params.EtcdCfg.MetaRootPath.GetValue()
 		s.kv = etcdkv.NewEtcdKV(s.etcdCli, s.metaRootPath,
-			etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+			etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)),
+			etcdkv.WithSharedBulkClient(&paramtable.Get().ServiceParam.EtcdCfg))
 	} else {
 		return retry.Unrecoverable(fmt.Errorf("not supported meta store: %s", metaType))
 	}
@@ -715,6 +732,8 @@ func (s *Server) startServerLoop() {
 	s.serverLoopWg.Add(2)
 	s.startWatchService(s.serverLoopCtx)
 	s.startFlushLoop(s.serverLoopCtx)
+	s.startQuarantineDetector(s.serverLoopCtx)
+	s.startStatslogRebuildChecker(s.serverLoopCtx)
 	s.globalScheduler.Start()
 	go s.importInspector.Start()
 	go s.importChecker.Start()
@@ -845,6 +864,7 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 				zap.String("address", info.Address),
 				zap.Int64("serverID", info.Version))
 			s.metricsCacheManager.InvalidateSystemInfoMetrics()
+			sessionutil.GetFeatureGate().AddOrUpdate(event.Session)
 			if Params.DataCoordCfg.BindIndexNodeMode.GetAsBool() {
 				log.Info("receive datanode session event, but adding datanode by bind mode, skip it",
 					zap.String("address", event.Session.Address),
@@ -858,6 +878,7 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 				zap.String("address", info.Address),
 				zap.Int64("serverID", info.Version))
 			s.metricsCacheManager.InvalidateSystemInfoMetrics()
+			sessionutil.GetFeatureGate().Remove(event.Session)
 			if Params.DataCoordCfg.BindIndexNodeMode.GetAsBool() {
 				log.Info("receive datanode session event, but adding datanode by bind mode, skip it",
 					zap.String("address", event.Session.Address),
@@ -878,15 +899,18 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 				zap.Int64("serverID", event.Session.ServerID),
 				zap.Bool("indexNonEncoding", event.Session.IndexNonEncoding))
 			s.indexEngineVersionManager.AddNode(event.Session)
+			sessionutil.GetFeatureGate().AddOrUpdate(event.Session)
 		case sessionutil.SessionDelEvent:
 			log.Info("received querynode unregister",
 				zap.String("address", event.Session.Address),
 				zap.Int64("serverID", event.Session.ServerID))
 			s.indexEngineVersionManager.RemoveNode(event.Session)
+			sessionutil.GetFeatureGate().Remove(event.Session)
 		case sessionutil.SessionUpdateEvent:
 			serverID := event.Session.ServerID
 			log.Info("received querynode SessionUpdateEvent", zap.Int64("serverID", serverID))
 			s.indexEngineVersionManager.Update(event.Session)
+			sessionutil.GetFeatureGate().AddOrUpdate(event.Session)
 		default:
 			log.Warn("receive unknown service event type",
 				zap.Any("type", event.EventType))
@@ -1101,6 +1125,11 @@ func (s *Server) registerMetricsRequest() {
 			return s.importMeta.TaskStatsJSON(ctx), nil
 		})
 
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.ImportJobKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			return s.getImportJobsJSON(ctx, jsonReq)
+		})
+
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.CompactionTaskKey,
 		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
 			return s.meta.compactionTaskMeta.TaskStatsJSON(), nil
@@ -1116,6 +1145,11 @@ func (s *Server) registerMetricsRequest() {
 			return s.getSyncTaskJSON(ctx, req)
 		})
 
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.SlowSyncTaskKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			return s.getSyncTaskJSON(ctx, req)
+		})
+
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.SegmentKey,
 		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
 			return s.getSegmentsJSON(ctx, req, jsonReq)
@@ -1131,6 +1165,46 @@ func (s *Server) registerMetricsRequest() {
 			collectionID := metricsinfo.GetCollectionIDFromRequest(jsonReq)
 			return s.meta.indexMeta.GetIndexJSON(collectionID), nil
 		})
+
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.SegmentManifestKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			return s.getSegmentManifestJSON(ctx, jsonReq)
+		})
+
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.FeatureGateKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			bs, err := json.Marshal(sessionutil.GetFeatureGate().View())
+			if err != nil {
+				return "", err
+			}
+			return string(bs), nil
+		})
+
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.SegmentCandidateKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			return s.getSegmentCandidatesJSON(ctx, jsonReq)
+		})
+
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.QuarantinedSegmentKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			return s.getQuarantinedSegmentsJSON(ctx, jsonReq)
+		})
+
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.IndexGCReportKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			return s.getIndexGCReportJSON()
+		})
+
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.ConfigDriftKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			return s.getConfigDriftJSON(ctx)
+		})
+
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.IndexCompletenessKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			return s.getIndexCompletenessJSON(ctx, jsonReq)
+		})
+
 	log.Ctx(s.ctx).Info("register metrics actions finished")
 }
 
@@ -1231,3 +1305,40 @@ func (s *Server) listLoadedSegments(ctx context.Context) ([]int64, error) {
 
 	return resp.SegmentIDs, nil
 }
+
+func (s *Server) refreshCollectionTarget(ctx context.Context, collectionID int64) error {
+	req := &querypb.LoadCollectionRequest{
+		CollectionID: collectionID,
+		Refresh:      true,
+	}
+	status, err := s.mixCoord.LoadCollection(ctx, req)
+	err = merr.CheckRPCCall(status, err)
+	// nothing to hand off if the collection is not loaded
+	if errors.Is(err, merr.ErrCollectionNotLoaded) {
+		return nil
+	}
+	return err
+}
+
+func (s *Server) getTimeTravelWatermark(ctx context.Context) (uint64, error) {
+	req, err := metricsinfo.ConstructGetMetricsRequest(map[string]interface{}{
+		metricsinfo.MetricTypeKey:                 metricsinfo.TimeTravelWatermarkKey,
+		metricsinfo.MetricRequestProcessInRoleKey: typeutil.QueryCoordRole,
+	})
+	if err != nil {
+		return 0, err
+	}
+	resp, err := s.mixCoord.GetMetrics(ctx, req)
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return 0, err
+	}
+	watermark := &metricsinfo.TimeTravelWatermark{}
+	if err := json.Unmarshal([]byte(resp.GetResponse()), watermark); err != nil {
+		return 0, err
+	}
+	if len(watermark.UnknownNodes) > 0 {
+		log.Ctx(ctx).RatedWarn(60, "the running requests on the querynodes are unknown, they are only protected by the drop tolerance",
+			zap.Int64s("nodes", watermark.UnknownNodes))
+	}
+	return watermark.Watermark, nil
+}
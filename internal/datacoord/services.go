@@ -273,6 +273,33 @@ func (s *Server) FlushAll(ctx context.Context, req *datapb.FlushAllRequest) (*da
 	}, nil
 }
 
+// FlushAndSeal seals all the growing segments of the collection and waits for them to be flushed,
+// it returns the barrier ts and the flushed segments, so that the backup tools could get a consistent cut.
+func (s *Server) FlushAndSeal(ctx context.Context, req *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	log.Info("receive flush and seal request")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.FlushAndSealResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if req.GetCollectionID() <= 0 {
+		return &datapb.FlushAndSealResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("collectionID is required for flush and seal")),
+		}, nil
+	}
+
+	resp, err := s.flushAndSeal(ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to flush and seal", zap.Error(err))
+		return &datapb.FlushAndSealResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return resp, nil
+}
+
 // AssignSegmentID applies for segment ids and make allocation for records.
 func (s *Server) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
 	log := log.Ctx(ctx)
@@ -783,6 +810,7 @@ func (s *Server) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtual
 	s.compactionInspector.removeTasksByChannel(channel)
 	metrics.DataCoordCheckpointUnixSeconds.DeleteLabelValues(fmt.Sprint(paramtable.GetNodeID()), channel)
 	s.meta.MarkChannelCheckpointDropped(ctx, channel)
+	s.channelHealth.Remove(channel)
 
 	// no compaction triggered in Drop procedure
 	return resp, nil
@@ -1088,6 +1116,14 @@ func (s *Server) GetChannelRecoveryInfo(ctx context.Context, req *datapb.GetChan
 	}
 	collectionID := funcutil.GetCollectionIDFromVChannel(req.GetVchannel())
 
+	// the segments marked to be re-flushed are reset before the positions are collected,
+	// so they're recovered as the unflushed segments of the vchannel.
+	if err := s.meta.startReflush(ctx, req.GetVchannel()); err != nil {
+		log.Warn("failed to start reflush of the vchannel", zap.Error(err))
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
 	channelInfo := s.handler.GetDataVChanPositions(&channelMeta{
 		Name:         req.GetVchannel(),
 		CollectionID: collectionID,
@@ -1100,7 +1136,8 @@ func (s *Server) GetChannelRecoveryInfo(ctx context.Context, req *datapb.GetChan
 	segmentsNotCreatedByStreaming := make([]*datapb.SegmentNotCreatedByStreaming, 0)
 	for _, segmentID := range channelInfo.GetUnflushedSegmentIds() {
 		segment := s.meta.GetSegment(ctx, segmentID)
-		if segment != nil && !segment.IsCreatedByStreaming {
+		// the reflushing segment is not managed by the streaming node anymore, so it's flushed in the same way.
+		if segment != nil && (!segment.IsCreatedByStreaming || segment.GetIsReflushing()) {
 			segmentsNotCreatedByStreaming = append(segmentsNotCreatedByStreaming, &datapb.SegmentNotCreatedByStreaming{
 				CollectionId: segment.CollectionID,
 				PartitionId:  segment.PartitionID,
@@ -1122,6 +1159,7 @@ func (s *Server) GetChannelRecoveryInfo(ctx context.Context, req *datapb.GetChan
 	resp.Info = channelInfo
 	resp.Schema = nil // schema is managed by streaming node itself now.
 	resp.SegmentsNotCreatedByStreaming = segmentsNotCreatedByStreaming
+	resp.ReflushingSegmentIds, resp.ReflushPosition = s.meta.getReflushPosition(ctx, req.GetVchannel())
 	return resp, nil
 }
 
@@ -1230,18 +1268,9 @@ func (s *Server) ShowConfigurations(ctx context.Context, req *internalpb.ShowCon
 		}, nil
 	}
 
-	configList := make([]*commonpb.KeyValuePair, 0)
-	for key, value := range Params.GetComponentConfigurations("datacoord", req.Pattern) {
-		configList = append(configList,
-			&commonpb.KeyValuePair{
-				Key:   key,
-				Value: value,
-			})
-	}
-
 	return &internalpb.ShowConfigurationsResponse{
 		Status:        merr.Success(),
-		Configuations: configList,
+		Configuations: componentutil.ShowConfigurations("datacoord", req),
 	}, nil
 }
 
@@ -1718,6 +1747,39 @@ func (s *Server) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmen
 	return merr.Status(err), nil
 }
 
+// UpdateSegmentStatslogs replaces the statslogs of a segment, it's called by the datanode
+// after the statslogs are rebuilt from the binlogs of the segment, e.g. the original ones are corrupted.
+func (s *Server) UpdateSegmentStatslogs(ctx context.Context, req *datapb.UpdateSegmentStatslogsRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	log := log.Ctx(ctx).With(
+		zap.Int64("nodeID", req.GetBase().GetSourceID()),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("segmentID", req.GetSegmentID()),
+		zap.String("channel", req.GetChannel()),
+	)
+
+	segment := s.meta.GetHealthySegment(ctx, req.GetSegmentID())
+	if segment == nil || segment.GetInsertChannel() != req.GetChannel() {
+		err := merr.WrapErrSegmentNotFound(req.GetSegmentID())
+		log.Warn("failed to update segment statslogs", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if err := binlog.CompressFieldBinlogs(req.GetStatslogs()); err != nil {
+		log.Warn("failed to compress statslogs", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if err := s.meta.UpdateSegmentsInfo(ctx, ReplaceStatslogsOperator(req.GetSegmentID(), req.GetStatslogs())); err != nil {
+		log.Warn("failed to update segment statslogs", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("segment statslogs updated",
+		zap.Strings("replaced", stringifyBinlogs(segment.GetStatslogs())),
+		zap.Strings("statslogs", stringifyBinlogs(req.GetStatslogs())))
+	return merr.Success(), nil
+}
+
 func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
 		return merr.Status(err), nil
@@ -1825,6 +1887,126 @@ func (s *Server) GcControl(ctx context.Context, request *datapb.GcControlRequest
 	return status, nil
 }
 
+// ListQuarantinedSegments lists the segments excluded from compaction and index building for unreadable logs.
+func (s *Server) ListQuarantinedSegments(ctx context.Context, req *datapb.ListQuarantinedSegmentsRequest) (*datapb.ListQuarantinedSegmentsResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ListQuarantinedSegmentsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	segments := s.meta.listQuarantinedSegments(ctx, req.GetCollectionID())
+	return &datapb.ListQuarantinedSegmentsResponse{
+		Status: merr.Success(),
+		Segments: lo.Map(segments, func(segment *SegmentInfo, _ int) *datapb.SegmentInfo {
+			// the logs are omitted to keep the response small
+			return &datapb.SegmentInfo{
+				ID:               segment.GetID(),
+				CollectionID:     segment.GetCollectionID(),
+				PartitionID:      segment.GetPartitionID(),
+				InsertChannel:    segment.GetInsertChannel(),
+				NumOfRows:        segment.GetNumOfRows(),
+				State:            segment.GetState(),
+				Level:            segment.GetLevel(),
+				QuarantineReason: segment.GetQuarantineReason(),
+				QuarantineTime:   segment.GetQuarantineTime(),
+			}
+		}),
+	}, nil
+}
+
+// OperateQuarantinedSegment validates, restores, repairs or drops a quarantined segment.
+func (s *Server) OperateQuarantinedSegment(ctx context.Context, req *datapb.OperateQuarantinedSegmentRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("segmentID", req.GetSegmentID()), zap.String("action", req.GetAction().String()))
+	log.Info("receive operate quarantined segment request")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := s.operateQuarantinedSegment(ctx, req.GetSegmentID(), req.GetAction()); err != nil {
+		log.Warn("failed to operate quarantined segment", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
+
+// RebuildStatslogs regenerates the statslogs of the flushed segments from their binlogs by the stats tasks,
+// the job is returned to track the progress by GetStatslogRebuildJob.
+func (s *Server) RebuildStatslogs(ctx context.Context, req *datapb.RebuildStatslogsRequest) (*datapb.RebuildStatslogsResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()), zap.Int64s("segmentIDs", req.GetSegmentIDs()))
+	log.Info("receive rebuild statslogs request")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.RebuildStatslogsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	job, err := s.rebuildStatslogs(ctx, req.GetCollectionID(), req.GetSegmentIDs())
+	if err != nil {
+		log.Warn("failed to rebuild statslogs", zap.Error(err))
+		return &datapb.RebuildStatslogsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return &datapb.RebuildStatslogsResponse{
+		Status: merr.Success(),
+		Job:    job,
+	}, nil
+}
+
+// GetStatslogRebuildJob gets the progress of the statslog rebuild job.
+func (s *Server) GetStatslogRebuildJob(ctx context.Context, req *datapb.GetStatslogRebuildJobRequest) (*datapb.GetStatslogRebuildJobResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.GetStatslogRebuildJobResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	job, err := s.refreshStatslogRebuildJob(ctx, req.GetJobID())
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to get statslog rebuild job", zap.Int64("jobID", req.GetJobID()), zap.Error(err))
+		return &datapb.GetStatslogRebuildJobResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return &datapb.GetStatslogRebuildJobResponse{
+		Status: merr.Success(),
+		Job:    job,
+	}, nil
+}
+
+// ReportChannelHealth records the health of the vchannel flowgraph reported by the streaming node.
+func (s *Server) ReportChannelHealth(ctx context.Context, req *datapb.ReportChannelHealthRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	log.Ctx(ctx).Info("receive report channel health request",
+		zap.String("vchannel", req.GetVchannel()),
+		zap.Int64("nodeID", req.GetBase().GetSourceID()),
+		zap.String("state", req.GetState().String()),
+		zap.Int64("failures", req.GetFailures()),
+		zap.String("reason", req.GetReason()))
+	s.channelHealth.Report(req)
+	return merr.Success(), nil
+}
+
+// ListBackgroundJobs lists the compactions, index builds, imports and gc runs of the datacoord.
+func (s *Server) ListBackgroundJobs(ctx context.Context, req *datapb.ListBackgroundJobsRequest) (*datapb.ListBackgroundJobsResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ListBackgroundJobsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	jobs := s.listBackgroundJobs(ctx, req.GetCollectionID())
+	if req.GetState() != "" {
+		jobs = lo.Filter(jobs, func(job *datapb.BackgroundJob, _ int) bool { return job.GetState() == req.GetState() })
+	}
+	return &datapb.ListBackgroundJobsResponse{
+		Status: merr.Success(),
+		Jobs:   jobs,
+	}, nil
+}
+
 func (s *Server) GetGcStatus(ctx context.Context) (*datapb.GetGcStatusResponse, error) {
 	status := s.garbageCollector.GetStatus()
 	var remainingSeconds int32
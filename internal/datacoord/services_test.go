@@ -661,6 +661,43 @@ func (s *ServerSuite) TestSaveBinlogPath_NormalCase() {
 	s.EqualValues(int64(3), fieldBinlogs.GetBinlogs()[2].GetLogID())
 }
 
+func (s *ServerSuite) TestUpdateSegmentStatslogs() {
+	s.testServer.meta.AddCollection(&collectionInfo{ID: 0})
+	err := s.testServer.meta.AddSegment(context.TODO(), NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            1,
+		InsertChannel: "ch1",
+		State:         commonpb.SegmentState_Flushed,
+		Statslogs: []*datapb.FieldBinlog{{
+			FieldID: 100,
+			Binlogs: []*datapb.Binlog{{LogID: 10}, {LogID: 11}},
+		}},
+	}))
+	s.Require().NoError(err)
+
+	ctx := context.Background()
+	req := &datapb.UpdateSegmentStatslogsRequest{
+		SegmentID: 1,
+		Channel:   "ch2",
+		Statslogs: []*datapb.FieldBinlog{{
+			FieldID: 100,
+			Binlogs: []*datapb.Binlog{{LogPath: "/by-dev/stats_log/0/0/1/100/1", EntriesNum: 5}},
+		}},
+	}
+	resp, err := s.testServer.UpdateSegmentStatslogs(ctx, req)
+	s.NoError(err)
+	s.ErrorIs(merr.Error(resp), merr.ErrSegmentNotFound)
+
+	req.Channel = "ch1"
+	resp, err = s.testServer.UpdateSegmentStatslogs(ctx, req)
+	s.NoError(merr.CheckRPCCall(resp, err))
+
+	segment := s.testServer.meta.GetHealthySegment(ctx, 1)
+	s.Require().Len(segment.GetStatslogs(), 1)
+	s.Require().Len(segment.GetStatslogs()[0].GetBinlogs(), 1)
+	s.EqualValues(1, segment.GetStatslogs()[0].GetBinlogs()[0].GetLogID())
+	s.Empty(segment.GetStatslogs()[0].GetBinlogs()[0].GetLogPath())
+}
+
 func (s *ServerSuite) TestFlush_NormalCase() {
 	req := &datapb.FlushRequest{
 		Base: &commonpb.MsgBase{
@@ -0,0 +1,321 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/lock"
+	"github.com/milvus-io/milvus/pkg/v2/util/logutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
+)
+
+// statslogRebuildMeta keeps the statslog rebuild jobs, the jobs are persisted
+// so the progress could be tracked after the stats tasks are recycled or datacoord restarts.
+type statslogRebuildMeta struct {
+	sync.RWMutex
+
+	ctx     context.Context
+	catalog metastore.DataCoordCatalog
+
+	// keyLock serializes the refresh of the same job
+	keyLock *lock.KeyLock[UniqueID]
+	// jobID -> job
+	jobs map[int64]*datapb.StatslogRebuildJob
+}
+
+func newStatslogRebuildMeta(ctx context.Context, catalog metastore.DataCoordCatalog) (*statslogRebuildMeta, error) {
+	m := &statslogRebuildMeta{
+		ctx:     ctx,
+		catalog: catalog,
+		keyLock: lock.NewKeyLock[UniqueID](),
+		jobs:    make(map[int64]*datapb.StatslogRebuildJob),
+	}
+	record := timerecord.NewTimeRecorder("statslogRebuildMeta-reloadFromKV")
+	jobs, err := catalog.ListStatslogRebuildJobs(ctx)
+	if err != nil {
+		log.Warn("statslogRebuildMeta reloadFromKV load jobs failed", zap.Error(err))
+		return nil, err
+	}
+	for _, job := range jobs {
+		m.jobs[job.GetJobID()] = job
+	}
+	log.Info("statslogRebuildMeta reloadFromKV done", zap.Duration("duration", record.ElapseSpan()))
+	return m, nil
+}
+
+func (m *statslogRebuildMeta) SaveJob(job *datapb.StatslogRebuildJob) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.catalog.SaveStatslogRebuildJob(m.ctx, job); err != nil {
+		return err
+	}
+	m.jobs[job.GetJobID()] = job
+	return nil
+}
+
+func (m *statslogRebuildMeta) GetJob(jobID int64) *datapb.StatslogRebuildJob {
+	m.RLock()
+	defer m.RUnlock()
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(job).(*datapb.StatslogRebuildJob)
+}
+
+func (m *statslogRebuildMeta) GetRunningJobIDs() []int64 {
+	m.RLock()
+	defer m.RUnlock()
+	jobIDs := make([]int64, 0)
+	for jobID, job := range m.jobs {
+		if job.GetState() == indexpb.JobState_JobStateInProgress {
+			jobIDs = append(jobIDs, jobID)
+		}
+	}
+	return jobIDs
+}
+
+// DropJob drops the job, it's a no-op if the job doesn't exist.
+func (m *statslogRebuildMeta) DropJob(jobID int64) error {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.jobs[jobID]; !ok {
+		return nil
+	}
+	if err := m.catalog.DropStatslogRebuildJob(m.ctx, jobID); err != nil {
+		return err
+	}
+	delete(m.jobs, jobID)
+	return nil
+}
+
+// GetExpiredJobIDs returns the finished and failed jobs which end before the retention period.
+func (m *statslogRebuildMeta) GetExpiredJobIDs(retention time.Duration) []int64 {
+	m.RLock()
+	defer m.RUnlock()
+	jobIDs := make([]int64, 0)
+	for jobID, job := range m.jobs {
+		if job.GetState() != indexpb.JobState_JobStateInProgress &&
+			time.Since(time.Unix(job.GetEndTime(), 0)) > retention {
+			jobIDs = append(jobIDs, jobID)
+		}
+	}
+	return jobIDs
+}
+
+// isStatslogRebuildable returns whether the statslogs of the segment could be regenerated from its binlogs,
+// the binlogs are read by a stats task, so the segment must be flushed and not being compacted.
+func isStatslogRebuildable(segment *SegmentInfo) bool {
+	return isSegmentHealthy(segment) &&
+		isFlushed(segment) &&
+		segment.GetLevel() != datapb.SegmentLevel_L0 &&
+		!segment.GetIsImporting() &&
+		!segment.GetIsInvisible() &&
+		!segment.isCompacting &&
+		!segment.isQuarantined()
+}
+
+// rebuildStatslogs submits one stats task for each segment to regenerate its statslogs (bloom filter, min/max)
+// by the current settings, the binlogs are kept as is, so the segment keeps its ID and indexes.
+// All the flushed segments of the collection are selected if segmentIDs is empty.
+func (s *Server) rebuildStatslogs(ctx context.Context, collectionID int64, segmentIDs []int64) (*datapb.StatslogRebuildJob, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	if collectionID <= 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("collectionID is required for statslog rebuild")
+	}
+
+	var segments []*SegmentInfo
+	skipped := make([]int64, 0)
+	if len(segmentIDs) == 0 {
+		segments = s.meta.SelectSegments(ctx, WithCollection(collectionID), SegmentFilterFunc(isStatslogRebuildable))
+	} else {
+		for _, segmentID := range segmentIDs {
+			segment := s.meta.GetHealthySegment(ctx, segmentID)
+			if segment == nil || segment.GetCollectionID() != collectionID || !isStatslogRebuildable(segment) {
+				skipped = append(skipped, segmentID)
+				continue
+			}
+			segments = append(segments, segment)
+		}
+	}
+	if len(segments) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("no flushed segment of collection %d to rebuild statslogs", collectionID)
+	}
+
+	jobID, err := s.allocator.AllocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	job := &datapb.StatslogRebuildJob{
+		JobID:        jobID,
+		CollectionID: collectionID,
+		State:        indexpb.JobState_JobStateInProgress,
+		StartTime:    time.Now().Unix(),
+	}
+	for _, segment := range segments {
+		// the finished task of a former job is dropped, so the segment could be rebuilt again
+		if task := s.meta.statsTaskMeta.GetStatsTaskBySegmentID(segment.GetID(), indexpb.StatsSubJob_StatslogJob); task != nil {
+			if !task.GetCanRecycle() {
+				skipped = append(skipped, segment.GetID())
+				continue
+			}
+			if err := s.meta.statsTaskMeta.DropStatsTask(ctx, task.GetTaskID()); err != nil {
+				skipped = append(skipped, segment.GetID())
+				continue
+			}
+		}
+		job.SegmentIDs = append(job.SegmentIDs, segment.GetID())
+	}
+	if len(job.GetSegmentIDs()) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("the statslogs of all the segments are being rebuilt")
+	}
+	job.SkippedSegmentIDs = skipped
+
+	// save the job before submitting the tasks, so the tasks are always tracked by the job,
+	// the job is locked until all the tasks are submitted, otherwise the unsubmitted ones are counted as failed.
+	s.statslogRebuildMeta.keyLock.Lock(jobID)
+	if err := s.statslogRebuildMeta.SaveJob(job); err != nil {
+		s.statslogRebuildMeta.keyLock.Unlock(jobID)
+		return nil, err
+	}
+	for _, segmentID := range job.GetSegmentIDs() {
+		if err := s.statsInspector.SubmitStatsTask(segmentID, segmentID, indexpb.StatsSubJob_StatslogJob, false); err != nil {
+			log.Warn("failed to submit statslog rebuild task", zap.Int64("segmentID", segmentID), zap.Error(err))
+		}
+	}
+	s.statslogRebuildMeta.keyLock.Unlock(jobID)
+	log.Info("statslog rebuild triggered",
+		zap.Int64("jobID", jobID),
+		zap.Int64s("segmentIDs", job.GetSegmentIDs()),
+		zap.Int64s("skipped", skipped))
+	return s.refreshStatslogRebuildJob(ctx, jobID)
+}
+
+// refreshStatslogRebuildJob collects the results of the finished stats tasks into the job,
+// the collected tasks are recycled, and the job is done once the tasks of all the segments are collected.
+func (s *Server) refreshStatslogRebuildJob(ctx context.Context, jobID int64) (*datapb.StatslogRebuildJob, error) {
+	s.statslogRebuildMeta.keyLock.Lock(jobID)
+	defer s.statslogRebuildMeta.keyLock.Unlock(jobID)
+
+	job := s.statslogRebuildMeta.GetJob(jobID)
+	if job == nil {
+		return nil, merr.WrapErrParameterInvalidMsg("statslog rebuild job %d not found", jobID)
+	}
+	if job.GetState() != indexpb.JobState_JobStateInProgress {
+		return job, nil
+	}
+
+	done := make(map[int64]struct{}, len(job.GetFinishedSegmentIDs())+len(job.GetFailedSegmentIDs()))
+	for _, segmentID := range job.GetFinishedSegmentIDs() {
+		done[segmentID] = struct{}{}
+	}
+	for _, segmentID := range job.GetFailedSegmentIDs() {
+		done[segmentID] = struct{}{}
+	}
+	collected := make([]int64, 0)
+	for _, segmentID := range job.GetSegmentIDs() {
+		if _, ok := done[segmentID]; ok {
+			continue
+		}
+		task := s.meta.statsTaskMeta.GetStatsTaskBySegmentID(segmentID, indexpb.StatsSubJob_StatslogJob)
+		switch {
+		case task == nil:
+			// the task is dropped if the segment is dropped or compacted before it's scheduled
+			job.FailedSegmentIDs = append(job.FailedSegmentIDs, segmentID)
+		case task.GetState() == indexpb.JobState_JobStateFinished:
+			job.FinishedSegmentIDs = append(job.FinishedSegmentIDs, segmentID)
+			collected = append(collected, task.GetTaskID())
+		case task.GetState() == indexpb.JobState_JobStateFailed:
+			job.FailedSegmentIDs = append(job.FailedSegmentIDs, segmentID)
+			job.FailReason = task.GetFailReason()
+			collected = append(collected, task.GetTaskID())
+		default:
+			continue
+		}
+		done[segmentID] = struct{}{}
+	}
+	if len(done) == len(job.GetSegmentIDs()) {
+		job.State = indexpb.JobState_JobStateFinished
+		if len(job.GetFailedSegmentIDs()) > 0 {
+			job.State = indexpb.JobState_JobStateFailed
+		}
+		job.EndTime = time.Now().Unix()
+	}
+	if err := s.statslogRebuildMeta.SaveJob(job); err != nil {
+		return nil, err
+	}
+	for _, taskID := range collected {
+		if err := s.meta.statsTaskMeta.MarkTaskCanRecycle(taskID); err != nil {
+			log.Ctx(ctx).Warn("failed to recycle statslog rebuild task", zap.Int64("jobID", jobID),
+				zap.Int64("taskID", taskID), zap.Error(err))
+		}
+	}
+	return proto.Clone(job).(*datapb.StatslogRebuildJob), nil
+}
+
+// cleanupStatslogRebuildJobs drops the finished and failed statslog rebuild jobs beyond the retention period.
+func (s *Server) cleanupStatslogRebuildJobs(ctx context.Context) {
+	retention := Params.DataCoordCfg.StatslogRebuildJobRetention.GetAsDuration(time.Second)
+	for _, jobID := range s.statslogRebuildMeta.GetExpiredJobIDs(retention) {
+		s.statslogRebuildMeta.keyLock.Lock(jobID)
+		err := s.statslogRebuildMeta.DropJob(jobID)
+		s.statslogRebuildMeta.keyLock.Unlock(jobID)
+		if err != nil {
+			log.Ctx(ctx).Warn("failed to drop expired statslog rebuild job", zap.Int64("jobID", jobID), zap.Error(err))
+			continue
+		}
+		log.Ctx(ctx).Info("expired statslog rebuild job dropped", zap.Int64("jobID", jobID))
+	}
+}
+
+// startStatslogRebuildChecker collects the results of the running statslog rebuild jobs in time,
+// so the stats tasks are recycled even if nobody gets the progress,
+// and drops the finished and failed jobs beyond the retention period.
+func (s *Server) startStatslogRebuildChecker(ctx context.Context) {
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		ticker := time.NewTicker(Params.DataCoordCfg.TaskCheckInterval.GetAsDuration(time.Second))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Ctx(s.ctx).Info("statslog rebuild checker shutdown")
+				return
+			case <-ticker.C:
+				for _, jobID := range s.statslogRebuildMeta.GetRunningJobIDs() {
+					if _, err := s.refreshStatslogRebuildJob(ctx, jobID); err != nil {
+						log.Ctx(ctx).Warn("failed to refresh statslog rebuild job", zap.Int64("jobID", jobID), zap.Error(err))
+					}
+				}
+				s.cleanupStatslogRebuildJobs(ctx)
+			}
+		}
+	}()
+}
@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/internal/datacoord/task"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestServer_rebuildStatslogs(t *testing.T) {
+	ctx := context.Background()
+	kv := NewMetaMemoryKV()
+	catalog := datacoord.NewCatalog(kv, "", "")
+	mockBroker := broker.NewMockBroker(t)
+	mockBroker.EXPECT().ShowCollectionIDs(mock.Anything).Return(nil, nil)
+	meta, err := newMeta(ctx, catalog, nil, mockBroker)
+	require.NoError(t, err)
+	rebuildMeta, err := newStatslogRebuildMeta(ctx, catalog)
+	require.NoError(t, err)
+
+	addSegment := func(id int64, state commonpb.SegmentState) {
+		err := meta.AddSegment(ctx, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  1,
+			PartitionID:   2,
+			InsertChannel: "ch1",
+			State:         state,
+			NumOfRows:     100,
+		}))
+		require.NoError(t, err)
+	}
+	addSegment(10, commonpb.SegmentState_Flushed)
+	addSegment(11, commonpb.SegmentState_Flushed)
+	addSegment(12, commonpb.SegmentState_Growing)
+
+	scheduler := task.NewMockGlobalScheduler(t)
+	scheduler.EXPECT().Enqueue(mock.Anything).Return()
+	alloc := newMockAllocator(t)
+	s := &Server{
+		meta:                meta,
+		allocator:           alloc,
+		statsInspector:      newStatsInspector(ctx, meta, scheduler, alloc, nil, nil, nil),
+		statslogRebuildMeta: rebuildMeta,
+	}
+
+	// the growing segment is skipped
+	job, err := s.rebuildStatslogs(ctx, 1, []int64{10, 11, 12})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int64{10, 11}, job.GetSegmentIDs())
+	assert.ElementsMatch(t, []int64{12}, job.GetSkippedSegmentIDs())
+	assert.Equal(t, indexpb.JobState_JobStateInProgress, job.GetState())
+
+	// the segments being rebuilt are skipped
+	_, err = s.rebuildStatslogs(ctx, 1, []int64{10})
+	assert.Error(t, err)
+	_, err = s.rebuildStatslogs(ctx, 0, nil)
+	assert.Error(t, err)
+
+	task10 := meta.statsTaskMeta.GetStatsTaskBySegmentID(10, indexpb.StatsSubJob_StatslogJob)
+	require.NotNil(t, task10)
+	assert.EqualValues(t, 10, task10.GetTargetSegmentID())
+	task11 := meta.statsTaskMeta.GetStatsTaskBySegmentID(11, indexpb.StatsSubJob_StatslogJob)
+	require.NotNil(t, task11)
+
+	require.NoError(t, meta.statsTaskMeta.UpdateTaskState(task10.GetTaskID(), indexpb.JobState_JobStateFinished, ""))
+	job, err = s.refreshStatslogRebuildJob(ctx, job.GetJobID())
+	require.NoError(t, err)
+	assert.Equal(t, indexpb.JobState_JobStateInProgress, job.GetState())
+	assert.ElementsMatch(t, []int64{10}, job.GetFinishedSegmentIDs())
+	assert.True(t, meta.statsTaskMeta.GetStatsTask(task10.GetTaskID()).GetCanRecycle())
+
+	require.NoError(t, meta.statsTaskMeta.UpdateTaskState(task11.GetTaskID(), indexpb.JobState_JobStateFailed, "mock error"))
+	job, err = s.refreshStatslogRebuildJob(ctx, job.GetJobID())
+	require.NoError(t, err)
+	assert.Equal(t, indexpb.JobState_JobStateFailed, job.GetState())
+	assert.ElementsMatch(t, []int64{10}, job.GetFinishedSegmentIDs())
+	assert.ElementsMatch(t, []int64{11}, job.GetFailedSegmentIDs())
+	assert.Equal(t, "mock error", job.GetFailReason())
+	assert.Empty(t, rebuildMeta.GetRunningJobIDs())
+
+	t.Run("persisted", func(t *testing.T) {
+		// the job is kept after the stats tasks are recycled
+		for _, taskID := range meta.statsTaskMeta.CanCleanedTasks() {
+			require.NoError(t, meta.statsTaskMeta.DropStatsTask(ctx, taskID))
+		}
+		reloaded, err := newStatslogRebuildMeta(ctx, datacoord.NewCatalog(kv, "", ""))
+		require.NoError(t, err)
+		reloadedJob := reloaded.GetJob(job.GetJobID())
+		require.NotNil(t, reloadedJob)
+		assert.Equal(t, indexpb.JobState_JobStateFailed, reloadedJob.GetState())
+		assert.ElementsMatch(t, []int64{10}, reloadedJob.GetFinishedSegmentIDs())
+
+		_, err = s.refreshStatslogRebuildJob(ctx, job.GetJobID()+100)
+		assert.Error(t, err)
+	})
+
+	t.Run("rebuild again", func(t *testing.T) {
+		job, err := s.rebuildStatslogs(ctx, 1, nil)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int64{10, 11}, job.GetSegmentIDs())
+
+		// the task is dropped if the segment is dropped before it's scheduled
+		task10 := meta.statsTaskMeta.GetStatsTaskBySegmentID(10, indexpb.StatsSubJob_StatslogJob)
+		require.NoError(t, meta.statsTaskMeta.DropStatsTask(ctx, task10.GetTaskID()))
+		job, err = s.refreshStatslogRebuildJob(ctx, job.GetJobID())
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int64{10}, job.GetFailedSegmentIDs())
+		assert.Equal(t, indexpb.JobState_JobStateInProgress, job.GetState())
+	})
+	t.Run("retention", func(t *testing.T) {
+		// the failed job is kept within the retention period
+		s.cleanupStatslogRebuildJobs(ctx)
+		assert.NotNil(t, rebuildMeta.GetJob(job.GetJobID()))
+
+		paramtable.Get().Save(Params.DataCoordCfg.StatslogRebuildJobRetention.Key, "0")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.StatslogRebuildJobRetention.Key)
+		s.cleanupStatslogRebuildJobs(ctx)
+		assert.Nil(t, rebuildMeta.GetJob(job.GetJobID()))
+		// the running job is kept
+		assert.Len(t, rebuildMeta.GetRunningJobIDs(), 1)
+
+		reloaded, err := newStatslogRebuildMeta(ctx, datacoord.NewCatalog(kv, "", ""))
+		require.NoError(t, err)
+		assert.Nil(t, reloaded.GetJob(job.GetJobID()))
+		assert.Len(t, reloaded.GetRunningJobIDs(), 1)
+	})
+}
@@ -56,6 +56,17 @@ type globalTaskScheduler struct {
 	execPool     *conc.Pool[struct{}]
 	checkPool    *conc.Pool[struct{}]
 	cluster      session.Cluster
+	// controller limits the parallelism of the tasks on each worker, nil if not limited
+	controller *ParallelismController
+}
+
+type SchedulerOption func(s *globalTaskScheduler)
+
+// WithParallelismController limits the parallelism of the tasks on each worker by the controller.
+func WithParallelismController(controller *ParallelismController) SchedulerOption {
+	return func(s *globalTaskScheduler) {
+		s.controller = controller
+	}
 }
 
 func (s *globalTaskScheduler) Enqueue(task Task) {
@@ -81,6 +92,7 @@ func (s *globalTaskScheduler) AbortAndRemoveTask(taskID int64) {
 	defer s.mu.Unlock(taskID)
 	if task, ok := s.runningTasks.GetAndRemove(taskID); ok {
 		task.DropTaskOnWorker(s.cluster)
+		s.releaseParallelism(taskID, 0)
 	}
 	if task := s.pendingTasks.Get(taskID); task != nil {
 		task.DropTaskOnWorker(s.cluster)
@@ -162,7 +174,11 @@ func (s *globalTaskScheduler) schedule() {
 	}
 	nodeSlots := s.cluster.QuerySlot()
 	log.Ctx(s.ctx).Info("scheduling pending tasks...", zap.Int("num", pendingNum), zap.Any("nodeSlots", nodeSlots))
+	if s.controller != nil {
+		s.controller.adjust(nodeSlots, s.countPendingTasks(s.controller.taskType))
+	}
 
+	throttled := make([]Task, 0)
 	futures := make([]*conc.Future[struct{}], 0)
 	for {
 		task := s.pendingTasks.Pop()
@@ -170,11 +186,23 @@ func (s *globalTaskScheduler) schedule() {
 			break
 		}
 		taskSlot := task.GetTaskSlot()
-		nodeID := s.pickNode(nodeSlots, taskSlot)
+		candidates := nodeSlots
+		if s.controller != nil {
+			candidates = s.controller.filterNodes(task, nodeSlots)
+		}
+		nodeID := s.pickNode(candidates, taskSlot)
 		if nodeID == NullNodeID {
+			if len(candidates) < len(nodeSlots) {
+				// the task is throttled by the parallelism limit, the other types of tasks could still be scheduled
+				throttled = append(throttled, task)
+				continue
+			}
 			s.pendingTasks.Push(task)
 			break
 		}
+		if s.controller != nil {
+			s.controller.acquire(task, nodeID)
+		}
 		future := s.execPool.Submit(func() (struct{}, error) {
 			s.mu.RLock(task.GetTaskID())
 			defer s.mu.RUnlock(task.GetTaskID())
@@ -183,17 +211,41 @@ func (s *globalTaskScheduler) schedule() {
 				task.CreateTaskOnWorker(nodeID, s.cluster)
 				switch task.GetTaskState() {
 				case taskcommon.Init, taskcommon.Retry:
+					s.releaseParallelism(task.GetTaskID(), 0)
 					s.pendingTasks.Push(task)
 				case taskcommon.InProgress:
 					task.SetTaskTime(taskcommon.TimeStart, time.Now())
 					s.runningTasks.Insert(task.GetTaskID(), task)
+				default:
+					s.releaseParallelism(task.GetTaskID(), 0)
 				}
+			} else {
+				s.releaseParallelism(task.GetTaskID(), 0)
 			}
 			return struct{}{}, nil
 		})
 		futures = append(futures, future)
 	}
 	_ = conc.AwaitAll(futures...)
+	for _, task := range throttled {
+		s.pendingTasks.Push(task)
+	}
+}
+
+func (s *globalTaskScheduler) countPendingTasks(taskType taskcommon.Type) int {
+	num := 0
+	for _, taskID := range s.pendingTasks.TaskIDs() {
+		if task := s.pendingTasks.Get(taskID); task != nil && task.GetTaskType() == taskType {
+			num++
+		}
+	}
+	return num
+}
+
+func (s *globalTaskScheduler) releaseParallelism(taskID int64, duration time.Duration) {
+	if s.controller != nil {
+		s.controller.release(taskID, duration)
+	}
 }
 
 func (s *globalTaskScheduler) check() {
@@ -212,13 +264,20 @@ func (s *globalTaskScheduler) check() {
 			switch task.GetTaskState() {
 			case taskcommon.None:
 				s.runningTasks.Remove(task.GetTaskID())
+				s.releaseParallelism(task.GetTaskID(), 0)
 			case taskcommon.Init, taskcommon.Retry:
 				s.runningTasks.Remove(task.GetTaskID())
+				s.releaseParallelism(task.GetTaskID(), 0)
 				s.pendingTasks.Push(task)
 			case taskcommon.Finished, taskcommon.Failed:
 				task.SetTaskTime(taskcommon.TimeEnd, time.Now())
 				task.DropTaskOnWorker(s.cluster)
 				s.runningTasks.Remove(task.GetTaskID())
+				var duration time.Duration
+				if task.GetTaskState() == taskcommon.Finished {
+					duration = task.GetTaskTime(taskcommon.TimeEnd).Sub(task.GetTaskTime(taskcommon.TimeStart))
+				}
+				s.releaseParallelism(task.GetTaskID(), duration)
 			}
 			return struct{}{}, nil
 		})
@@ -313,11 +372,11 @@ func (s *globalTaskScheduler) updateTaskTimeMetrics() {
 	}
 }
 
-func NewGlobalTaskScheduler(ctx context.Context, cluster session.Cluster) GlobalScheduler {
+func NewGlobalTaskScheduler(ctx context.Context, cluster session.Cluster, opts ...SchedulerOption) GlobalScheduler {
 	execPool := conc.NewPool[struct{}](128)
 	checkPool := conc.NewPool[struct{}](128)
 	ctx1, cancel := context.WithCancel(ctx)
-	return &globalTaskScheduler{
+	s := &globalTaskScheduler{
 		ctx:          ctx1,
 		cancel:       cancel,
 		wg:           sync.WaitGroup{},
@@ -328,4 +387,8 @@ func NewGlobalTaskScheduler(ctx context.Context, cluster session.Cluster) Global
 		checkPool:    checkPool,
 		cluster:      cluster,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
@@ -0,0 +1,218 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/datacoord/session"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/taskcommon"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// the weight of the latest finished task in the moving average of task duration
+const durationSmoothingFactor = 0.3
+
+// ChannelTask is implemented by the tasks working on a vchannel, e.g. the compaction tasks.
+// The ingest lag of the vchannel is taken as the feedback of the worker running the task.
+type ChannelTask interface {
+	GetTaskChannel() string
+}
+
+// ParallelismController limits the number of concurrent tasks of a type on each worker.
+// The limit is adjusted by the feedback of the workers, it's increased by one while the tasks
+// finish in time and more tasks are queued, and halved once the tasks get slow or the ingest
+// of the vchannels the worker is running tasks on lags behind.
+type ParallelismController struct {
+	taskType taskcommon.Type
+	// ingestLags returns how far the ingest of each vchannel lags behind, nil if unknown
+	ingestLags func() map[string]time.Duration
+
+	mu         sync.Mutex
+	nodes      map[int64]*nodeParallelism
+	runningOn  map[int64]runningTask // taskID -> the running task
+	lastAdjust time.Time
+}
+
+type runningTask struct {
+	nodeID  int64
+	channel string
+}
+
+type nodeParallelism struct {
+	limit   int
+	running int
+	// the moving average duration of the finished tasks, and the number of them since last adjustment
+	avgDuration time.Duration
+	finished    int
+}
+
+func NewParallelismController(taskType taskcommon.Type, ingestLags func() map[string]time.Duration) *ParallelismController {
+	return &ParallelismController{
+		taskType:   taskType,
+		ingestLags: ingestLags,
+		nodes:      make(map[int64]*nodeParallelism),
+		runningOn:  make(map[int64]runningTask),
+	}
+}
+
+func (c *ParallelismController) enabled(task Task) bool {
+	return task.GetTaskType() == c.taskType && paramtable.Get().DataCoordCfg.CompactionParallelismAutoTune.GetAsBool()
+}
+
+func (c *ParallelismController) limitRange() (int, int) {
+	params := &paramtable.Get().DataCoordCfg
+	minLimit := params.CompactionMinParallelTasks.GetAsInt()
+	return minLimit, max(minLimit, params.CompactionMaxParallelTasksPerNode.GetAsInt())
+}
+
+func (c *ParallelismController) getOrCreateNode(nodeID int64) *nodeParallelism {
+	n, ok := c.nodes[nodeID]
+	if !ok {
+		minLimit, maxLimit := c.limitRange()
+		n = &nodeParallelism{limit: (minLimit + maxLimit) / 2}
+		c.nodes[nodeID] = n
+		metrics.TaskParallelismLimit.WithLabelValues(fmt.Sprint(nodeID), string(c.taskType)).Set(float64(n.limit))
+	}
+	return n
+}
+
+// filterNodes returns the workers which could accept the task under the parallelism limit.
+func (c *ParallelismController) filterNodes(task Task, workerSlots map[int64]*session.WorkerSlots) map[int64]*session.WorkerSlots {
+	if !c.enabled(task) {
+		return workerSlots
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	candidates := make(map[int64]*session.WorkerSlots, len(workerSlots))
+	for nodeID, ws := range workerSlots {
+		if n := c.getOrCreateNode(nodeID); n.running < n.limit {
+			candidates[nodeID] = ws
+		}
+	}
+	return candidates
+}
+
+// acquire counts the task as running on the worker.
+func (c *ParallelismController) acquire(task Task, nodeID int64) {
+	if !c.enabled(task) {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.runningOn[task.GetTaskID()]; ok {
+		return
+	}
+	running := runningTask{nodeID: nodeID}
+	if channelTask, ok := task.(ChannelTask); ok {
+		running.channel = channelTask.GetTaskChannel()
+	}
+	c.runningOn[task.GetTaskID()] = running
+	c.getOrCreateNode(nodeID).running++
+}
+
+// release stops counting the task as running, the duration of the task is taken as feedback if it's positive.
+func (c *ParallelismController) release(taskID int64, duration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	running, ok := c.runningOn[taskID]
+	if !ok {
+		return
+	}
+	delete(c.runningOn, taskID)
+	n := c.getOrCreateNode(running.nodeID)
+	n.running--
+	if duration > 0 {
+		if n.avgDuration == 0 {
+			n.avgDuration = duration
+		} else {
+			n.avgDuration = time.Duration(durationSmoothingFactor*float64(duration) + (1-durationSmoothingFactor)*float64(n.avgDuration))
+		}
+		n.finished++
+	}
+}
+
+// adjust updates the limit of each worker by the feedback collected since last adjustment,
+// pending is the number of queued tasks of the type.
+func (c *ParallelismController) adjust(workerSlots map[int64]*session.WorkerSlots, pending int) {
+	params := &paramtable.Get().DataCoordCfg
+	if !params.CompactionParallelismAutoTune.GetAsBool() ||
+		time.Since(c.lastAdjust) < params.CompactionParallelismAdjustInterval.GetAsDuration(time.Second) {
+		return
+	}
+	var ingestLags map[string]time.Duration
+	if c.ingestLags != nil {
+		ingestLags = c.ingestLags()
+	}
+	targetDuration := params.CompactionTargetPlanDuration.GetAsDuration(time.Second)
+	maxIngestLag := params.CompactionMaxIngestLag.GetAsDuration(time.Second)
+	minLimit, maxLimit := c.limitRange()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastAdjust = time.Now()
+	// the ingest lag of a worker is the max lag of the vchannels it's running tasks on
+	nodeIngestLags := make(map[int64]time.Duration)
+	for _, running := range c.runningOn {
+		if lag := ingestLags[running.channel]; lag > nodeIngestLags[running.nodeID] {
+			nodeIngestLags[running.nodeID] = lag
+		}
+	}
+	for nodeID, n := range c.nodes {
+		ws, ok := workerSlots[nodeID]
+		if !ok && n.running == 0 {
+			delete(c.nodes, nodeID)
+			metrics.TaskParallelismLimit.DeleteLabelValues(fmt.Sprint(nodeID), string(c.taskType))
+			continue
+		}
+
+		limit := n.limit
+		ingestLag := nodeIngestLags[nodeID]
+		slowdown := ingestLag > maxIngestLag || n.avgDuration > targetDuration
+		switch {
+		case slowdown:
+			limit = n.limit / 2
+		case n.finished > 0 && pending > 0 && n.running >= n.limit && ok && ws.AvailableSlots > 0:
+			limit = n.limit + 1
+		}
+		limit = min(max(limit, minLimit), maxLimit)
+		if limit != n.limit {
+			log.Info("adjust task parallelism of worker",
+				zap.Int64("nodeID", nodeID),
+				zap.String("taskType", string(c.taskType)),
+				zap.Int("oldLimit", n.limit),
+				zap.Int("newLimit", limit),
+				zap.Int("running", n.running),
+				zap.Int("pending", pending),
+				zap.Duration("avgDuration", n.avgDuration),
+				zap.Duration("ingestLag", ingestLag))
+			n.limit = limit
+			metrics.TaskParallelismLimit.WithLabelValues(fmt.Sprint(nodeID), string(c.taskType)).Set(float64(limit))
+		}
+		if slowdown {
+			// wait for the feedback of the tasks under the new limit
+			n.avgDuration = 0
+		}
+		n.finished = 0
+	}
+}
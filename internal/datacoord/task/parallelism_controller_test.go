@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/datacoord/session"
+	taskcommon "github.com/milvus-io/milvus/pkg/v2/taskcommon"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type channelTask struct {
+	*MockTask
+	channel string
+}
+
+func (t *channelTask) GetTaskChannel() string {
+	return t.channel
+}
+
+func TestParallelismController(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.DataCoordCfg.CompactionParallelismAutoTune.Key, "true")
+	params.Save(params.DataCoordCfg.CompactionMinParallelTasks.Key, "1")
+	params.Save(params.DataCoordCfg.CompactionMaxParallelTasksPerNode.Key, "4")
+	defer params.Reset(params.DataCoordCfg.CompactionParallelismAutoTune.Key)
+	defer params.Reset(params.DataCoordCfg.CompactionMinParallelTasks.Key)
+	defer params.Reset(params.DataCoordCfg.CompactionMaxParallelTasksPerNode.Key)
+
+	ingestLags := make(map[string]time.Duration)
+	c := NewParallelismController(taskcommon.Compaction, func() map[string]time.Duration { return ingestLags })
+	adjust := func(workerSlots map[int64]*session.WorkerSlots, pending int) {
+		c.lastAdjust = time.Time{}
+		c.adjust(workerSlots, pending)
+	}
+	newTask := func(id int64, taskType taskcommon.Type) *MockTask {
+		task := NewMockTask(t)
+		task.EXPECT().GetTaskID().Return(id).Maybe()
+		task.EXPECT().GetTaskType().Return(taskType).Maybe()
+		return task
+	}
+	workerSlots := map[int64]*session.WorkerSlots{
+		1: {NodeID: 1, AvailableSlots: 10},
+		2: {NodeID: 2, AvailableSlots: 10},
+	}
+
+	// the other types of tasks are not limited
+	assert.Len(t, c.filterNodes(newTask(100, taskcommon.Index), workerSlots), 2)
+
+	// the initial limit is 2
+	for i := int64(1); i <= 2; i++ {
+		task := newTask(i, taskcommon.Compaction)
+		assert.Contains(t, c.filterNodes(task, workerSlots), int64(1))
+		c.acquire(task, 1)
+	}
+	candidates := c.filterNodes(newTask(3, taskcommon.Compaction), workerSlots)
+	assert.Len(t, candidates, 1)
+	assert.Contains(t, candidates, int64(2))
+
+	// no feedback, no increase
+	adjust(workerSlots, 10)
+	assert.Equal(t, 2, c.nodes[1].limit)
+
+	// the tasks finish in time and more tasks are queued
+	c.release(1, time.Minute)
+	c.acquire(newTask(3, taskcommon.Compaction), 1)
+	adjust(workerSlots, 10)
+	assert.Equal(t, 3, c.nodes[1].limit)
+	assert.Equal(t, 2, c.nodes[2].limit)
+
+	// the tasks get slow
+	c.release(2, time.Hour)
+	adjust(workerSlots, 10)
+	assert.Equal(t, 1, c.nodes[1].limit)
+	assert.Zero(t, c.nodes[1].avgDuration)
+
+	// the ingest of the vchannel being compacted on node 2 lags behind, node 1 is not affected
+	ingestLags["ch1"] = time.Hour
+	adjust(workerSlots, 10)
+	assert.Equal(t, 2, c.nodes[2].limit)
+	lagging := &channelTask{MockTask: newTask(4, taskcommon.Compaction), channel: "ch1"}
+	c.acquire(lagging, 2)
+	c.acquire(&channelTask{MockTask: newTask(5, taskcommon.Compaction), channel: "ch2"}, 1)
+	adjust(workerSlots, 10)
+	assert.Equal(t, 1, c.nodes[1].limit)
+	assert.Equal(t, 1, c.nodes[2].limit)
+	c.release(lagging.GetTaskID(), 0)
+	c.release(5, 0)
+
+	// the limit never exceeds the max
+	delete(ingestLags, "ch1")
+	for i := 0; i < 10; i++ {
+		c.release(3, 0)
+		task := newTask(int64(10+i), taskcommon.Compaction)
+		c.acquire(task, 2)
+		c.release(task.GetTaskID(), time.Second)
+		c.acquire(task, 2)
+		adjust(workerSlots, 10)
+		c.release(task.GetTaskID(), 0)
+		c.nodes[2].running = c.nodes[2].limit
+	}
+	assert.Equal(t, 4, c.nodes[2].limit)
+
+	// the removed worker is forgotten
+	c.nodes[2].running = 0
+	adjust(map[int64]*session.WorkerSlots{1: workerSlots[1]}, 0)
+	assert.NotContains(t, c.nodes, int64(2))
+}
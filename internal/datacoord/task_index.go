@@ -164,6 +164,12 @@ func (it *indexBuildTask) CreateTaskOnWorker(nodeID int64, cluster session.Clust
 		it.SetState(indexpb.JobState_JobStateNone, "task is no need to build index")
 		return
 	}
+	if segment.isQuarantined() {
+		// the task is enqueued again once the segment is restored
+		log.Info("segment is quarantined, removing the index task")
+		it.SetState(indexpb.JobState_JobStateNone, "segment is quarantined")
+		return
+	}
 
 	// Handle special cases for certain index types or small segments
 	indexParams := it.meta.indexMeta.GetIndexParams(segIndex.CollectionID, segIndex.IndexID)
@@ -403,6 +409,8 @@ func (it *indexBuildTask) QueryTaskOnWorker(cluster session.Cluster) {
 				log.Info("query task index info successfully",
 					zap.Int64("taskID", it.BuildID), zap.String("result state", info.GetState().String()),
 					zap.String("failReason", info.GetFailReason()))
+				// the build may fail for the lost binlogs, let the quarantine detector validate the segment
+				it.meta.SuspectSegments(it.SegmentID)
 				it.dropAndResetTaskOnWorker(cluster, info.GetFailReason())
 			}
 			// inProgress or unissued, keep InProgress state
@@ -365,6 +365,17 @@ func (st *statsTask) SetJobInfo(ctx context.Context, result *workerpb.StatsResul
 		}
 	case indexpb.StatsSubJob_BM25Job:
 	// bm25 logs are generated during with segment flush.
+	case indexpb.StatsSubJob_StatslogJob:
+		// the replaced statslogs are removed by the garbage collector since they're not referenced by meta any more.
+		if len(result.GetStatsLogs()) == 0 || st.meta.GetHealthySegment(ctx, st.GetSegmentID()) == nil {
+			break
+		}
+		err = st.meta.UpdateSegmentsInfo(ctx, ReplaceStatslogsOperator(st.GetSegmentID(), result.GetStatsLogs()))
+		if err != nil {
+			log.Ctx(ctx).Warn("save rebuilt statslogs failed", zap.Int64("taskID", st.GetTaskID()),
+				zap.Int64("segmentID", st.GetSegmentID()), zap.Error(err))
+			break
+		}
 	default:
 		log.Ctx(ctx).Warn("unexpected sub job type", zap.String("type", st.GetSubJobType().String()))
 	}
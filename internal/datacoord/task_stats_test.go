@@ -556,6 +556,18 @@ func (s *statsTaskSuite) TestSetJobInfo() {
 		st.SubJobType = indexpb.StatsSubJob_BM25Job
 		err = st.SetJobInfo(context.Background(), result)
 		s.NoError(err)
+
+		// Test StatslogJob job type, the statslogs are replaced by the rebuilt ones
+		s.mt.segments.segments[s.segID].Statslogs = []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1}}}}
+		st.SubJobType = indexpb.StatsSubJob_StatslogJob
+		result.StatsLogs = []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 2, EntriesNum: 1000}}}}
+		err = st.SetJobInfo(context.Background(), result)
+		s.NoError(err)
+		statslogs := s.mt.segments.GetSegment(s.segID).GetStatslogs()
+		s.Len(statslogs, 1)
+		s.Len(statslogs[0].GetBinlogs(), 1)
+		s.EqualValues(2, statslogs[0].GetBinlogs()[0].GetLogID())
+		result.StatsLogs = nil
 	})
 
 	// Restore original segments
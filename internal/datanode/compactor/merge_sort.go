@@ -32,7 +32,7 @@ func mergeSortMultipleSegments(ctx context.Context,
 	collectionTtl int64,
 	compactionParams compaction.Params,
 	sortByFields []int64,
-) ([]*datapb.CompactionSegment, error) {
+) ([]*datapb.CompactionSegment, int64, error) {
 	_ = tr.RecordSpan()
 
 	ctx, span := otel.Tracer(typeutil.DataNodeRole).Start(ctx, "mergeSortMultipleSegments")
@@ -46,13 +46,13 @@ func mergeSortMultipleSegments(ctx context.Context,
 	writer, err := NewMultiSegmentWriter(ctx, binlogIO, compAlloc, plan.GetMaxSize(), plan.GetSchema(), compactionParams, maxRows, partitionID, collectionID, plan.GetChannel(), 4096,
 		storage.WithStorageConfig(compactionParams.StorageConfig))
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	pkField, err := typeutil.GetPrimaryFieldSchema(plan.GetSchema())
 	if err != nil {
 		log.Warn("failed to get pk field from schema")
-		return nil, err
+		return nil, 0, err
 	}
 
 	segmentReaders := make([]storage.RecordReader, len(binlogs))
@@ -67,7 +67,7 @@ func mergeSortMultipleSegments(ctx context.Context,
 			storage.WithStorageConfig(compactionParams.StorageConfig),
 		)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		segmentReaders[i] = reader
 		deltalogPaths := make([]string, 0)
@@ -78,7 +78,7 @@ func mergeSortMultipleSegments(ctx context.Context,
 		}
 		delta, err := compaction.ComposeDeleteFromDeltalogs(ctx, binlogIO, deltalogPaths)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		segmentFilters[i] = compaction.NewEntityFilter(delta, collectionTtl, currentTime)
 	}
@@ -109,12 +109,12 @@ func mergeSortMultipleSegments(ctx context.Context,
 
 	if _, err = storage.MergeSort(compactionParams.BinLogMaxSize, plan.GetSchema(), segmentReaders, writer, predicate, sortByFields); err != nil {
 		writer.Close()
-		return nil, err
+		return nil, 0, err
 	}
 
 	if err := writer.Close(); err != nil {
 		log.Warn("compact wrong, failed to finish writer", zap.Error(err))
-		return nil, err
+		return nil, 0, err
 	}
 
 	res := writer.GetCompactionSegments()
@@ -146,5 +146,5 @@ func mergeSortMultipleSegments(ctx context.Context,
 	metrics.DataNodeCompactionDeleteCount.WithLabelValues(fmt.Sprint(collectionID)).Add(float64(deltalogDeleteEntriesCount))
 	metrics.DataNodeCompactionMissingDeleteCount.WithLabelValues(fmt.Sprint(collectionID)).Add(float64(missingDeleteCount))
 
-	return res, nil
+	return res, int64(deletedRowCount + expiredRowCount), nil
 }
@@ -139,7 +139,7 @@ func (t *mixCompactionTask) preCompact() error {
 
 func (t *mixCompactionTask) mergeSplit(
 	ctx context.Context,
-) ([]*datapb.CompactionSegment, error) {
+) ([]*datapb.CompactionSegment, int64, error) {
 	_ = t.tr.RecordSpan()
 
 	ctx, span := otel.Tracer(typeutil.DataNodeRole).Start(ctx, "MergeSplit")
@@ -152,7 +152,7 @@ func (t *mixCompactionTask) mergeSplit(
 	compAlloc := NewCompactionAllocator(segIDAlloc, logIDAlloc)
 	mWriter, err := NewMultiSegmentWriter(ctx, t.binlogIO, compAlloc, t.plan.GetMaxSize(), t.plan.GetSchema(), t.compactionParams, t.maxRows, t.partitionID, t.collectionID, t.GetChannelName(), 4096, storage.WithStorageConfig(t.compactionParams.StorageConfig))
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	deletedRowCount := int64(0)
@@ -161,28 +161,28 @@ func (t *mixCompactionTask) mergeSplit(
 	pkField, err := typeutil.GetPrimaryFieldSchema(t.plan.GetSchema())
 	if err != nil {
 		log.Warn("failed to get pk field from schema")
-		return nil, err
+		return nil, 0, err
 	}
 
 	for _, seg := range t.plan.GetSegmentBinlogs() {
 		del, exp, err := t.writeSegment(ctx, seg, mWriter, pkField)
 		if err != nil {
 			mWriter.Close()
-			return nil, err
+			return nil, 0, err
 		}
 		deletedRowCount += del
 		expiredRowCount += exp
 	}
 	if err := mWriter.Close(); err != nil {
 		log.Warn("compact wrong, failed to finish writer", zap.Error(err))
-		return nil, err
+		return nil, 0, err
 	}
 	res := mWriter.GetCompactionSegments()
 	if len(res) == 0 {
 		// append an empty segment
 		id, err := segIDAlloc.AllocOne()
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		res = append(res, &datapb.CompactionSegment{
 			SegmentID: id,
@@ -197,7 +197,7 @@ func (t *mixCompactionTask) mergeSplit(
 		zap.Int64("expired entities", expiredRowCount),
 		zap.Duration("total elapse", totalElapse))
 
-	return res, nil
+	return res, deletedRowCount + expiredRowCount, nil
 }
 
 func (t *mixCompactionTask) writeSegment(ctx context.Context,
@@ -363,24 +363,32 @@ func (t *mixCompactionTask) Compact() (*datapb.CompactionPlanResult, error) {
 	}
 
 	var res []*datapb.CompactionSegment
+	var filteredRowCount int64
 	var err error
 	if sortMergeAppicable {
 		// TODO: the implementation of mergeSortMultipleSegments is not correct, also see issue: https://github.com/milvus-io/milvus/issues/43034
 		log.Info("compact by merge sort")
-		res, err = mergeSortMultipleSegments(ctxTimeout, t.plan, t.collectionID, t.partitionID, t.maxRows, t.binlogIO,
+		res, filteredRowCount, err = mergeSortMultipleSegments(ctxTimeout, t.plan, t.collectionID, t.partitionID, t.maxRows, t.binlogIO,
 			t.plan.GetSegmentBinlogs(), t.tr, t.currentTime, t.plan.GetCollectionTtl(), t.compactionParams, t.sortByFieldIDs)
 		if err != nil {
 			log.Warn("compact wrong, fail to merge sort segments", zap.Error(err))
 			return nil, err
 		}
 	} else {
-		res, err = t.mergeSplit(ctxTimeout)
+		res, filteredRowCount, err = t.mergeSplit(ctxTimeout)
 		if err != nil {
 			log.Warn("compact wrong, failed to mergeSplit", zap.Error(err))
 			return nil, err
 		}
 	}
 
+	if t.compactionParams.ValidateResult {
+		if err := validateCompactionResult(ctxTimeout, t.binlogIO, t.collectionID, t.plan.GetSchema(), t.compactionParams, res, t.maxRows-filteredRowCount); err != nil {
+			log.Warn("compact wrong, invalid compaction result", zap.Error(err))
+			return nil, err
+		}
+	}
+
 	log.Info("compact done", zap.Duration("compact elapse", time.Since(compactStart)), zap.Any("res", res))
 
 	metrics.DataNodeCompactionLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), t.plan.GetType().String()).Observe(float64(t.tr.ElapseSpan().Milliseconds()))
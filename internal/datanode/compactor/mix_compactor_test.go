@@ -41,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -252,6 +253,60 @@ func (s *MixCompactionTaskStorageV1Suite) TestCompactTwoToOne() {
 	s.Empty(segment.Deltalogs)
 }
 
+func (s *MixCompactionTaskStorageV1Suite) TestCompactValidateResult() {
+	alloc := allocator.NewLocalAllocator(7777777, math.MaxInt64)
+	uploaded := typeutil.NewConcurrentMap[string, []byte]()
+	s.mockBinlogIO.EXPECT().Upload(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, kvs map[string][]byte) error {
+			for k, v := range kvs {
+				uploaded.Insert(k, v)
+			}
+			return nil
+		})
+	s.task.plan.SegmentBinlogs = make([]*datapb.CompactionSegmentBinlogs, 0)
+	for _, segID := range []int64{5, 6, 7} {
+		s.initSegBuffer(1, segID)
+		kvs, fBinlogs, err := serializeWrite(context.TODO(), alloc, s.segWriter)
+		s.Require().NoError(err)
+		s.mockBinlogIO.EXPECT().Download(mock.Anything, mock.MatchedBy(func(keys []string) bool {
+			left, right := lo.Difference(keys, lo.Keys(kvs))
+			return len(left) == 0 && len(right) == 0
+		})).Return(lo.Values(kvs), nil).Once()
+
+		s.task.plan.SegmentBinlogs = append(s.task.plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			CollectionID: 1,
+			SegmentID:    segID,
+			FieldBinlogs: lo.Values(fBinlogs),
+		})
+	}
+	s.mockBinlogIO.EXPECT().Download(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, paths []string) ([][]byte, error) {
+			return lo.Map(paths, func(path string, _ int) []byte {
+				v, _ := uploaded.Get(path)
+				return v
+			}), nil
+		})
+
+	s.task.compactionParams.ValidateResult = true
+	s.task.compactionParams.ValidateSamplePKNum = 2
+	result, err := s.task.Compact()
+	s.Require().NoError(err)
+	s.Equal(1, len(result.GetSegments()))
+	s.EqualValues(3, result.GetSegments()[0].GetNumOfRows())
+
+	// the reported row count doesn't match the rows of the inputs
+	err = validateCompactionResult(context.TODO(), s.task.binlogIO, s.task.collectionID, s.task.plan.GetSchema(),
+		s.task.compactionParams, result.GetSegments(), 4)
+	s.ErrorIs(err, merr.ErrCompactionResult)
+
+	// the reported row count doesn't match the rows in the binlogs
+	segment := result.GetSegments()[0]
+	segment.NumOfRows = 4
+	err = validateCompactionResult(context.TODO(), s.task.binlogIO, s.task.collectionID, s.task.plan.GetSchema(),
+		s.task.compactionParams, result.GetSegments(), 4)
+	s.ErrorIs(err, merr.ErrCompactionResult)
+}
+
 func (s *MixCompactionTaskStorageV1Suite) prepareCompactTwoToOneWithBM25Segments() {
 	s.SetupBM25()
 	segments := []int64{5, 6, 7}
@@ -485,7 +540,7 @@ func (s *MixCompactionTaskStorageV1Suite) TestSplitMergeEntityExpired() {
 	err := s.task.preCompact()
 	s.Require().NoError(err)
 
-	compactionSegments, err := s.task.mergeSplit(s.task.ctx)
+	compactionSegments, _, err := s.task.mergeSplit(s.task.ctx)
 	s.NoError(err)
 	s.Equal(1, len(compactionSegments))
 	s.EqualValues(0, compactionSegments[0].GetNumOfRows())
@@ -586,7 +641,7 @@ func (s *MixCompactionTaskStorageV1Suite) TestMergeNoExpirationLackBinlog() {
 			err := s.task.preCompact()
 			s.Require().NoError(err)
 
-			res, err := s.task.mergeSplit(s.task.ctx)
+			res, _, err := s.task.mergeSplit(s.task.ctx)
 			s.NoError(err)
 			s.EqualValues(test.expectedRes, len(res))
 			s.EqualValues(test.leftNumRows, res[0].GetNumOfRows())
@@ -668,7 +723,7 @@ func (s *MixCompactionTaskStorageV1Suite) TestMergeNoExpiration() {
 			err := s.task.preCompact()
 			s.NoError(err)
 
-			res, err := s.task.mergeSplit(s.task.ctx)
+			res, _, err := s.task.mergeSplit(s.task.ctx)
 			s.NoError(err)
 			s.EqualValues(test.expectedRes, len(res))
 			s.EqualValues(test.leftNumRows, res[0].GetNumOfRows())
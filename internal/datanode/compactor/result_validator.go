@@ -0,0 +1,197 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compactor
+
+import (
+	"context"
+	"fmt"
+	sio "io"
+	"path"
+
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/compaction"
+	"github.com/milvus-io/milvus/internal/flushcommon/io"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// validateCompactionResult re-checks the compaction result before reporting success,
+// the plan fails and the input segments are kept if any of the checks fails:
+//  1. the rows of the result equal to the input rows minus the deleted and expired ones;
+//  2. all the insert binlogs of the result segments are readable and match the reported row count;
+//  3. the sampled pks of the result segments exist in the bloom filter of their statslogs.
+func validateCompactionResult(ctx context.Context,
+	binlogIO io.BinlogIO,
+	collectionID int64,
+	schema *schemapb.CollectionSchema,
+	params compaction.Params,
+	segments []*datapb.CompactionSegment,
+	expectedRows int64,
+) error {
+	resultRows := lo.SumBy(segments, func(segment *datapb.CompactionSegment) int64 {
+		return segment.GetNumOfRows()
+	})
+	if resultRows != expectedRows {
+		return merr.WrapErrCompactionResult(fmt.Sprintf("row count mismatch, expected %d, result %d", expectedRows, resultRows))
+	}
+
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		if err := validateResultSegment(ctx, binlogIO, collectionID, schema, pkField, params, segment); err != nil {
+			log.Ctx(ctx).Warn("compaction result segment is invalid", zap.Int64("segmentID", segment.GetSegmentID()), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+func validateResultSegment(ctx context.Context,
+	binlogIO io.BinlogIO,
+	collectionID int64,
+	schema *schemapb.CollectionSchema,
+	pkField *schemapb.FieldSchema,
+	params compaction.Params,
+	segment *datapb.CompactionSegment,
+) error {
+	if segment.GetNumOfRows() == 0 {
+		return nil
+	}
+
+	stats, err := loadResultPkStats(ctx, binlogIO, pkField.GetFieldID(), segment.GetField2StatslogPaths())
+	if err != nil {
+		return err
+	}
+	if len(stats) == 0 {
+		return merr.WrapErrCompactionResult(fmt.Sprintf("segment %d has no pk stats", segment.GetSegmentID()))
+	}
+
+	reader, err := storage.NewBinlogRecordReader(ctx,
+		segment.GetInsertLogs(),
+		schema,
+		storage.WithCollectionID(collectionID),
+		storage.WithDownloader(binlogIO.Download),
+		storage.WithVersion(segment.GetStorageVersion()),
+		storage.WithStorageConfig(params.StorageConfig),
+	)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	sampleStep := int64(1)
+	if params.ValidateSamplePKNum > 0 {
+		sampleStep = max(1, segment.GetNumOfRows()/int64(params.ValidateSamplePKNum))
+	}
+	var rows int64
+	for {
+		r, err := reader.Next()
+		if err != nil {
+			if err == sio.EOF {
+				break
+			}
+			return err
+		}
+
+		pkArray := r.Column(pkField.GetFieldID())
+		for i := range r.Len() {
+			if (rows+int64(i))%sampleStep != 0 {
+				continue
+			}
+			var pk storage.PrimaryKey
+			switch pkField.GetDataType() {
+			case schemapb.DataType_Int64:
+				pk = storage.NewInt64PrimaryKey(pkArray.(*array.Int64).Value(i))
+			case schemapb.DataType_VarChar:
+				pk = storage.NewVarCharPrimaryKey(pkArray.(*array.String).Value(i))
+			default:
+				return merr.WrapErrCompactionResult(fmt.Sprintf("unsupported pk type %s", pkField.GetDataType().String()))
+			}
+			if !lo.SomeBy(stats, func(stat *storage.PkStatistics) bool { return stat.PkExist(pk) }) {
+				return merr.WrapErrCompactionResult(fmt.Sprintf("pk %v of segment %d is not in the bloom filter", pk.GetValue(), segment.GetSegmentID()))
+			}
+		}
+		rows += int64(r.Len())
+	}
+
+	if rows != segment.GetNumOfRows() {
+		return merr.WrapErrCompactionResult(fmt.Sprintf("segment %d has %d rows in binlogs, but %d rows reported", segment.GetSegmentID(), rows, segment.GetNumOfRows()))
+	}
+	return nil
+}
+
+// loadResultPkStats loads the pk statistics of the result segment, see also compaction.LoadStats.
+func loadResultPkStats(ctx context.Context, binlogIO io.BinlogIO, pkFieldID int64, statsBinlogs []*datapb.FieldBinlog) ([]*storage.PkStatistics, error) {
+	var paths []string
+	compound := false
+	for _, fieldBinlog := range statsBinlogs {
+		if fieldBinlog.GetFieldID() != pkFieldID {
+			continue
+		}
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if _, logidx := path.Split(binlog.GetLogPath()); logidx == storage.CompoundStatsType.LogIdx() {
+				paths = []string{binlog.GetLogPath()}
+				compound = true
+				break
+			}
+			paths = append(paths, binlog.GetLogPath())
+		}
+	}
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	values, err := binlogIO.Download(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	blobs := lo.Map(values, func(value []byte, _ int) *storage.Blob {
+		return &storage.Blob{Value: value}
+	})
+
+	var stats []*storage.PrimaryKeyStats
+	if compound {
+		stats, err = storage.DeserializeStatsList(blobs[0])
+	} else {
+		stats, err = storage.DeserializeStats(blobs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*storage.PkStatistics, 0, len(stats))
+	for _, stat := range stats {
+		if stat.BF == nil {
+			return nil, merr.WrapErrCompactionResult("pk stats without bloom filter")
+		}
+		result = append(result, &storage.PkStatistics{
+			PkFilter: stat.BF,
+			MinPK:    stat.MinPk,
+			MaxPK:    stat.MaxPk,
+		})
+	}
+	return result, nil
+}
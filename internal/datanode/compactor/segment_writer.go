@@ -158,10 +158,12 @@ func (w *MultiSegmentWriter) rotateWriter() error {
 
 	chunkSize := w.binLogMaxSize
 
+	tagger, _ := w.binlogIO.(storage.ObjectTagger)
 	w.rwOption = append(w.rwOption,
 		storage.WithUploader(func(ctx context.Context, kvs map[string][]byte) error {
 			return w.binlogIO.Upload(ctx, kvs)
 		}),
+		storage.WithObjectTagger(tagger),
 		storage.WithVersion(w.storageVersion),
 	)
 	rw, err := storage.NewBinlogRecordWriter(w.ctx, w.collectionID, w.partitionID, newSegmentID,
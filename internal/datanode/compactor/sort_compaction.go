@@ -162,6 +162,7 @@ func (t *sortCompactionTask) sortSegment(ctx context.Context) (*datapb.Compactio
 	alloc := allocator.NewLocalAllocator(t.plan.GetPreAllocatedLogIDs().GetBegin(), t.plan.GetPreAllocatedLogIDs().GetEnd())
 	targetSegmentID := t.plan.GetPreAllocatedSegmentIDs().GetBegin()
 
+	tagger, _ := t.binlogIO.(storage.ObjectTagger)
 	srw, err := storage.NewBinlogRecordWriter(ctx,
 		t.collectionID,
 		t.partitionID,
@@ -173,6 +174,7 @@ func (t *sortCompactionTask) sortSegment(ctx context.Context) (*datapb.Compactio
 		storage.WithUploader(func(ctx context.Context, kvs map[string][]byte) error {
 			return t.binlogIO.Upload(ctx, kvs)
 		}),
+		storage.WithObjectTagger(tagger),
 		storage.WithVersion(t.storageVersion),
 		storage.WithStorageConfig(t.compactionParams.StorageConfig),
 	)
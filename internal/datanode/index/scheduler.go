@@ -221,6 +221,9 @@ func getStateFromError(err error) indexpb.JobState {
 	} else if errors.Is(err, merr.ErrIoKeyNotFound) || errors.Is(err, merr.ErrSegcoreUnsupported) {
 		// NoSuchKey or unsupported error
 		return indexpb.JobState_JobStateFailed
+	} else if errors.Is(err, errOversizedJob) {
+		// the job won't fit the node no matter how many times it's retried
+		return indexpb.JobState_JobStateFailed
 	} else if errors.Is(err, merr.ErrSegcorePretendFinished) {
 		return indexpb.JobState_JobStateFinished
 	}
@@ -26,8 +26,10 @@ import (
 )
 
 var (
-	errCancel      = errors.New("canceled")
-	DiskUsageRatio = 4.0
+	errCancel = errors.New("canceled")
+	// errOversizedJob marks the index build job which won't fit the node, the job fails without retry.
+	errOversizedJob = errors.New("index build job won't fit the node")
+	DiskUsageRatio  = 4.0
 )
 
 type Key struct {
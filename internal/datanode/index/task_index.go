@@ -23,6 +23,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -34,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/proto/cgopb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexcgopb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/workerpb"
@@ -41,7 +43,9 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/retry"
 	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 // IndexBuildTask is used to record the information of the index tasks.
@@ -231,6 +235,15 @@ func (it *indexBuildTask) Execute(ctx context.Context) error {
 
 	// Ignore the error here, this param will only be used for diskann and aisaq
 	fieldDataSize, _ = estimateFieldDataSize(it.req.GetDim(), it.req.GetNumRows(), it.req.GetField().GetDataType())
+	if paramtable.Get().DataNodeCfg.IndexRejectOversizedJob.GetAsBool() && typeutil.IsDenseFloatVectorType(it.req.GetField().GetDataType()) {
+		estimate, err := CheckBuildResource(it.newIndexParams, it.req.GetNumRows(), it.req.GetDim())
+		if err != nil {
+			log.Warn("index build job is rejected by the resource estimation", zap.Any("estimate", estimate), zap.Error(err))
+			return err
+		}
+		log.Info("estimated the resource of the index build job", zap.Int64("memory", estimate.MemoryBytes),
+			zap.Int64("disk", estimate.DiskBytes), zap.Duration("duration", estimate.Duration))
+	}
 	if vecindexmgr.GetVecIndexMgrInstance().IsDiskANN(indexType) {
 		err = indexparams.SetDiskIndexBuildParams(it.newIndexParams, int64(fieldDataSize))
 		if err != nil {
@@ -339,7 +352,20 @@ func (it *indexBuildTask) PostExecute(ctx context.Context) error {
 			log.Warn("indexBuildTask Execute CIndexDelete failed", zap.Error(err))
 		}
 	}
-	indexStats, err := it.index.UpLoad()
+	// the built index is kept across upload attempts, so a transient upload failure
+	// only redoes the upload instead of rebuilding the whole index
+	var indexStats *cgopb.IndexStats
+	attempt := 0
+	err := retry.Do(ctx, func() error {
+		attempt++
+		var err error
+		indexStats, err = it.index.UpLoad()
+		if err != nil {
+			log.Warn("failed to upload index, the built index is kept for retry", zap.Int("attempt", attempt), zap.Error(err))
+		}
+		return err
+	}, retry.Attempts(paramtable.Get().DataNodeCfg.IndexUploadRetryAttempts.GetAsUint()),
+		retry.Sleep(paramtable.Get().DataNodeCfg.IndexUploadRetryInterval.GetAsDuration(time.Millisecond)))
 	if err != nil {
 		log.Warn("failed to upload index", zap.Error(err))
 		gcIndex()
@@ -351,6 +377,20 @@ func (it *indexBuildTask) PostExecute(ctx context.Context) error {
 	// early release index for gc, and we can ensure that Delete is idempotent.
 	gcIndex()
 
+	// the index files are uploaded by the index engine, tag them here so that they share the lifecycle of the segment
+	if tagger, ok := it.cm.(storage.ObjectTagger); ok {
+		indexFiles := lo.Map(indexStats.GetSerializedIndexInfos(), func(info *cgopb.SerializedIndexFileInfo, _ int) string {
+			return info.GetFileName()
+		})
+		tags := storage.SegmentObjectTags(it.req.GetCollectionID(), it.req.GetSegmentID())
+		if err := retry.Do(ctx, func() error {
+			return storage.TagObjects(ctx, tagger, indexFiles, tags)
+		}); err != nil {
+			log.Warn("failed to tag index files", zap.Error(err))
+			return err
+		}
+	}
+
 	// use serialized size before encoding
 	var serializedSize uint64
 	saveFileKeys := make([]string, 0)
@@ -496,6 +496,29 @@ func (m *TaskManager) StorePKSortStatsResult(
 	}
 }
 
+func (m *TaskManager) StoreStatslogResult(
+	ClusterID string,
+	taskID typeutil.UniqueID,
+	collID typeutil.UniqueID,
+	partID typeutil.UniqueID,
+	segID typeutil.UniqueID,
+	channel string,
+	numRows int64,
+	statsLogs []*datapb.FieldBinlog,
+) {
+	key := Key{ClusterID: ClusterID, TaskID: taskID}
+	m.stateLock.Lock()
+	defer m.stateLock.Unlock()
+	if info, ok := m.statsTasks[key]; ok {
+		info.CollID = collID
+		info.PartID = partID
+		info.SegID = segID
+		info.InsertChannel = channel
+		info.NumRows = numRows
+		info.StatsLogs = statsLogs
+	}
+}
+
 func (m *TaskManager) StoreStatsTextIndexResult(
 	ClusterID string,
 	taskID typeutil.UniqueID,
@@ -19,6 +19,7 @@ package index
 import (
 	"context"
 	"fmt"
+	sio "io"
 	"runtime/debug"
 	"strconv"
 	"time"
@@ -185,6 +186,7 @@ func (st *statsTask) sort(ctx context.Context) ([]*datapb.FieldBinlog, error) {
 	}
 
 	alloc := allocator.NewLocalAllocator(st.req.StartLogID, st.req.EndLogID)
+	tagger, _ := st.binlogIO.(storage.ObjectTagger)
 	srw, err := storage.NewBinlogRecordWriter(ctx,
 		st.req.GetCollectionID(),
 		st.req.GetPartitionID(),
@@ -196,6 +198,7 @@ func (st *statsTask) sort(ctx context.Context) ([]*datapb.FieldBinlog, error) {
 		storage.WithUploader(func(ctx context.Context, kvs map[string][]byte) error {
 			return st.binlogIO.Upload(ctx, kvs)
 		}),
+		storage.WithObjectTagger(tagger),
 		storage.WithVersion(st.req.GetStorageVersion()),
 		storage.WithStorageConfig(st.req.GetStorageConfig()),
 	)
@@ -299,11 +302,94 @@ func (st *statsTask) sort(ctx context.Context) ([]*datapb.FieldBinlog, error) {
 	return insertLogs, nil
 }
 
+// rebuildStatslog regenerates the pk statslog (bloom filter, min/max) of the segment from its binlogs,
+// the binlogs are kept as is, so the segment keeps its ID and indexes.
+func (st *statsTask) rebuildStatslog(ctx context.Context) error {
+	log := log.Ctx(ctx).With(
+		zap.String("clusterID", st.req.GetClusterID()),
+		zap.Int64("taskID", st.req.GetTaskID()),
+		zap.Int64("collectionID", st.req.GetCollectionID()),
+		zap.Int64("partitionID", st.req.GetPartitionID()),
+		zap.Int64("segmentID", st.req.GetSegmentID()),
+	)
+
+	collector, err := storage.NewPkStatsCollector(st.req.GetCollectionID(), st.req.GetSchema(), st.req.GetNumRows())
+	if err != nil {
+		return err
+	}
+	rr, err := storage.NewBinlogRecordReader(ctx, st.req.InsertLogs, st.req.Schema,
+		storage.WithCollectionID(st.req.CollectionID),
+		storage.WithVersion(st.req.StorageVersion),
+		storage.WithDownloader(st.binlogIO.Download),
+		storage.WithStorageConfig(st.req.GetStorageConfig()),
+	)
+	if err != nil {
+		log.Warn("error creating insert binlog reader", zap.Error(err))
+		return err
+	}
+	defer rr.Close()
+
+	var numRows int64
+	for {
+		r, err := rr.Next()
+		if err == sio.EOF {
+			break
+		}
+		if err != nil {
+			log.Warn("failed to read insert binlogs", zap.Error(err))
+			return err
+		}
+		if err := collector.Collect(r); err != nil {
+			return err
+		}
+		numRows += int64(r.Len())
+	}
+
+	alloc := allocator.NewLocalAllocator(st.req.StartLogID, st.req.EndLogID)
+	tagger, _ := st.binlogIO.(storage.ObjectTagger)
+	tags := storage.SegmentObjectTags(st.req.GetCollectionID(), st.req.GetSegmentID())
+	stats, err := collector.Digest(st.req.GetCollectionID(), st.req.GetPartitionID(), st.req.GetSegmentID(),
+		st.req.GetStorageConfig().GetRootPath(), numRows, alloc,
+		func(blobs []*storage.Blob) error {
+			kvs := lo.SliceToMap(blobs, func(blob *storage.Blob) (string, []byte) { return blob.Key, blob.Value })
+			if err := st.binlogIO.Upload(ctx, kvs); err != nil {
+				return err
+			}
+			if tagger == nil || len(tags) == 0 {
+				return nil
+			}
+			return storage.TagObjects(ctx, tagger, lo.Keys(kvs), tags)
+		})
+	if err != nil {
+		log.Warn("failed to write statslog", zap.Error(err))
+		return err
+	}
+
+	statsLogs := lo.Values(stats)
+	if err := binlog.CompressFieldBinlogs(statsLogs); err != nil {
+		return err
+	}
+	st.manager.StoreStatslogResult(st.req.GetClusterID(),
+		st.req.GetTaskID(),
+		st.req.GetCollectionID(),
+		st.req.GetPartitionID(),
+		st.req.GetSegmentID(),
+		st.req.GetInsertChannel(),
+		numRows, statsLogs)
+
+	log.Info("rebuild statslog end", zap.Int64("numRows", numRows))
+	return nil
+}
+
 func (st *statsTask) Execute(ctx context.Context) error {
 	// sort segment and check need to do text index.
 	ctx, span := otel.Tracer(typeutil.IndexNodeRole).Start(ctx, fmt.Sprintf("Stats-Execute-%s-%d", st.req.GetClusterID(), st.req.GetTaskID()))
 	defer span.End()
 
+	if st.req.GetSubJobType() == indexpb.StatsSubJob_StatslogJob {
+		return st.rebuildStatslog(ctx)
+	}
+
 	insertLogs := st.req.GetInsertLogs()
 	var err error
 	if st.req.GetSubJobType() == indexpb.StatsSubJob_Sort {
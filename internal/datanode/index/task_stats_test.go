@@ -177,6 +177,106 @@ func (s *TaskStatsSuite) TestSortSegmentWithBM25() {
 	})
 }
 
+func (s *TaskStatsSuite) TestRebuildStatslog() {
+	s.Run("normal case", func() {
+		s.schema = genCollectionSchemaWithBM25()
+		s.GenSegmentWriterWithBM25(0)
+		_, kvs, fBinlogs, err := serializeWrite(context.TODO(), "root_path", 0, s.segWriter)
+		s.NoError(err)
+		s.mockBinlogIO.EXPECT().Download(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, paths []string) ([][]byte, error) {
+			result := make([][]byte, len(paths))
+			for i, path := range paths {
+				result[i] = kvs[path]
+			}
+			return result, nil
+		})
+		var uploaded map[string][]byte
+		s.mockBinlogIO.EXPECT().Upload(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, kvs map[string][]byte) error {
+			uploaded = kvs
+			return nil
+		}).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		testTaskKey := Key{ClusterID: s.clusterID, TaskID: 100}
+		manager := NewTaskManager(ctx)
+		manager.LoadOrStoreStatsTask(s.clusterID, testTaskKey.TaskID, &StatsTaskInfo{SegID: 1})
+		task := NewStatsTask(ctx, cancel, &workerpb.CreateStatsRequest{
+			CollectionID:    s.collectionID,
+			PartitionID:     s.partitionID,
+			ClusterID:       s.clusterID,
+			TaskID:          testTaskKey.TaskID,
+			SegmentID:       1,
+			TargetSegmentID: 1,
+			SubJobType:      indexpb.StatsSubJob_StatslogJob,
+			InsertLogs:      lo.Values(fBinlogs),
+			Schema:          s.schema,
+			NumRows:         1,
+			StartLogID:      0,
+			EndLogID:        7,
+			StorageConfig: &indexpb.StorageConfig{
+				RootPath: "root_path",
+			},
+		}, manager, s.mockBinlogIO)
+		err = task.PreExecute(ctx)
+		s.Require().NoError(err)
+		err = task.Execute(ctx)
+		s.Require().NoError(err)
+		s.Len(uploaded, 1)
+
+		// the binlogs are kept, only the statslog is generated
+		info := manager.statsTasks[testTaskKey]
+		s.Empty(info.InsertLogs)
+		s.EqualValues(1, info.NumRows)
+		s.Require().Len(info.StatsLogs, 1)
+		s.Len(info.StatsLogs[0].GetBinlogs(), 1)
+		s.EqualValues(1, info.StatsLogs[0].GetBinlogs()[0].GetEntriesNum())
+	})
+
+	s.Run("upload statslog failed", func() {
+		s.schema = genCollectionSchemaWithBM25()
+		s.GenSegmentWriterWithBM25(0)
+		_, kvs, fBinlogs, err := serializeWrite(context.TODO(), "root_path", 0, s.segWriter)
+		s.NoError(err)
+		s.mockBinlogIO.EXPECT().Download(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, paths []string) ([][]byte, error) {
+			result := make([][]byte, len(paths))
+			for i, path := range paths {
+				result[i] = kvs[path]
+			}
+			return result, nil
+		})
+		s.mockBinlogIO.EXPECT().Upload(mock.Anything, mock.Anything).Return(errors.New("mock error")).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		testTaskKey := Key{ClusterID: s.clusterID, TaskID: 100}
+		manager := NewTaskManager(ctx)
+		manager.LoadOrStoreStatsTask(s.clusterID, testTaskKey.TaskID, &StatsTaskInfo{SegID: 1})
+		task := NewStatsTask(ctx, cancel, &workerpb.CreateStatsRequest{
+			CollectionID:    s.collectionID,
+			PartitionID:     s.partitionID,
+			ClusterID:       s.clusterID,
+			TaskID:          testTaskKey.TaskID,
+			SegmentID:       1,
+			TargetSegmentID: 1,
+			SubJobType:      indexpb.StatsSubJob_StatslogJob,
+			InsertLogs:      lo.Values(fBinlogs),
+			Schema:          s.schema,
+			NumRows:         1,
+			StartLogID:      0,
+			EndLogID:        7,
+			StorageConfig: &indexpb.StorageConfig{
+				RootPath: "root_path",
+			},
+		}, manager, s.mockBinlogIO)
+		err = task.PreExecute(ctx)
+		s.Require().NoError(err)
+		err = task.Execute(ctx)
+		s.Error(err)
+		s.Empty(manager.statsTasks[testTaskKey].StatsLogs)
+	})
+}
+
 func (s *TaskStatsSuite) TestBuildIndexParams() {
 	s.Run("test storage v2 index params", func() {
 		req := &workerpb.CreateStatsRequest{
@@ -20,13 +20,16 @@ import (
 	"context"
 	"testing"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
 	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/cgopb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/workerpb"
@@ -235,3 +238,56 @@ func (suite *AnalyzeTaskSuite) TestAnalyze() {
 func TestAnalyzeTaskSuite(t *testing.T) {
 	suite.Run(t, new(AnalyzeTaskSuite))
 }
+
+type flakyUploadIndex struct {
+	indexcgowrapper.CodecIndex
+	uploadFailures int
+	uploadCalls    int
+	deleted        bool
+}
+
+func (idx *flakyUploadIndex) UpLoad() (*cgopb.IndexStats, error) {
+	idx.uploadCalls++
+	if idx.uploadCalls <= idx.uploadFailures {
+		return nil, errors.New("mock upload failure")
+	}
+	return &cgopb.IndexStats{
+		MemSize:              1024,
+		SerializedIndexInfos: []*cgopb.SerializedIndexFileInfo{{FileName: "index/1/file", FileSize: 1024}},
+	}, nil
+}
+
+func (idx *flakyUploadIndex) Delete() error {
+	idx.deleted = true
+	return nil
+}
+
+func (suite *IndexBuildTaskSuite) TestUploadRetry() {
+	paramtable.Get().Save(paramtable.Get().DataNodeCfg.IndexUploadRetryInterval.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().DataNodeCfg.IndexUploadRetryInterval.Key)
+
+	newTask := func(index indexcgowrapper.CodecIndex) *indexBuildTask {
+		return &indexBuildTask{
+			index:   index,
+			req:     &workerpb.CreateJobRequest{ClusterID: "test", BuildID: 1},
+			tr:      timerecord.NewTimeRecorder("test-indexBuildTask"),
+			manager: NewTaskManager(context.Background()),
+		}
+	}
+
+	suite.Run("upload recovers without rebuild", func() {
+		index := &flakyUploadIndex{uploadFailures: 2}
+		err := newTask(index).PostExecute(context.Background())
+		suite.NoError(err)
+		suite.Equal(3, index.uploadCalls)
+		suite.True(index.deleted)
+	})
+
+	suite.Run("upload keeps failing", func() {
+		index := &flakyUploadIndex{uploadFailures: 10}
+		err := newTask(index).PostExecute(context.Background())
+		suite.Error(err)
+		suite.Equal(3, index.uploadCalls)
+		suite.True(index.deleted)
+	})
+}
@@ -34,6 +34,8 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/util/hardware"
+	"github.com/milvus-io/milvus/pkg/v2/util/indexparams"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -69,6 +71,26 @@ func estimateFieldDataSize(dim int64, numRows int64, dataType schemapb.DataType)
 	}
 }
 
+// CheckBuildResource returns error if the estimated memory or disk of the build job exceeds the capacity of the node,
+// so that the job fails fast instead of the node being OOM killed or running out of disk in the middle of the build.
+// The error of an oversized job is marked as errOversizedJob, the job is not retried.
+func CheckBuildResource(indexParams map[string]string, numRows int64, dim int64) (*indexparams.JobEstimate, error) {
+	estimate, err := indexparams.EstimateJob(indexParams, numRows, dim)
+	if err != nil {
+		return nil, err
+	}
+	if totalMem := hardware.GetMemoryCount(); totalMem > 0 && uint64(estimate.MemoryBytes) > totalMem {
+		return estimate, errors.Mark(merr.WrapErrServiceMemoryLimitExceeded(float32(estimate.MemoryBytes), float32(totalMem), errOversizedJob.Error()), errOversizedJob)
+	}
+	if estimate.DiskBytes > 0 {
+		_, totalGB, err := hardware.GetDiskUsage(paramtable.Get().LocalStorageCfg.Path.GetValue())
+		if err == nil && totalGB > 0 && float64(estimate.DiskBytes) > totalGB*1e9 {
+			return estimate, errors.Mark(merr.WrapErrServiceDiskLimitExceeded(float32(estimate.DiskBytes), float32(totalGB*1e9), errOversizedJob.Error()), errOversizedJob)
+		}
+	}
+	return estimate, nil
+}
+
 func mapToKVPairs(m map[string]string) []*commonpb.KeyValuePair {
 	kvs := make([]*commonpb.KeyValuePair, 0, len(m))
 	for k, v := range m {
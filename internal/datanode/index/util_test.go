@@ -21,6 +21,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 )
 
 type utilSuite struct {
@@ -37,6 +40,25 @@ func (s *utilSuite) Test_mapToKVPairs() {
 	s.Equal(3, len(mapToKVPairs(indexParams)))
 }
 
+func (s *utilSuite) Test_CheckBuildResource() {
+	indexParams := map[string]string{
+		"index_type": "HNSW",
+		"M":          "16",
+	}
+
+	estimate, err := CheckBuildResource(indexParams, 1000, 128)
+	s.NoError(err)
+	s.Greater(estimate.MemoryBytes, int64(0))
+
+	_, err = CheckBuildResource(indexParams, 1<<40, 128)
+	s.ErrorIs(err, merr.ErrServiceMemoryLimitExceeded)
+	s.ErrorIs(err, errOversizedJob)
+	s.Equal(indexpb.JobState_JobStateFailed, getStateFromError(err))
+
+	_, err = CheckBuildResource(indexParams, 1000, 0)
+	s.Error(err)
+}
+
 func Test_utilSuite(t *testing.T) {
 	suite.Run(t, new(utilSuite))
 }
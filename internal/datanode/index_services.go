@@ -36,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexcgopb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/workerpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -228,6 +229,36 @@ func (node *DataNode) GetJobStats(ctx context.Context, req *workerpb.GetJobStats
 	}, nil
 }
 
+// EstimateJob returns the projected memory, disk and duration of an index build job on this node,
+// the reject reason is set if the job won't fit the node.
+func (node *DataNode) EstimateJob(ctx context.Context, req *workerpb.EstimateJobRequest) (*workerpb.EstimateJobResponse, error) {
+	if err := node.lifetime.Add(merr.IsHealthyOrStopping); err != nil {
+		log.Ctx(ctx).Warn("index node not ready", zap.Error(err))
+		return &workerpb.EstimateJobResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	defer node.lifetime.Done()
+
+	estimate, err := index.CheckBuildResource(funcutil.KeyValuePair2Map(req.GetIndexParams()), req.GetNumRows(), req.GetDim())
+	if estimate == nil {
+		log.Ctx(ctx).Warn("estimate index build job failed", zap.Error(err))
+		return &workerpb.EstimateJobResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	resp := &workerpb.EstimateJobResponse{
+		Status:      merr.Success(),
+		MemoryBytes: estimate.MemoryBytes,
+		DiskBytes:   estimate.DiskBytes,
+		DurationMs:  estimate.Duration.Milliseconds(),
+	}
+	if err != nil {
+		resp.RejectReason = err.Error()
+	}
+	return resp, nil
+}
+
 // Deprecated: use CreateTask instead, keep for compatibility
 func (node *DataNode) CreateJobV2(ctx context.Context, req *workerpb.CreateJobV2Request) (*commonpb.Status, error) {
 	log := log.Ctx(ctx).With(
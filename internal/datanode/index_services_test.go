@@ -674,6 +674,38 @@ func (s *IndexServiceSuite) Test_CreateStatsTask() {
 	})
 }
 
+func (s *IndexServiceSuite) Test_EstimateJob() {
+	ctx := context.Background()
+	indexParams := []*commonpb.KeyValuePair{
+		{Key: common.IndexTypeKey, Value: "HNSW"},
+		{Key: common.MetricTypeKey, Value: "L2"},
+		{Key: "M", Value: "16"},
+	}
+
+	s.Run("fit", func() {
+		resp, err := s.node.EstimateJob(ctx, &workerpb.EstimateJobRequest{
+			IndexParams: indexParams,
+			NumRows:     s.numRows,
+			Dim:         8,
+		})
+		s.NoError(err)
+		s.NoError(merr.Error(resp.GetStatus()))
+		s.Greater(resp.GetMemoryBytes(), int64(0))
+		s.Empty(resp.GetRejectReason())
+	})
+
+	s.Run("rejected", func() {
+		resp, err := s.node.EstimateJob(ctx, &workerpb.EstimateJobRequest{
+			IndexParams: indexParams,
+			NumRows:     1 << 40,
+			Dim:         128,
+		})
+		s.NoError(err)
+		s.NoError(merr.Error(resp.GetStatus()))
+		s.NotEmpty(resp.GetRejectReason())
+	})
+}
+
 func generateTestSchema() *schemapb.CollectionSchema {
 	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
 		{FieldID: common.TimeStampField, Name: "ts", DataType: schemapb.DataType_Int64},
@@ -86,15 +86,25 @@ func (node *DataNode) getSystemInfoMetrics(ctx context.Context, _ *milvuspb.GetM
 		log.Ctx(ctx).Warn("get iowait failed", zap.Error(err))
 	}
 
+	ioStats, err := hardware.GetIOStats()
+	if err != nil {
+		log.Ctx(ctx).Warn("get io stats failed", zap.Error(err))
+		ioStats = &hardware.ContainerIOStats{}
+	}
+
 	hardwareMetrics := metricsinfo.HardwareMetrics{
-		IP:               node.session.Address,
-		CPUCoreCount:     hardware.GetCPUNum(),
-		CPUCoreUsage:     hardware.GetCPUUsage(),
-		Memory:           totalMem,
-		MemoryUsage:      usedMem,
-		Disk:             total,
-		DiskUsage:        used,
-		IOWaitPercentage: ioWait,
+		IP:                node.session.Address,
+		CPUCoreCount:      hardware.GetCPUNum(),
+		CPUCoreUsage:      hardware.GetCPUUsage(),
+		Memory:            totalMem,
+		MemoryUsage:       usedMem,
+		Disk:              total,
+		DiskUsage:         used,
+		IOWaitPercentage:  ioWait,
+		CPULimit:          hardware.GetCPULimit(),
+		CPUThrottledRatio: hardware.GetCPUThrottledRatio(),
+		IOReadBytes:       ioStats.ReadBytes,
+		IOWriteBytes:      ioStats.WriteBytes,
 	}
 	quotaMetrics.Hms = hardwareMetrics
 
@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode/compactor"
 	"github.com/milvus-io/milvus/internal/datanode/importv2"
 	"github.com/milvus-io/milvus/internal/flushcommon/io"
+	"github.com/milvus-io/milvus/internal/util/componentutil"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	"github.com/milvus-io/milvus/internal/util/importutilv2"
 	"github.com/milvus-io/milvus/pkg/v2/common"
@@ -118,18 +119,9 @@ func (node *DataNode) ShowConfigurations(ctx context.Context, req *internalpb.Sh
 			Configuations: nil,
 		}, nil
 	}
-	configList := make([]*commonpb.KeyValuePair, 0)
-	for key, value := range Params.GetComponentConfigurations("datanode", req.Pattern) {
-		configList = append(configList,
-			&commonpb.KeyValuePair{
-				Key:   key,
-				Value: value,
-			})
-	}
-
 	return &internalpb.ShowConfigurationsResponse{
 		Status:        merr.Success(),
-		Configuations: configList,
+		Configuations: componentutil.ShowConfigurations("datanode", req),
 	}, nil
 }
 
@@ -359,3 +359,9 @@ func (c *Client) DropTask(ctx context.Context, in *workerpb.DropTaskRequest, opt
 		return client.DropTask(ctx, in)
 	})
 }
+
+func (c *Client) EstimateJob(ctx context.Context, in *workerpb.EstimateJobRequest, opts ...grpc.CallOption) (*workerpb.EstimateJobResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client DataNodeClient) (*workerpb.EstimateJobResponse, error) {
+		return client.EstimateJob(ctx, in)
+	})
+}
@@ -99,6 +99,9 @@ func Test_NewClient(t *testing.T) {
 
 		r17, err := client.DropTask(ctx, nil)
 		retCheck(retNotNil, r17, err)
+
+		r18, err := client.EstimateJob(ctx, nil)
+		retCheck(retNotNil, r18, err)
 	}
 
 	client.(*Client).grpcClient = &mock2.GRPCClientBase[DataNodeClient]{
@@ -284,6 +287,14 @@ func TestIndexClient(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("EstimateJob", func(t *testing.T) {
+		mockIN.EXPECT().EstimateJob(mock.Anything, mock.Anything).Return(nil, nil)
+
+		req := &workerpb.EstimateJobRequest{}
+		_, err := client.EstimateJob(ctx, req)
+		assert.NoError(t, err)
+	})
+
 	err = client.Close()
 	assert.NoError(t, err)
 }
@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	_ "github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/workerpb"
@@ -48,6 +49,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/netutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 type Server struct {
@@ -144,6 +146,7 @@ func (s *Server) startGrpcLoop() {
 			}),
 		)),
 		grpc.StatsHandler(tracer.GetDynamicOtelGrpcServerStatsHandler()),
+		grpc.StatsHandler(metrics.NewGRPCPayloadStatsHandler(typeutil.DataNodeRole)),
 	}
 
 	grpcOpts = append(grpcOpts, utils.EnableInternalTLS("DataNode"))
@@ -399,3 +402,7 @@ func (s *Server) QueryTask(ctx context.Context, request *workerpb.QueryTaskReque
 func (s *Server) DropTask(ctx context.Context, request *workerpb.DropTaskRequest) (*commonpb.Status, error) {
 	return s.datanode.DropTask(ctx, request)
 }
+
+func (s *Server) EstimateJob(ctx context.Context, request *workerpb.EstimateJobRequest) (*workerpb.EstimateJobResponse, error) {
+	return s.datanode.EstimateJob(ctx, request)
+}
@@ -316,6 +316,66 @@ func (c *Client) ShowCollectionIDs(ctx context.Context, in *rootcoordpb.ShowColl
 	})
 }
 
+// SetCollectionQuotaOverride enables or disables a force deny/allow property of the collection.
+func (c *Client) SetCollectionQuotaOverride(ctx context.Context, in *rootcoordpb.SetCollectionQuotaOverrideRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	in = typeutil.Clone(in)
+	commonpbutil.UpdateMsgBase(
+		in.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.SetCollectionQuotaOverride(ctx, in)
+	})
+}
+
+// CreateCollectionTemplate creates a named preset of the schema skeleton, default indexes and properties.
+func (c *Client) CreateCollectionTemplate(ctx context.Context, in *rootcoordpb.CreateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	in = typeutil.Clone(in)
+	commonpbutil.UpdateMsgBase(
+		in.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.CreateCollectionTemplate(ctx, in)
+	})
+}
+
+// DropCollectionTemplate drops the collection template, the collections created from it are not affected.
+func (c *Client) DropCollectionTemplate(ctx context.Context, in *rootcoordpb.DropCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	in = typeutil.Clone(in)
+	commonpbutil.UpdateMsgBase(
+		in.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.DropCollectionTemplate(ctx, in)
+	})
+}
+
+// ListCollectionTemplates lists all the collection templates, or the given one if the name is specified.
+func (c *Client) ListCollectionTemplates(ctx context.Context, in *rootcoordpb.ListCollectionTemplatesRequest, opts ...grpc.CallOption) (*rootcoordpb.ListCollectionTemplatesResponse, error) {
+	in = typeutil.Clone(in)
+	commonpbutil.UpdateMsgBase(
+		in.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*rootcoordpb.ListCollectionTemplatesResponse, error) {
+		return client.ListCollectionTemplates(ctx, in)
+	})
+}
+
+// GetUsage returns the storage, the recent request rates and the applied rate limits of the collections and users.
+func (c *Client) GetUsage(ctx context.Context, in *rootcoordpb.GetUsageRequest, opts ...grpc.CallOption) (*rootcoordpb.GetUsageResponse, error) {
+	in = typeutil.Clone(in)
+	commonpbutil.UpdateMsgBase(
+		in.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*rootcoordpb.GetUsageResponse, error) {
+		return client.GetUsage(ctx, in)
+	})
+}
+
 func (c *Client) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	request = typeutil.Clone(request)
 	commonpbutil.UpdateMsgBase(
@@ -896,6 +956,90 @@ func (c *Client) FlushAll(ctx context.Context, req *datapb.FlushAllRequest, opts
 	})
 }
 
+// FlushAndSeal seals all the growing segments of the collection and waits for them to be flushed.
+func (c *Client) FlushAndSeal(ctx context.Context, req *datapb.FlushAndSealRequest, opts ...grpc.CallOption) (*datapb.FlushAndSealResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*datapb.FlushAndSealResponse, error) {
+		return client.FlushAndSeal(ctx, req)
+	})
+}
+
+// ListQuarantinedSegments lists the segments excluded from compaction and index building for unreadable logs.
+func (c *Client) ListQuarantinedSegments(ctx context.Context, req *datapb.ListQuarantinedSegmentsRequest, opts ...grpc.CallOption) (*datapb.ListQuarantinedSegmentsResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*datapb.ListQuarantinedSegmentsResponse, error) {
+		return client.ListQuarantinedSegments(ctx, req)
+	})
+}
+
+// OperateQuarantinedSegment validates, restores, repairs or drops a quarantined segment.
+func (c *Client) OperateQuarantinedSegment(ctx context.Context, req *datapb.OperateQuarantinedSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.OperateQuarantinedSegment(ctx, req)
+	})
+}
+
+// RebuildStatslogs regenerates the statslogs of the flushed segments from their binlogs.
+func (c *Client) RebuildStatslogs(ctx context.Context, req *datapb.RebuildStatslogsRequest, opts ...grpc.CallOption) (*datapb.RebuildStatslogsResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*datapb.RebuildStatslogsResponse, error) {
+		return client.RebuildStatslogs(ctx, req)
+	})
+}
+
+// GetStatslogRebuildJob gets the progress of the statslog rebuild job.
+func (c *Client) GetStatslogRebuildJob(ctx context.Context, req *datapb.GetStatslogRebuildJobRequest, opts ...grpc.CallOption) (*datapb.GetStatslogRebuildJobResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*datapb.GetStatslogRebuildJobResponse, error) {
+		return client.GetStatslogRebuildJob(ctx, req)
+	})
+}
+
+// ReportChannelHealth reports the health of the vchannel flowgraph from the streaming node.
+func (c *Client) ReportChannelHealth(ctx context.Context, req *datapb.ReportChannelHealthRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.ReportChannelHealth(ctx, req)
+	})
+}
+
+// ListBackgroundJobs lists the background jobs of the coordinators, e.g. compactions, index builds, imports and loads.
+func (c *Client) ListBackgroundJobs(ctx context.Context, req *datapb.ListBackgroundJobsRequest, opts ...grpc.CallOption) (*datapb.ListBackgroundJobsResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*datapb.ListBackgroundJobsResponse, error) {
+		return client.ListBackgroundJobs(ctx, req)
+	})
+}
+
 // AssignSegmentID applies allocations for specified Coolection/Partition and related Channel Name(Virtial Channel)
 //
 // ctx is the context to control request deadline and cancellation
@@ -1246,6 +1390,17 @@ func (c *Client) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmen
 	})
 }
 
+func (c *Client) UpdateSegmentStatslogs(ctx context.Context, req *datapb.UpdateSegmentStatslogsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client MixCoordClient) (*commonpb.Status, error) {
+		return client.UpdateSegmentStatslogs(ctx, req)
+	})
+}
+
 // BroadcastAlteredCollection is the DataCoord client side code for BroadcastAlteredCollection call.
 func (c *Client) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	req = typeutil.Clone(req)
@@ -117,6 +117,26 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.ShowCollectionIDs(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.SetCollectionQuotaOverride(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.CreateCollectionTemplate(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.DropCollectionTemplate(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListCollectionTemplates(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.GetUsage(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 		{
 			r, err := client.CreatePartition(ctx, nil)
 			retCheck(retNotNil, r, err)
@@ -493,6 +513,26 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.ShowCollectionIDs(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.SetCollectionQuotaOverride(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.CreateCollectionTemplate(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.DropCollectionTemplate(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListCollectionTemplates(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.GetUsage(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	{
 		rTimeout, err := client.CreatePartition(shortCtx, nil)
 		retCheck(rTimeout, err)
@@ -2661,3 +2701,186 @@ func Test_FlushAll(t *testing.T) {
 	_, err = client.FlushAll(ctx, &datapb.FlushAllRequest{})
 	assert.ErrorIs(t, err, context.DeadlineExceeded)
 }
+
+func Test_FlushAndSeal(t *testing.T) {
+	paramtable.Init()
+
+	ctx := context.Background()
+	client, err := NewClient(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	defer client.Close()
+
+	mockDC := mocks.NewMockDataCoordClient(t)
+	mockmix := MixCoordClient{
+		DataCoordClient: mockDC,
+	}
+	mockGrpcClient := mocks.NewMockGrpcClient[MixCoordClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().GetNodeID().Return(1)
+	mockGrpcClient.EXPECT().ReCall(mock1.Anything, mock1.Anything).RunAndReturn(func(ctx context.Context, f func(MixCoordClient) (interface{}, error)) (interface{}, error) {
+		return f(mockmix)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	// test success
+	mockDC.EXPECT().FlushAndSeal(mock1.Anything, mock1.Anything).Return(&datapb.FlushAndSealResponse{
+		Status: merr.Success(),
+	}, nil)
+	_, err = client.FlushAndSeal(ctx, &datapb.FlushAndSealRequest{})
+	assert.Nil(t, err)
+
+	// test return error status
+	mockDC.ExpectedCalls = nil
+	mockDC.EXPECT().FlushAndSeal(mock1.Anything, mock1.Anything).Return(&datapb.FlushAndSealResponse{
+		Status: merr.Status(merr.ErrServiceNotReady),
+	}, nil)
+
+	rsp, err := client.FlushAndSeal(ctx, &datapb.FlushAndSealRequest{})
+	assert.NotEqual(t, int32(0), rsp.GetStatus().GetCode())
+	assert.Nil(t, err)
+
+	// test return error
+	mockDC.ExpectedCalls = nil
+	mockDC.EXPECT().FlushAndSeal(mock1.Anything, mock1.Anything).Return(&datapb.FlushAndSealResponse{
+		Status: merr.Success(),
+	}, mockErr)
+
+	_, err = client.FlushAndSeal(ctx, &datapb.FlushAndSealRequest{})
+	assert.NotNil(t, err)
+
+	// test ctx done
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	time.Sleep(20 * time.Millisecond)
+	_, err = client.FlushAndSeal(ctx, &datapb.FlushAndSealRequest{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func Test_QuarantinedSegments(t *testing.T) {
+	paramtable.Init()
+
+	ctx := context.Background()
+	client, err := NewClient(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	defer client.Close()
+
+	mockDC := mocks.NewMockDataCoordClient(t)
+	mockmix := MixCoordClient{
+		DataCoordClient: mockDC,
+	}
+	mockGrpcClient := mocks.NewMockGrpcClient[MixCoordClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().GetNodeID().Return(1)
+	mockGrpcClient.EXPECT().ReCall(mock1.Anything, mock1.Anything).RunAndReturn(func(ctx context.Context, f func(MixCoordClient) (interface{}, error)) (interface{}, error) {
+		return f(mockmix)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	// test success
+	mockDC.EXPECT().ListQuarantinedSegments(mock1.Anything, mock1.Anything).Return(&datapb.ListQuarantinedSegmentsResponse{
+		Status: merr.Success(),
+	}, nil)
+	_, err = client.ListQuarantinedSegments(ctx, &datapb.ListQuarantinedSegmentsRequest{})
+	assert.Nil(t, err)
+
+	mockDC.EXPECT().OperateQuarantinedSegment(mock1.Anything, mock1.Anything).Return(merr.Success(), nil)
+	_, err = client.OperateQuarantinedSegment(ctx, &datapb.OperateQuarantinedSegmentRequest{})
+	assert.Nil(t, err)
+
+	mockDC.EXPECT().ReportChannelHealth(mock1.Anything, mock1.Anything).Return(merr.Success(), nil)
+	_, err = client.ReportChannelHealth(ctx, &datapb.ReportChannelHealthRequest{})
+	assert.Nil(t, err)
+
+	mockDC.EXPECT().RebuildStatslogs(mock1.Anything, mock1.Anything).Return(&datapb.RebuildStatslogsResponse{
+		Status: merr.Success(),
+	}, nil)
+	_, err = client.RebuildStatslogs(ctx, &datapb.RebuildStatslogsRequest{})
+	assert.Nil(t, err)
+
+	mockDC.EXPECT().GetStatslogRebuildJob(mock1.Anything, mock1.Anything).Return(&datapb.GetStatslogRebuildJobResponse{
+		Status: merr.Success(),
+	}, nil)
+	_, err = client.GetStatslogRebuildJob(ctx, &datapb.GetStatslogRebuildJobRequest{})
+	assert.Nil(t, err)
+
+	// test return error
+	mockDC.ExpectedCalls = nil
+	mockDC.EXPECT().ListQuarantinedSegments(mock1.Anything, mock1.Anything).Return(nil, mockErr)
+	_, err = client.ListQuarantinedSegments(ctx, &datapb.ListQuarantinedSegmentsRequest{})
+	assert.NotNil(t, err)
+
+	mockDC.EXPECT().OperateQuarantinedSegment(mock1.Anything, mock1.Anything).Return(nil, mockErr)
+	_, err = client.OperateQuarantinedSegment(ctx, &datapb.OperateQuarantinedSegmentRequest{})
+	assert.NotNil(t, err)
+
+	mockDC.EXPECT().ReportChannelHealth(mock1.Anything, mock1.Anything).Return(nil, mockErr)
+	_, err = client.ReportChannelHealth(ctx, &datapb.ReportChannelHealthRequest{})
+	assert.NotNil(t, err)
+
+	mockDC.EXPECT().RebuildStatslogs(mock1.Anything, mock1.Anything).Return(nil, mockErr)
+	_, err = client.RebuildStatslogs(ctx, &datapb.RebuildStatslogsRequest{})
+	assert.NotNil(t, err)
+
+	mockDC.EXPECT().GetStatslogRebuildJob(mock1.Anything, mock1.Anything).Return(nil, mockErr)
+	_, err = client.GetStatslogRebuildJob(ctx, &datapb.GetStatslogRebuildJobRequest{})
+	assert.NotNil(t, err)
+
+	// test ctx done
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	time.Sleep(20 * time.Millisecond)
+	_, err = client.ListQuarantinedSegments(ctx, &datapb.ListQuarantinedSegmentsRequest{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	_, err = client.OperateQuarantinedSegment(ctx, &datapb.OperateQuarantinedSegmentRequest{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	_, err = client.ReportChannelHealth(ctx, &datapb.ReportChannelHealthRequest{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	_, err = client.RebuildStatslogs(ctx, &datapb.RebuildStatslogsRequest{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	_, err = client.GetStatslogRebuildJob(ctx, &datapb.GetStatslogRebuildJobRequest{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func Test_ListBackgroundJobs(t *testing.T) {
+	paramtable.Init()
+
+	ctx := context.Background()
+	client, err := NewClient(ctx)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	defer client.Close()
+
+	mockDC := mocks.NewMockDataCoordClient(t)
+	mockmix := MixCoordClient{
+		DataCoordClient: mockDC,
+	}
+	mockGrpcClient := mocks.NewMockGrpcClient[MixCoordClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().GetNodeID().Return(1)
+	mockGrpcClient.EXPECT().ReCall(mock1.Anything, mock1.Anything).RunAndReturn(func(ctx context.Context, f func(MixCoordClient) (interface{}, error)) (interface{}, error) {
+		return f(mockmix)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	// test success
+	mockDC.EXPECT().ListBackgroundJobs(mock1.Anything, mock1.Anything).Return(&datapb.ListBackgroundJobsResponse{
+		Status: merr.Success(),
+	}, nil)
+	_, err = client.ListBackgroundJobs(ctx, &datapb.ListBackgroundJobsRequest{})
+	assert.Nil(t, err)
+
+	// test return error
+	mockDC.ExpectedCalls = nil
+	mockDC.EXPECT().ListBackgroundJobs(mock1.Anything, mock1.Anything).Return(nil, mockErr)
+	_, err = client.ListBackgroundJobs(ctx, &datapb.ListBackgroundJobsRequest{})
+	assert.NotNil(t, err)
+
+	// test ctx done
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	time.Sleep(20 * time.Millisecond)
+	_, err = client.ListBackgroundJobs(ctx, &datapb.ListBackgroundJobsRequest{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -402,6 +402,31 @@ func (s *Server) ShowCollectionIDs(ctx context.Context, in *rootcoordpb.ShowColl
 	return s.mixCoord.ShowCollectionIDs(ctx, in)
 }
 
+// SetCollectionQuotaOverride enables or disables a force deny/allow property of the collection.
+func (s *Server) SetCollectionQuotaOverride(ctx context.Context, in *rootcoordpb.SetCollectionQuotaOverrideRequest) (*commonpb.Status, error) {
+	return s.mixCoord.SetCollectionQuotaOverride(ctx, in)
+}
+
+// CreateCollectionTemplate creates a named preset of the schema skeleton, default indexes and properties.
+func (s *Server) CreateCollectionTemplate(ctx context.Context, in *rootcoordpb.CreateCollectionTemplateRequest) (*commonpb.Status, error) {
+	return s.mixCoord.CreateCollectionTemplate(ctx, in)
+}
+
+// DropCollectionTemplate drops the collection template, the collections created from it are not affected.
+func (s *Server) DropCollectionTemplate(ctx context.Context, in *rootcoordpb.DropCollectionTemplateRequest) (*commonpb.Status, error) {
+	return s.mixCoord.DropCollectionTemplate(ctx, in)
+}
+
+// ListCollectionTemplates lists all the collection templates, or the given one if the name is specified.
+func (s *Server) ListCollectionTemplates(ctx context.Context, in *rootcoordpb.ListCollectionTemplatesRequest) (*rootcoordpb.ListCollectionTemplatesResponse, error) {
+	return s.mixCoord.ListCollectionTemplates(ctx, in)
+}
+
+// GetUsage returns the storage, the recent request rates and the applied rate limits of the collections and users.
+func (s *Server) GetUsage(ctx context.Context, in *rootcoordpb.GetUsageRequest) (*rootcoordpb.GetUsageResponse, error) {
+	return s.mixCoord.GetUsage(ctx, in)
+}
+
 func (s *Server) AddCollectionField(ctx context.Context, in *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
 	return s.mixCoord.AddCollectionField(ctx, in)
 }
@@ -729,6 +754,41 @@ func (s *Server) FlushAll(ctx context.Context, req *datapb.FlushAllRequest) (*da
 	return s.mixCoord.FlushAll(ctx, req)
 }
 
+// FlushAndSeal seals all the growing segments of the collection and waits for them to be flushed.
+func (s *Server) FlushAndSeal(ctx context.Context, req *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error) {
+	return s.mixCoord.FlushAndSeal(ctx, req)
+}
+
+// ListQuarantinedSegments lists the segments excluded from compaction and index building for unreadable logs.
+func (s *Server) ListQuarantinedSegments(ctx context.Context, req *datapb.ListQuarantinedSegmentsRequest) (*datapb.ListQuarantinedSegmentsResponse, error) {
+	return s.mixCoord.ListQuarantinedSegments(ctx, req)
+}
+
+// OperateQuarantinedSegment validates, restores, repairs or drops a quarantined segment.
+func (s *Server) OperateQuarantinedSegment(ctx context.Context, req *datapb.OperateQuarantinedSegmentRequest) (*commonpb.Status, error) {
+	return s.mixCoord.OperateQuarantinedSegment(ctx, req)
+}
+
+// RebuildStatslogs regenerates the statslogs of the flushed segments from their binlogs.
+func (s *Server) RebuildStatslogs(ctx context.Context, req *datapb.RebuildStatslogsRequest) (*datapb.RebuildStatslogsResponse, error) {
+	return s.mixCoord.RebuildStatslogs(ctx, req)
+}
+
+// GetStatslogRebuildJob gets the progress of the statslog rebuild job.
+func (s *Server) GetStatslogRebuildJob(ctx context.Context, req *datapb.GetStatslogRebuildJobRequest) (*datapb.GetStatslogRebuildJobResponse, error) {
+	return s.mixCoord.GetStatslogRebuildJob(ctx, req)
+}
+
+// ReportChannelHealth reports the health of the vchannel flowgraph from the streaming node.
+func (s *Server) ReportChannelHealth(ctx context.Context, req *datapb.ReportChannelHealthRequest) (*commonpb.Status, error) {
+	return s.mixCoord.ReportChannelHealth(ctx, req)
+}
+
+// ListBackgroundJobs lists the background jobs of the coordinators, e.g. compactions, index builds, imports and loads.
+func (s *Server) ListBackgroundJobs(ctx context.Context, req *datapb.ListBackgroundJobsRequest) (*datapb.ListBackgroundJobsResponse, error) {
+	return s.mixCoord.ListBackgroundJobs(ctx, req)
+}
+
 // AssignSegmentID requests to allocate segment space for insert
 func (s *Server) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest) (*datapb.AssignSegmentIDResponse, error) {
 	return s.mixCoord.AssignSegmentID(ctx, req)
@@ -849,6 +909,11 @@ func (s *Server) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmen
 	return s.mixCoord.MarkSegmentsDropped(ctx, req)
 }
 
+// UpdateSegmentStatslogs is the distributed caller of UpdateSegmentStatslogs.
+func (s *Server) UpdateSegmentStatslogs(ctx context.Context, req *datapb.UpdateSegmentStatslogsRequest) (*commonpb.Status, error) {
+	return s.mixCoord.UpdateSegmentStatslogs(ctx, req)
+}
+
 func (s *Server) BroadcastAlteredCollection(ctx context.Context, request *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return s.mixCoord.BroadcastAlteredCollection(ctx, request)
 }
@@ -55,6 +55,7 @@ const (
 	GetAction            = "get"
 	DeleteAction         = "delete"
 	InsertAction         = "insert"
+	StreamInsertAction   = "stream_insert"
 	UpsertAction         = "upsert"
 	SearchAction         = "search"
 	AdvancedSearchAction = "advanced_search"
@@ -17,6 +17,8 @@
 package httpserver
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -122,6 +124,8 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(EntityCategory+InsertAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
 		return &CollectionDataReq{}
 	}, wrapperTraceLog(h.insert))), false))
+	// StreamInsert, the body is streamed, so neither the body is bound nor the timeout is applied
+	router.POST(EntityCategory+StreamInsertAction, restfulSizeMiddleware(h.streamInsert, false))
 	// Upsert
 	router.POST(EntityCategory+UpsertAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
 		return &CollectionDataReq{}
@@ -258,20 +262,25 @@ func wrapperPost(newReq newReqFunc, v2 handlerFuncV2) gin.HandlerFunc {
 				}
 			}
 		}
-		innerCtx := gCtx.Request.Context()
-		ctx, span := otel.Tracer(typeutil.ProxyRole).Start(innerCtx, gCtx.Request.URL.Path)
+		ctx, span := newRequestContext(gCtx, dbName)
 		defer span.End()
-		username, _ := gCtx.Get(ContextUsername)
-		ctx = proxy.NewContextWithMetadata(ctx, username.(string), dbName)
-		traceID := span.SpanContext().TraceID().String()
-		ctx = log.WithTraceID(ctx, traceID)
-		gCtx.Keys["traceID"] = traceID
 		log.Ctx(ctx).Debug("high level restful api, read parameters from request body, then start to handle.",
 			zap.Any("url", gCtx.Request.URL.Path))
 		v2(ctx, gCtx, req, dbName)
 	}
 }
 
+// newRequestContext starts the trace span of the request and sets the metadata of the caller into the context.
+func newRequestContext(gCtx *gin.Context, dbName string) (context.Context, trace.Span) {
+	ctx, span := otel.Tracer(typeutil.ProxyRole).Start(gCtx.Request.Context(), gCtx.Request.URL.Path)
+	username, _ := gCtx.Get(ContextUsername)
+	ctx = proxy.NewContextWithMetadata(ctx, username.(string), dbName)
+	traceID := span.SpanContext().TraceID().String()
+	ctx = log.WithTraceID(ctx, traceID)
+	gCtx.Keys["traceID"] = traceID
+	return ctx, span
+}
+
 // restfulSizeMiddleware is the middleware fetchs metrics stats from gin struct.
 func restfulSizeMiddleware(handler gin.HandlerFunc, observeOutbound bool) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -1137,6 +1146,153 @@ func (h *HandlersV2) insert(ctx context.Context, c *gin.Context, anyReq any, dbN
 	return resp, err
 }
 
+// streamInsert inserts the rows streamed in the request body as ndjson, the first line is the request
+// without data, e.g. {"collectionName": "book"}, and each following line is a row.
+// The rows are validated and inserted chunk by chunk, so the size of the request is not limited by the grpc
+// message size, but a single line should not be larger than the chunk size.
+// A single summarized response is returned. If a chunk fails, the insertion stops and the response reports
+// the offset of the first row not inserted, the rows before it are inserted and the ones from it are not.
+func (h *HandlersV2) streamInsert(c *gin.Context) {
+	chunkSize := proxy.Params.HTTPCfg.StreamInsertChunkSize.GetAsInt()
+	reader := bufio.NewReader(c.Request.Body)
+	line, readErr := readStreamLine(reader, chunkSize)
+	httpReq := &StreamInsertReq{}
+	if len(line) == 0 || json.Unmarshal(line, httpReq) != nil || httpReq.CollectionName == "" {
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: the first line should be the request with collectionName",
+		})
+		return
+	}
+	dbName := httpReq.DbName
+	if dbName == "" {
+		dbName = c.Request.Header.Get(HTTPHeaderDBName)
+		if dbName == "" {
+			dbName = DefaultDbName
+		}
+	}
+	ctx, span := newRequestContext(c, dbName)
+	defer span.End()
+	log := log.Ctx(ctx).With(zap.String("collection", httpReq.CollectionName))
+
+	collSchema, err := h.GetCollectionSchema(ctx, c, dbName, httpReq.CollectionName)
+	if err != nil {
+		return
+	}
+
+	var (
+		insertCount  int64
+		insertedRows int
+		intIDs       []int64
+		strIDs       []string
+		chunks       int
+		rows         [][]byte
+		rowsSize     int
+	)
+	insertChunk := func() error {
+		defer func() {
+			rows, rowsSize = rows[:0], 0
+		}()
+		body := make([]byte, 0, rowsSize+len(rows)+16)
+		body = append(body, `{"data":[`...)
+		for i, row := range rows {
+			if i > 0 {
+				body = append(body, ',')
+			}
+			body = append(body, row...)
+		}
+		body = append(body, "]}"...)
+
+		err, data, validDataMap := checkAndSetData(body, collSchema, false)
+		if err != nil {
+			return merr.WrapErrParameterInvalidMsg("%s, error: %s", merr.ErrInvalidInsertData.Error(), err.Error())
+		}
+		req := &milvuspb.InsertRequest{
+			DbName:         dbName,
+			CollectionName: httpReq.CollectionName,
+			PartitionName:  httpReq.PartitionName,
+			NumRows:        uint32(len(data)),
+		}
+		req.FieldsData, err = anyToColumns(data, validDataMap, collSchema, true, false)
+		if err != nil {
+			return merr.WrapErrParameterInvalidMsg("%s, error: %s", merr.ErrInvalidInsertData.Error(), err.Error())
+		}
+		c.Set(ContextRequest, req)
+		if _, err := CheckLimiter(ctx, req, h.proxy); err != nil {
+			return merr.WrapErrServiceRateLimit(0, err.Error())
+		}
+		resp, err := wrapperProxyWithLimit(ctx, c, req, h.checkAuth, true, "/milvus.proto.milvus.MilvusService/Insert", false, h.proxy, func(reqCtx context.Context, req any) (interface{}, error) {
+			return h.proxy.Insert(reqCtx, req.(*milvuspb.InsertRequest))
+		})
+		if err != nil {
+			return err
+		}
+		insertResp := resp.(*milvuspb.MutationResult)
+		insertCount += insertResp.GetInsertCnt()
+		intIDs = append(intIDs, insertResp.GetIDs().GetIntId().GetData()...)
+		strIDs = append(strIDs, insertResp.GetIDs().GetStrId().GetData()...)
+		insertedRows += len(rows)
+		chunks++
+		return nil
+	}
+
+	for err == nil && readErr == nil {
+		line, readErr = readStreamLine(reader, chunkSize)
+		if len(line) > 0 {
+			rows = append(rows, line)
+			rowsSize += len(line)
+		}
+		if len(rows) > 0 && (rowsSize >= chunkSize || readErr != nil) {
+			err = insertChunk()
+		}
+	}
+	if err == nil && readErr != io.EOF {
+		err = readErr
+		if !errors.Is(err, merr.ErrParameterInvalid) {
+			err = merr.WrapErrParameterInvalidMsg("failed to read the request body, error: %s", readErr.Error())
+		}
+	}
+
+	var insertIDs any = strIDs
+	if len(strIDs) == 0 {
+		insertIDs = intIDs
+		if allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64)); !allowJS {
+			insertIDs = formatInt64(intIDs)
+		}
+	}
+	data := gin.H{"insertCount": insertCount, "insertIds": insertIDs, "chunks": chunks}
+	if err != nil {
+		log.Warn("high level restful api, stream insert failed", zap.Int("chunk", chunks), zap.Int("failedRowOffset", insertedRows), zap.Int64("insertCount", insertCount), zap.Error(err))
+		data["failedRowOffset"] = insertedRows
+		HTTPReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(err),
+			HTTPReturnMessage: fmt.Sprintf("%s, failed at chunk %d, the rows from offset %d are not inserted", err.Error(), chunks, insertedRows),
+			HTTPReturnData:    data,
+		})
+		return
+	}
+	HTTPReturn(c, http.StatusOK, gin.H{
+		HTTPReturnCode: merr.Code(nil),
+		HTTPReturnData: data,
+	})
+}
+
+// readStreamLine reads the next line of the stream without the trailing spaces,
+// the line larger than maxSize is rejected before it is buffered as a whole.
+func readStreamLine(reader *bufio.Reader, maxSize int) ([]byte, error) {
+	var line []byte
+	for {
+		fragment, err := reader.ReadSlice('\n')
+		if len(line)+len(bytes.TrimRight(fragment, "\r\n")) > maxSize {
+			return nil, merr.WrapErrParameterInvalidMsg("the line of the streaming insert is larger than %d bytes", maxSize)
+		}
+		line = append(line, fragment...)
+		if err != bufio.ErrBufferFull {
+			return bytes.TrimSpace(line), err
+		}
+	}
+}
+
 func (h *HandlersV2) upsert(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	httpReq := anyReq.(*CollectionDataReq)
 	req := &milvuspb.UpsertRequest{
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
@@ -2295,6 +2296,86 @@ func TestAllowInt64(t *testing.T) {
 	validateTestCases(t, testEngine, queryTestCases, true)
 }
 
+func TestStreamInsert(t *testing.T) {
+	paramtable.Init()
+	// disable rate limit
+	paramtable.Get().Save(paramtable.Get().QuotaConfig.QuotaAndLimitsEnabled.Key, "false")
+	defer paramtable.Get().Reset(paramtable.Get().QuotaConfig.QuotaAndLimitsEnabled.Key)
+	// every row is inserted in its own chunk, and the rows larger than a row below are rejected
+	paramtable.Get().Save(paramtable.Get().HTTPCfg.StreamInsertChunkSize.Key, strconv.Itoa(len(`{"book_id": 1, "word_count": 1, "book_intro": [0.1, 0.2]}`)))
+	defer paramtable.Get().Reset(paramtable.Get().HTTPCfg.StreamInsertChunkSize.Key)
+
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionName: DefaultCollectionName,
+		Schema:         generateCollectionSchema(schemapb.DataType_Int64, false, true),
+		ShardsNum:      ShardNumDefault,
+		Status:         &StatusSuccess,
+	}, nil)
+	mp.EXPECT().Insert(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+		assert.EqualValues(t, 1, req.GetNumRows())
+		id := req.GetFieldsData()[0].GetScalars().GetLongData().GetData()[0]
+		return &milvuspb.MutationResult{Status: commonSuccessStatus, InsertCnt: 1, IDs: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{id}}}}}, nil
+	})
+	testEngine := initHTTPServerV2(mp, false)
+
+	streamInsert := func(body string) map[string]any {
+		req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, StreamInsertAction), bytes.NewReader([]byte(body)))
+		req.Header.Set(HTTPHeaderAllowInt64, "true")
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		result := make(map[string]any)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+		return result
+	}
+
+	t.Run("success", func(t *testing.T) {
+		result := streamInsert(`{"collectionName": "book"}
+{"book_id": 1, "word_count": 1, "book_intro": [0.1, 0.2]}
+
+{"book_id": 2, "word_count": 2, "book_intro": [0.1, 0.2]}
+{"book_id": 3, "word_count": 3, "book_intro": [0.1, 0.2]}`)
+		assert.EqualValues(t, 0, result[HTTPReturnCode])
+		data := result[HTTPReturnData].(map[string]any)
+		assert.EqualValues(t, 3, data["insertCount"])
+		assert.EqualValues(t, 3, data["chunks"])
+		assert.Equal(t, []any{float64(1), float64(2), float64(3)}, data["insertIds"])
+	})
+
+	t.Run("invalid row", func(t *testing.T) {
+		result := streamInsert(`{"collectionName": "book"}
+{"book_id": 1, "word_count": 1, "book_intro": [0.1, 0.2]}
+{"book_id": 2}
+{"book_id": 3, "word_count": 3, "book_intro": [0.1, 0.2]}
+`)
+		assert.EqualValues(t, merr.Code(merr.ErrParameterInvalid), result[HTTPReturnCode])
+		assert.Contains(t, result[HTTPReturnMessage], "failed at chunk 1")
+		data := result[HTTPReturnData].(map[string]any)
+		assert.EqualValues(t, 1, data["insertCount"])
+		assert.EqualValues(t, 1, data["chunks"])
+		assert.EqualValues(t, 1, data["failedRowOffset"])
+	})
+
+	t.Run("too large line", func(t *testing.T) {
+		result := streamInsert(`{"collectionName": "book"}
+{"book_id": 1, "word_count": 1, "book_intro": [0.1, 0.2]}
+{"book_id": 2, "word_count": 2, "book_intro": [0.1, 0.2222]}
+{"book_id": 3, "word_count": 3, "book_intro": [0.1, 0.2]}
+`)
+		assert.EqualValues(t, merr.Code(merr.ErrParameterInvalid), result[HTTPReturnCode])
+		assert.Contains(t, result[HTTPReturnMessage], "larger than 57 bytes")
+		data := result[HTTPReturnData].(map[string]any)
+		assert.EqualValues(t, 1, data["insertCount"])
+		assert.EqualValues(t, 1, data["failedRowOffset"])
+	})
+
+	t.Run("invalid header", func(t *testing.T) {
+		result := streamInsert(`{"book_id": 1, "word_count": 1, "book_intro": [0.1, 0.2]}`)
+		assert.EqualValues(t, merr.Code(merr.ErrIncorrectParameterFormat), result[HTTPReturnCode])
+	})
+}
+
 func generateCollectionSchemaWithVectorFields() *schemapb.CollectionSchema {
 	collSchema := generateCollectionSchema(schemapb.DataType_Int64, false, true)
 	binaryVectorField := generateVectorFieldSchema(schemapb.DataType_BinaryVector)
@@ -330,6 +330,15 @@ type CollectionDataReq struct {
 
 func (req *CollectionDataReq) GetDbName() string { return req.DbName }
 
+// StreamInsertReq is the first line of the streaming insert request body, the rows follow it line by line.
+type StreamInsertReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" binding:"required"`
+	PartitionName  string `json:"partitionName"`
+}
+
+func (req *StreamInsertReq) GetDbName() string { return req.DbName }
+
 type SearchReqV2 struct {
 	DbName           string                 `json:"dbName"`
 	CollectionName   string                 `json:"collectionName" binding:"required"`
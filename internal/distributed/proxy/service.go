@@ -107,6 +107,10 @@ type Server struct {
 
 	etcdCli        *clientv3.Client
 	mixCoordClient types.MixCoordClient
+
+	// unaryInterceptor is the interceptor chain of the external grpc server,
+	// the chunks of the streaming insert are intercepted by it one by one.
+	unaryInterceptor grpc.UnaryServerInterceptor
 }
 
 // NewServer create a Proxy server.
@@ -238,7 +242,7 @@ func (s *Server) startExternalGrpc(errChan chan error) {
 
 	var unaryServerOption grpc.ServerOption
 	if enableCustomInterceptor {
-		unaryServerOption = grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+		s.unaryInterceptor = grpc_middleware.ChainUnaryServer(
 			proxy.DatabaseInterceptor(),
 			UnaryRequestStatsInterceptor,
 			accesslog.UnaryAccessLogInterceptor,
@@ -250,7 +254,8 @@ func (s *Server) startExternalGrpc(errChan chan error) {
 			accesslog.UnaryUpdateAccessInfoInterceptor,
 			proxy.TraceLogInterceptor,
 			connection.KeepActiveInterceptor,
-		))
+		)
+		unaryServerOption = grpc.UnaryInterceptor(s.unaryInterceptor)
 	} else {
 		unaryServerOption = grpc.EmptyServerOption{}
 	}
@@ -319,6 +324,7 @@ func (s *Server) startExternalGrpc(errChan chan error) {
 	}
 
 	milvuspb.RegisterMilvusServiceServer(s.grpcExternalServer, s)
+	proxypb.RegisterStreamInsertServiceServer(s.grpcExternalServer, s)
 	grpc_health_v1.RegisterHealthServer(s.grpcExternalServer, s)
 	errChan <- nil
 
@@ -768,6 +774,75 @@ func (s *Server) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*
 	return s.proxy.Insert(ctx, request)
 }
 
+// StreamInsert inserts the rows streamed by the client chunk by chunk.
+// Every chunk is intercepted and inserted like a single Insert call, the insertion stops at the first failed chunk,
+// and the response reports the chunks inserted before it.
+func (s *Server) StreamInsert(stream proxypb.StreamInsertService_StreamInsertServer) error {
+	resp := &proxypb.StreamInsertResponse{Status: merr.Success()}
+	var first *milvuspb.InsertRequest
+	for {
+		request, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(resp)
+		}
+		if err != nil {
+			return err
+		}
+		if first == nil {
+			first = request
+		}
+		if request.GetDbName() == "" {
+			request.DbName = first.GetDbName()
+		}
+		if request.GetCollectionName() == "" {
+			request.CollectionName = first.GetCollectionName()
+		}
+		if request.GetPartitionName() == "" {
+			request.PartitionName = first.GetPartitionName()
+		}
+
+		result, err := s.insertChunk(stream.Context(), request)
+		if err == nil {
+			err = merr.Error(result.GetStatus())
+		}
+		if err != nil {
+			log.Ctx(stream.Context()).Warn("stream insert failed", zap.String("collection", request.GetCollectionName()),
+				zap.Int64("insertedChunks", resp.GetInsertedChunks()), zap.Int64("insertCnt", resp.GetInsertCnt()), zap.Error(err))
+			resp.Status = merr.Status(err)
+			return stream.SendAndClose(resp)
+		}
+		resp.InsertedChunks++
+		resp.InsertCnt += result.GetInsertCnt()
+		if resp.IDs == nil {
+			resp.IDs = result.GetIDs()
+			continue
+		}
+		if intIDs := result.GetIDs().GetIntId().GetData(); len(intIDs) > 0 {
+			resp.IDs.GetIntId().Data = append(resp.IDs.GetIntId().GetData(), intIDs...)
+		}
+		if strIDs := result.GetIDs().GetStrId().GetData(); len(strIDs) > 0 {
+			resp.IDs.GetStrId().Data = append(resp.IDs.GetStrId().GetData(), strIDs...)
+		}
+	}
+}
+
+// insertChunk inserts a chunk of the streaming insert through the interceptors of the Insert call.
+func (s *Server) insertChunk(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	if s.unaryInterceptor == nil {
+		return s.proxy.Insert(ctx, request)
+	}
+	resp, err := s.unaryInterceptor(ctx, request, &grpc.UnaryServerInfo{
+		Server:     s,
+		FullMethod: milvuspb.MilvusService_Insert_FullMethodName,
+	}, func(ctx context.Context, req any) (any, error) {
+		return s.proxy.Insert(ctx, req.(*milvuspb.InsertRequest))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*milvuspb.MutationResult), nil
+}
+
 func (s *Server) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	return s.proxy.Delete(ctx, request)
 }
@@ -21,6 +21,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"io"
 	"net/http/httptest"
 	"os"
 	"strconv"
@@ -42,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/federpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	grpcproxyclient "github.com/milvus-io/milvus/internal/distributed/proxy/client"
 	"github.com/milvus-io/milvus/internal/distributed/proxy/httpserver"
 	"github.com/milvus-io/milvus/internal/json"
@@ -50,7 +52,9 @@ import (
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	milvusmock "github.com/milvus-io/milvus/internal/util/mock"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/uniquegenerator"
@@ -1124,6 +1128,79 @@ func TestNotImplementedAPIs(t *testing.T) {
 	})
 }
 
+type mockStreamInsertServer struct {
+	grpc.ServerStream
+	requests []*milvuspb.InsertRequest
+	resp     *proxypb.StreamInsertResponse
+}
+
+func (s *mockStreamInsertServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *mockStreamInsertServer) Recv() (*milvuspb.InsertRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *mockStreamInsertServer) SendAndClose(resp *proxypb.StreamInsertResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func TestServer_StreamInsert(t *testing.T) {
+	server := getServer(t)
+	mockProxy := server.proxy.(*mocks.MockProxy)
+	mockProxy.EXPECT().Insert(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+		assert.Equal(t, "db", req.GetDbName())
+		assert.Equal(t, "coll", req.GetCollectionName())
+		return &milvuspb.MutationResult{
+			Status:    merr.Success(),
+			InsertCnt: int64(req.GetNumRows()),
+			IDs:       &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{int64(req.GetNumRows())}}}},
+		}, nil
+	})
+	newStream := func() *mockStreamInsertServer {
+		return &mockStreamInsertServer{requests: []*milvuspb.InsertRequest{
+			{DbName: "db", CollectionName: "coll", NumRows: 1},
+			{NumRows: 2},
+			{NumRows: 3},
+		}}
+	}
+
+	t.Run("normal", func(t *testing.T) {
+		stream := newStream()
+		assert.NoError(t, server.StreamInsert(stream))
+		assert.NoError(t, merr.Error(stream.resp.GetStatus()))
+		assert.EqualValues(t, 3, stream.resp.GetInsertedChunks())
+		assert.EqualValues(t, 6, stream.resp.GetInsertCnt())
+		assert.Equal(t, []int64{1, 2, 3}, stream.resp.GetIDs().GetIntId().GetData())
+	})
+
+	t.Run("intercepted", func(t *testing.T) {
+		calls := 0
+		server.unaryInterceptor = func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			assert.Equal(t, milvuspb.MilvusService_Insert_FullMethodName, info.FullMethod)
+			if calls++; calls == 2 {
+				return nil, merr.ErrServiceRateLimit
+			}
+			return handler(ctx, req)
+		}
+		defer func() { server.unaryInterceptor = nil }()
+
+		stream := newStream()
+		assert.NoError(t, server.StreamInsert(stream))
+		assert.ErrorIs(t, merr.Error(stream.resp.GetStatus()), merr.ErrServiceRateLimit)
+		assert.EqualValues(t, 1, stream.resp.GetInsertedChunks())
+		assert.EqualValues(t, 1, stream.resp.GetInsertCnt())
+		assert.Equal(t, 2, calls)
+	})
+}
+
 func TestHttpAuthenticate(t *testing.T) {
 	paramtable.Get().Save(proxy.Params.CommonCfg.AuthorizationEnabled.Key, "true")
 	defer paramtable.Get().Reset(proxy.Params.CommonCfg.AuthorizationEnabled.Key)
@@ -0,0 +1,45 @@
+package streaming
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+)
+
+// ManualFlush sends a manual flush message of the collection to the wal of the vchannel,
+// it returns the segments which are sealed by the flush.
+func ManualFlush(ctx context.Context, collectionID int64, vchannel string, flushTs uint64) ([]int64, error) {
+	logger := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID), zap.String("vchannel", vchannel))
+	flushMsg, err := message.NewManualFlushMessageBuilderV2().
+		WithVChannel(vchannel).
+		WithHeader(&message.ManualFlushMessageHeader{
+			CollectionId: collectionID,
+			FlushTs:      flushTs,
+		}).
+		WithBody(&message.ManualFlushMessageBody{}).
+		BuildMutable()
+	if err != nil {
+		logger.Warn("build manual flush message failed", zap.Error(err))
+		return nil, err
+	}
+
+	appendResult, err := WAL().RawAppend(ctx, flushMsg, AppendOption{
+		BarrierTimeTick: flushTs,
+	})
+	if err != nil {
+		logger.Warn("append manual flush message to wal failed", zap.Error(err))
+		return nil, err
+	}
+
+	var flushMsgResponse message.ManualFlushExtraResponse
+	if err := appendResult.GetExtra(&flushMsgResponse); err != nil {
+		logger.Warn("get extra from append result failed", zap.Error(err))
+		return nil, err
+	}
+	logger.Info("append manual flush message to wal successfully")
+
+	return flushMsgResponse.GetSegmentIds(), nil
+}
@@ -287,7 +287,8 @@ func (s *Server) initMeta() error {
 	} else if metaType == util.MetaStoreTypeEtcd {
 		metaRootPath = params.EtcdCfg.MetaRootPath.GetValue()
 		s.metaKV = etcdkv.NewEtcdKV(s.etcdCli, metaRootPath,
-			etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+			etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)),
+			etcdkv.WithSharedBulkClient(&paramtable.Get().ServiceParam.EtcdCfg))
 	}
 	return nil
 }
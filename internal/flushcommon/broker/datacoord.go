@@ -144,6 +144,19 @@ func (dc *dataCoordBroker) SaveBinlogPaths(ctx context.Context, req *datapb.Save
 	return nil
 }
 
+func (dc *dataCoordBroker) UpdateSegmentStatslogs(ctx context.Context, req *datapb.UpdateSegmentStatslogsRequest) error {
+	log := log.Ctx(ctx)
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(dc.serverID))
+	resp, err := dc.client.UpdateSegmentStatslogs(ctx, req)
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to UpdateSegmentStatslogs", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
 func (dc *dataCoordBroker) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error) {
 	log := log.Ctx(ctx)
 
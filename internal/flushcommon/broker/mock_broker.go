@@ -417,6 +417,53 @@ func (_c *MockBroker_UpdateChannelCheckpoint_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// UpdateSegmentStatslogs provides a mock function with given fields: ctx, req
+func (_m *MockBroker) UpdateSegmentStatslogs(ctx context.Context, req *datapb.UpdateSegmentStatslogsRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSegmentStatslogs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateSegmentStatslogsRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBroker_UpdateSegmentStatslogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSegmentStatslogs'
+type MockBroker_UpdateSegmentStatslogs_Call struct {
+	*mock.Call
+}
+
+// UpdateSegmentStatslogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *datapb.UpdateSegmentStatslogsRequest
+func (_e *MockBroker_Expecter) UpdateSegmentStatslogs(ctx interface{}, req interface{}) *MockBroker_UpdateSegmentStatslogs_Call {
+	return &MockBroker_UpdateSegmentStatslogs_Call{Call: _e.mock.On("UpdateSegmentStatslogs", ctx, req)}
+}
+
+func (_c *MockBroker_UpdateSegmentStatslogs_Call) Run(run func(ctx context.Context, req *datapb.UpdateSegmentStatslogsRequest)) *MockBroker_UpdateSegmentStatslogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.UpdateSegmentStatslogsRequest))
+	})
+	return _c
+}
+
+func (_c *MockBroker_UpdateSegmentStatslogs_Call) Return(_a0 error) *MockBroker_UpdateSegmentStatslogs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBroker_UpdateSegmentStatslogs_Call) RunAndReturn(run func(context.Context, *datapb.UpdateSegmentStatslogsRequest) error) *MockBroker_UpdateSegmentStatslogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockBroker creates a new instance of MockBroker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockBroker(t interface {
@@ -122,3 +122,12 @@ func (b *BinlogIoImpl) AsyncUpload(ctx context.Context, kvs map[string][]byte) [
 
 	return futures
 }
+
+// TagObject tags the object if the underlying ChunkManager supports tagging.
+func (b *BinlogIoImpl) TagObject(ctx context.Context, filePath string, tags map[string]string) error {
+	tagger, ok := b.ChunkManager.(storage.ObjectTagger)
+	if !ok {
+		return nil
+	}
+	return tagger.TagObject(ctx, filePath, tags)
+}
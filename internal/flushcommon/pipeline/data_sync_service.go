@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/streamingutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgdispatcher"
@@ -41,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/util/conc"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -100,6 +102,22 @@ func (dsService *DataSyncService) GracefullyClose() {
 	}
 }
 
+// Failed returns a channel which is closed once the flowgraph fails, e.g. a node panics.
+func (dsService *DataSyncService) Failed() <-chan struct{} {
+	if dsService.fg == nil {
+		return nil
+	}
+	return dsService.fg.Failed()
+}
+
+// Err returns the error which fails the flowgraph, nil if it's working.
+func (dsService *DataSyncService) Err() error {
+	if dsService.fg == nil {
+		return nil
+	}
+	return dsService.fg.Err()
+}
+
 func (dsService *DataSyncService) GetOpID() int64 {
 	return dsService.opID
 }
@@ -124,6 +142,7 @@ func (dsService *DataSyncService) close() {
 		// clean up metrics
 		pChan := funcutil.ToPhysicalChannel(dsService.vchannelName)
 		metrics.CleanupDataNodeCollectionMetrics(paramtable.GetNodeID(), dsService.collectionID, pChan)
+		metrics.CleanupDataNodeChannelMetrics(paramtable.GetNodeID(), dsService.vchannelName)
 
 		log.Info("dataSyncService closed")
 	})
@@ -134,15 +153,16 @@ func (dsService *DataSyncService) GetMetaCache() metacache.MetaCache {
 }
 
 func getMetaCacheForStreaming(initCtx context.Context, params *util.PipelineParams, info *datapb.ChannelWatchInfo, unflushed, flushed []*datapb.SegmentInfo) (metacache.MetaCache, error) {
-	return initMetaCache(initCtx, params.ChunkManager, info, nil, unflushed, flushed, params.SchemaManager)
+	return initMetaCache(initCtx, params, info, nil, unflushed, flushed)
 }
 
 func getMetaCacheWithTickler(initCtx context.Context, params *util.PipelineParams, info *datapb.ChannelWatchInfo, tickler *util.Tickler, unflushed, flushed []*datapb.SegmentInfo) (metacache.MetaCache, error) {
 	tickler.SetTotal(int32(len(unflushed) + len(flushed)))
-	return initMetaCache(initCtx, params.ChunkManager, info, tickler, unflushed, flushed, params.SchemaManager)
+	return initMetaCache(initCtx, params, info, tickler, unflushed, flushed)
 }
 
-func initMetaCache(initCtx context.Context, chunkManager storage.ChunkManager, info *datapb.ChannelWatchInfo, tickler interface{ Inc() }, unflushed, flushed []*datapb.SegmentInfo, schemaManager metacache.SchemaManager) (metacache.MetaCache, error) {
+func initMetaCache(initCtx context.Context, params *util.PipelineParams, info *datapb.ChannelWatchInfo, tickler interface{ Inc() }, unflushed, flushed []*datapb.SegmentInfo) (metacache.MetaCache, error) {
+	chunkManager := params.ChunkManager
 	// tickler will update addSegment progress to watchInfo
 	futures := make([]*conc.Future[any], 0, len(unflushed)+len(flushed))
 	// segmentPks := typeutil.NewConcurrentMap[int64, []*storage.PkStatistics]()
@@ -163,7 +183,10 @@ func initMetaCache(initCtx context.Context, chunkManager storage.ChunkManager, i
 				var err error
 				stats, err = compaction.LoadStats(initCtx, chunkManager, info.GetSchema(), segment.GetID(), segment.GetStatslogs())
 				if err != nil {
-					return nil, err
+					stats, err = rebuildSegmentStats(initCtx, params, info, segment, segType == "sealed", err)
+					if err != nil {
+						return nil, err
+					}
 				}
 				segmentPks.Insert(segment.GetID(), pkoracle.NewBloomFilterSet(stats...))
 				if tickler != nil {
@@ -187,7 +210,10 @@ func initMetaCache(initCtx context.Context, chunkManager storage.ChunkManager, i
 
 	// growing segments's stats should always be loaded, for generating merged pk bf.
 	loadSegmentStats("growing", unflushed)
-	if !(streamingutil.IsStreamingServiceEnabled() || paramtable.Get().DataNodeCfg.SkipBFStatsLoad.GetAsBool()) {
+	// the strict pk mode checks the duplicates against the flushed segments as well.
+	strictPKMode, _ := common.GetCollectionStrictPKMode(info.GetSchema().GetProperties())
+	strictPK := strictPKMode != ""
+	if strictPK || !(streamingutil.IsStreamingServiceEnabled() || paramtable.Get().DataNodeCfg.SkipBFStatsLoad.GetAsBool()) {
 		loadSegmentStats("sealed", flushed)
 	}
 
@@ -214,11 +240,56 @@ func initMetaCache(initCtx context.Context, chunkManager storage.ChunkManager, i
 		return segmentStats
 	}
 	// return channel, nil
-	metacache := metacache.NewMetaCache(info, pkStatsFactory, bm25StatsFactor, schemaManager)
+	metacache := metacache.NewMetaCache(info, pkStatsFactory, bm25StatsFactor, params.SchemaManager)
 
 	return metacache, nil
 }
 
+// rebuildSegmentStats rebuilds the pk stats of segment from its pk binlogs if loading statslog failed.
+// The rebuilt statslog replaces the statslogs of the segment in datacoord meta, so the corrupted ones are garbage collected.
+// A flushed segment gets a compound statslog, while a growing one gets a normal statslog which later syncs are appended to.
+func rebuildSegmentStats(ctx context.Context, params *util.PipelineParams, info *datapb.ChannelWatchInfo, segment *datapb.SegmentInfo, flushed bool, loadErr error) ([]*storage.PkStatistics, error) {
+	if !paramtable.Get().DataNodeCfg.StatsRebuildEnabled.GetAsBool() || ctx.Err() != nil {
+		return nil, loadErr
+	}
+	log := log.Ctx(ctx).With(zap.Int64("segmentID", segment.GetID()))
+	log.Warn("failed to load segment stats, try to rebuild it from pk binlogs", zap.Error(loadErr))
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+
+	logID := int64(storage.CompoundStatsType)
+	if !flushed {
+		var err error
+		if logID, err = params.Allocator.AllocOne(); err != nil {
+			metrics.DataNodeStatsLogRebuildCount.WithLabelValues(nodeID, metrics.FailLabel).Inc()
+			return nil, merr.Combine(loadErr, err)
+		}
+	}
+	stats, statslog, err := compaction.RebuildStats(ctx, params.ChunkManager, info.GetSchema(), segment, logID,
+		paramtable.Get().DataNodeCfg.StatsRebuildConcurrency.GetAsInt())
+	if err != nil {
+		metrics.DataNodeStatsLogRebuildCount.WithLabelValues(nodeID, metrics.FailLabel).Inc()
+		return nil, merr.Combine(loadErr, err)
+	}
+	metrics.DataNodeStatsLogRebuildCount.WithLabelValues(nodeID, metrics.SuccessLabel).Inc()
+	if statslog == nil {
+		return stats, nil
+	}
+
+	// the rebuilt statslog is left to the garbage collector if it's failed to be recorded in meta.
+	if err := params.Broker.UpdateSegmentStatslogs(ctx, &datapb.UpdateSegmentStatslogsRequest{
+		CollectionID: segment.GetCollectionID(),
+		SegmentID:    segment.GetID(),
+		Channel:      segment.GetInsertChannel(),
+		Statslogs:    []*datapb.FieldBinlog{statslog},
+	}); err != nil {
+		log.Warn("failed to replace segment statslogs with the rebuilt one", zap.Error(err))
+		return stats, nil
+	}
+	// later syncs carry the full statslogs of the segment, keep them consistent with meta.
+	segment.Statslogs = []*datapb.FieldBinlog{statslog}
+	return stats, nil
+}
+
 func getServiceWithChannel(initCtx context.Context, params *util.PipelineParams,
 	info *datapb.ChannelWatchInfo, metacache metacache.MetaCache,
 	unflushed, flushed []*datapb.SegmentInfo, input <-chan *msgstream.MsgPack,
@@ -310,7 +381,8 @@ func getServiceWithChannel(initCtx context.Context, params *util.PipelineParams,
 	err = params.WriteBufferManager.Register(channelName, metacache,
 		writebuffer.WithMetaWriter(syncmgr.BrokerMetaWriter(params.Broker, config.serverID)),
 		writebuffer.WithIDAllocator(params.Allocator),
-		writebuffer.WithTaskObserverCallback(wbTaskObserverCallback))
+		writebuffer.WithTaskObserverCallback(wbTaskObserverCallback),
+		writebuffer.WithPKVerifier(newPKVerifier(params, metacache)))
 	if err != nil {
 		log.Warn("failed to register channel buffer", zap.String("channel", channelName), zap.Error(err))
 		return nil, err
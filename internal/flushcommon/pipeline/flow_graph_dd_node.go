@@ -73,6 +73,9 @@ type ddNode struct {
 	growingSegInfo    map[typeutil.UniqueID]*datapb.SegmentInfo // segmentID
 	sealedSegInfo     map[typeutil.UniqueID]*datapb.SegmentInfo // segmentID
 	droppedSegmentIDs []int64
+
+	// failure stops the flowgraph, e.g. the insert message is corrupted
+	failure error
 }
 
 // Name returns node name, implementing flowgraph.Node
@@ -180,6 +183,11 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 				continue
 			}
 
+			if err := ddn.verifyInsertChecksum(imsg); err != nil {
+				ddn.failure = err
+				return []Msg{}
+			}
+
 			util.GetRateCollector().Add(metricsinfo.InsertConsumeThroughput, float64(proto.Size(imsg.InsertRequest)))
 
 			metrics.DataNodeConsumeBytesCount.
@@ -306,6 +314,35 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 	return []Msg{&fgMsg}
 }
 
+// verifyInsertChecksum verifies the rows of the insert message against the checksum computed by the proxy,
+// the mismatch means the rows are corrupted in the message queue or the serialization path.
+// The error is returned only if the channel should stop on mismatch.
+func (ddn *ddNode) verifyInsertChecksum(imsg *msgstream.InsertMsg) error {
+	checksum, ok, err := imsg.VerifyChecksum()
+	if !ok || err == nil {
+		return nil
+	}
+	metrics.DataNodeInsertChecksumMismatchCount.
+		WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), fmt.Sprint(ddn.collectionID)).
+		Inc()
+	log.Error("insert message corrupted, checksum mismatch",
+		zap.String("channel", ddn.vChannelName),
+		zap.Int64("segmentID", imsg.GetSegmentID()),
+		zap.Uint64("numRows", imsg.GetNumRows()),
+		zap.Uint32("checksum", checksum),
+		zap.Uint64("endTs", imsg.EndTs()),
+		zap.Error(err))
+	if paramtable.Get().DataNodeCfg.InsertChecksumStopOnMismatch.GetAsBool() {
+		return err
+	}
+	return nil
+}
+
+// Err returns the error which stops the flowgraph, implementing flowgraph.FailableNode
+func (ddn *ddNode) Err() error {
+	return ddn.failure
+}
+
 func (ddn *ddNode) tryToFilterSegmentInsertMessages(msg *msgstream.InsertMsg) bool {
 	if msg.GetShardName() != ddn.vChannelName {
 		return true
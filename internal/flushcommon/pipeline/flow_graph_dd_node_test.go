@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
@@ -585,6 +586,32 @@ func TestFlowGraph_DDNode_filterMessages(t *testing.T) {
 	})
 }
 
+func TestFlowGraph_DDNode_verifyInsertChecksum(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	defer params.Reset(params.DataNodeCfg.InsertChecksumStopOnMismatch.Key)
+
+	ddn := &ddNode{
+		ctx:          context.Background(),
+		collectionID: 1,
+		vChannelName: ddNodeChannelName,
+	}
+	msg := getInsertMsg(100, 10000)
+	msg.NumRows = 10
+	require.NoError(t, msg.SetChecksum())
+	assert.NoError(t, ddn.verifyInsertChecksum(msg))
+	// the messages without checksum are not verified
+	assert.NoError(t, ddn.verifyInsertChecksum(getInsertMsg(100, 10000)))
+
+	// the mismatch is only counted by default
+	msg.NumRows = 11
+	assert.NoError(t, ddn.verifyInsertChecksum(msg))
+
+	params.Save(params.DataNodeCfg.InsertChecksumStopOnMismatch.Key, "true")
+	assert.Error(t, ddn.verifyInsertChecksum(msg))
+	assert.NoError(t, ddn.Err())
+}
+
 func TestFlowGraph_DDNode_isDropped(t *testing.T) {
 	tests := []struct {
 		indroppedSegment []*datapb.SegmentInfo
@@ -0,0 +1,146 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	sio "io"
+	"math"
+
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/compaction"
+	"github.com/milvus-io/milvus/internal/flushcommon/io"
+	"github.com/milvus-io/milvus/internal/flushcommon/metacache"
+	"github.com/milvus-io/milvus/internal/flushcommon/util"
+	"github.com/milvus-io/milvus/internal/flushcommon/writebuffer"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// newPKVerifier returns the verifier of the strict pk mode, which reads the primary keys and the deletes
+// of the flushed segments from the storage.
+// The segments which are compacted or dropped already are treated as not containing the primary keys.
+func newPKVerifier(params *util.PipelineParams, metaCache metacache.MetaCache) writebuffer.PKVerifier {
+	binlogIO := io.NewBinlogIO(params.ChunkManager)
+	return func(ctx context.Context, segmentID int64, l0SegmentIDs []int64, pks []storage.PrimaryKey) ([]bool, error) {
+		schema := metaCache.GetSchema(math.MaxUint64)
+		pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+		if err != nil {
+			return nil, err
+		}
+		segments, err := params.Broker.GetSegmentInfo(ctx, append([]int64{segmentID}, l0SegmentIDs...))
+		if err != nil {
+			return nil, err
+		}
+		alive := make([]bool, len(pks))
+		segment, ok := lo.Find(segments, func(segment *datapb.SegmentInfo) bool { return segment.GetID() == segmentID })
+		if !ok || segment.GetState() == commonpb.SegmentState_Dropped {
+			return alive, nil
+		}
+
+		candidates := make(map[any]struct{}, len(pks))
+		for _, pk := range pks {
+			candidates[pk.GetValue()] = struct{}{}
+		}
+		rowTs, err := readSegmentPKTimestamps(ctx, binlogIO, schema, pkField, segment, candidates)
+		if err != nil {
+			return nil, err
+		}
+
+		var deltalogs []string
+		for _, s := range segments {
+			if s.GetID() != segmentID && (s.GetLevel() != datapb.SegmentLevel_L0 || s.GetState() == commonpb.SegmentState_Dropped) {
+				continue
+			}
+			for _, fieldBinlog := range s.GetDeltalogs() {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					deltalogs = append(deltalogs, binlog.GetLogPath())
+				}
+			}
+		}
+		deletes, err := compaction.ComposeDeleteFromDeltalogs(ctx, binlogIO, deltalogs)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, pk := range pks {
+			ts, ok := rowTs[pk.GetValue()]
+			alive[i] = ok && deletes[pk.GetValue()] < ts
+		}
+		return alive, nil
+	}
+}
+
+// readSegmentPKTimestamps returns the latest timestamp of the rows of the @candidates primary keys in the segment.
+func readSegmentPKTimestamps(ctx context.Context, binlogIO io.BinlogIO, schema *schemapb.CollectionSchema,
+	pkField *schemapb.FieldSchema, segment *datapb.SegmentInfo, candidates map[any]struct{},
+) (map[any]typeutil.Timestamp, error) {
+	neededFields := typeutil.NewSet(pkField.GetFieldID(), common.TimeStampField)
+	binlogs := segment.GetBinlogs()
+	if segment.GetStorageVersion() == storage.StorageV1 {
+		binlogs = lo.Filter(binlogs, func(fieldBinlog *datapb.FieldBinlog, _ int) bool {
+			return neededFields.Contain(fieldBinlog.GetFieldID())
+		})
+	}
+	reader, err := storage.NewBinlogRecordReader(ctx, binlogs, schema,
+		storage.WithCollectionID(segment.GetCollectionID()),
+		storage.WithDownloader(binlogIO.Download),
+		storage.WithVersion(segment.GetStorageVersion()),
+		storage.WithStorageConfig(compaction.CreateStorageConfig()),
+		storage.WithNeededFields(neededFields),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	rowTs := make(map[any]typeutil.Timestamp)
+	for {
+		r, err := reader.Next()
+		if err != nil {
+			if err == sio.EOF {
+				break
+			}
+			return nil, err
+		}
+		pkArray := r.Column(pkField.GetFieldID())
+		tsArray := r.Column(common.TimeStampField).(*array.Int64)
+		for i := range r.Len() {
+			var value any
+			switch pkField.GetDataType() {
+			case schemapb.DataType_Int64:
+				value = pkArray.(*array.Int64).Value(i)
+			case schemapb.DataType_VarChar:
+				value = pkArray.(*array.String).Value(i)
+			default:
+				return nil, merr.WrapErrParameterInvalidMsg("unsupported pk type %s", pkField.GetDataType().String())
+			}
+			if _, ok := candidates[value]; !ok {
+				continue
+			}
+			rowTs[value] = max(rowTs[value], typeutil.Timestamp(tsArray.Value(i)))
+		}
+	}
+	return rowTs, nil
+}
@@ -70,6 +70,51 @@ func (_c *MockSyncManager_Close_Call) RunAndReturn(run func() error) *MockSyncMa
 	return _c
 }
 
+// SlowTasksJSON provides a mock function with no fields
+func (_m *MockSyncManager) SlowTasksJSON() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SlowTasksJSON")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockSyncManager_SlowTasksJSON_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SlowTasksJSON'
+type MockSyncManager_SlowTasksJSON_Call struct {
+	*mock.Call
+}
+
+// SlowTasksJSON is a helper method to define mock.On call
+func (_e *MockSyncManager_Expecter) SlowTasksJSON() *MockSyncManager_SlowTasksJSON_Call {
+	return &MockSyncManager_SlowTasksJSON_Call{Call: _e.mock.On("SlowTasksJSON")}
+}
+
+func (_c *MockSyncManager_SlowTasksJSON_Call) Run(run func()) *MockSyncManager_SlowTasksJSON_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSyncManager_SlowTasksJSON_Call) Return(_a0 string) *MockSyncManager_SlowTasksJSON_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSyncManager_SlowTasksJSON_Call) RunAndReturn(run func() string) *MockSyncManager_SlowTasksJSON_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SyncData provides a mock function with given fields: ctx, task, callbacks
 func (_m *MockSyncManager) SyncData(ctx context.Context, task Task, callbacks ...func(error) error) (*conc.Future[struct{}], error) {
 	_va := make([]interface{}, len(callbacks))
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"time"
 
 	"github.com/apache/arrow/go/v17/arrow"
 	"github.com/apache/arrow/go/v17/arrow/array"
@@ -33,8 +34,11 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/faultinject"
 	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/retry"
 )
 
@@ -88,10 +92,13 @@ func (bw *BulkPackWriter) Write(ctx context.Context, pack *SyncPack) (
 		log.Error("failed to write insert data", zap.Error(err))
 		return
 	}
+	statsStart := time.Now()
 	if stats, err = bw.writeStats(ctx, pack); err != nil {
 		log.Error("failed to process stats blob", zap.Error(err))
 		return
 	}
+	metrics.DataNodeChannelStatsLogLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), pack.channelName).
+		Observe(float64(time.Since(statsStart).Milliseconds()))
 	if deltas, err = bw.writeDelta(ctx, pack); err != nil {
 		log.Error("failed to process delta blob", zap.Error(err))
 		return
@@ -145,12 +152,24 @@ func (bw *BulkPackWriter) nextID() int64 {
 	return r
 }
 
+// tagObjects tags the written objects of the segment if the ChunkManager supports tagging.
+func (bw *BulkPackWriter) tagObjects(ctx context.Context, pack *SyncPack, paths ...string) error {
+	tagger, _ := bw.chunkManager.(storage.ObjectTagger)
+	return storage.TagObjects(ctx, tagger, paths, storage.SegmentObjectTags(pack.collectionID, pack.segmentID))
+}
+
 func (bw *BulkPackWriter) writeLog(ctx context.Context, blob *storage.Blob,
 	root, p string, pack *SyncPack,
 ) (*datapb.Binlog, error) {
 	key := path.Join(bw.chunkManager.RootPath(), root, p)
 	err := retry.Do(ctx, func() error {
-		return bw.chunkManager.Write(ctx, key, blob.Value)
+		if err := faultinject.Inject(ctx, faultinject.DataNodeFlushUpload); err != nil {
+			return err
+		}
+		if err := bw.chunkManager.Write(ctx, key, blob.Value); err != nil {
+			return err
+		}
+		return bw.tagObjects(ctx, pack, key)
 	}, bw.writeRetryOpts...)
 	if err != nil {
 		return nil, err
@@ -189,6 +208,7 @@ func (bw *BulkPackWriter) writeInserts(ctx context.Context, pack *SyncPack) (map
 		if err != nil {
 			return nil, err
 		}
+		binlog.InsertChecksum = pack.insertChecksum
 		logs[fieldID] = &datapb.FieldBinlog{
 			FieldID: fieldID,
 			Binlogs: []*datapb.Binlog{binlog},
@@ -326,6 +346,7 @@ func (bw *BulkPackWriter) writeDelta(ctx context.Context, pack *SyncPack) (*data
 	logID := bw.nextID()
 	k := metautil.JoinIDPath(pack.collectionID, pack.partitionID, pack.segmentID, logID)
 	path := path.Join(bw.chunkManager.RootPath(), common.SegmentDeltaLogPath, k)
+	tagger, _ := bw.chunkManager.(storage.ObjectTagger)
 	writer, err := storage.NewDeltalogWriter(
 		ctx, pack.collectionID, pack.partitionID, pack.segmentID, logID, pkField.DataType, path,
 		storage.WithUploader(func(ctx context.Context, kvs map[string][]byte) error {
@@ -338,6 +359,7 @@ func (bw *BulkPackWriter) writeDelta(ctx context.Context, pack *SyncPack) (*data
 			}
 			return nil
 		}),
+		storage.WithObjectTagger(tagger),
 	)
 	if err != nil {
 		return nil, err
@@ -18,7 +18,9 @@ package syncmgr
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"time"
 
 	"github.com/apache/arrow/go/v17/arrow/array"
 	"github.com/apache/arrow/go/v17/arrow/memory"
@@ -32,9 +34,11 @@ import (
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexcgopb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/faultinject"
 	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/retry"
@@ -89,10 +93,13 @@ func (bw *BulkPackWriterV2) Write(ctx context.Context, pack *SyncPack) (
 		log.Error("failed to write insert data", zap.Error(err))
 		return
 	}
+	statsStart := time.Now()
 	if stats, err = bw.writeStats(ctx, pack); err != nil {
 		log.Error("failed to process stats blob", zap.Error(err))
 		return
 	}
+	metrics.DataNodeChannelStatsLogLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), pack.channelName).
+		Observe(float64(time.Since(statsStart).Milliseconds()))
 	if deltas, err = bw.writeDelta(ctx, pack); err != nil {
 		log.Error("failed to process delta blob", zap.Error(err))
 		return
@@ -129,6 +136,10 @@ func (bw *BulkPackWriterV2) writeInserts(ctx context.Context, pack *SyncPack) (m
 		return make(map[int64]*datapb.FieldBinlog), nil
 	}
 
+	if err := faultinject.Inject(ctx, faultinject.DataNodeFlushUpload); err != nil {
+		return nil, err
+	}
+
 	columnGroups := bw.columnGroups
 
 	rec, err := bw.serializeBinlog(ctx, pack)
@@ -185,6 +196,9 @@ func (bw *BulkPackWriterV2) writeInserts(ctx context.Context, pack *SyncPack) (m
 	if err = w.Close(); err != nil {
 		return nil, err
 	}
+	if err = bw.tagObjects(ctx, pack, paths...); err != nil {
+		return nil, err
+	}
 	for _, columnGroup := range columnGroups {
 		columnGroupID := columnGroup.GroupID
 		logs[columnGroupID] = &datapb.FieldBinlog{
@@ -192,12 +206,13 @@ func (bw *BulkPackWriterV2) writeInserts(ctx context.Context, pack *SyncPack) (m
 			ChildFields: columnGroup.Fields,
 			Binlogs: []*datapb.Binlog{
 				{
-					LogSize:       int64(w.GetColumnGroupWrittenCompressed(columnGroup.GroupID)),
-					MemorySize:    int64(w.GetColumnGroupWrittenUncompressed(columnGroup.GroupID)),
-					LogPath:       w.GetWrittenPaths(columnGroupID),
-					EntriesNum:    w.GetWrittenRowNum(),
-					TimestampFrom: tsFrom,
-					TimestampTo:   tsTo,
+					LogSize:        int64(w.GetColumnGroupWrittenCompressed(columnGroup.GroupID)),
+					MemorySize:     int64(w.GetColumnGroupWrittenUncompressed(columnGroup.GroupID)),
+					LogPath:        w.GetWrittenPaths(columnGroupID),
+					EntriesNum:     w.GetWrittenRowNum(),
+					TimestampFrom:  tsFrom,
+					TimestampTo:    tsTo,
+					InsertChecksum: pack.insertChecksum,
 				},
 			},
 		}
@@ -141,7 +141,7 @@ func (s *PackWriterV2Suite) TestPackWriterV2_Write() {
 		deletes.Append(pk, ts)
 	}
 
-	pack := new(SyncPack).WithCollectionID(collectionID).WithPartitionID(partitionID).WithSegmentID(segmentID).WithChannelName(channelName).WithInsertData(genInsertData(rows, s.schema)).WithDeleteData(deletes)
+	pack := new(SyncPack).WithCollectionID(collectionID).WithPartitionID(partitionID).WithSegmentID(segmentID).WithChannelName(channelName).WithInsertData(genInsertData(rows, s.schema)).WithDeleteData(deletes).WithInsertChecksum(12345)
 
 	bw := NewBulkPackWriterV2(mc, s.schema, s.cm, s.logIDAlloc, packed.DefaultWriteBufferSize, 0, nil, s.currentSplit)
 
@@ -150,6 +150,8 @@ func (s *PackWriterV2Suite) TestPackWriterV2_Write() {
 	s.Equal(gotInserts[0].Binlogs[0].GetEntriesNum(), int64(rows))
 	s.Equal(gotInserts[0].Binlogs[0].GetLogPath(), "/tmp/insert_log/123/456/789/0/1")
 	s.Equal(gotInserts[101].Binlogs[0].GetLogPath(), "/tmp/insert_log/123/456/789/101/2")
+	s.EqualValues(12345, gotInserts[0].Binlogs[0].GetInsertChecksum())
+	s.EqualValues(12345, gotInserts[101].Binlogs[0].GetInsertChecksum())
 }
 
 func (s *PackWriterV2Suite) TestWriteEmptyInsertData() {
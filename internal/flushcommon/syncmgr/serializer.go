@@ -42,6 +42,9 @@ type SyncPack struct {
 	insertData []*storage.InsertData
 	deltaData  *storage.DeleteData
 	bm25Stats  map[int64]*storage.BM25Stats
+	// insertChecksum is the combined checksum of the insert batches, which is recorded in the binlogs,
+	// nil if any of the batches is not checksummed.
+	insertChecksum *uint32
 
 	// statistics
 	tsFrom        typeutil.Timestamp
@@ -69,6 +72,12 @@ func (p *SyncPack) WithInsertData(insertData []*storage.InsertData) *SyncPack {
 	return p
 }
 
+// WithInsertChecksum sets the combined checksum of the insert batches computed by the proxy.
+func (p *SyncPack) WithInsertChecksum(checksum uint32) *SyncPack {
+	p.insertChecksum = &checksum
+	return p
+}
+
 func (p *SyncPack) WithDeleteData(deltaData *storage.DeleteData) *SyncPack {
 	p.deltaData = deltaData
 	return p
@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncmgr
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+type slowTaskRecord struct {
+	task   Task
+	cost   time.Duration
+	doneAt time.Time
+}
+
+// slowTaskRecorder keeps the slowest sync tasks finished in the recent window.
+type slowTaskRecorder struct {
+	mu       sync.Mutex
+	capacity int
+	window   time.Duration
+	// sorted by cost in descending order
+	records []slowTaskRecord
+}
+
+func newSlowTaskRecorder(capacity int, window time.Duration) *slowTaskRecorder {
+	return &slowTaskRecorder{
+		capacity: capacity,
+		window:   window,
+		records:  make([]slowTaskRecord, 0, capacity+1),
+	}
+}
+
+func (r *slowTaskRecorder) record(task Task, cost time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	r.removeExpired(now)
+	if len(r.records) >= r.capacity && cost <= r.records[len(r.records)-1].cost {
+		return
+	}
+	idx := sort.Search(len(r.records), func(i int) bool {
+		return r.records[i].cost < cost
+	})
+	r.records = append(r.records, slowTaskRecord{})
+	copy(r.records[idx+1:], r.records[idx:])
+	r.records[idx] = slowTaskRecord{task: task, cost: cost, doneAt: now}
+	if len(r.records) > r.capacity {
+		r.records = r.records[:r.capacity]
+	}
+}
+
+// list returns the recorded tasks, the slowest first.
+func (r *slowTaskRecorder) list() []Task {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.removeExpired(time.Now())
+	tasks := make([]Task, 0, len(r.records))
+	for _, record := range r.records {
+		tasks = append(tasks, record.task)
+	}
+	return tasks
+}
+
+func (r *slowTaskRecorder) removeExpired(now time.Time) {
+	records := r.records[:0]
+	for _, record := range r.records {
+		if now.Sub(record.doneAt) < r.window {
+			records = append(records, record)
+		}
+	}
+	r.records = records
+}
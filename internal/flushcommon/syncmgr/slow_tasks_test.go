@@ -0,0 +1,47 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncmgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlowTaskRecorder(t *testing.T) {
+	recorder := newSlowTaskRecorder(3, time.Minute)
+	for i, cost := range []time.Duration{5, 1, 9, 3, 7} {
+		recorder.record(&SyncTask{segmentID: int64(i)}, cost*time.Millisecond)
+	}
+
+	segmentIDs := lo.Map(recorder.list(), func(task Task, _ int) int64 {
+		return task.SegmentID()
+	})
+	assert.Equal(t, []int64{2, 4, 0}, segmentIDs)
+
+	t.Run("expired", func(t *testing.T) {
+		recorder := newSlowTaskRecorder(3, 100*time.Millisecond)
+		recorder.record(&SyncTask{segmentID: 1}, time.Second)
+		time.Sleep(200 * time.Millisecond)
+		assert.Empty(t, recorder.list())
+
+		recorder.record(&SyncTask{segmentID: 2}, time.Millisecond)
+		assert.Len(t, recorder.list(), 1)
+	})
+}
@@ -64,7 +64,11 @@ func (s *storageV1Serializer) serializeBinlog(ctx context.Context, pack *SyncPac
 		return make(map[int64]*storage.Blob), nil
 	}
 	log := log.Ctx(ctx)
-	blobs, err := s.inCodec.Serialize(pack.partitionID, pack.segmentID, pack.insertData...)
+	var opts []storage.BinlogWriterOptions
+	if pack.insertChecksum != nil {
+		opts = append(opts, storage.WithWriterInsertChecksum(*pack.insertChecksum))
+	}
+	blobs, err := s.inCodec.SerializeWithOptions(pack.partitionID, pack.segmentID, opts, pack.insertData...)
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +55,8 @@ type SyncManager interface {
 	// Close waits for the task to finish and then shuts down the sync manager.
 	Close() error
 	TaskStatsJSON() string
+	// SlowTasksJSON returns the slowest sync tasks finished recently.
+	SlowTasksJSON() string
 }
 
 type syncManager struct {
@@ -63,6 +65,7 @@ type syncManager struct {
 
 	tasks     *typeutil.ConcurrentMap[string, Task]
 	taskStats *expirable.LRU[string, Task]
+	slowTasks *slowTaskRecorder
 	handler   config.EventHandler
 }
 
@@ -78,6 +81,7 @@ func NewSyncManager(chunkManager storage.ChunkManager) SyncManager {
 		chunkManager:      chunkManager,
 		tasks:             typeutil.NewConcurrentMap[string, Task](),
 		taskStats:         expirable.NewLRU[string, Task](64, nil, time.Minute*15),
+		slowTasks:         newSlowTaskRecorder(32, time.Minute*15),
 	}
 	// setup config update watcher
 	handler := config.NewHandler("datanode.syncmgr.poolsize", syncMgr.resizeHandler)
@@ -150,6 +154,9 @@ func (mgr *syncManager) submit(ctx context.Context, key int64, task Task, callba
 			mgr.tasks.Remove(taskKey)
 		}()
 		if err == nil {
+			if syncTask, ok := task.(*SyncTask); ok {
+				mgr.slowTasks.record(task, syncTask.execTime)
+			}
 			return nil
 		}
 		task.HandleError(err)
@@ -175,6 +182,20 @@ func (mgr *syncManager) TaskStatsJSON() string {
 	return string(ret)
 }
 
+func (mgr *syncManager) SlowTasksJSON() string {
+	tasks := mgr.slowTasks.list()
+	if len(tasks) == 0 {
+		return ""
+	}
+
+	ret, err := json.Marshal(tasks)
+	if err != nil {
+		log.Warn("failed to marshal slow sync tasks", zap.Error(err))
+		return ""
+	}
+	return string(ret)
+}
+
 func (mgr *syncManager) Close() error {
 	paramtable.Get().Unwatch(paramtable.Get().DataNodeCfg.MaxParallelSyncMgrTasksPerCPUCore.Key, mgr.handler)
 	timeout := paramtable.Get().CommonCfg.SyncTaskPoolReleaseTimeoutSeconds.GetAsDuration(time.Second)
@@ -45,6 +45,9 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
+// flushLogModule is the log module of datanode flush, its level could be changed at runtime.
+const flushLogModule = "datanode.flush"
+
 type SyncTask struct {
 	chunkManager storage.ChunkManager
 	allocator    allocator.Interface
@@ -92,7 +95,7 @@ type SyncTask struct {
 }
 
 func (t *SyncTask) getLogger() *log.MLogger {
-	return log.Ctx(context.Background()).With(
+	return log.Module(context.Background(), flushLogModule).With(
 		zap.Int64("collectionID", t.collectionID),
 		zap.Int64("partitionID", t.partitionID),
 		zap.Int64("segmentID", t.segmentID),
@@ -197,6 +200,8 @@ func (t *SyncTask) Run(ctx context.Context) (err error) {
 
 	t.execTime = t.tr.ElapseSpan()
 	log.Info("task done", zap.Int64("flushedSize", t.flushedSize), zap.Duration("timeTaken", t.execTime))
+	metrics.DataNodeChannelFlushLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), t.channelName, t.level.String()).Observe(float64(t.execTime.Milliseconds()))
+	metrics.DataNodeChannelBinlogUploadSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), t.channelName).Observe(float64(t.flushedSize))
 
 	if !t.pack.isFlush {
 		metrics.DataNodeAutoFlushBufferCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.SuccessLabel, t.level.String()).Inc()
@@ -305,6 +310,7 @@ func (t *SyncTask) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(&metricsinfo.SyncTask{
 		SegmentID:     t.segmentID,
+		Channel:       t.channelName,
 		BatchRows:     t.batchRows,
 		SegmentLevel:  t.level.String(),
 		TSFrom:        tsoutil.PhysicalTimeFormat(t.tsFrom),
@@ -0,0 +1,262 @@
+package writebuffer
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	deltaWALPathPrefix = "delta_wal"
+	deltaWALFileSuffix = ".wal"
+)
+
+// deltaWALRecord is a batch of deletes written to the delta wal.
+type deltaWALRecord struct {
+	SegmentID   int64    `json:"segment_id"`
+	PartitionID int64    `json:"partition_id"`
+	Int64Pks    []int64  `json:"int64_pks,omitempty"`
+	VarCharPks  []string `json:"varchar_pks,omitempty"`
+	Tss         []uint64 `json:"tss"`
+}
+
+func (r *deltaWALRecord) primaryKeys() *schemapb.IDs {
+	if len(r.VarCharPks) > 0 {
+		return &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: r.VarCharPks}}}
+	}
+	return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: r.Int64Pks}}}
+}
+
+// filter keeps the deletes before the timestamp only.
+func (r *deltaWALRecord) filter(ts uint64) *deltaWALRecord {
+	result := &deltaWALRecord{SegmentID: r.SegmentID, PartitionID: r.PartitionID}
+	for i, t := range r.Tss {
+		if t >= ts {
+			continue
+		}
+		result.Tss = append(result.Tss, t)
+		if len(r.VarCharPks) > 0 {
+			result.VarCharPks = append(result.VarCharPks, r.VarCharPks[i])
+		} else {
+			result.Int64Pks = append(result.Int64Pks, r.Int64Pks[i])
+		}
+	}
+	return result
+}
+
+// deltaWAL is a local write-ahead log of the buffered deletes of a channel.
+// The deletes of each segment are appended to the active file of the segment,
+// which is sealed once the delta buffer is yielded to sync, and removed after the sync is done.
+// So the files left after a crash contain the deletes which are not synced yet.
+type deltaWAL struct {
+	dir       string
+	syncWrite bool
+
+	mu    sync.Mutex
+	files map[int64]*os.File // segmentID -> active file
+}
+
+func getDeltaWALDir(channel string) string {
+	return filepath.Join(paramtable.Get().LocalStorageCfg.Path.GetValue(), deltaWALPathPrefix, channel)
+}
+
+func newDeltaWAL(dir string) (*deltaWAL, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &deltaWAL{
+		dir:       dir,
+		syncWrite: paramtable.Get().DataNodeCfg.DeltaWALSyncWrite.GetAsBool(),
+		files:     make(map[int64]*os.File),
+	}, nil
+}
+
+func (w *deltaWAL) activePath(segmentID int64) string {
+	return filepath.Join(w.dir, fmt.Sprintf("%d%s", segmentID, deltaWALFileSuffix))
+}
+
+func (w *deltaWAL) sealedPath(segmentID int64, ts uint64) string {
+	return filepath.Join(w.dir, fmt.Sprintf("%d-%d%s", segmentID, ts, deltaWALFileSuffix))
+}
+
+// Append writes the deletes of the segment to the log.
+func (w *deltaWAL) Append(segmentID, partitionID int64, pks *schemapb.IDs, tss []uint64) error {
+	record := &deltaWALRecord{
+		SegmentID:   segmentID,
+		PartitionID: partitionID,
+		Int64Pks:    pks.GetIntId().GetData(),
+		VarCharPks:  pks.GetStrId().GetData(),
+		Tss:         tss,
+	}
+	bs, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	file, ok := w.files[segmentID]
+	if !ok {
+		file, err = os.OpenFile(w.activePath(segmentID), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return err
+		}
+		w.files[segmentID] = file
+	}
+	if _, err := file.Write(append(bs, '\n')); err != nil {
+		return err
+	}
+	if w.syncWrite {
+		return file.Sync()
+	}
+	return nil
+}
+
+// Seal seals the active file of the segment with the start timestamp of the sync task,
+// the following deletes are written to a new active file.
+func (w *deltaWAL) Seal(segmentID int64, ts uint64) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	file, ok := w.files[segmentID]
+	if !ok {
+		return nil
+	}
+	delete(w.files, segmentID)
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(w.activePath(segmentID), w.sealedPath(segmentID, ts))
+}
+
+// Remove removes the sealed file once the sync task is done.
+func (w *deltaWAL) Remove(segmentID int64, ts uint64) error {
+	err := os.Remove(w.sealedPath(segmentID, ts))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// Replay reads the deletes left in the log before the timestamp, the deletes after the timestamp are dropped
+// since they will be consumed again. The replayed files are returned and kept until Clean is invoked,
+// the caller shall append and buffer the returned deletes again before that. It shall be invoked before any Append.
+func (w *deltaWAL) Replay(ts uint64) ([]*deltaWALRecord, []string, error) {
+	entries, err := os.ReadDir(w.dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), deltaWALFileSuffix) {
+			names = append(names, entry.Name())
+		}
+	}
+	// replay the sealed files before the active file of the same segment
+	sort.Slice(names, func(i, j int) bool {
+		return deltaWALFileOrder(names[i]) < deltaWALFileOrder(names[j])
+	})
+
+	var records []*deltaWALRecord
+	paths := make([]string, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(w.dir, name)
+		fileRecords, err := readDeltaWALFile(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, record := range fileRecords {
+			if record = record.filter(ts); len(record.Tss) > 0 {
+				records = append(records, record)
+			}
+		}
+		paths = append(paths, path)
+	}
+	return records, paths, nil
+}
+
+// Clean removes the replayed files once the replayed deletes are appended to the active files again,
+// the active files are synced first so that the deletes are not lost if it crashes in between.
+// The replayed deletes are written to the files of the new segments, so the replayed files are never reused.
+func (w *deltaWAL) Clean(paths []string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, file := range w.files {
+		if err := file.Sync(); err != nil {
+			return err
+		}
+	}
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deltaWALFileOrder returns the sort key of the file, the active file is ordered after the sealed ones.
+func deltaWALFileOrder(name string) string {
+	name = strings.TrimSuffix(name, deltaWALFileSuffix)
+	segmentID, ts, sealed := strings.Cut(name, "-")
+	if !sealed {
+		ts = strconv.FormatUint(^uint64(0), 10)
+	}
+	return fmt.Sprintf("%020s-%020s", segmentID, ts)
+}
+
+func readDeltaWALFile(path string) ([]*deltaWALRecord, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var records []*deltaWALRecord
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		record := &deltaWALRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil || len(record.Tss) != len(record.Int64Pks)+len(record.VarCharPks) {
+			// the tail record may be torn by the crash, it's not acked so that could be dropped
+			log.Warn("invalid delta wal record, skip the rest of the file", zap.String("path", path), zap.Error(err))
+			break
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
+
+// Close closes the active files, the files are kept for the recovery.
+func (w *deltaWAL) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var err error
+	for segmentID, file := range w.files {
+		err = merr.Combine(err, file.Close())
+		delete(w.files, segmentID)
+	}
+	return err
+}
+
+// Drop closes and removes all the files of the log.
+func (w *deltaWAL) Drop() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for segmentID, file := range w.files {
+		file.Close()
+		delete(w.files, segmentID)
+	}
+	return os.RemoveAll(w.dir)
+}
@@ -0,0 +1,144 @@
+package writebuffer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type DeltaWALSuite struct {
+	suite.Suite
+}
+
+func (s *DeltaWALSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *DeltaWALSuite) newWAL() *deltaWAL {
+	wal, err := newDeltaWAL(filepath.Join(s.T().TempDir(), "channel"))
+	s.Require().NoError(err)
+	return wal
+}
+
+func int64IDs(pks ...int64) *schemapb.IDs {
+	return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}}
+}
+
+func (s *DeltaWALSuite) TestReplay() {
+	s.Run("int64_pk", func() {
+		wal := s.newWAL()
+		s.NoError(wal.Append(1, 10, int64IDs(1, 2), []uint64{100, 101}))
+		s.NoError(wal.Append(2, 20, int64IDs(3), []uint64{102}))
+		s.NoError(wal.Append(1, 10, int64IDs(4, 5), []uint64{103, 200}))
+
+		records, paths, err := wal.Replay(200)
+		s.NoError(err)
+		s.Len(records, 3)
+		s.Len(paths, 2)
+		s.Equal([]int64{1, 2}, records[0].Int64Pks)
+		s.Equal([]int64{4}, records[1].Int64Pks)
+		s.Equal([]uint64{103}, records[1].Tss)
+		s.EqualValues(20, records[2].PartitionID)
+
+		// files are kept until the replayed deletes are written again
+		records, _, err = wal.Replay(200)
+		s.NoError(err)
+		s.Len(records, 3)
+
+		// the replayed deletes are written to the new segment before the clean
+		s.NoError(wal.Append(3, 10, records[0].primaryKeys(), records[0].Tss))
+		s.NoError(wal.Clean(paths))
+		for _, path := range paths {
+			s.NoFileExists(path)
+		}
+		records, _, err = wal.Replay(200)
+		s.NoError(err)
+		s.Require().Len(records, 1)
+		s.EqualValues(3, records[0].SegmentID)
+	})
+
+	s.Run("varchar_pk", func() {
+		wal := s.newWAL()
+		pks := &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", "b"}}}}
+		s.NoError(wal.Append(1, 10, pks, []uint64{100, 101}))
+
+		records, _, err := wal.Replay(200)
+		s.NoError(err)
+		s.Require().Len(records, 1)
+		s.Equal(pks.GetStrId().GetData(), records[0].primaryKeys().GetStrId().GetData())
+	})
+
+	s.Run("sealed_before_active", func() {
+		wal := s.newWAL()
+		s.NoError(wal.Append(1, 10, int64IDs(1), []uint64{100}))
+		s.NoError(wal.Seal(1, 100))
+		s.NoError(wal.Append(1, 10, int64IDs(2), []uint64{101}))
+
+		records, _, err := wal.Replay(200)
+		s.NoError(err)
+		s.Require().Len(records, 2)
+		s.Equal([]int64{1}, records[0].Int64Pks)
+		s.Equal([]int64{2}, records[1].Int64Pks)
+	})
+
+	s.Run("torn_record", func() {
+		wal := s.newWAL()
+		s.NoError(wal.Append(1, 10, int64IDs(1), []uint64{100}))
+		f, err := os.OpenFile(wal.activePath(1), os.O_WRONLY|os.O_APPEND, 0o644)
+		s.Require().NoError(err)
+		_, err = f.WriteString(`{"segment_id":1,"partition_id":10,"int64_p`)
+		s.NoError(err)
+		f.Close()
+
+		records, _, err := wal.Replay(200)
+		s.NoError(err)
+		s.Len(records, 1)
+	})
+}
+
+func (s *DeltaWALSuite) TestSealAndRemove() {
+	wal := s.newWAL()
+	s.NoError(wal.Seal(1, 100))
+	s.NoError(wal.Remove(1, 100))
+
+	s.NoError(wal.Append(1, 10, int64IDs(1), []uint64{100}))
+	s.NoError(wal.Seal(1, 100))
+	s.FileExists(wal.sealedPath(1, 100))
+	s.NoFileExists(wal.activePath(1))
+
+	s.NoError(wal.Remove(1, 100))
+	s.NoFileExists(wal.sealedPath(1, 100))
+
+	records, paths, err := wal.Replay(200)
+	s.NoError(err)
+	s.Empty(records)
+	s.Empty(paths)
+}
+
+func (s *DeltaWALSuite) TestClose() {
+	wal := s.newWAL()
+	s.NoError(wal.Append(1, 10, int64IDs(1), []uint64{100}))
+	s.NoError(wal.Close())
+	s.FileExists(wal.activePath(1))
+
+	// the deletes are recovered after close
+	records, _, err := wal.Replay(200)
+	s.NoError(err)
+	s.Len(records, 1)
+}
+
+func (s *DeltaWALSuite) TestDrop() {
+	wal := s.newWAL()
+	s.NoError(wal.Append(1, 10, int64IDs(1), []uint64{100}))
+	s.NoError(wal.Drop())
+	s.NoDirExists(wal.dir)
+}
+
+func TestDeltaWAL(t *testing.T) {
+	suite.Run(t, new(DeltaWALSuite))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -74,8 +75,18 @@ type InsertBuffer struct {
 	BufferBase
 	collSchema *schemapb.CollectionSchema
 
+	// columns is the typed columnar buffer which the incoming data is appended to,
+	// it's handed off to the serializer as is when yielded.
+	columns *storage.InsertData
+	// buffers holds the sealed columns and the data which could not be appended to the columns
 	buffers     []*storage.InsertData
 	statsBuffer *statsBuffer
+
+	// checksum is the combined checksum of the buffered insert messages in order,
+	// it's not recorded once any buffered message is not checksummed.
+	checksum        uint32
+	checksumBatches int
+	unchecksummed   bool
 }
 
 func NewInsertBuffer(sch *schemapb.CollectionSchema) (*InsertBuffer, error) {
@@ -108,12 +119,33 @@ func NewInsertBuffer(sch *schemapb.CollectionSchema) (*InsertBuffer, error) {
 }
 
 func (ib *InsertBuffer) buffer(inData *storage.InsertData, tr TimeRange, startPos, endPos *msgpb.MsgPosition) {
-	// buffer := ib.currentBuffer()
-	// storage.MergeInsertData(buffer.buffer, inData)
-	ib.buffers = append(ib.buffers, inData)
+	if ib.columns == nil {
+		columns, err := storage.NewInsertDataWithCap(ib.collSchema, 0, true)
+		if err != nil {
+			log.Warn("failed to create columnar insert buffer", zap.Error(err))
+			ib.buffers = append(ib.buffers, inData)
+			return
+		}
+		ib.columns = columns
+	}
+	// the columns are appended in batch, so the insert message could be released once buffered
+	if err := storage.AppendInsertData(ib.columns, inData); err != nil {
+		log.RatedWarn(10, "failed to append insert data to columnar buffer, keep it as a separate chunk", zap.Error(err))
+		// seal the columns to keep the order of rows
+		ib.sealColumns()
+		ib.buffers = append(ib.buffers, inData)
+	}
+}
+
+func (ib *InsertBuffer) sealColumns() {
+	if ib.columns != nil && !ib.columns.IsEmpty() {
+		ib.buffers = append(ib.buffers, ib.columns)
+	}
+	ib.columns = nil
 }
 
 func (ib *InsertBuffer) Yield() []*storage.InsertData {
+	ib.sealColumns()
 	result := ib.buffers
 	// set buffer nil to so that fragmented buffer could get GCed
 	ib.buffers = nil
@@ -141,10 +173,27 @@ func (ib *InsertBuffer) Buffer(inData *InsertData, startPos, endPos *msgpb.MsgPo
 	if inData.bm25Stats != nil {
 		ib.statsBuffer.Buffer(inData.bm25Stats)
 	}
+	if len(inData.checksums) != len(inData.data) {
+		ib.unchecksummed = true
+	}
+	for _, checksum := range inData.checksums {
+		ib.checksum = msgstream.CombineChecksum(ib.checksum, checksum)
+		ib.checksumBatches++
+	}
 
 	return bufferedSize
 }
 
+// Checksum returns the combined checksum of the buffered insert messages,
+// nil if there is none or any of them is not checksummed.
+func (ib *InsertBuffer) Checksum() *uint32 {
+	if ib.unchecksummed || ib.checksumBatches == 0 {
+		return nil
+	}
+	checksum := ib.checksum
+	return &checksum
+}
+
 func (ib *InsertBuffer) getTimestampRange(tsData *storage.Int64FieldData) TimeRange {
 	tr := TimeRange{
 		timestampMin: math.MaxUint64,
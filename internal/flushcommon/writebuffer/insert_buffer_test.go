@@ -144,6 +144,32 @@ func (s *InsertBufferSuite) TestBuffer() {
 	s.EqualValues(5367, memSize)
 }
 
+func (s *InsertBufferSuite) TestChecksum() {
+	_, msg1 := s.composeInsertMsg(10, 128)
+	_, msg2 := s.composeInsertMsg(10, 128)
+	s.Require().NoError(msg1.SetChecksum())
+	s.Require().NoError(msg2.SetChecksum())
+	checksum1, _, _ := msg1.GetChecksum()
+	checksum2, _, _ := msg2.GetChecksum()
+
+	insertBuffer, err := NewInsertBuffer(s.collSchema)
+	s.Require().NoError(err)
+	s.Nil(insertBuffer.Checksum())
+
+	groups, err := PrepareInsert(s.collSchema, s.pkField, []*msgstream.InsertMsg{msg1, msg2})
+	s.Require().NoError(err)
+	insertBuffer.Buffer(groups[0], &msgpb.MsgPosition{Timestamp: 100}, &msgpb.MsgPosition{Timestamp: 200})
+	s.Require().NotNil(insertBuffer.Checksum())
+	s.Equal(msgstream.CombineChecksum(msgstream.CombineChecksum(0, checksum1), checksum2), *insertBuffer.Checksum())
+
+	// not recorded once any message is not checksummed
+	_, msg3 := s.composeInsertMsg(10, 128)
+	groups, err = PrepareInsert(s.collSchema, s.pkField, []*msgstream.InsertMsg{msg3})
+	s.Require().NoError(err)
+	insertBuffer.Buffer(groups[0], &msgpb.MsgPosition{Timestamp: 100}, &msgpb.MsgPosition{Timestamp: 200})
+	s.Nil(insertBuffer.Checksum())
+}
+
 func (s *InsertBufferSuite) TestYield() {
 	insertBuffer, err := NewInsertBuffer(s.collSchema)
 	s.Require().NoError(err)
@@ -173,6 +199,39 @@ func (s *InsertBufferSuite) TestYield() {
 	s.ElementsMatch(pks, pkData)
 }
 
+func (s *InsertBufferSuite) TestColumnarBuffer() {
+	insertBuffer, err := NewInsertBuffer(s.collSchema)
+	s.Require().NoError(err)
+
+	var pks []int64
+	for i := 0; i < 3; i++ {
+		batch, insertMsg := s.composeInsertMsg(10, 128)
+		groups, err := PrepareInsert(s.collSchema, s.pkField, []*msgstream.InsertMsg{insertMsg})
+		s.Require().NoError(err)
+		insertBuffer.Buffer(groups[0], &msgpb.MsgPosition{Timestamp: 100}, &msgpb.MsgPosition{Timestamp: 200})
+		pks = append(pks, batch...)
+	}
+
+	// the data is appended to a single columnar chunk
+	result := insertBuffer.Yield()
+	s.Require().Len(result, 1)
+	s.Equal(30, result[0].GetRowNum())
+	s.Equal(pks, result[0].Data[common.StartOfUserFieldID].GetDataRows())
+	s.Len(result[0].Data[common.StartOfUserFieldID+1].GetDataRows(), 30*128)
+	s.Nil(insertBuffer.Yield())
+
+	// the data mismatching the schema is kept as a separate chunk in order
+	_, insertMsg := s.composeInsertMsg(10, 128)
+	groups, err := PrepareInsert(s.collSchema, s.pkField, []*msgstream.InsertMsg{insertMsg})
+	s.Require().NoError(err)
+	insertBuffer.Buffer(groups[0], &msgpb.MsgPosition{Timestamp: 100}, &msgpb.MsgPosition{Timestamp: 200})
+	delete(groups[0].data[0].Data, common.StartOfUserFieldID+1)
+	insertBuffer.Buffer(groups[0], &msgpb.MsgPosition{Timestamp: 100}, &msgpb.MsgPosition{Timestamp: 200})
+	result = insertBuffer.Yield()
+	s.Require().Len(result, 2)
+	s.Len(result[1].Data, 3)
+}
+
 type InsertBufferConstructSuite struct {
 	suite.Suite
 	schema *schemapb.CollectionSchema
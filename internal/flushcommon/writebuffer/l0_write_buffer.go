@@ -13,6 +13,7 @@ import (
 	"github.com/milvus-io/milvus/internal/flushcommon/metacache/pkoracle"
 	"github.com/milvus-io/milvus/internal/flushcommon/syncmgr"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
@@ -29,6 +30,8 @@ type l0WriteBuffer struct {
 
 	syncMgr     syncmgr.SyncManager
 	idAllocator allocator.Interface
+
+	deltaWALReplayed bool
 }
 
 func NewL0WriteBuffer(channel string, metacache metacache.MetaCache, syncMgr syncmgr.SyncManager, option *writeBufferOption) (WriteBuffer, error) {
@@ -48,33 +51,87 @@ func NewL0WriteBuffer(channel string, metacache metacache.MetaCache, syncMgr syn
 	}, nil
 }
 
-func (wb *l0WriteBuffer) dispatchDeleteMsgsWithoutFilter(deleteMsgs []*msgstream.DeleteMsg, startPos, endPos *msgpb.MsgPosition) {
+func (wb *l0WriteBuffer) dispatchDeleteMsgsWithoutFilter(deleteMsgs []*msgstream.DeleteMsg, startPos, endPos *msgpb.MsgPosition) error {
 	for _, msg := range deleteMsgs {
 		l0SegmentID := wb.getL0SegmentID(msg.GetPartitionID(), startPos)
 		pks := storage.ParseIDs2PrimaryKeys(msg.GetPrimaryKeys())
 		pkTss := msg.GetTimestamps()
 		if len(pks) > 0 {
+			if err := wb.appendDeltaWAL(l0SegmentID, msg.GetPartitionID(), msg.GetPrimaryKeys(), pkTss); err != nil {
+				return err
+			}
 			wb.bufferDelete(l0SegmentID, pks, pkTss, startPos, endPos)
 		}
 	}
+	return nil
+}
+
+// replayDeltaWAL buffers the deletes left in the delta wal by the last crash before the first batch.
+func (wb *l0WriteBuffer) replayDeltaWAL(startPos, endPos *msgpb.MsgPosition) error {
+	if wb.deltaWAL == nil || wb.deltaWALReplayed {
+		return nil
+	}
+	records, paths, err := wb.deltaWAL.Replay(startPos.GetTimestamp())
+	if err != nil {
+		wb.logger.Warn("failed to replay delta wal", zap.Error(err))
+		return err
+	}
+	var rows int
+	for _, record := range records {
+		l0SegmentID := wb.getL0SegmentID(record.PartitionID, startPos)
+		if err := wb.appendDeltaWAL(l0SegmentID, record.PartitionID, record.primaryKeys(), record.Tss); err != nil {
+			return err
+		}
+		wb.bufferDelete(l0SegmentID, storage.ParseIDs2PrimaryKeys(record.primaryKeys()), record.Tss, startPos, endPos)
+		rows += len(record.Tss)
+	}
+	// the replayed files are removed only after the deletes are written to the new files
+	if err := wb.deltaWAL.Clean(paths); err != nil {
+		wb.logger.Warn("failed to clean replayed delta wal", zap.Error(err))
+		return err
+	}
+	wb.deltaWALReplayed = true
+	if rows > 0 {
+		wb.logger.Info("replay delta wal done", zap.Int("records", len(records)), zap.Int("rows", rows))
+	}
+	return nil
 }
 
 func (wb *l0WriteBuffer) BufferData(insertData []*InsertData, deleteMsgs []*msgstream.DeleteMsg, startPos, endPos *msgpb.MsgPosition) error {
 	wb.mut.Lock()
 	defer wb.mut.Unlock()
 
+	if err := wb.replayDeltaWAL(startPos, endPos); err != nil {
+		return err
+	}
+
 	// buffer insert data and add segment if not exists
+	checker := wb.newStrictPKChecker(deleteMsgs, startPos.GetTimestamp())
 	for _, inData := range insertData {
+		// the duplicates are checked before appended into the wal in streaming service mode,
+		// otherwise they could only be detected here since the msgstream is consumed by querynode as well.
+		if checker != nil && !streamingutil.IsStreamingServiceEnabled() {
+			pks, err := checker.primaryKeys(inData)
+			if err != nil {
+				return err
+			}
+			checker.report(inData.segmentID, checker.check(pks))
+		}
 		err := wb.bufferInsert(inData, startPos, endPos)
 		if err != nil {
 			return err
 		}
+		if checker != nil {
+			checker.observe(inData)
+		}
 	}
 
 	// In streaming service mode, flushed segments no longer maintain a bloom filter.
 	// So, here we skip generating BF (growing segment's BF will be regenerated during the sync phase)
 	// and also skip filtering delete entries by bf.
-	wb.dispatchDeleteMsgsWithoutFilter(deleteMsgs, startPos, endPos)
+	if err := wb.dispatchDeleteMsgsWithoutFilter(deleteMsgs, startPos, endPos); err != nil {
+		return err
+	}
 	// update buffer last checkpoint
 	wb.checkpoint = endPos
 
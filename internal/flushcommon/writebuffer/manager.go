@@ -40,6 +40,9 @@ type BufferManager interface {
 	DropPartitions(channel string, partitionIDs []int64)
 	// BufferData put data into channel write buffer.
 	BufferData(channel string, insertData []*InsertData, deleteMsgs []*msgstream.DeleteMsg, startPos, endPos *msgpb.MsgPosition) error
+	// CheckPrimaryKeys checks the duplicate primary keys of the insert against the channel write buffer,
+	// the insert is returned as is if the write buffer is not registered.
+	CheckPrimaryKeys(ctx context.Context, channel string, insert *msgpb.InsertRequest, ts uint64) (*msgpb.InsertRequest, error)
 	// GetCheckpoint returns checkpoint for provided channel.
 	GetCheckpoint(channel string) (*msgpb.MsgPosition, bool, error)
 	// NotifyCheckpointUpdated notify write buffer checkpoint updated to reset flushTs.
@@ -223,6 +226,15 @@ func (m *bufferManager) BufferData(channel string, insertData []*InsertData, del
 	return buf.BufferData(insertData, deleteMsgs, startPos, endPos)
 }
 
+// CheckPrimaryKeys checks the duplicate primary keys of the insert against the channel write buffer.
+func (m *bufferManager) CheckPrimaryKeys(ctx context.Context, channel string, insert *msgpb.InsertRequest, ts uint64) (*msgpb.InsertRequest, error) {
+	buf, loaded := m.buffers.Get(channel)
+	if !loaded {
+		return insert, nil
+	}
+	return buf.CheckPrimaryKeys(ctx, insert, ts)
+}
+
 // GetCheckpoint returns checkpoint for provided channel.
 func (m *bufferManager) GetCheckpoint(channel string) (*msgpb.MsgPosition, bool, error) {
 	buf, loaded := m.buffers.Get(channel)
@@ -76,6 +76,67 @@ func (_c *MockBufferManager_BufferData_Call) RunAndReturn(run func(string, []*In
 	return _c
 }
 
+// CheckPrimaryKeys provides a mock function with given fields: ctx, channel, insert, ts
+func (_m *MockBufferManager) CheckPrimaryKeys(ctx context.Context, channel string, insert *msgpb.InsertRequest, ts uint64) (*msgpb.InsertRequest, error) {
+	ret := _m.Called(ctx, channel, insert, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckPrimaryKeys")
+	}
+
+	var r0 *msgpb.InsertRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *msgpb.InsertRequest, uint64) (*msgpb.InsertRequest, error)); ok {
+		return rf(ctx, channel, insert, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *msgpb.InsertRequest, uint64) *msgpb.InsertRequest); ok {
+		r0 = rf(ctx, channel, insert, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*msgpb.InsertRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *msgpb.InsertRequest, uint64) error); ok {
+		r1 = rf(ctx, channel, insert, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBufferManager_CheckPrimaryKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckPrimaryKeys'
+type MockBufferManager_CheckPrimaryKeys_Call struct {
+	*mock.Call
+}
+
+// CheckPrimaryKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel string
+//   - insert *msgpb.InsertRequest
+//   - ts uint64
+func (_e *MockBufferManager_Expecter) CheckPrimaryKeys(ctx interface{}, channel interface{}, insert interface{}, ts interface{}) *MockBufferManager_CheckPrimaryKeys_Call {
+	return &MockBufferManager_CheckPrimaryKeys_Call{Call: _e.mock.On("CheckPrimaryKeys", ctx, channel, insert, ts)}
+}
+
+func (_c *MockBufferManager_CheckPrimaryKeys_Call) Run(run func(ctx context.Context, channel string, insert *msgpb.InsertRequest, ts uint64)) *MockBufferManager_CheckPrimaryKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*msgpb.InsertRequest), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockBufferManager_CheckPrimaryKeys_Call) Return(_a0 *msgpb.InsertRequest, _a1 error) *MockBufferManager_CheckPrimaryKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBufferManager_CheckPrimaryKeys_Call) RunAndReturn(run func(context.Context, string, *msgpb.InsertRequest, uint64) (*msgpb.InsertRequest, error)) *MockBufferManager_CheckPrimaryKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateNewGrowingSegment provides a mock function with given fields: ctx, channel, partition, segmentID
 func (_m *MockBufferManager) CreateNewGrowingSegment(ctx context.Context, channel string, partition int64, segmentID int64) error {
 	ret := _m.Called(ctx, channel, partition, segmentID)
@@ -73,6 +73,66 @@ func (_c *MockWriteBuffer_BufferData_Call) RunAndReturn(run func([]*InsertData,
 	return _c
 }
 
+// CheckPrimaryKeys provides a mock function with given fields: ctx, insert, ts
+func (_m *MockWriteBuffer) CheckPrimaryKeys(ctx context.Context, insert *msgpb.InsertRequest, ts uint64) (*msgpb.InsertRequest, error) {
+	ret := _m.Called(ctx, insert, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckPrimaryKeys")
+	}
+
+	var r0 *msgpb.InsertRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *msgpb.InsertRequest, uint64) (*msgpb.InsertRequest, error)); ok {
+		return rf(ctx, insert, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *msgpb.InsertRequest, uint64) *msgpb.InsertRequest); ok {
+		r0 = rf(ctx, insert, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*msgpb.InsertRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *msgpb.InsertRequest, uint64) error); ok {
+		r1 = rf(ctx, insert, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWriteBuffer_CheckPrimaryKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckPrimaryKeys'
+type MockWriteBuffer_CheckPrimaryKeys_Call struct {
+	*mock.Call
+}
+
+// CheckPrimaryKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - insert *msgpb.InsertRequest
+//   - ts uint64
+func (_e *MockWriteBuffer_Expecter) CheckPrimaryKeys(ctx interface{}, insert interface{}, ts interface{}) *MockWriteBuffer_CheckPrimaryKeys_Call {
+	return &MockWriteBuffer_CheckPrimaryKeys_Call{Call: _e.mock.On("CheckPrimaryKeys", ctx, insert, ts)}
+}
+
+func (_c *MockWriteBuffer_CheckPrimaryKeys_Call) Run(run func(ctx context.Context, insert *msgpb.InsertRequest, ts uint64)) *MockWriteBuffer_CheckPrimaryKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*msgpb.InsertRequest), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockWriteBuffer_CheckPrimaryKeys_Call) Return(_a0 *msgpb.InsertRequest, _a1 error) *MockWriteBuffer_CheckPrimaryKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWriteBuffer_CheckPrimaryKeys_Call) RunAndReturn(run func(context.Context, *msgpb.InsertRequest, uint64) (*msgpb.InsertRequest, error)) *MockWriteBuffer_CheckPrimaryKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields: ctx, drop
 func (_m *MockWriteBuffer) Close(ctx context.Context, drop bool) {
 	_m.Called(ctx, drop)
@@ -22,6 +22,7 @@ type writeBufferOption struct {
 	errorHandler         func(error)
 	taskObserverCallback TaskObserverCallback
 	storageVersion       int64
+	pkVerifier           PKVerifier
 }
 
 func defaultWBOption(metacache metacache.MetaCache) *writeBufferOption {
@@ -29,6 +30,7 @@ func defaultWBOption(metacache metacache.MetaCache) *writeBufferOption {
 		syncPolicies: []SyncPolicy{
 			GetFullBufferPolicy(),
 			GetSyncStaleBufferPolicy(paramtable.Get().DataNodeCfg.SyncPeriod.GetAsDuration(time.Second)),
+			GetCheckpointLagPolicy(),
 			GetSealedSegmentsPolicy(metacache),
 			GetDroppedSegmentPolicy(metacache),
 		},
@@ -39,6 +41,13 @@ func defaultWBOption(metacache metacache.MetaCache) *writeBufferOption {
 	}
 }
 
+// WithPKVerifier sets the verifier of the probable duplicate primary keys for the strict pk mode.
+func WithPKVerifier(verifier PKVerifier) WriteBufferOption {
+	return func(opt *writeBufferOption) {
+		opt.pkVerifier = verifier
+	}
+}
+
 func WithIDAllocator(allocator allocator.Interface) WriteBufferOption {
 	return func(opt *writeBufferOption) {
 		opt.idAllocator = allocator
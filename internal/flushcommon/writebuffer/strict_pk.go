@@ -0,0 +1,341 @@
+package writebuffer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/flushcommon/metacache"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// PKVerifier returns whether each of @pks is alive in the flushed segment,
+// the deletes of the segment and the flushed L0 segments of @l0SegmentIDs are applied.
+type PKVerifier func(ctx context.Context, segmentID int64, l0SegmentIDs []int64, pks []storage.PrimaryKey) ([]bool, error)
+
+// strictPKChecker detects the duplicate primary keys on ingest for the collections with the strict pk mode enabled.
+//
+// The primary keys are checked against the bloom filters of the segments in the channel, the hits are verified
+// against the insert data buffered in memory, so are the duplicates in the batch itself.
+// The hits which can't be verified in memory, e.g. the ones hitting the synced data, are probable duplicates,
+// which are verified against the flushed segments by the PKVerifier before being acted on.
+// The primary keys deleted in the buffers are never treated as duplicates, e.g. the upserted ones.
+type strictPKChecker struct {
+	wb      *l0WriteBuffer
+	mode    string
+	schema  *schemapb.CollectionSchema
+	pkField *schemapb.FieldSchema
+	deleted map[any]struct{}
+}
+
+// strictPKResult is the result of the check of a batch of primary keys.
+type strictPKResult struct {
+	duplicates  []bool
+	probable    map[int64][]int // segmentID => offsets of the probable duplicates hitting the segment only
+	hitSegments typeutil.Set[int64]
+}
+
+func (r *strictPKResult) verifiedNum() int {
+	var num int
+	for _, duplicate := range r.duplicates {
+		if duplicate {
+			num++
+		}
+	}
+	return num
+}
+
+func (r *strictPKResult) probableNum() int {
+	offsets := typeutil.NewSet[int]()
+	for _, segmentOffsets := range r.probable {
+		offsets.Insert(segmentOffsets...)
+	}
+	return offsets.Len()
+}
+
+// newStrictPKChecker returns nil if the strict pk mode of the collection is not enabled.
+func (wb *l0WriteBuffer) newStrictPKChecker(deleteMsgs []*msgstream.DeleteMsg, ts uint64) *strictPKChecker {
+	schema := wb.metaCache.GetSchema(ts)
+	mode, err := common.GetCollectionStrictPKMode(schema.GetProperties())
+	if err != nil {
+		wb.logger.RatedWarn(60, "invalid strict pk mode, duplicate pk detection is skipped", zap.Error(err))
+		return nil
+	}
+	if mode == "" {
+		return nil
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil
+	}
+
+	deleted := make(map[any]struct{})
+	for _, msg := range deleteMsgs {
+		for _, pk := range storage.ParseIDs2PrimaryKeys(msg.GetPrimaryKeys()) {
+			deleted[pk.GetValue()] = struct{}{}
+		}
+	}
+	for _, buf := range wb.buffers {
+		for _, pk := range buf.deltaBuffer.buffer.Pks {
+			deleted[pk.GetValue()] = struct{}{}
+		}
+	}
+	return &strictPKChecker{
+		wb:      wb,
+		mode:    mode,
+		schema:  schema,
+		pkField: pkField,
+		deleted: deleted,
+	}
+}
+
+// CheckPrimaryKeys checks the primary keys of the insert before it's appended into the wal,
+// so that the dropped or rejected duplicates are consistent for all the consumers of the wal.
+// The inserts which are appended but not yet buffered are not checked against.
+func (wb *l0WriteBuffer) CheckPrimaryKeys(ctx context.Context, insert *msgpb.InsertRequest, ts uint64) (*msgpb.InsertRequest, error) {
+	wb.mut.RLock()
+	checker := wb.newStrictPKChecker(nil, ts)
+	if checker == nil {
+		wb.mut.RUnlock()
+		return insert, nil
+	}
+	pks, err := checker.insertPrimaryKeys(insert)
+	if err != nil {
+		wb.mut.RUnlock()
+		return nil, err
+	}
+	result := checker.check(pks)
+	l0SegmentIDs := lo.Map(wb.metaCache.GetSegmentsBy(metacache.WithLevel(datapb.SegmentLevel_L0),
+		metacache.WithSegmentState(commonpb.SegmentState_Flushed)), func(segment *metacache.SegmentInfo, _ int) int64 {
+		return segment.SegmentID()
+	})
+	wb.mut.RUnlock()
+
+	// the verification reads the flushed segments, so it's done without the lock
+	if err := checker.verify(ctx, result, pks, l0SegmentIDs); err != nil {
+		return nil, err
+	}
+	checker.report(insert.GetSegmentID(), result)
+
+	verifiedNum := result.verifiedNum()
+	switch {
+	case verifiedNum == 0 || checker.mode == common.StrictPKModeDetect:
+		return insert, nil
+	case checker.mode == common.StrictPKModeReject:
+		return nil, merr.WrapErrParameterInvalidMsg("%d rows of the insert have duplicate primary keys, rejected by %s %s",
+			verifiedNum, common.CollectionStrictPKModeKey, checker.mode)
+	case verifiedNum == len(pks):
+		return nil, merr.WrapErrParameterInvalidMsg("all the %d rows of the insert have duplicate primary keys", verifiedNum)
+	default:
+		return dropInsertRows(insert, result.duplicates)
+	}
+}
+
+// check returns the duplicates of @pks verified in memory and the probable ones to be verified.
+func (c *strictPKChecker) check(pks []storage.PrimaryKey) *strictPKResult {
+	result := &strictPKResult{
+		duplicates:  make([]bool, len(pks)),
+		probable:    make(map[int64][]int),
+		hitSegments: typeutil.NewSet[int64](),
+	}
+	if len(pks) == 0 {
+		return result
+	}
+
+	// the bloom filters of the growing segments are maintained by observe
+	hits := make(map[int64][]bool)
+	lc := storage.NewBatchLocationsCache(pks)
+	for _, segment := range c.wb.metaCache.GetSegmentsBy() {
+		bfs := segment.GetBloomFilterSet()
+		if bfs == nil {
+			continue
+		}
+		segmentHits := bfs.BatchPkExist(lc)
+		for _, hit := range segmentHits {
+			if hit {
+				hits[segment.SegmentID()] = segmentHits
+				result.hitSegments.Insert(segment.SegmentID())
+				break
+			}
+		}
+	}
+	buffered := c.bufferedPKs(result.hitSegments)
+
+	seen := make(map[any]struct{}, len(pks))
+	for i, pk := range pks {
+		value := pk.GetValue()
+		if _, ok := c.deleted[value]; ok {
+			continue
+		}
+		_, inBatch := seen[value]
+		seen[value] = struct{}{}
+		if inBatch {
+			result.duplicates[i] = true
+			continue
+		}
+		hitSegmentIDs := lo.Filter(lo.Keys(hits), func(segmentID int64, _ int) bool { return hits[segmentID][i] })
+		if lo.ContainsBy(hitSegmentIDs, func(segmentID int64) bool {
+			_, ok := buffered[segmentID][value]
+			return ok
+		}) {
+			result.duplicates[i] = true
+			continue
+		}
+		for _, segmentID := range hitSegmentIDs {
+			result.probable[segmentID] = append(result.probable[segmentID], i)
+		}
+	}
+	return result
+}
+
+// verify verifies the probable duplicates against the flushed segments, the alive ones are marked as duplicates
+// and the rest are false positives of the bloom filters. They're all kept as probable if no verifier is set.
+func (c *strictPKChecker) verify(ctx context.Context, result *strictPKResult, pks []storage.PrimaryKey, l0SegmentIDs []int64) error {
+	if c.wb.pkVerifier == nil {
+		return nil
+	}
+	for segmentID, offsets := range result.probable {
+		offsets = lo.Filter(offsets, func(offset int, _ int) bool { return !result.duplicates[offset] })
+		if len(offsets) == 0 {
+			delete(result.probable, segmentID)
+			continue
+		}
+		alive, err := c.wb.pkVerifier(ctx, segmentID, l0SegmentIDs, lo.Map(offsets, func(offset int, _ int) storage.PrimaryKey {
+			return pks[offset]
+		}))
+		if err != nil {
+			c.wb.logger.Warn("failed to verify the probable duplicate primary keys", zap.Int64("segmentID", segmentID), zap.Error(err))
+			return err
+		}
+		for i, offset := range offsets {
+			if alive[i] {
+				result.duplicates[offset] = true
+			}
+		}
+		delete(result.probable, segmentID)
+	}
+	return nil
+}
+
+// report reports the duplicates by metrics and logs.
+func (c *strictPKChecker) report(segmentID int64, result *strictPKResult) {
+	verifiedNum, probableNum := result.verifiedNum(), result.probableNum()
+	if verifiedNum == 0 && probableNum == 0 {
+		return
+	}
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	collectionID := fmt.Sprint(c.wb.collectionID)
+	metrics.DataNodeDuplicatePKRows.WithLabelValues(nodeID, collectionID, metrics.VerifiedDuplicateLabel).Add(float64(verifiedNum))
+	metrics.DataNodeDuplicatePKRows.WithLabelValues(nodeID, collectionID, metrics.ProbableDuplicateLabel).Add(float64(probableNum))
+	c.wb.logger.RatedWarn(10, "duplicate primary keys detected on ingest",
+		zap.String("mode", c.mode),
+		zap.Int64("segmentID", segmentID),
+		zap.Int("verified", verifiedNum),
+		zap.Int("probable", probableNum),
+		zap.Int64s("hitSegments", result.hitSegments.Collect()))
+}
+
+// observe adds the primary keys of the buffered insert data into the bloom filter of the growing segment,
+// which is skipped by the write buffer until the segment is synced otherwise.
+func (c *strictPKChecker) observe(inData *InsertData) {
+	segment, ok := c.wb.metaCache.GetSegmentByID(inData.segmentID)
+	if !ok || segment.GetBloomFilterSet() == nil {
+		return
+	}
+	for _, pkField := range inData.pkField {
+		if err := segment.GetBloomFilterSet().UpdatePKRange(pkField); err != nil {
+			c.wb.logger.Warn("failed to update bloom filter of growing segment", zap.Int64("segmentID", inData.segmentID), zap.Error(err))
+			return
+		}
+	}
+}
+
+func (c *strictPKChecker) primaryKeys(inData *InsertData) ([]storage.PrimaryKey, error) {
+	pks := make([]storage.PrimaryKey, 0, inData.rowNum)
+	for _, pkField := range inData.pkField {
+		for i := 0; i < pkField.RowNum(); i++ {
+			pk, err := storage.GenPrimaryKeyByRawData(pkField.GetRow(i), c.pkField.GetDataType())
+			if err != nil {
+				return nil, err
+			}
+			pks = append(pks, pk)
+		}
+	}
+	return pks, nil
+}
+
+func (c *strictPKChecker) insertPrimaryKeys(insert *msgpb.InsertRequest) ([]storage.PrimaryKey, error) {
+	pkFieldData, ok := lo.Find(insert.GetFieldsData(), func(fieldData *schemapb.FieldData) bool {
+		return fieldData.GetFieldId() == c.pkField.GetFieldID()
+	})
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("primary key field %s not found in the insert", c.pkField.GetName())
+	}
+	return storage.ParseFieldData2PrimaryKeys(pkFieldData)
+}
+
+// bufferedPKs returns the primary keys of the insert data buffered in memory of the segments.
+func (c *strictPKChecker) bufferedPKs(segmentIDs typeutil.Set[int64]) map[int64]map[any]struct{} {
+	pks := make(map[int64]map[any]struct{}, segmentIDs.Len())
+	for segmentID := range segmentIDs {
+		buf, ok := c.wb.buffers[segmentID]
+		if !ok {
+			continue
+		}
+		segmentPKs := make(map[any]struct{})
+		for _, data := range buf.insertBuffer.buffers {
+			pkField, ok := data.Data[c.pkField.GetFieldID()]
+			if !ok {
+				continue
+			}
+			for i := 0; i < pkField.RowNum(); i++ {
+				segmentPKs[pkField.GetRow(i)] = struct{}{}
+			}
+		}
+		pks[segmentID] = segmentPKs
+	}
+	return pks
+}
+
+// dropInsertRows returns a copy of the insert without the duplicate rows.
+// The checksum carried by the insert covers the dropped rows, so it's recomputed for the kept ones.
+func dropInsertRows(insert *msgpb.InsertRequest, duplicates []bool) (*msgpb.InsertRequest, error) {
+	result := proto.Clone(insert).(*msgpb.InsertRequest)
+	result.FieldsData = typeutil.PrepareResultFieldData(insert.GetFieldsData(), int64(len(duplicates)))
+	result.Timestamps = nil
+	result.RowIDs = nil
+	var kept uint64
+	for i, duplicate := range duplicates {
+		if duplicate {
+			continue
+		}
+		typeutil.AppendFieldData(result.FieldsData, insert.GetFieldsData(), int64(i))
+		if len(insert.GetTimestamps()) == len(duplicates) {
+			result.Timestamps = append(result.Timestamps, insert.GetTimestamps()[i])
+		}
+		if len(insert.GetRowIDs()) == len(duplicates) {
+			result.RowIDs = append(result.RowIDs, insert.GetRowIDs()[i])
+		}
+		kept++
+	}
+	result.NumRows = kept
+	if _, ok := insert.GetBase().GetProperties()[msgstream.InsertChecksumKey]; ok {
+		if err := (&msgstream.InsertMsg{InsertRequest: result}).SetChecksum(); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,185 @@
+package writebuffer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/flushcommon/metacache"
+	"github.com/milvus-io/milvus/internal/flushcommon/metacache/pkoracle"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func (s *L0WriteBufferSuite) TestStrictPK() {
+	run := func(mode string, verifier PKVerifier, segments ...*metacache.SegmentInfo) (*l0WriteBuffer, func(insertMsg *msgstream.InsertMsg, deleteMsgs ...*msgstream.DeleteMsg)) {
+		schema := proto.Clone(s.collSchema).(*schemapb.CollectionSchema)
+		schema.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionStrictPKModeKey, Value: mode}}
+		segment := metacache.NewSegmentInfo(&datapb.SegmentInfo{
+			ID:    1000,
+			State: commonpb.SegmentState_Growing,
+		}, pkoracle.NewBloomFilterSet(), nil)
+
+		mc := metacache.NewMockMetaCache(s.T())
+		mc.EXPECT().GetSchema(mock.Anything).Return(schema).Maybe()
+		mc.EXPECT().Collection().Return(s.collID).Maybe()
+		mc.EXPECT().GetSegmentByID(int64(1000)).Return(segment, true).Maybe()
+		mc.EXPECT().GetSegmentsBy().Return(append([]*metacache.SegmentInfo{segment}, segments...)).Maybe()
+		mc.EXPECT().GetSegmentsBy(mock.Anything, mock.Anything).Return(nil).Maybe()
+		mc.EXPECT().AddSegment(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+		mc.EXPECT().UpdateSegments(mock.Anything, mock.Anything).Return().Maybe()
+
+		wb, err := NewL0WriteBuffer(s.channelName, mc, s.syncMgr, &writeBufferOption{
+			idAllocator: s.allocator,
+			pkVerifier:  verifier,
+		})
+		s.Require().NoError(err)
+		return wb.(*l0WriteBuffer), func(insertMsg *msgstream.InsertMsg, deleteMsgs ...*msgstream.DeleteMsg) {
+			insertData, err := PrepareInsert(schema, s.pkSchema, []*msgstream.InsertMsg{insertMsg})
+			s.Require().NoError(err)
+			err = wb.BufferData(insertData, deleteMsgs, &msgpb.MsgPosition{Timestamp: 100}, &msgpb.MsgPosition{Timestamp: 200})
+			s.Require().NoError(err)
+		}
+	}
+	verifiedRows := func() prometheus.Counter {
+		return metrics.DataNodeDuplicatePKRows.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), fmt.Sprint(s.collID), metrics.VerifiedDuplicateLabel)
+	}
+	// firstHalf returns the insert msg with the first half rows of @msg.
+	firstHalf := func(msg *msgstream.InsertMsg) *msgstream.InsertMsg {
+		rows := int(msg.GetNumRows())
+		insert, err := dropInsertRows(msg.InsertRequest, lo.RepeatBy(rows, func(i int) bool { return i >= rows/2 }))
+		s.Require().NoError(err)
+		return &msgstream.InsertMsg{
+			BaseMsg:       msg.BaseMsg,
+			InsertRequest: insert,
+		}
+	}
+
+	s.Run("dedup", func() {
+		metrics.DataNodeDuplicatePKRows.Reset()
+		wb, bufferData := run(common.StrictPKModeDedup, nil)
+		pks, msg := s.composeInsertMsg(1000, 20, 128, schemapb.DataType_Int64)
+		buffered := firstHalf(msg)
+		bufferData(buffered)
+		s.EqualValues(10, wb.buffers[1000].insertBuffer.rows)
+
+		// the duplicates are verified against the buffered data and dropped before appended
+		insert, err := wb.CheckPrimaryKeys(context.Background(), msg.InsertRequest, 100)
+		s.NoError(err)
+		s.EqualValues(10, insert.GetNumRows())
+		s.Len(insert.GetTimestamps(), 10)
+		s.Equal(pks[10:], insert.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		s.MetricsEqual(verifiedRows(), 10)
+
+		// the insert is failed if all the rows are duplicates
+		_, err = wb.CheckPrimaryKeys(context.Background(), buffered.InsertRequest, 100)
+		s.ErrorIs(err, merr.ErrParameterInvalid)
+
+		// the deleted pks are not duplicates, e.g. upsert
+		delMsg := s.composeDeleteMsg(lo.Map(pks, func(id int64, _ int) storage.PrimaryKey { return storage.NewInt64PrimaryKey(id) }))
+		bufferData(buffered, delMsg)
+		insert, err = wb.CheckPrimaryKeys(context.Background(), msg.InsertRequest, 100)
+		s.NoError(err)
+		s.Same(msg.InsertRequest, insert)
+	})
+
+	s.Run("dedup_checksum", func() {
+		wb, bufferData := run(common.StrictPKModeDedup, nil)
+		_, msg := s.composeInsertMsg(1000, 20, 128, schemapb.DataType_Int64)
+		s.Require().NoError(msg.SetChecksum())
+		bufferData(firstHalf(msg))
+
+		// the checksum of the deduplicated insert covers the kept rows only
+		insert, err := wb.CheckPrimaryKeys(context.Background(), msg.InsertRequest, 100)
+		s.NoError(err)
+		s.EqualValues(10, insert.GetNumRows())
+		deduplicated := &msgstream.InsertMsg{InsertRequest: insert}
+		checksum, ok, err := deduplicated.VerifyChecksum()
+		s.NoError(err)
+		s.True(ok)
+		original, _, err := msg.GetChecksum()
+		s.NoError(err)
+		s.NotEqual(original, checksum)
+		_, _, err = msg.VerifyChecksum()
+		s.NoError(err)
+
+		// the insert without checksum is left without checksum
+		_, msg = s.composeInsertMsg(1000, 20, 128, schemapb.DataType_Int64)
+		wb, bufferData = run(common.StrictPKModeDedup, nil)
+		bufferData(firstHalf(msg))
+		insert, err = wb.CheckPrimaryKeys(context.Background(), msg.InsertRequest, 100)
+		s.NoError(err)
+		_, ok, err = (&msgstream.InsertMsg{InsertRequest: insert}).VerifyChecksum()
+		s.NoError(err)
+		s.False(ok)
+	})
+
+	s.Run("reject", func() {
+		metrics.DataNodeDuplicatePKRows.Reset()
+		wb, bufferData := run(common.StrictPKModeReject, nil)
+		_, msg := s.composeInsertMsg(1000, 20, 128, schemapb.DataType_Int64)
+		bufferData(firstHalf(msg))
+
+		_, err := wb.CheckPrimaryKeys(context.Background(), msg.InsertRequest, 100)
+		s.ErrorIs(err, merr.ErrParameterInvalid)
+		s.MetricsEqual(verifiedRows(), 10)
+	})
+
+	s.Run("verify", func() {
+		metrics.DataNodeDuplicatePKRows.Reset()
+		pks, msg := s.composeInsertMsg(1000, 20, 128, schemapb.DataType_Int64)
+		// the flushed segment contains all the pks, but only the first half are alive
+		stats, err := storage.NewPrimaryKeyStats(s.pkSchema.GetFieldID(), int64(s.pkSchema.GetDataType()), 20)
+		s.Require().NoError(err)
+		stats.UpdateByMsgs(&storage.Int64FieldData{Data: pks})
+		flushed := metacache.NewSegmentInfo(&datapb.SegmentInfo{
+			ID:    2000,
+			State: commonpb.SegmentState_Flushed,
+		}, pkoracle.NewBloomFilterSet(&storage.PkStatistics{PkFilter: stats.BF, MinPK: stats.MinPk, MaxPK: stats.MaxPk}), nil)
+
+		verifier := func(ctx context.Context, segmentID int64, l0SegmentIDs []int64, verifyPKs []storage.PrimaryKey) ([]bool, error) {
+			s.EqualValues(2000, segmentID)
+			return lo.Map(verifyPKs, func(pk storage.PrimaryKey, _ int) bool {
+				return lo.IndexOf(pks, pk.GetValue().(int64)) < 10
+			}), nil
+		}
+		wb, _ := run(common.StrictPKModeDedup, verifier, flushed)
+		insert, err := wb.CheckPrimaryKeys(context.Background(), msg.InsertRequest, 100)
+		s.NoError(err)
+		s.Equal(pks[10:], insert.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		s.MetricsEqual(verifiedRows(), 10)
+
+		// the probable duplicates are not acted on if the verification fails
+		wb, _ = run(common.StrictPKModeDedup, func(ctx context.Context, segmentID int64, l0SegmentIDs []int64, pks []storage.PrimaryKey) ([]bool, error) {
+			return nil, merr.WrapErrServiceInternal("mock")
+		}, flushed)
+		_, err = wb.CheckPrimaryKeys(context.Background(), msg.InsertRequest, 100)
+		s.Error(err)
+	})
+
+	s.Run("detect", func() {
+		metrics.DataNodeDuplicatePKRows.Reset()
+		wb, bufferData := run(common.StrictPKModeDetect, nil)
+		_, msg := s.composeInsertMsg(1000, 10, 128, schemapb.DataType_Int64)
+		bufferData(msg)
+		bufferData(msg)
+		s.EqualValues(20, wb.buffers[1000].insertBuffer.rows)
+		s.MetricsEqual(verifiedRows(), 10)
+
+		insert, err := wb.CheckPrimaryKeys(context.Background(), msg.InsertRequest, 100)
+		s.NoError(err)
+		s.Same(msg.InsertRequest, insert)
+	})
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/flushcommon/metacache"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -68,6 +70,47 @@ func GetSyncStaleBufferPolicy(staleDuration time.Duration) SyncPolicy {
 	}, "buffer stale")
 }
 
+// GetCheckpointLagPolicy selects the lagging segments once the replay window of the channel checkpoint
+// exceeds the budget, the buffers older than half of the budget are synced so that
+// the checkpoint could be advanced far enough instead of being pushed tick by tick.
+func GetCheckpointLagPolicy() SyncPolicy {
+	return wrapSelectSegmentFuncPolicy(func(buffers []*segmentBuffer, ts typeutil.Timestamp) []int64 {
+		budget := paramtable.Get().DataNodeCfg.CheckpointReplayBudget.GetAsDuration(time.Second)
+		if budget <= 0 || len(buffers) == 0 {
+			return nil
+		}
+		current := tsoutil.PhysicalTime(ts)
+		oldest := lo.MinBy(buffers, func(a, b *segmentBuffer) bool {
+			return a.MinTimestamp() < b.MinTimestamp()
+		})
+		if current.Sub(tsoutil.PhysicalTime(oldest.MinTimestamp())) <= budget {
+			return nil
+		}
+		threshold := tsoutil.ComposeTSByTime(current.Add(-budget/2), 0)
+		return lo.FilterMap(buffers, func(buf *segmentBuffer, _ int) (int64, bool) {
+			return buf.segmentID, buf.MinTimestamp() < threshold
+		})
+	}, "checkpoint lag")
+}
+
+// GetMaxBufferAgePolicy selects the buffers older than the max age configured by the collection property,
+// so that the small buffers are flushed by the wall-clock interval instead of waiting for the size threshold.
+func GetMaxBufferAgePolicy(meta metacache.MetaCache) SyncPolicy {
+	return wrapSelectSegmentFuncPolicy(func(buffers []*segmentBuffer, ts typeutil.Timestamp) []int64 {
+		if len(buffers) == 0 {
+			return nil
+		}
+		maxAge, err := common.GetCollectionInsertBufferMaxAge(meta.GetSchema(ts).GetProperties())
+		if err != nil || maxAge <= 0 {
+			return nil
+		}
+		current := tsoutil.PhysicalTime(ts)
+		return lo.FilterMap(buffers, func(buf *segmentBuffer, _ int) (int64, bool) {
+			return buf.segmentID, current.Sub(tsoutil.PhysicalTime(buf.MinTimestamp())) > maxAge
+		})
+	}, "buffer max age")
+}
+
 func GetSealedSegmentsPolicy(meta metacache.MetaCache) SyncPolicy {
 	return wrapSelectSegmentFuncPolicy(func(_ []*segmentBuffer, _ typeutil.Timestamp) []int64 {
 		ids := meta.GetSegmentIDsBy(metacache.WithSegmentState(commonpb.SegmentState_Sealed))
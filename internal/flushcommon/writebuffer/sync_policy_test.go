@@ -73,6 +73,65 @@ func (s *SyncPolicySuite) TestSyncStalePolicy() {
 	s.Equal(0, len(ids), "")
 }
 
+func (s *SyncPolicySuite) TestCheckpointLagPolicy() {
+	policy := GetCheckpointLagPolicy()
+	now := time.Now()
+
+	newBuffer := func(segmentID int64, lag time.Duration) *segmentBuffer {
+		buffer, err := newSegmentBuffer(segmentID, s.collSchema)
+		s.Require().NoError(err)
+		buffer.insertBuffer.startPos = &msgpb.MsgPosition{
+			Timestamp: tsoutil.ComposeTSByTime(now.Add(-lag), 0),
+		}
+		return buffer
+	}
+	buffers := []*segmentBuffer{
+		newBuffer(100, 11*time.Minute),
+		newBuffer(101, 6*time.Minute),
+		newBuffer(102, time.Minute),
+	}
+
+	ids := policy.SelectSegments(buffers, tsoutil.ComposeTSByTime(now, 0))
+	s.Equal(0, len(ids), "policy disabled by default")
+
+	paramtable.Get().Save(paramtable.Get().DataNodeCfg.CheckpointReplayBudget.Key, "600")
+	defer paramtable.Get().Reset(paramtable.Get().DataNodeCfg.CheckpointReplayBudget.Key)
+
+	ids = policy.SelectSegments(buffers, tsoutil.ComposeTSByTime(now, 0))
+	s.ElementsMatch([]int64{100, 101}, ids)
+
+	ids = policy.SelectSegments(buffers[1:], tsoutil.ComposeTSByTime(now, 0))
+	s.Equal(0, len(ids), "replay window within budget")
+}
+
+func (s *SyncPolicySuite) TestMaxBufferAgePolicy() {
+	schema := &schemapb.CollectionSchema{
+		Name:   s.collSchema.GetName(),
+		Fields: s.collSchema.GetFields(),
+	}
+	metacache := metacache.NewMockMetaCache(s.T())
+	metacache.EXPECT().GetSchema(mock.Anything).Return(schema)
+	policy := GetMaxBufferAgePolicy(metacache)
+	now := time.Now()
+
+	buffer, err := newSegmentBuffer(100, s.collSchema)
+	s.Require().NoError(err)
+	buffer.insertBuffer.startPos = &msgpb.MsgPosition{
+		Timestamp: tsoutil.ComposeTSByTime(now.Add(-time.Minute), 0),
+	}
+
+	ids := policy.SelectSegments([]*segmentBuffer{buffer}, tsoutil.ComposeTSByTime(now, 0))
+	s.Equal(0, len(ids), "max age not configured")
+
+	schema.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionInsertBufferMaxAgeKey, Value: "30"}}
+	ids = policy.SelectSegments([]*segmentBuffer{buffer}, tsoutil.ComposeTSByTime(now, 0))
+	s.ElementsMatch([]int64{100}, ids)
+
+	schema.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionInsertBufferMaxAgeKey, Value: "120"}}
+	ids = policy.SelectSegments([]*segmentBuffer{buffer}, tsoutil.ComposeTSByTime(now, 0))
+	s.Equal(0, len(ids), "buffer within max age")
+}
+
 func (s *SyncPolicySuite) TestSyncDroppedPolicy() {
 	metacache := metacache.NewMockMetaCache(s.T())
 	policy := GetDroppedSegmentPolicy(metacache)
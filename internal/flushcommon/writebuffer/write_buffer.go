@@ -3,6 +3,7 @@ package writebuffer
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 
 	"github.com/cockroachdb/errors"
@@ -18,6 +19,7 @@ import (
 	"github.com/milvus-io/milvus/internal/flushcommon/metacache/pkoracle"
 	"github.com/milvus-io/milvus/internal/flushcommon/syncmgr"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
@@ -41,6 +43,9 @@ type WriteBuffer interface {
 	CreateNewGrowingSegment(partitionID int64, segmentID int64, startPos *msgpb.MsgPosition)
 	// BufferData is the method to buffer dml data msgs.
 	BufferData(insertMsgs []*InsertData, deleteMsgs []*msgstream.DeleteMsg, startPos, endPos *msgpb.MsgPosition) error
+	// CheckPrimaryKeys checks the duplicate primary keys of the insert for the collection with the strict pk mode,
+	// it returns the insert with the duplicates dropped in dedup mode, or an error in reject mode.
+	CheckPrimaryKeys(ctx context.Context, insert *msgpb.InsertRequest, ts uint64) (*msgpb.InsertRequest, error)
 	// FlushTimestamp set flush timestamp for write buffer
 	SetFlushTimestamp(flushTs uint64)
 	// GetFlushTimestamp get current flush timestamp
@@ -129,6 +134,11 @@ type writeBufferBase struct {
 	checkpoint     *msgpb.MsgPosition
 	flushTimestamp *atomic.Uint64
 
+	// deltaWAL persists the buffered deletes locally, nil if disabled
+	deltaWAL *deltaWAL
+	// pkVerifier verifies the probable duplicate primary keys of the strict pk mode, nil if not set
+	pkVerifier PKVerifier
+
 	errHandler           func(err error)
 	taskObserverCallback func(t syncmgr.Task, err error) // execute when a sync task finished, should be concurrent safe.
 
@@ -140,7 +150,7 @@ type writeBufferBase struct {
 func newWriteBufferBase(channel string, metacache metacache.MetaCache, syncMgr syncmgr.SyncManager, option *writeBufferOption) (*writeBufferBase, error) {
 	flushTs := atomic.NewUint64(nonFlushTS)
 	flushTsPolicy := GetFlushTsPolicy(flushTs, metacache)
-	option.syncPolicies = append(option.syncPolicies, flushTsPolicy)
+	option.syncPolicies = append(option.syncPolicies, flushTsPolicy, GetMaxBufferAgePolicy(metacache))
 
 	schema := metacache.GetSchema(0)
 	estSize, err := typeutil.EstimateSizePerRecord(schema)
@@ -162,12 +172,20 @@ func newWriteBufferBase(channel string, metacache metacache.MetaCache, syncMgr s
 		flushTimestamp:       flushTs,
 		errHandler:           option.errorHandler,
 		taskObserverCallback: option.taskObserverCallback,
+		pkVerifier:           option.pkVerifier,
 	}
 
 	wb.logger = log.With(zap.Int64("collectionID", wb.collectionID),
 		zap.String("channel", wb.channelName))
 	wb.cpRatedLogger = wb.logger.WithRateGroup(fmt.Sprintf("writebuffer_cp_%s", wb.channelName), 1, 60)
 
+	if paramtable.Get().DataNodeCfg.DeltaWALEnabled.GetAsBool() {
+		wb.deltaWAL, err = newDeltaWAL(getDeltaWALDir(channel))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return wb, nil
 }
 
@@ -320,9 +338,11 @@ func (wb *writeBufferBase) syncSegments(ctx context.Context, segmentIDs []int64)
 
 			if syncTask.StartPosition() != nil {
 				wb.syncCheckpoint.Remove(syncTask.SegmentID(), syncTask.StartPosition().GetTimestamp())
+				wb.removeDeltaWAL(syncTask.SegmentID(), syncTask.StartPosition().GetTimestamp())
 			}
 
-			if syncTask.IsFlush() {
+			// the flushed segments are kept with their bloom filters for the duplicate detection of the strict pk mode
+			if syncTask.IsFlush() && !wb.strictPKEnabled() {
 				wb.metaCache.RemoveSegments(metacache.WithSegmentIDs(syncTask.SegmentID()))
 				log.Info("flushed segment removed", zap.Int64("segmentID", syncTask.SegmentID()), zap.String("channel", syncTask.ChannelName()))
 			}
@@ -367,19 +387,20 @@ func (wb *writeBufferBase) getOrCreateBuffer(segmentID int64, timetick uint64) *
 	return buffer
 }
 
-func (wb *writeBufferBase) yieldBuffer(segmentID int64) ([]*storage.InsertData, map[int64]*storage.BM25Stats, *storage.DeleteData, *schemapb.CollectionSchema, *TimeRange, *msgpb.MsgPosition) {
+func (wb *writeBufferBase) yieldBuffer(segmentID int64) ([]*storage.InsertData, map[int64]*storage.BM25Stats, *storage.DeleteData, *schemapb.CollectionSchema, *TimeRange, *msgpb.MsgPosition, *uint32) {
 	buffer, ok := wb.buffers[segmentID]
 	if !ok {
-		return nil, nil, nil, nil, nil, nil
+		return nil, nil, nil, nil, nil, nil, nil
 	}
 
 	// remove buffer and move it to sync manager
 	delete(wb.buffers, segmentID)
 	start := buffer.EarliestPosition()
 	timeRange := buffer.GetTimeRange()
+	checksum := buffer.insertBuffer.Checksum()
 	insert, bm25, delta, schema := buffer.Yield()
 
-	return insert, bm25, delta, schema, timeRange, start
+	return insert, bm25, delta, schema, timeRange, start, checksum
 }
 
 type InsertData struct {
@@ -394,6 +415,10 @@ type InsertData struct {
 	tsField []*storage.Int64FieldData
 	rowNum  int64
 
+	// checksums are the checksums of the insert messages, one for each data,
+	// it's shorter than data if some messages are not checksummed.
+	checksums []uint32
+
 	intPKTs map[int64]int64
 	strPKTs map[string]int64
 }
@@ -539,13 +564,14 @@ func (wb *writeBufferBase) getSyncTask(ctx context.Context, segmentID int64) (sy
 	var totalMemSize float64 = 0
 	var tsFrom, tsTo uint64
 
-	insert, bm25, delta, schema, timeRange, startPos := wb.yieldBuffer(segmentID)
+	insert, bm25, delta, schema, timeRange, startPos, checksum := wb.yieldBuffer(segmentID)
 	if timeRange != nil {
 		tsFrom, tsTo = timeRange.timestampMin, timeRange.timestampMax
 	}
 
 	if startPos != nil {
 		wb.syncCheckpoint.Add(segmentID, startPos, "syncing task")
+		wb.sealDeltaWAL(segmentID, startPos.GetTimestamp())
 	}
 
 	actions := []metacache.SegmentAction{}
@@ -581,6 +607,10 @@ func (wb *writeBufferBase) getSyncTask(ctx context.Context, segmentID int64) (sy
 		pack.WithBM25Stats(bm25)
 	}
 
+	if checksum != nil {
+		pack.WithInsertChecksum(*checksum)
+	}
+
 	if segmentInfo.State() == commonpb.SegmentState_Flushing ||
 		segmentInfo.Level() == datapb.SegmentLevel_L0 { // Level zero segment will always be sync as flushed
 		pack.WithFlush()
@@ -602,6 +632,11 @@ func (wb *writeBufferBase) getSyncTask(ctx context.Context, segmentID int64) (sy
 }
 
 // getEstBatchSize returns the batch size based on estimated size per record and FlushBufferSize configuration value.
+func (wb *writeBufferBase) strictPKEnabled() bool {
+	mode, _ := common.GetCollectionStrictPKMode(wb.metaCache.GetSchema(math.MaxUint64).GetProperties())
+	return mode != ""
+}
+
 func (wb *writeBufferBase) getEstBatchSize() uint {
 	sizeLimit := paramtable.Get().DataNodeCfg.FlushInsertBufferSize.GetAsInt64()
 	return uint(sizeLimit / int64(wb.estSizePerRecord))
@@ -613,6 +648,8 @@ func (wb *writeBufferBase) Close(ctx context.Context, drop bool) {
 	wb.mut.Lock()
 	defer wb.mut.Unlock()
 	if !drop {
+		// keep the delta wal, the deletes not synced are replayed when the channel is recovered on this node
+		wb.closeDeltaWAL()
 		return
 	}
 
@@ -638,6 +675,7 @@ func (wb *writeBufferBase) Close(ctx context.Context, drop bool) {
 			}
 			if syncTask.StartPosition() != nil {
 				wb.syncCheckpoint.Remove(syncTask.SegmentID(), syncTask.StartPosition().GetTimestamp())
+				wb.removeDeltaWAL(syncTask.SegmentID(), syncTask.StartPosition().GetTimestamp())
 			}
 			return nil
 		})
@@ -659,6 +697,56 @@ func (wb *writeBufferBase) Close(ctx context.Context, drop bool) {
 		// TODO change to remove channel in the future
 		panic(err)
 	}
+	wb.dropDeltaWAL()
+}
+
+// appendDeltaWAL writes the deletes to the delta wal before buffering them,
+// the deletes shall not be buffered if it fails, otherwise they may be lost after a crash.
+func (wb *writeBufferBase) appendDeltaWAL(segmentID, partitionID int64, pks *schemapb.IDs, tss []uint64) error {
+	if wb.deltaWAL == nil {
+		return nil
+	}
+	if err := wb.deltaWAL.Append(segmentID, partitionID, pks, tss); err != nil {
+		wb.logger.Warn("failed to append delta wal", zap.Int64("segmentID", segmentID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (wb *writeBufferBase) sealDeltaWAL(segmentID int64, ts uint64) {
+	if wb.deltaWAL == nil {
+		return
+	}
+	if err := wb.deltaWAL.Seal(segmentID, ts); err != nil {
+		wb.logger.Warn("failed to seal delta wal", zap.Int64("segmentID", segmentID), zap.Error(err))
+	}
+}
+
+func (wb *writeBufferBase) removeDeltaWAL(segmentID int64, ts uint64) {
+	if wb.deltaWAL == nil {
+		return
+	}
+	if err := wb.deltaWAL.Remove(segmentID, ts); err != nil {
+		wb.logger.Warn("failed to remove delta wal", zap.Int64("segmentID", segmentID), zap.Error(err))
+	}
+}
+
+func (wb *writeBufferBase) closeDeltaWAL() {
+	if wb.deltaWAL == nil {
+		return
+	}
+	if err := wb.deltaWAL.Close(); err != nil {
+		wb.logger.Warn("failed to close delta wal", zap.Error(err))
+	}
+}
+
+func (wb *writeBufferBase) dropDeltaWAL() {
+	if wb.deltaWAL == nil {
+		return
+	}
+	if err := wb.deltaWAL.Drop(); err != nil {
+		wb.logger.Warn("failed to drop delta wal", zap.Error(err))
+	}
 }
 
 // prepareInsert transfers InsertMsg into organized InsertData grouped by segmentID
@@ -730,6 +818,9 @@ func PrepareInsert(collSchema *schemapb.CollectionSchema, pkField *schemapb.Fiel
 			inData.pkField = append(inData.pkField, pkFieldData)
 			inData.tsField = append(inData.tsField, tsFieldData)
 			inData.rowNum += int64(data.GetRowNum())
+			if checksum, ok, err := msg.GetChecksum(); ok && err == nil {
+				inData.checksums = append(inData.checksums, checksum)
+			}
 		}
 		result = append(result, inData)
 	}
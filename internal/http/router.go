@@ -25,12 +25,19 @@ const LivezRouterPath = "/livez"
 // LogLevelRouterPath is path for Get and Update log level at runtime.
 const LogLevelRouterPath = "/log/level"
 
+// LogModuleLevelRouterPath is path for Get and Update per-module log level at runtime.
+const LogModuleLevelRouterPath = "/log/level/module"
+
 // EventLogRouterPath is path for eventlog control.
 const EventLogRouterPath = "/eventlog"
 
 // ExprPath is path for expression.
 const ExprPath = "/expr"
 
+// FaultInjectionRouterPath is path for list, enable or disable the failpoints of the process,
+// it only works with the faultinject build.
+const FaultInjectionRouterPath = "/management/fault_injection"
+
 // StaticPath is path for the static view.
 const StaticPath = "/static/"
 
@@ -54,6 +61,16 @@ const (
 	RouteGcPause  = "/management/datacoord/garbage_collection/pause"
 	RouteGcResume = "/management/datacoord/garbage_collection/resume"
 
+	RouteFlushAndSeal = "/management/datacoord/collection/flush_and_seal"
+
+	RouteListQuarantinedSegments   = "/management/datacoord/segment/quarantine/list"
+	RouteOperateQuarantinedSegment = "/management/datacoord/segment/quarantine/operate"
+
+	RouteRebuildStatslogs      = "/management/datacoord/statslog/rebuild"
+	RouteGetStatslogRebuildJob = "/management/datacoord/statslog/get"
+
+	RouteListBackgroundJobs = "/management/coord/jobs/list"
+
 	RouteSuspendQueryCoordBalance = "/management/querycoord/balance/suspend"
 	RouteResumeQueryCoordBalance  = "/management/querycoord/balance/resume"
 	RouteQueryCoordBalanceStatus  = "/management/querycoord/balance/status"
@@ -65,6 +82,21 @@ const (
 	RouteListQueryNode              = "/management/querycoord/node/list"
 	RouteGetQueryNodeDistribution   = "/management/querycoord/distribution/get"
 	RouteCheckQueryNodeDistribution = "/management/querycoord/distribution/check"
+
+	RouteUpdateCollectionReplica = "/management/querycoord/collection/replica/update"
+
+	RouteSetCollectionQuotaOverride = "/management/rootcoord/collection/quota/override"
+
+	RouteCreateCollectionTemplate = "/management/rootcoord/collection_template/create"
+	RouteDropCollectionTemplate   = "/management/rootcoord/collection_template/drop"
+	RouteListCollectionTemplates  = "/management/rootcoord/collection_template/list"
+
+	RouteCreateCollectionFromTemplate = "/management/proxy/collection/create_from_template"
+
+	RouteGetUsage = "/management/rootcoord/usage"
+
+	RouteListClientSessions = "/management/proxy/client/list"
+	RouteKillClientSession  = "/management/proxy/client/kill"
 )
 
 const (
@@ -98,6 +130,9 @@ const (
 	// SlowQueryPath is the path to get slow queries metrics
 	SlowQueryPath = "/_cluster/slow_query"
 
+	// RCDdlTasksPath is the path to get the asynchronous ddl tasks in RootCoord.
+	RCDdlTasksPath = "/_rc/tasks/ddl"
+
 	// QCDistPath is the path to get QueryCoord distribution.
 	QCDistPath = "/_qc/dist"
 	// QCTargetPath is the path to get QueryCoord target.
@@ -126,9 +161,13 @@ const (
 	DCBuildIndexTasksPath = "/_dc/tasks/build_index"
 	// DCSegmentsPath is the path to get segments in DataCoord.
 	DCSegmentsPath = "/_dc/segments"
+	// DCSegmentCandidatesPath is the path to get the segments selected or rejected by compaction and GC in DataCoord.
+	DCSegmentCandidatesPath = "/_dc/segments/candidates"
 
 	// DNSyncTasksPath is the path to get sync tasks in DataNode.
 	DNSyncTasksPath = "/_dn/tasks/sync"
+	// DNSlowSyncTasksPath is the path to get the slowest recent sync tasks in DataNode.
+	DNSlowSyncTasksPath = "/_dn/tasks/sync/slow"
 	// DNSegmentsPath is the path to get segments in DataNode.
 	DNSegmentsPath = "/_dn/segments"
 	// DNChannelsPath is the path to get channels in DataNode.
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/eventlog"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/util/expr"
+	"github.com/milvus-io/milvus/pkg/v2/util/faultinject"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
@@ -72,6 +73,10 @@ func registerDefaults() {
 			log.Level().ServeHTTP(w, req)
 		},
 	})
+	Register(&Handler{
+		Path:    LogModuleLevelRouterPath,
+		Handler: log.ModuleLevelHandler(),
+	})
 	Register(&Handler{
 		Path:    HealthzRouterPath,
 		Handler: healthz.Handler(),
@@ -102,6 +107,10 @@ func registerDefaults() {
 			json.NewEncoder(w).Encode(resp)
 		}),
 	})
+	Register(&Handler{
+		Path:    FaultInjectionRouterPath,
+		Handler: faultinject.Handler(),
+	})
 	Register(&Handler{
 		Path:    StaticPath,
 		Handler: GetStaticHandler(),
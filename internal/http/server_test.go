@@ -99,6 +99,28 @@ func (suite *HTTPServerTestSuite) TestDefaultLogHandler() {
 	suite.Equal(zap.ErrorLevel, log.GetLevel())
 }
 
+func (suite *HTTPServerTestSuite) TestModuleLogLevelHandler() {
+	defer log.ResetModuleLevel("datacoord.meta")
+	payload, err := json.Marshal(map[string]any{"module": "datacoord.meta", "level": "debug"})
+	suite.Require().NoError(err)
+
+	url := "http://localhost:" + DefaultListenPort + LogModuleLevelRouterPath
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(payload))
+	suite.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := http.Client{}
+	resp, err := client.Do(req)
+	suite.Require().NoError(err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	suite.Require().NoError(err)
+	suite.Equal(http.StatusOK, resp.StatusCode)
+	suite.JSONEq(`{"datacoord.meta":"debug"}`, string(body))
+	suite.Equal(zap.DebugLevel, log.GetModuleLevels()["datacoord.meta"])
+}
+
 func (suite *HTTPServerTestSuite) TestHealthzHandler() {
 	url := "http://localhost:" + DefaultListenPort + "/healthz"
 	client := http.Client{}
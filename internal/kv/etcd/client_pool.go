@@ -0,0 +1,90 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdkv
+
+import (
+	"context"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+)
+
+// requestClass classifies the etcd requests by their latency requirement.
+type requestClass int
+
+const (
+	// latencySensitiveRequest is the small get/put/txn request.
+	latencySensitiveRequest requestClass = iota
+	// bulkRequest is the range read which may return lots of kvs, e.g. list segments.
+	bulkRequest
+)
+
+func (c requestClass) String() string {
+	if c == bulkRequest {
+		return metrics.MetaBulkRequestLabel
+	}
+	return metrics.MetaLatencySensitiveRequestLabel
+}
+
+// clientPool dispatches the etcd requests to different clients by request class.
+// Bulk range reads go through a dedicated client with bounded concurrency,
+// so that large range responses won't block the small requests and keepalives on the primary connection.
+type clientPool struct {
+	primary *clientv3.Client
+	bulk    *clientv3.Client
+	// ownBulk is true if the bulk client is closed with the pool.
+	ownBulk bool
+	// bulkSem bounds the concurrent bulk requests, nil means unlimited.
+	bulkSem chan struct{}
+}
+
+func newClientPool(primary *clientv3.Client, bulk *clientv3.Client, bulkConcurrency int, ownBulk bool) *clientPool {
+	pool := &clientPool{
+		primary: primary,
+		bulk:    bulk,
+		ownBulk: ownBulk,
+	}
+	if bulk != nil && bulkConcurrency > 0 {
+		pool.bulkSem = make(chan struct{}, bulkConcurrency)
+	}
+	return pool
+}
+
+// acquire returns the client for the request class, the returned release func must be called once the request is done.
+func (p *clientPool) acquire(ctx context.Context, class requestClass) (*clientv3.Client, func(), error) {
+	if class != bulkRequest || p.bulk == nil {
+		return p.primary, func() {}, nil
+	}
+	if p.bulkSem == nil {
+		return p.bulk, func() {}, nil
+	}
+	select {
+	case p.bulkSem <- struct{}{}:
+		return p.bulk, func() { <-p.bulkSem }, nil
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	}
+}
+
+// close closes the owned bulk client, the primary client is owned by the caller.
+func (p *clientPool) close() error {
+	if p.bulk == nil || p.bulk == p.primary || !p.ownBulk {
+		return nil
+	}
+	return p.bulk.Close()
+}
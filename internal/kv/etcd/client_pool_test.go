@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdkv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestClientPool(t *testing.T) {
+	primary := &clientv3.Client{}
+	bulk := &clientv3.Client{}
+
+	t.Run("without bulk client", func(t *testing.T) {
+		pool := newClientPool(primary, nil, 1, true)
+		client, release, err := pool.acquire(context.Background(), bulkRequest)
+		assert.NoError(t, err)
+		assert.Same(t, primary, client)
+		release()
+		assert.NoError(t, pool.close())
+	})
+
+	t.Run("dispatch by class", func(t *testing.T) {
+		pool := newClientPool(primary, bulk, 0, true)
+		client, release, err := pool.acquire(context.Background(), latencySensitiveRequest)
+		assert.NoError(t, err)
+		assert.Same(t, primary, client)
+		release()
+
+		client, release, err = pool.acquire(context.Background(), bulkRequest)
+		assert.NoError(t, err)
+		assert.Same(t, bulk, client)
+		release()
+	})
+
+	t.Run("bounded bulk concurrency", func(t *testing.T) {
+		pool := newClientPool(primary, bulk, 1, true)
+		_, release, err := pool.acquire(context.Background(), bulkRequest)
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, _, err = pool.acquire(ctx, bulkRequest)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		// latency sensitive requests are not limited
+		client, releaseSmall, err := pool.acquire(ctx, latencySensitiveRequest)
+		assert.NoError(t, err)
+		assert.Same(t, primary, client)
+		releaseSmall()
+
+		release()
+		_, release, err = pool.acquire(context.Background(), bulkRequest)
+		assert.NoError(t, err)
+		release()
+	})
+	t.Run("shared bulk client", func(t *testing.T) {
+		// the shared bulk client is not closed by the pool
+		pool := newClientPool(primary, bulk, 1, false)
+		assert.NoError(t, pool.close())
+	})
+}
+
+func TestWithSharedBulkClient(t *testing.T) {
+	paramtable.Init()
+	etcdCfg := &paramtable.Get().EtcdCfg
+	paramtable.Get().Save(etcdCfg.BulkClientEnabled.Key, "false")
+	defer paramtable.Get().Reset(etcdCfg.BulkClientEnabled.Key)
+
+	opt := defaultOption()
+	WithSharedBulkClient(etcdCfg)(opt)
+	assert.Nil(t, opt.bulkClient)
+	assert.False(t, opt.bulkShared)
+}
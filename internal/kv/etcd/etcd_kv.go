@@ -47,6 +47,7 @@ var _ kv.WatchKV = (*etcdKV)(nil)
 // etcdKV implements TxnKV interface, it supports to process multiple kvs in a transaction.
 type etcdKV struct {
 	client   *clientv3.Client
+	pool     *clientPool
 	rootPath string
 
 	requestTimeout time.Duration
@@ -60,6 +61,7 @@ func NewEtcdKV(client *clientv3.Client, rootPath string, options ...Option) *etc
 	}
 	kv := &etcdKV{
 		client:   client,
+		pool:     newClientPool(client, opt.bulkClient, opt.bulkConcurrency, !opt.bulkShared),
 		rootPath: rootPath,
 
 		requestTimeout: opt.requestTimeout,
@@ -69,6 +71,9 @@ func NewEtcdKV(client *clientv3.Client, rootPath string, options ...Option) *etc
 
 // Close closes the connection to etcd.
 func (kv *etcdKV) Close() {
+	if err := kv.pool.close(); err != nil {
+		log.Ctx(context.TODO()).Warn("failed to close etcd bulk client", zap.String("path", kv.rootPath), zap.Error(err))
+	}
 	log.Ctx(context.TODO()).Debug("etcd kv closed", zap.String("path", kv.rootPath))
 }
 
@@ -91,7 +96,7 @@ func (kv *etcdKV) WalkWithPrefix(ctx context.Context, prefix string, paginationS
 	key := prefix
 	for {
 		ctx1, cancel := getContextWithTimeout(ctx, kv.requestTimeout)
-		resp, err := kv.getEtcdMeta(ctx1, key, opts...)
+		resp, err := kv.getEtcdMeta(ctx1, bulkRequest, key, opts...)
 		if err != nil {
 			cancel()
 			return err
@@ -123,7 +128,7 @@ func (kv *etcdKV) LoadWithPrefix(ctx context.Context, key string) ([]string, []s
 	key = path.Join(kv.rootPath, key)
 	ctx1, cancel := getContextWithTimeout(ctx, kv.requestTimeout)
 	defer cancel()
-	resp, err := kv.getEtcdMeta(ctx1, key, clientv3.WithPrefix(),
+	resp, err := kv.getEtcdMeta(ctx1, bulkRequest, key, clientv3.WithPrefix(),
 		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
 	if err != nil {
 		return nil, nil, err
@@ -144,7 +149,7 @@ func (kv *etcdKV) Has(ctx context.Context, key string) (bool, error) {
 	ctx1, cancel := getContextWithTimeout(ctx, kv.requestTimeout)
 	defer cancel()
 
-	resp, err := kv.getEtcdMeta(ctx1, key, clientv3.WithCountOnly())
+	resp, err := kv.getEtcdMeta(ctx1, latencySensitiveRequest, key, clientv3.WithCountOnly())
 	if err != nil {
 		return false, err
 	}
@@ -159,7 +164,7 @@ func (kv *etcdKV) HasPrefix(ctx context.Context, prefix string) (bool, error) {
 	ctx1, cancel := getContextWithTimeout(ctx, kv.requestTimeout)
 	defer cancel()
 
-	resp, err := kv.getEtcdMeta(ctx1, prefix, clientv3.WithPrefix(), clientv3.WithLimit(1), clientv3.WithCountOnly())
+	resp, err := kv.getEtcdMeta(ctx1, latencySensitiveRequest, prefix, clientv3.WithPrefix(), clientv3.WithLimit(1), clientv3.WithCountOnly())
 	if err != nil {
 		return false, err
 	}
@@ -174,7 +179,7 @@ func (kv *etcdKV) LoadBytesWithPrefix(ctx context.Context, key string) ([]string
 	key = path.Join(kv.rootPath, key)
 	ctx1, cancel := getContextWithTimeout(ctx, kv.requestTimeout)
 	defer cancel()
-	resp, err := kv.getEtcdMeta(ctx1, key, clientv3.WithPrefix(),
+	resp, err := kv.getEtcdMeta(ctx1, bulkRequest, key, clientv3.WithPrefix(),
 		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
 	if err != nil {
 		return nil, nil, err
@@ -195,7 +200,7 @@ func (kv *etcdKV) LoadBytesWithPrefix2(ctx context.Context, key string) ([]strin
 	key = path.Join(kv.rootPath, key)
 	ctx1, cancel := getContextWithTimeout(ctx, kv.requestTimeout)
 	defer cancel()
-	resp, err := kv.getEtcdMeta(ctx1, key, clientv3.WithPrefix(),
+	resp, err := kv.getEtcdMeta(ctx1, bulkRequest, key, clientv3.WithPrefix(),
 		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
 	if err != nil {
 		return nil, nil, nil, err
@@ -218,7 +223,7 @@ func (kv *etcdKV) Load(ctx context.Context, key string) (string, error) {
 	key = path.Join(kv.rootPath, key)
 	ctx1, cancel := getContextWithTimeout(ctx, kv.requestTimeout)
 	defer cancel()
-	resp, err := kv.getEtcdMeta(ctx1, key)
+	resp, err := kv.getEtcdMeta(ctx1, latencySensitiveRequest, key)
 	if err != nil {
 		return "", err
 	}
@@ -235,7 +240,7 @@ func (kv *etcdKV) LoadBytes(ctx context.Context, key string) ([]byte, error) {
 	key = path.Join(kv.rootPath, key)
 	ctx1, cancel := getContextWithTimeout(ctx, kv.requestTimeout)
 	defer cancel()
-	resp, err := kv.getEtcdMeta(ctx1, key)
+	resp, err := kv.getEtcdMeta(ctx1, latencySensitiveRequest, key)
 	if err != nil {
 		return nil, err
 	}
@@ -322,7 +327,7 @@ func (kv *etcdKV) LoadBytesWithRevision(ctx context.Context, key string) ([]stri
 	key = path.Join(kv.rootPath, key)
 	ctx1, cancel := getContextWithTimeout(ctx, kv.requestTimeout)
 	defer cancel()
-	resp, err := kv.getEtcdMeta(ctx1, key, clientv3.WithPrefix(),
+	resp, err := kv.getEtcdMeta(ctx1, bulkRequest, key, clientv3.WithPrefix(),
 		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
 	if err != nil {
 		return nil, nil, 0, err
@@ -700,13 +705,21 @@ func CheckTnxStringValueSizeAndWarn(ctx context.Context, kvs map[string]string)
 	return CheckTnxBytesValueSizeAndWarn(ctx, newKvs)
 }
 
-func (kv *etcdKV) getEtcdMeta(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+func (kv *etcdKV) getEtcdMeta(ctx context.Context, class requestClass, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
 	ctx1, cancel := context.WithTimeout(ctx, kv.requestTimeout)
 	defer cancel()
 
+	client, release, err := kv.pool.acquire(ctx1, class)
+	if err != nil {
+		metrics.MetaOpCounter.WithLabelValues(metrics.MetaGetLabel, metrics.FailLabel).Inc()
+		return nil, err
+	}
+	defer release()
+
 	start := timerecord.NewTimeRecorder("getEtcdMeta")
-	resp, err := kv.client.Get(ctx1, key, opts...)
+	resp, err := client.Get(ctx1, key, opts...)
 	elapsed := start.ElapseSpan()
+	metrics.MetaClassRequestLatency.WithLabelValues(class.String(), metrics.MetaGetLabel).Observe(float64(elapsed.Milliseconds()))
 	metrics.MetaOpCounter.WithLabelValues(metrics.MetaGetLabel, metrics.TotalLabel).Inc()
 
 	// cal meta kv size
@@ -717,6 +730,7 @@ func (kv *etcdKV) getEtcdMeta(ctx context.Context, key string, opts ...clientv3.
 		}
 		metrics.MetaKvSize.WithLabelValues(metrics.MetaGetLabel).Observe(float64(totalSize))
 		metrics.MetaRequestLatency.WithLabelValues(metrics.MetaGetLabel).Observe(float64(elapsed.Milliseconds()))
+		metrics.ObserveMetaOpByPrefix(metrics.MetaKeyPrefix(kv.rootPath, key), metrics.MetaGetLabel, elapsed, totalSize)
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaGetLabel, metrics.SuccessLabel).Inc()
 	} else {
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaGetLabel, metrics.FailLabel).Inc()
@@ -731,10 +745,12 @@ func (kv *etcdKV) putEtcdMeta(ctx context.Context, key, val string, opts ...clie
 	start := timerecord.NewTimeRecorder("putEtcdMeta")
 	resp, err := kv.client.Put(ctx1, key, val, opts...)
 	elapsed := start.ElapseSpan()
+	metrics.MetaClassRequestLatency.WithLabelValues(latencySensitiveRequest.String(), metrics.MetaPutLabel).Observe(float64(elapsed.Milliseconds()))
 	metrics.MetaOpCounter.WithLabelValues(metrics.MetaPutLabel, metrics.TotalLabel).Inc()
 	if err == nil {
 		metrics.MetaKvSize.WithLabelValues(metrics.MetaPutLabel).Observe(float64(len(val)))
 		metrics.MetaRequestLatency.WithLabelValues(metrics.MetaPutLabel).Observe(float64(elapsed.Milliseconds()))
+		metrics.ObserveMetaOpByPrefix(metrics.MetaKeyPrefix(kv.rootPath, key), metrics.MetaPutLabel, elapsed, len(val))
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaPutLabel, metrics.SuccessLabel).Inc()
 	} else {
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaPutLabel, metrics.FailLabel).Inc()
@@ -750,10 +766,12 @@ func (kv *etcdKV) removeEtcdMeta(ctx context.Context, key string, opts ...client
 	start := timerecord.NewTimeRecorder("removeEtcdMeta")
 	resp, err := kv.client.Delete(ctx1, key, opts...)
 	elapsed := start.ElapseSpan()
+	metrics.MetaClassRequestLatency.WithLabelValues(latencySensitiveRequest.String(), metrics.MetaRemoveLabel).Observe(float64(elapsed.Milliseconds()))
 	metrics.MetaOpCounter.WithLabelValues(metrics.MetaRemoveLabel, metrics.TotalLabel).Inc()
 
 	if err == nil {
 		metrics.MetaRequestLatency.WithLabelValues(metrics.MetaRemoveLabel).Observe(float64(elapsed.Milliseconds()))
+		metrics.ObserveMetaOpByPrefix(metrics.MetaKeyPrefix(kv.rootPath, key), metrics.MetaRemoveLabel, elapsed, 0)
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaRemoveLabel, metrics.SuccessLabel).Inc()
 	} else {
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaRemoveLabel, metrics.FailLabel).Inc()
@@ -771,8 +789,16 @@ func (kv *etcdKV) executeTxn(txn clientv3.Txn, ops ...clientv3.Op) (*clientv3.Tx
 
 	resp, err := txn.Then(ops...).Commit()
 	elapsed := start.ElapseSpan()
+	metrics.MetaClassRequestLatency.WithLabelValues(latencySensitiveRequest.String(), metrics.MetaTxnLabel).Observe(float64(elapsed.Milliseconds()))
 	metrics.MetaOpCounter.WithLabelValues(metrics.MetaTxnLabel, metrics.TotalLabel).Inc()
 
+	// the ops of one txn are usually on the same prefix, so the first key is used as the representative.
+	prefix := metrics.MetaUnknownKeyPrefix
+	if len(ops) > 0 {
+		prefix = metrics.MetaKeyPrefix(kv.rootPath, string(ops[0].KeyBytes()))
+	}
+	metrics.MetaTxnOpCount.WithLabelValues(prefix).Observe(float64(len(ops)))
+
 	if err == nil && resp.Succeeded {
 		// cal put meta kv size
 		totalPutSize := 0
@@ -794,6 +820,7 @@ func (kv *etcdKV) executeTxn(txn clientv3.Txn, ops ...clientv3.Op) (*clientv3.Tx
 		}
 		metrics.MetaKvSize.WithLabelValues(metrics.MetaGetLabel).Observe(float64(totalGetSize))
 		metrics.MetaRequestLatency.WithLabelValues(metrics.MetaTxnLabel).Observe(float64(elapsed.Milliseconds()))
+		metrics.ObserveMetaOpByPrefix(prefix, metrics.MetaTxnLabel, elapsed, totalPutSize+totalGetSize)
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaTxnLabel, metrics.SuccessLabel).Inc()
 	} else {
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaTxnLabel, metrics.FailLabel).Inc()
@@ -17,8 +17,10 @@
 package etcdkv
 
 import (
+	"sync"
 	"time"
 
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/server/v3/embed"
 	"go.uber.org/zap"
 
@@ -53,7 +55,45 @@ func NewWatchKVFactory(rootPath string, etcdCfg *paramtable.EtcdConfig) (kv.Watc
 		}
 		return watchKv, err
 	}
-	client, err := etcd.CreateEtcdClient(
+	client, err := createEtcdClient(etcdCfg)
+	if err != nil {
+		return nil, err
+	}
+	options := []Option{WithRequestTimeout(etcdCfg.RequestTimeout.GetAsDuration(time.Millisecond))}
+	if etcdCfg.BulkClientEnabled.GetAsBool() {
+		bulkClient, err := createEtcdClient(etcdCfg)
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+		options = append(options, WithBulkClient(bulkClient, etcdCfg.BulkClientMaxConcurrency.GetAsInt()))
+	}
+	watchKv := NewEtcdKV(client, rootPath, options...)
+	return watchKv, err
+}
+
+var sharedBulkClient struct {
+	mu     sync.Mutex
+	client *clientv3.Client
+}
+
+// getSharedBulkClient returns the bulk client shared by the kvs of the process.
+func getSharedBulkClient(etcdCfg *paramtable.EtcdConfig) (*clientv3.Client, error) {
+	sharedBulkClient.mu.Lock()
+	defer sharedBulkClient.mu.Unlock()
+	if sharedBulkClient.client != nil {
+		return sharedBulkClient.client, nil
+	}
+	client, err := createEtcdClient(etcdCfg)
+	if err != nil {
+		return nil, err
+	}
+	sharedBulkClient.client = client
+	return client, nil
+}
+
+func createEtcdClient(etcdCfg *paramtable.EtcdConfig) (*clientv3.Client, error) {
+	return etcd.CreateEtcdClient(
 		etcdCfg.UseEmbedEtcd.GetAsBool(),
 		etcdCfg.EtcdEnableAuth.GetAsBool(),
 		etcdCfg.EtcdAuthUserName.GetValue(),
@@ -65,12 +105,6 @@ func NewWatchKVFactory(rootPath string, etcdCfg *paramtable.EtcdConfig) (kv.Watc
 		etcdCfg.EtcdTLSCACert.GetValue(),
 		etcdCfg.EtcdTLSMinVersion.GetValue(),
 		etcdCfg.ClientOptions()...)
-	if err != nil {
-		return nil, err
-	}
-	watchKv := NewEtcdKV(client, rootPath,
-		WithRequestTimeout(etcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
-	return watchKv, err
 }
 
 // NewMetaKvFactory returns an object that implements the kv.MetaKv interface using etcd.
@@ -16,10 +16,23 @@
 
 package etcdkv
 
-import "time"
+import (
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
 
 type etcdOpt struct {
 	requestTimeout time.Duration
+
+	bulkClient      *clientv3.Client
+	bulkConcurrency int
+	// bulkShared is true if the bulk client is shared by the kvs and not owned by the kv.
+	bulkShared bool
 }
 
 type Option func(*etcdOpt)
@@ -30,6 +43,34 @@ func WithRequestTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithBulkClient sets a dedicated client for bulk range reads with bounded concurrency,
+// the kv takes the ownership of the client and closes it on Close.
+func WithBulkClient(client *clientv3.Client, concurrency int) Option {
+	return func(opt *etcdOpt) {
+		opt.bulkClient = client
+		opt.bulkConcurrency = concurrency
+	}
+}
+
+// WithSharedBulkClient sets the process-wide bulk client if the bulk client is enabled by @etcdCfg,
+// the shared client is created on the first use and not closed by the kv.
+func WithSharedBulkClient(etcdCfg *paramtable.EtcdConfig) Option {
+	return func(opt *etcdOpt) {
+		if etcdCfg.UseEmbedEtcd.GetAsBool() || !etcdCfg.BulkClientEnabled.GetAsBool() {
+			return
+		}
+		client, err := getSharedBulkClient(etcdCfg)
+		if err != nil {
+			// the bulk range reads fall back to the primary client
+			log.Warn("failed to create etcd bulk client", zap.Error(err))
+			return
+		}
+		opt.bulkClient = client
+		opt.bulkConcurrency = etcdCfg.BulkClientMaxConcurrency.GetAsInt()
+		opt.bulkShared = true
+	}
+}
+
 func defaultOption() *etcdOpt {
 	return &etcdOpt{
 		requestTimeout: defaultRequestTimeout,
@@ -640,11 +640,15 @@ func (kv *txnTiKV) WalkWithPrefix(ctx context.Context, prefix string, pagination
 func (kv *txnTiKV) executeTxn(ctx context.Context, txn *transaction.KVTxn) error {
 	start := timerecord.NewTimeRecorder("executeTxn")
 
-	elapsed := start.ElapseSpan()
+	// the keys are not tracked by the txn, so the txn metrics are not grouped by key prefix for tikv.
+	opCount, size := txn.Len(), txn.Size()
+	metrics.MetaTxnOpCount.WithLabelValues(metrics.MetaUnknownKeyPrefix).Observe(float64(opCount))
 	metrics.MetaOpCounter.WithLabelValues(metrics.MetaTxnLabel, metrics.TotalLabel).Inc()
 	err := commitTxn(ctx, txn)
+	elapsed := start.ElapseSpan()
 	if err == nil {
 		metrics.MetaRequestLatency.WithLabelValues(metrics.MetaTxnLabel).Observe(float64(elapsed.Milliseconds()))
+		metrics.ObserveMetaOpByPrefix(metrics.MetaUnknownKeyPrefix, metrics.MetaTxnLabel, elapsed, size)
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaTxnLabel, metrics.SuccessLabel).Inc()
 	} else {
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaTxnLabel, metrics.FailLabel).Inc()
@@ -681,6 +685,7 @@ func (kv *txnTiKV) getTiKVMeta(ctx context.Context, key string) (string, error)
 	metrics.MetaOpCounter.WithLabelValues(metrics.MetaGetLabel, metrics.TotalLabel).Inc()
 	metrics.MetaKvSize.WithLabelValues(metrics.MetaGetLabel).Observe(float64(len(val)))
 	metrics.MetaRequestLatency.WithLabelValues(metrics.MetaGetLabel).Observe(float64(elapsed.Milliseconds()))
+	metrics.ObserveMetaOpByPrefix(metrics.MetaKeyPrefix(kv.rootPath, key), metrics.MetaGetLabel, elapsed, len(val))
 	metrics.MetaOpCounter.WithLabelValues(metrics.MetaGetLabel, metrics.SuccessLabel).Inc()
 
 	return strVal, nil
@@ -715,6 +720,7 @@ func (kv *txnTiKV) putTiKVMeta(ctx context.Context, key, val string) error {
 	if err == nil {
 		metrics.MetaKvSize.WithLabelValues(metrics.MetaPutLabel).Observe(float64(len(byteValue)))
 		metrics.MetaRequestLatency.WithLabelValues(metrics.MetaPutLabel).Observe(float64(elapsed.Milliseconds()))
+		metrics.ObserveMetaOpByPrefix(metrics.MetaKeyPrefix(kv.rootPath, key), metrics.MetaPutLabel, elapsed, len(byteValue))
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaPutLabel, metrics.SuccessLabel).Inc()
 	} else {
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaPutLabel, metrics.FailLabel).Inc()
@@ -747,6 +753,7 @@ func (kv *txnTiKV) removeTiKVMeta(ctx context.Context, key string) error {
 
 	if err == nil {
 		metrics.MetaRequestLatency.WithLabelValues(metrics.MetaRemoveLabel).Observe(float64(elapsed.Milliseconds()))
+		metrics.ObserveMetaOpByPrefix(metrics.MetaKeyPrefix(kv.rootPath, key), metrics.MetaRemoveLabel, elapsed, 0)
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaRemoveLabel, metrics.SuccessLabel).Inc()
 	} else {
 		metrics.MetaOpCounter.WithLabelValues(metrics.MetaRemoveLabel, metrics.FailLabel).Inc()
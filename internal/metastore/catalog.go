@@ -180,6 +180,10 @@ type DataCoordCatalog interface {
 	SaveStatsTask(ctx context.Context, task *indexpb.StatsTask) error
 	DropStatsTask(ctx context.Context, taskID typeutil.UniqueID) error
 
+	ListStatslogRebuildJobs(ctx context.Context) ([]*datapb.StatslogRebuildJob, error)
+	SaveStatslogRebuildJob(ctx context.Context, job *datapb.StatslogRebuildJob) error
+	DropStatslogRebuildJob(ctx context.Context, jobID typeutil.UniqueID) error
+
 	// Analyzer Resource
 	SaveFileResource(ctx context.Context, resource *model.FileResource) error
 	RemoveFileResource(ctx context.Context, resourceID int64) error
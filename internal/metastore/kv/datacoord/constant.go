@@ -33,8 +33,13 @@ const (
 	PartitionStatsInfoPrefix           = MetaPrefix + "/partition-stats"
 	PartitionStatsCurrentVersionPrefix = MetaPrefix + "/current-partition-stats-version"
 	StatsTaskPrefix                    = MetaPrefix + "/stats-task"
+	StatslogRebuildJobPrefix           = MetaPrefix + "/rebuild-statslog-job"
+	StatslogRebuildSegmentsPrefix      = MetaPrefix + "/rebuild-statslog-segments"
 	FileResourceMetaPrefix             = MetaPrefix + "/file_resource"
 
 	NonRemoveFlagTomestone = "non-removed"
 	RemoveFlagTomestone    = "removed"
 )
+
+// statslogRebuildSegmentsBatchSize is the max number of the segments per list in a segment batch of the statslog rebuild job.
+const statslogRebuildSegmentsBatchSize = 10000
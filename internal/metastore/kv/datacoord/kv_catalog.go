@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -963,6 +964,135 @@ func (kc *Catalog) DropStatsTask(ctx context.Context, taskID typeutil.UniqueID)
 	return kc.MetaKv.Remove(ctx, key)
 }
 
+func (kc *Catalog) ListStatslogRebuildJobs(ctx context.Context) ([]*datapb.StatslogRebuildJob, error) {
+	jobs := make([]*datapb.StatslogRebuildJob, 0)
+
+	applyFn := func(key []byte, value []byte) error {
+		job := &datapb.StatslogRebuildJob{}
+		err := proto.Unmarshal(value, job)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, job)
+		return nil
+	}
+
+	err := kc.MetaKv.WalkWithPrefix(ctx, StatslogRebuildJobPrefix, kc.paginationSize, applyFn)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		if err := kc.loadStatslogRebuildSegments(ctx, job); err != nil {
+			return nil, err
+		}
+	}
+	return jobs, nil
+}
+
+// loadStatslogRebuildSegments fills the segment lists of the job from its segment batches.
+func (kc *Catalog) loadStatslogRebuildSegments(ctx context.Context, job *datapb.StatslogRebuildJob) error {
+	if job.GetSegmentBatches() == 0 {
+		return nil
+	}
+	_, values, err := kc.MetaKv.LoadWithPrefix(ctx, buildStatslogRebuildSegmentsPrefix(job.GetJobID()))
+	if err != nil {
+		return err
+	}
+	batches := make([]*datapb.StatslogRebuildJobSegments, 0, len(values))
+	for _, value := range values {
+		batch := &datapb.StatslogRebuildJobSegments{}
+		if err := proto.Unmarshal([]byte(value), batch); err != nil {
+			return err
+		}
+		// the batches beyond the count are left by an interrupted save
+		if batch.GetBatch() < job.GetSegmentBatches() {
+			batches = append(batches, batch)
+		}
+	}
+	if len(batches) != int(job.GetSegmentBatches()) {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("statslog rebuild job %d has %d segment batches, expected %d",
+			job.GetJobID(), len(batches), job.GetSegmentBatches()))
+	}
+	sort.Slice(batches, func(i, j int) bool {
+		return batches[i].GetBatch() < batches[j].GetBatch()
+	})
+	for _, batch := range batches {
+		job.SegmentIDs = append(job.SegmentIDs, batch.GetSegmentIDs()...)
+		job.SkippedSegmentIDs = append(job.SkippedSegmentIDs, batch.GetSkippedSegmentIDs()...)
+		job.FinishedSegmentIDs = append(job.FinishedSegmentIDs, batch.GetFinishedSegmentIDs()...)
+		job.FailedSegmentIDs = append(job.FailedSegmentIDs, batch.GetFailedSegmentIDs()...)
+	}
+	job.SegmentBatches = 0
+	return nil
+}
+
+// splitStatslogRebuildSegments splits the segment lists of the job into the batches of at most batchSize segments per list.
+func splitStatslogRebuildSegments(job *datapb.StatslogRebuildJob, batchSize int) []*datapb.StatslogRebuildJobSegments {
+	lists := [][]int64{job.GetSegmentIDs(), job.GetSkippedSegmentIDs(), job.GetFinishedSegmentIDs(), job.GetFailedSegmentIDs()}
+	batchNum := 0
+	for _, list := range lists {
+		batchNum = max(batchNum, (len(list)+batchSize-1)/batchSize)
+	}
+	chunk := func(list []int64, i int) []int64 {
+		if i*batchSize >= len(list) {
+			return nil
+		}
+		return list[i*batchSize : min((i+1)*batchSize, len(list))]
+	}
+	batches := make([]*datapb.StatslogRebuildJobSegments, 0, batchNum)
+	for i := 0; i < batchNum; i++ {
+		batches = append(batches, &datapb.StatslogRebuildJobSegments{
+			JobID:              job.GetJobID(),
+			Batch:              int32(i),
+			SegmentIDs:         chunk(job.GetSegmentIDs(), i),
+			SkippedSegmentIDs:  chunk(job.GetSkippedSegmentIDs(), i),
+			FinishedSegmentIDs: chunk(job.GetFinishedSegmentIDs(), i),
+			FailedSegmentIDs:   chunk(job.GetFailedSegmentIDs(), i),
+		})
+	}
+	return batches
+}
+
+// SaveStatslogRebuildJob saves the segment lists of the job in batches, then the job record without the lists,
+// so the record always refers to the saved batches.
+func (kc *Catalog) SaveStatslogRebuildJob(ctx context.Context, job *datapb.StatslogRebuildJob) error {
+	batches := splitStatslogRebuildSegments(job, statslogRebuildSegmentsBatchSize)
+	kvs := make(map[string]string, len(batches))
+	for _, batch := range batches {
+		value, err := proto.Marshal(batch)
+		if err != nil {
+			return err
+		}
+		kvs[buildStatslogRebuildSegmentsKey(job.GetJobID(), batch.GetBatch())] = string(value)
+	}
+	if err := kc.SaveByBatch(ctx, kvs); err != nil {
+		return err
+	}
+
+	record := &datapb.StatslogRebuildJob{
+		JobID:          job.GetJobID(),
+		CollectionID:   job.GetCollectionID(),
+		State:          job.GetState(),
+		FailReason:     job.GetFailReason(),
+		StartTime:      job.GetStartTime(),
+		EndTime:        job.GetEndTime(),
+		SegmentBatches: int32(len(batches)),
+	}
+	value, err := proto.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(ctx, buildStatslogRebuildJobKey(job.GetJobID()), string(value))
+}
+
+// DropStatslogRebuildJob drops the job record first, so the segment batches left by a failure are never referred.
+func (kc *Catalog) DropStatslogRebuildJob(ctx context.Context, jobID typeutil.UniqueID) error {
+	if err := kc.MetaKv.Remove(ctx, buildStatslogRebuildJobKey(jobID)); err != nil {
+		return err
+	}
+	return kc.MetaKv.RemoveWithPrefix(ctx, buildStatslogRebuildSegmentsPrefix(jobID))
+}
+
 func (kc *Catalog) SaveFileResource(ctx context.Context, resource *model.FileResource) error {
 	k := BuildFileResourceKey(resource.ID)
 	v, err := proto.Marshal(resource.Marshal())
@@ -1806,3 +1806,145 @@ func Test_StatsTasks(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func Test_StatslogRebuildJobs(t *testing.T) {
+	kc := &Catalog{}
+	mockErr := errors.New("mock error")
+
+	t.Run("ListStatslogRebuildJobs", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, StatslogRebuildJobPrefix, mock.Anything, mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+
+		jobs, err := kc.ListStatslogRebuildJobs(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, jobs)
+
+		job := &datapb.StatslogRebuildJob{
+			JobID:        1,
+			CollectionID: 2,
+			SegmentIDs:   []int64{3, 4},
+			State:        indexpb.JobState_JobStateInProgress,
+		}
+		value, err := proto.Marshal(job)
+		assert.NoError(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, StatslogRebuildJobPrefix, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, _ string, _ int, f func([]byte, []byte) error) error {
+			return f([]byte("key1"), value)
+		})
+		kc.MetaKv = txn
+
+		jobs, err = kc.ListStatslogRebuildJobs(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobs))
+		assert.ElementsMatch(t, []int64{3, 4}, jobs[0].GetSegmentIDs())
+	})
+
+	t.Run("SaveStatslogRebuildJob", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(mock.Anything, buildStatslogRebuildJobKey(1), mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+
+		err := kc.SaveStatslogRebuildJob(context.Background(), &datapb.StatslogRebuildJob{JobID: 1})
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().MultiSave(mock.Anything, mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+
+		err = kc.SaveStatslogRebuildJob(context.Background(), &datapb.StatslogRebuildJob{JobID: 1, SegmentIDs: []int64{2}})
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().Save(mock.Anything, buildStatslogRebuildJobKey(1), mock.Anything).Return(nil)
+		kc.MetaKv = txn
+
+		err = kc.SaveStatslogRebuildJob(context.Background(), &datapb.StatslogRebuildJob{JobID: 1})
+		assert.NoError(t, err)
+	})
+
+	t.Run("segment batches", func(t *testing.T) {
+		job := &datapb.StatslogRebuildJob{
+			JobID:              1,
+			CollectionID:       2,
+			SegmentIDs:         []int64{10, 11, 12, 13, 14},
+			SkippedSegmentIDs:  []int64{20},
+			FinishedSegmentIDs: []int64{10, 11, 12},
+			State:              indexpb.JobState_JobStateInProgress,
+		}
+		batches := splitStatslogRebuildSegments(job, 2)
+		assert.Len(t, batches, 3)
+		assert.Equal(t, []int64{14}, batches[2].GetSegmentIDs())
+		assert.Empty(t, batches[2].GetFinishedSegmentIDs())
+
+		saved := make(map[string]string)
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().MultiSave(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, kvs map[string]string) error {
+			for k, v := range kvs {
+				saved[k] = v
+			}
+			return nil
+		})
+		txn.EXPECT().Save(mock.Anything, buildStatslogRebuildJobKey(1), mock.Anything).RunAndReturn(func(_ context.Context, k string, v string) error {
+			saved[k] = v
+			return nil
+		})
+		kc.MetaKv = txn
+		err := kc.SaveStatslogRebuildJob(context.Background(), job)
+		assert.NoError(t, err)
+		assert.Len(t, saved, 2)
+
+		// the job record doesn't keep the segment lists
+		record := &datapb.StatslogRebuildJob{}
+		err = proto.Unmarshal([]byte(saved[buildStatslogRebuildJobKey(1)]), record)
+		assert.NoError(t, err)
+		assert.Empty(t, record.GetSegmentIDs())
+		assert.Equal(t, int32(1), record.GetSegmentBatches())
+
+		// the segment lists are recovered from the batches, the stale batch is ignored
+		stale, err := proto.Marshal(&datapb.StatslogRebuildJobSegments{JobID: 1, Batch: 1, SegmentIDs: []int64{99}})
+		assert.NoError(t, err)
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, StatslogRebuildJobPrefix, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, _ string, _ int, f func([]byte, []byte) error) error {
+			return f([]byte(buildStatslogRebuildJobKey(1)), []byte(saved[buildStatslogRebuildJobKey(1)]))
+		})
+		txn.EXPECT().LoadWithPrefix(mock.Anything, buildStatslogRebuildSegmentsPrefix(1)).
+			Return(nil, []string{saved[buildStatslogRebuildSegmentsKey(1, 0)], string(stale)}, nil)
+		kc.MetaKv = txn
+		jobs, err := kc.ListStatslogRebuildJobs(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, jobs, 1)
+		assert.Equal(t, job.GetSegmentIDs(), jobs[0].GetSegmentIDs())
+		assert.Equal(t, job.GetSkippedSegmentIDs(), jobs[0].GetSkippedSegmentIDs())
+		assert.Equal(t, job.GetFinishedSegmentIDs(), jobs[0].GetFinishedSegmentIDs())
+		assert.Equal(t, int32(0), jobs[0].GetSegmentBatches())
+
+		// the missing batch fails the listing
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, StatslogRebuildJobPrefix, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, _ string, _ int, f func([]byte, []byte) error) error {
+			return f([]byte(buildStatslogRebuildJobKey(1)), []byte(saved[buildStatslogRebuildJobKey(1)]))
+		})
+		txn.EXPECT().LoadWithPrefix(mock.Anything, buildStatslogRebuildSegmentsPrefix(1)).Return(nil, nil, nil)
+		kc.MetaKv = txn
+		_, err = kc.ListStatslogRebuildJobs(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("DropStatslogRebuildJob", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(mock.Anything, buildStatslogRebuildJobKey(1)).Return(mockErr)
+		kc.MetaKv = txn
+
+		err := kc.DropStatslogRebuildJob(context.Background(), 1)
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(mock.Anything, buildStatslogRebuildJobKey(1)).Return(nil)
+		txn.EXPECT().RemoveWithPrefix(mock.Anything, buildStatslogRebuildSegmentsPrefix(1)).Return(nil)
+		kc.MetaKv = txn
+
+		err = kc.DropStatslogRebuildJob(context.Background(), 1)
+		assert.NoError(t, err)
+	})
+}
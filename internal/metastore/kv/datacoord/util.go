@@ -352,3 +352,15 @@ func buildAnalyzeTaskKey(taskID int64) string {
 func buildStatsTaskKey(taskID int64) string {
 	return fmt.Sprintf("%s/%d", StatsTaskPrefix, taskID)
 }
+
+func buildStatslogRebuildJobKey(jobID int64) string {
+	return fmt.Sprintf("%s/%d", StatslogRebuildJobPrefix, jobID)
+}
+
+func buildStatslogRebuildSegmentsPrefix(jobID int64) string {
+	return fmt.Sprintf("%s/%d/", StatslogRebuildSegmentsPrefix, jobID)
+}
+
+func buildStatslogRebuildSegmentsKey(jobID int64, batch int32) string {
+	return fmt.Sprintf("%s/%d/%d", StatslogRebuildSegmentsPrefix, jobID, batch)
+}
@@ -83,6 +83,11 @@ type SuffixSnapshot struct {
 	paginationSize int
 
 	closeGC chan struct{}
+
+	pinMu sync.Mutex
+	// pinnedTs the timestamps pinned by time travel readers, ts -> ref count,
+	// the versions visible at the pinned timestamps are kept by GC.
+	pinnedTs map[typeutil.Timestamp]int
 }
 
 // tsv struct stores kv with timestamp
@@ -118,6 +123,7 @@ func NewSuffixSnapshot(metaKV kv.MetaKv, sep, root, snapshot string) (*SuffixSna
 		rootLen:        rootLen,
 		paginationSize: paramtable.Get().MetaStoreCfg.PaginationSize.GetAsInt(),
 		closeGC:        make(chan struct{}, 1),
+		pinnedTs:       make(map[typeutil.Timestamp]int),
 	}
 	go ss.startBackgroundGC(context.TODO())
 	return ss, nil
@@ -317,6 +323,8 @@ func (ss *SuffixSnapshot) Load(ctx context.Context, key string, ts typeutil.Time
 		}
 		return value, err
 	}
+	// keep the versions visible at ts from being removed by GC while traveling
+	defer ss.pinTs(ts)()
 
 	ss.Lock()
 	after, err := ss.checkKeyTS(ctx, key, ts)
@@ -451,6 +459,7 @@ func (ss *SuffixSnapshot) LoadWithPrefix(ctx context.Context, key string, ts typ
 		err := ss.MetaKv.WalkWithPrefix(ctx, key, ss.paginationSize, applyFn)
 		return fks, fvs, err
 	}
+	defer ss.pinTs(ts)()
 	ss.Lock()
 	defer ss.Unlock()
 
@@ -610,11 +619,56 @@ func (ss *SuffixSnapshot) Close() {
 	close(ss.closeGC)
 }
 
+// pinTs pins the snapshot at the timestamp for time travel, the versions visible at ts won't be removed by GC
+// even if they are expired, until the returned unpin func is called.
+func (ss *SuffixSnapshot) pinTs(ts typeutil.Timestamp) func() {
+	ss.pinMu.Lock()
+	defer ss.pinMu.Unlock()
+	ss.pinnedTs[ts]++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ss.pinMu.Lock()
+			defer ss.pinMu.Unlock()
+			ss.pinnedTs[ts]--
+			if ss.pinnedTs[ts] <= 0 {
+				delete(ss.pinnedTs, ts)
+			}
+		})
+	}
+}
+
+func (ss *SuffixSnapshot) getPinnedTs() []typeutil.Timestamp {
+	ss.pinMu.Lock()
+	defer ss.pinMu.Unlock()
+	pinned := make([]typeutil.Timestamp, 0, len(ss.pinnedTs))
+	for ts := range ss.pinnedTs {
+		pinned = append(pinned, ts)
+	}
+	return pinned
+}
+
+// getPinnedVersions returns the version keys visible at the pinned timestamps, versions must be sorted by ts.
+func (ss *SuffixSnapshot) getPinnedVersions(versions []string, pinnedTs []typeutil.Timestamp) typeutil.Set[string] {
+	pinned := typeutil.NewSet[string]()
+	for _, pinTs := range pinnedTs {
+		idx := sort.Search(len(versions), func(i int) bool {
+			ts, _ := ss.isTSKey(versions[i])
+			return ts > pinTs
+		})
+		if idx > 0 {
+			pinned.Insert(versions[idx-1])
+		}
+	}
+	return pinned
+}
+
 // startBackgroundGC the data will clean up if key ts!=0 and expired
 func (ss *SuffixSnapshot) startBackgroundGC(ctx context.Context) {
 	log := log.Ctx(ctx)
 	log.Debug("suffix snapshot GC goroutine start!")
-	ticker := time.NewTicker(60 * time.Minute)
+	interval := paramtable.Get().ServiceParam.MetaStoreCfg.SnapshotGCInterval.GetAsDuration(time.Second)
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	for {
@@ -627,6 +681,10 @@ func (ss *SuffixSnapshot) startBackgroundGC(ctx context.Context) {
 			if err != nil {
 				log.Warn("remove expired data fail during GC", zap.Error(err))
 			}
+			if newInterval := paramtable.Get().ServiceParam.MetaStoreCfg.SnapshotGCInterval.GetAsDuration(time.Second); newInterval != interval {
+				interval = newInterval
+				ticker.Reset(interval)
+			}
 		}
 	}
 }
@@ -664,13 +722,16 @@ func (ss *SuffixSnapshot) batchRemoveExpiredKvs(ctx context.Context, keyGroup []
 
 // removeExpiredKvs removes expired key-value pairs from the snapshot
 // It walks through all keys with the snapshot prefix, groups them by original key,
-// and removes expired versions or all versions if the original key has been deleted
+// and removes expired versions or all versions if the original key has been deleted.
+// The versions visible at the pinned timestamps are always kept.
 func (ss *SuffixSnapshot) removeExpiredKvs(ctx context.Context, now time.Time) error {
 	log := log.Ctx(ctx)
 	ttlTime := paramtable.Get().ServiceParam.MetaStoreCfg.SnapshotTTLSeconds.GetAsDuration(time.Second)
 	reserveTime := paramtable.Get().ServiceParam.MetaStoreCfg.SnapshotReserveTimeSeconds.GetAsDuration(time.Second)
+	pinnedTs := ss.getPinnedTs()
 
 	candidateExpiredKeys := make([]string, 0)
+	versions := make([]string, 0)
 	latestOriginalKey := ""
 	latestOriginValue := ""
 	totalVersions := 0
@@ -680,7 +741,16 @@ func (ss *SuffixSnapshot) removeExpiredKvs(ctx context.Context, now time.Time) e
 		if curOriginalKey == "" {
 			return nil
 		}
-		if ss.isTombstone(latestOriginValue) {
+		pinnedVersions := typeutil.NewSet[string]()
+		if len(pinnedTs) > 0 {
+			sort.Slice(versions, func(i, j int) bool {
+				ti, _ := ss.isTSKey(versions[i])
+				tj, _ := ss.isTSKey(versions[j])
+				return ti < tj
+			})
+			pinnedVersions = ss.getPinnedVersions(versions, pinnedTs)
+		}
+		if ss.isTombstone(latestOriginValue) && pinnedVersions.Len() == 0 {
 			// If deleted, remove all versions including the original key
 			return ss.batchRemoveExpiredKvs(ctx, candidateExpiredKeys, curOriginalKey, totalVersions == len(candidateExpiredKeys))
 		}
@@ -688,6 +758,9 @@ func (ss *SuffixSnapshot) removeExpiredKvs(ctx context.Context, now time.Time) e
 		// If not deleted, check for expired versions
 		expiredKeys := make([]string, 0)
 		for _, key := range candidateExpiredKeys {
+			if pinnedVersions.Contain(key) {
+				continue
+			}
 			ts, _ := ss.isTSKey(key)
 			expireTime, _ := tsoutil.ParseTS(ts)
 			if expireTime.Add(ttlTime).Before(now) {
@@ -722,11 +795,13 @@ func (ss *SuffixSnapshot) removeExpiredKvs(ctx context.Context, now time.Time) e
 			}
 
 			candidateExpiredKeys = make([]string, 0)
+			versions = make([]string, 0)
 			totalVersions = 0
 		}
 
 		latestOriginalKey = curOriginalKey
 		latestOriginValue = string(v)
+		versions = append(versions, key)
 		totalVersions++
 
 		// Record versions that are already expired but not removed
@@ -639,6 +639,57 @@ func Test_SuffixSnapshotRemoveExpiredKvs(t *testing.T) {
 	})
 }
 
+func Test_SuffixSnapshotRemoveExpiredKvsWithPinnedTs(t *testing.T) {
+	sep := "_ts"
+	rootPath := "root/"
+	now := time.Now()
+	ftso := func(hours int) typeutil.Timestamp {
+		return tsoutil.ComposeTS(now.Add(-time.Duration(hours)*time.Hour).UnixMilli(), 0)
+	}
+
+	kv := mocks.NewMetaKv(t)
+	ss, err := NewSuffixSnapshot(kv, sep, rootPath, snapshotPrefix)
+	assert.NoError(t, err)
+	defer ss.Close()
+
+	// all versions are expired, the latest is tombstone
+	versions := []typeutil.Timestamp{ftso(100), ftso(90), ftso(80), ftso(70)}
+	kv.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, prefix string, paginationSize int, fn func([]byte, []byte) error) error {
+			for i, ts := range versions {
+				value := "value"
+				if i == len(versions)-1 {
+					value = string(SuffixSnapshotTombstone)
+				}
+				if err := fn([]byte(rootPath+ss.composeTSKey("key", ts)), []byte(value)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	var removed []string
+	kv.EXPECT().MultiRemove(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, keys []string) error {
+		removed = append(removed, keys...)
+		return nil
+	})
+
+	// pin the ts between the 2nd and 3rd version
+	unpin := ss.pinTs(ftso(85))
+	err = ss.removeExpiredKvs(context.TODO(), now)
+	assert.NoError(t, err)
+	// the version visible at pinned ts and the tombstone are kept
+	assert.ElementsMatch(t, []string{ss.composeTSKey("key", versions[0]), ss.composeTSKey("key", versions[2])}, removed)
+
+	unpin()
+	unpin()
+	assert.Empty(t, ss.getPinnedTs())
+	removed = nil
+	err = ss.removeExpiredKvs(context.TODO(), now)
+	assert.NoError(t, err)
+	// all versions and the original key are removed
+	assert.Len(t, removed, len(versions)+1)
+}
+
 func Test_SuffixSnapshotMultiSaveAndRemoveWithPrefix(t *testing.T) {
 	rand.Seed(time.Now().UnixNano())
 	randVal := rand.Int()
@@ -991,6 +991,53 @@ func (_c *DataCoordCatalog_DropStatsTask_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// DropStatslogRebuildJob provides a mock function with given fields: ctx, jobID
+func (_m *DataCoordCatalog) DropStatslogRebuildJob(ctx context.Context, jobID int64) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropStatslogRebuildJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropStatslogRebuildJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropStatslogRebuildJob'
+type DataCoordCatalog_DropStatslogRebuildJob_Call struct {
+	*mock.Call
+}
+
+// DropStatslogRebuildJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int64
+func (_e *DataCoordCatalog_Expecter) DropStatslogRebuildJob(ctx interface{}, jobID interface{}) *DataCoordCatalog_DropStatslogRebuildJob_Call {
+	return &DataCoordCatalog_DropStatslogRebuildJob_Call{Call: _e.mock.On("DropStatslogRebuildJob", ctx, jobID)}
+}
+
+func (_c *DataCoordCatalog_DropStatslogRebuildJob_Call) Run(run func(ctx context.Context, jobID int64)) *DataCoordCatalog_DropStatslogRebuildJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropStatslogRebuildJob_Call) Return(_a0 error) *DataCoordCatalog_DropStatslogRebuildJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropStatslogRebuildJob_Call) RunAndReturn(run func(context.Context, int64) error) *DataCoordCatalog_DropStatslogRebuildJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GcConfirm provides a mock function with given fields: ctx, collectionID, partitionID
 func (_m *DataCoordCatalog) GcConfirm(ctx context.Context, collectionID int64, partitionID int64) bool {
 	ret := _m.Called(ctx, collectionID, partitionID)
@@ -1795,6 +1842,64 @@ func (_c *DataCoordCatalog_ListStatsTasks_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ListStatslogRebuildJobs provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListStatslogRebuildJobs(ctx context.Context) ([]*datapb.StatslogRebuildJob, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStatslogRebuildJobs")
+	}
+
+	var r0 []*datapb.StatslogRebuildJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.StatslogRebuildJob, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.StatslogRebuildJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.StatslogRebuildJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListStatslogRebuildJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStatslogRebuildJobs'
+type DataCoordCatalog_ListStatslogRebuildJobs_Call struct {
+	*mock.Call
+}
+
+// ListStatslogRebuildJobs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListStatslogRebuildJobs(ctx interface{}) *DataCoordCatalog_ListStatslogRebuildJobs_Call {
+	return &DataCoordCatalog_ListStatslogRebuildJobs_Call{Call: _e.mock.On("ListStatslogRebuildJobs", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListStatslogRebuildJobs_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListStatslogRebuildJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListStatslogRebuildJobs_Call) Return(_a0 []*datapb.StatslogRebuildJob, _a1 error) *DataCoordCatalog_ListStatslogRebuildJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListStatslogRebuildJobs_Call) RunAndReturn(run func(context.Context) ([]*datapb.StatslogRebuildJob, error)) *DataCoordCatalog_ListStatslogRebuildJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MarkChannelAdded provides a mock function with given fields: ctx, channel
 func (_m *DataCoordCatalog) MarkChannelAdded(ctx context.Context, channel string) error {
 	ret := _m.Called(ctx, channel)
@@ -2504,6 +2609,53 @@ func (_c *DataCoordCatalog_SaveStatsTask_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// SaveStatslogRebuildJob provides a mock function with given fields: ctx, job
+func (_m *DataCoordCatalog) SaveStatslogRebuildJob(ctx context.Context, job *datapb.StatslogRebuildJob) error {
+	ret := _m.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveStatslogRebuildJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.StatslogRebuildJob) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveStatslogRebuildJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveStatslogRebuildJob'
+type DataCoordCatalog_SaveStatslogRebuildJob_Call struct {
+	*mock.Call
+}
+
+// SaveStatslogRebuildJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job *datapb.StatslogRebuildJob
+func (_e *DataCoordCatalog_Expecter) SaveStatslogRebuildJob(ctx interface{}, job interface{}) *DataCoordCatalog_SaveStatslogRebuildJob_Call {
+	return &DataCoordCatalog_SaveStatslogRebuildJob_Call{Call: _e.mock.On("SaveStatslogRebuildJob", ctx, job)}
+}
+
+func (_c *DataCoordCatalog_SaveStatslogRebuildJob_Call) Run(run func(ctx context.Context, job *datapb.StatslogRebuildJob)) *DataCoordCatalog_SaveStatslogRebuildJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.StatslogRebuildJob))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveStatslogRebuildJob_Call) Return(_a0 error) *DataCoordCatalog_SaveStatslogRebuildJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveStatslogRebuildJob_Call) RunAndReturn(run func(context.Context, *datapb.StatslogRebuildJob) error) *DataCoordCatalog_SaveStatslogRebuildJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShouldDropChannel provides a mock function with given fields: ctx, channel
 func (_m *DataCoordCatalog) ShouldDropChannel(ctx context.Context, channel string) bool {
 	ret := _m.Called(ctx, channel)
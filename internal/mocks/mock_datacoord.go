@@ -744,6 +744,65 @@ func (_c *MockDataCoord_FlushAll_Call) RunAndReturn(run func(context.Context, *d
 	return _c
 }
 
+// FlushAndSeal provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) FlushAndSeal(_a0 context.Context, _a1 *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlushAndSeal")
+	}
+
+	var r0 *datapb.FlushAndSealResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushAndSealRequest) *datapb.FlushAndSealResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.FlushAndSealResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushAndSealRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_FlushAndSeal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlushAndSeal'
+type MockDataCoord_FlushAndSeal_Call struct {
+	*mock.Call
+}
+
+// FlushAndSeal is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.FlushAndSealRequest
+func (_e *MockDataCoord_Expecter) FlushAndSeal(_a0 interface{}, _a1 interface{}) *MockDataCoord_FlushAndSeal_Call {
+	return &MockDataCoord_FlushAndSeal_Call{Call: _e.mock.On("FlushAndSeal", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_FlushAndSeal_Call) Run(run func(_a0 context.Context, _a1 *datapb.FlushAndSealRequest)) *MockDataCoord_FlushAndSeal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.FlushAndSealRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_FlushAndSeal_Call) Return(_a0 *datapb.FlushAndSealResponse, _a1 error) *MockDataCoord_FlushAndSeal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_FlushAndSeal_Call) RunAndReturn(run func(context.Context, *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error)) *MockDataCoord_FlushAndSeal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GcConfirm provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) GcConfirm(_a0 context.Context, _a1 *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2160,6 +2219,65 @@ func (_c *MockDataCoord_GetSegmentsByStates_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetStatslogRebuildJob provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetStatslogRebuildJob(_a0 context.Context, _a1 *datapb.GetStatslogRebuildJobRequest) (*datapb.GetStatslogRebuildJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatslogRebuildJob")
+	}
+
+	var r0 *datapb.GetStatslogRebuildJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetStatslogRebuildJobRequest) (*datapb.GetStatslogRebuildJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetStatslogRebuildJobRequest) *datapb.GetStatslogRebuildJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetStatslogRebuildJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetStatslogRebuildJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetStatslogRebuildJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatslogRebuildJob'
+type MockDataCoord_GetStatslogRebuildJob_Call struct {
+	*mock.Call
+}
+
+// GetStatslogRebuildJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetStatslogRebuildJobRequest
+func (_e *MockDataCoord_Expecter) GetStatslogRebuildJob(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetStatslogRebuildJob_Call {
+	return &MockDataCoord_GetStatslogRebuildJob_Call{Call: _e.mock.On("GetStatslogRebuildJob", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetStatslogRebuildJob_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetStatslogRebuildJobRequest)) *MockDataCoord_GetStatslogRebuildJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetStatslogRebuildJobRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetStatslogRebuildJob_Call) Return(_a0 *datapb.GetStatslogRebuildJobResponse, _a1 error) *MockDataCoord_GetStatslogRebuildJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetStatslogRebuildJob_Call) RunAndReturn(run func(context.Context, *datapb.GetStatslogRebuildJobRequest) (*datapb.GetStatslogRebuildJobResponse, error)) *MockDataCoord_GetStatslogRebuildJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ImportV2 provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) ImportV2(_a0 context.Context, _a1 *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2264,6 +2382,65 @@ func (_c *MockDataCoord_Init_Call) RunAndReturn(run func() error) *MockDataCoord
 	return _c
 }
 
+// ListBackgroundJobs provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListBackgroundJobs(_a0 context.Context, _a1 *datapb.ListBackgroundJobsRequest) (*datapb.ListBackgroundJobsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBackgroundJobs")
+	}
+
+	var r0 *datapb.ListBackgroundJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListBackgroundJobsRequest) (*datapb.ListBackgroundJobsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListBackgroundJobsRequest) *datapb.ListBackgroundJobsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListBackgroundJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListBackgroundJobsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ListBackgroundJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBackgroundJobs'
+type MockDataCoord_ListBackgroundJobs_Call struct {
+	*mock.Call
+}
+
+// ListBackgroundJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ListBackgroundJobsRequest
+func (_e *MockDataCoord_Expecter) ListBackgroundJobs(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListBackgroundJobs_Call {
+	return &MockDataCoord_ListBackgroundJobs_Call{Call: _e.mock.On("ListBackgroundJobs", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ListBackgroundJobs_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListBackgroundJobsRequest)) *MockDataCoord_ListBackgroundJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ListBackgroundJobsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ListBackgroundJobs_Call) Return(_a0 *datapb.ListBackgroundJobsResponse, _a1 error) *MockDataCoord_ListBackgroundJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ListBackgroundJobs_Call) RunAndReturn(run func(context.Context, *datapb.ListBackgroundJobsRequest) (*datapb.ListBackgroundJobsResponse, error)) *MockDataCoord_ListBackgroundJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListFileResources provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) ListFileResources(_a0 context.Context, _a1 *milvuspb.ListFileResourcesRequest) (*milvuspb.ListFileResourcesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2441,6 +2618,65 @@ func (_c *MockDataCoord_ListIndexes_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListQuarantinedSegments provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListQuarantinedSegments(_a0 context.Context, _a1 *datapb.ListQuarantinedSegmentsRequest) (*datapb.ListQuarantinedSegmentsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQuarantinedSegments")
+	}
+
+	var r0 *datapb.ListQuarantinedSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListQuarantinedSegmentsRequest) (*datapb.ListQuarantinedSegmentsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListQuarantinedSegmentsRequest) *datapb.ListQuarantinedSegmentsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListQuarantinedSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListQuarantinedSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ListQuarantinedSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQuarantinedSegments'
+type MockDataCoord_ListQuarantinedSegments_Call struct {
+	*mock.Call
+}
+
+// ListQuarantinedSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ListQuarantinedSegmentsRequest
+func (_e *MockDataCoord_Expecter) ListQuarantinedSegments(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListQuarantinedSegments_Call {
+	return &MockDataCoord_ListQuarantinedSegments_Call{Call: _e.mock.On("ListQuarantinedSegments", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ListQuarantinedSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListQuarantinedSegmentsRequest)) *MockDataCoord_ListQuarantinedSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ListQuarantinedSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ListQuarantinedSegments_Call) Return(_a0 *datapb.ListQuarantinedSegmentsResponse, _a1 error) *MockDataCoord_ListQuarantinedSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ListQuarantinedSegments_Call) RunAndReturn(run func(context.Context, *datapb.ListQuarantinedSegmentsRequest) (*datapb.ListQuarantinedSegmentsResponse, error)) *MockDataCoord_ListQuarantinedSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ManualCompaction provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) ManualCompaction(_a0 context.Context, _a1 *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2559,6 +2795,124 @@ func (_c *MockDataCoord_MarkSegmentsDropped_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// OperateQuarantinedSegment provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) OperateQuarantinedSegment(_a0 context.Context, _a1 *datapb.OperateQuarantinedSegmentRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OperateQuarantinedSegment")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateQuarantinedSegmentRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateQuarantinedSegmentRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.OperateQuarantinedSegmentRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_OperateQuarantinedSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateQuarantinedSegment'
+type MockDataCoord_OperateQuarantinedSegment_Call struct {
+	*mock.Call
+}
+
+// OperateQuarantinedSegment is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.OperateQuarantinedSegmentRequest
+func (_e *MockDataCoord_Expecter) OperateQuarantinedSegment(_a0 interface{}, _a1 interface{}) *MockDataCoord_OperateQuarantinedSegment_Call {
+	return &MockDataCoord_OperateQuarantinedSegment_Call{Call: _e.mock.On("OperateQuarantinedSegment", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_OperateQuarantinedSegment_Call) Run(run func(_a0 context.Context, _a1 *datapb.OperateQuarantinedSegmentRequest)) *MockDataCoord_OperateQuarantinedSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.OperateQuarantinedSegmentRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_OperateQuarantinedSegment_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_OperateQuarantinedSegment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_OperateQuarantinedSegment_Call) RunAndReturn(run func(context.Context, *datapb.OperateQuarantinedSegmentRequest) (*commonpb.Status, error)) *MockDataCoord_OperateQuarantinedSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebuildStatslogs provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) RebuildStatslogs(_a0 context.Context, _a1 *datapb.RebuildStatslogsRequest) (*datapb.RebuildStatslogsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebuildStatslogs")
+	}
+
+	var r0 *datapb.RebuildStatslogsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RebuildStatslogsRequest) (*datapb.RebuildStatslogsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RebuildStatslogsRequest) *datapb.RebuildStatslogsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.RebuildStatslogsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RebuildStatslogsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_RebuildStatslogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebuildStatslogs'
+type MockDataCoord_RebuildStatslogs_Call struct {
+	*mock.Call
+}
+
+// RebuildStatslogs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.RebuildStatslogsRequest
+func (_e *MockDataCoord_Expecter) RebuildStatslogs(_a0 interface{}, _a1 interface{}) *MockDataCoord_RebuildStatslogs_Call {
+	return &MockDataCoord_RebuildStatslogs_Call{Call: _e.mock.On("RebuildStatslogs", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_RebuildStatslogs_Call) Run(run func(_a0 context.Context, _a1 *datapb.RebuildStatslogsRequest)) *MockDataCoord_RebuildStatslogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.RebuildStatslogsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_RebuildStatslogs_Call) Return(_a0 *datapb.RebuildStatslogsResponse, _a1 error) *MockDataCoord_RebuildStatslogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_RebuildStatslogs_Call) RunAndReturn(run func(context.Context, *datapb.RebuildStatslogsRequest) (*datapb.RebuildStatslogsResponse, error)) *MockDataCoord_RebuildStatslogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with no fields
 func (_m *MockDataCoord) Register() error {
 	ret := _m.Called()
@@ -2663,6 +3017,65 @@ func (_c *MockDataCoord_RemoveFileResource_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ReportChannelHealth provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ReportChannelHealth(_a0 context.Context, _a1 *datapb.ReportChannelHealthRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportChannelHealth")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportChannelHealthRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportChannelHealthRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportChannelHealthRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ReportChannelHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportChannelHealth'
+type MockDataCoord_ReportChannelHealth_Call struct {
+	*mock.Call
+}
+
+// ReportChannelHealth is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ReportChannelHealthRequest
+func (_e *MockDataCoord_Expecter) ReportChannelHealth(_a0 interface{}, _a1 interface{}) *MockDataCoord_ReportChannelHealth_Call {
+	return &MockDataCoord_ReportChannelHealth_Call{Call: _e.mock.On("ReportChannelHealth", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ReportChannelHealth_Call) Run(run func(_a0 context.Context, _a1 *datapb.ReportChannelHealthRequest)) *MockDataCoord_ReportChannelHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ReportChannelHealthRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ReportChannelHealth_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ReportChannelHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ReportChannelHealth_Call) RunAndReturn(run func(context.Context, *datapb.ReportChannelHealthRequest) (*commonpb.Status, error)) *MockDataCoord_ReportChannelHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReportDataNodeTtMsgs provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) ReportDataNodeTtMsgs(_a0 context.Context, _a1 *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3272,6 +3685,65 @@ func (_c *MockDataCoord_UpdateSegmentStatistics_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// UpdateSegmentStatslogs provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) UpdateSegmentStatslogs(_a0 context.Context, _a1 *datapb.UpdateSegmentStatslogsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSegmentStatslogs")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateSegmentStatslogsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateSegmentStatslogsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.UpdateSegmentStatslogsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_UpdateSegmentStatslogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSegmentStatslogs'
+type MockDataCoord_UpdateSegmentStatslogs_Call struct {
+	*mock.Call
+}
+
+// UpdateSegmentStatslogs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.UpdateSegmentStatslogsRequest
+func (_e *MockDataCoord_Expecter) UpdateSegmentStatslogs(_a0 interface{}, _a1 interface{}) *MockDataCoord_UpdateSegmentStatslogs_Call {
+	return &MockDataCoord_UpdateSegmentStatslogs_Call{Call: _e.mock.On("UpdateSegmentStatslogs", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_UpdateSegmentStatslogs_Call) Run(run func(_a0 context.Context, _a1 *datapb.UpdateSegmentStatslogsRequest)) *MockDataCoord_UpdateSegmentStatslogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.UpdateSegmentStatslogsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_UpdateSegmentStatslogs_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_UpdateSegmentStatslogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_UpdateSegmentStatslogs_Call) RunAndReturn(run func(context.Context, *datapb.UpdateSegmentStatslogsRequest) (*commonpb.Status, error)) *MockDataCoord_UpdateSegmentStatslogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WatchChannels provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) WatchChannels(_a0 context.Context, _a1 *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	ret := _m.Called(_a0, _a1)
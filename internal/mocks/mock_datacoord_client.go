@@ -966,6 +966,80 @@ func (_c *MockDataCoordClient_FlushAll_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// FlushAndSeal provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) FlushAndSeal(ctx context.Context, in *datapb.FlushAndSealRequest, opts ...grpc.CallOption) (*datapb.FlushAndSealResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlushAndSeal")
+	}
+
+	var r0 *datapb.FlushAndSealResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushAndSealRequest, ...grpc.CallOption) (*datapb.FlushAndSealResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushAndSealRequest, ...grpc.CallOption) *datapb.FlushAndSealResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.FlushAndSealResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushAndSealRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_FlushAndSeal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlushAndSeal'
+type MockDataCoordClient_FlushAndSeal_Call struct {
+	*mock.Call
+}
+
+// FlushAndSeal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.FlushAndSealRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) FlushAndSeal(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_FlushAndSeal_Call {
+	return &MockDataCoordClient_FlushAndSeal_Call{Call: _e.mock.On("FlushAndSeal",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_FlushAndSeal_Call) Run(run func(ctx context.Context, in *datapb.FlushAndSealRequest, opts ...grpc.CallOption)) *MockDataCoordClient_FlushAndSeal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.FlushAndSealRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_FlushAndSeal_Call) Return(_a0 *datapb.FlushAndSealResponse, _a1 error) *MockDataCoordClient_FlushAndSeal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_FlushAndSeal_Call) RunAndReturn(run func(context.Context, *datapb.FlushAndSealRequest, ...grpc.CallOption) (*datapb.FlushAndSealResponse, error)) *MockDataCoordClient_FlushAndSeal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GcConfirm provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) GcConfirm(ctx context.Context, in *datapb.GcConfirmRequest, opts ...grpc.CallOption) (*datapb.GcConfirmResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -2742,6 +2816,80 @@ func (_c *MockDataCoordClient_GetSegmentsByStates_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// GetStatslogRebuildJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetStatslogRebuildJob(ctx context.Context, in *datapb.GetStatslogRebuildJobRequest, opts ...grpc.CallOption) (*datapb.GetStatslogRebuildJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatslogRebuildJob")
+	}
+
+	var r0 *datapb.GetStatslogRebuildJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetStatslogRebuildJobRequest, ...grpc.CallOption) (*datapb.GetStatslogRebuildJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetStatslogRebuildJobRequest, ...grpc.CallOption) *datapb.GetStatslogRebuildJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetStatslogRebuildJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetStatslogRebuildJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetStatslogRebuildJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatslogRebuildJob'
+type MockDataCoordClient_GetStatslogRebuildJob_Call struct {
+	*mock.Call
+}
+
+// GetStatslogRebuildJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetStatslogRebuildJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetStatslogRebuildJob(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetStatslogRebuildJob_Call {
+	return &MockDataCoordClient_GetStatslogRebuildJob_Call{Call: _e.mock.On("GetStatslogRebuildJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetStatslogRebuildJob_Call) Run(run func(ctx context.Context, in *datapb.GetStatslogRebuildJobRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetStatslogRebuildJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetStatslogRebuildJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetStatslogRebuildJob_Call) Return(_a0 *datapb.GetStatslogRebuildJobResponse, _a1 error) *MockDataCoordClient_GetStatslogRebuildJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetStatslogRebuildJob_Call) RunAndReturn(run func(context.Context, *datapb.GetStatslogRebuildJobRequest, ...grpc.CallOption) (*datapb.GetStatslogRebuildJobResponse, error)) *MockDataCoordClient_GetStatslogRebuildJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ImportV2 provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -2816,6 +2964,80 @@ func (_c *MockDataCoordClient_ImportV2_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListBackgroundJobs provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ListBackgroundJobs(ctx context.Context, in *datapb.ListBackgroundJobsRequest, opts ...grpc.CallOption) (*datapb.ListBackgroundJobsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBackgroundJobs")
+	}
+
+	var r0 *datapb.ListBackgroundJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListBackgroundJobsRequest, ...grpc.CallOption) (*datapb.ListBackgroundJobsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListBackgroundJobsRequest, ...grpc.CallOption) *datapb.ListBackgroundJobsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListBackgroundJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListBackgroundJobsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_ListBackgroundJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBackgroundJobs'
+type MockDataCoordClient_ListBackgroundJobs_Call struct {
+	*mock.Call
+}
+
+// ListBackgroundJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ListBackgroundJobsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) ListBackgroundJobs(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ListBackgroundJobs_Call {
+	return &MockDataCoordClient_ListBackgroundJobs_Call{Call: _e.mock.On("ListBackgroundJobs",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_ListBackgroundJobs_Call) Run(run func(ctx context.Context, in *datapb.ListBackgroundJobsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ListBackgroundJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ListBackgroundJobsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListBackgroundJobs_Call) Return(_a0 *datapb.ListBackgroundJobsResponse, _a1 error) *MockDataCoordClient_ListBackgroundJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListBackgroundJobs_Call) RunAndReturn(run func(context.Context, *datapb.ListBackgroundJobsRequest, ...grpc.CallOption) (*datapb.ListBackgroundJobsResponse, error)) *MockDataCoordClient_ListBackgroundJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListFileResources provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) ListFileResources(ctx context.Context, in *milvuspb.ListFileResourcesRequest, opts ...grpc.CallOption) (*milvuspb.ListFileResourcesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -3038,6 +3260,80 @@ func (_c *MockDataCoordClient_ListIndexes_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ListQuarantinedSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ListQuarantinedSegments(ctx context.Context, in *datapb.ListQuarantinedSegmentsRequest, opts ...grpc.CallOption) (*datapb.ListQuarantinedSegmentsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQuarantinedSegments")
+	}
+
+	var r0 *datapb.ListQuarantinedSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListQuarantinedSegmentsRequest, ...grpc.CallOption) (*datapb.ListQuarantinedSegmentsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListQuarantinedSegmentsRequest, ...grpc.CallOption) *datapb.ListQuarantinedSegmentsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListQuarantinedSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListQuarantinedSegmentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_ListQuarantinedSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQuarantinedSegments'
+type MockDataCoordClient_ListQuarantinedSegments_Call struct {
+	*mock.Call
+}
+
+// ListQuarantinedSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ListQuarantinedSegmentsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) ListQuarantinedSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ListQuarantinedSegments_Call {
+	return &MockDataCoordClient_ListQuarantinedSegments_Call{Call: _e.mock.On("ListQuarantinedSegments",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_ListQuarantinedSegments_Call) Run(run func(ctx context.Context, in *datapb.ListQuarantinedSegmentsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ListQuarantinedSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ListQuarantinedSegmentsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListQuarantinedSegments_Call) Return(_a0 *datapb.ListQuarantinedSegmentsResponse, _a1 error) *MockDataCoordClient_ListQuarantinedSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListQuarantinedSegments_Call) RunAndReturn(run func(context.Context, *datapb.ListQuarantinedSegmentsRequest, ...grpc.CallOption) (*datapb.ListQuarantinedSegmentsResponse, error)) *MockDataCoordClient_ListQuarantinedSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ManualCompaction provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -3186,6 +3482,154 @@ func (_c *MockDataCoordClient_MarkSegmentsDropped_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// OperateQuarantinedSegment provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) OperateQuarantinedSegment(ctx context.Context, in *datapb.OperateQuarantinedSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OperateQuarantinedSegment")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateQuarantinedSegmentRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateQuarantinedSegmentRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.OperateQuarantinedSegmentRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_OperateQuarantinedSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateQuarantinedSegment'
+type MockDataCoordClient_OperateQuarantinedSegment_Call struct {
+	*mock.Call
+}
+
+// OperateQuarantinedSegment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.OperateQuarantinedSegmentRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) OperateQuarantinedSegment(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_OperateQuarantinedSegment_Call {
+	return &MockDataCoordClient_OperateQuarantinedSegment_Call{Call: _e.mock.On("OperateQuarantinedSegment",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_OperateQuarantinedSegment_Call) Run(run func(ctx context.Context, in *datapb.OperateQuarantinedSegmentRequest, opts ...grpc.CallOption)) *MockDataCoordClient_OperateQuarantinedSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.OperateQuarantinedSegmentRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_OperateQuarantinedSegment_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_OperateQuarantinedSegment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_OperateQuarantinedSegment_Call) RunAndReturn(run func(context.Context, *datapb.OperateQuarantinedSegmentRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_OperateQuarantinedSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebuildStatslogs provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) RebuildStatslogs(ctx context.Context, in *datapb.RebuildStatslogsRequest, opts ...grpc.CallOption) (*datapb.RebuildStatslogsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebuildStatslogs")
+	}
+
+	var r0 *datapb.RebuildStatslogsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RebuildStatslogsRequest, ...grpc.CallOption) (*datapb.RebuildStatslogsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RebuildStatslogsRequest, ...grpc.CallOption) *datapb.RebuildStatslogsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.RebuildStatslogsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RebuildStatslogsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_RebuildStatslogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebuildStatslogs'
+type MockDataCoordClient_RebuildStatslogs_Call struct {
+	*mock.Call
+}
+
+// RebuildStatslogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.RebuildStatslogsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) RebuildStatslogs(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_RebuildStatslogs_Call {
+	return &MockDataCoordClient_RebuildStatslogs_Call{Call: _e.mock.On("RebuildStatslogs",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_RebuildStatslogs_Call) Run(run func(ctx context.Context, in *datapb.RebuildStatslogsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_RebuildStatslogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.RebuildStatslogsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_RebuildStatslogs_Call) Return(_a0 *datapb.RebuildStatslogsResponse, _a1 error) *MockDataCoordClient_RebuildStatslogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_RebuildStatslogs_Call) RunAndReturn(run func(context.Context, *datapb.RebuildStatslogsRequest, ...grpc.CallOption) (*datapb.RebuildStatslogsResponse, error)) *MockDataCoordClient_RebuildStatslogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveFileResource provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) RemoveFileResource(ctx context.Context, in *milvuspb.RemoveFileResourceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -3260,6 +3704,80 @@ func (_c *MockDataCoordClient_RemoveFileResource_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ReportChannelHealth provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ReportChannelHealth(ctx context.Context, in *datapb.ReportChannelHealthRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportChannelHealth")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportChannelHealthRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportChannelHealthRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportChannelHealthRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_ReportChannelHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportChannelHealth'
+type MockDataCoordClient_ReportChannelHealth_Call struct {
+	*mock.Call
+}
+
+// ReportChannelHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ReportChannelHealthRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) ReportChannelHealth(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ReportChannelHealth_Call {
+	return &MockDataCoordClient_ReportChannelHealth_Call{Call: _e.mock.On("ReportChannelHealth",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_ReportChannelHealth_Call) Run(run func(ctx context.Context, in *datapb.ReportChannelHealthRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ReportChannelHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ReportChannelHealthRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_ReportChannelHealth_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_ReportChannelHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_ReportChannelHealth_Call) RunAndReturn(run func(context.Context, *datapb.ReportChannelHealthRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_ReportChannelHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReportDataNodeTtMsgs provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *datapb.ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -3704,6 +4222,80 @@ func (_c *MockDataCoordClient_UpdateSegmentStatistics_Call) RunAndReturn(run fun
 	return _c
 }
 
+// UpdateSegmentStatslogs provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) UpdateSegmentStatslogs(ctx context.Context, in *datapb.UpdateSegmentStatslogsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSegmentStatslogs")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateSegmentStatslogsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateSegmentStatslogsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.UpdateSegmentStatslogsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_UpdateSegmentStatslogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSegmentStatslogs'
+type MockDataCoordClient_UpdateSegmentStatslogs_Call struct {
+	*mock.Call
+}
+
+// UpdateSegmentStatslogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.UpdateSegmentStatslogsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) UpdateSegmentStatslogs(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_UpdateSegmentStatslogs_Call {
+	return &MockDataCoordClient_UpdateSegmentStatslogs_Call{Call: _e.mock.On("UpdateSegmentStatslogs",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_UpdateSegmentStatslogs_Call) Run(run func(ctx context.Context, in *datapb.UpdateSegmentStatslogsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_UpdateSegmentStatslogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.UpdateSegmentStatslogsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_UpdateSegmentStatslogs_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_UpdateSegmentStatslogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_UpdateSegmentStatslogs_Call) RunAndReturn(run func(context.Context, *datapb.UpdateSegmentStatslogsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_UpdateSegmentStatslogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WatchChannels provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) WatchChannels(ctx context.Context, in *datapb.WatchChannelsRequest, opts ...grpc.CallOption) (*datapb.WatchChannelsResponse, error) {
 	_va := make([]interface{}, len(opts))
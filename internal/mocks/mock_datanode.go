@@ -622,6 +622,65 @@ func (_c *MockDataNode_DropTask_Call) RunAndReturn(run func(context.Context, *wo
 	return _c
 }
 
+// EstimateJob provides a mock function with given fields: _a0, _a1
+func (_m *MockDataNode) EstimateJob(_a0 context.Context, _a1 *workerpb.EstimateJobRequest) (*workerpb.EstimateJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EstimateJob")
+	}
+
+	var r0 *workerpb.EstimateJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *workerpb.EstimateJobRequest) (*workerpb.EstimateJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *workerpb.EstimateJobRequest) *workerpb.EstimateJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workerpb.EstimateJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *workerpb.EstimateJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNode_EstimateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EstimateJob'
+type MockDataNode_EstimateJob_Call struct {
+	*mock.Call
+}
+
+// EstimateJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *workerpb.EstimateJobRequest
+func (_e *MockDataNode_Expecter) EstimateJob(_a0 interface{}, _a1 interface{}) *MockDataNode_EstimateJob_Call {
+	return &MockDataNode_EstimateJob_Call{Call: _e.mock.On("EstimateJob", _a0, _a1)}
+}
+
+func (_c *MockDataNode_EstimateJob_Call) Run(run func(_a0 context.Context, _a1 *workerpb.EstimateJobRequest)) *MockDataNode_EstimateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*workerpb.EstimateJobRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataNode_EstimateJob_Call) Return(_a0 *workerpb.EstimateJobResponse, _a1 error) *MockDataNode_EstimateJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNode_EstimateJob_Call) RunAndReturn(run func(context.Context, *workerpb.EstimateJobRequest) (*workerpb.EstimateJobResponse, error)) *MockDataNode_EstimateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FlushChannels provides a mock function with given fields: _a0, _a1
 func (_m *MockDataNode) FlushChannels(_a0 context.Context, _a1 *datapb.FlushChannelsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
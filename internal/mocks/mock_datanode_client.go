@@ -818,6 +818,80 @@ func (_c *MockDataNodeClient_DropTask_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// EstimateJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataNodeClient) EstimateJob(ctx context.Context, in *workerpb.EstimateJobRequest, opts ...grpc.CallOption) (*workerpb.EstimateJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EstimateJob")
+	}
+
+	var r0 *workerpb.EstimateJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *workerpb.EstimateJobRequest, ...grpc.CallOption) (*workerpb.EstimateJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *workerpb.EstimateJobRequest, ...grpc.CallOption) *workerpb.EstimateJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workerpb.EstimateJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *workerpb.EstimateJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNodeClient_EstimateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EstimateJob'
+type MockDataNodeClient_EstimateJob_Call struct {
+	*mock.Call
+}
+
+// EstimateJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *workerpb.EstimateJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataNodeClient_Expecter) EstimateJob(ctx interface{}, in interface{}, opts ...interface{}) *MockDataNodeClient_EstimateJob_Call {
+	return &MockDataNodeClient_EstimateJob_Call{Call: _e.mock.On("EstimateJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataNodeClient_EstimateJob_Call) Run(run func(ctx context.Context, in *workerpb.EstimateJobRequest, opts ...grpc.CallOption)) *MockDataNodeClient_EstimateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*workerpb.EstimateJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataNodeClient_EstimateJob_Call) Return(_a0 *workerpb.EstimateJobResponse, _a1 error) *MockDataNodeClient_EstimateJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNodeClient_EstimateJob_Call) RunAndReturn(run func(context.Context, *workerpb.EstimateJobRequest, ...grpc.CallOption) (*workerpb.EstimateJobResponse, error)) *MockDataNodeClient_EstimateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FlushChannels provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataNodeClient) FlushChannels(ctx context.Context, in *datapb.FlushChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
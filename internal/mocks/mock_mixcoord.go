@@ -1283,6 +1283,65 @@ func (_c *MixCoord_CreateCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// CreateCollectionTemplate provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) CreateCollectionTemplate(_a0 context.Context, _a1 *rootcoordpb.CreateCollectionTemplateRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCollectionTemplate")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateCollectionTemplateRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateCollectionTemplateRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CreateCollectionTemplateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_CreateCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollectionTemplate'
+type MixCoord_CreateCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateCollectionTemplate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.CreateCollectionTemplateRequest
+func (_e *MixCoord_Expecter) CreateCollectionTemplate(_a0 interface{}, _a1 interface{}) *MixCoord_CreateCollectionTemplate_Call {
+	return &MixCoord_CreateCollectionTemplate_Call{Call: _e.mock.On("CreateCollectionTemplate", _a0, _a1)}
+}
+
+func (_c *MixCoord_CreateCollectionTemplate_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.CreateCollectionTemplateRequest)) *MixCoord_CreateCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CreateCollectionTemplateRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_CreateCollectionTemplate_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_CreateCollectionTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_CreateCollectionTemplate_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CreateCollectionTemplateRequest) (*commonpb.Status, error)) *MixCoord_CreateCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCredential provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) CreateCredential(_a0 context.Context, _a1 *internalpb.CredentialInfo) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2345,6 +2404,65 @@ func (_c *MixCoord_DropCollectionFunction_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// DropCollectionTemplate provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) DropCollectionTemplate(_a0 context.Context, _a1 *rootcoordpb.DropCollectionTemplateRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropCollectionTemplate")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropCollectionTemplateRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropCollectionTemplateRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.DropCollectionTemplateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_DropCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionTemplate'
+type MixCoord_DropCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// DropCollectionTemplate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.DropCollectionTemplateRequest
+func (_e *MixCoord_Expecter) DropCollectionTemplate(_a0 interface{}, _a1 interface{}) *MixCoord_DropCollectionTemplate_Call {
+	return &MixCoord_DropCollectionTemplate_Call{Call: _e.mock.On("DropCollectionTemplate", _a0, _a1)}
+}
+
+func (_c *MixCoord_DropCollectionTemplate_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.DropCollectionTemplateRequest)) *MixCoord_DropCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.DropCollectionTemplateRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_DropCollectionTemplate_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_DropCollectionTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_DropCollectionTemplate_Call) RunAndReturn(run func(context.Context, *rootcoordpb.DropCollectionTemplateRequest) (*commonpb.Status, error)) *MixCoord_DropCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) DropDatabase(_a0 context.Context, _a1 *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2876,6 +2994,65 @@ func (_c *MixCoord_FlushAll_Call) RunAndReturn(run func(context.Context, *datapb
 	return _c
 }
 
+// FlushAndSeal provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) FlushAndSeal(_a0 context.Context, _a1 *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlushAndSeal")
+	}
+
+	var r0 *datapb.FlushAndSealResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushAndSealRequest) *datapb.FlushAndSealResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.FlushAndSealResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushAndSealRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_FlushAndSeal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlushAndSeal'
+type MixCoord_FlushAndSeal_Call struct {
+	*mock.Call
+}
+
+// FlushAndSeal is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.FlushAndSealRequest
+func (_e *MixCoord_Expecter) FlushAndSeal(_a0 interface{}, _a1 interface{}) *MixCoord_FlushAndSeal_Call {
+	return &MixCoord_FlushAndSeal_Call{Call: _e.mock.On("FlushAndSeal", _a0, _a1)}
+}
+
+func (_c *MixCoord_FlushAndSeal_Call) Run(run func(_a0 context.Context, _a1 *datapb.FlushAndSealRequest)) *MixCoord_FlushAndSeal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.FlushAndSealRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_FlushAndSeal_Call) Return(_a0 *datapb.FlushAndSealResponse, _a1 error) *MixCoord_FlushAndSeal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_FlushAndSeal_Call) RunAndReturn(run func(context.Context, *datapb.FlushAndSealRequest) (*datapb.FlushAndSealResponse, error)) *MixCoord_FlushAndSeal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GcConfirm provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) GcConfirm(_a0 context.Context, _a1 *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5000,6 +5177,65 @@ func (_c *MixCoord_GetStatisticsChannel_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetStatslogRebuildJob provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) GetStatslogRebuildJob(_a0 context.Context, _a1 *datapb.GetStatslogRebuildJobRequest) (*datapb.GetStatslogRebuildJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatslogRebuildJob")
+	}
+
+	var r0 *datapb.GetStatslogRebuildJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetStatslogRebuildJobRequest) (*datapb.GetStatslogRebuildJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetStatslogRebuildJobRequest) *datapb.GetStatslogRebuildJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetStatslogRebuildJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetStatslogRebuildJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_GetStatslogRebuildJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatslogRebuildJob'
+type MixCoord_GetStatslogRebuildJob_Call struct {
+	*mock.Call
+}
+
+// GetStatslogRebuildJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetStatslogRebuildJobRequest
+func (_e *MixCoord_Expecter) GetStatslogRebuildJob(_a0 interface{}, _a1 interface{}) *MixCoord_GetStatslogRebuildJob_Call {
+	return &MixCoord_GetStatslogRebuildJob_Call{Call: _e.mock.On("GetStatslogRebuildJob", _a0, _a1)}
+}
+
+func (_c *MixCoord_GetStatslogRebuildJob_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetStatslogRebuildJobRequest)) *MixCoord_GetStatslogRebuildJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetStatslogRebuildJobRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_GetStatslogRebuildJob_Call) Return(_a0 *datapb.GetStatslogRebuildJobResponse, _a1 error) *MixCoord_GetStatslogRebuildJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_GetStatslogRebuildJob_Call) RunAndReturn(run func(context.Context, *datapb.GetStatslogRebuildJobRequest) (*datapb.GetStatslogRebuildJobResponse, error)) *MixCoord_GetStatslogRebuildJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTimeTickChannel provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) GetTimeTickChannel(_a0 context.Context, _a1 *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5059,6 +5295,65 @@ func (_c *MixCoord_GetTimeTickChannel_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetUsage provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) GetUsage(_a0 context.Context, _a1 *rootcoordpb.GetUsageRequest) (*rootcoordpb.GetUsageResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsage")
+	}
+
+	var r0 *rootcoordpb.GetUsageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetUsageRequest) (*rootcoordpb.GetUsageResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetUsageRequest) *rootcoordpb.GetUsageResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetUsageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetUsageRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_GetUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsage'
+type MixCoord_GetUsage_Call struct {
+	*mock.Call
+}
+
+// GetUsage is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.GetUsageRequest
+func (_e *MixCoord_Expecter) GetUsage(_a0 interface{}, _a1 interface{}) *MixCoord_GetUsage_Call {
+	return &MixCoord_GetUsage_Call{Call: _e.mock.On("GetUsage", _a0, _a1)}
+}
+
+func (_c *MixCoord_GetUsage_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.GetUsageRequest)) *MixCoord_GetUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetUsageRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_GetUsage_Call) Return(_a0 *rootcoordpb.GetUsageResponse, _a1 error) *MixCoord_GetUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_GetUsage_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetUsageRequest) (*rootcoordpb.GetUsageResponse, error)) *MixCoord_GetUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GracefulStop provides a mock function with no fields
 func (_m *MixCoord) GracefulStop() {
 	_m.Called()
@@ -5431,6 +5726,65 @@ func (_c *MixCoord_ListAliases_Call) RunAndReturn(run func(context.Context, *mil
 	return _c
 }
 
+// ListBackgroundJobs provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) ListBackgroundJobs(_a0 context.Context, _a1 *datapb.ListBackgroundJobsRequest) (*datapb.ListBackgroundJobsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBackgroundJobs")
+	}
+
+	var r0 *datapb.ListBackgroundJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListBackgroundJobsRequest) (*datapb.ListBackgroundJobsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListBackgroundJobsRequest) *datapb.ListBackgroundJobsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListBackgroundJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListBackgroundJobsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_ListBackgroundJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBackgroundJobs'
+type MixCoord_ListBackgroundJobs_Call struct {
+	*mock.Call
+}
+
+// ListBackgroundJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ListBackgroundJobsRequest
+func (_e *MixCoord_Expecter) ListBackgroundJobs(_a0 interface{}, _a1 interface{}) *MixCoord_ListBackgroundJobs_Call {
+	return &MixCoord_ListBackgroundJobs_Call{Call: _e.mock.On("ListBackgroundJobs", _a0, _a1)}
+}
+
+func (_c *MixCoord_ListBackgroundJobs_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListBackgroundJobsRequest)) *MixCoord_ListBackgroundJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ListBackgroundJobsRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_ListBackgroundJobs_Call) Return(_a0 *datapb.ListBackgroundJobsResponse, _a1 error) *MixCoord_ListBackgroundJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_ListBackgroundJobs_Call) RunAndReturn(run func(context.Context, *datapb.ListBackgroundJobsRequest) (*datapb.ListBackgroundJobsResponse, error)) *MixCoord_ListBackgroundJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListCheckers provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) ListCheckers(_a0 context.Context, _a1 *querypb.ListCheckersRequest) (*querypb.ListCheckersResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5490,20 +5844,79 @@ func (_c *MixCoord_ListCheckers_Call) RunAndReturn(run func(context.Context, *qu
 	return _c
 }
 
-// ListCredUsers provides a mock function with given fields: _a0, _a1
-func (_m *MixCoord) ListCredUsers(_a0 context.Context, _a1 *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+// ListCollectionTemplates provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) ListCollectionTemplates(_a0 context.Context, _a1 *rootcoordpb.ListCollectionTemplatesRequest) (*rootcoordpb.ListCollectionTemplatesResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListCredUsers")
+		panic("no return value specified for ListCollectionTemplates")
 	}
 
-	var r0 *milvuspb.ListCredUsersResponse
+	var r0 *rootcoordpb.ListCollectionTemplatesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListCollectionTemplatesRequest) (*rootcoordpb.ListCollectionTemplatesResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListCredUsersRequest) *milvuspb.ListCredUsersResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListCollectionTemplatesRequest) *rootcoordpb.ListCollectionTemplatesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListCollectionTemplatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListCollectionTemplatesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_ListCollectionTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollectionTemplates'
+type MixCoord_ListCollectionTemplates_Call struct {
+	*mock.Call
+}
+
+// ListCollectionTemplates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.ListCollectionTemplatesRequest
+func (_e *MixCoord_Expecter) ListCollectionTemplates(_a0 interface{}, _a1 interface{}) *MixCoord_ListCollectionTemplates_Call {
+	return &MixCoord_ListCollectionTemplates_Call{Call: _e.mock.On("ListCollectionTemplates", _a0, _a1)}
+}
+
+func (_c *MixCoord_ListCollectionTemplates_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.ListCollectionTemplatesRequest)) *MixCoord_ListCollectionTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListCollectionTemplatesRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_ListCollectionTemplates_Call) Return(_a0 *rootcoordpb.ListCollectionTemplatesResponse, _a1 error) *MixCoord_ListCollectionTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_ListCollectionTemplates_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ListCollectionTemplatesRequest) (*rootcoordpb.ListCollectionTemplatesResponse, error)) *MixCoord_ListCollectionTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCredUsers provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) ListCredUsers(_a0 context.Context, _a1 *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCredUsers")
+	}
+
+	var r0 *milvuspb.ListCredUsersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListCredUsersRequest) *milvuspb.ListCredUsersResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -5962,6 +6375,65 @@ func (_c *MixCoord_ListPrivilegeGroups_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListQuarantinedSegments provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) ListQuarantinedSegments(_a0 context.Context, _a1 *datapb.ListQuarantinedSegmentsRequest) (*datapb.ListQuarantinedSegmentsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQuarantinedSegments")
+	}
+
+	var r0 *datapb.ListQuarantinedSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListQuarantinedSegmentsRequest) (*datapb.ListQuarantinedSegmentsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListQuarantinedSegmentsRequest) *datapb.ListQuarantinedSegmentsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListQuarantinedSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListQuarantinedSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_ListQuarantinedSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQuarantinedSegments'
+type MixCoord_ListQuarantinedSegments_Call struct {
+	*mock.Call
+}
+
+// ListQuarantinedSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ListQuarantinedSegmentsRequest
+func (_e *MixCoord_Expecter) ListQuarantinedSegments(_a0 interface{}, _a1 interface{}) *MixCoord_ListQuarantinedSegments_Call {
+	return &MixCoord_ListQuarantinedSegments_Call{Call: _e.mock.On("ListQuarantinedSegments", _a0, _a1)}
+}
+
+func (_c *MixCoord_ListQuarantinedSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListQuarantinedSegmentsRequest)) *MixCoord_ListQuarantinedSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ListQuarantinedSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_ListQuarantinedSegments_Call) Return(_a0 *datapb.ListQuarantinedSegmentsResponse, _a1 error) *MixCoord_ListQuarantinedSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_ListQuarantinedSegments_Call) RunAndReturn(run func(context.Context, *datapb.ListQuarantinedSegmentsRequest) (*datapb.ListQuarantinedSegmentsResponse, error)) *MixCoord_ListQuarantinedSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListQueryNode provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) ListQueryNode(_a0 context.Context, _a1 *querypb.ListQueryNodeRequest) (*querypb.ListQueryNodeResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -6541,6 +7013,65 @@ func (_c *MixCoord_OperatePrivilegeGroup_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// OperateQuarantinedSegment provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) OperateQuarantinedSegment(_a0 context.Context, _a1 *datapb.OperateQuarantinedSegmentRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OperateQuarantinedSegment")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateQuarantinedSegmentRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateQuarantinedSegmentRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.OperateQuarantinedSegmentRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_OperateQuarantinedSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateQuarantinedSegment'
+type MixCoord_OperateQuarantinedSegment_Call struct {
+	*mock.Call
+}
+
+// OperateQuarantinedSegment is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.OperateQuarantinedSegmentRequest
+func (_e *MixCoord_Expecter) OperateQuarantinedSegment(_a0 interface{}, _a1 interface{}) *MixCoord_OperateQuarantinedSegment_Call {
+	return &MixCoord_OperateQuarantinedSegment_Call{Call: _e.mock.On("OperateQuarantinedSegment", _a0, _a1)}
+}
+
+func (_c *MixCoord_OperateQuarantinedSegment_Call) Run(run func(_a0 context.Context, _a1 *datapb.OperateQuarantinedSegmentRequest)) *MixCoord_OperateQuarantinedSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.OperateQuarantinedSegmentRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_OperateQuarantinedSegment_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_OperateQuarantinedSegment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_OperateQuarantinedSegment_Call) RunAndReturn(run func(context.Context, *datapb.OperateQuarantinedSegmentRequest) (*commonpb.Status, error)) *MixCoord_OperateQuarantinedSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperateUserRole provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) OperateUserRole(_a0 context.Context, _a1 *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -6600,6 +7131,65 @@ func (_c *MixCoord_OperateUserRole_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// RebuildStatslogs provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) RebuildStatslogs(_a0 context.Context, _a1 *datapb.RebuildStatslogsRequest) (*datapb.RebuildStatslogsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebuildStatslogs")
+	}
+
+	var r0 *datapb.RebuildStatslogsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RebuildStatslogsRequest) (*datapb.RebuildStatslogsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RebuildStatslogsRequest) *datapb.RebuildStatslogsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.RebuildStatslogsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RebuildStatslogsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_RebuildStatslogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebuildStatslogs'
+type MixCoord_RebuildStatslogs_Call struct {
+	*mock.Call
+}
+
+// RebuildStatslogs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.RebuildStatslogsRequest
+func (_e *MixCoord_Expecter) RebuildStatslogs(_a0 interface{}, _a1 interface{}) *MixCoord_RebuildStatslogs_Call {
+	return &MixCoord_RebuildStatslogs_Call{Call: _e.mock.On("RebuildStatslogs", _a0, _a1)}
+}
+
+func (_c *MixCoord_RebuildStatslogs_Call) Run(run func(_a0 context.Context, _a1 *datapb.RebuildStatslogsRequest)) *MixCoord_RebuildStatslogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.RebuildStatslogsRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_RebuildStatslogs_Call) Return(_a0 *datapb.RebuildStatslogsResponse, _a1 error) *MixCoord_RebuildStatslogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_RebuildStatslogs_Call) RunAndReturn(run func(context.Context, *datapb.RebuildStatslogsRequest) (*datapb.RebuildStatslogsResponse, error)) *MixCoord_RebuildStatslogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with no fields
 func (_m *MixCoord) Register() error {
 	ret := _m.Called()
@@ -6914,6 +7504,65 @@ func (_c *MixCoord_RenameCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ReportChannelHealth provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) ReportChannelHealth(_a0 context.Context, _a1 *datapb.ReportChannelHealthRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportChannelHealth")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportChannelHealthRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportChannelHealthRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportChannelHealthRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_ReportChannelHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportChannelHealth'
+type MixCoord_ReportChannelHealth_Call struct {
+	*mock.Call
+}
+
+// ReportChannelHealth is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ReportChannelHealthRequest
+func (_e *MixCoord_Expecter) ReportChannelHealth(_a0 interface{}, _a1 interface{}) *MixCoord_ReportChannelHealth_Call {
+	return &MixCoord_ReportChannelHealth_Call{Call: _e.mock.On("ReportChannelHealth", _a0, _a1)}
+}
+
+func (_c *MixCoord_ReportChannelHealth_Call) Run(run func(_a0 context.Context, _a1 *datapb.ReportChannelHealthRequest)) *MixCoord_ReportChannelHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ReportChannelHealthRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_ReportChannelHealth_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_ReportChannelHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_ReportChannelHealth_Call) RunAndReturn(run func(context.Context, *datapb.ReportChannelHealthRequest) (*commonpb.Status, error)) *MixCoord_ReportChannelHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReportDataNodeTtMsgs provides a mock function with given fields: _a0, _a1
 func (_m *MixCoord) ReportDataNodeTtMsgs(_a0 context.Context, _a1 *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -7478,6 +8127,65 @@ func (_c *MixCoord_SetAddress_Call) RunAndReturn(run func(string)) *MixCoord_Set
 	return _c
 }
 
+// SetCollectionQuotaOverride provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) SetCollectionQuotaOverride(_a0 context.Context, _a1 *rootcoordpb.SetCollectionQuotaOverrideRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCollectionQuotaOverride")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.SetCollectionQuotaOverrideRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.SetCollectionQuotaOverrideRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.SetCollectionQuotaOverrideRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_SetCollectionQuotaOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCollectionQuotaOverride'
+type MixCoord_SetCollectionQuotaOverride_Call struct {
+	*mock.Call
+}
+
+// SetCollectionQuotaOverride is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.SetCollectionQuotaOverrideRequest
+func (_e *MixCoord_Expecter) SetCollectionQuotaOverride(_a0 interface{}, _a1 interface{}) *MixCoord_SetCollectionQuotaOverride_Call {
+	return &MixCoord_SetCollectionQuotaOverride_Call{Call: _e.mock.On("SetCollectionQuotaOverride", _a0, _a1)}
+}
+
+func (_c *MixCoord_SetCollectionQuotaOverride_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.SetCollectionQuotaOverrideRequest)) *MixCoord_SetCollectionQuotaOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.SetCollectionQuotaOverrideRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_SetCollectionQuotaOverride_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_SetCollectionQuotaOverride_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_SetCollectionQuotaOverride_Call) RunAndReturn(run func(context.Context, *rootcoordpb.SetCollectionQuotaOverrideRequest) (*commonpb.Status, error)) *MixCoord_SetCollectionQuotaOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetEtcdClient provides a mock function with given fields: etcdClient
 func (_m *MixCoord) SetEtcdClient(etcdClient *clientv3.Client) {
 	_m.Called(etcdClient)
@@ -8965,6 +9673,65 @@ func (_c *MixCoord_UpdateSegmentStatistics_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// UpdateSegmentStatslogs provides a mock function with given fields: _a0, _a1
+func (_m *MixCoord) UpdateSegmentStatslogs(_a0 context.Context, _a1 *datapb.UpdateSegmentStatslogsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSegmentStatslogs")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateSegmentStatslogsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateSegmentStatslogsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.UpdateSegmentStatslogsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MixCoord_UpdateSegmentStatslogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSegmentStatslogs'
+type MixCoord_UpdateSegmentStatslogs_Call struct {
+	*mock.Call
+}
+
+// UpdateSegmentStatslogs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.UpdateSegmentStatslogsRequest
+func (_e *MixCoord_Expecter) UpdateSegmentStatslogs(_a0 interface{}, _a1 interface{}) *MixCoord_UpdateSegmentStatslogs_Call {
+	return &MixCoord_UpdateSegmentStatslogs_Call{Call: _e.mock.On("UpdateSegmentStatslogs", _a0, _a1)}
+}
+
+func (_c *MixCoord_UpdateSegmentStatslogs_Call) Run(run func(_a0 context.Context, _a1 *datapb.UpdateSegmentStatslogsRequest)) *MixCoord_UpdateSegmentStatslogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.UpdateSegmentStatslogsRequest))
+	})
+	return _c
+}
+
+func (_c *MixCoord_UpdateSegmentStatslogs_Call) Return(_a0 *commonpb.Status, _a1 error) *MixCoord_UpdateSegmentStatslogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MixCoord_UpdateSegmentStatslogs_Call) RunAndReturn(run func(context.Context, *datapb.UpdateSegmentStatslogsRequest) (*commonpb.Status, error)) *MixCoord_UpdateSegmentStatslogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStateCode provides a mock function with given fields: _a0
 func (_m *MixCoord) UpdateStateCode(_a0 commonpb.StateCode) {
 	_m.Called(_a0)
@@ -1638,6 +1638,80 @@ func (_c *MockMixCoordClient_CreateCollection_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// CreateCollectionTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) CreateCollectionTemplate(ctx context.Context, in *rootcoordpb.CreateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCollectionTemplate")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateCollectionTemplateRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateCollectionTemplateRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CreateCollectionTemplateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_CreateCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollectionTemplate'
+type MockMixCoordClient_CreateCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateCollectionTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.CreateCollectionTemplateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) CreateCollectionTemplate(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_CreateCollectionTemplate_Call {
+	return &MockMixCoordClient_CreateCollectionTemplate_Call{Call: _e.mock.On("CreateCollectionTemplate",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_CreateCollectionTemplate_Call) Run(run func(ctx context.Context, in *rootcoordpb.CreateCollectionTemplateRequest, opts ...grpc.CallOption)) *MockMixCoordClient_CreateCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CreateCollectionTemplateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_CreateCollectionTemplate_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_CreateCollectionTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_CreateCollectionTemplate_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CreateCollectionTemplateRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_CreateCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCredential provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -2970,6 +3044,80 @@ func (_c *MockMixCoordClient_DropCollectionFunction_Call) RunAndReturn(run func(
 	return _c
 }
 
+// DropCollectionTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) DropCollectionTemplate(ctx context.Context, in *rootcoordpb.DropCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropCollectionTemplate")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropCollectionTemplateRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropCollectionTemplateRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.DropCollectionTemplateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_DropCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionTemplate'
+type MockMixCoordClient_DropCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// DropCollectionTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.DropCollectionTemplateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) DropCollectionTemplate(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_DropCollectionTemplate_Call {
+	return &MockMixCoordClient_DropCollectionTemplate_Call{Call: _e.mock.On("DropCollectionTemplate",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_DropCollectionTemplate_Call) Run(run func(ctx context.Context, in *rootcoordpb.DropCollectionTemplateRequest, opts ...grpc.CallOption)) *MockMixCoordClient_DropCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.DropCollectionTemplateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_DropCollectionTemplate_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_DropCollectionTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_DropCollectionTemplate_Call) RunAndReturn(run func(context.Context, *rootcoordpb.DropCollectionTemplateRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_DropCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -3636,6 +3784,80 @@ func (_c *MockMixCoordClient_FlushAll_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// FlushAndSeal provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) FlushAndSeal(ctx context.Context, in *datapb.FlushAndSealRequest, opts ...grpc.CallOption) (*datapb.FlushAndSealResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlushAndSeal")
+	}
+
+	var r0 *datapb.FlushAndSealResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushAndSealRequest, ...grpc.CallOption) (*datapb.FlushAndSealResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushAndSealRequest, ...grpc.CallOption) *datapb.FlushAndSealResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.FlushAndSealResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushAndSealRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_FlushAndSeal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlushAndSeal'
+type MockMixCoordClient_FlushAndSeal_Call struct {
+	*mock.Call
+}
+
+// FlushAndSeal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.FlushAndSealRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) FlushAndSeal(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_FlushAndSeal_Call {
+	return &MockMixCoordClient_FlushAndSeal_Call{Call: _e.mock.On("FlushAndSeal",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_FlushAndSeal_Call) Run(run func(ctx context.Context, in *datapb.FlushAndSealRequest, opts ...grpc.CallOption)) *MockMixCoordClient_FlushAndSeal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.FlushAndSealRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_FlushAndSeal_Call) Return(_a0 *datapb.FlushAndSealResponse, _a1 error) *MockMixCoordClient_FlushAndSeal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_FlushAndSeal_Call) RunAndReturn(run func(context.Context, *datapb.FlushAndSealRequest, ...grpc.CallOption) (*datapb.FlushAndSealResponse, error)) *MockMixCoordClient_FlushAndSeal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GcConfirm provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) GcConfirm(ctx context.Context, in *datapb.GcConfirmRequest, opts ...grpc.CallOption) (*datapb.GcConfirmResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -6152,8 +6374,8 @@ func (_c *MockMixCoordClient_GetStatisticsChannel_Call) RunAndReturn(run func(co
 	return _c
 }
 
-// GetTimeTickChannel provides a mock function with given fields: ctx, in, opts
-func (_m *MockMixCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+// GetStatslogRebuildJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) GetStatslogRebuildJob(ctx context.Context, in *datapb.GetStatslogRebuildJobRequest, opts ...grpc.CallOption) (*datapb.GetStatslogRebuildJobResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -6164,23 +6386,23 @@ func (_m *MockMixCoordClient) GetTimeTickChannel(ctx context.Context, in *intern
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTimeTickChannel")
+		panic("no return value specified for GetStatslogRebuildJob")
 	}
 
-	var r0 *milvuspb.StringResponse
+	var r0 *datapb.GetStatslogRebuildJobResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetStatslogRebuildJobRequest, ...grpc.CallOption) (*datapb.GetStatslogRebuildJobResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetStatslogRebuildJobRequest, ...grpc.CallOption) *datapb.GetStatslogRebuildJobResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*datapb.GetStatslogRebuildJobResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetStatslogRebuildJobRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -6189,21 +6411,21 @@ func (_m *MockMixCoordClient) GetTimeTickChannel(ctx context.Context, in *intern
 	return r0, r1
 }
 
-// MockMixCoordClient_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
-type MockMixCoordClient_GetTimeTickChannel_Call struct {
+// MockMixCoordClient_GetStatslogRebuildJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatslogRebuildJob'
+type MockMixCoordClient_GetStatslogRebuildJob_Call struct {
 	*mock.Call
 }
 
-// GetTimeTickChannel is a helper method to define mock.On call
+// GetStatslogRebuildJob is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.GetTimeTickChannelRequest
+//   - in *datapb.GetStatslogRebuildJobRequest
 //   - opts ...grpc.CallOption
-func (_e *MockMixCoordClient_Expecter) GetTimeTickChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_GetTimeTickChannel_Call {
-	return &MockMixCoordClient_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel",
+func (_e *MockMixCoordClient_Expecter) GetStatslogRebuildJob(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_GetStatslogRebuildJob_Call {
+	return &MockMixCoordClient_GetStatslogRebuildJob_Call{Call: _e.mock.On("GetStatslogRebuildJob",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockMixCoordClient_GetTimeTickChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption)) *MockMixCoordClient_GetTimeTickChannel_Call {
+func (_c *MockMixCoordClient_GetStatslogRebuildJob_Call) Run(run func(ctx context.Context, in *datapb.GetStatslogRebuildJobRequest, opts ...grpc.CallOption)) *MockMixCoordClient_GetStatslogRebuildJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -6211,23 +6433,23 @@ func (_c *MockMixCoordClient_GetTimeTickChannel_Call) Run(run func(ctx context.C
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.GetTimeTickChannelRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.GetStatslogRebuildJobRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockMixCoordClient_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockMixCoordClient_GetTimeTickChannel_Call {
+func (_c *MockMixCoordClient_GetStatslogRebuildJob_Call) Return(_a0 *datapb.GetStatslogRebuildJobResponse, _a1 error) *MockMixCoordClient_GetStatslogRebuildJob_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMixCoordClient_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockMixCoordClient_GetTimeTickChannel_Call {
+func (_c *MockMixCoordClient_GetStatslogRebuildJob_Call) RunAndReturn(run func(context.Context, *datapb.GetStatslogRebuildJobRequest, ...grpc.CallOption) (*datapb.GetStatslogRebuildJobResponse, error)) *MockMixCoordClient_GetStatslogRebuildJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// HasCollection provides a mock function with given fields: ctx, in, opts
-func (_m *MockMixCoordClient) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
+// GetTimeTickChannel provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -6238,23 +6460,23 @@ func (_m *MockMixCoordClient) HasCollection(ctx context.Context, in *milvuspb.Ha
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for HasCollection")
+		panic("no return value specified for GetTimeTickChannel")
 	}
 
-	var r0 *milvuspb.BoolResponse
+	var r0 *milvuspb.StringResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) *milvuspb.BoolResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.BoolResponse)
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -6263,21 +6485,21 @@ func (_m *MockMixCoordClient) HasCollection(ctx context.Context, in *milvuspb.Ha
 	return r0, r1
 }
 
-// MockMixCoordClient_HasCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasCollection'
-type MockMixCoordClient_HasCollection_Call struct {
+// MockMixCoordClient_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
+type MockMixCoordClient_GetTimeTickChannel_Call struct {
 	*mock.Call
 }
 
-// HasCollection is a helper method to define mock.On call
+// GetTimeTickChannel is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.HasCollectionRequest
+//   - in *internalpb.GetTimeTickChannelRequest
 //   - opts ...grpc.CallOption
-func (_e *MockMixCoordClient_Expecter) HasCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_HasCollection_Call {
-	return &MockMixCoordClient_HasCollection_Call{Call: _e.mock.On("HasCollection",
+func (_e *MockMixCoordClient_Expecter) GetTimeTickChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_GetTimeTickChannel_Call {
+	return &MockMixCoordClient_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockMixCoordClient_HasCollection_Call) Run(run func(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_HasCollection_Call {
+func (_c *MockMixCoordClient_GetTimeTickChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption)) *MockMixCoordClient_GetTimeTickChannel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -6285,23 +6507,23 @@ func (_c *MockMixCoordClient_HasCollection_Call) Run(run func(ctx context.Contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.HasCollectionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.GetTimeTickChannelRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockMixCoordClient_HasCollection_Call) Return(_a0 *milvuspb.BoolResponse, _a1 error) *MockMixCoordClient_HasCollection_Call {
+func (_c *MockMixCoordClient_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockMixCoordClient_GetTimeTickChannel_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMixCoordClient_HasCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)) *MockMixCoordClient_HasCollection_Call {
+func (_c *MockMixCoordClient_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockMixCoordClient_GetTimeTickChannel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// HasPartition provides a mock function with given fields: ctx, in, opts
-func (_m *MockMixCoordClient) HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
+// GetUsage provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) GetUsage(ctx context.Context, in *rootcoordpb.GetUsageRequest, opts ...grpc.CallOption) (*rootcoordpb.GetUsageResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -6312,23 +6534,23 @@ func (_m *MockMixCoordClient) HasPartition(ctx context.Context, in *milvuspb.Has
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for HasPartition")
+		panic("no return value specified for GetUsage")
 	}
 
-	var r0 *milvuspb.BoolResponse
+	var r0 *rootcoordpb.GetUsageResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetUsageRequest, ...grpc.CallOption) (*rootcoordpb.GetUsageResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) *milvuspb.BoolResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetUsageRequest, ...grpc.CallOption) *rootcoordpb.GetUsageResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.BoolResponse)
+			r0 = ret.Get(0).(*rootcoordpb.GetUsageResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetUsageRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -6337,21 +6559,21 @@ func (_m *MockMixCoordClient) HasPartition(ctx context.Context, in *milvuspb.Has
 	return r0, r1
 }
 
-// MockMixCoordClient_HasPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasPartition'
-type MockMixCoordClient_HasPartition_Call struct {
+// MockMixCoordClient_GetUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsage'
+type MockMixCoordClient_GetUsage_Call struct {
 	*mock.Call
 }
 
-// HasPartition is a helper method to define mock.On call
+// GetUsage is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.HasPartitionRequest
+//   - in *rootcoordpb.GetUsageRequest
 //   - opts ...grpc.CallOption
-func (_e *MockMixCoordClient_Expecter) HasPartition(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_HasPartition_Call {
-	return &MockMixCoordClient_HasPartition_Call{Call: _e.mock.On("HasPartition",
+func (_e *MockMixCoordClient_Expecter) GetUsage(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_GetUsage_Call {
+	return &MockMixCoordClient_GetUsage_Call{Call: _e.mock.On("GetUsage",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockMixCoordClient_HasPartition_Call) Run(run func(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_HasPartition_Call {
+func (_c *MockMixCoordClient_GetUsage_Call) Run(run func(ctx context.Context, in *rootcoordpb.GetUsageRequest, opts ...grpc.CallOption)) *MockMixCoordClient_GetUsage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -6359,23 +6581,23 @@ func (_c *MockMixCoordClient_HasPartition_Call) Run(run func(ctx context.Context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.HasPartitionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetUsageRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockMixCoordClient_HasPartition_Call) Return(_a0 *milvuspb.BoolResponse, _a1 error) *MockMixCoordClient_HasPartition_Call {
+func (_c *MockMixCoordClient_GetUsage_Call) Return(_a0 *rootcoordpb.GetUsageResponse, _a1 error) *MockMixCoordClient_GetUsage_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMixCoordClient_HasPartition_Call) RunAndReturn(run func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)) *MockMixCoordClient_HasPartition_Call {
+func (_c *MockMixCoordClient_GetUsage_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetUsageRequest, ...grpc.CallOption) (*rootcoordpb.GetUsageResponse, error)) *MockMixCoordClient_GetUsage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ImportV2 provides a mock function with given fields: ctx, in, opts
-func (_m *MockMixCoordClient) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
+// HasCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -6386,15 +6608,163 @@ func (_m *MockMixCoordClient) ImportV2(ctx context.Context, in *internalpb.Impor
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ImportV2")
+		panic("no return value specified for HasCollection")
 	}
 
-	var r0 *internalpb.ImportResponse
+	var r0 *milvuspb.BoolResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequestInternal, ...grpc.CallOption) (*internalpb.ImportResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequestInternal, ...grpc.CallOption) *internalpb.ImportResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) *milvuspb.BoolResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.BoolResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_HasCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasCollection'
+type MockMixCoordClient_HasCollection_Call struct {
+	*mock.Call
+}
+
+// HasCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.HasCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) HasCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_HasCollection_Call {
+	return &MockMixCoordClient_HasCollection_Call{Call: _e.mock.On("HasCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_HasCollection_Call) Run(run func(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_HasCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.HasCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_HasCollection_Call) Return(_a0 *milvuspb.BoolResponse, _a1 error) *MockMixCoordClient_HasCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_HasCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)) *MockMixCoordClient_HasCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasPartition provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasPartition")
+	}
+
+	var r0 *milvuspb.BoolResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) *milvuspb.BoolResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.BoolResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_HasPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasPartition'
+type MockMixCoordClient_HasPartition_Call struct {
+	*mock.Call
+}
+
+// HasPartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.HasPartitionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) HasPartition(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_HasPartition_Call {
+	return &MockMixCoordClient_HasPartition_Call{Call: _e.mock.On("HasPartition",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_HasPartition_Call) Run(run func(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption)) *MockMixCoordClient_HasPartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.HasPartitionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_HasPartition_Call) Return(_a0 *milvuspb.BoolResponse, _a1 error) *MockMixCoordClient_HasPartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_HasPartition_Call) RunAndReturn(run func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)) *MockMixCoordClient_HasPartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportV2 provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportV2")
+	}
+
+	var r0 *internalpb.ImportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequestInternal, ...grpc.CallOption) (*internalpb.ImportResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequestInternal, ...grpc.CallOption) *internalpb.ImportResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -6596,6 +6966,80 @@ func (_c *MockMixCoordClient_ListAliases_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ListBackgroundJobs provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) ListBackgroundJobs(ctx context.Context, in *datapb.ListBackgroundJobsRequest, opts ...grpc.CallOption) (*datapb.ListBackgroundJobsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBackgroundJobs")
+	}
+
+	var r0 *datapb.ListBackgroundJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListBackgroundJobsRequest, ...grpc.CallOption) (*datapb.ListBackgroundJobsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListBackgroundJobsRequest, ...grpc.CallOption) *datapb.ListBackgroundJobsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListBackgroundJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListBackgroundJobsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_ListBackgroundJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBackgroundJobs'
+type MockMixCoordClient_ListBackgroundJobs_Call struct {
+	*mock.Call
+}
+
+// ListBackgroundJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ListBackgroundJobsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) ListBackgroundJobs(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_ListBackgroundJobs_Call {
+	return &MockMixCoordClient_ListBackgroundJobs_Call{Call: _e.mock.On("ListBackgroundJobs",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_ListBackgroundJobs_Call) Run(run func(ctx context.Context, in *datapb.ListBackgroundJobsRequest, opts ...grpc.CallOption)) *MockMixCoordClient_ListBackgroundJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ListBackgroundJobsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListBackgroundJobs_Call) Return(_a0 *datapb.ListBackgroundJobsResponse, _a1 error) *MockMixCoordClient_ListBackgroundJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListBackgroundJobs_Call) RunAndReturn(run func(context.Context, *datapb.ListBackgroundJobsRequest, ...grpc.CallOption) (*datapb.ListBackgroundJobsResponse, error)) *MockMixCoordClient_ListBackgroundJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListCheckers provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) ListCheckers(ctx context.Context, in *querypb.ListCheckersRequest, opts ...grpc.CallOption) (*querypb.ListCheckersResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -6670,6 +7114,80 @@ func (_c *MockMixCoordClient_ListCheckers_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ListCollectionTemplates provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) ListCollectionTemplates(ctx context.Context, in *rootcoordpb.ListCollectionTemplatesRequest, opts ...grpc.CallOption) (*rootcoordpb.ListCollectionTemplatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCollectionTemplates")
+	}
+
+	var r0 *rootcoordpb.ListCollectionTemplatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListCollectionTemplatesRequest, ...grpc.CallOption) (*rootcoordpb.ListCollectionTemplatesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListCollectionTemplatesRequest, ...grpc.CallOption) *rootcoordpb.ListCollectionTemplatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListCollectionTemplatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListCollectionTemplatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_ListCollectionTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollectionTemplates'
+type MockMixCoordClient_ListCollectionTemplates_Call struct {
+	*mock.Call
+}
+
+// ListCollectionTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.ListCollectionTemplatesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) ListCollectionTemplates(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_ListCollectionTemplates_Call {
+	return &MockMixCoordClient_ListCollectionTemplates_Call{Call: _e.mock.On("ListCollectionTemplates",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_ListCollectionTemplates_Call) Run(run func(ctx context.Context, in *rootcoordpb.ListCollectionTemplatesRequest, opts ...grpc.CallOption)) *MockMixCoordClient_ListCollectionTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListCollectionTemplatesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListCollectionTemplates_Call) Return(_a0 *rootcoordpb.ListCollectionTemplatesResponse, _a1 error) *MockMixCoordClient_ListCollectionTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListCollectionTemplates_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ListCollectionTemplatesRequest, ...grpc.CallOption) (*rootcoordpb.ListCollectionTemplatesResponse, error)) *MockMixCoordClient_ListCollectionTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListCredUsers provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -7262,6 +7780,80 @@ func (_c *MockMixCoordClient_ListPrivilegeGroups_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ListQuarantinedSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) ListQuarantinedSegments(ctx context.Context, in *datapb.ListQuarantinedSegmentsRequest, opts ...grpc.CallOption) (*datapb.ListQuarantinedSegmentsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQuarantinedSegments")
+	}
+
+	var r0 *datapb.ListQuarantinedSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListQuarantinedSegmentsRequest, ...grpc.CallOption) (*datapb.ListQuarantinedSegmentsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListQuarantinedSegmentsRequest, ...grpc.CallOption) *datapb.ListQuarantinedSegmentsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListQuarantinedSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListQuarantinedSegmentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_ListQuarantinedSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQuarantinedSegments'
+type MockMixCoordClient_ListQuarantinedSegments_Call struct {
+	*mock.Call
+}
+
+// ListQuarantinedSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ListQuarantinedSegmentsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) ListQuarantinedSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_ListQuarantinedSegments_Call {
+	return &MockMixCoordClient_ListQuarantinedSegments_Call{Call: _e.mock.On("ListQuarantinedSegments",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_ListQuarantinedSegments_Call) Run(run func(ctx context.Context, in *datapb.ListQuarantinedSegmentsRequest, opts ...grpc.CallOption)) *MockMixCoordClient_ListQuarantinedSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ListQuarantinedSegmentsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListQuarantinedSegments_Call) Return(_a0 *datapb.ListQuarantinedSegmentsResponse, _a1 error) *MockMixCoordClient_ListQuarantinedSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_ListQuarantinedSegments_Call) RunAndReturn(run func(context.Context, *datapb.ListQuarantinedSegmentsRequest, ...grpc.CallOption) (*datapb.ListQuarantinedSegmentsResponse, error)) *MockMixCoordClient_ListQuarantinedSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListQueryNode provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) ListQueryNode(ctx context.Context, in *querypb.ListQueryNodeRequest, opts ...grpc.CallOption) (*querypb.ListQueryNodeResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -7748,16 +8340,164 @@ type MockMixCoordClient_MarkSegmentsDropped_Call struct {
 	*mock.Call
 }
 
-// MarkSegmentsDropped is a helper method to define mock.On call
+// MarkSegmentsDropped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.MarkSegmentsDroppedRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) MarkSegmentsDropped(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_MarkSegmentsDropped_Call {
+	return &MockMixCoordClient_MarkSegmentsDropped_Call{Call: _e.mock.On("MarkSegmentsDropped",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_MarkSegmentsDropped_Call) Run(run func(ctx context.Context, in *datapb.MarkSegmentsDroppedRequest, opts ...grpc.CallOption)) *MockMixCoordClient_MarkSegmentsDropped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.MarkSegmentsDroppedRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_MarkSegmentsDropped_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_MarkSegmentsDropped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_MarkSegmentsDropped_Call) RunAndReturn(run func(context.Context, *datapb.MarkSegmentsDroppedRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_MarkSegmentsDropped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperatePrivilege provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OperatePrivilege")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_OperatePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperatePrivilege'
+type MockMixCoordClient_OperatePrivilege_Call struct {
+	*mock.Call
+}
+
+// OperatePrivilege is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.OperatePrivilegeRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) OperatePrivilege(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_OperatePrivilege_Call {
+	return &MockMixCoordClient_OperatePrivilege_Call{Call: _e.mock.On("OperatePrivilege",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_OperatePrivilege_Call) Run(run func(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption)) *MockMixCoordClient_OperatePrivilege_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.OperatePrivilegeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_OperatePrivilege_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_OperatePrivilege_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_OperatePrivilege_Call) RunAndReturn(run func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_OperatePrivilege_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperatePrivilegeGroup provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) OperatePrivilegeGroup(ctx context.Context, in *milvuspb.OperatePrivilegeGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OperatePrivilegeGroup")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeGroupRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeGroupRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.OperatePrivilegeGroupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_OperatePrivilegeGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperatePrivilegeGroup'
+type MockMixCoordClient_OperatePrivilegeGroup_Call struct {
+	*mock.Call
+}
+
+// OperatePrivilegeGroup is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.MarkSegmentsDroppedRequest
+//   - in *milvuspb.OperatePrivilegeGroupRequest
 //   - opts ...grpc.CallOption
-func (_e *MockMixCoordClient_Expecter) MarkSegmentsDropped(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_MarkSegmentsDropped_Call {
-	return &MockMixCoordClient_MarkSegmentsDropped_Call{Call: _e.mock.On("MarkSegmentsDropped",
+func (_e *MockMixCoordClient_Expecter) OperatePrivilegeGroup(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_OperatePrivilegeGroup_Call {
+	return &MockMixCoordClient_OperatePrivilegeGroup_Call{Call: _e.mock.On("OperatePrivilegeGroup",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockMixCoordClient_MarkSegmentsDropped_Call) Run(run func(ctx context.Context, in *datapb.MarkSegmentsDroppedRequest, opts ...grpc.CallOption)) *MockMixCoordClient_MarkSegmentsDropped_Call {
+func (_c *MockMixCoordClient_OperatePrivilegeGroup_Call) Run(run func(ctx context.Context, in *milvuspb.OperatePrivilegeGroupRequest, opts ...grpc.CallOption)) *MockMixCoordClient_OperatePrivilegeGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -7765,23 +8505,23 @@ func (_c *MockMixCoordClient_MarkSegmentsDropped_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.MarkSegmentsDroppedRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.OperatePrivilegeGroupRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockMixCoordClient_MarkSegmentsDropped_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_MarkSegmentsDropped_Call {
+func (_c *MockMixCoordClient_OperatePrivilegeGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_OperatePrivilegeGroup_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMixCoordClient_MarkSegmentsDropped_Call) RunAndReturn(run func(context.Context, *datapb.MarkSegmentsDroppedRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_MarkSegmentsDropped_Call {
+func (_c *MockMixCoordClient_OperatePrivilegeGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.OperatePrivilegeGroupRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_OperatePrivilegeGroup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OperatePrivilege provides a mock function with given fields: ctx, in, opts
-func (_m *MockMixCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// OperateQuarantinedSegment provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) OperateQuarantinedSegment(ctx context.Context, in *datapb.OperateQuarantinedSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -7792,15 +8532,15 @@ func (_m *MockMixCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OperatePrivilege")
+		panic("no return value specified for OperateQuarantinedSegment")
 	}
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateQuarantinedSegmentRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.OperateQuarantinedSegmentRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -7808,7 +8548,7 @@ func (_m *MockMixCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.OperateQuarantinedSegmentRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -7817,21 +8557,21 @@ func (_m *MockMixCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb
 	return r0, r1
 }
 
-// MockMixCoordClient_OperatePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperatePrivilege'
-type MockMixCoordClient_OperatePrivilege_Call struct {
+// MockMixCoordClient_OperateQuarantinedSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateQuarantinedSegment'
+type MockMixCoordClient_OperateQuarantinedSegment_Call struct {
 	*mock.Call
 }
 
-// OperatePrivilege is a helper method to define mock.On call
+// OperateQuarantinedSegment is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.OperatePrivilegeRequest
+//   - in *datapb.OperateQuarantinedSegmentRequest
 //   - opts ...grpc.CallOption
-func (_e *MockMixCoordClient_Expecter) OperatePrivilege(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_OperatePrivilege_Call {
-	return &MockMixCoordClient_OperatePrivilege_Call{Call: _e.mock.On("OperatePrivilege",
+func (_e *MockMixCoordClient_Expecter) OperateQuarantinedSegment(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_OperateQuarantinedSegment_Call {
+	return &MockMixCoordClient_OperateQuarantinedSegment_Call{Call: _e.mock.On("OperateQuarantinedSegment",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockMixCoordClient_OperatePrivilege_Call) Run(run func(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption)) *MockMixCoordClient_OperatePrivilege_Call {
+func (_c *MockMixCoordClient_OperateQuarantinedSegment_Call) Run(run func(ctx context.Context, in *datapb.OperateQuarantinedSegmentRequest, opts ...grpc.CallOption)) *MockMixCoordClient_OperateQuarantinedSegment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -7839,23 +8579,23 @@ func (_c *MockMixCoordClient_OperatePrivilege_Call) Run(run func(ctx context.Con
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.OperatePrivilegeRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.OperateQuarantinedSegmentRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockMixCoordClient_OperatePrivilege_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_OperatePrivilege_Call {
+func (_c *MockMixCoordClient_OperateQuarantinedSegment_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_OperateQuarantinedSegment_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMixCoordClient_OperatePrivilege_Call) RunAndReturn(run func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_OperatePrivilege_Call {
+func (_c *MockMixCoordClient_OperateQuarantinedSegment_Call) RunAndReturn(run func(context.Context, *datapb.OperateQuarantinedSegmentRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_OperateQuarantinedSegment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OperatePrivilegeGroup provides a mock function with given fields: ctx, in, opts
-func (_m *MockMixCoordClient) OperatePrivilegeGroup(ctx context.Context, in *milvuspb.OperatePrivilegeGroupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// OperateUserRole provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -7866,15 +8606,15 @@ func (_m *MockMixCoordClient) OperatePrivilegeGroup(ctx context.Context, in *mil
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OperatePrivilegeGroup")
+		panic("no return value specified for OperateUserRole")
 	}
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeGroupRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeGroupRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -7882,7 +8622,7 @@ func (_m *MockMixCoordClient) OperatePrivilegeGroup(ctx context.Context, in *mil
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.OperatePrivilegeGroupRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -7891,21 +8631,21 @@ func (_m *MockMixCoordClient) OperatePrivilegeGroup(ctx context.Context, in *mil
 	return r0, r1
 }
 
-// MockMixCoordClient_OperatePrivilegeGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperatePrivilegeGroup'
-type MockMixCoordClient_OperatePrivilegeGroup_Call struct {
+// MockMixCoordClient_OperateUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateUserRole'
+type MockMixCoordClient_OperateUserRole_Call struct {
 	*mock.Call
 }
 
-// OperatePrivilegeGroup is a helper method to define mock.On call
+// OperateUserRole is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.OperatePrivilegeGroupRequest
+//   - in *milvuspb.OperateUserRoleRequest
 //   - opts ...grpc.CallOption
-func (_e *MockMixCoordClient_Expecter) OperatePrivilegeGroup(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_OperatePrivilegeGroup_Call {
-	return &MockMixCoordClient_OperatePrivilegeGroup_Call{Call: _e.mock.On("OperatePrivilegeGroup",
+func (_e *MockMixCoordClient_Expecter) OperateUserRole(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_OperateUserRole_Call {
+	return &MockMixCoordClient_OperateUserRole_Call{Call: _e.mock.On("OperateUserRole",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockMixCoordClient_OperatePrivilegeGroup_Call) Run(run func(ctx context.Context, in *milvuspb.OperatePrivilegeGroupRequest, opts ...grpc.CallOption)) *MockMixCoordClient_OperatePrivilegeGroup_Call {
+func (_c *MockMixCoordClient_OperateUserRole_Call) Run(run func(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption)) *MockMixCoordClient_OperateUserRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -7913,23 +8653,23 @@ func (_c *MockMixCoordClient_OperatePrivilegeGroup_Call) Run(run func(ctx contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.OperatePrivilegeGroupRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.OperateUserRoleRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockMixCoordClient_OperatePrivilegeGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_OperatePrivilegeGroup_Call {
+func (_c *MockMixCoordClient_OperateUserRole_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_OperateUserRole_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMixCoordClient_OperatePrivilegeGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.OperatePrivilegeGroupRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_OperatePrivilegeGroup_Call {
+func (_c *MockMixCoordClient_OperateUserRole_Call) RunAndReturn(run func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_OperateUserRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OperateUserRole provides a mock function with given fields: ctx, in, opts
-func (_m *MockMixCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// RebuildStatslogs provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) RebuildStatslogs(ctx context.Context, in *datapb.RebuildStatslogsRequest, opts ...grpc.CallOption) (*datapb.RebuildStatslogsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -7940,23 +8680,23 @@ func (_m *MockMixCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OperateUserRole")
+		panic("no return value specified for RebuildStatslogs")
 	}
 
-	var r0 *commonpb.Status
+	var r0 *datapb.RebuildStatslogsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RebuildStatslogsRequest, ...grpc.CallOption) (*datapb.RebuildStatslogsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RebuildStatslogsRequest, ...grpc.CallOption) *datapb.RebuildStatslogsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*datapb.RebuildStatslogsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RebuildStatslogsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -7965,21 +8705,21 @@ func (_m *MockMixCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.
 	return r0, r1
 }
 
-// MockMixCoordClient_OperateUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateUserRole'
-type MockMixCoordClient_OperateUserRole_Call struct {
+// MockMixCoordClient_RebuildStatslogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebuildStatslogs'
+type MockMixCoordClient_RebuildStatslogs_Call struct {
 	*mock.Call
 }
 
-// OperateUserRole is a helper method to define mock.On call
+// RebuildStatslogs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.OperateUserRoleRequest
+//   - in *datapb.RebuildStatslogsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockMixCoordClient_Expecter) OperateUserRole(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_OperateUserRole_Call {
-	return &MockMixCoordClient_OperateUserRole_Call{Call: _e.mock.On("OperateUserRole",
+func (_e *MockMixCoordClient_Expecter) RebuildStatslogs(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_RebuildStatslogs_Call {
+	return &MockMixCoordClient_RebuildStatslogs_Call{Call: _e.mock.On("RebuildStatslogs",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockMixCoordClient_OperateUserRole_Call) Run(run func(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption)) *MockMixCoordClient_OperateUserRole_Call {
+func (_c *MockMixCoordClient_RebuildStatslogs_Call) Run(run func(ctx context.Context, in *datapb.RebuildStatslogsRequest, opts ...grpc.CallOption)) *MockMixCoordClient_RebuildStatslogs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -7987,17 +8727,17 @@ func (_c *MockMixCoordClient_OperateUserRole_Call) Run(run func(ctx context.Cont
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.OperateUserRoleRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.RebuildStatslogsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockMixCoordClient_OperateUserRole_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_OperateUserRole_Call {
+func (_c *MockMixCoordClient_RebuildStatslogs_Call) Return(_a0 *datapb.RebuildStatslogsResponse, _a1 error) *MockMixCoordClient_RebuildStatslogs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMixCoordClient_OperateUserRole_Call) RunAndReturn(run func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_OperateUserRole_Call {
+func (_c *MockMixCoordClient_RebuildStatslogs_Call) RunAndReturn(run func(context.Context, *datapb.RebuildStatslogsRequest, ...grpc.CallOption) (*datapb.RebuildStatslogsResponse, error)) *MockMixCoordClient_RebuildStatslogs_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -8298,6 +9038,80 @@ func (_c *MockMixCoordClient_RenameCollection_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// ReportChannelHealth provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) ReportChannelHealth(ctx context.Context, in *datapb.ReportChannelHealthRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportChannelHealth")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportChannelHealthRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportChannelHealthRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportChannelHealthRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_ReportChannelHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportChannelHealth'
+type MockMixCoordClient_ReportChannelHealth_Call struct {
+	*mock.Call
+}
+
+// ReportChannelHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ReportChannelHealthRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) ReportChannelHealth(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_ReportChannelHealth_Call {
+	return &MockMixCoordClient_ReportChannelHealth_Call{Call: _e.mock.On("ReportChannelHealth",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_ReportChannelHealth_Call) Run(run func(ctx context.Context, in *datapb.ReportChannelHealthRequest, opts ...grpc.CallOption)) *MockMixCoordClient_ReportChannelHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ReportChannelHealthRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_ReportChannelHealth_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_ReportChannelHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_ReportChannelHealth_Call) RunAndReturn(run func(context.Context, *datapb.ReportChannelHealthRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_ReportChannelHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReportDataNodeTtMsgs provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *datapb.ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -8964,6 +9778,80 @@ func (_c *MockMixCoordClient_SelectUser_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// SetCollectionQuotaOverride provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) SetCollectionQuotaOverride(ctx context.Context, in *rootcoordpb.SetCollectionQuotaOverrideRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCollectionQuotaOverride")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.SetCollectionQuotaOverrideRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.SetCollectionQuotaOverrideRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.SetCollectionQuotaOverrideRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_SetCollectionQuotaOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCollectionQuotaOverride'
+type MockMixCoordClient_SetCollectionQuotaOverride_Call struct {
+	*mock.Call
+}
+
+// SetCollectionQuotaOverride is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.SetCollectionQuotaOverrideRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) SetCollectionQuotaOverride(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_SetCollectionQuotaOverride_Call {
+	return &MockMixCoordClient_SetCollectionQuotaOverride_Call{Call: _e.mock.On("SetCollectionQuotaOverride",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_SetCollectionQuotaOverride_Call) Run(run func(ctx context.Context, in *rootcoordpb.SetCollectionQuotaOverrideRequest, opts ...grpc.CallOption)) *MockMixCoordClient_SetCollectionQuotaOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.SetCollectionQuotaOverrideRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_SetCollectionQuotaOverride_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_SetCollectionQuotaOverride_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_SetCollectionQuotaOverride_Call) RunAndReturn(run func(context.Context, *rootcoordpb.SetCollectionQuotaOverrideRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_SetCollectionQuotaOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetSegmentState provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) SetSegmentState(ctx context.Context, in *datapb.SetSegmentStateRequest, opts ...grpc.CallOption) (*datapb.SetSegmentStateResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -10592,6 +11480,80 @@ func (_c *MockMixCoordClient_UpdateSegmentStatistics_Call) RunAndReturn(run func
 	return _c
 }
 
+// UpdateSegmentStatslogs provides a mock function with given fields: ctx, in, opts
+func (_m *MockMixCoordClient) UpdateSegmentStatslogs(ctx context.Context, in *datapb.UpdateSegmentStatslogsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSegmentStatslogs")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateSegmentStatslogsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.UpdateSegmentStatslogsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.UpdateSegmentStatslogsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMixCoordClient_UpdateSegmentStatslogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSegmentStatslogs'
+type MockMixCoordClient_UpdateSegmentStatslogs_Call struct {
+	*mock.Call
+}
+
+// UpdateSegmentStatslogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.UpdateSegmentStatslogsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockMixCoordClient_Expecter) UpdateSegmentStatslogs(ctx interface{}, in interface{}, opts ...interface{}) *MockMixCoordClient_UpdateSegmentStatslogs_Call {
+	return &MockMixCoordClient_UpdateSegmentStatslogs_Call{Call: _e.mock.On("UpdateSegmentStatslogs",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockMixCoordClient_UpdateSegmentStatslogs_Call) Run(run func(ctx context.Context, in *datapb.UpdateSegmentStatslogsRequest, opts ...grpc.CallOption)) *MockMixCoordClient_UpdateSegmentStatslogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.UpdateSegmentStatslogsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMixCoordClient_UpdateSegmentStatslogs_Call) Return(_a0 *commonpb.Status, _a1 error) *MockMixCoordClient_UpdateSegmentStatslogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMixCoordClient_UpdateSegmentStatslogs_Call) RunAndReturn(run func(context.Context, *datapb.UpdateSegmentStatslogsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockMixCoordClient_UpdateSegmentStatslogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateAnalyzer provides a mock function with given fields: ctx, in, opts
 func (_m *MockMixCoordClient) ValidateAnalyzer(ctx context.Context, in *querypb.ValidateAnalyzerRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1040,6 +1040,80 @@ func (_c *MockRootCoordClient_CreateCollection_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// CreateCollectionTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CreateCollectionTemplate(ctx context.Context, in *rootcoordpb.CreateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCollectionTemplate")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateCollectionTemplateRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateCollectionTemplateRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CreateCollectionTemplateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_CreateCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollectionTemplate'
+type MockRootCoordClient_CreateCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateCollectionTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.CreateCollectionTemplateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) CreateCollectionTemplate(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateCollectionTemplate_Call {
+	return &MockRootCoordClient_CreateCollectionTemplate_Call{Call: _e.mock.On("CreateCollectionTemplate",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_CreateCollectionTemplate_Call) Run(run func(ctx context.Context, in *rootcoordpb.CreateCollectionTemplateRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreateCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CreateCollectionTemplateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_CreateCollectionTemplate_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateCollectionTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_CreateCollectionTemplate_Call) RunAndReturn(run func(context.Context, *rootcoordpb.CreateCollectionTemplateRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCredential provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -2002,6 +2076,80 @@ func (_c *MockRootCoordClient_DropCollectionFunction_Call) RunAndReturn(run func
 	return _c
 }
 
+// DropCollectionTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DropCollectionTemplate(ctx context.Context, in *rootcoordpb.DropCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropCollectionTemplate")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropCollectionTemplateRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropCollectionTemplateRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.DropCollectionTemplateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_DropCollectionTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionTemplate'
+type MockRootCoordClient_DropCollectionTemplate_Call struct {
+	*mock.Call
+}
+
+// DropCollectionTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.DropCollectionTemplateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) DropCollectionTemplate(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropCollectionTemplate_Call {
+	return &MockRootCoordClient_DropCollectionTemplate_Call{Call: _e.mock.On("DropCollectionTemplate",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_DropCollectionTemplate_Call) Run(run func(ctx context.Context, in *rootcoordpb.DropCollectionTemplateRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropCollectionTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.DropCollectionTemplateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropCollectionTemplate_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropCollectionTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropCollectionTemplate_Call) RunAndReturn(run func(context.Context, *rootcoordpb.DropCollectionTemplateRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropCollectionTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -2816,6 +2964,80 @@ func (_c *MockRootCoordClient_GetTimeTickChannel_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetUsage provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) GetUsage(ctx context.Context, in *rootcoordpb.GetUsageRequest, opts ...grpc.CallOption) (*rootcoordpb.GetUsageResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsage")
+	}
+
+	var r0 *rootcoordpb.GetUsageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetUsageRequest, ...grpc.CallOption) (*rootcoordpb.GetUsageResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetUsageRequest, ...grpc.CallOption) *rootcoordpb.GetUsageResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetUsageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetUsageRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_GetUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsage'
+type MockRootCoordClient_GetUsage_Call struct {
+	*mock.Call
+}
+
+// GetUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.GetUsageRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) GetUsage(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetUsage_Call {
+	return &MockRootCoordClient_GetUsage_Call{Call: _e.mock.On("GetUsage",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_GetUsage_Call) Run(run func(ctx context.Context, in *rootcoordpb.GetUsageRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetUsageRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetUsage_Call) Return(_a0 *rootcoordpb.GetUsageResponse, _a1 error) *MockRootCoordClient_GetUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetUsage_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetUsageRequest, ...grpc.CallOption) (*rootcoordpb.GetUsageResponse, error)) *MockRootCoordClient_GetUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HasCollection provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -3112,6 +3334,80 @@ func (_c *MockRootCoordClient_ListAliases_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ListCollectionTemplates provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListCollectionTemplates(ctx context.Context, in *rootcoordpb.ListCollectionTemplatesRequest, opts ...grpc.CallOption) (*rootcoordpb.ListCollectionTemplatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCollectionTemplates")
+	}
+
+	var r0 *rootcoordpb.ListCollectionTemplatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListCollectionTemplatesRequest, ...grpc.CallOption) (*rootcoordpb.ListCollectionTemplatesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListCollectionTemplatesRequest, ...grpc.CallOption) *rootcoordpb.ListCollectionTemplatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListCollectionTemplatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListCollectionTemplatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_ListCollectionTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollectionTemplates'
+type MockRootCoordClient_ListCollectionTemplates_Call struct {
+	*mock.Call
+}
+
+// ListCollectionTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.ListCollectionTemplatesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) ListCollectionTemplates(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListCollectionTemplates_Call {
+	return &MockRootCoordClient_ListCollectionTemplates_Call{Call: _e.mock.On("ListCollectionTemplates",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_ListCollectionTemplates_Call) Run(run func(ctx context.Context, in *rootcoordpb.ListCollectionTemplatesRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListCollectionTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListCollectionTemplatesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_ListCollectionTemplates_Call) Return(_a0 *rootcoordpb.ListCollectionTemplatesResponse, _a1 error) *MockRootCoordClient_ListCollectionTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_ListCollectionTemplates_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ListCollectionTemplatesRequest, ...grpc.CallOption) (*rootcoordpb.ListCollectionTemplatesResponse, error)) *MockRootCoordClient_ListCollectionTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListCredUsers provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -4000,6 +4296,80 @@ func (_c *MockRootCoordClient_SelectUser_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// SetCollectionQuotaOverride provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) SetCollectionQuotaOverride(ctx context.Context, in *rootcoordpb.SetCollectionQuotaOverrideRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCollectionQuotaOverride")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.SetCollectionQuotaOverrideRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.SetCollectionQuotaOverrideRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.SetCollectionQuotaOverrideRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_SetCollectionQuotaOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCollectionQuotaOverride'
+type MockRootCoordClient_SetCollectionQuotaOverride_Call struct {
+	*mock.Call
+}
+
+// SetCollectionQuotaOverride is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.SetCollectionQuotaOverrideRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) SetCollectionQuotaOverride(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_SetCollectionQuotaOverride_Call {
+	return &MockRootCoordClient_SetCollectionQuotaOverride_Call{Call: _e.mock.On("SetCollectionQuotaOverride",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_SetCollectionQuotaOverride_Call) Run(run func(ctx context.Context, in *rootcoordpb.SetCollectionQuotaOverrideRequest, opts ...grpc.CallOption)) *MockRootCoordClient_SetCollectionQuotaOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.SetCollectionQuotaOverrideRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_SetCollectionQuotaOverride_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_SetCollectionQuotaOverride_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_SetCollectionQuotaOverride_Call) RunAndReturn(run func(context.Context, *rootcoordpb.SetCollectionQuotaOverrideRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_SetCollectionQuotaOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShowCollectionIDs provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) ShowCollectionIDs(ctx context.Context, in *rootcoordpb.ShowCollectionIDsRequest, opts ...grpc.CallOption) (*rootcoordpb.ShowCollectionIDsResponse, error) {
 	_va := make([]interface{}, len(opts))
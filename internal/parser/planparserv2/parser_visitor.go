@@ -390,6 +390,13 @@ func (v *ParserVisitor) VisitEquality(ctx *parser.EqualityContext) interface{} {
 
 	leftExpr, rightExpr := getExpr(left), getExpr(right)
 
+	if caseExpr, ok, err := translateCaseFunctionEquality(ctx.GetOp().GetTokenType(), leftExpr, rightExpr); ok {
+		if err != nil {
+			return err
+		}
+		return caseExpr
+	}
+
 	expr, err := HandleCompare(ctx.GetOp().GetTokenType(), leftExpr, rightExpr)
 	if err != nil {
 		return err
@@ -401,6 +408,66 @@ func (v *ParserVisitor) VisitEquality(ctx *parser.EqualityContext) interface{} {
 	}
 }
 
+const (
+	lowerFunctionName = "lower"
+	upperFunctionName = "upper"
+)
+
+// translateCaseFunctionEquality translates `lower(field) == "abc"` and `upper(field) != "ABC"`
+// into the filter functions `lower_equal` and `upper_equal`, which are evaluated by querynode.
+func translateCaseFunctionEquality(op int, left, right *ExprWithType) (*ExprWithType, bool, error) {
+	callExpr, valueExpr := left.expr.GetCallExpr(), right.expr.GetValueExpr()
+	if callExpr == nil {
+		callExpr, valueExpr = right.expr.GetCallExpr(), left.expr.GetValueExpr()
+	}
+	if callExpr == nil {
+		return nil, false, nil
+	}
+	funcName := callExpr.GetFunctionName()
+	if funcName != lowerFunctionName && funcName != upperFunctionName {
+		return nil, false, nil
+	}
+
+	params := callExpr.GetFunctionParameters()
+	if len(params) != 1 || params[0].GetColumnExpr() == nil {
+		return nil, true, fmt.Errorf("function %s only accepts a single string field", funcName)
+	}
+	columnInfo := params[0].GetColumnExpr().GetInfo()
+	if !typeutil.IsStringType(columnInfo.GetDataType()) || len(columnInfo.GetNestedPath()) != 0 {
+		return nil, true, fmt.Errorf("function %s on non-string field is unsupported", funcName)
+	}
+	if valueExpr == nil || !IsString(valueExpr.GetValue()) {
+		return nil, true, fmt.Errorf("function %s can only be compared with a string literal", funcName)
+	}
+
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_CallExpr{
+			CallExpr: &planpb.CallExpr{
+				FunctionName:       funcName + "_equal",
+				FunctionParameters: []*planpb.Expr{params[0], {Expr: &planpb.Expr_ValueExpr{ValueExpr: valueExpr}}},
+			},
+		},
+	}
+	switch op {
+	case parser.PlanParserEQ:
+	case parser.PlanParserNE:
+		expr = &planpb.Expr{
+			Expr: &planpb.Expr_UnaryExpr{
+				UnaryExpr: &planpb.UnaryExpr{
+					Op:    planpb.UnaryExpr_Not,
+					Child: expr,
+				},
+			},
+		}
+	default:
+		return nil, true, fmt.Errorf("unsupported op for function %s", funcName)
+	}
+	return &ExprWithType{
+		expr:     expr,
+		dataType: schemapb.DataType_Bool,
+	}, true, nil
+}
+
 // VisitRelational translates expr to range/compare plan.
 func (v *ParserVisitor) VisitRelational(ctx *parser.RelationalContext) interface{} {
 	left := ctx.Expr(0).Accept(v)
@@ -491,16 +558,39 @@ func (v *ParserVisitor) VisitLike(ctx *parser.LikeContext) interface{} {
 		return err
 	}
 
-	return &ExprWithType{
-		expr: &planpb.Expr{
-			Expr: &planpb.Expr_UnaryRangeExpr{
-				UnaryRangeExpr: &planpb.UnaryRangeExpr{
-					ColumnInfo: column,
-					Op:         op,
-					Value:      NewString(operand),
-				},
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: column,
+				Op:         op,
+				Value:      NewString(operand),
 			},
 		},
+	}
+	// pattern anchored by a literal prefix, e.g. `abc%d_f`, is filtered by prefix first,
+	// so that the scalar index could be used to narrow down the candidates.
+	if prefix := extractLikePrefix(pattern); op == planpb.OpType_Match && prefix != "" && typeutil.IsStringType(leftExpr.dataType) {
+		expr = &planpb.Expr{
+			Expr: &planpb.Expr_BinaryExpr{
+				BinaryExpr: &planpb.BinaryExpr{
+					Left: &planpb.Expr{
+						Expr: &planpb.Expr_UnaryRangeExpr{
+							UnaryRangeExpr: &planpb.UnaryRangeExpr{
+								ColumnInfo: column,
+								Op:         planpb.OpType_PrefixMatch,
+								Value:      NewString(prefix),
+							},
+						},
+					},
+					Right: expr,
+					Op:    planpb.BinaryExpr_LogicalAnd,
+				},
+			},
+		}
+	}
+
+	return &ExprWithType{
+		expr:     expr,
 		dataType: schemapb.DataType_Bool,
 	}
 }
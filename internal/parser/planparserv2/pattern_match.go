@@ -22,26 +22,6 @@ func optimizeLikePattern(pattern string) (planpb.OpType, string, bool) {
 		return planpb.OpType_PrefixMatch, "", true
 	}
 
-	process := func(s string) (string, bool) {
-		var buf strings.Builder
-		for i := 0; i < len(s); i++ {
-			c := s[i]
-			if c == escapeCharacter && i+1 < len(s) {
-				next := s[i+1]
-				if _, ok := wildcards[next]; ok {
-					buf.WriteByte(next)
-					i++
-					continue
-				}
-			}
-			if _, ok := wildcards[c]; ok {
-				return "", false
-			}
-			buf.WriteByte(c)
-		}
-		return buf.String(), true
-	}
-
 	leading := pattern[0] == '%'
 	trailing := pattern[len(pattern)-1] == '%'
 
@@ -50,7 +30,7 @@ func optimizeLikePattern(pattern string) (planpb.OpType, string, bool) {
 		inner := pattern[1 : len(pattern)-1]
 		trimmed := strings.TrimLeft(inner, "%")
 		trimmed = strings.TrimRight(trimmed, "%")
-		if subStr, valid := process(trimmed); valid {
+		if subStr, valid := unescapeLikeLiteral(trimmed); valid {
 			// if subStr is empty, it means the pattern is all %,
 			// return prefix match and empty operand, means all match
 			if len(subStr) == 0 {
@@ -60,16 +40,16 @@ func optimizeLikePattern(pattern string) (planpb.OpType, string, bool) {
 		}
 	case leading:
 		trimmed := strings.TrimLeft(pattern[1:], "%")
-		if subStr, valid := process(trimmed); valid {
+		if subStr, valid := unescapeLikeLiteral(trimmed); valid {
 			return planpb.OpType_PostfixMatch, subStr, true
 		}
 	case trailing:
 		trimmed := strings.TrimRight(pattern[:len(pattern)-1], "%")
-		if subStr, valid := process(trimmed); valid {
+		if subStr, valid := unescapeLikeLiteral(trimmed); valid {
 			return planpb.OpType_PrefixMatch, subStr, true
 		}
 	default:
-		if subStr, valid := process(pattern); valid {
+		if subStr, valid := unescapeLikeLiteral(pattern); valid {
 			return planpb.OpType_Equal, subStr, true
 		}
 	}
@@ -85,3 +65,30 @@ func translatePatternMatch(pattern string) (op planpb.OpType, operand string, er
 
 	return planpb.OpType_Match, pattern, nil
 }
+
+// extractLikePrefix returns the literal prefix before the first wildcard of the pattern.
+func extractLikePrefix(pattern string) string {
+	prefix, _ := unescapeLikeLiteral(pattern)
+	return prefix
+}
+
+// unescapeLikeLiteral unescapes the literal of the pattern before the first unescaped wildcard,
+// the bool result reports whether the whole pattern is literal.
+func unescapeLikeLiteral(pattern string) (string, bool) {
+	var buf strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c == escapeCharacter && i+1 < len(pattern) {
+			if _, ok := wildcards[pattern[i+1]]; ok {
+				buf.WriteByte(pattern[i+1])
+				i++
+				continue
+			}
+		}
+		if _, ok := wildcards[c]; ok {
+			return buf.String(), false
+		}
+		buf.WriteByte(c)
+	}
+	return buf.String(), true
+}
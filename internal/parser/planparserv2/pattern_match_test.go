@@ -121,3 +121,24 @@ func TestOptimizeLikePattern(t *testing.T) {
 		}
 	}
 }
+
+func TestExtractLikePrefix(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		expected string
+	}{
+		{"abc%", "abc"},
+		{"abc_d%", "abc"},
+		{"a\\%bc%", "a%bc"},
+		{"a\\_bc_", "a_bc"},
+		{"%abc", ""},
+		{"abc", "abc"},
+		{"abc\\", "abc\\"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		if actual := extractLikePrefix(test.pattern); actual != test.expected {
+			t.Errorf("extractLikePrefix(%q) = %q, expected %q", test.pattern, actual, test.expected)
+		}
+	}
+}
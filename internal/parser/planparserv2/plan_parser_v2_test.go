@@ -265,6 +265,54 @@ func TestExpr_Like(t *testing.T) {
 	assert.Equal(t, `8%-0`, plan.GetVectorAnns().GetPredicates().GetUnaryRangeExpr().GetValue().GetStringVal())
 }
 
+func TestExpr_LikeWithPrefix(t *testing.T) {
+	schema := newTestSchema(true)
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr, err := ParseExpr(helper, `VarCharField like "ab\\_c_d%"`, nil)
+	assert.NoError(t, err)
+	binaryExpr := expr.GetBinaryExpr()
+	assert.NotNil(t, binaryExpr)
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, binaryExpr.GetOp())
+	assert.Equal(t, planpb.OpType_PrefixMatch, binaryExpr.GetLeft().GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, "ab_c", binaryExpr.GetLeft().GetUnaryRangeExpr().GetValue().GetStringVal())
+	assert.Equal(t, planpb.OpType_Match, binaryExpr.GetRight().GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, `ab\_c_d%`, binaryExpr.GetRight().GetUnaryRangeExpr().GetValue().GetStringVal())
+
+	// no literal prefix, keep the pattern match
+	expr, err = ParseExpr(helper, `VarCharField like "_abc%d"`, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.OpType_Match, expr.GetUnaryRangeExpr().GetOp())
+}
+
+func TestExpr_CaseFunction(t *testing.T) {
+	schema := newTestSchema(true)
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr, err := ParseExpr(helper, `lower(VarCharField) == "abc"`, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "lower_equal", expr.GetCallExpr().GetFunctionName())
+	assert.Equal(t, 2, len(expr.GetCallExpr().GetFunctionParameters()))
+	assert.Equal(t, "abc", expr.GetCallExpr().GetFunctionParameters()[1].GetValueExpr().GetValue().GetStringVal())
+
+	expr, err = ParseExpr(helper, `"ABC" != upper(VarCharField)`, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.UnaryExpr_Not, expr.GetUnaryExpr().GetOp())
+	assert.Equal(t, "upper_equal", expr.GetUnaryExpr().GetChild().GetCallExpr().GetFunctionName())
+
+	invalidExprs := []string{
+		`lower(Int64Field) == "abc"`,
+		`lower(VarCharField) == 1`,
+		`lower(VarCharField, StringField) == "abc"`,
+		`upper(VarCharField) == StringField`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
 func TestExpr_TextMatch(t *testing.T) {
 	schema := newTestSchema(true)
 	helper, err := typeutil.CreateSchemaHelper(schema)
@@ -1279,6 +1327,24 @@ func Test_JSONExpr(t *testing.T) {
 	}
 }
 
+func Test_JSONNestedPathExpr(t *testing.T) {
+	schema := newTestSchemaHelper(t)
+
+	expr, err := ParseExpr(schema, `JSONField["a"]["b"] > 5`, nil)
+	assert.NoError(t, err)
+	unaryRange := expr.GetUnaryRangeExpr()
+	assert.NotNil(t, unaryRange)
+	assert.Equal(t, schemapb.DataType_JSON, unaryRange.GetColumnInfo().GetDataType())
+	assert.Equal(t, []string{"a", "b"}, unaryRange.GetColumnInfo().GetNestedPath())
+	assert.Equal(t, planpb.OpType_GreaterThan, unaryRange.GetOp())
+	assert.Equal(t, int64(5), unaryRange.GetValue().GetInt64Val())
+
+	expr, err = ParseExpr(schema, `JSONField["a"]["b"]["c"] in ["x", "y"]`, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, expr.GetTermExpr().GetColumnInfo().GetNestedPath())
+	assert.Equal(t, 2, len(expr.GetTermExpr().GetValues()))
+}
+
 func Test_InvalidExprOnJSONField(t *testing.T) {
 	schema := newTestSchemaHelper(t)
 	expr := ""
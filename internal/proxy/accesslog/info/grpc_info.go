@@ -18,6 +18,7 @@ package info
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"path"
 	"strings"
@@ -366,6 +367,20 @@ func (i *GrpcAccessInfo) ClientRequestTime() string {
 	return time.UnixMilli(unixmsec).Format(timeFormat)
 }
 
+// ClientTags returns the user-defined tags of the request,
+// which are passed via grpc metadata with the "client-tag-" prefix.
+func (i *GrpcAccessInfo) ClientTags() string {
+	tags := logutil.GetClientTags(i.ctx)
+	if len(tags) == 0 {
+		return NotAny
+	}
+	v, err := json.Marshal(tags)
+	if err != nil {
+		return Unknown
+	}
+	return string(v)
+}
+
 func (i *GrpcAccessInfo) SetActualConsistencyLevel(acl commonpb.ConsistencyLevel) {
 	i.actualConsistencyLevel = &acl
 }
@@ -26,6 +26,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/baggage"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -296,6 +297,17 @@ func (s *GrpcAccessInfoSuite) TestClientRequestTime() {
 	s.NotEqual(Unknown, Get(s.info, "$client_request_time")[0])
 }
 
+func (s *GrpcAccessInfoSuite) TestClientTags() {
+	s.Equal(NotAny, Get(s.info, "$client_tags")[0])
+
+	member, err := baggage.NewMemberRaw(common.ClientTagKeyPrefix+"app", "recommender")
+	s.Require().NoError(err)
+	bag, err := baggage.New(member)
+	s.Require().NoError(err)
+	s.info.ctx = baggage.ContextWithBaggage(context.Background(), bag)
+	s.Equal(`{"app":"recommender"}`, Get(s.info, "$client_tags")[0])
+}
+
 func (s *GrpcAccessInfoSuite) TestTemplateValueLength() {
 	// params := []*commonpb.KeyValuePair{{Key: "test_key", Value: "test_value"}}
 	exprTemplValues := map[string]*schemapb.TemplateValue{
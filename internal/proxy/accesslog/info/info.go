@@ -56,6 +56,7 @@ var MetricFuncMap = map[string]getMetricFunc{
 	"$query_params":          getQueryParams,
 	"$client_request_time":   getClientRequestTime,
 	"$template_value_length": getTemplateValueLength,
+	"$client_tags":           getClientTags,
 }
 
 type AccessInfo interface {
@@ -85,6 +86,7 @@ type AccessInfo interface {
 	QueryParams() string
 	ClientRequestTime() string
 	TemplateValueLength() string
+	ClientTags() string
 	SetActualConsistencyLevel(commonpb.ConsistencyLevel)
 }
 
@@ -208,3 +210,7 @@ func getClientRequestTime(i AccessInfo) string {
 func getTemplateValueLength(i AccessInfo) string {
 	return i.TemplateValueLength()
 }
+
+func getClientTags(i AccessInfo) string {
+	return i.ClientTags()
+}
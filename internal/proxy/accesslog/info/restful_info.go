@@ -269,6 +269,10 @@ func (i *RestfulInfo) ClientRequestTime() string {
 	return Unknown
 }
 
+func (i *RestfulInfo) ClientTags() string {
+	return NotAny
+}
+
 func (i *RestfulInfo) SetActualConsistencyLevel(acl commonpb.ConsistencyLevel) {
 	i.actualConsistencyLevel = &acl
 }
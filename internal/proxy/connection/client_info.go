@@ -11,13 +11,16 @@ import (
 type clientInfo struct {
 	*commonpb.ClientInfo
 	identifier     int64
+	remoteAddr     string
 	lastActiveTime time.Time
+	stats          *sessionStats
 }
 
 func (c *clientInfo) GetLogger() []zap.Field {
 	fields := ZapClientInfo(c.ClientInfo)
 	fields = append(fields,
 		zap.Int64("identifier", c.identifier),
+		zap.String("remote_addr", c.remoteAddr),
 		zap.Time("last_active_time", c.lastActiveTime),
 	)
 	return fields
@@ -11,6 +11,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -23,6 +24,8 @@ type connectionManager struct {
 	wg          sync.WaitGroup
 
 	clientInfos *typeutil.ConcurrentMap[int64, clientInfo]
+	// identifier -> the time the session is killed
+	killedSessions *typeutil.ConcurrentMap[int64, time.Time]
 }
 
 func (s *connectionManager) init() {
@@ -52,6 +55,7 @@ func (s *connectionManager) checkLoop() {
 			return
 		case <-t.C:
 			s.removeLongInactiveClients()
+			s.removeInactiveCursors()
 			// not sure if we should purge them periodically.
 			s.purgeIfNumOfClientsExceed()
 			t.Reset(paramtable.Get().ProxyCfg.ConnectionCheckIntervalSeconds.GetAsDuration(time.Second))
@@ -99,7 +103,9 @@ func (s *connectionManager) Register(ctx context.Context, identifier int64, info
 	cli := clientInfo{
 		ClientInfo:     info,
 		identifier:     identifier,
+		remoteAddr:     getRemoteAddrFromContext(ctx),
 		lastActiveTime: time.Now(),
+		stats:          newSessionStats(),
 	}
 
 	s.clientInfos.Insert(identifier, cli)
@@ -121,6 +127,9 @@ func (s *connectionManager) List() []*commonpb.ClientInfo {
 			}
 			client.Reserved["identifier"] = string(strconv.AppendInt(nil, identifier, 10))
 			client.Reserved["last_active_time"] = info.lastActiveTime.String()
+			client.Reserved["remote_addr"] = info.remoteAddr
+			client.Reserved["in_flight_requests"] = strconv.FormatInt(info.stats.inFlight.Load(), 10)
+			client.Reserved["open_cursors"] = strconv.Itoa(info.stats.openCursors())
 
 			clients = append(clients, client)
 		}
@@ -130,6 +139,95 @@ func (s *connectionManager) List() []*commonpb.ClientInfo {
 	return clients
 }
 
+// ListSessions returns the sessions of the connected clients.
+func (s *connectionManager) ListSessions() []*ClientSession {
+	sessions := make([]*ClientSession, 0, s.clientInfos.Len())
+	s.clientInfos.Range(func(identifier int64, info clientInfo) bool {
+		sessions = append(sessions, &ClientSession{
+			Identifier:       identifier,
+			User:             info.GetUser(),
+			SdkType:          info.GetSdkType(),
+			SdkVersion:       info.GetSdkVersion(),
+			Host:             info.GetHost(),
+			RemoteAddr:       info.remoteAddr,
+			LastActiveTime:   info.lastActiveTime,
+			InFlightRequests: info.stats.inFlight.Load(),
+			OpenCursors:      info.stats.openCursors(),
+			Reserved:         info.GetReserved(),
+		})
+		return true
+	})
+	return sessions
+}
+
+// Kill removes the session of the client and cancels its in-flight requests,
+// the following requests of the session are rejected, the client has to connect again to get a new session.
+func (s *connectionManager) Kill(ctx context.Context, identifier int64) error {
+	info, ok := s.clientInfos.GetAndRemove(identifier)
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("client session %d not found", identifier)
+	}
+	s.killedSessions.Insert(identifier, time.Now())
+	info.stats.cancelRequests(merr.WrapErrServiceSessionKilled(identifier))
+	log.Ctx(ctx).Info("client session killed", info.GetLogger()...)
+	return nil
+}
+
+// IsKilled returns whether the session of the identifier is killed.
+func (s *connectionManager) IsKilled(identifier int64) bool {
+	return s.killedSessions.Contain(identifier)
+}
+
+// BeginRequest records the in-flight request of the session, the request is canceled with the cancel function
+// if the session is killed. The returned function shall be invoked once the request is done.
+func (s *connectionManager) BeginRequest(identifier int64, cancel context.CancelCauseFunc) func() {
+	info, ok := s.clientInfos.Get(identifier)
+	if !ok {
+		return func() {}
+	}
+	return info.stats.beginRequest(cancel)
+}
+
+// TouchCursor records the iterator of the session which is identified by the session ts.
+func (s *connectionManager) TouchCursor(ctx context.Context, sessionTs uint64) {
+	identifier, err := GetIdentifierFromContext(ctx)
+	if err != nil {
+		return
+	}
+	info, ok := s.clientInfos.Get(identifier)
+	if !ok {
+		return
+	}
+	info.stats.touchCursor(sessionTs)
+}
+
+// UpdateLastWriteTs records the timestamp of the last write of the session on the collection,
+// which is used as the guarantee timestamp of the reads with session consistency.
+func (s *connectionManager) UpdateLastWriteTs(ctx context.Context, collectionID int64, ts uint64) {
+	identifier, err := GetIdentifierFromContext(ctx)
+	if err != nil {
+		return
+	}
+	info, ok := s.clientInfos.Get(identifier)
+	if !ok {
+		return
+	}
+	info.stats.updateLastWriteTs(collectionID, ts)
+}
+
+// GetLastWriteTs returns the timestamp of the last write of the session on the collection, 0 if unknown.
+func (s *connectionManager) GetLastWriteTs(ctx context.Context, collectionID int64) uint64 {
+	identifier, err := GetIdentifierFromContext(ctx)
+	if err != nil {
+		return 0
+	}
+	info, ok := s.clientInfos.Get(identifier)
+	if !ok {
+		return 0
+	}
+	return info.stats.getLastWriteTs(collectionID)
+}
+
 func (s *connectionManager) Get(ctx context.Context) *commonpb.ClientInfo {
 	identifier, err := GetIdentifierFromContext(ctx)
 	if err != nil {
@@ -160,12 +258,28 @@ func (s *connectionManager) removeLongInactiveClients() {
 		}
 		return true
 	})
+	// the killed identifier is not reused once the client connects again
+	s.killedSessions.Range(func(identifier int64, killedTime time.Time) bool {
+		if time.Since(killedTime) > ttl {
+			s.killedSessions.Remove(identifier)
+		}
+		return true
+	})
+}
+
+func (s *connectionManager) removeInactiveCursors() {
+	ttl := paramtable.Get().ProxyCfg.ConnectionCursorTTLSeconds.GetAsDuration(time.Second)
+	s.clientInfos.Range(func(identifier int64, info clientInfo) bool {
+		info.stats.removeInactiveCursors(ttl)
+		return true
+	})
 }
 
 func newConnectionManager() *connectionManager {
 	s := &connectionManager{
-		closeSignal: make(chan struct{}, 1),
-		clientInfos: typeutil.NewConcurrentMap[int64, clientInfo](),
+		closeSignal:    make(chan struct{}, 1),
+		clientInfos:    typeutil.NewConcurrentMap[int64, clientInfo](),
+		killedSessions: typeutil.NewConcurrentMap[int64, time.Time](),
 	}
 	s.init()
 
@@ -2,12 +2,16 @@ package connection
 
 import (
 	"context"
+	"net"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
@@ -65,3 +69,62 @@ func TestConnectionManager_Purge(t *testing.T) {
 		return s.clientInfos.Len() <= 2
 	}, time.Second*5, time.Second)
 }
+
+func TestConnectionManager_Session(t *testing.T) {
+	paramtable.Init()
+
+	s := newConnectionManager()
+	defer s.Stop()
+
+	ctx := peer.NewContext(context.TODO(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 19530}})
+	s.Register(ctx, 1, &commonpb.ClientInfo{User: "root", SdkType: "python"})
+
+	reqCtx, cancel := context.WithCancelCause(context.TODO())
+	defer cancel(nil)
+	done := s.BeginRequest(1, cancel)
+	ctx = metadata.NewIncomingContext(context.TODO(), metadata.New(map[string]string{"identifier": "1"}))
+	s.TouchCursor(ctx, 100)
+	s.TouchCursor(ctx, 100)
+	s.TouchCursor(ctx, 101)
+
+	sessions := s.ListSessions()
+	assert.Len(t, sessions, 1)
+	assert.EqualValues(t, 1, sessions[0].Identifier)
+	assert.Equal(t, "root", sessions[0].User)
+	assert.Equal(t, "127.0.0.1:19530", sessions[0].RemoteAddr)
+	assert.EqualValues(t, 1, sessions[0].InFlightRequests)
+	assert.Equal(t, 2, sessions[0].OpenCursors)
+	assert.Equal(t, "1", s.List()[0].GetReserved()["in_flight_requests"])
+
+	done()
+	assert.EqualValues(t, 0, s.ListSessions()[0].InFlightRequests)
+	inFlightCtx, inFlightCancel := context.WithCancelCause(context.TODO())
+	defer inFlightCancel(nil)
+	s.BeginRequest(1, inFlightCancel)
+
+	pt := paramtable.Get()
+	pt.Save(pt.ProxyCfg.ConnectionCursorTTLSeconds.Key, "0")
+	defer pt.Reset(pt.ProxyCfg.ConnectionCursorTTLSeconds.Key)
+	time.Sleep(time.Millisecond)
+	s.removeInactiveCursors()
+	assert.Equal(t, 0, s.ListSessions()[0].OpenCursors)
+
+	assert.EqualValues(t, 0, s.GetLastWriteTs(ctx, 1000))
+	s.UpdateLastWriteTs(ctx, 1000, 200)
+	s.UpdateLastWriteTs(ctx, 1000, 100)
+	assert.EqualValues(t, 200, s.GetLastWriteTs(ctx, 1000))
+	assert.EqualValues(t, 0, s.GetLastWriteTs(ctx, 1001))
+	// unknown session
+	s.UpdateLastWriteTs(context.TODO(), 1000, 300)
+	assert.EqualValues(t, 0, s.GetLastWriteTs(context.TODO(), 1000))
+
+	assert.Error(t, s.Kill(context.TODO(), 2))
+	assert.NoError(t, s.Kill(context.TODO(), 1))
+	assert.True(t, s.IsKilled(1))
+	// the in-flight request is canceled while the done one is not
+	assert.ErrorIs(t, context.Cause(inFlightCtx), merr.ErrServiceSessionKilled)
+	assert.NoError(t, reqCtx.Err())
+	assert.Empty(t, s.ListSessions())
+	// no-op for the unknown session
+	s.BeginRequest(1, cancel)()
+}
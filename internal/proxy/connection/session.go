@@ -0,0 +1,105 @@
+package connection
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+// ClientSession is the observable state of a connected client.
+type ClientSession struct {
+	Identifier       int64             `json:"identifier,string"`
+	User             string            `json:"user,omitempty"`
+	SdkType          string            `json:"sdk_type,omitempty"`
+	SdkVersion       string            `json:"sdk_version,omitempty"`
+	Host             string            `json:"host,omitempty"`
+	RemoteAddr       string            `json:"remote_addr,omitempty"`
+	LastActiveTime   time.Time         `json:"last_active_time"`
+	InFlightRequests int64             `json:"in_flight_requests"`
+	OpenCursors      int               `json:"open_cursors"`
+	Reserved         map[string]string `json:"reserved,omitempty"`
+}
+
+// sessionStats is the runtime stats of a client session.
+type sessionStats struct {
+	inFlight atomic.Int64
+
+	mu          sync.Mutex
+	cursors     map[uint64]time.Time              // session ts of the iterator -> last active time
+	lastWriteTs map[int64]uint64                  // collection id -> timestamp of the last write
+	nextID      int64                             // id of the next in-flight request
+	requests    map[int64]context.CancelCauseFunc // the cancel functions of the in-flight requests
+}
+
+func newSessionStats() *sessionStats {
+	return &sessionStats{
+		cursors:     make(map[uint64]time.Time),
+		lastWriteTs: make(map[int64]uint64),
+		requests:    make(map[int64]context.CancelCauseFunc),
+	}
+}
+
+// beginRequest records the cancel function of the in-flight request, the returned function removes it.
+func (s *sessionStats) beginRequest(cancel context.CancelCauseFunc) func() {
+	s.inFlight.Inc()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	id := s.nextID
+	s.requests[id] = cancel
+	return func() {
+		s.inFlight.Dec()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.requests, id)
+	}
+}
+
+// cancelRequests cancels all the in-flight requests with the cause.
+func (s *sessionStats) cancelRequests(cause error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, cancel := range s.requests {
+		cancel(cause)
+	}
+}
+
+func (s *sessionStats) updateLastWriteTs(collectionID int64, ts uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ts > s.lastWriteTs[collectionID] {
+		s.lastWriteTs[collectionID] = ts
+	}
+}
+
+func (s *sessionStats) getLastWriteTs(collectionID int64) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastWriteTs[collectionID]
+}
+
+func (s *sessionStats) touchCursor(sessionTs uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cursors[sessionTs] = time.Now()
+}
+
+func (s *sessionStats) openCursors() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.cursors)
+}
+
+// removeInactiveCursors removes the cursors which are not used within the ttl,
+// the iterators don't notify the server when they are closed.
+func (s *sessionStats) removeInactiveCursors(ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sessionTs, lastActiveTime := range s.cursors {
+		if time.Since(lastActiveTime) > ttl {
+			delete(s.cursors, sessionTs)
+		}
+	}
+}
@@ -4,15 +4,18 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 )
 
 func ZapClientInfo(info *commonpb.ClientInfo) []zap.Field {
@@ -47,13 +50,33 @@ func GetIdentifierFromContext(ctx context.Context) (int64, error) {
 	return identifier, nil
 }
 
+func getRemoteAddrFromContext(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	return p.Addr.String()
+}
+
 func KeepActiveInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	identifier, identifierErr := GetIdentifierFromContext(ctx)
+	if identifierErr == nil {
+		// the client shall connect again to get a new session once it's killed.
+		if GetManager().IsKilled(identifier) && !strings.HasSuffix(info.FullMethod, "/Connect") {
+			return nil, merr.WrapErrServiceSessionKilled(identifier)
+		}
+		var cancel context.CancelCauseFunc
+		ctx, cancel = context.WithCancelCause(ctx)
+		defer cancel(nil)
+		done := GetManager().BeginRequest(identifier, cancel)
+		defer done()
+	}
+
 	// We shouldn't block the normal rpc. though this may be not very accurate enough.
 	// On the other hand, too many goroutines will also influence the rpc.
 	// Not sure which way is better, since actually we already make the `keepActive` asynchronous.
 	go func() {
-		identifier, err := GetIdentifierFromContext(ctx)
-		if err == nil && funcutil.CheckCtxValid(ctx) {
+		if identifierErr == nil && funcutil.CheckCtxValid(ctx) {
 			GetManager().KeepActive(identifier)
 		}
 	}()
@@ -7,6 +7,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
 func Test_getIdentifierFromContext(t *testing.T) {
@@ -63,3 +67,44 @@ func TestKeepActiveInterceptor(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "not-important", got)
 }
+
+func TestKeepActiveInterceptor_Killed(t *testing.T) {
+	paramtable.Init()
+
+	GetManager().Register(context.TODO(), 20251015, &commonpb.ClientInfo{})
+	assert.NoError(t, GetManager().Kill(context.TODO(), 20251015))
+
+	md := metadata.New(map[string]string{
+		"identifier": "20251015",
+	})
+	ctx := metadata.NewIncomingContext(context.TODO(), md)
+	var handler grpc.UnaryHandler = func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "not-important", nil
+	}
+
+	_, err := KeepActiveInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Search"}, handler)
+	assert.ErrorIs(t, err, merr.ErrServiceSessionKilled)
+
+	// the client is able to connect again
+	got, err := KeepActiveInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Connect"}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "not-important", got)
+}
+
+func TestKeepActiveInterceptor_KillInFlight(t *testing.T) {
+	paramtable.Init()
+
+	GetManager().Register(context.TODO(), 20251016, &commonpb.ClientInfo{})
+
+	md := metadata.New(map[string]string{
+		"identifier": "20251016",
+	})
+	ctx := metadata.NewIncomingContext(context.TODO(), md)
+	var handler grpc.UnaryHandler = func(ctx context.Context, req interface{}) (interface{}, error) {
+		assert.NoError(t, GetManager().Kill(context.TODO(), 20251016))
+		return nil, context.Cause(ctx)
+	}
+
+	_, err := KeepActiveInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Search"}, handler)
+	assert.ErrorIs(t, err, merr.ErrServiceSessionKilled)
+}
@@ -68,7 +68,10 @@ func (r *defaultLimitReducer) afterReduce(result *milvuspb.QueryResults) error {
 		result.FieldsData[i].IsDynamic = field.GetIsDynamic()
 	}
 
-	result.Status = merr.Status(err)
+	// keep the status set by reduce, which may carry the truncation info
+	if err != nil || result.Status == nil {
+		result.Status = merr.Status(err)
+	}
 	return err
 }
 
@@ -193,6 +193,28 @@ func buildReqParams(c *gin.Context, metricsType string, customParams ...*commonp
 	return ret
 }
 
+func getRootComponentMetrics(node *Proxy, metricsType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		params := buildReqParams(c, metricsType, metricsinfo.RequestProcessInRCRole)
+		req, err := metricsinfo.ConstructGetMetricsRequest(params)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				mhttp.HTTPReturnMessage: err.Error(),
+			})
+			return
+		}
+
+		resp, err := node.mixCoord.GetMetrics(c, req)
+		if err := merr.CheckRPCCall(resp, err); err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				mhttp.HTTPReturnMessage: err.Error(),
+			})
+			return
+		}
+		c.Data(http.StatusOK, contentType, []byte(resp.GetResponse()))
+	}
+}
+
 func getQueryComponentMetrics(node *Proxy, metricsType string, customParams ...*commonpb.KeyValuePair) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		params := buildReqParams(c, metricsType, metricsinfo.RequestProcessInQCRole)
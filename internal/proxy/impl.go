@@ -27,6 +27,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 	"github.com/tidwall/gjson"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
@@ -56,6 +57,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/crypto"
@@ -140,7 +142,8 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 				}
 			}
 			if collectionName != "" {
-				globalMetaCache.RemoveCollection(ctx, request.GetDbName(), collectionName) // no need to return error, though collection may be not cached
+				// the collection name may be an alias, the stale describe responses in flight shall not be cached for it
+				globalMetaCache.ExpireCollectionName(ctx, request.GetDbName(), collectionName, request.GetBase().GetTimestamp())
 				node.shardMgr.DeprecateShardCache(request.GetDbName(), collectionName)
 			}
 			log.Info("complete to invalidate collection meta cache with collection name", zap.String("type", request.GetBase().GetMsgType().String()))
@@ -554,9 +557,57 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 		method,
 	).Observe(float64(tr.ElapseSpan().Milliseconds()))
 
+	if cct.template != nil && merr.Ok(cct.result) {
+		if err := node.createTemplateIndexes(ctx, request, cct.template); err != nil {
+			log.Warn("failed to create the default indexes of collection template", zap.String("template", cct.template.GetName()), zap.Error(err))
+			return merr.Status(node.rollbackTemplateCollection(ctx, request, err)), nil
+		}
+	}
 	return cct.result, nil
 }
 
+// createTemplateIndexes creates the default indexes of the collection template after the collection is created.
+func (node *Proxy) createTemplateIndexes(ctx context.Context, request *milvuspb.CreateCollectionRequest, template *rootcoordpb.CollectionTemplate) error {
+	for _, index := range template.GetIndexParams() {
+		status, err := node.CreateIndex(ctx, &milvuspb.CreateIndexRequest{
+			DbName:         request.GetDbName(),
+			CollectionName: request.GetCollectionName(),
+			FieldName:      index.GetFieldName(),
+			IndexName:      index.GetIndexName(),
+			ExtraParams:    index.GetParams(),
+		})
+		if err := merr.CheckRPCCall(status, err); err != nil {
+			return errors.Wrapf(err, "failed to create the index of field %s from template %s", index.GetFieldName(), template.GetName())
+		}
+	}
+	return nil
+}
+
+// rollbackTemplateCollection drops the collection whose template indexes failed to be created,
+// so the creation from template is all or nothing. The partial state is reported if the rollback fails.
+func (node *Proxy) rollbackTemplateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest, indexErr error) error {
+	status, err := node.DropCollection(ctx, &milvuspb.DropCollectionRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+	})
+	if err := merr.CheckRPCCall(status, err); err != nil {
+		log.Ctx(ctx).Warn("failed to roll back the collection created from template", zap.String("collection", request.GetCollectionName()), zap.Error(err))
+		return errors.Wrapf(indexErr, "collection %s is created without the template indexes and failed to be rolled back (%s), drop it or create the indexes manually",
+			request.GetCollectionName(), err.Error())
+	}
+	return errors.Wrapf(indexErr, "collection %s is rolled back", request.GetCollectionName())
+}
+
+// CreateCollectionFromTemplate creates a collection with the schema skeleton, default indexes and properties of the template,
+// the given properties take precedence over the ones of the template.
+func (node *Proxy) CreateCollectionFromTemplate(ctx context.Context, request *milvuspb.CreateCollectionRequest, templateName string) (*commonpb.Status, error) {
+	request = typeutil.Clone(request)
+	request.Properties = append(lo.Filter(request.GetProperties(), func(kv *commonpb.KeyValuePair, _ int) bool {
+		return kv.GetKey() != common.CollectionTemplateKey
+	}), &commonpb.KeyValuePair{Key: common.CollectionTemplateKey, Value: templateName})
+	return node.CreateCollection(ctx, request)
+}
+
 // DropCollection drop a collection.
 func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
@@ -1994,11 +2045,27 @@ func (node *Proxy) GetLoadState(ctx context.Context, request *milvuspb.GetLoadSt
 			}, nil
 		}
 	}
-	if progress >= 100 {
-		successResponse.State = commonpb.LoadState_LoadStateLoaded
-	} else {
+	if progress < 100 {
 		successResponse.State = commonpb.LoadState_LoadStateLoading
+		return successResponse, nil
+	}
+	// the collection is reported as loading until the flushed segments are fully indexed,
+	// otherwise the search on the collection may fall back to brute force on the unindexed segments.
+	if paramtable.Get().ProxyCfg.LoadStateRequireIndex.GetAsBool() {
+		completeness, err := getIndexCompleteness(ctx, node.mixCoord, collectionID)
+		if err != nil {
+			return getErrResponse(err), nil
+		}
+		if !completeness.Complete {
+			log.Info("collection is loaded but not fully indexed",
+				zap.Int64("collectionID", collectionID),
+				zap.Int("unindexedSegmentNum", len(completeness.UnindexedSegments)),
+				zap.String("estimatedTime", completeness.EstimatedTime))
+			successResponse.State = commonpb.LoadState_LoadStateLoading
+			return successResponse, nil
+		}
 	}
+	successResponse.State = commonpb.LoadState_LoadStateLoaded
 	return successResponse, nil
 }
 
@@ -2542,7 +2609,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	// InsertCnt always equals to the number of entities in the request
 	it.result.InsertCnt = int64(request.NumRows)
 
-	rateCol.Add(internalpb.RateType_DMLInsert.String(), float64(it.insertMsg.Size()))
+	rateCol.Add(internalpb.RateType_DMLInsert.String(), float64(it.insertMsg.Size()), GetCollectionRateSubLabel(request), GetUserRateSubLabel(ctx))
 
 	successCnt := it.result.InsertCnt - int64(len(it.result.ErrIndex))
 	username := GetCurUserFromContextOrDefault(ctx)
@@ -2565,12 +2632,16 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	metrics.ProxyInsertVectors.
 		WithLabelValues(nodeID, dbName, collectionName).
 		Add(float64(successCnt))
-	metrics.ProxyMutationLatency.
-		WithLabelValues(nodeID, metrics.InsertLabel, dbName, collectionName).
-		Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.ObserveWithTrace(ctx, metrics.ProxyMutationLatency.
+		WithLabelValues(nodeID, metrics.InsertLabel, dbName, collectionName),
+		float64(tr.ElapseSpan().Milliseconds()))
 	metrics.ProxyCollectionMutationLatency.
 		WithLabelValues(nodeID, metrics.InsertLabel, dbName, collectionName).
 		Observe(float64(tr.ElapseSpan().Milliseconds()))
+	if merr.Ok(it.result.GetStatus()) {
+		connection.GetManager().UpdateLastWriteTs(ctx, it.collectionID, it.result.GetTimestamp())
+	}
+	setMutationResultDetail(ctx, it.result, it.result.GetIDs(), false)
 	return it.result, nil
 }
 
@@ -2638,7 +2709,7 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	}
 
 	receiveSize := proto.Size(dr.req)
-	rateCol.Add(internalpb.RateType_DMLDelete.String(), float64(receiveSize))
+	rateCol.Add(internalpb.RateType_DMLDelete.String(), float64(receiveSize), GetCollectionRateSubLabel(request), GetUserRateSubLabel(ctx))
 
 	successCnt := dr.result.GetDeleteCnt()
 
@@ -2670,10 +2741,14 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 		metrics.ProxyReportValue.WithLabelValues(nodeID, hookutil.OpTypeDelete, dbName, username).Add(float64(v))
 	}
 
-	metrics.ProxyMutationLatency.
-		WithLabelValues(nodeID, metrics.DeleteLabel, dbName, collectionName).
-		Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.ObserveWithTrace(ctx, metrics.ProxyMutationLatency.
+		WithLabelValues(nodeID, metrics.DeleteLabel, dbName, collectionName),
+		float64(tr.ElapseSpan().Milliseconds()))
 	metrics.ProxyCollectionMutationLatency.WithLabelValues(nodeID, metrics.DeleteLabel, dbName, collectionName).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	if merr.Ok(dr.result.GetStatus()) {
+		connection.GetManager().UpdateLastWriteTs(ctx, dr.collectionID, dr.result.GetTimestamp())
+	}
+	setMutationResultDetail(ctx, dr.result, dr.pks, true)
 	return dr.result, nil
 }
 
@@ -2805,7 +2880,8 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 		metrics.ProxyReportValue.WithLabelValues(nodeID, hookutil.OpTypeUpsert, dbName, username).Add(float64(v))
 	}
 
-	rateCol.Add(internalpb.RateType_DMLUpsert.String(), float64(it.upsertMsg.InsertMsg.Size()+it.upsertMsg.DeleteMsg.Size()))
+	rateCol.Add(internalpb.RateType_DMLUpsert.String(), float64(it.upsertMsg.InsertMsg.Size()+it.upsertMsg.DeleteMsg.Size()),
+		GetCollectionRateSubLabel(request), GetUserRateSubLabel(ctx))
 	if merr.Ok(it.result.GetStatus()) {
 		metrics.ProxyReportValue.WithLabelValues(nodeID, hookutil.OpTypeUpsert, dbName, username).Add(float64(v))
 	}
@@ -2813,12 +2889,16 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 	metrics.ProxyUpsertVectors.
 		WithLabelValues(nodeID, dbName, collectionName).
 		Add(float64(successCnt))
-	metrics.ProxyMutationLatency.
-		WithLabelValues(nodeID, metrics.UpsertLabel, dbName, collectionName).
-		Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.ObserveWithTrace(ctx, metrics.ProxyMutationLatency.
+		WithLabelValues(nodeID, metrics.UpsertLabel, dbName, collectionName),
+		float64(tr.ElapseSpan().Milliseconds()))
 	metrics.ProxyCollectionMutationLatency.WithLabelValues(nodeID, metrics.UpsertLabel, dbName, collectionName).Observe(float64(tr.ElapseSpan().Milliseconds()))
 
 	log.Debug("Finish processing upsert request in Proxy")
+	if merr.Ok(it.result.GetStatus()) {
+		connection.GetManager().UpdateLastWriteTs(ctx, it.collectionID, it.result.GetTimestamp())
+	}
+	setMutationResultDetail(ctx, it.result, it.result.GetIDs(), true)
 	return it.result, nil
 }
 
@@ -2834,8 +2914,24 @@ func GetCollectionRateSubLabel(req any) string {
 	return ratelimitutil.GetCollectionSubLabel(dbName.(string), collectionName.(string))
 }
 
+func GetUserRateSubLabel(ctx context.Context) string {
+	username := GetCurUserFromContextOrDefault(ctx)
+	if username == "" {
+		return ""
+	}
+	return ratelimitutil.GetUserSubLabel(username)
+}
+
 // Search searches the most similar records of requests.
 func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &milvuspb.SearchResults{
+			Status: merr.Status(err),
+		}, nil
+	}
+	// record the rate once, the request may be searched more than once for the retries and the recall evaluation
+	rateCol.Add(internalpb.RateType_DQLSearch.String(), float64(request.GetNq()), GetCollectionRateSubLabel(request), GetUserRateSubLabel(ctx))
+
 	var err error
 	rsp := &milvuspb.SearchResults{
 		Status: merr.Success(),
@@ -2845,6 +2941,10 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	resultSizeInsufficient := false
 	isTopkReduce := false
 	isRecallEvaluation := false
+	staleRetried := false
+	// the collection resolved by the name before searching, to tell whether the search fails for the stale cache
+	collectionID, _ := globalMetaCache.GetCollectionID(ctx, request.GetDbName(), request.GetCollectionName())
+	node.trafficMirror.MirrorSearch(ctx, request)
 	err2 := retry.Handle(ctx, func() (bool, error) {
 		rsp, resultSizeInsufficient, isTopkReduce, isRecallEvaluation, err = node.search(ctx, request, optimizedSearch, false)
 		if merr.Ok(rsp.GetStatus()) && optimizedSearch && resultSizeInsufficient && isTopkReduce && paramtable.Get().AutoIndexConfig.EnableResultLimitCheck.GetAsBool() {
@@ -2870,6 +2970,10 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		if errors.Is(merr.Error(rsp.GetStatus()), merr.ErrInconsistentRequery) {
 			return true, merr.Error(rsp.GetStatus())
 		}
+		if !staleRetried && isStaleCollection(ctx, merr.Error(rsp.GetStatus()), request.GetDbName(), request.GetCollectionName(), collectionID) {
+			staleRetried = true
+			return true, merr.Error(rsp.GetStatus())
+		}
 		// search for ground truth and compute recall
 		if isRecallEvaluation && merr.Ok(rsp.GetStatus()) {
 			var rspGT *milvuspb.SearchResults
@@ -2989,7 +3093,9 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest,
 				traceID = sp.SpanContext().TraceID().String()
 			}
 			if node.slowQueries != nil {
-				node.slowQueries.Add(qt.BeginTs(), metricsinfo.NewSlowQueryWithSearchRequest(request, user, span, traceID))
+				slowQuery := metricsinfo.NewSlowQueryWithSearchRequest(request, user, span, traceID)
+				slowQuery.Tags = logutil.GetClientTags(ctx)
+				node.slowQueries.Add(qt.BeginTs(), slowQuery)
 			}
 		}
 		if span >= paramtable.Get().ProxyCfg.SlowQuerySpanInSeconds.GetAsDuration(time.Second) {
@@ -3048,12 +3154,12 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest,
 		Add(float64(qt.result.GetResults().GetNumQueries()))
 
 	searchDur := tr.ElapseSpan().Milliseconds()
-	metrics.ProxySQLatency.WithLabelValues(
+	metrics.ObserveWithTrace(ctx, metrics.ProxySQLatency.WithLabelValues(
 		nodeID,
 		metrics.SearchLabel,
 		dbName,
 		collectionName,
-	).Observe(float64(searchDur))
+	), float64(searchDur))
 
 	metrics.ProxyCollectionSQLatency.WithLabelValues(
 		nodeID,
@@ -3091,6 +3197,7 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest,
 		})
 		SetReportValue(qt.result.GetStatus(), v)
 		SetStorageCost(qt.result.GetStatus(), qt.storageCost)
+		SetPartialLoadWarning(qt.result.GetStatus(), node.shardMgr != nil && node.shardMgr.IsPartialLoaded(dbName, collectionName))
 		if merr.Ok(qt.result.GetStatus()) {
 			metrics.ProxyReportValue.WithLabelValues(nodeID, hookutil.OpTypeSearch, dbName, username).Add(float64(v))
 		}
@@ -3215,7 +3322,9 @@ func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSea
 				traceID = sp.SpanContext().TraceID().String()
 			}
 			if node.slowQueries != nil {
-				node.slowQueries.Add(qt.BeginTs(), metricsinfo.NewSlowQueryWithSearchRequest(newSearchReq, user, span, traceID))
+				slowQuery := metricsinfo.NewSlowQueryWithSearchRequest(newSearchReq, user, span, traceID)
+				slowQuery.Tags = logutil.GetClientTags(ctx)
+				node.slowQueries.Add(qt.BeginTs(), slowQuery)
 			}
 		}
 		if span >= paramtable.Get().ProxyCfg.SlowQuerySpanInSeconds.GetAsDuration(time.Second) {
@@ -3273,12 +3382,12 @@ func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSea
 		Add(float64(len(request.GetRequests()) * int(qt.SearchRequest.GetNq())))
 
 	searchDur := tr.ElapseSpan().Milliseconds()
-	metrics.ProxySQLatency.WithLabelValues(
+	metrics.ObserveWithTrace(ctx, metrics.ProxySQLatency.WithLabelValues(
 		nodeID,
 		metrics.HybridSearchLabel,
 		dbName,
 		collectionName,
-	).Observe(float64(searchDur))
+	), float64(searchDur))
 
 	metrics.ProxyCollectionSQLatency.WithLabelValues(
 		nodeID,
@@ -3316,6 +3425,7 @@ func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSea
 		})
 		SetReportValue(qt.result.GetStatus(), v)
 		SetStorageCost(qt.result.GetStatus(), qt.storageCost)
+		SetPartialLoadWarning(qt.result.GetStatus(), node.shardMgr != nil && node.shardMgr.IsPartialLoaded(dbName, collectionName))
 		if merr.Ok(qt.result.GetStatus()) {
 			metrics.ProxyReportValue.WithLabelValues(nodeID, hookutil.OpTypeHybridSearch, dbName, username).Add(float64(v))
 		}
@@ -3489,7 +3599,9 @@ func (node *Proxy) query(ctx context.Context, qt *queryTask, sp trace.Span) (*mi
 			}
 
 			if node.slowQueries != nil {
-				node.slowQueries.Add(qt.BeginTs(), metricsinfo.NewSlowQueryWithQueryRequest(request, user, span, traceID))
+				slowQuery := metricsinfo.NewSlowQueryWithQueryRequest(request, user, span, traceID)
+				slowQuery.Tags = logutil.GetClientTags(ctx)
+				node.slowQueries.Add(qt.BeginTs(), slowQuery)
 			}
 		}
 		if span >= paramtable.Get().ProxyCfg.SlowQuerySpanInSeconds.GetAsDuration(time.Second) {
@@ -3531,12 +3643,12 @@ func (node *Proxy) query(ctx context.Context, qt *queryTask, sp trace.Span) (*mi
 			metrics.QueryLabel,
 		).Observe(float64(span.Milliseconds()))
 
-		metrics.ProxySQLatency.WithLabelValues(
+		metrics.ObserveWithTrace(ctx, metrics.ProxySQLatency.WithLabelValues(
 			strconv.FormatInt(paramtable.GetNodeID(), 10),
 			metrics.QueryLabel,
 			request.GetDbName(),
 			request.GetCollectionName(),
-		).Observe(float64(tr.ElapseSpan().Milliseconds()))
+		), float64(tr.ElapseSpan().Milliseconds()))
 
 		metrics.ProxyCollectionSQLatency.WithLabelValues(
 			strconv.FormatInt(paramtable.GetNodeID(), 10),
@@ -3551,23 +3663,26 @@ func (node *Proxy) query(ctx context.Context, qt *queryTask, sp trace.Span) (*mi
 
 // Query get the records by primary keys.
 func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
-	qt := &queryTask{
-		ctx:       ctx,
-		Condition: NewTaskCondition(ctx),
-		RetrieveRequest: &internalpb.RetrieveRequest{
-			Base: commonpbutil.NewMsgBase(
-				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
-				commonpbutil.WithSourceID(paramtable.GetNodeID()),
-			),
-			ReqID:            paramtable.GetNodeID(),
-			ConsistencyLevel: request.ConsistencyLevel,
-		},
-		request:             request,
-		mixCoord:            node.mixCoord,
-		lb:                  node.lbPolicy,
-		shardclientMgr:      node.shardMgr,
-		mustUsePartitionKey: Params.ProxyCfg.MustUsePartitionKey.GetAsBool(),
+	newQueryTask := func() *queryTask {
+		return &queryTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			RetrieveRequest: &internalpb.RetrieveRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+				),
+				ReqID:            paramtable.GetNodeID(),
+				ConsistencyLevel: request.ConsistencyLevel,
+			},
+			request:             request,
+			mixCoord:            node.mixCoord,
+			lb:                  node.lbPolicy,
+			shardclientMgr:      node.shardMgr,
+			mustUsePartitionKey: Params.ProxyCfg.MustUsePartitionKey.GetAsBool(),
+		}
 	}
+	qt := newQueryTask()
 
 	subLabel := GetCollectionRateSubLabel(request)
 	metrics.GetStats(ctx).
@@ -3582,19 +3697,24 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		request.GetCollectionName(),
 	).Add(float64(1))
 
-	rateCol.Add(internalpb.RateType_DQLQuery.String(), 1, subLabel)
+	rateCol.Add(internalpb.RateType_DQLQuery.String(), 1, subLabel, GetUserRateSubLabel(ctx))
 
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
 		return &milvuspb.QueryResults{
 			Status: merr.Status(err),
 		}, nil
 	}
+	node.trafficMirror.MirrorQuery(ctx, request)
 
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-Query")
 	defer sp.End()
 	method := "Query"
 
 	res, storageCost, err := node.query(ctx, qt, sp)
+	if err == nil && isStaleCollection(ctx, merr.Error(res.GetStatus()), request.GetDbName(), request.GetCollectionName(), qt.CollectionID) {
+		qt = newQueryTask()
+		res, storageCost, err = node.query(ctx, qt, sp)
+	}
 
 	if Params.QueryNodeCfg.StorageUsageTrackingEnabled.GetAsBool() {
 		metrics.ProxyScannedRemoteMB.WithLabelValues(
@@ -5047,6 +5167,9 @@ func (node *Proxy) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCre
 			zap.Error(err))
 		return merr.Status(err), nil
 	}
+	if merr.Ok(result) {
+		DeregisterSubLabel(ratelimitutil.GetUserSubLabel(req.GetUsername()))
+	}
 	return result, err
 }
 
@@ -6288,6 +6411,9 @@ func (node *Proxy) ListImports(ctx context.Context, req *internalpb.ListImportsR
 
 // DeregisterSubLabel must add the sub-labels here if using other labels for the sub-labels
 func DeregisterSubLabel(subLabel string) {
+	rateCol.DeregisterSubLabel(internalpb.RateType_DMLInsert.String(), subLabel)
+	rateCol.DeregisterSubLabel(internalpb.RateType_DMLUpsert.String(), subLabel)
+	rateCol.DeregisterSubLabel(internalpb.RateType_DMLDelete.String(), subLabel)
 	rateCol.DeregisterSubLabel(internalpb.RateType_DQLQuery.String(), subLabel)
 	rateCol.DeregisterSubLabel(internalpb.RateType_DQLSearch.String(), subLabel)
 }
@@ -6306,6 +6432,9 @@ func (node *Proxy) RegisterRestRouter(router gin.IRouter) {
 	// Slow query request that executed by proxy
 	router.GET(http.SlowQueryPath, getSlowQuery(node))
 
+	// RootCoord requests that are forwarded from proxy
+	router.GET(http.RCDdlTasksPath, getRootComponentMetrics(node, metricsinfo.DdlTaskKey))
+
 	// QueryCoord requests that are forwarded from proxy
 	router.GET(http.QCTargetPath, getQueryComponentMetrics(node, metricsinfo.TargetKey))
 	router.GET(http.QCDistPath, getQueryComponentMetrics(node, metricsinfo.DistKey))
@@ -6325,9 +6454,11 @@ func (node *Proxy) RegisterRestRouter(router gin.IRouter) {
 	router.GET(http.DCBuildIndexTasksPath, getDataComponentMetrics(node, metricsinfo.BuildIndexTaskKey))
 	router.GET(http.IndexListPath, getDataComponentMetrics(node, metricsinfo.IndexKey))
 	router.GET(http.DCSegmentsPath, getDataComponentMetrics(node, metricsinfo.SegmentKey, metricsinfo.RequestParamsInDC))
+	router.GET(http.DCSegmentCandidatesPath, getDataComponentMetrics(node, metricsinfo.SegmentCandidateKey))
 
 	// Datanode requests that are forwarded from datacoord
 	router.GET(http.DNSyncTasksPath, getDataComponentMetrics(node, metricsinfo.SyncTaskKey))
+	router.GET(http.DNSlowSyncTasksPath, getDataComponentMetrics(node, metricsinfo.SlowSyncTaskKey))
 	router.GET(http.DNSegmentsPath, getDataComponentMetrics(node, metricsinfo.SegmentKey, metricsinfo.RequestParamsInDN))
 	router.GET(http.DNChannelsPath, getDataComponentMetrics(node, metricsinfo.ChannelKey))
 
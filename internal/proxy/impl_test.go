@@ -1701,7 +1701,10 @@ func TestRegisterRestRouter(t *testing.T) {
 		{path: mhttp.DCCompactionTasksPath, statusCode: http.StatusInternalServerError},
 		{path: mhttp.DCImportTasksPath, statusCode: http.StatusInternalServerError},
 		{path: mhttp.DCBuildIndexTasksPath, statusCode: http.StatusInternalServerError},
+		{path: mhttp.DCSegmentCandidatesPath, statusCode: http.StatusInternalServerError},
+		{path: mhttp.RCDdlTasksPath, statusCode: http.StatusInternalServerError},
 		{path: mhttp.DNSyncTasksPath, statusCode: http.StatusInternalServerError},
+		{path: mhttp.DNSlowSyncTasksPath, statusCode: http.StatusInternalServerError},
 	}
 
 	for _, tt := range tests {
@@ -2034,3 +2037,15 @@ func TestProxy_ListFileResources(t *testing.T) {
 		assert.Error(t, merr.Error(resp.GetStatus()))
 	})
 }
+
+func TestProxy_RollbackTemplateCollection(t *testing.T) {
+	node := &Proxy{}
+	node.UpdateStateCode(commonpb.StateCode_Abnormal)
+	request := &milvuspb.CreateCollectionRequest{CollectionName: "coll"}
+	indexErr := merr.WrapErrParameterInvalidMsg("invalid index params")
+
+	// the partial state is reported if the collection fails to be rolled back.
+	err := node.rollbackTemplateCollection(context.Background(), request, indexErr)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	assert.Contains(t, err.Error(), "failed to be rolled back")
+}
@@ -18,19 +18,29 @@ package proxy
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	management "github.com/milvus-io/milvus/internal/http"
 	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/proxy/connection"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 )
 
 // this file contains proxy management restful API handler
@@ -54,6 +64,30 @@ func RegisterMgrRoute(proxy *Proxy) {
 			Path:        management.RouteGetQueryNodeDistribution,
 			HandlerFunc: proxy.GetQueryNodeDistribution,
 		})
+		management.Register(&management.Handler{
+			Path:        management.RouteFlushAndSeal,
+			HandlerFunc: proxy.FlushAndSeal,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteListQuarantinedSegments,
+			HandlerFunc: proxy.ListQuarantinedSegments,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteOperateQuarantinedSegment,
+			HandlerFunc: proxy.OperateQuarantinedSegment,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteRebuildStatslogs,
+			HandlerFunc: proxy.RebuildStatslogs,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGetStatslogRebuildJob,
+			HandlerFunc: proxy.GetStatslogRebuildJob,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteListBackgroundJobs,
+			HandlerFunc: proxy.ListBackgroundJobs,
+		})
 		management.Register(&management.Handler{
 			Path:        management.RouteSuspendQueryCoordBalance,
 			HandlerFunc: proxy.SuspendQueryCoordBalance,
@@ -86,6 +120,42 @@ func RegisterMgrRoute(proxy *Proxy) {
 			Path:        management.RouteQueryCoordBalanceStatus,
 			HandlerFunc: proxy.CheckQueryCoordBalanceStatus,
 		})
+		management.Register(&management.Handler{
+			Path:        management.RouteUpdateCollectionReplica,
+			HandlerFunc: proxy.UpdateCollectionReplica,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteSetCollectionQuotaOverride,
+			HandlerFunc: proxy.SetCollectionQuotaOverride,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteCreateCollectionTemplate,
+			HandlerFunc: proxy.CreateCollectionTemplate,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteDropCollectionTemplate,
+			HandlerFunc: proxy.DropCollectionTemplate,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteListCollectionTemplates,
+			HandlerFunc: proxy.ListCollectionTemplates,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteCreateCollectionFromTemplate,
+			HandlerFunc: proxy.CreateCollectionFromTemplateHandler,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGetUsage,
+			HandlerFunc: proxy.GetUsage,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteListClientSessions,
+			HandlerFunc: proxy.ListClientSessions,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteKillClientSession,
+			HandlerFunc: proxy.KillClientSession,
+		})
 	})
 }
 
@@ -132,6 +202,288 @@ func (node *Proxy) ResumeDatacoordGC(w http.ResponseWriter, req *http.Request) {
 	w.Write([]byte(`{"msg": "OK"}`))
 }
 
+// FlushAndSeal seals all the growing segments of the collection and waits for them to be flushed,
+// it returns the barrier ts and the flushed segments for the backup tools to get a consistent cut.
+func (node *Proxy) FlushAndSeal(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to flush and seal, %s"}`, err.Error())))
+		return
+	}
+
+	collectionID, err := strconv.ParseInt(req.FormValue("collection_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to flush and seal, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.mixCoord.FlushAndSeal(req.Context(), &datapb.FlushAndSealRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to flush and seal, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to flush and seal, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+
+	bytes, err := json.Marshal(map[string]any{
+		"collection_id": strconv.FormatInt(resp.GetCollectionID(), 10),
+		"barrier_ts":    strconv.FormatUint(resp.GetBarrierTs(), 10),
+		"segment_ids":   resp.GetSegmentIDs(),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to flush and seal, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// ListQuarantinedSegments lists the segments excluded from compaction and index building for unreadable logs,
+// the quarantined segments of all collections are listed if collection_id is not specified.
+func (node *Proxy) ListQuarantinedSegments(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list quarantined segments, %s"}`, err.Error())))
+		return
+	}
+
+	var collectionID int64
+	if value := req.FormValue("collection_id"); value != "" {
+		collectionID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list quarantined segments, %s"}`, err.Error())))
+			return
+		}
+	}
+
+	resp, err := node.mixCoord.ListQuarantinedSegments(req.Context(), &datapb.ListQuarantinedSegmentsRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list quarantined segments, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list quarantined segments, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+
+	segments := lo.Map(resp.GetSegments(), func(segment *datapb.SegmentInfo, _ int) *metricsinfo.QuarantinedSegment {
+		return &metricsinfo.QuarantinedSegment{
+			SegmentID:      segment.GetID(),
+			CollectionID:   segment.GetCollectionID(),
+			PartitionID:    segment.GetPartitionID(),
+			Channel:        segment.GetInsertChannel(),
+			State:          segment.GetState().String(),
+			Reason:         segment.GetQuarantineReason(),
+			QuarantineTime: time.Unix(segment.GetQuarantineTime(), 0).Format(time.RFC3339),
+		}
+	})
+	bytes, err := json.Marshal(segments)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list quarantined segments, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+var quarantineActions = map[string]datapb.QuarantineAction{
+	"validate": datapb.QuarantineAction_QuarantineValidate,
+	"restore":  datapb.QuarantineAction_QuarantineRestore,
+	"drop":     datapb.QuarantineAction_QuarantineDrop,
+	"repair":   datapb.QuarantineAction_QuarantineRepair,
+}
+
+// OperateQuarantinedSegment validates, restores, repairs or drops a quarantined segment by the action.
+func (node *Proxy) OperateQuarantinedSegment(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to operate quarantined segment, %s"}`, err.Error())))
+		return
+	}
+
+	segmentID, err := strconv.ParseInt(req.FormValue("segment_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to operate quarantined segment, %s"}`, err.Error())))
+		return
+	}
+	action, ok := quarantineActions[req.FormValue("action")]
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to operate quarantined segment, invalid action %s"}`, req.FormValue("action"))))
+		return
+	}
+
+	resp, err := node.mixCoord.OperateQuarantinedSegment(req.Context(), &datapb.OperateQuarantinedSegmentRequest{
+		Base:      commonpbutil.NewMsgBase(),
+		SegmentID: segmentID,
+		Action:    action,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to operate quarantined segment, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// RebuildStatslogs regenerates the statslogs (bloom filter, min/max) of the flushed segments from their binlogs,
+// all the flushed segments of the collection are rebuilt if segment_ids is not specified.
+func (node *Proxy) RebuildStatslogs(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to rebuild statslogs, %s"}`, err.Error())))
+		return
+	}
+
+	collectionID, err := strconv.ParseInt(req.FormValue("collection_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to rebuild statslogs, %s"}`, err.Error())))
+		return
+	}
+	var segmentIDs []int64
+	if value := req.FormValue("segment_ids"); value != "" {
+		for _, id := range strings.Split(value, ",") {
+			segmentID, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(fmt.Sprintf(`{"msg": "failed to rebuild statslogs, %s"}`, err.Error())))
+				return
+			}
+			segmentIDs = append(segmentIDs, segmentID)
+		}
+	}
+
+	resp, err := node.mixCoord.RebuildStatslogs(req.Context(), &datapb.RebuildStatslogsRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		SegmentIDs:   segmentIDs,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to rebuild statslogs, %s"}`, err.Error())))
+		return
+	}
+	bytes, err := protojson.Marshal(resp.GetJob())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to rebuild statslogs, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// GetStatslogRebuildJob gets the progress of the statslog rebuild job by the job_id returned by RebuildStatslogs.
+func (node *Proxy) GetStatslogRebuildJob(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get statslog rebuild job, %s"}`, err.Error())))
+		return
+	}
+
+	jobID, err := strconv.ParseInt(req.FormValue("job_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get statslog rebuild job, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.mixCoord.GetStatslogRebuildJob(req.Context(), &datapb.GetStatslogRebuildJobRequest{
+		Base:  commonpbutil.NewMsgBase(),
+		JobID: jobID,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get statslog rebuild job, %s"}`, err.Error())))
+		return
+	}
+	bytes, err := protojson.Marshal(resp.GetJob())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get statslog rebuild job, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// ListBackgroundJobs lists the background jobs of the coordinators, e.g. compactions, index builds, imports and loads,
+// the jobs can be filtered by collection_id and state.
+func (node *Proxy) ListBackgroundJobs(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list background jobs, %s"}`, err.Error())))
+		return
+	}
+
+	var collectionID int64
+	if value := req.FormValue("collection_id"); value != "" {
+		collectionID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list background jobs, %s"}`, err.Error())))
+			return
+		}
+	}
+
+	resp, err := node.mixCoord.ListBackgroundJobs(req.Context(), &datapb.ListBackgroundJobsRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		State:        req.FormValue("state"),
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list background jobs, %s"}`, err.Error())))
+		return
+	}
+
+	jobs := lo.Map(resp.GetJobs(), func(job *datapb.BackgroundJob, _ int) *metricsinfo.BackgroundJob {
+		return &metricsinfo.BackgroundJob{
+			Type:         job.GetType(),
+			JobID:        job.GetJobID(),
+			CollectionID: job.GetCollectionID(),
+			State:        job.GetState(),
+			Progress:     job.GetProgress(),
+			StartTime:    job.GetStartTime(),
+			Error:        job.GetError(),
+			Detail:       job.GetDetail(),
+		}
+	})
+	bytes, err := json.Marshal(jobs)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list background jobs, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
 func (node *Proxy) ListQueryNode(w http.ResponseWriter, req *http.Request) {
 	resp, err := node.mixCoord.ListQueryNode(req.Context(), &querypb.ListQueryNodeRequest{
 		Base: commonpbutil.NewMsgBase(),
@@ -417,6 +769,332 @@ func (node *Proxy) TransferSegment(w http.ResponseWriter, req *http.Request) {
 	w.Write([]byte(`{"msg": "OK"}`))
 }
 
+// UpdateCollectionReplica adds or removes the replicas of a loaded collection incrementally,
+// the existing replicas keep serving during the change.
+func (node *Proxy) UpdateCollectionReplica(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to update collection replica, %s"}`, err.Error())))
+		return
+	}
+
+	collectionID, err := strconv.ParseInt(req.FormValue("collection_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to update collection replica, %s"}`, err.Error())))
+		return
+	}
+
+	replicaNumber, err := strconv.ParseInt(req.FormValue("replica_number"), 10, 32)
+	if err != nil || replicaNumber <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to update collection replica, invalid replica number %s"}`, req.FormValue("replica_number"))))
+		return
+	}
+
+	var resourceGroups []string
+	if rgs := req.FormValue("resource_groups"); len(rgs) > 0 {
+		resourceGroups = lo.Map(strings.Split(rgs, ","), func(rg string, _ int) string { return strings.TrimSpace(rg) })
+	}
+
+	// the querycoord skips the collections not loaded silently
+	loaded, err := isCollectionLoaded(req.Context(), node.mixCoord, collectionID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to update collection replica, %s"}`, err.Error())))
+		return
+	}
+	if !loaded {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to update collection replica, collection %d is not loaded"}`, collectionID)))
+		return
+	}
+
+	resp, err := node.mixCoord.UpdateLoadConfig(req.Context(), &querypb.UpdateLoadConfigRequest{
+		Base:           commonpbutil.NewMsgBase(),
+		CollectionIDs:  []int64{collectionID},
+		ReplicaNumber:  int32(replicaNumber),
+		ResourceGroups: resourceGroups,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to update collection replica, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to update collection replica, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (node *Proxy) SetCollectionQuotaOverride(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to set collection quota override, %s"}`, err.Error())))
+		return
+	}
+
+	collectionName := req.FormValue("collection_name")
+	key := req.FormValue("key")
+	if len(collectionName) == 0 || len(key) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"msg": "failed to set collection quota override, collection_name and key are required"}`))
+		return
+	}
+
+	enabled, err := strconv.ParseBool(req.FormValue("enabled"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to set collection quota override, invalid enabled %s"}`, req.FormValue("enabled"))))
+		return
+	}
+
+	resp, err := node.mixCoord.SetCollectionQuotaOverride(req.Context(), &rootcoordpb.SetCollectionQuotaOverrideRequest{
+		Base:           commonpbutil.NewMsgBase(),
+		DbName:         req.FormValue("db_name"),
+		CollectionName: collectionName,
+		Key:            key,
+		Enabled:        enabled,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to set collection quota override, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to set collection quota override, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// CreateCollectionTemplate creates the collection template of the request body, which is the json of the template.
+func (node *Proxy) CreateCollectionTemplate(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write([]byte(`{"msg": "failed to create collection template, only POST is allowed"}`))
+		return
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create collection template, %s"}`, err.Error())))
+		return
+	}
+	template := &rootcoordpb.CollectionTemplate{}
+	if err := protojson.Unmarshal(body, template); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create collection template, invalid template, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.mixCoord.CreateCollectionTemplate(req.Context(), &rootcoordpb.CreateCollectionTemplateRequest{
+		Base:     commonpbutil.NewMsgBase(),
+		Template: template,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create collection template, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// DropCollectionTemplate drops the collection template, the collections created from it are not affected.
+func (node *Proxy) DropCollectionTemplate(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write([]byte(`{"msg": "failed to drop collection template, only POST is allowed"}`))
+		return
+	}
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop collection template, %s"}`, err.Error())))
+		return
+	}
+	name := req.FormValue("name")
+	if len(name) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"msg": "failed to drop collection template, name is required"}`))
+		return
+	}
+
+	resp, err := node.mixCoord.DropCollectionTemplate(req.Context(), &rootcoordpb.DropCollectionTemplateRequest{
+		Base: commonpbutil.NewMsgBase(),
+		Name: name,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop collection template, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// ListCollectionTemplates lists all the collection templates, or the given one if the name is specified.
+func (node *Proxy) ListCollectionTemplates(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list collection templates, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.mixCoord.ListCollectionTemplates(req.Context(), &rootcoordpb.ListCollectionTemplatesRequest{
+		Base: commonpbutil.NewMsgBase(),
+		Name: req.FormValue("name"),
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list collection templates, %s"}`, err.Error())))
+		return
+	}
+	bytes, err := protojson.Marshal(&rootcoordpb.ListCollectionTemplatesResponse{Templates: resp.GetTemplates()})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list collection templates, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// verifyAdminRequest verifies the basic auth credentials of the request belong to the root or an admin user,
+// the management routes bypass the rbac interceptors so the request is only served for the admin.
+func verifyAdminRequest(req *http.Request) (string, error) {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return "", nil
+	}
+	username, password, ok := req.BasicAuth()
+	if !ok {
+		return "", errors.Wrap(merr.ErrNeedAuthenticate, "basic auth credentials are required")
+	}
+	if !PasswordVerify(req.Context(), username, password) {
+		return "", errors.Wrapf(merr.ErrNeedAuthenticate, "invalid credentials of user %s", username)
+	}
+	if username == util.UserRoot {
+		return username, nil
+	}
+	roles, err := GetRole(username)
+	if err != nil {
+		return "", err
+	}
+	if !lo.Contains(roles, util.RoleAdmin) {
+		return "", merr.WrapErrPrivilegeNotPermitted("user %s is not admin", username)
+	}
+	return username, nil
+}
+
+// CreateCollectionFromTemplateHandler creates a collection from the collection template,
+// the collection is rolled back if the default indexes of the template fail to be created.
+// The request must carry the credentials of an admin user if the authorization is enabled.
+func (node *Proxy) CreateCollectionFromTemplateHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write([]byte(`{"msg": "failed to create collection from template, only POST is allowed"}`))
+		return
+	}
+	username, err := verifyAdminRequest(req)
+	if err != nil {
+		if errors.Is(err, merr.ErrNeedAuthenticate) {
+			w.WriteHeader(http.StatusUnauthorized)
+		} else {
+			w.WriteHeader(http.StatusForbidden)
+		}
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create collection from template, %s"}`, err.Error())))
+		return
+	}
+	err = req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create collection from template, %s"}`, err.Error())))
+		return
+	}
+	templateName := req.FormValue("template")
+	collectionName := req.FormValue("collection_name")
+	if len(templateName) == 0 || len(collectionName) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"msg": "failed to create collection from template, template and collection_name are required"}`))
+		return
+	}
+	var shardsNum int64
+	if value := req.FormValue("shards_num"); value != "" {
+		shardsNum, err = strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create collection from template, invalid shards_num %s"}`, value)))
+			return
+		}
+	}
+
+	dbName := req.FormValue("db_name")
+	resp, err := node.CreateCollectionFromTemplate(NewContextWithMetadata(req.Context(), username, dbName), &milvuspb.CreateCollectionRequest{
+		Base:           commonpbutil.NewMsgBase(),
+		DbName:         dbName,
+		CollectionName: collectionName,
+		ShardsNum:      int32(shardsNum),
+	}, templateName)
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create collection from template, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// GetUsage returns the storage, the recent request rates and the applied rate limits of the collections and users,
+// the report is filtered by the collection_id and user if specified.
+func (node *Proxy) GetUsage(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get usage, %s"}`, err.Error())))
+		return
+	}
+
+	var collectionID int64
+	if value := req.FormValue("collection_id"); value != "" {
+		collectionID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get usage, %s"}`, err.Error())))
+			return
+		}
+	}
+
+	resp, err := node.mixCoord.GetUsage(req.Context(), &rootcoordpb.GetUsageRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		User:         req.FormValue("user"),
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get usage, %s"}`, err.Error())))
+		return
+	}
+	bytes, err := protojson.Marshal(&rootcoordpb.GetUsageResponse{Collections: resp.GetCollections(), Users: resp.GetUsers()})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get usage, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
 func (node *Proxy) TransferChannel(w http.ResponseWriter, req *http.Request) {
 	err := req.ParseForm()
 	if err != nil {
@@ -526,3 +1204,38 @@ func (node *Proxy) CheckQueryNodeDistribution(w http.ResponseWriter, req *http.R
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(`{"msg": "OK"}`))
 }
+
+func (node *Proxy) ListClientSessions(w http.ResponseWriter, req *http.Request) {
+	bytes, err := json.Marshal(connection.GetManager().ListSessions())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list client sessions, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+func (node *Proxy) KillClientSession(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to kill client session, %s"}`, err.Error())))
+		return
+	}
+
+	identifier, err := strconv.ParseInt(req.FormValue("identifier"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to kill client session, %s"}`, err.Error())))
+		return
+	}
+
+	if err := connection.GetManager().Kill(req.Context(), identifier); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to kill client session, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
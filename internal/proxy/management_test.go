@@ -27,13 +27,26 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proxy/connection"
+	"github.com/milvus-io/milvus/internal/proxy/privilege"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/crypto"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
 type ProxyManagementSuite struct {
@@ -745,6 +758,581 @@ func (s *ProxyManagementSuite) TestCheckQueryNodeDistribution() {
 	})
 }
 
+func (s *ProxyManagementSuite) TestUpdateCollectionReplica() {
+	newRequest := func(body string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, management.RouteUpdateCollectionReplica, strings.NewReader(body))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().ShowLoadCollections(mock.Anything, mock.Anything).Return(&querypb.ShowCollectionsResponse{
+			Status:        merr.Success(),
+			CollectionIDs: []int64{1},
+		}, nil)
+		s.mixcoord.EXPECT().UpdateLoadConfig(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *querypb.UpdateLoadConfigRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal([]int64{1}, req.GetCollectionIDs())
+			s.Equal(int32(3), req.GetReplicaNumber())
+			s.Equal([]string{"rg1", "rg2"}, req.GetResourceGroups())
+			return merr.Success(), nil
+		})
+
+		recorder := httptest.NewRecorder()
+		s.proxy.UpdateCollectionReplica(recorder, newRequest("collection_id=1&replica_number=3&resource_groups=rg1, rg2"))
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK"}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		// test invalid request body
+		req, err := http.NewRequest(http.MethodPost, management.RouteUpdateCollectionReplica, nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.UpdateCollectionReplica(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		// test invalid params
+		for _, body := range []string{"", "collection_id=1", "collection_id=1&replica_number=0", "collection_id=a&replica_number=1"} {
+			recorder = httptest.NewRecorder()
+			s.proxy.UpdateCollectionReplica(recorder, newRequest(body))
+			s.Equal(http.StatusBadRequest, recorder.Code)
+		}
+
+		// test collection not loaded
+		s.mixcoord.EXPECT().ShowLoadCollections(mock.Anything, mock.Anything).Return(&querypb.ShowCollectionsResponse{
+			Status: merr.Success(),
+		}, nil).Once()
+		recorder = httptest.NewRecorder()
+		s.proxy.UpdateCollectionReplica(recorder, newRequest("collection_id=1&replica_number=2"))
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		// test rpc return error
+		s.mixcoord.EXPECT().ShowLoadCollections(mock.Anything, mock.Anything).Return(&querypb.ShowCollectionsResponse{
+			Status:        merr.Success(),
+			CollectionIDs: []int64{1},
+		}, nil)
+		s.mixcoord.EXPECT().UpdateLoadConfig(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error")).Once()
+		recorder = httptest.NewRecorder()
+		s.proxy.UpdateCollectionReplica(recorder, newRequest("collection_id=1&replica_number=2"))
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+
+		s.mixcoord.EXPECT().UpdateLoadConfig(mock.Anything, mock.Anything).Return(merr.Status(merr.ErrResourceGroupNodeNotEnough), nil).Once()
+		recorder = httptest.NewRecorder()
+		s.proxy.UpdateCollectionReplica(recorder, newRequest("collection_id=1&replica_number=2"))
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestFlushAndSeal() {
+	newRequest := func(body string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, management.RouteFlushAndSeal, strings.NewReader(body))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().FlushAndSeal(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.FlushAndSealRequest, opts ...grpc.CallOption) (*datapb.FlushAndSealResponse, error) {
+			s.EqualValues(1, req.GetCollectionID())
+			return &datapb.FlushAndSealResponse{
+				Status:       merr.Success(),
+				CollectionID: 1,
+				BarrierTs:    100,
+				SegmentIDs:   []int64{10, 11},
+			}, nil
+		})
+
+		recorder := httptest.NewRecorder()
+		s.proxy.FlushAndSeal(recorder, newRequest("collection_id=1"))
+		s.Equal(http.StatusOK, recorder.Code)
+		s.JSONEq(`{"collection_id": "1", "barrier_ts": "100", "segment_ids": [10, 11]}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		recorder := httptest.NewRecorder()
+		s.proxy.FlushAndSeal(recorder, newRequest("collection_id=a"))
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		s.mixcoord.EXPECT().FlushAndSeal(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error")).Once()
+		recorder = httptest.NewRecorder()
+		s.proxy.FlushAndSeal(recorder, newRequest("collection_id=1"))
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+
+		s.mixcoord.EXPECT().FlushAndSeal(mock.Anything, mock.Anything).Return(&datapb.FlushAndSealResponse{
+			Status: merr.Status(merr.ErrCollectionNotFound),
+		}, nil).Once()
+		recorder = httptest.NewRecorder()
+		s.proxy.FlushAndSeal(recorder, newRequest("collection_id=1"))
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestSetCollectionQuotaOverride() {
+	newRequest := func(body string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, management.RouteSetCollectionQuotaOverride, strings.NewReader(body))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().SetCollectionQuotaOverride(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *rootcoordpb.SetCollectionQuotaOverrideRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal("db1", req.GetDbName())
+			s.Equal("coll", req.GetCollectionName())
+			s.Equal(common.CollectionForceDenyWritingKey, req.GetKey())
+			s.True(req.GetEnabled())
+			return merr.Success(), nil
+		})
+
+		recorder := httptest.NewRecorder()
+		s.proxy.SetCollectionQuotaOverride(recorder, newRequest("db_name=db1&collection_name=coll&key=collection.force.deny.writing&enabled=true"))
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK"}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		for _, body := range []string{"", "collection_name=coll", "collection_name=coll&key=collection.force.deny.writing&enabled=a"} {
+			recorder := httptest.NewRecorder()
+			s.proxy.SetCollectionQuotaOverride(recorder, newRequest(body))
+			s.Equal(http.StatusBadRequest, recorder.Code)
+		}
+
+		s.mixcoord.EXPECT().SetCollectionQuotaOverride(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error")).Once()
+		recorder := httptest.NewRecorder()
+		s.proxy.SetCollectionQuotaOverride(recorder, newRequest("collection_name=coll&key=collection.force.deny.writing&enabled=false"))
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+
+		s.mixcoord.EXPECT().SetCollectionQuotaOverride(mock.Anything, mock.Anything).Return(merr.Status(merr.ErrParameterInvalid), nil).Once()
+		recorder = httptest.NewRecorder()
+		s.proxy.SetCollectionQuotaOverride(recorder, newRequest("collection_name=coll&key=invalid&enabled=false"))
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestCollectionTemplate() {
+	newRequest := func(method string, path string, body string, contentType string) *http.Request {
+		req, err := http.NewRequest(method, path, strings.NewReader(body))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", contentType)
+		return req
+	}
+	const formType = "application/x-www-form-urlencoded"
+
+	s.Run("create", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().CreateCollectionTemplate(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *rootcoordpb.CreateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal("t1", req.GetTemplate().GetName())
+			s.Len(req.GetTemplate().GetSchema().GetFields(), 1)
+			s.Equal("vec", req.GetTemplate().GetIndexParams()[0].GetFieldName())
+			return merr.Success(), nil
+		}).Once()
+		body := `{"name":"t1","schema":{"fields":[{"name":"vec","dataType":"FloatVector"}]},"indexParams":[{"fieldName":"vec","params":[{"key":"index_type","value":"HNSW"}]}]}`
+		recorder := httptest.NewRecorder()
+		s.proxy.CreateCollectionTemplate(recorder, newRequest(http.MethodPost, management.RouteCreateCollectionTemplate, body, "application/json"))
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK"}`, recorder.Body.String())
+
+		recorder = httptest.NewRecorder()
+		s.proxy.CreateCollectionTemplate(recorder, newRequest(http.MethodGet, management.RouteCreateCollectionTemplate, body, "application/json"))
+		s.Equal(http.StatusMethodNotAllowed, recorder.Code)
+
+		recorder = httptest.NewRecorder()
+		s.proxy.CreateCollectionTemplate(recorder, newRequest(http.MethodPost, management.RouteCreateCollectionTemplate, `{"name":1}`, "application/json"))
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		s.mixcoord.EXPECT().CreateCollectionTemplate(mock.Anything, mock.Anything).Return(merr.Status(merr.ErrParameterInvalid), nil).Once()
+		recorder = httptest.NewRecorder()
+		s.proxy.CreateCollectionTemplate(recorder, newRequest(http.MethodPost, management.RouteCreateCollectionTemplate, body, "application/json"))
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("drop", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().DropCollectionTemplate(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *rootcoordpb.DropCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal("t1", req.GetName())
+			return merr.Success(), nil
+		}).Once()
+		recorder := httptest.NewRecorder()
+		s.proxy.DropCollectionTemplate(recorder, newRequest(http.MethodPost, management.RouteDropCollectionTemplate, "name=t1", formType))
+		s.Equal(http.StatusOK, recorder.Code)
+
+		// the template is never dropped by a GET request.
+		recorder = httptest.NewRecorder()
+		s.proxy.DropCollectionTemplate(recorder, newRequest(http.MethodGet, management.RouteDropCollectionTemplate+"?name=t1", "", formType))
+		s.Equal(http.StatusMethodNotAllowed, recorder.Code)
+
+		recorder = httptest.NewRecorder()
+		s.proxy.DropCollectionTemplate(recorder, newRequest(http.MethodPost, management.RouteDropCollectionTemplate, "", formType))
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		s.mixcoord.EXPECT().DropCollectionTemplate(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error")).Once()
+		recorder = httptest.NewRecorder()
+		s.proxy.DropCollectionTemplate(recorder, newRequest(http.MethodPost, management.RouteDropCollectionTemplate, "name=t1", formType))
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("list", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().ListCollectionTemplates(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *rootcoordpb.ListCollectionTemplatesRequest, opts ...grpc.CallOption) (*rootcoordpb.ListCollectionTemplatesResponse, error) {
+			s.Equal("t1", req.GetName())
+			return &rootcoordpb.ListCollectionTemplatesResponse{
+				Status:    merr.Success(),
+				Templates: []*rootcoordpb.CollectionTemplate{{Name: "t1"}},
+			}, nil
+		}).Once()
+		recorder := httptest.NewRecorder()
+		s.proxy.ListCollectionTemplates(recorder, newRequest(http.MethodGet, management.RouteListCollectionTemplates+"?name=t1", "", formType))
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"t1"`)
+
+		s.mixcoord.EXPECT().ListCollectionTemplates(mock.Anything, mock.Anything).Return(&rootcoordpb.ListCollectionTemplatesResponse{
+			Status: merr.Status(merr.ErrParameterInvalid),
+		}, nil).Once()
+		recorder = httptest.NewRecorder()
+		s.proxy.ListCollectionTemplates(recorder, newRequest(http.MethodGet, management.RouteListCollectionTemplates, "", formType))
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("create_collection", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		recorder := httptest.NewRecorder()
+		s.proxy.CreateCollectionFromTemplateHandler(recorder, newRequest(http.MethodGet, management.RouteCreateCollectionFromTemplate, "", formType))
+		s.Equal(http.StatusMethodNotAllowed, recorder.Code)
+
+		for _, body := range []string{"", "template=t1", "collection_name=coll", "template=t1&collection_name=coll&shards_num=a"} {
+			recorder := httptest.NewRecorder()
+			s.proxy.CreateCollectionFromTemplateHandler(recorder, newRequest(http.MethodPost, management.RouteCreateCollectionFromTemplate, body, formType))
+			s.Equal(http.StatusBadRequest, recorder.Code)
+		}
+
+		// the proxy is not healthy.
+		recorder = httptest.NewRecorder()
+		s.proxy.CreateCollectionFromTemplateHandler(recorder, newRequest(http.MethodPost, management.RouteCreateCollectionFromTemplate, "template=t1&collection_name=coll", formType))
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("create_collection_with_authorization", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		encryptedPwd, err := crypto.PasswordEncrypt("password")
+		s.Require().NoError(err)
+		s.mixcoord.EXPECT().ListPolicy(mock.Anything, mock.Anything).Return(&internalpb.ListPolicyResponse{
+			Status:    merr.Success(),
+			UserRoles: []string{funcutil.EncodeUserRoleCache("user1", util.RolePublic), funcutil.EncodeUserRoleCache("user2", util.RoleAdmin)},
+		}, nil)
+		s.mixcoord.EXPECT().GetCredential(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *rootcoordpb.GetCredentialRequest, opts ...grpc.CallOption) (*rootcoordpb.GetCredentialResponse, error) {
+			return &rootcoordpb.GetCredentialResponse{Status: merr.Success(), Username: req.GetUsername(), Password: encryptedPwd}, nil
+		})
+		s.Require().NoError(privilege.InitPrivilegeCache(context.Background(), s.mixcoord))
+
+		newAuthRequest := func(username, password string) *http.Request {
+			req := newRequest(http.MethodPost, management.RouteCreateCollectionFromTemplate, "template=t1&collection_name=coll", formType)
+			if username != "" {
+				req.SetBasicAuth(username, password)
+			}
+			return req
+		}
+
+		// the credentials are missing or invalid.
+		for _, cred := range [][2]string{{"", ""}, {"user2", "wrong"}} {
+			recorder := httptest.NewRecorder()
+			s.proxy.CreateCollectionFromTemplateHandler(recorder, newAuthRequest(cred[0], cred[1]))
+			s.Equal(http.StatusUnauthorized, recorder.Code)
+		}
+
+		// the user is not admin.
+		recorder := httptest.NewRecorder()
+		s.proxy.CreateCollectionFromTemplateHandler(recorder, newAuthRequest("user1", "password"))
+		s.Equal(http.StatusForbidden, recorder.Code)
+
+		// the admin and root pass the verification, the proxy is not healthy.
+		for _, username := range []string{"user2", util.UserRoot} {
+			recorder := httptest.NewRecorder()
+			s.proxy.CreateCollectionFromTemplateHandler(recorder, newAuthRequest(username, "password"))
+			s.Equal(http.StatusInternalServerError, recorder.Code)
+		}
+	})
+}
+
+func (s *ProxyManagementSuite) TestGetUsage() {
+	newRequest := func(path string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		s.Require().NoError(err)
+		return req
+	}
+
+	s.mixcoord.EXPECT().GetUsage(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *rootcoordpb.GetUsageRequest, opts ...grpc.CallOption) (*rootcoordpb.GetUsageResponse, error) {
+		s.Equal(int64(100), req.GetCollectionID())
+		s.Equal("root", req.GetUser())
+		return &rootcoordpb.GetUsageResponse{
+			Status:      merr.Success(),
+			Collections: []*rootcoordpb.CollectionUsage{{CollectionID: 100, StoredRows: 10}},
+			Users:       []*rootcoordpb.UserUsage{{User: "root"}},
+		}, nil
+	}).Once()
+	recorder := httptest.NewRecorder()
+	s.proxy.GetUsage(recorder, newRequest(management.RouteGetUsage+"?collection_id=100&user=root"))
+	s.Equal(http.StatusOK, recorder.Code)
+	s.Contains(recorder.Body.String(), `"storedRows":"10"`)
+
+	recorder = httptest.NewRecorder()
+	s.proxy.GetUsage(recorder, newRequest(management.RouteGetUsage+"?collection_id=a"))
+	s.Equal(http.StatusBadRequest, recorder.Code)
+
+	s.mixcoord.EXPECT().GetUsage(mock.Anything, mock.Anything).Return(nil, errors.New("mock error")).Once()
+	recorder = httptest.NewRecorder()
+	s.proxy.GetUsage(recorder, newRequest(management.RouteGetUsage))
+	s.Equal(http.StatusInternalServerError, recorder.Code)
+}
+
+func (s *ProxyManagementSuite) TestClientSessions() {
+	connection.GetManager().Register(context.TODO(), 20251015, &commonpb.ClientInfo{User: "root"})
+
+	req, err := http.NewRequest(http.MethodGet, management.RouteListClientSessions, nil)
+	s.Require().NoError(err)
+	recorder := httptest.NewRecorder()
+	s.proxy.ListClientSessions(recorder, req)
+	s.Equal(http.StatusOK, recorder.Code)
+	s.Contains(recorder.Body.String(), `"identifier":"20251015"`)
+
+	// test miss requested param
+	req, err = http.NewRequest(http.MethodPost, management.RouteKillClientSession, strings.NewReader(""))
+	s.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	recorder = httptest.NewRecorder()
+	s.proxy.KillClientSession(recorder, req)
+	s.Equal(http.StatusBadRequest, recorder.Code)
+
+	req, err = http.NewRequest(http.MethodPost, management.RouteKillClientSession, strings.NewReader("identifier=20251015"))
+	s.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	recorder = httptest.NewRecorder()
+	s.proxy.KillClientSession(recorder, req)
+	s.Equal(http.StatusOK, recorder.Code)
+	s.True(connection.GetManager().IsKilled(20251015))
+
+	// the session is not found after killed
+	req, err = http.NewRequest(http.MethodPost, management.RouteKillClientSession, strings.NewReader("identifier=20251015"))
+	s.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	recorder = httptest.NewRecorder()
+	s.proxy.KillClientSession(recorder, req)
+	s.Equal(http.StatusBadRequest, recorder.Code)
+}
+
 func TestProxyManagement(t *testing.T) {
 	suite.Run(t, new(ProxyManagementSuite))
 }
+
+func (s *ProxyManagementSuite) TestQuarantinedSegments() {
+	newRequest := func(path string, body string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+
+	s.Run("list", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().ListQuarantinedSegments(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.ListQuarantinedSegmentsRequest, opts ...grpc.CallOption) (*datapb.ListQuarantinedSegmentsResponse, error) {
+			s.EqualValues(1, req.GetCollectionID())
+			return &datapb.ListQuarantinedSegmentsResponse{
+				Status: merr.Success(),
+				Segments: []*datapb.SegmentInfo{
+					{ID: 10, CollectionID: 1, State: commonpb.SegmentState_Flushed, QuarantineReason: "key not found"},
+				},
+			}, nil
+		})
+
+		recorder := httptest.NewRecorder()
+		s.proxy.ListQuarantinedSegments(recorder, newRequest(management.RouteListQuarantinedSegments, "collection_id=1"))
+		s.Equal(http.StatusOK, recorder.Code)
+		var segments []*metricsinfo.QuarantinedSegment
+		s.NoError(json.Unmarshal(recorder.Body.Bytes(), &segments))
+		s.Len(segments, 1)
+		s.EqualValues(10, segments[0].SegmentID)
+		s.Equal("key not found", segments[0].Reason)
+
+		recorder = httptest.NewRecorder()
+		s.proxy.ListQuarantinedSegments(recorder, newRequest(management.RouteListQuarantinedSegments, "collection_id=a"))
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("operate", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().OperateQuarantinedSegment(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.OperateQuarantinedSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.EqualValues(10, req.GetSegmentID())
+			s.Equal(datapb.QuarantineAction_QuarantineDrop, req.GetAction())
+			return merr.Success(), nil
+		}).Once()
+		recorder := httptest.NewRecorder()
+		s.proxy.OperateQuarantinedSegment(recorder, newRequest(management.RouteOperateQuarantinedSegment, "segment_id=10&action=drop"))
+		s.Equal(http.StatusOK, recorder.Code)
+
+		s.mixcoord.EXPECT().OperateQuarantinedSegment(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.OperateQuarantinedSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal(datapb.QuarantineAction_QuarantineRepair, req.GetAction())
+			return merr.Success(), nil
+		}).Once()
+		recorder = httptest.NewRecorder()
+		s.proxy.OperateQuarantinedSegment(recorder, newRequest(management.RouteOperateQuarantinedSegment, "segment_id=10&action=repair"))
+		s.Equal(http.StatusOK, recorder.Code)
+
+		recorder = httptest.NewRecorder()
+		s.proxy.OperateQuarantinedSegment(recorder, newRequest(management.RouteOperateQuarantinedSegment, "segment_id=10&action=unknown"))
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		s.mixcoord.EXPECT().OperateQuarantinedSegment(mock.Anything, mock.Anything).Return(merr.Status(merr.ErrParameterInvalid), nil).Once()
+		recorder = httptest.NewRecorder()
+		s.proxy.OperateQuarantinedSegment(recorder, newRequest(management.RouteOperateQuarantinedSegment, "segment_id=10&action=restore"))
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestStatslogRebuild() {
+	newRequest := func(path string, body string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+
+	s.Run("rebuild", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().RebuildStatslogs(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.RebuildStatslogsRequest, opts ...grpc.CallOption) (*datapb.RebuildStatslogsResponse, error) {
+			s.EqualValues(1, req.GetCollectionID())
+			s.Equal([]int64{10, 11}, req.GetSegmentIDs())
+			return &datapb.RebuildStatslogsResponse{
+				Status: merr.Success(),
+				Job:    &datapb.StatslogRebuildJob{JobID: 100, CollectionID: 1, SegmentIDs: []int64{10, 11}, State: indexpb.JobState_JobStateInProgress},
+			}, nil
+		}).Once()
+		recorder := httptest.NewRecorder()
+		s.proxy.RebuildStatslogs(recorder, newRequest(management.RouteRebuildStatslogs, "collection_id=1&segment_ids=10,11"))
+		s.Equal(http.StatusOK, recorder.Code)
+		job := &datapb.StatslogRebuildJob{}
+		s.NoError(protojson.Unmarshal(recorder.Body.Bytes(), job))
+		s.EqualValues(100, job.GetJobID())
+
+		recorder = httptest.NewRecorder()
+		s.proxy.RebuildStatslogs(recorder, newRequest(management.RouteRebuildStatslogs, "collection_id=1&segment_ids=10,a"))
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		s.mixcoord.EXPECT().RebuildStatslogs(mock.Anything, mock.Anything).Return(&datapb.RebuildStatslogsResponse{
+			Status: merr.Status(merr.ErrParameterInvalid),
+		}, nil).Once()
+		recorder = httptest.NewRecorder()
+		s.proxy.RebuildStatslogs(recorder, newRequest(management.RouteRebuildStatslogs, "collection_id=1"))
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("get", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().GetStatslogRebuildJob(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.GetStatslogRebuildJobRequest, opts ...grpc.CallOption) (*datapb.GetStatslogRebuildJobResponse, error) {
+			s.EqualValues(100, req.GetJobID())
+			return &datapb.GetStatslogRebuildJobResponse{
+				Status: merr.Success(),
+				Job:    &datapb.StatslogRebuildJob{JobID: 100, FinishedSegmentIDs: []int64{10, 11}, State: indexpb.JobState_JobStateFinished},
+			}, nil
+		}).Once()
+		recorder := httptest.NewRecorder()
+		s.proxy.GetStatslogRebuildJob(recorder, newRequest(management.RouteGetStatslogRebuildJob, "job_id=100"))
+		s.Equal(http.StatusOK, recorder.Code)
+		job := &datapb.StatslogRebuildJob{}
+		s.NoError(protojson.Unmarshal(recorder.Body.Bytes(), job))
+		s.Equal(indexpb.JobState_JobStateFinished, job.GetState())
+
+		recorder = httptest.NewRecorder()
+		s.proxy.GetStatslogRebuildJob(recorder, newRequest(management.RouteGetStatslogRebuildJob, "job_id=a"))
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestListBackgroundJobs() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().ListBackgroundJobs(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.ListBackgroundJobsRequest, opts ...grpc.CallOption) (*datapb.ListBackgroundJobsResponse, error) {
+			s.EqualValues(1, req.GetCollectionID())
+			s.Equal(metricsinfo.BackgroundJobRunning, req.GetState())
+			return &datapb.ListBackgroundJobsResponse{
+				Status: merr.Success(),
+				Jobs: []*datapb.BackgroundJob{
+					{Type: metricsinfo.BackgroundJobCompaction, JobID: 10, CollectionID: 1, State: metricsinfo.BackgroundJobRunning, Progress: 50},
+				},
+			}, nil
+		})
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteListBackgroundJobs+"?collection_id=1&state=running", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.ListBackgroundJobs(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		var jobs []*metricsinfo.BackgroundJob
+		s.NoError(json.Unmarshal(recorder.Body.Bytes(), &jobs))
+		s.Len(jobs, 1)
+		s.EqualValues(10, jobs[0].JobID)
+		s.EqualValues(50, jobs[0].Progress)
+	})
+
+	s.Run("invalid collection id", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteListBackgroundJobs+"?collection_id=a", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.ListBackgroundJobs(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("coord error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.mixcoord.EXPECT().ListBackgroundJobs(mock.Anything, mock.Anything).Return(nil, merr.ErrServiceNotReady)
+		req, err := http.NewRequest(http.MethodGet, management.RouteListBackgroundJobs, nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.ListBackgroundJobs(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
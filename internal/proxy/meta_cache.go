@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/samber/lo"
 	"go.uber.org/zap"
@@ -42,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
@@ -61,6 +63,8 @@ type Cache interface {
 	GetPartitions(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error)
 	// GetPartitionInfo get partition's info.
 	GetPartitionInfo(ctx context.Context, database, collectionName string, partitionName string) (*partitionInfo, error)
+	// GetPartitionIDs get the identifiers of partitions in bulk, in the same order of the partition names.
+	GetPartitionIDs(ctx context.Context, database, collectionName string, partitionNames []string) ([]typeutil.UniqueID, error)
 	// GetPartitionsIndex returns a partition names in partition key indexed order.
 	GetPartitionsIndex(ctx context.Context, database, collectionName string) ([]string, error)
 	// GetCollectionSchema get collection's schema.
@@ -71,6 +75,9 @@ type Cache interface {
 	// InvalidateShardLeaderCache(collections []int64)
 	// ListShardLocation() map[int64]nodeInfo
 	RemoveCollection(ctx context.Context, database, collectionName string)
+	// ExpireCollectionName removes the cached collection of the name, which could be an alias,
+	// and the describe responses before the version are not cached for the name anymore.
+	ExpireCollectionName(ctx context.Context, database, collectionName string, version uint64)
 	RemoveCollectionsByID(ctx context.Context, collectionID UniqueID, version uint64, removeVersion bool) []string
 
 	// GetCredentialInfo operate credential cache
@@ -270,6 +277,33 @@ type partitionInfos struct {
 	name2Info             map[string]*partitionInfo // map[int64]*partitionInfo
 	name2ID               map[string]int64          // map[int64]*partitionInfo
 	indexedPartitionNames []string
+	// missing caches the partition names confirmed not existing, name -> confirmed time,
+	// it's invalidated along with the partition infos when the collection cache is refreshed.
+	missing *typeutil.ConcurrentMap[string, time.Time]
+}
+
+// lookup returns the infos of the partition names and the names not found, empty name means the default partition.
+func (p *partitionInfos) lookup(partitionNames []string) ([]*partitionInfo, []string) {
+	infos := make([]*partitionInfo, 0, len(partitionNames))
+	var missed []string
+	for _, name := range partitionNames {
+		info, ok := p.name2Info[name]
+		if name == "" {
+			info, ok = lo.Find(p.partitionInfos, func(info *partitionInfo) bool { return info.isDefault })
+		}
+		if !ok {
+			missed = append(missed, name)
+			continue
+		}
+		infos = append(infos, info)
+	}
+	return infos, missed
+}
+
+// isMissing returns whether the partition is confirmed not existing recently.
+func (p *partitionInfos) isMissing(partitionName string) bool {
+	confirmedAt, ok := p.missing.Get(partitionName)
+	return ok && time.Since(confirmedAt) < Params.ProxyCfg.PartitionNegativeCacheTTL.GetAsDuration(time.Second)
 }
 
 // partitionInfo single model for partition information.
@@ -310,8 +344,14 @@ type MetaCache struct {
 	IDLock  sync.RWMutex
 
 	collectionCacheVersion map[UniqueID]uint64 // collectionID -> cacheVersion
+	// the alias may be altered to another collection, so the cache is versioned by the name as well
+	nameCacheVersion map[string]uint64 // database-collectionName -> cacheVersion
 }
 
+// nameCacheVersionRetention is how long the version of an expired collection name is kept,
+// it only has to outlive the describe requests in flight when the name is expired.
+const nameCacheVersionRetention = 10 * time.Minute
+
 // globalMetaCache is singleton instance of Cache
 var globalMetaCache Cache
 
@@ -343,6 +383,7 @@ func NewMetaCache(mixCoord types.MixCoordClient) (*MetaCache, error) {
 		privilegeInfos:         map[string]struct{}{},
 		userToRoles:            map[string]map[string]struct{}{},
 		collectionCacheVersion: make(map[UniqueID]uint64),
+		nameCacheVersion:       make(map[string]uint64),
 	}, nil
 }
 
@@ -416,11 +457,13 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	curVersion := m.collectionCacheVersion[collection.GetCollectionID()]
+	nameVersion := m.nameCacheVersion[buildSfKeyByName(database, collectionName)]
 	// Compatibility logic: if the rootcoord version is lower(requestTime = 0), update the cache directly.
-	if collection.GetRequestTime() < curVersion && collection.GetRequestTime() != 0 {
+	if collection.GetRequestTime() < max(curVersion, nameVersion) && collection.GetRequestTime() != 0 {
 		log.Debug("describe collection timestamp less than version, don't update cache",
 			zap.String("collectionName", collectionName),
-			zap.Uint64("version", collection.GetRequestTime()), zap.Uint64("cache version", curVersion))
+			zap.Uint64("version", collection.GetRequestTime()), zap.Uint64("cache version", curVersion),
+			zap.Uint64("name cache version", nameVersion))
 		return &collectionInfo{
 			collID:                collection.CollectionID,
 			schema:                schemaInfo,
@@ -638,24 +681,61 @@ func (m *MetaCache) GetPartitions(ctx context.Context, database, collectionName
 }
 
 func (m *MetaCache) GetPartitionInfo(ctx context.Context, database, collectionName string, partitionName string) (*partitionInfo, error) {
-	partitions, err := m.GetPartitionInfos(ctx, database, collectionName)
+	infos, err := m.resolvePartitions(ctx, database, collectionName, []string{partitionName})
 	if err != nil {
 		return nil, err
 	}
+	return infos[0], nil
+}
 
-	if partitionName == "" {
-		for _, info := range partitions.partitionInfos {
-			if info.isDefault {
-				return info, nil
-			}
-		}
+func (m *MetaCache) GetPartitionIDs(ctx context.Context, database, collectionName string, partitionNames []string) ([]typeutil.UniqueID, error) {
+	infos, err := m.resolvePartitions(ctx, database, collectionName, partitionNames)
+	if err != nil {
+		return nil, err
 	}
+	return lo.Map(infos, func(info *partitionInfo, _ int) typeutil.UniqueID {
+		return info.partitionID
+	}), nil
+}
 
-	info, ok := partitions.name2Info[partitionName]
-	if !ok {
-		return nil, merr.WrapErrPartitionNotFound(partitionName)
+// resolvePartitions resolves the partition names with the cached partition infos.
+// The cache may be stale if the partitions are just created, so the partitions are refreshed from rootcoord
+// at most once for all the missed names, and the names still missed are cached as negative results
+// to avoid hot-spotting rootcoord by the requests with non-existing partitions.
+func (m *MetaCache) resolvePartitions(ctx context.Context, database, collectionName string, partitionNames []string) ([]*partitionInfo, error) {
+	partitions, err := m.GetPartitionInfos(ctx, database, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	infos, missed := partitions.lookup(partitionNames)
+	if len(missed) == 0 {
+		return infos, nil
+	}
+	if lo.EveryBy(missed, partitions.isMissing) {
+		return nil, merr.WrapErrPartitionNotFound(missed[0])
 	}
-	return info, nil
+
+	method := "ResolvePartitions"
+	tr := timerecord.NewTimeRecorder("UpdateCache")
+	metrics.ProxyCacheStatsCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), method, metrics.CacheMissLabel).Inc()
+	collInfo, err := m.UpdateByName(ctx, database, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	metrics.ProxyUpdateCacheLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	partitions = collInfo.partInfo
+	infos, missed = partitions.lookup(partitionNames)
+	if len(missed) > 0 {
+		now := time.Now()
+		for _, name := range missed {
+			partitions.missing.Insert(name, now)
+		}
+		log.Ctx(ctx).Debug("partitions not found after refreshing cache", zap.String("database", database),
+			zap.String("collectionName", collectionName), zap.Strings("partitionNames", missed))
+		return nil, merr.WrapErrPartitionNotFound(missed[0])
+	}
+	return infos, nil
 }
 
 func (m *MetaCache) GetPartitionsIndex(ctx context.Context, database, collectionName string) ([]string, error) {
@@ -773,6 +853,7 @@ func parsePartitionsInfo(infos []*partitionInfo, hasPartitionKey bool) *partitio
 		partitionInfos: infos,
 		name2ID:        name2ID,
 		name2Info:      name2Info,
+		missing:        typeutil.NewConcurrentMap[string, time.Time](),
 	}
 
 	if !hasPartitionKey {
@@ -813,6 +894,38 @@ func (m *MetaCache) RemoveCollection(ctx context.Context, database, collectionNa
 	log.Ctx(ctx).Debug("remove collection", zap.String("db", database), zap.String("collection", collectionName), zap.Bool("dbok", dbOk))
 }
 
+func (m *MetaCache) ExpireCollectionName(ctx context.Context, database, collectionName string, version uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	databases := []string{database}
+	if database == "" {
+		databases = append(databases, defaultDB)
+	}
+	for _, database := range databases {
+		if db, ok := m.collInfo[database]; ok {
+			delete(db, collectionName)
+		}
+		key := buildSfKeyByName(database, collectionName)
+		if version > m.nameCacheVersion[key] {
+			m.nameCacheVersion[key] = version
+		}
+	}
+	m.pruneNameCacheVersion(version)
+	log.Ctx(ctx).Debug("expire collection name", zap.String("db", database), zap.String("collection", collectionName),
+		zap.Uint64("version", version))
+}
+
+// pruneNameCacheVersion removes the versions of the names expired before the retention,
+// the describe requests in flight when they were expired are done already. The caller must hold the lock.
+func (m *MetaCache) pruneNameCacheVersion(version uint64) {
+	expired := tsoutil.PhysicalTime(version).Add(-nameCacheVersionRetention)
+	for key, v := range m.nameCacheVersion {
+		if tsoutil.PhysicalTime(v).Before(expired) {
+			delete(m.nameCacheVersion, key)
+		}
+	}
+}
+
 func (m *MetaCache) RemoveCollectionsByID(ctx context.Context, collectionID UniqueID, version uint64, removeVersion bool) []string {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -846,6 +959,11 @@ func (m *MetaCache) RemoveDatabase(ctx context.Context, database string) {
 	defer m.mu.Unlock()
 	delete(m.collInfo, database)
 	delete(m.dbInfo, database)
+	for key := range m.nameCacheVersion {
+		if strings.HasPrefix(key, buildSfKeyByName(database, "")) {
+			delete(m.nameCacheVersion, key)
+		}
+	}
 }
 
 func (m *MetaCache) HasDatabase(ctx context.Context, database string) bool {
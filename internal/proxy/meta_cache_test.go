@@ -45,6 +45,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/crypto"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
@@ -282,6 +283,26 @@ func (c *MockMixCoordClientInterface) ShowCollectionIDs(ctx context.Context, in
 	panic("implement me")
 }
 
+func (c *MockMixCoordClientInterface) SetCollectionQuotaOverride(ctx context.Context, req *rootcoordpb.SetCollectionQuotaOverrideRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (c *MockMixCoordClientInterface) CreateCollectionTemplate(ctx context.Context, req *rootcoordpb.CreateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (c *MockMixCoordClientInterface) DropCollectionTemplate(ctx context.Context, req *rootcoordpb.DropCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (c *MockMixCoordClientInterface) ListCollectionTemplates(ctx context.Context, req *rootcoordpb.ListCollectionTemplatesRequest, opts ...grpc.CallOption) (*rootcoordpb.ListCollectionTemplatesResponse, error) {
+	panic("implement me")
+}
+
+func (c *MockMixCoordClientInterface) GetUsage(ctx context.Context, req *rootcoordpb.GetUsageRequest, opts ...grpc.CallOption) (*rootcoordpb.GetUsageResponse, error) {
+	panic("implement me")
+}
+
 func (c *MockMixCoordClientInterface) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	panic("implement me")
 }
@@ -685,6 +706,10 @@ func (c *MockMixCoordClientInterface) MarkSegmentsDropped(ctx context.Context, r
 	panic("implement me")
 }
 
+func (c *MockMixCoordClientInterface) UpdateSegmentStatslogs(ctx context.Context, req *datapb.UpdateSegmentStatslogsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 // BroadcastAlteredCollection is the DataCoord client side code for BroadcastAlteredCollection call.
 func (c *MockMixCoordClientInterface) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	panic("implement me")
@@ -1346,6 +1371,35 @@ func TestMetaCache_GetPartitionError(t *testing.T) {
 	assert.Equal(t, id, typeutil.UniqueID(0))
 }
 
+func TestMetaCache_GetPartitionIDs(t *testing.T) {
+	ctx := context.Background()
+	rootCoord := &MockMixCoordClientInterface{}
+	err := InitMetaCache(ctx, rootCoord)
+	assert.NoError(t, err)
+
+	ids, err := globalMetaCache.GetPartitionIDs(ctx, dbName, "collection1", []string{"par1", "par2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []typeutil.UniqueID{1, 2}, ids)
+	accessCount := rootCoord.GetAccessCount()
+
+	// missed partition refreshes the cache once
+	_, err = globalMetaCache.GetPartitionIDs(ctx, dbName, "collection1", []string{"par1", "par3"})
+	assert.ErrorIs(t, err, merr.ErrPartitionNotFound)
+	assert.Equal(t, accessCount+1, rootCoord.GetAccessCount())
+
+	// the negative result is cached
+	_, err = globalMetaCache.GetPartitionID(ctx, dbName, "collection1", "par3")
+	assert.ErrorIs(t, err, merr.ErrPartitionNotFound)
+	assert.Equal(t, accessCount+1, rootCoord.GetAccessCount())
+
+	// the negative result expires
+	Params.Save(Params.ProxyCfg.PartitionNegativeCacheTTL.Key, "0")
+	defer Params.Reset(Params.ProxyCfg.PartitionNegativeCacheTTL.Key)
+	_, err = globalMetaCache.GetPartitionID(ctx, dbName, "collection1", "par3")
+	assert.ErrorIs(t, err, merr.ErrPartitionNotFound)
+	assert.Equal(t, accessCount+2, rootCoord.GetAccessCount())
+}
+
 func TestMetaCache_GetShard(t *testing.T) {
 	t.Skip("GetShard has been moved to ShardClientMgr in shardclient package")
 	// Test body removed - functionality moved to shardclient package
@@ -2037,3 +2091,57 @@ func TestMetaCache_GetShardLeaderList(t *testing.T) {
 	t.Skip("GetShardLeaderList has been moved to ShardClientMgr in shardclient package")
 	// Test body removed - functionality moved to shardclient package
 }
+
+func TestMetaCache_ExpireCollectionName(t *testing.T) {
+	ctx := context.Background()
+	rootCoord := mocks.NewMockMixCoordClient(t)
+	rootCoord.EXPECT().ShowLoadCollections(mock.Anything, mock.Anything).Return(&querypb.ShowCollectionsResponse{}, nil).Maybe()
+	rootCoord.EXPECT().ShowPartitions(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+		Status: merr.Success(),
+	}, nil).Maybe()
+	cache, err := NewMetaCache(rootCoord)
+	assert.NoError(t, err)
+
+	describe := func(collectionID int64, requestTime uint64) {
+		rootCoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+			Status:       merr.Success(),
+			Schema:       &schemapb.CollectionSchema{Name: "collection1"},
+			CollectionID: collectionID,
+			DbName:       dbName,
+			RequestTime:  requestTime,
+		}, nil).Once()
+	}
+
+	// the alias is altered at 100, the describe started before it shall not cache the old collection
+	describe(111, 90)
+	cache.ExpireCollectionName(ctx, dbName, "alias1", 100)
+	collInfo, err := cache.update(ctx, dbName, "alias1", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(111), collInfo.collID)
+	_, ok := cache.collInfo[dbName]["alias1"]
+	assert.False(t, ok)
+
+	describe(222, 110)
+	collInfo, err = cache.update(ctx, dbName, "alias1", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(222), collInfo.collID)
+	_, ok = cache.collInfo[dbName]["alias1"]
+	assert.True(t, ok)
+
+	// the smaller version doesn't roll back the name version
+	cache.ExpireCollectionName(ctx, dbName, "alias1", 50)
+	_, ok = cache.collInfo[dbName]["alias1"]
+	assert.False(t, ok)
+	assert.Equal(t, uint64(100), cache.nameCacheVersion[buildSfKeyByName(dbName, "alias1")])
+
+	// the versions expired before the retention are pruned
+	now := tsoutil.ComposeTSByTime(time.Now(), 0)
+	cache.ExpireCollectionName(ctx, dbName, "alias2", now)
+	_, ok = cache.nameCacheVersion[buildSfKeyByName(dbName, "alias1")]
+	assert.False(t, ok)
+	assert.Equal(t, now, cache.nameCacheVersion[buildSfKeyByName(dbName, "alias2")])
+
+	// the versions are removed with the database
+	cache.RemoveDatabase(ctx, dbName)
+	assert.Empty(t, cache.nameCacheVersion)
+}
@@ -77,6 +77,42 @@ func (_c *MockCache_AllocID_Call) RunAndReturn(run func(context.Context) (int64,
 	return _c
 }
 
+// ExpireCollectionName provides a mock function with given fields: ctx, database, collectionName, version
+func (_m *MockCache) ExpireCollectionName(ctx context.Context, database string, collectionName string, version uint64) {
+	_m.Called(ctx, database, collectionName, version)
+}
+
+// MockCache_ExpireCollectionName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireCollectionName'
+type MockCache_ExpireCollectionName_Call struct {
+	*mock.Call
+}
+
+// ExpireCollectionName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - database string
+//   - collectionName string
+//   - version uint64
+func (_e *MockCache_Expecter) ExpireCollectionName(ctx interface{}, database interface{}, collectionName interface{}, version interface{}) *MockCache_ExpireCollectionName_Call {
+	return &MockCache_ExpireCollectionName_Call{Call: _e.mock.On("ExpireCollectionName", ctx, database, collectionName, version)}
+}
+
+func (_c *MockCache_ExpireCollectionName_Call) Run(run func(ctx context.Context, database string, collectionName string, version uint64)) *MockCache_ExpireCollectionName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockCache_ExpireCollectionName_Call) Return() *MockCache_ExpireCollectionName_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCache_ExpireCollectionName_Call) RunAndReturn(run func(context.Context, string, string, uint64)) *MockCache_ExpireCollectionName_Call {
+	_c.Run(run)
+	return _c
+}
+
 // GetCollectionID provides a mock function with given fields: ctx, database, collectionName
 func (_m *MockCache) GetCollectionID(ctx context.Context, database string, collectionName string) (int64, error) {
 	ret := _m.Called(ctx, database, collectionName)
@@ -432,6 +468,67 @@ func (_c *MockCache_GetPartitionID_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetPartitionIDs provides a mock function with given fields: ctx, database, collectionName, partitionNames
+func (_m *MockCache) GetPartitionIDs(ctx context.Context, database string, collectionName string, partitionNames []string) ([]int64, error) {
+	ret := _m.Called(ctx, database, collectionName, partitionNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPartitionIDs")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) ([]int64, error)); ok {
+		return rf(ctx, database, collectionName, partitionNames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) []int64); ok {
+		r0 = rf(ctx, database, collectionName, partitionNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []string) error); ok {
+		r1 = rf(ctx, database, collectionName, partitionNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCache_GetPartitionIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionIDs'
+type MockCache_GetPartitionIDs_Call struct {
+	*mock.Call
+}
+
+// GetPartitionIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - database string
+//   - collectionName string
+//   - partitionNames []string
+func (_e *MockCache_Expecter) GetPartitionIDs(ctx interface{}, database interface{}, collectionName interface{}, partitionNames interface{}) *MockCache_GetPartitionIDs_Call {
+	return &MockCache_GetPartitionIDs_Call{Call: _e.mock.On("GetPartitionIDs", ctx, database, collectionName, partitionNames)}
+}
+
+func (_c *MockCache_GetPartitionIDs_Call) Run(run func(ctx context.Context, database string, collectionName string, partitionNames []string)) *MockCache_GetPartitionIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *MockCache_GetPartitionIDs_Call) Return(_a0 []int64, _a1 error) *MockCache_GetPartitionIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCache_GetPartitionIDs_Call) RunAndReturn(run func(context.Context, string, string, []string) ([]int64, error)) *MockCache_GetPartitionIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPartitionInfo provides a mock function with given fields: ctx, database, collectionName, partitionName
 func (_m *MockCache) GetPartitionInfo(ctx context.Context, database string, collectionName string, partitionName string) (*partitionInfo, error) {
 	ret := _m.Called(ctx, database, collectionName, partitionName)
@@ -0,0 +1,129 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const (
+	// MutationResultDetailHeader is the request header to ask for the status of each primary key in the mutation result.
+	MutationResultDetailHeader = "mutation-result-detail"
+	// MutationResultDetailKey is set in the status extra info with the status of each primary key in json.
+	MutationResultDetailKey = "pk_status"
+	// MutationResultDetailTruncatedKey is set in the status extra info once the primary keys exceed the limit.
+	MutationResultDetailTruncatedKey = "pk_status_truncated"
+)
+
+const (
+	PkStatusAccepted            = "accepted"
+	PkStatusDuplicateSuppressed = "duplicate_suppressed"
+	PkStatusRejected            = "rejected"
+)
+
+// pkStatus is the status of a primary key in the mutation.
+type pkStatus struct {
+	PK     any    `json:"pk"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// isMutationResultDetailRequested returns whether the request asks for the detailed mutation result.
+func isMutationResultDetailRequested(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(MutationResultDetailHeader)
+	if len(values) == 0 {
+		return false
+	}
+	enabled, _ := strconv.ParseBool(strings.TrimSpace(values[0]))
+	return enabled
+}
+
+// buildPkStatus returns the status of each primary key in the mutation, at most limit primary keys are returned.
+// The rows in the error index are rejected, and if dedup is true, the earlier rows of a duplicated primary key
+// are suppressed by the last one.
+func buildPkStatus(ids *schemapb.IDs, dedup bool, errIndex []uint32, limit int) ([]*pkStatus, bool) {
+	num := typeutil.GetSizeOfIDs(ids)
+	rejected := typeutil.NewSet(errIndex...)
+	var lastIndex map[any]int
+	if dedup {
+		lastIndex = make(map[any]int, num)
+		for i := 0; i < num; i++ {
+			lastIndex[typeutil.GetPK(ids, int64(i))] = i
+		}
+	}
+
+	result := make([]*pkStatus, 0, min(num, limit))
+	for i := 0; i < num; i++ {
+		if len(result) >= limit {
+			return result, true
+		}
+		pk := typeutil.GetPK(ids, int64(i))
+		switch {
+		case rejected.Contain(uint32(i)):
+			result = append(result, &pkStatus{PK: pk, Status: PkStatusRejected, Reason: "rejected by the row check"})
+		case dedup && lastIndex[pk] != i:
+			result = append(result, &pkStatus{PK: pk, Status: PkStatusDuplicateSuppressed, Reason: "suppressed by the later row of the same primary key"})
+		default:
+			result = append(result, &pkStatus{PK: pk, Status: PkStatusAccepted})
+		}
+	}
+	return result, false
+}
+
+// setMutationResultDetail sets the status of each primary key in the status extra info of the mutation result
+// if the request asks for it. Nothing is set if the whole mutation fails, since the failure is not of any single key.
+func setMutationResultDetail(ctx context.Context, result *milvuspb.MutationResult, ids *schemapb.IDs, dedup bool) {
+	limit := Params.ProxyCfg.MutationResultDetailLimit.GetAsInt()
+	if limit <= 0 || typeutil.GetSizeOfIDs(ids) == 0 || !isMutationResultDetailRequested(ctx) {
+		return
+	}
+	if result.Status == nil {
+		result.Status = merr.Success()
+	}
+	if !merr.Ok(result.GetStatus()) {
+		return
+	}
+	statuses, truncated := buildPkStatus(ids, dedup, result.GetErrIndex(), limit)
+	bs, err := json.Marshal(statuses)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to marshal the status of primary keys", zap.Error(err))
+		return
+	}
+	if result.Status.ExtraInfo == nil {
+		result.Status.ExtraInfo = make(map[string]string)
+	}
+	result.Status.ExtraInfo[MutationResultDetailKey] = string(bs)
+	if truncated {
+		result.Status.ExtraInfo[MutationResultDetailTruncatedKey] = "true"
+	}
+}
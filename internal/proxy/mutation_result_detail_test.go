@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestIsMutationResultDetailRequested(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, isMutationResultDetailRequested(ctx))
+	assert.False(t, isMutationResultDetailRequested(metadata.NewIncomingContext(ctx, metadata.Pairs("foo", "bar"))))
+	assert.False(t, isMutationResultDetailRequested(metadata.NewIncomingContext(ctx, metadata.Pairs(MutationResultDetailHeader, "false"))))
+	assert.True(t, isMutationResultDetailRequested(metadata.NewIncomingContext(ctx, metadata.Pairs(MutationResultDetailHeader, "true"))))
+}
+
+func TestBuildPkStatus(t *testing.T) {
+	ids := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 1, 3}}}}
+
+	t.Run("accepted", func(t *testing.T) {
+		statuses, truncated := buildPkStatus(ids, false, nil, 10)
+		assert.False(t, truncated)
+		assert.Len(t, statuses, 4)
+		for _, status := range statuses {
+			assert.Equal(t, PkStatusAccepted, status.Status)
+		}
+	})
+
+	t.Run("dedup", func(t *testing.T) {
+		statuses, _ := buildPkStatus(ids, true, nil, 10)
+		assert.Equal(t, PkStatusDuplicateSuppressed, statuses[0].Status)
+		assert.Equal(t, PkStatusAccepted, statuses[1].Status)
+		assert.Equal(t, PkStatusAccepted, statuses[2].Status)
+		assert.Equal(t, PkStatusAccepted, statuses[3].Status)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		statuses, _ := buildPkStatus(ids, false, []uint32{3}, 10)
+		assert.Equal(t, PkStatusAccepted, statuses[2].Status)
+		assert.Equal(t, PkStatusRejected, statuses[3].Status)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		statuses, truncated := buildPkStatus(ids, false, nil, 2)
+		assert.True(t, truncated)
+		assert.Len(t, statuses, 2)
+	})
+
+	t.Run("varchar", func(t *testing.T) {
+		ids := &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", "a"}}}}
+		statuses, _ := buildPkStatus(ids, true, nil, 10)
+		assert.Equal(t, "a", statuses[0].PK)
+		assert.Equal(t, PkStatusDuplicateSuppressed, statuses[0].Status)
+		assert.Equal(t, PkStatusAccepted, statuses[1].Status)
+	})
+}
+
+func TestSetMutationResultDetail(t *testing.T) {
+	paramtable.Init()
+	ids := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MutationResultDetailHeader, "true"))
+
+	result := &milvuspb.MutationResult{Status: merr.Success()}
+	setMutationResultDetail(context.Background(), result, ids, false)
+	assert.Empty(t, result.GetStatus().GetExtraInfo())
+
+	setMutationResultDetail(ctx, result, ids, false)
+	var statuses []*pkStatus
+	assert.NoError(t, json.Unmarshal([]byte(result.GetStatus().GetExtraInfo()[MutationResultDetailKey]), &statuses))
+	assert.Len(t, statuses, 2)
+	assert.Empty(t, result.GetStatus().GetExtraInfo()[MutationResultDetailTruncatedKey])
+
+	// the failure of the whole mutation is not reported per primary key
+	result = &milvuspb.MutationResult{Status: merr.Status(merr.WrapErrParameterInvalidMsg("mock"))}
+	setMutationResultDetail(ctx, result, ids, false)
+	assert.Empty(t, result.GetStatus().GetExtraInfo())
+
+	paramtable.Get().Save(Params.ProxyCfg.MutationResultDetailLimit.Key, "1")
+	defer paramtable.Get().Reset(Params.ProxyCfg.MutationResultDetailLimit.Key)
+	result = &milvuspb.MutationResult{}
+	setMutationResultDetail(ctx, result, ids, false)
+	assert.Equal(t, "true", result.GetStatus().GetExtraInfo()[MutationResultDetailTruncatedKey])
+
+	paramtable.Get().Save(Params.ProxyCfg.MutationResultDetailLimit.Key, "0")
+	result = &milvuspb.MutationResult{Status: merr.Success()}
+	setMutationResultDetail(ctx, result, ids, false)
+	assert.Empty(t, result.GetStatus().GetExtraInfo())
+}
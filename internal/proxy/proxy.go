@@ -118,6 +118,8 @@ type Proxy struct {
 	enableComplexDeleteLimit bool
 
 	slowQueries *expirable.LRU[Timestamp, *metricsinfo.SlowQuery]
+
+	trafficMirror *trafficMirror
 }
 
 // NewProxy returns a Proxy struct.
@@ -136,6 +138,7 @@ func NewProxy(ctx context.Context, _ dependency.Factory) (*Proxy, error) {
 		resourceManager: resourceManager,
 		slowQueries:     expirable.NewLRU[Timestamp, *metricsinfo.SlowQuery](20, nil, time.Minute*15),
 	}
+	node.trafficMirror = newTrafficMirror(node, node.simpleLimiter)
 	node.UpdateStateCode(commonpb.StateCode_Abnormal)
 	expr.Register("proxy", node)
 	hookutil.InitOnceHook()
@@ -340,6 +343,10 @@ func (node *Proxy) Stop() error {
 		node.resourceManager.Close()
 	}
 
+	if node.trafficMirror != nil {
+		node.trafficMirror.Close()
+	}
+
 	node.cancel()
 	node.wg.Wait()
 
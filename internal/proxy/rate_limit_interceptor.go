@@ -123,13 +123,9 @@ func getCollectionAndPartitionIDs(ctx context.Context, r reqPartNames) (int64, m
 	if err != nil {
 		return 0, nil, err
 	}
-	parts := make([]int64, len(r.GetPartitionNames()))
-	for i, s := range r.GetPartitionNames() {
-		part, err := globalMetaCache.GetPartitionInfo(ctx, r.GetDbName(), r.GetCollectionName(), s)
-		if err != nil {
-			return 0, nil, err
-		}
-		parts[i] = part.partitionID
+	parts, err := globalMetaCache.GetPartitionIDs(ctx, r.GetDbName(), r.GetCollectionName(), r.GetPartitionNames())
+	if err != nil {
+		return 0, nil, err
 	}
 
 	return db.dbID, map[int64][]int64{collectionID: parts}, nil
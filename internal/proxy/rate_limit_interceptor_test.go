@@ -64,6 +64,7 @@ func TestRateLimitInterceptor(t *testing.T) {
 			createdTimestamp:    10001,
 			createdUtcTimestamp: 10002,
 		}, nil)
+		mockCache.EXPECT().GetPartitionIDs(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]int64{10}, nil)
 		mockCache.EXPECT().GetDatabaseInfo(mock.Anything, mock.Anything).Return(&databaseInfo{
 			dbID:             100,
 			createdTimestamp: 1,
@@ -479,7 +480,8 @@ func TestGetInfo(t *testing.T) {
 			createdTimestamp: 1,
 		}, nil).Twice()
 		mockCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil).Twice()
-		mockCache.EXPECT().GetPartitionInfo(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("mock error: get partition info")).Twice()
+		mockCache.EXPECT().GetPartitionInfo(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("mock error: get partition info")).Once()
+		mockCache.EXPECT().GetPartitionIDs(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("mock error: get partition ids")).Once()
 		{
 			_, _, err := getCollectionAndPartitionID(ctx, &milvuspb.InsertRequest{
 				DbName:         "foo",
@@ -508,7 +510,8 @@ func TestGetInfo(t *testing.T) {
 		mockCache.EXPECT().GetPartitionInfo(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&partitionInfo{
 			name:        "p1",
 			partitionID: 100,
-		}, nil).Times(3)
+		}, nil).Times(2)
+		mockCache.EXPECT().GetPartitionIDs(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]int64{100}, nil).Times(1)
 		{
 			db, col2par, err := getCollectionAndPartitionID(ctx, &milvuspb.InsertRequest{
 				DbName:         "foo",
@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const (
+	// ResponseTruncatedKey is set in the status extra info once the results are truncated by the max response size.
+	ResponseTruncatedKey = "truncated"
+	// ResponseCursorKey is the position to continue with after truncation,
+	// it's the offset for query and the index of the first query not returned for search.
+	ResponseCursorKey = "cursor"
+)
+
+// getResponseSizeLimit returns the size to truncate the results, 0 means the truncation is disabled.
+func getResponseSizeLimit(maxResponseSize, maxOutputSize int64) int64 {
+	if maxResponseSize <= 0 {
+		return 0
+	}
+	return min(maxResponseSize, maxOutputSize)
+}
+
+func markResponseTruncated(status *commonpb.Status, cursor int64) *commonpb.Status {
+	if status == nil {
+		status = merr.Success()
+	}
+	if status.ExtraInfo == nil {
+		status.ExtraInfo = make(map[string]string)
+	}
+	status.ExtraInfo[ResponseTruncatedKey] = "true"
+	status.ExtraInfo[ResponseCursorKey] = strconv.FormatInt(cursor, 10)
+	return status
+}
+
+// truncateSearchResultData keeps the results of the leading queries within the size limit,
+// the results of a query are either all kept or all dropped.
+// It returns the number of queries kept and whether the results are truncated.
+func truncateSearchResultData(data *schemapb.SearchResultData, sizeLimit int64) (int64, bool, error) {
+	nq := int64(len(data.GetTopks()))
+	if sizeLimit <= 0 || int64(proto.Size(data)) <= sizeLimit {
+		return nq, false, nil
+	}
+
+	var (
+		size     int64
+		kept     int64
+		keptRows int64
+	)
+	for ; kept < nq; kept++ {
+		topk := data.GetTopks()[kept]
+		// scores and ids are counted roughly
+		querySize := topk * 12
+		scratch := typeutil.PrepareResultFieldData(data.GetFieldsData(), topk)
+		for row := keptRows; row < keptRows+topk; row++ {
+			typeutil.AppendFieldData(scratch, data.GetFieldsData(), row)
+		}
+		for _, fieldData := range scratch {
+			querySize += int64(proto.Size(fieldData))
+		}
+		if size+querySize > sizeLimit {
+			break
+		}
+		size += querySize
+		keptRows += topk
+	}
+	if kept == 0 {
+		return 0, false, merr.WrapErrParameterInvalidMsg("the results of the first query exceed the max response size %d, "+
+			"please reduce the limit or the output fields", sizeLimit)
+	}
+	if kept == nq {
+		return nq, false, nil
+	}
+
+	fieldsData := typeutil.PrepareResultFieldData(data.GetFieldsData(), keptRows)
+	for row := int64(0); row < keptRows; row++ {
+		typeutil.AppendFieldData(fieldsData, data.GetFieldsData(), row)
+	}
+	data.FieldsData = fieldsData
+	if groupByValue := data.GetGroupByFieldValue(); groupByValue != nil {
+		truncated := typeutil.PrepareResultFieldData([]*schemapb.FieldData{groupByValue}, keptRows)
+		for row := int64(0); row < keptRows; row++ {
+			typeutil.AppendFieldData(truncated, []*schemapb.FieldData{groupByValue}, row)
+		}
+		data.GroupByFieldValue = truncated[0]
+	}
+	switch ids := data.GetIds().GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		ids.IntId.Data = ids.IntId.GetData()[:keptRows]
+	case *schemapb.IDs_StrId:
+		ids.StrId.Data = ids.StrId.GetData()[:keptRows]
+	}
+	data.Scores = data.GetScores()[:min(keptRows, int64(len(data.GetScores())))]
+	data.Distances = data.GetDistances()[:min(keptRows, int64(len(data.GetDistances())))]
+	data.Recalls = data.GetRecalls()[:min(kept, int64(len(data.GetRecalls())))]
+	for _, highlight := range data.GetHighlightResults() {
+		highlight.Datas = highlight.GetDatas()[:min(keptRows, int64(len(highlight.GetDatas())))]
+	}
+	data.Topks = data.GetTopks()[:kept]
+	data.NumQueries = kept
+	return kept, true, nil
+}
@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+const truncationTestDim = 32
+
+func newTruncationSearchResultData(nq, topk int64) *schemapb.SearchResultData {
+	rows := nq * topk
+	ids := make([]int64, rows)
+	scores := make([]float32, rows)
+	vectors := make([]float32, rows*truncationTestDim)
+	for i := range ids {
+		ids[i] = int64(i)
+		scores[i] = float32(i)
+	}
+	for i := range vectors {
+		vectors[i] = float32(i)
+	}
+	topks := make([]int64, nq)
+	for i := range topks {
+		topks[i] = topk
+	}
+	return &schemapb.SearchResultData{
+		NumQueries: nq,
+		TopK:       topk,
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}},
+		},
+		Scores:     scores,
+		Topks:      topks,
+		FieldsData: []*schemapb.FieldData{getFieldData("FloatVectorField", common.StartOfUserFieldID+1, schemapb.DataType_FloatVector, vectors, truncationTestDim)},
+	}
+}
+
+func TestGetResponseSizeLimit(t *testing.T) {
+	assert.EqualValues(t, 0, getResponseSizeLimit(0, 100))
+	assert.EqualValues(t, 0, getResponseSizeLimit(-1, 100))
+	assert.EqualValues(t, 50, getResponseSizeLimit(50, 100))
+	assert.EqualValues(t, 100, getResponseSizeLimit(200, 100))
+}
+
+func TestMarkResponseTruncated(t *testing.T) {
+	status := markResponseTruncated(nil, 10)
+	assert.True(t, merr.Ok(status))
+	assert.Equal(t, "true", status.GetExtraInfo()[ResponseTruncatedKey])
+	assert.Equal(t, "10", status.GetExtraInfo()[ResponseCursorKey])
+}
+
+func TestTruncateSearchResultData(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		data := newTruncationSearchResultData(3, 4)
+		kept, truncated, err := truncateSearchResultData(data, 0)
+		assert.NoError(t, err)
+		assert.False(t, truncated)
+		assert.EqualValues(t, 3, kept)
+		assert.Len(t, data.GetScores(), 12)
+	})
+
+	t.Run("within limit", func(t *testing.T) {
+		data := newTruncationSearchResultData(3, 4)
+		kept, truncated, err := truncateSearchResultData(data, 1<<20)
+		assert.NoError(t, err)
+		assert.False(t, truncated)
+		assert.EqualValues(t, 3, kept)
+	})
+
+	t.Run("truncated by query", func(t *testing.T) {
+		data := newTruncationSearchResultData(3, 4)
+		// each query takes a bit more than 4 * (12 + 128) bytes
+		kept, truncated, err := truncateSearchResultData(data, 1200)
+		assert.NoError(t, err)
+		assert.True(t, truncated)
+		assert.EqualValues(t, 2, kept)
+		assert.EqualValues(t, 2, data.GetNumQueries())
+		assert.Equal(t, []int64{4, 4}, data.GetTopks())
+		assert.Equal(t, []int64{0, 1, 2, 3, 4, 5, 6, 7}, data.GetIds().GetIntId().GetData())
+		assert.Len(t, data.GetScores(), 8)
+		assert.Len(t, data.GetFieldsData()[0].GetVectors().GetFloatVector().GetData(), 8*truncationTestDim)
+	})
+
+	t.Run("first query exceeds", func(t *testing.T) {
+		data := newTruncationSearchResultData(3, 4)
+		_, _, err := truncateSearchResultData(data, 100)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+}
@@ -649,6 +649,26 @@ func (coord *MixCoordMock) ShowCollectionIDs(ctx context.Context, req *rootcoord
 	panic("implements me")
 }
 
+func (coord *MixCoordMock) SetCollectionQuotaOverride(ctx context.Context, req *rootcoordpb.SetCollectionQuotaOverrideRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (coord *MixCoordMock) CreateCollectionTemplate(ctx context.Context, req *rootcoordpb.CreateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (coord *MixCoordMock) DropCollectionTemplate(ctx context.Context, req *rootcoordpb.DropCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (coord *MixCoordMock) ListCollectionTemplates(ctx context.Context, req *rootcoordpb.ListCollectionTemplatesRequest, opts ...grpc.CallOption) (*rootcoordpb.ListCollectionTemplatesResponse, error) {
+	panic("implement me")
+}
+
+func (coord *MixCoordMock) GetUsage(ctx context.Context, req *rootcoordpb.GetUsageRequest, opts ...grpc.CallOption) (*rootcoordpb.GetUsageResponse, error) {
+	panic("implement me")
+}
+
 func (coord *MixCoordMock) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	code := coord.state.Load().(commonpb.StateCode)
 	if code != commonpb.StateCode_Healthy {
@@ -1247,6 +1267,10 @@ func (coord *MixCoordMock) MarkSegmentsDropped(ctx context.Context, req *datapb.
 	panic("implement me")
 }
 
+func (coord *MixCoordMock) UpdateSegmentStatslogs(ctx context.Context, req *datapb.UpdateSegmentStatslogsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 func (coord *MixCoordMock) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	panic("implement me")
 }
@@ -1639,6 +1663,34 @@ func (coord *MixCoordMock) FlushAll(ctx context.Context, in *datapb.FlushAllRequ
 	return &datapb.FlushAllResponse{}, nil
 }
 
+func (coord *MixCoordMock) FlushAndSeal(ctx context.Context, in *datapb.FlushAndSealRequest, opts ...grpc.CallOption) (*datapb.FlushAndSealResponse, error) {
+	return &datapb.FlushAndSealResponse{}, nil
+}
+
+func (coord *MixCoordMock) ListQuarantinedSegments(ctx context.Context, in *datapb.ListQuarantinedSegmentsRequest, opts ...grpc.CallOption) (*datapb.ListQuarantinedSegmentsResponse, error) {
+	return &datapb.ListQuarantinedSegmentsResponse{}, nil
+}
+
+func (coord *MixCoordMock) OperateQuarantinedSegment(ctx context.Context, in *datapb.OperateQuarantinedSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (coord *MixCoordMock) RebuildStatslogs(ctx context.Context, in *datapb.RebuildStatslogsRequest, opts ...grpc.CallOption) (*datapb.RebuildStatslogsResponse, error) {
+	return &datapb.RebuildStatslogsResponse{}, nil
+}
+
+func (coord *MixCoordMock) GetStatslogRebuildJob(ctx context.Context, in *datapb.GetStatslogRebuildJobRequest, opts ...grpc.CallOption) (*datapb.GetStatslogRebuildJobResponse, error) {
+	return &datapb.GetStatslogRebuildJobResponse{}, nil
+}
+
+func (coord *MixCoordMock) ReportChannelHealth(ctx context.Context, in *datapb.ReportChannelHealthRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (coord *MixCoordMock) ListBackgroundJobs(ctx context.Context, in *datapb.ListBackgroundJobsRequest, opts ...grpc.CallOption) (*datapb.ListBackgroundJobsResponse, error) {
+	return &datapb.ListBackgroundJobsResponse{}, nil
+}
+
 func (coord *MixCoordMock) AddFileResource(ctx context.Context, req *milvuspb.AddFileResourceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return merr.Success(), nil
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/function/rerank"
 	"github.com/milvus-io/milvus/internal/util/segcore"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
@@ -147,6 +148,7 @@ type searchReduceOperator struct {
 	collectionID       int64
 	partitionIDs       []int64
 	queryInfos         []*planpb.QueryInfo
+	stableOrder        bool
 }
 
 func newSearchReduceOperator(t *searchTask, _ map[string]any) (operator, error) {
@@ -163,6 +165,7 @@ func newSearchReduceOperator(t *searchTask, _ map[string]any) (operator, error)
 		collectionID:       t.GetCollectionID(),
 		partitionIDs:       t.GetPartitionIDs(),
 		queryInfos:         t.queryInfos,
+		stableOrder:        t.GetBase().GetProperties()[common.StableOrderKey] == "true",
 	}, nil
 }
 
@@ -173,7 +176,7 @@ func (op *searchReduceOperator) run(ctx context.Context, span trace.Span, inputs
 	metricType := getMetricType(toReduceResults)
 	result, err := reduceResults(
 		op.traceCtx, toReduceResults, op.nq, op.topK, op.offset,
-		metricType, op.primaryFieldSchema.GetDataType(), op.queryInfos[0], false, op.collectionID, op.partitionIDs)
+		metricType, op.primaryFieldSchema.GetDataType(), op.queryInfos[0], false, op.stableOrder, op.collectionID, op.partitionIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -240,7 +243,7 @@ func (op *hybridSearchReduceOperator) run(ctx context.Context, span trace.Span,
 		subMetricType := getMetricType(internalResults)
 		result, err := reduceResults(
 			op.traceCtx, internalResults, subReq.GetNq(), subReq.GetTopk(), subReq.GetOffset(), subMetricType,
-			op.primaryFieldSchema.GetDataType(), op.queryInfos[index], true, op.collectionID, op.partitionIDs)
+			op.primaryFieldSchema.GetDataType(), op.queryInfos[index], true, false, op.collectionID, op.partitionIDs)
 		if err != nil {
 			return nil, err
 		}
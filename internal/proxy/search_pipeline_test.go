@@ -78,6 +78,7 @@ func (s *SearchPipelineSuite) TestSearchReduceOp() {
 		1,
 		[]int64{1},
 		[]*planpb.QueryInfo{{}},
+		false,
 	}
 	_, err := op.run(context.Background(), s.span, []*internalpb.SearchResults{data})
 	s.NoError(err)
@@ -170,6 +171,7 @@ func (s *SearchPipelineSuite) TestRerankOp() {
 		1,
 		[]int64{1},
 		[]*planpb.QueryInfo{{}},
+		false,
 	}
 
 	data := genTestSearchResultData(nq, topk, schemapb.DataType_Int64, "intField", 102, false)
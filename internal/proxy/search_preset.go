@@ -0,0 +1,117 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// searchPreset is a named group of search parameters stored in collection properties,
+// e.g. `collection.searchPreset.fast` = `{"metric_type": "L2", "params": {"ef": 32}}`.
+type searchPreset struct {
+	MetricType string         `json:"metric_type,omitempty"`
+	Params     map[string]any `json:"params,omitempty"`
+}
+
+func parseSearchPreset(name, value string) (*searchPreset, error) {
+	preset := &searchPreset{}
+	if err := json.Unmarshal([]byte(value), preset); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid search preset %s: %s", name, err.Error())
+	}
+	if preset.MetricType == "" && len(preset.Params) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("search preset %s is empty", name)
+	}
+	return preset, nil
+}
+
+// validateSearchPresets checks all the search presets in the collection properties are well-formed.
+func validateSearchPresets(props ...*commonpb.KeyValuePair) error {
+	for _, kv := range props {
+		if !strings.HasPrefix(kv.GetKey(), common.CollectionSearchPresetKeyPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(kv.GetKey(), common.CollectionSearchPresetKeyPrefix)
+		if name == "" {
+			return merr.WrapErrParameterInvalidMsg("search preset name should not be empty")
+		}
+		if _, err := parseSearchPreset(name, kv.GetValue()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applySearchPreset expands the preset referenced by `search_preset` in the search params,
+// the parameters specified in the request explicitly take precedence over the preset.
+func applySearchPreset(searchParams []*commonpb.KeyValuePair, properties []*commonpb.KeyValuePair) ([]*commonpb.KeyValuePair, error) {
+	name, exist := funcutil.TryGetAttrByKeyFromRepeatedKV(common.SearchPresetKey, searchParams)
+	if !exist {
+		return searchParams, nil
+	}
+	value, exist := funcutil.TryGetAttrByKeyFromRepeatedKV(common.CollectionSearchPresetKeyPrefix+name, properties)
+	if !exist {
+		return nil, merr.WrapErrParameterInvalidMsg("search preset %s not found in collection properties", name)
+	}
+	preset, err := parseSearchPreset(name, value)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]any, len(preset.Params))
+	for k, v := range preset.Params {
+		params[k] = v
+	}
+	hasMetricType := false
+	ret := make([]*commonpb.KeyValuePair, 0, len(searchParams)+1)
+	for _, kv := range searchParams {
+		switch kv.GetKey() {
+		case common.SearchPresetKey:
+			continue
+		case ParamsKey:
+			requestParams := make(map[string]any)
+			if kv.GetValue() != "" {
+				if err := json.Unmarshal([]byte(kv.GetValue()), &requestParams); err != nil {
+					return nil, merr.WrapErrParameterInvalidMsg("invalid %s: %s", ParamsKey, err.Error())
+				}
+			}
+			for k, v := range requestParams {
+				params[k] = v
+			}
+			continue
+		case common.MetricTypeKey:
+			hasMetricType = true
+		}
+		ret = append(ret, kv)
+	}
+	if !hasMetricType && preset.MetricType != "" {
+		ret = append(ret, &commonpb.KeyValuePair{Key: common.MetricTypeKey, Value: preset.MetricType})
+	}
+	if len(params) > 0 {
+		bs, err := json.Marshal(params)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, &commonpb.KeyValuePair{Key: ParamsKey, Value: string(bs)})
+	}
+	return ret, nil
+}
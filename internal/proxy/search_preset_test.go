@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+)
+
+func TestValidateSearchPresets(t *testing.T) {
+	assert.NoError(t, validateSearchPresets())
+	assert.NoError(t, validateSearchPresets(
+		&commonpb.KeyValuePair{Key: common.CollectionTTLConfigKey, Value: "10"},
+		&commonpb.KeyValuePair{Key: common.CollectionSearchPresetKeyPrefix + "fast", Value: `{"metric_type": "L2", "params": {"ef": 32}}`},
+	))
+
+	assert.Error(t, validateSearchPresets(&commonpb.KeyValuePair{Key: common.CollectionSearchPresetKeyPrefix, Value: `{"metric_type": "L2"}`}))
+	assert.Error(t, validateSearchPresets(&commonpb.KeyValuePair{Key: common.CollectionSearchPresetKeyPrefix + "bad", Value: `not json`}))
+	assert.Error(t, validateSearchPresets(&commonpb.KeyValuePair{Key: common.CollectionSearchPresetKeyPrefix + "empty", Value: `{}`}))
+}
+
+func TestApplySearchPreset(t *testing.T) {
+	properties := []*commonpb.KeyValuePair{
+		{Key: common.CollectionSearchPresetKeyPrefix + "fast", Value: `{"metric_type": "L2", "params": {"ef": 32, "nprobe": 8}}`},
+	}
+
+	t.Run("no preset", func(t *testing.T) {
+		searchParams := []*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}}
+		ret, err := applySearchPreset(searchParams, properties)
+		assert.NoError(t, err)
+		assert.Equal(t, searchParams, ret)
+	})
+
+	t.Run("apply preset", func(t *testing.T) {
+		ret, err := applySearchPreset([]*commonpb.KeyValuePair{
+			{Key: TopKKey, Value: "10"},
+			{Key: common.SearchPresetKey, Value: "fast"},
+		}, properties)
+		assert.NoError(t, err)
+		_, exist := funcutil.TryGetAttrByKeyFromRepeatedKV(common.SearchPresetKey, ret)
+		assert.False(t, exist)
+		metricType, _ := funcutil.TryGetAttrByKeyFromRepeatedKV(common.MetricTypeKey, ret)
+		assert.Equal(t, "L2", metricType)
+		params, _ := funcutil.TryGetAttrByKeyFromRepeatedKV(ParamsKey, ret)
+		assert.JSONEq(t, `{"ef": 32, "nprobe": 8}`, params)
+	})
+
+	t.Run("request overrides preset", func(t *testing.T) {
+		ret, err := applySearchPreset([]*commonpb.KeyValuePair{
+			{Key: common.SearchPresetKey, Value: "fast"},
+			{Key: common.MetricTypeKey, Value: "IP"},
+			{Key: ParamsKey, Value: `{"ef": 64}`},
+		}, properties)
+		assert.NoError(t, err)
+		metricType, _ := funcutil.TryGetAttrByKeyFromRepeatedKV(common.MetricTypeKey, ret)
+		assert.Equal(t, "IP", metricType)
+		params, _ := funcutil.TryGetAttrByKeyFromRepeatedKV(ParamsKey, ret)
+		assert.JSONEq(t, `{"ef": 64, "nprobe": 8}`, params)
+	})
+
+	t.Run("preset not found", func(t *testing.T) {
+		_, err := applySearchPreset([]*commonpb.KeyValuePair{{Key: common.SearchPresetKey, Value: "slow"}}, properties)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid request params", func(t *testing.T) {
+		_, err := applySearchPreset([]*commonpb.KeyValuePair{
+			{Key: common.SearchPresetKey, Value: "fast"},
+			{Key: ParamsKey, Value: `not json`},
+		}, properties)
+		assert.Error(t, err)
+	})
+}
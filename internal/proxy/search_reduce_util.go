@@ -39,6 +39,11 @@ func reduceSearchResult(ctx context.Context, subSearchResultData []*schemapb.Sea
 			reduceInfo.GetOffset(),
 			reduceInfo.GetGroupSize())
 	}
+	if reduceInfo.GetStableOrder() {
+		for _, data := range subSearchResultData {
+			reduce.SortEqualScoresByPK(data)
+		}
+	}
 	return reduceSearchResultDataNoGroupBy(ctx,
 		subSearchResultData,
 		reduceInfo.GetNq(),
@@ -501,7 +506,7 @@ func fillInEmptyResult(numQueries int64) *milvuspb.SearchResults {
 	}
 }
 
-func reduceResults(ctx context.Context, toReduceResults []*internalpb.SearchResults, nq, topK, offset int64, metricType string, pkType schemapb.DataType, queryInfo *planpb.QueryInfo, isAdvance bool, collectionID int64, partitionIDs []int64) (*milvuspb.SearchResults, error) {
+func reduceResults(ctx context.Context, toReduceResults []*internalpb.SearchResults, nq, topK, offset int64, metricType string, pkType schemapb.DataType, queryInfo *planpb.QueryInfo, isAdvance bool, stableOrder bool, collectionID int64, partitionIDs []int64) (*milvuspb.SearchResults, error) {
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "reduceResults")
 	defer sp.End()
 
@@ -525,7 +530,7 @@ func reduceResults(ctx context.Context, toReduceResults []*internalpb.SearchResu
 		zap.Int("number of valid search results", len(validSearchResults)))
 	var result *milvuspb.SearchResults
 	result, err = reduceSearchResult(ctx, validSearchResults, reduce.NewReduceSearchResultInfo(nq, topK).WithMetricType(metricType).WithPkType(pkType).
-		WithOffset(offset).WithGroupByField(queryInfo.GetGroupByFieldId()).WithGroupSize(queryInfo.GetGroupSize()).WithAdvance(isAdvance))
+		WithOffset(offset).WithGroupByField(queryInfo.GetGroupByFieldId()).WithGroupSize(queryInfo.GetGroupSize()).WithAdvance(isAdvance).WithStableOrder(stableOrder))
 	if err != nil {
 		log.Warn("failed to reduce search results", zap.Error(err))
 		return nil, err
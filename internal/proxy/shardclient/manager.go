@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/registry"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
@@ -45,6 +46,7 @@ type ShardClientMgr interface {
 	DeprecateShardCache(database, collectionName string)
 	InvalidateShardLeaderCache(collections []int64)
 	ListShardLocation() map[int64]NodeInfo
+	IsPartialLoaded(database, collectionName string) bool
 	RemoveDatabase(database string)
 
 	GetClient(ctx context.Context, nodeInfo NodeInfo) (types.QueryNodeClient, error)
@@ -202,9 +204,10 @@ func (m *shardClientMgrImpl) updateShardLocationCache(ctx context.Context, datab
 	}
 
 	newShardLeaders := &shardLeaders{
-		collectionID: collectionID,
-		shardLeaders: shards,
-		idx:          atomic.NewInt64(0),
+		collectionID:  collectionID,
+		shardLeaders:  shards,
+		idx:           atomic.NewInt64(0),
+		partialLoaded: resp.GetStatus().GetExtraInfo()[common.PartialLoadedKey] == "true",
 	}
 
 	m.leaderMut.Lock()
@@ -257,6 +260,14 @@ func (m *shardClientMgrImpl) RemoveDatabase(database string) {
 	delete(m.collLeader, database)
 }
 
+// IsPartialLoaded returns whether the cached shard leaders report the collection as partially loaded
+func (m *shardClientMgrImpl) IsPartialLoaded(database, collectionName string) bool {
+	m.leaderMut.RLock()
+	defer m.leaderMut.RUnlock()
+	leaders, ok := m.collLeader[database][collectionName]
+	return ok && leaders.partialLoaded
+}
+
 // DeprecateShardCache clear the shard leader cache of a collection
 func (m *shardClientMgrImpl) DeprecateShardCache(database, collectionName string) {
 	log.Info("deprecate shard cache for collection", zap.String("collectionName", collectionName))
@@ -305,6 +305,53 @@ func (_c *MockShardClientManager_InvalidateShardLeaderCache_Call) RunAndReturn(r
 	return _c
 }
 
+// IsPartialLoaded provides a mock function with given fields: database, collectionName
+func (_m *MockShardClientManager) IsPartialLoaded(database string, collectionName string) bool {
+	ret := _m.Called(database, collectionName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsPartialLoaded")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(database, collectionName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockShardClientManager_IsPartialLoaded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsPartialLoaded'
+type MockShardClientManager_IsPartialLoaded_Call struct {
+	*mock.Call
+}
+
+// IsPartialLoaded is a helper method to define mock.On call
+//   - database string
+//   - collectionName string
+func (_e *MockShardClientManager_Expecter) IsPartialLoaded(database interface{}, collectionName interface{}) *MockShardClientManager_IsPartialLoaded_Call {
+	return &MockShardClientManager_IsPartialLoaded_Call{Call: _e.mock.On("IsPartialLoaded", database, collectionName)}
+}
+
+func (_c *MockShardClientManager_IsPartialLoaded_Call) Run(run func(database string, collectionName string)) *MockShardClientManager_IsPartialLoaded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockShardClientManager_IsPartialLoaded_Call) Return(_a0 bool) *MockShardClientManager_IsPartialLoaded_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockShardClientManager_IsPartialLoaded_Call) RunAndReturn(run func(string, string) bool) *MockShardClientManager_IsPartialLoaded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListShardLocation provides a mock function with no fields
 func (_m *MockShardClientManager) ListShardLocation() map[int64]NodeInfo {
 	ret := _m.Called()
@@ -28,6 +28,8 @@ type shardLeaders struct {
 	idx          *atomic.Int64
 	collectionID int64
 	shardLeaders map[string][]NodeInfo
+	// partialLoaded indicates some quarantined segments are excluded from the collection
+	partialLoaded bool
 }
 
 func (sl *shardLeaders) Get(channel string) []NodeInfo {
@@ -21,9 +21,11 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 
@@ -32,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proxy/shardclient"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
@@ -202,6 +205,7 @@ type createCollectionTask struct {
 	mixCoord types.MixCoordClient
 	result   *commonpb.Status
 	schema   *schemapb.CollectionSchema
+	template *rootcoordpb.CollectionTemplate
 }
 
 func (t *createCollectionTask) TraceCtx() context.Context {
@@ -355,6 +359,10 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 	t.Base.MsgType = commonpb.MsgType_CreateCollection
 	t.Base.SourceID = paramtable.GetNodeID()
 
+	if err := t.applyCollectionTemplate(ctx); err != nil {
+		return err
+	}
+
 	t.schema = &schemapb.CollectionSchema{}
 	err := proto.Unmarshal(t.Schema, t.schema)
 	if err != nil {
@@ -425,6 +433,18 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 		return merr.WrapErrParameterInvalidMsg("unknown or invalid IANA Time Zone ID: %s", tz)
 	}
 
+	if err := validateSearchPresets(t.GetProperties()...); err != nil {
+		return err
+	}
+
+	if err := validateBinlogCompression(t.schema, t.GetProperties()...); err != nil {
+		return err
+	}
+
+	if err := validateStrictPKMode(t.GetProperties()...); err != nil {
+		return err
+	}
+
 	// validate clustering key
 	if err := t.validateClusteringKey(ctx); err != nil {
 		return err
@@ -469,6 +489,42 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 	return nil
 }
 
+// applyCollectionTemplate fills the schema skeleton and the properties of the collection template if it's specified,
+// the schema and the properties in the request take precedence over the template.
+func (t *createCollectionTask) applyCollectionTemplate(ctx context.Context) error {
+	name, ok := funcutil.TryGetAttrByKeyFromRepeatedKV(common.CollectionTemplateKey, t.GetProperties())
+	if !ok {
+		return nil
+	}
+	template, err := getCollectionTemplate(ctx, t.mixCoord, name)
+	if err != nil {
+		return err
+	}
+
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(t.Schema, schema); err != nil {
+		return err
+	}
+	if len(schema.GetFields()) == 0 && template.GetSchema() != nil {
+		templateSchema := typeutil.Clone(template.GetSchema())
+		templateSchema.Name = t.GetCollectionName()
+		if schema.GetDescription() != "" {
+			templateSchema.Description = schema.GetDescription()
+		}
+		if t.Schema, err = proto.Marshal(templateSchema); err != nil {
+			return err
+		}
+	}
+
+	for _, property := range template.GetProperties() {
+		if _, ok := funcutil.TryGetAttrByKeyFromRepeatedKV(property.GetKey(), t.GetProperties()); !ok {
+			t.Properties = append(t.Properties, &commonpb.KeyValuePair{Key: property.GetKey(), Value: property.GetValue()})
+		}
+	}
+	t.template = template
+	return nil
+}
+
 func (t *createCollectionTask) Execute(ctx context.Context) error {
 	var err error
 	t.result, err = t.mixCoord.CreateCollection(ctx, t.CreateCollectionRequest)
@@ -1173,6 +1229,38 @@ func validatePartitionKeyIsolation(ctx context.Context, colName string, isPartit
 	return true, nil
 }
 
+// validateStrictPKMode validates the strict primary key mode in the collection properties,
+// the duplicates can only be dropped or rejected before they are appended into the wal of the streaming service.
+func validateStrictPKMode(props ...*commonpb.KeyValuePair) error {
+	mode, err := common.GetCollectionStrictPKMode(props)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	if (mode == common.StrictPKModeDedup || mode == common.StrictPKModeReject) && !streamingutil.IsStreamingServiceEnabled() {
+		return merr.WrapErrParameterInvalidMsg("%s %s requires the streaming service", common.CollectionStrictPKModeKey, mode)
+	}
+	return nil
+}
+
+// validateBinlogCompression validates the field level binlog compression in the collection properties.
+func validateBinlogCompression(schema *schemapb.CollectionSchema, props ...*commonpb.KeyValuePair) error {
+	for _, kv := range props {
+		fieldName, ok := strings.CutPrefix(kv.GetKey(), common.CollectionBinlogCompressionKeyPrefix)
+		if !ok {
+			continue
+		}
+		if !lo.ContainsBy(typeutil.GetAllFieldSchemas(schema), func(field *schemapb.FieldSchema) bool {
+			return field.GetName() == fieldName
+		}) {
+			return merr.WrapErrParameterInvalidMsg("field %s of binlog compression not found in collection", fieldName)
+		}
+		if _, _, err := common.ParseBinlogCompression(kv.GetValue()); err != nil {
+			return merr.WrapErrParameterInvalidMsg(err.Error())
+		}
+	}
+	return nil
+}
+
 func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 	if len(t.GetProperties()) > 0 && len(t.GetDeleteKeys()) > 0 {
 		return merr.WrapErrParameterInvalidMsg("cannot provide both DeleteKeys and ExtraParams")
@@ -1215,6 +1303,15 @@ func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 		if exist && !funcutil.IsTimezoneValid(userDefinedTimezone) {
 			return merr.WrapErrParameterInvalidMsg("unknown or invalid IANA Time Zone ID: %s", userDefinedTimezone)
 		}
+		if err := validateSearchPresets(t.Properties...); err != nil {
+			return err
+		}
+		if err := validateBinlogCompression(collSchema.CollectionSchema, t.Properties...); err != nil {
+			return err
+		}
+		if err := validateStrictPKMode(t.Properties...); err != nil {
+			return err
+		}
 	} else if len(t.GetDeleteKeys()) > 0 {
 		key := hasPropInDeletekeys(t.DeleteKeys)
 		if key != "" {
@@ -3146,6 +3243,20 @@ func (t *RunAnalyzerTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// isStableOrder is used to check if the hits with equal scores should be ordered by pk
+func isStableOrder(params []*commonpb.KeyValuePair) (bool, error) {
+	for _, kv := range params {
+		if kv.GetKey() == common.StableOrderKey {
+			stableOrder, err := strconv.ParseBool(kv.GetValue())
+			if err != nil {
+				return false, merr.WrapErrParameterInvalidMsg("parse %s failed: %s", common.StableOrderKey, kv.GetValue())
+			}
+			return stableOrder, nil
+		}
+	}
+	return false, nil
+}
+
 // isIgnoreGrowing is used to check if the request should ignore growing
 func isIgnoreGrowing(params []*commonpb.KeyValuePair) (bool, error) {
 	for _, kv := range params {
@@ -261,6 +261,9 @@ type deleteRunner struct {
 
 	scannedRemoteBytes atomic.Int64
 	scannedTotalBytes  atomic.Int64
+
+	// pks is the primary keys of the simple delete, nil for the complex delete
+	pks *schemapb.IDs
 }
 
 func (dr *deleteRunner) Init(ctx context.Context) error {
@@ -586,6 +589,7 @@ func (dr *deleteRunner) simpleDelete(ctx context.Context, pk *schemapb.IDs, numR
 		zap.Int64("collectionID", dr.collectionID),
 		zap.Int64("partitionID", partitionID))
 
+	dr.pks = pk
 	task, err := dr.produce(ctx, pk, partitionID)
 	if err != nil {
 		log.Ctx(ctx).Warn("produce delete task failed")
@@ -29,7 +29,6 @@ import (
 	"github.com/milvus-io/milvus/internal/distributed/streaming"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
-	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
@@ -109,34 +108,5 @@ func (t *flushTask) Execute(ctx context.Context) error {
 
 // sendManualFlushToWAL sends a manual flush message to WAL.
 func sendManualFlushToWAL(ctx context.Context, collID int64, vchannel string, flushTs uint64) ([]int64, error) {
-	logger := log.Ctx(ctx).With(zap.Int64("collectionID", collID), zap.String("vchannel", vchannel))
-	flushMsg, err := message.NewManualFlushMessageBuilderV2().
-		WithVChannel(vchannel).
-		WithHeader(&message.ManualFlushMessageHeader{
-			CollectionId: collID,
-			FlushTs:      flushTs,
-		}).
-		WithBody(&message.ManualFlushMessageBody{}).
-		BuildMutable()
-	if err != nil {
-		logger.Warn("build manual flush message failed", zap.Error(err))
-		return nil, err
-	}
-
-	appendResult, err := streaming.WAL().RawAppend(ctx, flushMsg, streaming.AppendOption{
-		BarrierTimeTick: flushTs,
-	})
-	if err != nil {
-		logger.Warn("append manual flush message to wal failed", zap.Error(err))
-		return nil, err
-	}
-
-	var flushMsgResponse message.ManualFlushExtraResponse
-	if err := appendResult.GetExtra(&flushMsgResponse); err != nil {
-		logger.Warn("get extra from append result failed", zap.Error(err))
-		return nil, err
-	}
-	logger.Info("append manual flush message to wal successfully")
-
-	return flushMsgResponse.GetSegmentIds(), nil
+	return streaming.ManualFlush(ctx, collID, vchannel, flushTs)
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -100,6 +101,9 @@ func repackInsertDataForStreamingService(
 			return nil, err
 		}
 		for _, msg := range msgs {
+			if err := setInsertChecksum(msg.(*msgstream.InsertMsg)); err != nil {
+				return nil, err
+			}
 			insertRequest := msg.(*msgstream.InsertMsg).InsertRequest
 			newMsg, err := message.NewInsertMessageBuilderV1().
 				WithVChannel(channel).
@@ -181,6 +185,9 @@ func repackInsertDataWithPartitionKeyForStreamingService(
 				return nil, err
 			}
 			for _, msg := range msgs {
+				if err := setInsertChecksum(msg.(*msgstream.InsertMsg)); err != nil {
+					return nil, err
+				}
 				insertRequest := msg.(*msgstream.InsertMsg).InsertRequest
 				newMsg, err := message.NewInsertMessageBuilderV1().
 					WithVChannel(channel).
@@ -206,3 +213,12 @@ func repackInsertDataWithPartitionKeyForStreamingService(
 	}
 	return messages, nil
 }
+
+// setInsertChecksum carries the checksum of the insert batch in the message if it's enabled,
+// the datanode verifies it before buffering the rows.
+func setInsertChecksum(msg *msgstream.InsertMsg) error {
+	if !paramtable.Get().ProxyCfg.InsertChecksumEnabled.GetAsBool() {
+		return nil
+	}
+	return msg.SetChecksum()
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog"
+	"github.com/milvus-io/milvus/internal/proxy/connection"
 	"github.com/milvus-io/milvus/internal/proxy/shardclient"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/exprutil"
@@ -541,6 +542,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 			guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, t.BeginTs(), consistencyLevel)
 		}
 	}
+	guaranteeTs = parseSessionGuaranteeTs(ctx, t.CollectionID, guaranteeTs, consistencyLevel)
 
 	// update actual consistency level
 	accesslog.SetActualConsistencyLevel(ctx, consistencyLevel)
@@ -557,6 +559,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if t.queryParams.isIterator && t.request.GetGuaranteeTimestamp() > 0 {
 		t.MvccTimestamp = t.request.GetGuaranteeTimestamp()
 		t.GuaranteeTimestamp = t.request.GetGuaranteeTimestamp()
+		connection.GetManager().TouchCursor(ctx, t.request.GetGuaranteeTimestamp())
 	}
 	t.RetrieveRequest.IsIterator = queryParams.isIterator
 
@@ -674,6 +677,7 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 	if t.queryParams.isIterator && t.request.GetGuaranteeTimestamp() == 0 {
 		// first page for iteration, need to set up sessionTs for iterator
 		t.result.SessionTs = getMaxMvccTsFromChannels(t.channelsMvcc, t.BeginTs())
+		connection.GetManager().TouchCursor(ctx, t.result.SessionTs)
 	}
 	if !t.reQuery {
 		if len(t.queryParams.extractTimeFields) > 0 {
@@ -813,6 +817,9 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 	ret.FieldsData = typeutil.PrepareResultFieldData(validRetrieveResults[0].GetFieldsData(), int64(loopEnd))
 	var retSize int64
 	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	sizeLimit := getResponseSizeLimit(paramtable.Get().ProxyCfg.MaxQueryResponseSize.GetAsInt64(), maxOutputSize)
+	// the picked (result index, cursor) pairs to rebuild the truncated results
+	var picked [][2]int64
 	for j := 0; j < loopEnd; j++ {
 		sel, drainOneResult := typeutil.SelectMinPK(validRetrieveResults, cursors)
 		if sel == -1 || (reduce.ShouldStopWhenDrained(queryParams.reduceType) && drainOneResult) {
@@ -820,8 +827,14 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 		}
 		retSize += typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
 
-		// limit retrieve result to avoid oom
-		if retSize > maxOutputSize {
+		// truncate the results exceeding the max response size
+		if sizeLimit > 0 {
+			if retSize > sizeLimit {
+				return truncateRetrieveResults(validRetrieveResults, picked, queryParams, sizeLimit)
+			}
+			picked = append(picked, [2]int64{int64(sel), cursors[sel]})
+		} else if retSize > maxOutputSize {
+			// limit retrieve result to avoid oom
 			return nil, fmt.Errorf("query results exceed the maxOutputSize Limit %d", maxOutputSize)
 		}
 
@@ -831,6 +844,27 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 	return ret, nil
 }
 
+// truncateRetrieveResults rebuilds the results with the picked rows,
+// and marks the status with the offset to continue with.
+func truncateRetrieveResults(retrieveResults []*internalpb.RetrieveResults, picked [][2]int64, queryParams *queryParams, sizeLimit int64) (*milvuspb.QueryResults, error) {
+	if len(picked) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("the first entity of query results exceeds the max response size %d, "+
+			"please reduce the output fields", sizeLimit)
+	}
+	ret := &milvuspb.QueryResults{
+		FieldsData: typeutil.PrepareResultFieldData(retrieveResults[0].GetFieldsData(), int64(len(picked))),
+	}
+	for _, pick := range picked {
+		typeutil.AppendFieldData(ret.FieldsData, retrieveResults[pick[0]].GetFieldsData(), pick[1])
+	}
+	var offset int64
+	if queryParams != nil {
+		offset = queryParams.offset
+	}
+	ret.Status = markResponseTruncated(nil, offset+int64(len(picked)))
+	return ret, nil
+}
+
 func reduceRetrieveResultsAndFillIfEmpty(ctx context.Context, retrieveResults []*internalpb.RetrieveResults, queryParams *queryParams, outputFieldsID []int64, schema *schemapb.CollectionSchema) (*milvuspb.QueryResults, error) {
 	result, err := reduceRetrieveResults(ctx, retrieveResults, queryParams)
 	if err != nil {
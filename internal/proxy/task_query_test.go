@@ -749,6 +749,36 @@ func TestTaskQuery_functions(t *testing.T) {
 				paramtable.Get().Save(paramtable.Get().QuotaConfig.MaxOutputSize.Key, "1104857600")
 			})
 
+			t.Run("test maxQueryResponseSize truncation", func(t *testing.T) {
+				paramtable.Get().Save(paramtable.Get().ProxyCfg.MaxQueryResponseSize.Key, "80")
+				defer paramtable.Get().Reset(paramtable.Get().ProxyCfg.MaxQueryResponseSize.Key)
+
+				ids := make([]int64, 100)
+				for i := range ids {
+					ids[i] = int64(i)
+				}
+				result := &internalpb.RetrieveResults{
+					Ids: &schemapb.IDs{
+						IdField: &schemapb.IDs_IntId{
+							IntId: &schemapb.LongArray{
+								Data: ids,
+							},
+						},
+					},
+					FieldsData: []*schemapb.FieldData{getFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, ids, 1)},
+				}
+
+				ret, err := reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{result}, &queryParams{limit: typeutil.Unlimited, offset: 5})
+				assert.NoError(t, err)
+				assert.Equal(t, []int64{5, 6, 7, 8, 9, 10, 11, 12, 13, 14}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+				assert.Equal(t, "true", ret.GetStatus().GetExtraInfo()[ResponseTruncatedKey])
+				assert.Equal(t, "15", ret.GetStatus().GetExtraInfo()[ResponseCursorKey])
+
+				paramtable.Get().Save(paramtable.Get().ProxyCfg.MaxQueryResponseSize.Key, "1")
+				_, err = reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{result}, &queryParams{limit: typeutil.Unlimited})
+				assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+			})
+
 			t.Run("test offset", func(t *testing.T) {
 				tests := []struct {
 					description string
@@ -19,6 +19,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog"
+	"github.com/milvus-io/milvus/internal/proxy/connection"
 	"github.com/milvus-io/milvus/internal/proxy/shardclient"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/exprutil"
@@ -199,6 +200,11 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		}
 	}
 
+	if err := t.applySearchPresets(ctx); err != nil {
+		log.Info("failed to apply search preset", zap.Error(err))
+		return err
+	}
+
 	nq, err := t.checkNq(ctx)
 	if err != nil {
 		log.Info("failed to check nq", zap.Error(err))
@@ -210,6 +216,17 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	stableOrder, err := isStableOrder(t.request.SearchParams)
+	if err != nil {
+		return err
+	}
+	if stableOrder {
+		if t.SearchRequest.Base.Properties == nil {
+			t.SearchRequest.Base.Properties = make(map[string]string)
+		}
+		t.SearchRequest.Base.Properties[common.StableOrderKey] = "true"
+	}
+
 	outputFieldIDs, err := getOutputFieldIDs(t.schema, t.translatedOutputFields)
 	if err != nil {
 		log.Info("fail to get output field ids", zap.Error(err))
@@ -251,6 +268,7 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, t.BeginTs(), consistencyLevel)
 		}
 	}
+	guaranteeTs = parseSessionGuaranteeTs(ctx, t.CollectionID, guaranteeTs, consistencyLevel)
 
 	// update actual consistency level
 	accesslog.SetActualConsistencyLevel(ctx, consistencyLevel)
@@ -267,6 +285,7 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	if t.isIterator && t.request.GetGuaranteeTimestamp() > 0 {
 		t.MvccTimestamp = t.request.GetGuaranteeTimestamp()
 		t.GuaranteeTimestamp = t.request.GetGuaranteeTimestamp()
+		connection.GetManager().TouchCursor(ctx, t.request.GetGuaranteeTimestamp())
 	}
 	t.SearchRequest.IsIterator = t.isIterator
 
@@ -562,6 +581,32 @@ func (t *searchTask) fillResult() {
 	t.result.CollectionName = t.collectionName
 }
 
+// applySearchPresets expands the search preset referenced by the request with the collection properties.
+func (t *searchTask) applySearchPresets(ctx context.Context) error {
+	_, hasPreset := funcutil.TryGetAttrByKeyFromRepeatedKV(common.SearchPresetKey, t.request.GetSearchParams())
+	for _, subReq := range t.request.GetSubReqs() {
+		_, exist := funcutil.TryGetAttrByKeyFromRepeatedKV(common.SearchPresetKey, subReq.GetSearchParams())
+		hasPreset = hasPreset || exist
+	}
+	if !hasPreset {
+		return nil
+	}
+
+	collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, t.request.GetDbName(), t.collectionName, t.GetCollectionID())
+	if err != nil {
+		return err
+	}
+	if t.request.SearchParams, err = applySearchPreset(t.request.GetSearchParams(), collectionInfo.properties); err != nil {
+		return err
+	}
+	for _, subReq := range t.request.GetSubReqs() {
+		if subReq.SearchParams, err = applySearchPreset(subReq.GetSearchParams(), collectionInfo.properties); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (t *searchTask) initSearchRequest(ctx context.Context) error {
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "init search request")
 	defer sp.End()
@@ -890,6 +935,7 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	if t.isIterator && t.request.GetGuaranteeTimestamp() == 0 {
 		// first page for iteration, need to set up sessionTs for iterator
 		t.result.SessionTs = getMaxMvccTsFromChannels(t.queryChannelsTs, t.BeginTs())
+		connection.GetManager().TouchCursor(ctx, t.result.SessionTs)
 	}
 
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
@@ -910,6 +956,20 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		}
 	}
 
+	if !t.isIterator {
+		sizeLimit := getResponseSizeLimit(paramtable.Get().ProxyCfg.MaxSearchResponseSize.GetAsInt64(),
+			paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64())
+		kept, truncated, err := truncateSearchResultData(t.result.GetResults(), sizeLimit)
+		if err != nil {
+			log.Warn("fail to truncate search results", zap.Error(err))
+			return err
+		}
+		if truncated {
+			log.Info("search results truncated by max response size", zap.Int64("nq", t.GetNq()), zap.Int64("kept", kept))
+			t.result.Status = markResponseTruncated(t.result.GetStatus(), kept)
+		}
+	}
+
 	log.Debug("Search post execute done",
 		zap.Int64("collection", t.GetCollectionID()),
 		zap.Int64s("partitionIDs", t.GetPartitionIDs()))
@@ -41,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/util/function/embedding"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
@@ -5497,3 +5498,35 @@ func TestAlterCollection_AllowInsertAutoID_AutoIDFalse(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, merr.Code(merr.ErrParameterInvalid), merr.Code(err))
 }
+
+func TestValidateStrictPKMode(t *testing.T) {
+	assert.NoError(t, validateStrictPKMode(&commonpb.KeyValuePair{Key: common.MmapEnabledKey, Value: "true"}))
+	assert.NoError(t, validateStrictPKMode(&commonpb.KeyValuePair{Key: common.CollectionStrictPKModeKey, Value: "detect"}))
+
+	err := validateStrictPKMode(&commonpb.KeyValuePair{Key: common.CollectionStrictPKModeKey, Value: "unknown"})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	streamingutil.SetStreamingServiceEnabled()
+	defer streamingutil.UnsetStreamingServiceEnabled()
+	assert.NoError(t, validateStrictPKMode(&commonpb.KeyValuePair{Key: common.CollectionStrictPKModeKey, Value: "reject"}))
+}
+
+func TestValidateBinlogCompression(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	assert.NoError(t, validateBinlogCompression(schema,
+		&commonpb.KeyValuePair{Key: common.CollectionBinlogCompressionKeyPrefix + "vec", Value: "none"},
+		&commonpb.KeyValuePair{Key: common.CollectionBinlogCompressionKeyPrefix + "pk", Value: "zstd:9"},
+		&commonpb.KeyValuePair{Key: common.MmapEnabledKey, Value: "true"},
+	))
+
+	err := validateBinlogCompression(schema, &commonpb.KeyValuePair{Key: common.CollectionBinlogCompressionKeyPrefix + "unknown", Value: "none"})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	err = validateBinlogCompression(schema, &commonpb.KeyValuePair{Key: common.CollectionBinlogCompressionKeyPrefix + "vec", Value: "zstd:100"})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
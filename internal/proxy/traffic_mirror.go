@@ -0,0 +1,286 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/crypto"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// mirrorCtxKey marks the context of the mirrored request, which is never mirrored again.
+type mirrorCtxKey struct{}
+
+// mirrorService is the service serving the requests mirrored to the shadow collections, it's the proxy itself.
+type mirrorService interface {
+	Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error)
+	Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)
+}
+
+// trafficMirror duplicates a ratio of the search and query requests to the shadow collections
+// and the mirror endpoint, to validate the index parameters or the upgraded cluster under the real load.
+// The mirrored requests are fire-and-forget, they run in background with their own timeout and the results are discarded.
+type trafficMirror struct {
+	local mirrorService
+	// interceptor authorizes and throttles the requests mirrored to the shadow collections
+	// as the external grpc server does, since the local service is called directly.
+	interceptor grpc.UnaryServerInterceptor
+	inflight    atomic.Int64
+
+	mu     sync.Mutex
+	closed bool
+	// remoteKey is the endpoint and the tls config the connection is built by
+	remoteKey string
+	conn      *grpc.ClientConn
+	remote    milvuspb.MilvusServiceClient
+}
+
+func newTrafficMirror(local mirrorService, limiter types.Limiter) *trafficMirror {
+	return &trafficMirror{
+		local: local,
+		interceptor: grpc_middleware.ChainUnaryServer(
+			DatabaseInterceptor(),
+			GrpcAuthInterceptor(AuthenticationInterceptor),
+			UnaryServerInterceptor(PrivilegeInterceptor),
+			RateLimitInterceptor(limiter),
+		),
+	}
+}
+
+// MirrorSearch mirrors the search request if it's sampled.
+func (m *trafficMirror) MirrorSearch(ctx context.Context, request *milvuspb.SearchRequest) {
+	if !m.sampled(ctx) {
+		return
+	}
+	if shadow := shadowCollection(request.GetDbName(), request.GetCollectionName()); shadow != "" {
+		req := proto.Clone(request).(*milvuspb.SearchRequest)
+		req.CollectionName = shadow
+		m.mirror(ctx, metrics.SearchLabel, metrics.ShadowCollectionMirrorLabel, func(ctx context.Context) error {
+			return merr.CheckRPCCall(m.invokeLocal(ctx, milvuspb.MilvusService_Search_FullMethodName, req,
+				func(ctx context.Context, req any) (any, error) {
+					return m.local.Search(ctx, req.(*milvuspb.SearchRequest))
+				}))
+		})
+	}
+	if remote := m.getRemote(); remote != nil {
+		req := proto.Clone(request).(*milvuspb.SearchRequest)
+		m.mirror(ctx, metrics.SearchLabel, metrics.EndpointMirrorLabel, func(ctx context.Context) error {
+			return merr.CheckRPCCall(remote.Search(ctx, req))
+		})
+	}
+}
+
+// MirrorQuery mirrors the query request if it's sampled.
+func (m *trafficMirror) MirrorQuery(ctx context.Context, request *milvuspb.QueryRequest) {
+	if !m.sampled(ctx) {
+		return
+	}
+	if shadow := shadowCollection(request.GetDbName(), request.GetCollectionName()); shadow != "" {
+		req := proto.Clone(request).(*milvuspb.QueryRequest)
+		req.CollectionName = shadow
+		m.mirror(ctx, metrics.QueryLabel, metrics.ShadowCollectionMirrorLabel, func(ctx context.Context) error {
+			return merr.CheckRPCCall(m.invokeLocal(ctx, milvuspb.MilvusService_Query_FullMethodName, req,
+				func(ctx context.Context, req any) (any, error) {
+					return m.local.Query(ctx, req.(*milvuspb.QueryRequest))
+				}))
+		})
+	}
+	if remote := m.getRemote(); remote != nil {
+		req := proto.Clone(request).(*milvuspb.QueryRequest)
+		m.mirror(ctx, metrics.QueryLabel, metrics.EndpointMirrorLabel, func(ctx context.Context) error {
+			return merr.CheckRPCCall(remote.Query(ctx, req))
+		})
+	}
+}
+
+func (m *trafficMirror) sampled(ctx context.Context) bool {
+	if m == nil || ctx.Value(mirrorCtxKey{}) != nil {
+		return false
+	}
+	ratio := paramtable.Get().ProxyCfg.TrafficMirrorRatio.GetAsFloat()
+	return ratio > 0 && rand.Float64() < ratio
+}
+
+// shadowCollection returns the shadow collection configured for the collection of the database, empty if there is none.
+// The source is in the form of "db.collection", or "collection" for the collection of the default database.
+func shadowCollection(dbName string, collection string) string {
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	for _, pair := range strings.Split(paramtable.Get().ProxyCfg.TrafficMirrorCollections.GetValue(), ",") {
+		source, shadow, ok := strings.Cut(pair, ":")
+		if !ok {
+			continue
+		}
+		sourceDB, sourceCollection, ok := strings.Cut(strings.TrimSpace(source), ".")
+		if !ok {
+			sourceDB, sourceCollection = util.DefaultDBName, sourceDB
+		}
+		if sourceDB == dbName && sourceCollection == collection {
+			return strings.TrimSpace(shadow)
+		}
+	}
+	return ""
+}
+
+// invokeLocal calls the local service through the interceptors, so the mirrored request is checked as the original one.
+func (m *trafficMirror) invokeLocal(ctx context.Context, method string, req any, handler grpc.UnaryHandler) (any, error) {
+	return m.interceptor(ctx, req, &grpc.UnaryServerInfo{
+		Server:     m.local,
+		FullMethod: method,
+	}, handler)
+}
+
+// mirror runs the mirrored request in background, it's abandoned if too many mirrored requests are in flight.
+func (m *trafficMirror) mirror(ctx context.Context, queryType string, target string, fn func(ctx context.Context) error) {
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	if m.inflight.Inc() > paramtable.Get().ProxyCfg.TrafficMirrorMaxConcurrency.GetAsInt64() {
+		m.inflight.Dec()
+		metrics.ProxyMirroredRequestCount.WithLabelValues(nodeID, queryType, target, metrics.AbandonLabel).Inc()
+		return
+	}
+
+	mirrorCtx := mirrorContext(ctx, target)
+	timeout := paramtable.Get().ProxyCfg.TrafficMirrorTimeout.GetAsDuration(time.Second)
+
+	go func() {
+		defer m.inflight.Dec()
+		ctx, cancel := context.WithTimeout(mirrorCtx, timeout)
+		defer cancel()
+		status := metrics.SuccessLabel
+		if err := fn(ctx); err != nil {
+			status = metrics.FailLabel
+			log.Ctx(ctx).RatedWarn(60, "failed to mirror request",
+				zap.String("queryType", queryType),
+				zap.String("target", target),
+				zap.Error(err))
+		}
+		metrics.ProxyMirroredRequestCount.WithLabelValues(nodeID, queryType, target, status).Inc()
+	}()
+}
+
+// mirrorContext returns the context of the mirrored request, which is detached from the cancellation of the original one.
+// The request mirrored to the shadow collection keeps the authorization of the caller,
+// while the one mirrored to the endpoint carries the configured token of the endpoint only,
+// the credentials of the caller never leave the cluster.
+func mirrorContext(ctx context.Context, target string) context.Context {
+	mirrorCtx := context.WithValue(context.Background(), mirrorCtxKey{}, true)
+	if target == metrics.EndpointMirrorLabel {
+		if token := paramtable.Get().ProxyCfg.TrafficMirrorToken.GetValue(); token != "" {
+			mirrorCtx = metadata.NewOutgoingContext(mirrorCtx, metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode(token)))
+		}
+		return mirrorCtx
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if auth := md.Get(util.HeaderAuthorize); len(auth) > 0 {
+			mirrorCtx = metadata.NewIncomingContext(mirrorCtx, metadata.Pairs(util.HeaderAuthorize, auth[0]))
+		}
+	}
+	return mirrorCtx
+}
+
+// getRemoteCreds returns the transport credentials of the mirror endpoint.
+func getRemoteCreds() (credentials.TransportCredentials, error) {
+	params := paramtable.Get().ProxyCfg
+	if !params.TrafficMirrorTLSEnabled.GetAsBool() {
+		return insecure.NewCredentials(), nil
+	}
+	if caPemPath := params.TrafficMirrorCaPemPath.GetValue(); caPemPath != "" {
+		return credentials.NewClientTLSFromFile(caPemPath, "")
+	}
+	// verified by the system roots
+	return credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12}), nil
+}
+
+// getRemote returns the client of the mirror endpoint, nil if the endpoint is not set.
+// The connection is rebuilt once the endpoint or the tls config is changed.
+func (m *trafficMirror) getRemote() milvuspb.MilvusServiceClient {
+	params := paramtable.Get().ProxyCfg
+	endpoint := params.TrafficMirrorEndpoint.GetValue()
+	remoteKey := strings.Join([]string{endpoint, params.TrafficMirrorTLSEnabled.GetValue(), params.TrafficMirrorCaPemPath.GetValue()}, "|")
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil
+	}
+	if remoteKey == m.remoteKey {
+		return m.remote
+	}
+
+	m.closeRemote()
+	m.remoteKey = remoteKey
+	if endpoint == "" {
+		return nil
+	}
+	creds, err := getRemoteCreds()
+	if err != nil {
+		log.Warn("failed to load the tls config of the traffic mirror endpoint", zap.String("endpoint", endpoint), zap.Error(err))
+		return nil
+	}
+	conn, err := grpc.NewClient(endpoint,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32)))
+	if err != nil {
+		log.Warn("failed to connect to the traffic mirror endpoint", zap.String("endpoint", endpoint), zap.Error(err))
+		return nil
+	}
+	log.Info("connect to the traffic mirror endpoint", zap.String("endpoint", endpoint))
+	m.conn = conn
+	m.remote = milvuspb.NewMilvusServiceClient(conn)
+	return m.remote
+}
+
+func (m *trafficMirror) closeRemote() {
+	if m.conn != nil {
+		if err := m.conn.Close(); err != nil {
+			log.Warn("failed to close the connection to the traffic mirror endpoint", zap.Error(err))
+		}
+	}
+	m.conn = nil
+	m.remote = nil
+}
+
+// Close closes the connection to the mirror endpoint, the mirrored requests in flight fail or time out.
+func (m *trafficMirror) Close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed = true
+	m.closeRemote()
+}
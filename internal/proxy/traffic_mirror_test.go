@@ -0,0 +1,169 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util"
+	"github.com/milvus-io/milvus/pkg/v2/util/crypto"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type fakeMirrorService struct {
+	block    chan struct{}
+	searches chan *milvuspb.SearchRequest
+	queries  chan *milvuspb.QueryRequest
+}
+
+func (s *fakeMirrorService) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	<-s.block
+	s.searches <- request
+	return &milvuspb.SearchResults{Status: merr.Success()}, nil
+}
+
+func (s *fakeMirrorService) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+	<-s.block
+	s.queries <- request
+	return &milvuspb.QueryResults{Status: merr.Success()}, nil
+}
+
+func TestTrafficMirror(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.TrafficMirrorCollections.Key, "coll:coll_shadow, other:other_shadow, db1.other:db1_shadow")
+	params.Save(params.ProxyCfg.TrafficMirrorMaxConcurrency.Key, "1")
+	defer params.Reset(params.ProxyCfg.TrafficMirrorCollections.Key)
+	defer params.Reset(params.ProxyCfg.TrafficMirrorMaxConcurrency.Key)
+	defer params.Reset(params.ProxyCfg.TrafficMirrorRatio.Key)
+
+	local := &fakeMirrorService{
+		block:    make(chan struct{}),
+		searches: make(chan *milvuspb.SearchRequest, 10),
+		queries:  make(chan *milvuspb.QueryRequest, 10),
+	}
+	mockCache := NewMockCache(t)
+	mockCache.EXPECT().GetDatabaseInfo(mock.Anything, mock.Anything).Return(&databaseInfo{dbID: 1}, nil).Maybe()
+	mockCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, mock.Anything).Return(int64(100), nil).Maybe()
+	originCache := globalMetaCache
+	globalMetaCache = mockCache
+	defer func() { globalMetaCache = originCache }()
+	limiter := &limiterMock{rate: 100}
+	m := newTrafficMirror(local, limiter)
+	defer m.Close()
+	ctx := context.Background()
+
+	assert.Equal(t, "other_shadow", shadowCollection("", "other"))
+	assert.Equal(t, "other_shadow", shadowCollection(util.DefaultDBName, "other"))
+	assert.Equal(t, "db1_shadow", shadowCollection("db1", "other"))
+	assert.Equal(t, "", shadowCollection("db1", "coll"))
+	assert.Equal(t, "", shadowCollection("", "coll_shadow"))
+
+	// disabled by default
+	m.MirrorSearch(ctx, &milvuspb.SearchRequest{CollectionName: "coll"})
+	assert.Zero(t, m.inflight.Load())
+
+	params.Save(params.ProxyCfg.TrafficMirrorRatio.Key, "1")
+	// the collections without shadow are not mirrored
+	m.MirrorSearch(ctx, &milvuspb.SearchRequest{CollectionName: "unknown"})
+	assert.Zero(t, m.inflight.Load())
+
+	request := &milvuspb.SearchRequest{CollectionName: "coll"}
+	m.MirrorSearch(ctx, request)
+	assert.EqualValues(t, 1, m.inflight.Load())
+	// abandoned beyond the max concurrency
+	m.MirrorQuery(ctx, &milvuspb.QueryRequest{CollectionName: "coll"})
+	assert.EqualValues(t, 1, m.inflight.Load())
+
+	close(local.block)
+	mirrored := <-local.searches
+	assert.Equal(t, "coll_shadow", mirrored.GetCollectionName())
+	assert.Equal(t, "coll", request.GetCollectionName())
+	assert.Eventually(t, func() bool { return m.inflight.Load() == 0 }, 5*time.Second, 10*time.Millisecond)
+
+	m.MirrorQuery(ctx, &milvuspb.QueryRequest{CollectionName: "coll"})
+	assert.Equal(t, "coll_shadow", (<-local.queries).GetCollectionName())
+
+	// the mirrored requests are throttled as the original ones
+	limiter.limit = true
+	m.MirrorQuery(ctx, &milvuspb.QueryRequest{CollectionName: "coll"})
+	assert.Eventually(t, func() bool { return m.inflight.Load() == 0 }, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, local.queries)
+
+	// the mirrored requests are never mirrored again
+	assert.False(t, m.sampled(context.WithValue(ctx, mirrorCtxKey{}, true)))
+}
+
+func TestTrafficMirrorContext(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	defer params.Reset(params.ProxyCfg.TrafficMirrorToken.Key)
+
+	callerAuth := crypto.Base64Encode("root:Milvus")
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderAuthorize, callerAuth))
+
+	// the shadow collection is authorized by the caller
+	md, ok := metadata.FromIncomingContext(mirrorContext(ctx, metrics.ShadowCollectionMirrorLabel))
+	assert.True(t, ok)
+	assert.Equal(t, []string{callerAuth}, md.Get(util.HeaderAuthorize))
+
+	// the credentials of the caller are never sent to the endpoint
+	mirrorCtx := mirrorContext(ctx, metrics.EndpointMirrorLabel)
+	_, ok = metadata.FromIncomingContext(mirrorCtx)
+	assert.False(t, ok)
+	_, ok = metadata.FromOutgoingContext(mirrorCtx)
+	assert.False(t, ok)
+
+	params.Save(params.ProxyCfg.TrafficMirrorToken.Key, "mirror:123456")
+	md, ok = metadata.FromOutgoingContext(mirrorContext(ctx, metrics.EndpointMirrorLabel))
+	assert.True(t, ok)
+	assert.Equal(t, []string{crypto.Base64Encode("mirror:123456")}, md.Get(util.HeaderAuthorize))
+}
+
+func TestTrafficMirrorRemote(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	defer params.Reset(params.ProxyCfg.TrafficMirrorEndpoint.Key)
+	defer params.Reset(params.ProxyCfg.TrafficMirrorTLSEnabled.Key)
+	defer params.Reset(params.ProxyCfg.TrafficMirrorCaPemPath.Key)
+
+	m := newTrafficMirror(&fakeMirrorService{}, &limiterMock{rate: 100})
+	assert.Nil(t, m.getRemote())
+
+	params.Save(params.ProxyCfg.TrafficMirrorEndpoint.Key, "localhost:19530")
+	remote := m.getRemote()
+	assert.NotNil(t, remote)
+	assert.Equal(t, remote, m.getRemote())
+
+	// rebuilt once the tls config is changed
+	params.Save(params.ProxyCfg.TrafficMirrorTLSEnabled.Key, "true")
+	assert.NotNil(t, m.getRemote())
+	params.Save(params.ProxyCfg.TrafficMirrorCaPemPath.Key, "/not/exist/ca.pem")
+	assert.Nil(t, m.getRemote())
+
+	m.Close()
+	assert.Nil(t, m.getRemote())
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proxy/connection"
 	"github.com/milvus-io/milvus/internal/proxy/privilege"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/function/embedding"
@@ -52,6 +53,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/contextutil"
@@ -59,6 +61,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metric"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -1210,6 +1213,15 @@ func parseGuaranteeTsFromConsistency(ts, tMax typeutil.Timestamp, consistency co
 	return ts
 }
 
+// parseSessionGuaranteeTs makes the read with session consistency see the last write of the client on the collection,
+// even if the client doesn't track the timestamp of its writes itself.
+func parseSessionGuaranteeTs(ctx context.Context, collectionID int64, ts typeutil.Timestamp, consistency commonpb.ConsistencyLevel) typeutil.Timestamp {
+	if consistency != commonpb.ConsistencyLevel_Session {
+		return ts
+	}
+	return max(ts, connection.GetManager().GetLastWriteTs(ctx, collectionID))
+}
+
 func parseGuaranteeTs(ts, tMax typeutil.Timestamp) typeutil.Timestamp {
 	switch ts {
 	case strongTS:
@@ -2170,6 +2182,63 @@ func getPartitionProgress(
 	return
 }
 
+// getIndexCompleteness returns whether all the flushed segments of the collection are built with the declared indexes.
+func getIndexCompleteness(ctx context.Context, mixCoord types.MixCoordClient, collectionID int64) (*metricsinfo.IndexCompleteness, error) {
+	req, err := metricsinfo.ConstructGetMetricsRequest(map[string]interface{}{
+		metricsinfo.MetricTypeKey:                     metricsinfo.IndexCompletenessKey,
+		metricsinfo.MetricRequestParamCollectionIDKey: collectionID,
+		metricsinfo.MetricRequestProcessInRoleKey:     typeutil.DataCoordRole,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := mixCoord.GetMetrics(ctx, req)
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Ctx(ctx).Warn("fail to get index completeness", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return nil, err
+	}
+	completeness := &metricsinfo.IndexCompleteness{}
+	if err := json.Unmarshal([]byte(resp.GetResponse()), completeness); err != nil {
+		return nil, err
+	}
+	return completeness, nil
+}
+
+// getCollectionTemplate returns the collection template by name from the rootcoord.
+func getCollectionTemplate(ctx context.Context, mixCoord types.MixCoordClient, name string) (*rootcoordpb.CollectionTemplate, error) {
+	resp, err := mixCoord.ListCollectionTemplates(ctx, &rootcoordpb.ListCollectionTemplatesRequest{
+		Base: commonpbutil.NewMsgBase(),
+		Name: name,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Ctx(ctx).Warn("fail to get collection template", zap.String("name", name), zap.Error(err))
+		return nil, err
+	}
+	if len(resp.GetTemplates()) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("collection template %s not found", name)
+	}
+	return resp.GetTemplates()[0], nil
+}
+
+// isStaleCollection checks whether the request failed with collection not found because the cached collection
+// of the name is stale, e.g. the alias is altered to another collection while the request is in flight.
+// The cache of the name is refreshed, and the request could be retried only if the name is resolved
+// to another collection than the requested one.
+func isStaleCollection(ctx context.Context, err error, dbName string, collectionName string, collectionID int64) bool {
+	if !errors.Is(err, merr.ErrCollectionNotFound) || !Params.ProxyCfg.RetryOnStaleCollection.GetAsBool() {
+		return false
+	}
+	globalMetaCache.RemoveCollection(ctx, dbName, collectionName)
+	latestID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil || latestID == collectionID {
+		return false
+	}
+	log.Ctx(ctx).Info("the cached collection is stale, retry the request",
+		zap.String("db", dbName), zap.String("collection", collectionName),
+		zap.Int64("staleCollectionID", collectionID), zap.Int64("collectionID", latestID))
+	return true
+}
+
 func isPartitionKeyMode(ctx context.Context, dbName string, colName string) (bool, error) {
 	colSchema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, colName)
 	if err != nil {
@@ -2380,6 +2449,24 @@ func SetReportValue(status *commonpb.Status, value int) {
 	status.ExtraInfo["report_value"] = strconv.Itoa(value)
 }
 
+// SetPartialLoadWarning attaches a warning to the status when the collection is partially loaded,
+// which means the result may miss the data of quarantined segments.
+func SetPartialLoadWarning(status *commonpb.Status, partialLoaded bool) {
+	if !partialLoaded {
+		return
+	}
+	if !merr.Ok(status) {
+		return
+	}
+	if status.ExtraInfo == nil {
+		status.ExtraInfo = make(map[string]string)
+		// set report_value to 0 for compatibility, same as SetStorageCost
+		status.ExtraInfo["report_value"] = strconv.Itoa(0)
+	}
+	status.ExtraInfo[common.PartialLoadedKey] = "true"
+	status.ExtraInfo[common.WarningKey] = "collection is partially loaded, some segments failed to load are excluded from the result"
+}
+
 func SetStorageCost(status *commonpb.Status, storageCost segcore.StorageCost) {
 	if !Params.QueryNodeCfg.StorageUsageTrackingEnabled.GetAsBool() {
 		return
@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proxy/connection"
 	"github.com/milvus-io/milvus/internal/proxy/privilege"
 	"github.com/milvus-io/milvus/internal/util/function/embedding"
 	"github.com/milvus-io/milvus/pkg/v2/common"
@@ -48,6 +49,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util"
 	"github.com/milvus-io/milvus/pkg/v2/util/crypto"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -2072,6 +2074,20 @@ func Test_ParseGuaranteeTsFromConsistency(t *testing.T) {
 	assert.Equal(t, tsEventually, parseGuaranteeTsFromConsistency(tsDefault, tsMax, eventually))
 }
 
+func Test_ParseSessionGuaranteeTs(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.New(map[string]string{"identifier": "12345"}))
+	connection.GetManager().Register(ctx, 12345, &commonpb.ClientInfo{})
+
+	session := commonpb.ConsistencyLevel_Session
+	assert.EqualValues(t, 100, parseSessionGuaranteeTs(ctx, 1, 100, session))
+	connection.GetManager().UpdateLastWriteTs(ctx, 1, 200)
+	assert.EqualValues(t, 200, parseSessionGuaranteeTs(ctx, 1, 100, session))
+	assert.EqualValues(t, 300, parseSessionGuaranteeTs(ctx, 1, 300, session))
+	assert.EqualValues(t, 100, parseSessionGuaranteeTs(ctx, 2, 100, session))
+	assert.EqualValues(t, 100, parseSessionGuaranteeTs(ctx, 1, 100, commonpb.ConsistencyLevel_Bounded))
+	assert.NoError(t, connection.GetManager().Kill(ctx, 12345))
+}
+
 func Test_NQLimit(t *testing.T) {
 	paramtable.Init()
 	assert.Nil(t, validateNQLimit(16384))
@@ -4830,3 +4846,54 @@ func TestGetStorageCost(t *testing.T) {
 		assert.True(t, ok)
 	})
 }
+
+func TestGetIndexCompleteness(t *testing.T) {
+	ctx := context.Background()
+	mixcoord := mocks.NewMockMixCoordClient(t)
+
+	mixcoord.EXPECT().GetMetrics(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+			assert.Contains(t, req.GetRequest(), metricsinfo.IndexCompletenessKey)
+			return &milvuspb.GetMetricsResponse{
+				Status:   merr.Success(),
+				Response: `{"collection_id": "1", "complete": false, "unindexed_segments": [{"segment_id": "10"}]}`,
+			}, nil
+		}).Once()
+	completeness, err := getIndexCompleteness(ctx, mixcoord, 1)
+	assert.NoError(t, err)
+	assert.False(t, completeness.Complete)
+	assert.Len(t, completeness.UnindexedSegments, 1)
+
+	mixcoord.EXPECT().GetMetrics(mock.Anything, mock.Anything).Return(nil, errors.New("mock error")).Once()
+	_, err = getIndexCompleteness(ctx, mixcoord, 1)
+	assert.Error(t, err)
+}
+
+func TestIsStaleCollection(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := NewMockCache(t)
+	globalMetaCache = mockCache
+
+	assert.False(t, isStaleCollection(ctx, nil, "db", "alias1", 111))
+	assert.False(t, isStaleCollection(ctx, merr.ErrCollectionNotLoaded, "db", "alias1", 111))
+
+	// the alias is altered to another collection
+	mockCache.EXPECT().RemoveCollection(mock.Anything, "db", "alias1").Return().Times(3)
+	mockCache.EXPECT().GetCollectionID(mock.Anything, "db", "alias1").Return(222, nil).Once()
+	assert.True(t, isStaleCollection(ctx, merr.WrapErrCollectionNotFound(111), "db", "alias1", 111))
+
+	// the name is still resolved to the same collection
+	mockCache.EXPECT().GetCollectionID(mock.Anything, "db", "alias1").Return(111, nil).Once()
+	assert.False(t, isStaleCollection(ctx, merr.WrapErrCollectionNotFound(111), "db", "alias1", 111))
+
+	// the collection is dropped indeed
+	mockCache.EXPECT().GetCollectionID(mock.Anything, "db", "alias1").Return(0, merr.WrapErrCollectionNotFound("alias1")).Once()
+	assert.False(t, isStaleCollection(ctx, merr.WrapErrCollectionNotFound(111), "db", "alias1", 111))
+
+	paramtable.Get().Save(Params.ProxyCfg.RetryOnStaleCollection.Key, "false")
+	defer paramtable.Get().Reset(Params.ProxyCfg.RetryOnStaleCollection.Key)
+	assert.False(t, isStaleCollection(ctx, merr.WrapErrCollectionNotFound(111), "db", "alias1", 111))
+}
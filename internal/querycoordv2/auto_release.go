@@ -0,0 +1,239 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// autoReleaseLoop releases the collections which are not searched or queried for the idle duration
+// set in the collection property, to reclaim the memory of querynodes.
+func (s *Server) autoReleaseLoop(ctx context.Context) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		interval := Params.QueryCoordCfg.AutoReleaseCheckInterval.GetAsDuration(time.Second)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("auto release loop exit!")
+				return
+			case <-ticker.C:
+				if Params.QueryCoordCfg.AutoReleaseEnabled.GetAsBool() {
+					s.releaseIdleCollections(ctx)
+				}
+			}
+		}
+	}()
+}
+
+func (s *Server) releaseIdleCollections(ctx context.Context) {
+	log := log.Ctx(ctx)
+	collections := lo.Filter(s.meta.GetAllCollections(ctx), func(collection *meta.Collection, _ int) bool {
+		// the partially loaded collections are not released, since they could not be reloaded by a load collection request
+		return collection.GetStatus() == querypb.LoadStatus_Loaded && collection.GetLoadType() == querypb.LoadType_LoadCollection
+	})
+	if len(collections) == 0 {
+		return
+	}
+
+	lastAccess, inFlight, err := s.getCollectionLastAccess(ctx)
+	if err != nil {
+		// skip the round, otherwise the collections accessed on the failed nodes may be released
+		log.Warn("failed to get the last access time of collections, skip auto release", zap.Error(err))
+		return
+	}
+
+	for _, collection := range collections {
+		resp, err := s.broker.DescribeCollection(ctx, collection.GetCollectionID())
+		if err != nil {
+			log.Warn("failed to describe collection for auto release", zap.Int64("collectionID", collection.GetCollectionID()), zap.Error(err))
+			continue
+		}
+		idle, err := common.GetCollectionAutoReleaseIdle(resp.GetProperties())
+		if err != nil || idle <= 0 {
+			continue
+		}
+		// the collection is regarded as accessed when it's loaded
+		lastActive := collection.UpdatedAt
+		if accessTime, ok := lastAccess[collection.GetCollectionID()]; ok && accessTime.After(lastActive) {
+			lastActive = accessTime
+		}
+		if time.Since(lastActive) < idle {
+			continue
+		}
+		// the collection is not idle until the running requests complete
+		if inFlight.Contain(collection.GetCollectionID()) {
+			log.Info("skip auto release of collection with running requests", zap.Int64("collectionID", collection.GetCollectionID()))
+			continue
+		}
+		s.autoReleaseCollection(ctx, collection, resp, lastActive)
+	}
+}
+
+// getCollectionLastAccess returns the last time the collections are searched or queried on all the querynodes,
+// and the collections with running search or query requests.
+func (s *Server) getCollectionLastAccess(ctx context.Context) (map[int64]time.Time, typeutil.Set[int64], error) {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.CollectionAccessKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	accesses, err := getMetrics[*metricsinfo.CollectionAccess](ctx, s, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	lastAccess := make(map[int64]time.Time)
+	inFlight := typeutil.NewSet[int64]()
+	for _, access := range accesses {
+		accessTime := time.UnixMilli(access.LastAccessTime)
+		if accessTime.After(lastAccess[access.CollectionID]) {
+			lastAccess[access.CollectionID] = accessTime
+		}
+		if access.InFlight > 0 {
+			inFlight.Insert(access.CollectionID)
+		}
+	}
+	return lastAccess, inFlight, nil
+}
+
+func (s *Server) autoReleaseCollection(ctx context.Context, collection *meta.Collection, desc *milvuspb.DescribeCollectionResponse, lastActive time.Time) {
+	collectionID := collection.GetCollectionID()
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID), zap.Time("lastActive", lastActive))
+
+	req := &querypb.LoadCollectionRequest{
+		DbID:         collection.GetDbID(),
+		CollectionID: collectionID,
+		FieldIndexID: collection.GetFieldIndexID(),
+		LoadFields:   collection.GetLoadFields(),
+	}
+	// keep the replica config only if it's specified by user, otherwise the default one is applied on reload
+	if collection.GetUserSpecifiedReplicaMode() {
+		req.ReplicaNumber = collection.GetReplicaNumber()
+		req.ResourceGroups = lo.Uniq(lo.Map(s.meta.ReplicaManager.GetByCollection(ctx, collectionID), func(replica *meta.Replica, _ int) string {
+			return replica.GetResourceGroup()
+		}))
+	}
+
+	loadDuration := collection.UpdatedAt.Sub(collection.CreatedAt)
+	value, err := meta.MarshalAutoReleasedCollection(meta.AutoReleasedCollection{
+		Request:      req,
+		LoadDuration: loadDuration,
+		ReleasedAt:   time.Now(),
+	})
+	if err != nil {
+		log.Warn("failed to marshal the auto release marker", zap.Error(err))
+		return
+	}
+	// the marker is persisted before the release, so the collection could be reloaded after querycoord restarts
+	err = s.broker.AlterCollectionProperties(ctx, desc.GetDbName(), desc.GetCollectionName(),
+		[]*commonpb.KeyValuePair{{Key: common.CollectionAutoReleasedKey, Value: value}}, nil)
+	if err != nil {
+		log.Warn("failed to persist the auto release marker, skip auto release", zap.Error(err))
+		return
+	}
+
+	err = s.broadcastDropLoadConfigCollectionV2ForReleaseCollection(ctx, &querypb.ReleaseCollectionRequest{CollectionID: collectionID})
+	if err != nil && !errors.Is(err, errReleaseCollectionNotLoaded) {
+		log.Warn("failed to release idle collection", zap.Error(err))
+		return
+	}
+	meta.GlobalAutoReleasedCache.Put(collectionID, req, loadDuration)
+	log.Info("idle collection released")
+}
+
+// recoverAutoReleasedCollection recovers the record of the collection released for being idle
+// from the collection property, which is persisted before querycoord restarts.
+func (s *Server) recoverAutoReleasedCollection(ctx context.Context, collectionID int64) {
+	desc, err := s.broker.DescribeCollection(ctx, collectionID)
+	if err != nil {
+		return
+	}
+	value, ok := lo.Find(desc.GetProperties(), func(kv *commonpb.KeyValuePair) bool {
+		return kv.GetKey() == common.CollectionAutoReleasedKey
+	})
+	if !ok {
+		return
+	}
+	record, err := meta.UnmarshalAutoReleasedCollection(value.GetValue())
+	if err != nil {
+		log.Ctx(ctx).Warn("invalid auto release marker", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return
+	}
+	meta.GlobalAutoReleasedCache.Recover(collectionID, record)
+}
+
+// dropAutoReleasedMarker deletes the auto release marker of the collection if it's set,
+// it's called once the collection is loaded or released by user.
+func (s *Server) dropAutoReleasedMarker(ctx context.Context, collectionID int64) error {
+	desc, err := s.broker.DescribeCollection(ctx, collectionID)
+	if err != nil {
+		return err
+	}
+	if !lo.ContainsBy(desc.GetProperties(), func(kv *commonpb.KeyValuePair) bool {
+		return kv.GetKey() == common.CollectionAutoReleasedKey
+	}) {
+		return nil
+	}
+	return s.broker.AlterCollectionProperties(ctx, desc.GetDbName(), desc.GetCollectionName(), nil, []string{common.CollectionAutoReleasedKey})
+}
+
+// reloadAutoReleasedCollection triggers the reload of the collection if it's released for being idle,
+// and returns a retriable error with the estimated remaining time of the reload.
+// The original error is returned if the collection is not auto released.
+func (s *Server) reloadAutoReleasedCollection(ctx context.Context, collectionID int64, err error) error {
+	if _, ok := meta.GlobalAutoReleasedCache.Get(collectionID); !ok && errors.Is(err, merr.ErrCollectionNotLoaded) {
+		s.recoverAutoReleasedCollection(ctx, collectionID)
+	}
+	record, triggered := meta.GlobalAutoReleasedCache.StartReload(collectionID)
+	if record.Request == nil {
+		return err
+	}
+	if triggered {
+		log.Ctx(ctx).Info("reload the collection released for being idle", zap.Int64("collectionID", collectionID))
+		go func() {
+			status, err := s.LoadCollection(s.ctx, record.Request)
+			if err := merr.CheckRPCCall(status, err); err != nil {
+				log.Warn("failed to reload the collection released for being idle", zap.Int64("collectionID", collectionID), zap.Error(err))
+				if errors.Is(err, merr.ErrCollectionNotFound) {
+					meta.GlobalAutoReleasedCache.Remove(collectionID)
+					return
+				}
+				meta.GlobalAutoReleasedCache.ResetReload(collectionID)
+			}
+		}()
+	}
+	return merr.WrapErrCollectionNotFullyLoaded(collectionID,
+		fmt.Sprintf("collection released for being idle is reloading, estimated remaining time %s", record.Remaining().Round(time.Second)))
+}
@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestGetCollectionLastAccess(t *testing.T) {
+	mockCluster := session.NewMockCluster(t)
+	nodeManager := session.NewNodeManager()
+	nodeManager.Add(session.NewNodeInfo(session.ImmutableNodeInfo{NodeID: 1}))
+	nodeManager.Add(session.NewNodeInfo(session.ImmutableNodeInfo{NodeID: 2}))
+	server := &Server{cluster: mockCluster, nodeMgr: nodeManager}
+
+	now := time.Now()
+	accesses := map[int64][]*metricsinfo.CollectionAccess{
+		1: {{CollectionID: 100, LastAccessTime: now.Add(-time.Hour).UnixMilli()}},
+		2: {{CollectionID: 100, LastAccessTime: now.UnixMilli()}, {CollectionID: 101, LastAccessTime: now.UnixMilli(), InFlight: 2}},
+	}
+	mockCluster.EXPECT().GetMetrics(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, nodeID int64, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+			bs, err := json.Marshal(accesses[nodeID])
+			assert.NoError(t, err)
+			return &milvuspb.GetMetricsResponse{Status: merr.Success(), Response: string(bs)}, nil
+		})
+
+	lastAccess, inFlight, err := server.getCollectionLastAccess(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, lastAccess, 2)
+	assert.Equal(t, now.UnixMilli(), lastAccess[100].UnixMilli())
+	assert.Equal(t, now.UnixMilli(), lastAccess[101].UnixMilli())
+	assert.ElementsMatch(t, []int64{101}, inFlight.Collect())
+}
+
+func TestReloadAutoReleasedCollection(t *testing.T) {
+	paramtable.Init()
+	meta.GlobalAutoReleasedCache = meta.NewAutoReleasedCache()
+	broker := meta.NewMockBroker(t)
+	// the server is not healthy, so the reload always fails
+	server := &Server{ctx: context.Background(), broker: broker}
+	collectionID := int64(100)
+	notLoaded := merr.WrapErrCollectionNotLoaded(collectionID)
+
+	broker.EXPECT().DescribeCollection(mock.Anything, collectionID).Return(&milvuspb.DescribeCollectionResponse{}, nil).Once()
+	err := server.reloadAutoReleasedCollection(context.Background(), collectionID, notLoaded)
+	assert.ErrorIs(t, err, merr.ErrCollectionNotLoaded)
+
+	meta.GlobalAutoReleasedCache.Put(collectionID, &querypb.LoadCollectionRequest{CollectionID: collectionID}, time.Minute)
+	err = server.reloadAutoReleasedCollection(context.Background(), collectionID, notLoaded)
+	assert.ErrorIs(t, err, merr.ErrCollectionNotFullyLoaded)
+	assert.Contains(t, err.Error(), "estimated remaining time")
+
+	// the failed reload could be triggered again
+	assert.Eventually(t, func() bool {
+		record, ok := meta.GlobalAutoReleasedCache.Get(collectionID)
+		return ok && record.ReloadAt.IsZero()
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestAutoReleasedMarker(t *testing.T) {
+	paramtable.Init()
+	meta.GlobalAutoReleasedCache = meta.NewAutoReleasedCache()
+	broker := meta.NewMockBroker(t)
+	server := &Server{ctx: context.Background(), broker: broker}
+	collectionID := int64(100)
+
+	record := meta.AutoReleasedCollection{
+		Request:      &querypb.LoadCollectionRequest{CollectionID: collectionID, ReplicaNumber: 2, LoadFields: []int64{100, 101}},
+		LoadDuration: time.Minute,
+		ReleasedAt:   time.Now(),
+	}
+	value, err := meta.MarshalAutoReleasedCollection(record)
+	assert.NoError(t, err)
+	decoded, err := meta.UnmarshalAutoReleasedCollection(value)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(record.Request, decoded.Request))
+	assert.Equal(t, record.LoadDuration, decoded.LoadDuration)
+	assert.True(t, record.ReleasedAt.Equal(decoded.ReleasedAt))
+	_, err = meta.UnmarshalAutoReleasedCollection("invalid")
+	assert.Error(t, err)
+
+	desc := &milvuspb.DescribeCollectionResponse{
+		DbName:         "default",
+		CollectionName: "coll",
+		CollectionID:   collectionID,
+		Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionAutoReleasedKey, Value: value}},
+	}
+	broker.EXPECT().DescribeCollection(mock.Anything, collectionID).Return(desc, nil)
+
+	// the record released before restart is recovered from the collection property
+	server.recoverAutoReleasedCollection(context.Background(), collectionID)
+	recovered, ok := meta.GlobalAutoReleasedCache.Get(collectionID)
+	assert.True(t, ok)
+	assert.True(t, proto.Equal(record.Request, recovered.Request))
+	assert.True(t, recovered.ReloadAt.IsZero())
+
+	broker.EXPECT().AlterCollectionProperties(mock.Anything, "default", "coll", mock.Anything, []string{common.CollectionAutoReleasedKey}).Return(nil).Once()
+	assert.NoError(t, server.dropAutoReleasedMarker(context.Background(), collectionID))
+}
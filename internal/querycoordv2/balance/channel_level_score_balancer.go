@@ -135,12 +135,13 @@ func (b *ChannelLevelScoreBalancer) BalanceReplica(ctx context.Context, replica
 				zap.Any("available nodes", rwNodes),
 			)
 			// handle stopped nodes here, have to assign segments on stopping nodes to nodes with the smallest score
+			targetNodes := b.nodeManager.FilterOverloadedNodes(rwNodes)
 			if !streamingutil.IsStreamingServiceEnabled() {
-				channelPlans = append(channelPlans, b.genStoppingChannelPlan(ctx, replica, channelName, rwNodes, roNodes)...)
+				channelPlans = append(channelPlans, b.genStoppingChannelPlan(ctx, replica, channelName, targetNodes, roNodes)...)
 			}
 
 			if len(channelPlans) == 0 {
-				segmentPlans = append(segmentPlans, b.genStoppingSegmentPlan(ctx, replica, channelName, rwNodes, roNodes)...)
+				segmentPlans = append(segmentPlans, b.genStoppingSegmentPlan(ctx, replica, channelName, targetNodes, roNodes)...)
 			}
 		} else {
 			if paramtable.Get().QueryCoordCfg.AutoBalanceChannel.GetAsBool() && !streamingutil.IsStreamingServiceEnabled() {
@@ -513,7 +513,7 @@ func (b *MultiTargetBalancer) balanceChannels(ctx context.Context, br *balanceRe
 			log.RatedInfo(10, "stopping balance is disabled!", zap.Int64s("stoppingNode", roNodes))
 			return nil
 		}
-		return b.genStoppingChannelPlan(ctx, replica, rwNodes, roNodes)
+		return b.genStoppingChannelPlan(ctx, replica, b.nodeManager.FilterOverloadedNodes(rwNodes), roNodes)
 	}
 
 	if paramtable.Get().QueryCoordCfg.AutoBalanceChannel.GetAsBool() {
@@ -541,7 +541,7 @@ func (b *MultiTargetBalancer) balanceSegments(ctx context.Context, replica *meta
 			zap.Any("available nodes", rwNodes),
 		)
 		// handle stopped nodes here, have to assign segments on stopping nodes to nodes with the smallest score
-		return b.genStoppingSegmentPlan(ctx, replica, rwNodes, roNodes)
+		return b.genStoppingSegmentPlan(ctx, replica, b.nodeManager.FilterOverloadedNodes(rwNodes), roNodes)
 	}
 	return b.genSegmentPlan(ctx, replica, rwNodes)
 }
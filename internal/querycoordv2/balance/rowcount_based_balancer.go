@@ -222,7 +222,7 @@ func (b *RowCountBasedBalancer) balanceChannels(ctx context.Context, br *balance
 			log.RatedInfo(10, "stopping balance is disabled!", zap.Int64s("stoppingNode", roNodes))
 			return nil
 		}
-		return b.genStoppingChannelPlan(ctx, replica, rwNodes, roNodes)
+		return b.genStoppingChannelPlan(ctx, replica, b.nodeManager.FilterOverloadedNodes(rwNodes), roNodes)
 	}
 
 	if paramtable.Get().QueryCoordCfg.AutoBalanceChannel.GetAsBool() {
@@ -250,7 +250,7 @@ func (b *RowCountBasedBalancer) balanceSegments(ctx context.Context, replica *me
 			zap.Any("available nodes", rwNodes),
 		)
 		// handle stopped nodes here, have to assign segments on stopping nodes to nodes with the smallest score
-		return b.genStoppingSegmentPlan(ctx, replica, rwNodes, roNodes)
+		return b.genStoppingSegmentPlan(ctx, replica, b.nodeManager.FilterOverloadedNodes(rwNodes), roNodes)
 	}
 	return b.genSegmentPlan(ctx, replica, rwNodes)
 }
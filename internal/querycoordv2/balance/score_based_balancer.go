@@ -404,8 +404,9 @@ func (b *ScoreBasedBalancer) calculateScoreBySegment(br *balanceReport, collecti
 	br.AddDetailRecord(StrRecordf("Calcalute score for collection %d on node %d, global row count: %d, collection row count: %d",
 		collectionID, nodeID, nodeRowCount, collectionRowCount))
 
-	return collectionRowCount + int(float64(nodeRowCount)*
+	score := collectionRowCount + int(float64(nodeRowCount)*
 		params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat())
+	return b.applyLoadScore(br, nodeID, score)
 }
 
 func (b *ScoreBasedBalancer) calculateScoreByChannel(br *balanceReport, collectionID, nodeID int64) int {
@@ -430,7 +431,24 @@ func (b *ScoreBasedBalancer) calculateScoreByChannel(br *balanceReport, collecti
 
 	// give a higher weight to distribute collection's channels evenly across multiple nodes.
 	channelWeight := paramtable.Get().QueryCoordCfg.CollectionChannelCountFactor.GetAsFloat()
-	return nodeChannelNum + int(float64(collectionChannelNum)*math.Max(1.0, channelWeight))
+	score := nodeChannelNum + int(float64(collectionChannelNum)*math.Max(1.0, channelWeight))
+	return b.applyLoadScore(br, nodeID, score)
+}
+
+// applyLoadScore amplifies the score of the node by its load reported by the recent heartbeats,
+// so that the busy nodes get less segments and channels even if they hold less rows.
+func (b *ScoreBasedBalancer) applyLoadScore(br *balanceReport, nodeID int64, score int) int {
+	factor := paramtable.Get().QueryCoordCfg.NodeLoadScoreFactor.GetAsFloat()
+	if factor <= 0 {
+		return score
+	}
+	nodeInfo := b.nodeManager.Get(nodeID)
+	if nodeInfo == nil {
+		return score
+	}
+	loadScore := nodeInfo.LoadScore()
+	br.AddDetailRecord(StrRecordf("Apply load score %.2f on node %d, score: %d", loadScore, nodeID, score))
+	return int(float64(score) * (1 + factor*loadScore))
 }
 
 // calculateSegmentScore calculate the score which the segment represented
@@ -498,7 +516,7 @@ func (b *ScoreBasedBalancer) balanceChannels(ctx context.Context, br *balanceRep
 		}
 
 		br.AddRecord(StrRecordf("executing stopping balance: %v", roNodes))
-		return b.genStoppingChannelPlan(ctx, replica, rwNodes, roNodes)
+		return b.genStoppingChannelPlan(ctx, replica, b.nodeManager.FilterOverloadedNodes(rwNodes), roNodes)
 	}
 
 	if paramtable.Get().QueryCoordCfg.AutoBalanceChannel.GetAsBool() {
@@ -528,7 +546,7 @@ func (b *ScoreBasedBalancer) balanceSegments(ctx context.Context, br *balanceRep
 			zap.Any("available nodes", rwNodes),
 		)
 		// handle stopped nodes here, have to assign segments on stopping nodes to nodes with the smallest score
-		return b.genStoppingSegmentPlan(ctx, replica, rwNodes, roNodes)
+		return b.genStoppingSegmentPlan(ctx, replica, b.nodeManager.FilterOverloadedNodes(rwNodes), roNodes)
 	}
 	return b.genSegmentPlan(ctx, br, replica, rwNodes)
 }
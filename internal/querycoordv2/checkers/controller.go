@@ -179,7 +179,7 @@ func (controller *CheckerController) Check() {
 // check is the real implementation of Check
 func (controller *CheckerController) check(ctx context.Context, checkType utils.CheckerType) {
 	checker := controller.checkers[checkType]
-	tasks := checker.Check(ctx)
+	tasks := checker.Check(withRoundBroker(ctx, controller.broker))
 
 	for _, task := range tasks {
 		err := controller.scheduler.Add(task)
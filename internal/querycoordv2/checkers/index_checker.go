@@ -82,10 +82,11 @@ func (c *IndexChecker) Check(ctx context.Context) []task.Task {
 		return nil
 	}
 	collectionIDs := c.meta.CollectionManager.GetAll(ctx)
+	broker := getBroker(ctx, c.broker)
 	var tasks []task.Task
 
 	for _, collectionID := range collectionIDs {
-		indexInfos, err := c.broker.ListIndexes(ctx, collectionID)
+		indexInfos, err := broker.ListIndexes(ctx, collectionID)
 		if err != nil {
 			log.Warn("failed to list indexes", zap.Int64("collection", collectionID), zap.Error(err))
 			continue
@@ -98,7 +99,7 @@ func (c *IndexChecker) Check(ctx context.Context) []task.Task {
 			continue
 		}
 		if schema == nil && paramtable.Get().CommonCfg.EnabledJSONKeyStats.GetAsBool() {
-			collectionSchema, err1 := c.broker.DescribeCollection(ctx, collectionID)
+			collectionSchema, err1 := broker.DescribeCollection(ctx, collectionID)
 			if err1 == nil {
 				schema = collectionSchema.GetSchema()
 				c.meta.PutCollectionSchema(ctx, collectionID, collectionSchema.GetSchema())
@@ -18,8 +18,10 @@ package checkers
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
@@ -29,17 +31,33 @@ import (
 	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
 var _ Checker = (*LeaderChecker)(nil)
 
-// LeaderChecker perform segment index check.
+// LeaderChecker detects the divergence of the shard leader views from the distribution and target, and repairs it:
+//   - missing route, the leader view lacks the route of a loaded segment, or routes it to an outdated copy.
+//   - stale route, the leader view routes a segment in target to a querynode which doesn't serve it,
+//     which is only repaired after the grace period since the distribution may lag behind.
+//   - released route, the leader view routes a segment which is neither loaded nor in target.
 type LeaderChecker struct {
 	*checkerActivation
 	meta    *meta.Meta
 	dist    *meta.DistributionManager
 	target  meta.TargetManagerInterface
 	nodeMgr *session.NodeManager
+
+	// staleRoutes records since when the stale routes are found
+	staleRoutes map[staleRoute]time.Time
+}
+
+type staleRoute struct {
+	leaderID  int64
+	channel   string
+	segmentID int64
+	nodeID    int64
 }
 
 func NewLeaderChecker(
@@ -54,6 +72,7 @@ func NewLeaderChecker(
 		dist:              dist,
 		target:            target,
 		nodeMgr:           nodeMgr,
+		staleRoutes:       make(map[staleRoute]time.Time),
 	}
 }
 
@@ -79,6 +98,7 @@ func (c *LeaderChecker) Check(ctx context.Context) []task.Task {
 
 	collectionIDs := c.meta.CollectionManager.GetAll(ctx)
 	tasks := make([]task.Task, 0)
+	staleRoutes := make(map[staleRoute]time.Time)
 
 	for _, collectionID := range collectionIDs {
 		if !c.readyToCheck(ctx, collectionID) {
@@ -89,6 +109,11 @@ func (c *LeaderChecker) Check(ctx context.Context) []task.Task {
 			log.Warn("collection released during check leader", zap.Int64("collection", collectionID))
 			continue
 		}
+		divergence := map[string]int{
+			metrics.MissingRouteLabel:  0,
+			metrics.StaleRouteLabel:    0,
+			metrics.ReleasedRouteLabel: 0,
+		}
 
 		replicas := c.meta.ReplicaManager.GetByCollection(ctx, collectionID)
 		for _, replica := range replicas {
@@ -100,13 +125,18 @@ func (c *LeaderChecker) Check(ctx context.Context) []task.Task {
 				delegatorList := c.dist.ChannelDistManager.GetByFilter(meta.WithCollectionID2Channel(replica.GetCollectionID()), meta.WithNodeID2Channel(node))
 				for _, d := range delegatorList {
 					dist := c.dist.SegmentDistManager.GetByFilter(meta.WithChannel(d.View.Channel), meta.WithReplica(replica))
-					tasks = append(tasks, c.findNeedLoadedSegments(ctx, replica, d.View, dist)...)
-					tasks = append(tasks, c.findNeedRemovedSegments(ctx, replica, d.View, dist)...)
+					tasks = append(tasks, c.findNeedLoadedSegments(ctx, replica, d.View, dist, divergence)...)
+					tasks = append(tasks, c.findNeedRemovedSegments(ctx, replica, d.View, dist, divergence)...)
+					tasks = append(tasks, c.findStaleRoutes(ctx, replica, d.View, dist, divergence, staleRoutes)...)
 					tasks = append(tasks, c.findNeedSyncPartitionStats(ctx, replica, d.View, node)...)
 				}
 			}
 		}
+		for divergenceType, num := range divergence {
+			metrics.QueryCoordLeaderViewDivergence.WithLabelValues(fmt.Sprint(collectionID), divergenceType).Set(float64(num))
+		}
 	}
+	c.staleRoutes = staleRoutes
 
 	return tasks
 }
@@ -156,7 +186,7 @@ func (c *LeaderChecker) findNeedSyncPartitionStats(ctx context.Context, replica
 	return ret
 }
 
-func (c *LeaderChecker) findNeedLoadedSegments(ctx context.Context, replica *meta.Replica, leaderView *meta.LeaderView, dist []*meta.Segment) []task.Task {
+func (c *LeaderChecker) findNeedLoadedSegments(ctx context.Context, replica *meta.Replica, leaderView *meta.LeaderView, dist []*meta.Segment, divergence map[string]int) []task.Task {
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", leaderView.CollectionID),
 		zap.Int64("replica", replica.GetID()),
@@ -181,6 +211,11 @@ func (c *LeaderChecker) findNeedLoadedSegments(ctx context.Context, replica *met
 			log.RatedDebug(10, "leader checker append a segment to set",
 				zap.Int64("segmentID", s.GetID()),
 				zap.Int64("nodeID", s.Node))
+			if ok {
+				c.countDivergence(leaderView.CollectionID, divergence, metrics.StaleRouteLabel)
+			} else {
+				c.countDivergence(leaderView.CollectionID, divergence, metrics.MissingRouteLabel)
+			}
 
 			action := task.NewLeaderAction(leaderView.ID, s.Node, task.ActionTypeGrow, s.GetInsertChannel(), s.GetID(), time.Now().UnixNano())
 			t := task.NewLeaderSegmentTask(
@@ -201,7 +236,7 @@ func (c *LeaderChecker) findNeedLoadedSegments(ctx context.Context, replica *met
 	return ret
 }
 
-func (c *LeaderChecker) findNeedRemovedSegments(ctx context.Context, replica *meta.Replica, leaderView *meta.LeaderView, dists []*meta.Segment) []task.Task {
+func (c *LeaderChecker) findNeedRemovedSegments(ctx context.Context, replica *meta.Replica, leaderView *meta.LeaderView, dists []*meta.Segment, divergence map[string]int) []task.Task {
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", leaderView.CollectionID),
 		zap.Int64("replica", replica.GetID()),
@@ -225,6 +260,7 @@ func (c *LeaderChecker) findNeedRemovedSegments(ctx context.Context, replica *me
 		log.Debug("leader checker append a segment to remove",
 			zap.Int64("segmentID", sid),
 			zap.Int64("nodeID", s.NodeID))
+		c.countDivergence(leaderView.CollectionID, divergence, metrics.ReleasedRouteLabel)
 		// reduce leader action won't be execute on worker, in  order to remove segment from delegator success even when worker done
 		// set workerID to leader view's node
 		action := task.NewLeaderAction(leaderView.ID, leaderView.ID, task.ActionTypeReduce, leaderView.Channel, sid, 0)
@@ -244,3 +280,73 @@ func (c *LeaderChecker) findNeedRemovedSegments(ctx context.Context, replica *me
 	}
 	return ret
 }
+
+// findStaleRoutes finds the segments in target which are routed to the querynodes not serving them,
+// while no other copy is loaded, and removes the routes after the grace period,
+// so that the shard is reported unserviceable until the segment is loaded again by the segment checker.
+// The routes to the outdated copies are repaired by findNeedLoadedSegments.
+func (c *LeaderChecker) findStaleRoutes(ctx context.Context, replica *meta.Replica, leaderView *meta.LeaderView,
+	dist []*meta.Segment, divergence map[string]int, staleRoutes map[staleRoute]time.Time,
+) []task.Task {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", leaderView.CollectionID),
+		zap.Int64("replica", replica.GetID()),
+		zap.String("channel", leaderView.Channel),
+		zap.Int64("leaderViewID", leaderView.ID),
+	)
+
+	servedBy := lo.GroupBy(dist, func(s *meta.Segment) int64 { return s.GetID() })
+	gracePeriod := paramtable.Get().QueryCoordCfg.LeaderDivergenceGracePeriod.GetAsDuration(time.Second)
+	ret := make([]task.Task, 0)
+	for sid, s := range leaderView.Segments {
+		if len(servedBy[sid]) > 0 {
+			continue
+		}
+		if c.target.GetSealedSegment(ctx, leaderView.CollectionID, sid, meta.CurrentTargetFirst) == nil {
+			// removed by findNeedRemovedSegments
+			continue
+		}
+		divergence[metrics.StaleRouteLabel]++
+
+		route := staleRoute{leaderID: leaderView.ID, channel: leaderView.Channel, segmentID: sid, nodeID: s.NodeID}
+		since, ok := c.staleRoutes[route]
+		if !ok {
+			since = time.Now()
+		}
+		staleRoutes[route] = since
+		if time.Since(since) < gracePeriod || !paramtable.Get().QueryCoordCfg.LeaderDivergenceRepair.GetAsBool() {
+			log.RatedWarn(60, "leader view routes segment to a querynode not serving it",
+				zap.Int64("segmentID", sid),
+				zap.Int64("nodeID", s.NodeID),
+				zap.Time("since", since))
+			continue
+		}
+
+		log.Warn("leader checker append a stale route to remove",
+			zap.Int64("segmentID", sid),
+			zap.Int64("nodeID", s.NodeID),
+			zap.Time("since", since))
+		metrics.QueryCoordLeaderViewRepairCount.WithLabelValues(fmt.Sprint(leaderView.CollectionID), metrics.StaleRouteLabel).Inc()
+		action := task.NewLeaderAction(leaderView.ID, leaderView.ID, task.ActionTypeReduce, leaderView.Channel, sid, 0)
+		t := task.NewLeaderSegmentTask(
+			ctx,
+			c.ID(),
+			leaderView.CollectionID,
+			replica,
+			leaderView.ID,
+			action,
+		)
+
+		// leader task shouldn't replace executing segment task
+		t.SetPriority(task.TaskPriorityLow)
+		t.SetReason("remove stale route from leader view")
+		ret = append(ret, t)
+	}
+	return ret
+}
+
+// countDivergence counts the divergence which is always repaired.
+func (c *LeaderChecker) countDivergence(collectionID int64, divergence map[string]int, divergenceType string) {
+	divergence[divergenceType]++
+	metrics.QueryCoordLeaderViewRepairCount.WithLabelValues(fmt.Sprint(collectionID), divergenceType).Inc()
+}
@@ -532,6 +532,74 @@ func (suite *LeaderCheckerTestSuite) TestIgnoreSyncRemovedSegments() {
 	suite.Equal(tasks[0].Priority(), task.TaskPriorityLow)
 }
 
+func (suite *LeaderCheckerTestSuite) TestRemoveStaleRoutes() {
+	ctx := context.Background()
+	observer := suite.checker
+	observer.meta.CollectionManager.PutCollection(ctx, utils.CreateTestCollection(1, 1))
+	observer.meta.CollectionManager.PutPartition(ctx, utils.CreateTestPartition(1, 1))
+	observer.meta.ReplicaManager.Put(ctx, utils.CreateTestReplica(1, 1, []int64{1, 2}))
+
+	segments := []*datapb.SegmentInfo{
+		{
+			ID:            2,
+			PartitionID:   1,
+			InsertChannel: "test-insert-channel",
+		},
+	}
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(
+		channels, segments, nil)
+	observer.target.UpdateCollectionNextTarget(ctx, int64(1))
+
+	// segment 2 is routed to node 1, which doesn't serve it
+	observer.dist.ChannelDistManager.Update(2, &meta.DmChannel{
+		VchannelInfo: &datapb.VchannelInfo{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+		Node:    2,
+		Version: 1,
+		View: &meta.LeaderView{
+			ID:            2,
+			CollectionID:  1,
+			Channel:       "test-insert-channel",
+			TargetVersion: observer.target.GetCollectionTargetVersion(ctx, 1, meta.CurrentTarget),
+			Segments:      map[int64]*querypb.SegmentDist{2: {NodeID: 1}},
+		},
+	})
+
+	// the stale route is tolerated within the grace period
+	tasks := suite.checker.Check(context.TODO())
+	suite.Len(tasks, 0)
+	suite.Len(suite.checker.staleRoutes, 1)
+
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.LeaderDivergenceGracePeriod.Key, "0")
+	defer paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.LeaderDivergenceGracePeriod.Key)
+	tasks = suite.checker.Check(context.TODO())
+	suite.Len(tasks, 1)
+	suite.Equal(tasks[0].Source(), utils.LeaderChecker)
+	suite.Len(tasks[0].Actions(), 1)
+	suite.Equal(tasks[0].Actions()[0].Type(), task.ActionTypeReduce)
+	suite.Equal(tasks[0].Actions()[0].Node(), int64(2))
+	suite.Equal(tasks[0].Actions()[0].(*task.LeaderAction).SegmentID(), int64(2))
+
+	// only reported if the repair is disabled
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.LeaderDivergenceRepair.Key, "false")
+	defer paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.LeaderDivergenceRepair.Key)
+	tasks = suite.checker.Check(context.TODO())
+	suite.Len(tasks, 0)
+
+	// the route is fixed once the segment is loaded
+	observer.dist.SegmentDistManager.Update(1, utils.CreateTestSegment(1, 1, 2, 1, 1, "test-insert-channel"))
+	suite.checker.Check(context.TODO())
+	suite.Len(suite.checker.staleRoutes, 0)
+}
+
 func (suite *LeaderCheckerTestSuite) TestUpdatePartitionStats() {
 	ctx := context.Background()
 	testChannel := "test-insert-channel"
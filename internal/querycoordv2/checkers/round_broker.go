@@ -0,0 +1,100 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkers
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+type roundBrokerKey struct{}
+
+// roundBroker memoizes the collection level broker calls within one check round,
+// so that the checkers won't ask the coordinators for the same collection repeatedly.
+// The segment level calls are passed through, and the failed calls are not cached.
+type roundBroker struct {
+	meta.Broker
+
+	collections *typeutil.ConcurrentMap[int64, *milvuspb.DescribeCollectionResponse]
+	partitions  *typeutil.ConcurrentMap[int64, []int64]
+	indexes     *typeutil.ConcurrentMap[int64, []*indexpb.IndexInfo]
+}
+
+func newRoundBroker(broker meta.Broker) *roundBroker {
+	return &roundBroker{
+		Broker:      broker,
+		collections: typeutil.NewConcurrentMap[int64, *milvuspb.DescribeCollectionResponse](),
+		partitions:  typeutil.NewConcurrentMap[int64, []int64](),
+		indexes:     typeutil.NewConcurrentMap[int64, []*indexpb.IndexInfo](),
+	}
+}
+
+func (b *roundBroker) DescribeCollection(ctx context.Context, collectionID int64) (*milvuspb.DescribeCollectionResponse, error) {
+	if resp, ok := b.collections.Get(collectionID); ok {
+		return resp, nil
+	}
+	resp, err := b.Broker.DescribeCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	b.collections.Insert(collectionID, resp)
+	return resp, nil
+}
+
+func (b *roundBroker) GetPartitions(ctx context.Context, collectionID int64) ([]int64, error) {
+	if partitions, ok := b.partitions.Get(collectionID); ok {
+		return partitions, nil
+	}
+	partitions, err := b.Broker.GetPartitions(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	b.partitions.Insert(collectionID, partitions)
+	return partitions, nil
+}
+
+func (b *roundBroker) ListIndexes(ctx context.Context, collectionID int64) ([]*indexpb.IndexInfo, error) {
+	if indexes, ok := b.indexes.Get(collectionID); ok {
+		return indexes, nil
+	}
+	indexes, err := b.Broker.ListIndexes(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	b.indexes.Insert(collectionID, indexes)
+	return indexes, nil
+}
+
+// withRoundBroker attaches a fresh round broker to the context of a check round.
+func withRoundBroker(ctx context.Context, broker meta.Broker) context.Context {
+	if broker == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, roundBrokerKey{}, newRoundBroker(broker))
+}
+
+// getBroker returns the round broker in the context, or the given broker if the check is not run by the controller.
+func getBroker(ctx context.Context, broker meta.Broker) meta.Broker {
+	if b, ok := ctx.Value(roundBrokerKey{}).(*roundBroker); ok {
+		return b
+	}
+	return broker
+}
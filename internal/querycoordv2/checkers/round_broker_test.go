@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+)
+
+func TestRoundBroker(t *testing.T) {
+	ctx := context.Background()
+	broker := meta.NewMockBroker(t)
+
+	// not in a check round
+	assert.Equal(t, meta.Broker(broker), getBroker(ctx, broker))
+	assert.Equal(t, ctx, withRoundBroker(ctx, nil))
+
+	broker.EXPECT().ListIndexes(mock.Anything, int64(1)).Return([]*indexpb.IndexInfo{{IndexID: 100}}, nil).Once()
+	broker.EXPECT().DescribeCollection(mock.Anything, int64(1)).Return(&milvuspb.DescribeCollectionResponse{CollectionID: 1}, nil).Once()
+	broker.EXPECT().GetPartitions(mock.Anything, int64(1)).Return([]int64{10, 11}, nil).Once()
+	broker.EXPECT().ListIndexes(mock.Anything, int64(2)).Return(nil, errors.New("mock error")).Twice()
+
+	roundCtx := withRoundBroker(ctx, broker)
+	cached := getBroker(roundCtx, broker)
+	for i := 0; i < 3; i++ {
+		indexes, err := cached.ListIndexes(roundCtx, 1)
+		assert.NoError(t, err)
+		assert.Len(t, indexes, 1)
+
+		coll, err := cached.DescribeCollection(roundCtx, 1)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 1, coll.GetCollectionID())
+
+		partitions, err := cached.GetPartitions(roundCtx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{10, 11}, partitions)
+	}
+
+	// failures are not cached
+	_, err := cached.ListIndexes(roundCtx, 2)
+	assert.Error(t, err)
+	_, err = cached.ListIndexes(roundCtx, 2)
+	assert.Error(t, err)
+
+	// a new round doesn't share the cache
+	broker.EXPECT().ListIndexes(mock.Anything, int64(1)).Return([]*indexpb.IndexInfo{}, nil).Once()
+	indexes, err := getBroker(withRoundBroker(ctx, broker), broker).ListIndexes(ctx, 1)
+	assert.NoError(t, err)
+	assert.Empty(t, indexes)
+}
@@ -129,6 +129,7 @@ func (c *SegmentChecker) checkReplica(ctx context.Context, replica *meta.Replica
 
 	// compare with targets to find the lack and redundancy of segments
 	lacks, loadPriorities, redundancies := c.getSealedSegmentDiff(ctx, replica.GetCollectionID(), replica.GetID())
+	lacks, loadPriorities = c.filterOutSegmentInBackoff(replica.GetCollectionID(), lacks, loadPriorities)
 	tasks := c.createSegmentLoadTasks(c.getTraceCtx(ctx, replica.GetCollectionID()), lacks, loadPriorities, replica)
 	task.SetReason("lacks of segment", tasks...)
 	task.SetPriority(task.TaskPriorityNormal, tasks...)
@@ -365,6 +366,21 @@ func (c *SegmentChecker) filterOutSegmentInUse(ctx context.Context, replica *met
 	return notUsed
 }
 
+// filterOutSegmentInBackoff filters out the segments which failed to load recently,
+// they will be retried after the backoff interval recorded in segment quarantine.
+func (c *SegmentChecker) filterOutSegmentInBackoff(collectionID int64, segments []*datapb.SegmentInfo, loadPriorities []commonpb.LoadPriority) ([]*datapb.SegmentInfo, []commonpb.LoadPriority) {
+	filteredSegments := make([]*datapb.SegmentInfo, 0, len(segments))
+	filteredPriorities := make([]commonpb.LoadPriority, 0, len(loadPriorities))
+	for i, segment := range segments {
+		if meta.GlobalFailedLoadCache.SegmentInBackoff(collectionID, segment.GetID()) {
+			continue
+		}
+		filteredSegments = append(filteredSegments, segment)
+		filteredPriorities = append(filteredPriorities, loadPriorities[i])
+	}
+	return filteredSegments, filteredPriorities
+}
+
 func (c *SegmentChecker) createSegmentLoadTasks(ctx context.Context, segments []*datapb.SegmentInfo, loadPriorities []commonpb.LoadPriority, replica *meta.Replica) []task.Task {
 	if len(segments) == 0 {
 		return nil
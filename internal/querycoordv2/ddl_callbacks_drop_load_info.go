@@ -74,6 +74,6 @@ func (s *Server) dropLoadConfigV2AckCallback(ctx context.Context, result message
 	if err := releaseJob.Execute(); err != nil {
 		return err
 	}
-	meta.GlobalFailedLoadCache.Remove(result.Message.Header().GetCollectionId())
+	meta.GlobalFailedLoadCache.RemoveCollection(result.Message.Header().GetCollectionId())
 	return nil
 }
@@ -154,6 +154,10 @@ func (dh *distHandler) handleDistResp(ctx context.Context, resp *querypb.GetData
 	now := time.Now()
 	node.SetLastHeartbeat(now)
 	metrics.QueryCoordLastHeartbeatTimeStamp.WithLabelValues(fmt.Sprint(resp.GetNodeID())).Set(float64(now.UnixNano()))
+	if heartbeat := session.ParseNodeHeartbeat(resp.GetStatus()); heartbeat != nil {
+		node.UpdateHeartbeat(heartbeat, now)
+		metrics.QueryCoordNodeLoadScore.WithLabelValues(fmt.Sprint(resp.GetNodeID())).Set(node.LoadScore())
+	}
 
 	// skip  update dist if no distribution change happens in query node
 	if resp.GetLastModifyTs() != 0 && resp.GetLastModifyTs() <= dh.lastUpdateTs {
@@ -165,7 +169,7 @@ func (dh *distHandler) handleDistResp(ctx context.Context, resp *querypb.GetData
 			session.WithSegmentCnt(len(resp.GetSegments())),
 			session.WithChannelCnt(len(resp.GetChannels())),
 			session.WithMemCapacity(resp.GetMemCapacityInMB()),
-			session.WithChannelCnt(int(resp.GetCpuNum())),
+			session.WithCPUNum(resp.GetCpuNum()),
 		)
 		dh.updateSegmentsDistribution(ctx, resp)
 		dh.updateChannelsDistribution(ctx, resp)
@@ -19,6 +19,7 @@ package querycoordv2
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/internal/util/componentutil"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v2/util/hardware"
@@ -284,6 +286,40 @@ func getMetrics[T any](ctx context.Context, s *Server, req *milvuspb.GetMetricsR
 	return metrics, err
 }
 
+// getConfigDriftJSON returns the configurations which differ across the querynodes.
+func (s *Server) getConfigDriftJSON(ctx context.Context) (string, error) {
+	var (
+		mu          sync.Mutex
+		wg          sync.WaitGroup
+		nodeConfigs = make(map[int64][]*commonpb.KeyValuePair)
+		failedNodes []int64
+	)
+	req := componentutil.NewShowConfigurationsDetailRequest("")
+	for _, node := range s.nodeMgr.GetAll() {
+		nodeID := node.ID()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := s.cluster.ShowConfigurations(ctx, nodeID, req)
+			mu.Lock()
+			defer mu.Unlock()
+			if err := merr.CheckRPCCall(resp, err); err != nil {
+				log.Ctx(ctx).Warn("failed to show configurations of QueryNode", zap.Int64("nodeID", nodeID), zap.Error(err))
+				failedNodes = append(failedNodes, nodeID)
+				return
+			}
+			nodeConfigs[nodeID] = resp.GetConfiguations()
+		}()
+	}
+	wg.Wait()
+
+	bs, err := json.Marshal(componentutil.DiffConfigurations(typeutil.QueryNodeRole, nodeConfigs, failedNodes))
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
 func (s *Server) getChannelsFromQueryNode(ctx context.Context, req *milvuspb.GetMetricsRequest) (string, error) {
 	channels, err := getMetrics[*metricsinfo.Channel](ctx, s, req)
 	return metricsinfo.MarshalGetMetricsValues(channels, err)
@@ -294,6 +330,86 @@ func (s *Server) getSegmentsFromQueryNode(ctx context.Context, req *milvuspb.Get
 	return metricsinfo.MarshalGetMetricsValues(segments, err)
 }
 
+// getTimeTravelWatermarkJSON returns the oldest timestamp pinned by the running requests across the querynodes.
+// The watermarks are cached from the heartbeats of the querynodes, so no querynode is requested here,
+// and the querynodes without a recent heartbeat are reported as unknown.
+func (s *Server) getTimeTravelWatermarkJSON() (string, error) {
+	result := &metricsinfo.TimeTravelWatermark{}
+	available := paramtable.Get().QueryCoordCfg.HeartbeatAvailableInterval.GetAsDuration(time.Millisecond)
+	for _, node := range s.nodeMgr.GetAll() {
+		if time.Since(node.LastHeartbeat()) > available {
+			result.UnknownNodes = append(result.UnknownNodes, node.ID())
+			continue
+		}
+		if watermark := node.TimeTravelWatermark(); watermark != 0 && (result.Watermark == 0 || watermark < result.Watermark) {
+			result.Watermark = watermark
+		}
+	}
+	ret, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+// getNodeHeartbeatsJSON returns the build version, feature flags and recent load samples of the querynodes.
+func (s *Server) getNodeHeartbeatsJSON() (string, error) {
+	nodes := s.nodeMgr.GetAll()
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID() < nodes[j].ID() })
+	infos := make([]*metricsinfo.NodeHeartbeatInfo, 0, len(nodes))
+	for _, node := range nodes {
+		infos = append(infos, node.HeartbeatInfo())
+	}
+	ret, err := json.Marshal(infos)
+	if err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+// getTargetDiffJSON returns the diff from current target to next target of the collection,
+// along with the progress of the distribution converging to next target.
+func (s *Server) getTargetDiffJSON(ctx context.Context, jsonReq gjson.Result) (string, error) {
+	collectionID := metricsinfo.GetCollectionIDFromRequest(jsonReq)
+	if collectionID <= 0 {
+		return "", merr.WrapErrParameterInvalidMsg("collection_id is required for target diff")
+	}
+	if s.meta.CollectionManager.GetCollection(ctx, collectionID) == nil {
+		return "", merr.WrapErrCollectionNotLoaded(collectionID)
+	}
+
+	diff := &metricsinfo.QueryCoordTargetDiff{
+		CollectionID:     collectionID,
+		CurrentVersion:   s.targetMgr.GetCollectionTargetVersion(ctx, collectionID, meta.CurrentTarget),
+		NextVersion:      s.targetMgr.GetCollectionTargetVersion(ctx, collectionID, meta.NextTarget),
+		EstimatedSeconds: -1,
+	}
+	currentSegments := s.targetMgr.GetSealedSegmentsByCollection(ctx, collectionID, meta.CurrentTarget)
+	nextSegments := s.targetMgr.GetSealedSegmentsByCollection(ctx, collectionID, meta.NextTarget)
+	diff.SegmentsToLoad, diff.SegmentsToRelease = lo.Difference(lo.Keys(nextSegments), lo.Keys(currentSegments))
+	currentChannels := s.targetMgr.GetDmChannelsByCollection(ctx, collectionID, meta.CurrentTarget)
+	nextChannels := s.targetMgr.GetDmChannelsByCollection(ctx, collectionID, meta.NextTarget)
+	diff.ChannelsToWatch, diff.ChannelsToRelease = lo.Difference(lo.Keys(nextChannels), lo.Keys(currentChannels))
+	sort.Slice(diff.SegmentsToLoad, func(i, j int) bool { return diff.SegmentsToLoad[i] < diff.SegmentsToLoad[j] })
+	sort.Slice(diff.SegmentsToRelease, func(i, j int) bool { return diff.SegmentsToRelease[i] < diff.SegmentsToRelease[j] })
+	sort.Strings(diff.ChannelsToWatch)
+	sort.Strings(diff.ChannelsToRelease)
+
+	if convergence, ok := s.targetObserver.GetConvergence(collectionID); ok {
+		diff.SegmentsRemaining = convergence.SegmentsRemaining
+		diff.ChannelsRemaining = convergence.ChannelsRemaining
+		if convergence.ETA >= 0 {
+			diff.EstimatedSeconds = convergence.ETA.Seconds()
+		}
+	}
+
+	bs, err := json.Marshal(diff)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
 func (s *Server) getSegmentsJSON(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
 	v := jsonReq.Get(metricsinfo.MetricRequestParamINKey)
 	if !v.Exists() {
@@ -18,17 +18,29 @@ package querycoordv2
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/tidwall/gjson"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/querycoordv2/job"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/observers"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
 func TestGetChannelsFromQueryNode(t *testing.T) {
@@ -69,6 +81,28 @@ func TestGetChannelsFromQueryNode(t *testing.T) {
 	assert.Equal(t, expectedChannels, actualChannels)
 }
 
+func TestGetTimeTravelWatermarkJSON(t *testing.T) {
+	paramtable.Init()
+	nodeManager := session.NewNodeManager()
+	now := time.Now()
+	for nodeID, ts := range map[int64]uint64{1: 200, 2: 0, 3: 100, 4: 50} {
+		node := session.NewNodeInfo(session.ImmutableNodeInfo{NodeID: nodeID})
+		node.UpdateHeartbeat(&metricsinfo.NodeHeartbeat{TimeTravelWatermark: ts}, now)
+		node.SetLastHeartbeat(now)
+		nodeManager.Add(node)
+	}
+	// the requests on the node without a recent heartbeat are unknown
+	nodeManager.Get(4).SetLastHeartbeat(now.Add(-time.Hour))
+	server := &Server{nodeMgr: nodeManager}
+
+	result, err := server.getTimeTravelWatermarkJSON()
+	assert.NoError(t, err)
+	watermark := &metricsinfo.TimeTravelWatermark{}
+	assert.NoError(t, json.Unmarshal([]byte(result), watermark))
+	assert.EqualValues(t, 100, watermark.Watermark)
+	assert.Equal(t, []int64{4}, watermark.UnknownNodes)
+}
+
 func TestGetSegmentsFromQueryNode(t *testing.T) {
 	mockCluster := session.NewMockCluster(t)
 	nodeManager := session.NewNodeManager()
@@ -110,6 +144,43 @@ func TestGetSegmentsFromQueryNode(t *testing.T) {
 	assert.Equal(t, expectedSegments, actualSegments)
 }
 
+func TestServer_getConfigDriftJSON(t *testing.T) {
+	mockCluster := session.NewMockCluster(t)
+	nodeManager := session.NewNodeManager()
+	nodeManager.Add(session.NewNodeInfo(session.ImmutableNodeInfo{NodeID: 1}))
+	nodeManager.Add(session.NewNodeInfo(session.ImmutableNodeInfo{NodeID: 2}))
+	nodeManager.Add(session.NewNodeInfo(session.ImmutableNodeInfo{NodeID: 3}))
+	server := &Server{cluster: mockCluster, nodeMgr: nodeManager}
+
+	mockCluster.EXPECT().ShowConfigurations(mock.Anything, int64(1), mock.Anything).Return(&internalpb.ShowConfigurationsResponse{
+		Status: merr.Success(),
+		Configuations: []*commonpb.KeyValuePair{
+			{Key: "querynode.a", Value: "1[FileSource]"},
+			{Key: "querynode.b", Value: "2[DefaultSource]"},
+		},
+	}, nil)
+	mockCluster.EXPECT().ShowConfigurations(mock.Anything, int64(2), mock.Anything).Return(&internalpb.ShowConfigurationsResponse{
+		Status: merr.Success(),
+		Configuations: []*commonpb.KeyValuePair{
+			{Key: "querynode.a", Value: "1[DefaultSource]"},
+			{Key: "querynode.b", Value: "3[EtcdSource]"},
+		},
+	}, nil)
+	mockCluster.EXPECT().ShowConfigurations(mock.Anything, int64(3), mock.Anything).Return(nil, errors.New("mock"))
+
+	result, err := server.getConfigDriftJSON(context.Background())
+	assert.NoError(t, err)
+
+	drift := &metricsinfo.ConfigDrift{}
+	assert.NoError(t, json.Unmarshal([]byte(result), drift))
+	assert.Equal(t, []int64{1, 2}, drift.Nodes)
+	assert.Equal(t, []int64{3}, drift.FailedNodes)
+	assert.Len(t, drift.Items, 1)
+	assert.Equal(t, "querynode.b", drift.Items[0].Key)
+	assert.Equal(t, "3", drift.Items[0].Values[1].Value)
+	assert.Equal(t, "EtcdSource", drift.Items[0].Values[1].Source)
+}
+
 func TestServer_getSegmentsJSON(t *testing.T) {
 	mockCluster := session.NewMockCluster(t)
 	nodeManager := session.NewNodeManager()
@@ -180,3 +251,86 @@ func TestServer_getSegmentsJSON(t *testing.T) {
 		assert.NotEmpty(t, result)
 	})
 }
+
+func TestServer_getTargetDiffJSON(t *testing.T) {
+	ctx := context.TODO()
+	nodeManager := session.NewNodeManager()
+	targetMgr := meta.NewMockTargetManager(t)
+	server := &Server{
+		meta:      meta.NewMeta(params.RandomIncrementIDAllocator(), mocks.NewQueryCoordCatalog(t), nodeManager),
+		targetMgr: targetMgr,
+		dist:      meta.NewDistributionManager(nodeManager),
+	}
+	server.targetObserver = observers.NewTargetObserver(server.meta, targetMgr, server.dist, nil, nil, nodeManager)
+	err := server.meta.CollectionManager.PutCollectionWithoutSave(ctx, utils.CreateTestCollection(1, 1))
+	assert.NoError(t, err)
+
+	t.Run("collection id required", func(t *testing.T) {
+		_, err := server.getTargetDiffJSON(ctx, gjson.Parse(`{}`))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("collection not loaded", func(t *testing.T) {
+		_, err := server.getTargetDiffJSON(ctx, gjson.Parse(`{"collection_id": 2}`))
+		assert.ErrorIs(t, err, merr.ErrCollectionNotLoaded)
+	})
+
+	t.Run("normal", func(t *testing.T) {
+		targetMgr.EXPECT().GetCollectionTargetVersion(mock.Anything, int64(1), meta.CurrentTarget).Return(100)
+		targetMgr.EXPECT().GetCollectionTargetVersion(mock.Anything, int64(1), meta.NextTarget).Return(101)
+		targetMgr.EXPECT().GetSealedSegmentsByCollection(mock.Anything, int64(1), meta.CurrentTarget).Return(map[int64]*datapb.SegmentInfo{
+			10: {ID: 10},
+			11: {ID: 11},
+		})
+		targetMgr.EXPECT().GetSealedSegmentsByCollection(mock.Anything, int64(1), meta.NextTarget).Return(map[int64]*datapb.SegmentInfo{
+			11: {ID: 11},
+			12: {ID: 12},
+		})
+		targetMgr.EXPECT().GetDmChannelsByCollection(mock.Anything, int64(1), meta.CurrentTarget).Return(map[string]*meta.DmChannel{})
+		targetMgr.EXPECT().GetDmChannelsByCollection(mock.Anything, int64(1), meta.NextTarget).Return(map[string]*meta.DmChannel{
+			"dmc0": meta.DmChannelFromVChannel(&datapb.VchannelInfo{ChannelName: "dmc0"}),
+		})
+
+		result, err := server.getTargetDiffJSON(ctx, gjson.Parse(`{"collection_id": 1}`))
+		assert.NoError(t, err)
+		diff := &metricsinfo.QueryCoordTargetDiff{}
+		assert.NoError(t, json.Unmarshal([]byte(result), diff))
+		assert.EqualValues(t, 100, diff.CurrentVersion)
+		assert.EqualValues(t, 101, diff.NextVersion)
+		assert.Equal(t, []int64{12}, diff.SegmentsToLoad)
+		assert.Equal(t, []int64{10}, diff.SegmentsToRelease)
+		assert.Equal(t, []string{"dmc0"}, diff.ChannelsToWatch)
+		assert.Empty(t, diff.ChannelsToRelease)
+		assert.EqualValues(t, -1, diff.EstimatedSeconds)
+	})
+}
+
+func TestServer_ListBackgroundJobs(t *testing.T) {
+	ctx := context.TODO()
+	nodeManager := session.NewNodeManager()
+	scheduler := task.NewMockScheduler(t)
+	scheduler.EXPECT().GetBalanceTasks().Return([]*datapb.BackgroundJob{
+		{Type: metricsinfo.BackgroundJobBalance, JobID: 1, CollectionID: 1, State: metricsinfo.BackgroundJobFailed, Error: "mock error"},
+	})
+	server := &Server{
+		ctx:           ctx,
+		meta:          meta.NewMeta(params.RandomIncrementIDAllocator(), mocks.NewQueryCoordCatalog(t), nodeManager),
+		jobScheduler:  job.NewScheduler(),
+		taskScheduler: scheduler,
+	}
+
+	resp, err := server.ListBackgroundJobs(ctx, &datapb.ListBackgroundJobsRequest{})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+
+	server.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = server.ListBackgroundJobs(ctx, &datapb.ListBackgroundJobsRequest{State: metricsinfo.BackgroundJobFailed})
+	assert.NoError(t, merr.CheckRPCCall(resp, err))
+	assert.Len(t, resp.GetJobs(), 1)
+	assert.Equal(t, metricsinfo.BackgroundJobBalance, resp.GetJobs()[0].GetType())
+	assert.Equal(t, "mock error", resp.GetJobs()[0].GetError())
+
+	resp, err = server.ListBackgroundJobs(ctx, &datapb.ListBackgroundJobsRequest{State: metricsinfo.BackgroundJobRunning})
+	assert.NoError(t, merr.CheckRPCCall(resp, err))
+	assert.Empty(t, resp.GetJobs())
+}
@@ -87,7 +87,7 @@ func (job *LoadCollectionJob) Execute() error {
 	vchannels := job.result.GetVChannelsWithoutControlChannel()
 
 	log := log.Ctx(job.ctx).With(zap.Int64("collectionID", req.GetCollectionId()))
-	meta.GlobalFailedLoadCache.Remove(req.GetCollectionId())
+	meta.GlobalFailedLoadCache.RemoveCollection(req.GetCollectionId())
 
 	// 1. create replica if not exist
 	if _, err := utils.SpawnReplicasWithReplicaConfig(job.ctx, job.meta, meta.SpawnWithReplicaConfigParams{
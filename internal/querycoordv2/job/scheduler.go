@@ -21,9 +21,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
@@ -43,6 +47,9 @@ type Scheduler struct {
 	processors *typeutil.ConcurrentSet[int64] // Collections of having processor
 	queues     map[int64]jobQueue             // CollectionID -> Queue
 	waitQueue  jobQueue
+	// jobStats records the load and release jobs processed recently
+	jobStats *expirable.LRU[int64, *datapb.BackgroundJob]
+	jobSeq   atomic.Int64
 
 	stopOnce sync.Once
 }
@@ -52,6 +59,7 @@ func NewScheduler() *Scheduler {
 		processors: typeutil.NewConcurrentSet[int64](),
 		queues:     make(map[int64]jobQueue),
 		waitQueue:  make(jobQueue, waitQueueCap),
+		jobStats:   expirable.NewLRU[int64, *datapb.BackgroundJob](256, nil, time.Minute*15),
 	}
 }
 
@@ -140,10 +148,13 @@ func (scheduler *Scheduler) process(job Job) {
 	log := log.Ctx(job.Context()).With(
 		zap.Int64("collectionID", job.CollectionID()))
 
+	seq, start := scheduler.jobSeq.Inc(), time.Now()
+	scheduler.recordJob(seq, job, start, false)
 	defer func() {
 		log.Info("start to post-execute job")
 		job.PostExecute()
 		log.Info("job finished")
+		scheduler.recordJob(seq, job, start, true)
 		job.Done()
 	}()
 
@@ -162,3 +173,40 @@ func (scheduler *Scheduler) process(job Job) {
 		job.SetError(err)
 	}
 }
+
+// recordJob records the state of the load or release job, the other jobs are ignored.
+func (scheduler *Scheduler) recordJob(seq int64, job Job, start time.Time, done bool) {
+	var jobType string
+	switch job.(type) {
+	case *LoadCollectionJob, *UpdateLoadConfigJob:
+		jobType = metricsinfo.BackgroundJobLoad
+	case *ReleaseCollectionJob:
+		jobType = metricsinfo.BackgroundJobRelease
+	default:
+		return
+	}
+
+	stat := &datapb.BackgroundJob{
+		Type:         jobType,
+		JobID:        job.MsgID(),
+		CollectionID: job.CollectionID(),
+		State:        metricsinfo.BackgroundJobRunning,
+		Progress:     -1,
+		StartTime:    typeutil.TimestampToString(uint64(start.UnixMilli())),
+	}
+	if done {
+		if err := job.Error(); err != nil {
+			stat.State = metricsinfo.BackgroundJobFailed
+			stat.Error = err.Error()
+		} else {
+			stat.State = metricsinfo.BackgroundJobCompleted
+			stat.Progress = 100
+		}
+	}
+	scheduler.jobStats.Add(seq, stat)
+}
+
+// GetJobStats returns the load and release jobs processed recently.
+func (scheduler *Scheduler) GetJobStats() []*datapb.BackgroundJob {
+	return scheduler.jobStats.Values()
+}
@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+)
+
+var GlobalAutoReleasedCache = NewAutoReleasedCache()
+
+// AutoReleasedCollection is a collection released for being idle.
+type AutoReleasedCollection struct {
+	// Request is the request to reload the collection with the load config before release
+	Request *querypb.LoadCollectionRequest
+	// LoadDuration is the time spent on the last load, used to estimate the remaining time of the reload
+	LoadDuration time.Duration
+	ReleasedAt   time.Time
+	// ReloadAt is the time the reload is triggered, zero means the reload is not triggered yet
+	ReloadAt time.Time
+}
+
+// Remaining returns the estimated remaining time of the reload.
+func (c *AutoReleasedCollection) Remaining() time.Duration {
+	if c.ReloadAt.IsZero() {
+		return c.LoadDuration
+	}
+	return max(c.LoadDuration-time.Since(c.ReloadAt), 0)
+}
+
+// autoReleasedValue is the persisted form of AutoReleasedCollection in the collection property.
+type autoReleasedValue struct {
+	Request      json.RawMessage `json:"request"`
+	LoadDuration time.Duration   `json:"load_duration"`
+	ReleasedAt   time.Time       `json:"released_at"`
+}
+
+// MarshalAutoReleasedCollection encodes the record into the value of the collection property `collection.autoRelease.released`.
+func MarshalAutoReleasedCollection(record AutoReleasedCollection) (string, error) {
+	request, err := protojson.Marshal(record.Request)
+	if err != nil {
+		return "", err
+	}
+	bs, err := json.Marshal(&autoReleasedValue{
+		Request:      request,
+		LoadDuration: record.LoadDuration,
+		ReleasedAt:   record.ReleasedAt,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// UnmarshalAutoReleasedCollection decodes the record from the value of the collection property `collection.autoRelease.released`.
+func UnmarshalAutoReleasedCollection(value string) (AutoReleasedCollection, error) {
+	var decoded autoReleasedValue
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return AutoReleasedCollection{}, err
+	}
+	request := &querypb.LoadCollectionRequest{}
+	if err := protojson.Unmarshal(decoded.Request, request); err != nil {
+		return AutoReleasedCollection{}, err
+	}
+	return AutoReleasedCollection{
+		Request:      request,
+		LoadDuration: decoded.LoadDuration,
+		ReleasedAt:   decoded.ReleasedAt,
+	}, nil
+}
+
+// AutoReleasedCache records the collections released for being idle, so that they could be reloaded on the next search.
+// The records are persisted as the collection property `collection.autoRelease.released` as well,
+// the ones released before querycoord restarts are recovered from the property on the next search.
+type AutoReleasedCache struct {
+	mu sync.RWMutex
+	// CollectionID -> AutoReleasedCollection
+	records map[int64]*AutoReleasedCollection
+}
+
+func NewAutoReleasedCache() *AutoReleasedCache {
+	return &AutoReleasedCache{
+		records: make(map[int64]*AutoReleasedCollection),
+	}
+}
+
+func (c *AutoReleasedCache) Put(collectionID int64, req *querypb.LoadCollectionRequest, loadDuration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.records[collectionID] = &AutoReleasedCollection{
+		Request:      req,
+		LoadDuration: loadDuration,
+		ReleasedAt:   time.Now(),
+	}
+	log.Info("AutoReleasedCache put released collection",
+		zap.Int64("collectionID", collectionID),
+		zap.Duration("loadDuration", loadDuration),
+	)
+}
+
+// Recover puts the record recovered from the collection property if the collection is not recorded yet.
+func (c *AutoReleasedCache) Recover(collectionID int64, record AutoReleasedCollection) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.records[collectionID]; ok {
+		return
+	}
+	record.ReloadAt = time.Time{}
+	c.records[collectionID] = &record
+	log.Info("AutoReleasedCache recovers released collection",
+		zap.Int64("collectionID", collectionID),
+		zap.Time("releasedAt", record.ReleasedAt),
+	)
+}
+
+func (c *AutoReleasedCache) Get(collectionID int64) (AutoReleasedCollection, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	record, ok := c.records[collectionID]
+	if !ok {
+		return AutoReleasedCollection{}, false
+	}
+	return *record, true
+}
+
+// StartReload marks the reload of the collection triggered,
+// it returns true only if the reload is not triggered yet, so that the collection is reloaded once.
+func (c *AutoReleasedCache) StartReload(collectionID int64) (AutoReleasedCollection, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	record, ok := c.records[collectionID]
+	if !ok {
+		return AutoReleasedCollection{}, false
+	}
+	if !record.ReloadAt.IsZero() {
+		return *record, false
+	}
+	record.ReloadAt = time.Now()
+	return *record, true
+}
+
+// ResetReload resets the reload of the collection, so that the reload could be triggered again.
+func (c *AutoReleasedCache) ResetReload(collectionID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if record, ok := c.records[collectionID]; ok {
+		record.ReloadAt = time.Time{}
+	}
+}
+
+func (c *AutoReleasedCache) Remove(collectionID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.records[collectionID]; !ok {
+		return
+	}
+	delete(c.records, collectionID)
+	log.Info("AutoReleasedCache removes released collection", zap.Int64("collectionID", collectionID))
+}
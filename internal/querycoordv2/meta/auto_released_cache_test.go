@@ -0,0 +1,61 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+)
+
+func TestAutoReleasedCache(t *testing.T) {
+	c := NewAutoReleasedCache()
+	collectionID := int64(100)
+
+	_, ok := c.Get(collectionID)
+	assert.False(t, ok)
+	_, ok = c.StartReload(collectionID)
+	assert.False(t, ok)
+
+	c.Put(collectionID, &querypb.LoadCollectionRequest{CollectionID: collectionID}, time.Minute)
+	record, ok := c.Get(collectionID)
+	assert.True(t, ok)
+	assert.Equal(t, collectionID, record.Request.GetCollectionID())
+	assert.Equal(t, time.Minute, record.Remaining())
+
+	// the reload is triggered only once
+	record, ok = c.StartReload(collectionID)
+	assert.True(t, ok)
+	assert.False(t, record.ReloadAt.IsZero())
+	assert.LessOrEqual(t, record.Remaining(), time.Minute)
+	_, ok = c.StartReload(collectionID)
+	assert.False(t, ok)
+
+	c.ResetReload(collectionID)
+	_, ok = c.StartReload(collectionID)
+	assert.True(t, ok)
+
+	record.ReloadAt = time.Now().Add(-time.Hour)
+	assert.Zero(t, record.Remaining())
+
+	c.Remove(collectionID)
+	_, ok = c.Get(collectionID)
+	assert.False(t, ok)
+}
@@ -19,6 +19,7 @@ package meta
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"sync"
 	"time"
@@ -641,6 +642,25 @@ func (m *CollectionManager) removePartition(ctx context.Context, collectionID ty
 	return nil
 }
 
+// SyncQuarantinedSegments persists the quarantined segments of the partially loaded collection recorded in GlobalFailedLoadCache,
+// so the collection is still partially loaded with the segments excluded after restart.
+func (m *CollectionManager) SyncQuarantinedSegments(ctx context.Context, collectionID typeutil.UniqueID) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	collection, ok := m.collections[collectionID]
+	if !ok {
+		return merr.WrapErrCollectionNotFound(collectionID)
+	}
+	segmentIDs := GlobalFailedLoadCache.GetExcludedSegments(collectionID)
+	if slices.Equal(segmentIDs, collection.GetQuarantinedSegments()) {
+		return nil
+	}
+	newCollection := collection.Clone()
+	newCollection.QuarantinedSegments = segmentIDs
+	return m.putCollection(ctx, true, newCollection)
+}
+
 func (m *CollectionManager) UpdateReplicaNumber(ctx context.Context, collectionID typeutil.UniqueID, replicaNumber int32, userSpecifiedReplicaMode bool) error {
 	m.rwmutex.Lock()
 	defer m.rwmutex.Unlock()
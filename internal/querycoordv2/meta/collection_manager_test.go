@@ -479,6 +479,28 @@ func (suite *CollectionManagerSuite) TestUpdateLoadPercentage() {
 	suite.Equal(querypb.LoadStatus_Loaded, mgr.CalculateLoadStatus(ctx, collection.CollectionID))
 }
 
+func (suite *CollectionManagerSuite) TestSyncQuarantinedSegments() {
+	mgr := suite.mgr
+	ctx := suite.ctx
+	GlobalFailedLoadCache = NewFailedLoadCache()
+	collectionID := suite.collections[0]
+
+	suite.ErrorIs(mgr.SyncQuarantinedSegments(ctx, 999), merr.ErrCollectionNotFound)
+
+	GlobalFailedLoadCache.RecoverQuarantine(collectionID, []int64{1000})
+	suite.NoError(mgr.SyncQuarantinedSegments(ctx, collectionID))
+	suite.Equal([]int64{1000}, mgr.GetCollection(ctx, collectionID).GetQuarantinedSegments())
+
+	// the quarantined segments are persisted
+	suite.clearMemory()
+	suite.NoError(mgr.Recover(ctx, suite.broker))
+	suite.Equal([]int64{1000}, mgr.GetCollection(ctx, collectionID).GetQuarantinedSegments())
+
+	GlobalFailedLoadCache.RemoveSegment(collectionID, 1000)
+	suite.NoError(mgr.SyncQuarantinedSegments(ctx, collectionID))
+	suite.Empty(mgr.GetCollection(ctx, collectionID).GetQuarantinedSegments())
+}
+
 func (suite *CollectionManagerSuite) TestUpgradeLoadFields() {
 	suite.releaseAll()
 	mgr := suite.mgr
@@ -51,6 +51,7 @@ type Broker interface {
 	GetRecoveryInfoV2(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) ([]*datapb.VchannelInfo, []*datapb.SegmentInfo, error)
 	DescribeDatabase(ctx context.Context, dbName string) (*rootcoordpb.DescribeDatabaseResponse, error)
 	GetCollectionLoadInfo(ctx context.Context, collectionID UniqueID) ([]string, int64, error)
+	AlterCollectionProperties(ctx context.Context, dbName string, collectionName string, properties []*commonpb.KeyValuePair, deleteKeys []string) error
 }
 
 type CoordinatorBroker struct {
@@ -84,6 +85,28 @@ func (broker *CoordinatorBroker) DescribeCollection(ctx context.Context, collect
 	return resp, nil
 }
 
+// AlterCollectionProperties updates the properties and deletes the @deleteKeys properties of the collection.
+func (broker *CoordinatorBroker) AlterCollectionProperties(ctx context.Context, dbName string, collectionName string, properties []*commonpb.KeyValuePair, deleteKeys []string) error {
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+
+	req := &milvuspb.AlterCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_AlterCollection),
+		),
+		DbName:         dbName,
+		CollectionName: collectionName,
+		Properties:     properties,
+		DeleteKeys:     deleteKeys,
+	}
+	resp, err := broker.mixCoord.AlterCollection(ctx, req)
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Ctx(ctx).Warn("failed to alter collection properties", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 func (broker *CoordinatorBroker) DescribeDatabase(ctx context.Context, dbName string) (*rootcoordpb.DescribeDatabaseResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
@@ -532,6 +532,38 @@ func (s *CoordinatorBrokerRootCoordSuite) TestDescribeDatabase() {
 	})
 }
 
+func (s *CoordinatorBrokerRootCoordSuite) TestAlterCollectionProperties() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.Run("normal_case", func() {
+		s.mixcoord.EXPECT().AlterCollection(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+				s.Equal("fake_db1", req.GetDbName())
+				s.Equal("fake_collection", req.GetCollectionName())
+				s.Equal([]string{"fake_key"}, req.GetDeleteKeys())
+				return merr.Success(), nil
+			})
+		err := s.broker.AlterCollectionProperties(ctx, "fake_db1", "fake_collection", nil, []string{"fake_key"})
+		s.NoError(err)
+		s.resetMock()
+	})
+
+	s.Run("rootcoord_return_error", func() {
+		s.mixcoord.EXPECT().AlterCollection(mock.Anything, mock.Anything).Return(nil, errors.New("fake error"))
+		err := s.broker.AlterCollectionProperties(ctx, "fake_db1", "fake_collection", nil, []string{"fake_key"})
+		s.Error(err)
+		s.resetMock()
+	})
+
+	s.Run("rootcoord_return_failure_status", func() {
+		s.mixcoord.EXPECT().AlterCollection(mock.Anything, mock.Anything).Return(merr.Status(errors.New("fake error")), nil)
+		err := s.broker.AlterCollectionProperties(ctx, "fake_db1", "fake_collection", nil, []string{"fake_key"})
+		s.Error(err)
+		s.resetMock()
+	})
+}
+
 func (s *CoordinatorBrokerRootCoordSuite) TestGetCollectionLoadInfo() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -17,6 +17,7 @@
 package meta
 
 import (
+	"slices"
 	"sync"
 	"time"
 
@@ -24,6 +25,8 @@ import (
 
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 const expireTime = 24 * time.Hour
@@ -36,15 +39,32 @@ type failInfo struct {
 	lastTime time.Time
 }
 
+type segmentFailInfo struct {
+	failCount int
+	err       error
+	nextRetry time.Time
+}
+
+// FailedLoadCache records the load failures of the collections and the segments.
+// The load of a failed segment is retried with exponential backoff,
+// and the segment is quarantined once it fails more than the configured threshold.
+// A collection whose missing segments are all quarantined could be marked as partially loaded,
+// the quarantined segments of it are persisted in the collection load info and recovered by RecoverQuarantine.
 type FailedLoadCache struct {
 	mu sync.RWMutex
 	// CollectionID, ErrorCode -> error
 	records map[int64]map[int32]*failInfo
+	// CollectionID -> SegmentID -> segmentFailInfo
+	segmentRecords map[int64]map[int64]*segmentFailInfo
+	// collections which are marked as partially loaded
+	partialLoaded typeutil.UniqueSet
 }
 
 func NewFailedLoadCache() *FailedLoadCache {
 	return &FailedLoadCache{
-		records: make(map[int64]map[int32]*failInfo),
+		records:        make(map[int64]map[int32]*failInfo),
+		segmentRecords: make(map[int64]map[int64]*segmentFailInfo),
+		partialLoaded:  typeutil.NewUniqueSet(),
 	}
 }
 
@@ -122,3 +142,165 @@ func (l *FailedLoadCache) TryExpire() {
 		}
 	}
 }
+
+// PutSegment records a load failure of the segment, and schedules the next retry time.
+func (l *FailedLoadCache) PutSegment(collectionID, segmentID int64, err error) {
+	if err == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.segmentRecords[collectionID]; !ok {
+		l.segmentRecords[collectionID] = make(map[int64]*segmentFailInfo)
+	}
+	info, ok := l.segmentRecords[collectionID][segmentID]
+	if !ok {
+		info = &segmentFailInfo{}
+		l.segmentRecords[collectionID][segmentID] = info
+	}
+	info.failCount++
+	info.err = err
+	backoff := segmentBackoff(info.failCount)
+	info.nextRetry = time.Now().Add(backoff)
+	log.Warn("FailedLoadCache put failed segment",
+		zap.Int64("collectionID", collectionID),
+		zap.Int64("segmentID", segmentID),
+		zap.Int("failCount", info.failCount),
+		zap.Duration("backoff", backoff),
+		zap.Error(err),
+	)
+}
+
+func segmentBackoff(failCount int) time.Duration {
+	base := paramtable.Get().QueryCoordCfg.SegmentQuarantineRetryBaseSeconds.GetAsDuration(time.Second)
+	maxBackoff := paramtable.Get().QueryCoordCfg.SegmentQuarantineRetryMaxSeconds.GetAsDuration(time.Second)
+	backoff := base
+	for i := 1; i < failCount && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+// RemoveSegment removes the failure record of the segment, it shall be called once the segment is loaded.
+// Returns true if the quarantined segments of the partially loaded collection are changed.
+func (l *FailedLoadCache) RemoveSegment(collectionID, segmentID int64) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.segmentRecords[collectionID][segmentID]; !ok {
+		return false
+	}
+	changed := l.partialLoaded.Contain(collectionID) && l.isSegmentQuarantined(collectionID, segmentID)
+	delete(l.segmentRecords[collectionID], segmentID)
+	if len(l.segmentRecords[collectionID]) == 0 {
+		delete(l.segmentRecords, collectionID)
+		l.partialLoaded.Remove(collectionID)
+	}
+	log.Info("FailedLoadCache removes segment", zap.Int64("collectionID", collectionID), zap.Int64("segmentID", segmentID))
+	return changed
+}
+
+// RemoveCollection removes all the records of the collection, it shall be called when collection is loaded or released.
+func (l *FailedLoadCache) RemoveCollection(collectionID int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.records, collectionID)
+	delete(l.segmentRecords, collectionID)
+	l.partialLoaded.Remove(collectionID)
+	log.Info("FailedLoadCache removes collection", zap.Int64("collectionID", collectionID))
+}
+
+// SegmentInBackoff returns whether the segment shall not be retried to load yet.
+func (l *FailedLoadCache) SegmentInBackoff(collectionID, segmentID int64) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	info, ok := l.segmentRecords[collectionID][segmentID]
+	return ok && time.Now().Before(info.nextRetry)
+}
+
+// IsSegmentQuarantined returns whether the segment failed to load more than the threshold.
+func (l *FailedLoadCache) IsSegmentQuarantined(collectionID, segmentID int64) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.isSegmentQuarantined(collectionID, segmentID)
+}
+
+func (l *FailedLoadCache) isSegmentQuarantined(collectionID, segmentID int64) bool {
+	info, ok := l.segmentRecords[collectionID][segmentID]
+	return ok && info.failCount >= paramtable.Get().QueryCoordCfg.SegmentQuarantineFailThreshold.GetAsInt()
+}
+
+// GetQuarantinedSegments returns all the quarantined segments of the collection.
+func (l *FailedLoadCache) GetQuarantinedSegments(collectionID int64) typeutil.UniqueSet {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	ret := typeutil.NewUniqueSet()
+	for segmentID := range l.segmentRecords[collectionID] {
+		if l.isSegmentQuarantined(collectionID, segmentID) {
+			ret.Insert(segmentID)
+		}
+	}
+	return ret
+}
+
+// GetExcludedSegments returns the quarantined segments of the partially loaded collection, which shall be persisted.
+func (l *FailedLoadCache) GetExcludedSegments(collectionID int64) []int64 {
+	if !l.IsPartialLoaded(collectionID) {
+		return nil
+	}
+	segmentIDs := l.GetQuarantinedSegments(collectionID).Collect()
+	slices.Sort(segmentIDs)
+	return segmentIDs
+}
+
+// MarkPartialLoaded marks whether the collection is partially loaded.
+func (l *FailedLoadCache) MarkPartialLoaded(collectionID int64, partial bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if partial {
+		l.partialLoaded.Insert(collectionID)
+	} else {
+		l.partialLoaded.Remove(collectionID)
+	}
+}
+
+// IsPartialLoaded returns whether the collection is partially loaded.
+func (l *FailedLoadCache) IsPartialLoaded(collectionID int64) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.partialLoaded.Contain(collectionID)
+}
+
+// IsSegmentExcluded returns whether the segment is excluded from the readable target,
+// which happens only when the collection is partially loaded and the segment is quarantined.
+func (l *FailedLoadCache) IsSegmentExcluded(collectionID, segmentID int64) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.partialLoaded.Contain(collectionID) && l.isSegmentQuarantined(collectionID, segmentID)
+}
+
+// RecoverQuarantine restores the persisted quarantined segments of the partially loaded collection after restart,
+// the segments are retried to load at once, and stay excluded from the readable target until loaded.
+func (l *FailedLoadCache) RecoverQuarantine(collectionID int64, segmentIDs []int64) {
+	if len(segmentIDs) == 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	records := make(map[int64]*segmentFailInfo, len(segmentIDs))
+	failCount := paramtable.Get().QueryCoordCfg.SegmentQuarantineFailThreshold.GetAsInt()
+	for _, segmentID := range segmentIDs {
+		records[segmentID] = &segmentFailInfo{
+			failCount: failCount,
+			err:       merr.WrapErrSegmentLack(segmentID, "quarantined before restart"),
+			nextRetry: time.Now(),
+		}
+	}
+	l.segmentRecords[collectionID] = records
+	l.partialLoaded.Insert(collectionID)
+	log.Info("FailedLoadCache recovers quarantined segments", zap.Int64("collectionID", collectionID), zap.Int64s("segmentIDs", segmentIDs))
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
 func TestFailedLoadCache(t *testing.T) {
@@ -52,3 +53,86 @@ func TestFailedLoadCache(t *testing.T) {
 	err = GlobalFailedLoadCache.Get(colID)
 	assert.Equal(t, commonpb.ErrorCode_Success, merr.Status(err).ErrorCode)
 }
+
+func TestFailedLoadCacheSegment(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.QueryCoordCfg.SegmentQuarantineFailThreshold.Key, "2")
+	defer params.Reset(params.QueryCoordCfg.SegmentQuarantineFailThreshold.Key)
+
+	cache := NewFailedLoadCache()
+	collectionID, segmentID := int64(100), int64(1000)
+	mockErr := merr.WrapErrServiceMemoryLimitExceeded(0, 0)
+
+	cache.PutSegment(collectionID, segmentID, nil)
+	assert.False(t, cache.SegmentInBackoff(collectionID, segmentID))
+
+	cache.PutSegment(collectionID, segmentID, mockErr)
+	assert.True(t, cache.SegmentInBackoff(collectionID, segmentID))
+	assert.False(t, cache.IsSegmentQuarantined(collectionID, segmentID))
+
+	cache.PutSegment(collectionID, segmentID, mockErr)
+	assert.True(t, cache.IsSegmentQuarantined(collectionID, segmentID))
+	assert.True(t, cache.GetQuarantinedSegments(collectionID).Contain(segmentID))
+	assert.False(t, cache.IsSegmentExcluded(collectionID, segmentID))
+	assert.Empty(t, cache.GetExcludedSegments(collectionID))
+
+	cache.MarkPartialLoaded(collectionID, true)
+	assert.True(t, cache.IsPartialLoaded(collectionID))
+	assert.True(t, cache.IsSegmentExcluded(collectionID, segmentID))
+	assert.Equal(t, []int64{segmentID}, cache.GetExcludedSegments(collectionID))
+
+	// backoff expired, segment shall be retried
+	cache.mu.Lock()
+	cache.segmentRecords[collectionID][segmentID].nextRetry = time.Now().Add(-time.Second)
+	cache.mu.Unlock()
+	assert.False(t, cache.SegmentInBackoff(collectionID, segmentID))
+
+	// segment loaded, partial load mark shall be cleared
+	assert.True(t, cache.RemoveSegment(collectionID, segmentID))
+	assert.False(t, cache.IsSegmentQuarantined(collectionID, segmentID))
+	assert.False(t, cache.IsPartialLoaded(collectionID))
+	assert.False(t, cache.RemoveSegment(collectionID, segmentID))
+
+	cache.PutSegment(collectionID, segmentID, mockErr)
+	cache.Put(collectionID, mockErr)
+	cache.MarkPartialLoaded(collectionID, true)
+	cache.RemoveCollection(collectionID)
+	assert.False(t, cache.SegmentInBackoff(collectionID, segmentID))
+	assert.False(t, cache.IsPartialLoaded(collectionID))
+	assert.NoError(t, cache.Get(collectionID))
+}
+
+func TestFailedLoadCacheRecoverQuarantine(t *testing.T) {
+	paramtable.Init()
+	cache := NewFailedLoadCache()
+	collectionID := int64(100)
+
+	cache.RecoverQuarantine(collectionID, nil)
+	assert.False(t, cache.IsPartialLoaded(collectionID))
+
+	// the recovered segments are excluded and retried at once
+	cache.RecoverQuarantine(collectionID, []int64{1000, 1001})
+	assert.True(t, cache.IsPartialLoaded(collectionID))
+	assert.True(t, cache.IsSegmentExcluded(collectionID, 1000))
+	assert.False(t, cache.SegmentInBackoff(collectionID, 1000))
+	assert.Equal(t, []int64{1000, 1001}, cache.GetExcludedSegments(collectionID))
+
+	assert.True(t, cache.RemoveSegment(collectionID, 1000))
+	assert.Equal(t, []int64{1001}, cache.GetExcludedSegments(collectionID))
+}
+
+func TestSegmentBackoff(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.QueryCoordCfg.SegmentQuarantineRetryBaseSeconds.Key, "10")
+	params.Save(params.QueryCoordCfg.SegmentQuarantineRetryMaxSeconds.Key, "60")
+	defer params.Reset(params.QueryCoordCfg.SegmentQuarantineRetryBaseSeconds.Key)
+	defer params.Reset(params.QueryCoordCfg.SegmentQuarantineRetryMaxSeconds.Key)
+
+	assert.Equal(t, 10*time.Second, segmentBackoff(1))
+	assert.Equal(t, 20*time.Second, segmentBackoff(2))
+	assert.Equal(t, 40*time.Second, segmentBackoff(3))
+	assert.Equal(t, 60*time.Second, segmentBackoff(4))
+	assert.Equal(t, 60*time.Second, segmentBackoff(10))
+}
@@ -5,7 +5,10 @@ package meta
 import (
 	context "context"
 
+	commonpb "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+
 	datapb "github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+
 	indexpb "github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -30,6 +33,56 @@ func (_m *MockBroker) EXPECT() *MockBroker_Expecter {
 	return &MockBroker_Expecter{mock: &_m.Mock}
 }
 
+// AlterCollectionProperties provides a mock function with given fields: ctx, dbName, collectionName, properties, deleteKeys
+func (_m *MockBroker) AlterCollectionProperties(ctx context.Context, dbName string, collectionName string, properties []*commonpb.KeyValuePair, deleteKeys []string) error {
+	ret := _m.Called(ctx, dbName, collectionName, properties, deleteKeys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlterCollectionProperties")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []*commonpb.KeyValuePair, []string) error); ok {
+		r0 = rf(ctx, dbName, collectionName, properties, deleteKeys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBroker_AlterCollectionProperties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterCollectionProperties'
+type MockBroker_AlterCollectionProperties_Call struct {
+	*mock.Call
+}
+
+// AlterCollectionProperties is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbName string
+//   - collectionName string
+//   - properties []*commonpb.KeyValuePair
+//   - deleteKeys []string
+func (_e *MockBroker_Expecter) AlterCollectionProperties(ctx interface{}, dbName interface{}, collectionName interface{}, properties interface{}, deleteKeys interface{}) *MockBroker_AlterCollectionProperties_Call {
+	return &MockBroker_AlterCollectionProperties_Call{Call: _e.mock.On("AlterCollectionProperties", ctx, dbName, collectionName, properties, deleteKeys)}
+}
+
+func (_c *MockBroker_AlterCollectionProperties_Call) Run(run func(ctx context.Context, dbName string, collectionName string, properties []*commonpb.KeyValuePair, deleteKeys []string)) *MockBroker_AlterCollectionProperties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]*commonpb.KeyValuePair), args[4].([]string))
+	})
+	return _c
+}
+
+func (_c *MockBroker_AlterCollectionProperties_Call) Return(_a0 error) *MockBroker_AlterCollectionProperties_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBroker_AlterCollectionProperties_Call) RunAndReturn(run func(context.Context, string, string, []*commonpb.KeyValuePair, []string) error) *MockBroker_AlterCollectionProperties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DescribeCollection provides a mock function with given fields: ctx, collectionID
 func (_m *MockBroker) DescribeCollection(ctx context.Context, collectionID int64) (*milvuspb.DescribeCollectionResponse, error) {
 	ret := _m.Called(ctx, collectionID)
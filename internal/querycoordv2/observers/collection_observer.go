@@ -363,6 +363,7 @@ func (ob *CollectionObserver) observePartitionLoadStatus(ctx context.Context, pa
 	)
 	loadedCount := subChannelCount
 	loadPercentage := int32(0)
+	missingSegments := make([]int64, 0)
 
 	for _, segment := range segmentTargets {
 		delegatorList := ob.dist.ChannelDistManager.GetByFilter(meta.WithChannelName2Channel(segment.GetInsertChannel()))
@@ -374,8 +375,27 @@ func (ob *CollectionObserver) observePartitionLoadStatus(ctx context.Context, pa
 		}
 		group := utils.GroupNodesByReplica(ctx, ob.meta.ReplicaManager, partition.GetCollectionID(), loadedSegmentNodes)
 		loadedCount += len(group)
+		if len(group) < int(replicaNum) {
+			missingSegments = append(missingSegments, segment.GetID())
+		}
 	}
 	loadPercentage = int32(loadedCount * 100 / (targetNum * int(replicaNum)))
+	if loadPercentage < 100 && ob.canPartialLoad(partition.GetCollectionID(), loadedCount, targetNum*int(replicaNum), missingSegments) {
+		log.Ctx(ctx).Warn("partition is marked as partially loaded due to quarantined segments",
+			zap.Int64("collectionID", partition.GetCollectionID()),
+			zap.Int64("partitionID", partition.GetPartitionID()),
+			zap.Int32("actualLoadPercentage", loadPercentage),
+			zap.Int64s("quarantinedSegments", missingSegments),
+		)
+		meta.GlobalFailedLoadCache.MarkPartialLoaded(partition.GetCollectionID(), true)
+		if err := ob.meta.CollectionManager.SyncQuarantinedSegments(ctx, partition.GetCollectionID()); err != nil {
+			log.Ctx(ctx).Warn("failed to save quarantined segments",
+				zap.Int64("collectionID", partition.GetCollectionID()),
+				zap.Error(err))
+			return false
+		}
+		loadPercentage = 100
+	}
 
 	if loadedCount <= ob.partitionLoadedCount[partition.GetPartitionID()] && loadPercentage != 100 {
 		ob.partitionLoadedCount[partition.GetPartitionID()] = loadedCount
@@ -409,6 +429,18 @@ func (ob *CollectionObserver) observePartitionLoadStatus(ctx context.Context, pa
 	return true
 }
 
+// canPartialLoad checks whether the partition could be treated as loaded while some segments are missing,
+// which requires all the missing segments are quarantined and the loaded ratio reaches `queryCoord.partialLoadRatio`.
+func (ob *CollectionObserver) canPartialLoad(collectionID int64, loadedCount, totalCount int, missingSegments []int64) bool {
+	ratio := paramtable.Get().QueryCoordCfg.PartialLoadRatio.GetAsFloat()
+	if ratio >= 1 || len(missingSegments) == 0 || float64(loadedCount) < ratio*float64(totalCount) {
+		return false
+	}
+	return lo.EveryBy(missingSegments, func(segmentID int64) bool {
+		return meta.GlobalFailedLoadCache.IsSegmentQuarantined(collectionID, segmentID)
+	})
+}
+
 func (ob *CollectionObserver) observeCollectionLoadStatus(ctx context.Context, collectionID int64) {
 	collectionPercentage, err := ob.meta.CollectionManager.UpdateCollectionLoadPercent(ctx, collectionID)
 	if err != nil {
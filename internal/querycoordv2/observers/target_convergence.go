@@ -0,0 +1,98 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+)
+
+// TargetConvergence describes how far the distribution is from the next target of a collection.
+type TargetConvergence struct {
+	SegmentsRemaining int
+	ChannelsRemaining int
+	// ETA is the estimated time to converge, negative means unknown.
+	ETA time.Duration
+
+	// the start point to compute the converging speed, it's reset once the remaining grows.
+	startRemaining int
+	startAt        time.Time
+}
+
+// GetConvergence returns the latest convergence progress of the collection.
+func (ob *TargetObserver) GetConvergence(collectionID int64) (TargetConvergence, bool) {
+	convergence, ok := ob.convergence.Get(collectionID)
+	if !ok {
+		return TargetConvergence{}, false
+	}
+	return *convergence, true
+}
+
+// updateConvergence counts the segments and channels in next target which are not served by all replicas yet,
+// and estimates the remaining time with the average converging speed since the remaining starts to drop.
+func (ob *TargetObserver) updateConvergence(ctx context.Context, collectionID int64) {
+	replicaNum := int(ob.meta.CollectionManager.GetReplicaNumber(ctx, collectionID))
+
+	segmentCopies := make(map[int64]int)
+	for _, segment := range ob.distMgr.SegmentDistManager.GetByFilter(meta.WithCollectionID(collectionID)) {
+		segmentCopies[segment.GetID()]++
+	}
+	channelCopies := make(map[string]int)
+	for _, channel := range ob.distMgr.ChannelDistManager.GetByFilter(meta.WithCollectionID2Channel(collectionID)) {
+		channelCopies[channel.GetChannelName()]++
+	}
+
+	current := &TargetConvergence{}
+	for segmentID := range ob.targetMgr.GetSealedSegmentsByCollection(ctx, collectionID, meta.NextTarget) {
+		if segmentCopies[segmentID] < replicaNum {
+			current.SegmentsRemaining++
+		}
+	}
+	for channel := range ob.targetMgr.GetDmChannelsByCollection(ctx, collectionID, meta.NextTarget) {
+		if channelCopies[channel] < replicaNum {
+			current.ChannelsRemaining++
+		}
+	}
+
+	now := time.Now()
+	current.startRemaining, current.startAt = current.SegmentsRemaining, now
+	if prev, ok := ob.convergence.Get(collectionID); ok && current.SegmentsRemaining <= prev.startRemaining {
+		current.startRemaining, current.startAt = prev.startRemaining, prev.startAt
+	}
+
+	converged := current.startRemaining - current.SegmentsRemaining
+	switch {
+	case current.SegmentsRemaining == 0 && current.ChannelsRemaining == 0:
+		current.ETA = 0
+	case converged > 0:
+		current.ETA = time.Duration(float64(now.Sub(current.startAt)) * float64(current.SegmentsRemaining) / float64(converged))
+	default:
+		current.ETA = -1
+	}
+	ob.convergence.Insert(collectionID, current)
+
+	eta := current.ETA.Seconds()
+	if current.ETA < 0 {
+		eta = -1
+	}
+	metrics.QueryCoordTargetSegmentsRemaining.WithLabelValues(fmt.Sprint(collectionID)).Set(float64(current.SegmentsRemaining))
+	metrics.QueryCoordTargetConvergenceETASeconds.WithLabelValues(fmt.Sprint(collectionID)).Set(eta)
+}
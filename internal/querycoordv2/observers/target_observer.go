@@ -95,6 +95,9 @@ type TargetObserver struct {
 
 	keylocks *lock.KeyLock[int64]
 
+	// convergence records the progress of distribution converging to next target, collectionID -> convergence
+	convergence *typeutil.ConcurrentMap[int64, *TargetConvergence]
+
 	startOnce sync.Once
 	stopOnce  sync.Once
 }
@@ -119,6 +122,7 @@ func NewTargetObserver(
 		readyNotifiers:       make(map[int64][]chan struct{}),
 		initChan:             make(chan initRequest),
 		keylocks:             lock.NewKeyLock[int64](),
+		convergence:          typeutil.NewConcurrentMap[int64, *TargetConvergence](),
 	}
 
 	result.loadingDispatcher = newTaskDispatcher(result.check)
@@ -234,6 +238,7 @@ func (ob *TargetObserver) schedule(ctx context.Context) {
 
 				ob.keylocks.Lock(req.CollectionID)
 				ob.targetMgr.RemoveCollection(ctx, req.CollectionID)
+				ob.convergence.Remove(req.CollectionID)
 				ob.keylocks.Unlock(req.CollectionID)
 				req.Notifier <- nil
 			case ReleasePartition:
@@ -286,6 +291,8 @@ func (ob *TargetObserver) check(ctx context.Context, collectionID int64) {
 			ob.syncNextTargetToDelegator(ctx, collectionID, ob.distMgr.ChannelDistManager.GetByFilter(meta.WithCollectionID2Channel(collectionID)), newVersion)
 		}
 	}
+
+	ob.updateConvergence(ctx, collectionID)
 }
 
 func (ob *TargetObserver) init(ctx context.Context, collectionID int64) {
@@ -351,6 +358,12 @@ func (ob *TargetObserver) clean() {
 		}
 		return true
 	})
+	ob.convergence.Range(func(collectionID int64, _ *TargetConvergence) bool {
+		if !collectionSet.Contain(collectionID) {
+			ob.convergence.Remove(collectionID)
+		}
+		return true
+	})
 
 	ob.mut.Lock()
 	defer ob.mut.Unlock()
@@ -533,6 +546,10 @@ func (ob *TargetObserver) genSyncAction(ctx context.Context, leaderView *meta.Le
 		)
 
 	sealedSegments := ob.targetMgr.GetSealedSegmentsByChannel(ctx, leaderView.CollectionID, leaderView.Channel, meta.NextTarget)
+	sealedSegments = lo.OmitBy(sealedSegments, func(segmentID int64, _ *datapb.SegmentInfo) bool {
+		_, loaded := leaderView.Segments[segmentID]
+		return !loaded && meta.GlobalFailedLoadCache.IsSegmentExcluded(leaderView.CollectionID, segmentID)
+	})
 	growingSegments := ob.targetMgr.GetGrowingSegmentsByChannel(ctx, leaderView.CollectionID, leaderView.Channel, meta.NextTarget)
 	droppedSegments := ob.targetMgr.GetDroppedSegmentsByChannel(ctx, leaderView.CollectionID, leaderView.Channel, meta.NextTarget)
 	channel := ob.targetMgr.GetDmChannel(ctx, leaderView.CollectionID, leaderView.Channel, meta.NextTargetFirst)
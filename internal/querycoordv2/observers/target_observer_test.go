@@ -268,6 +268,39 @@ func (suite *TargetObserverSuite) TestTriggerUpdateTarget() {
 	suite.Equal(action.GetDeleteCP().Timestamp, uint64(200))
 }
 
+func (suite *TargetObserverSuite) TestConvergence() {
+	ctx := suite.ctx
+
+	suite.Eventually(func() bool {
+		return len(suite.targetMgr.GetSealedSegmentsByCollection(ctx, suite.collectionID, meta.NextTarget)) == 2 &&
+			len(suite.targetMgr.GetDmChannelsByCollection(ctx, suite.collectionID, meta.NextTarget)) == 2
+	}, 5*time.Second, 1*time.Second)
+
+	suite.observer.updateConvergence(ctx, suite.collectionID)
+	convergence, ok := suite.observer.GetConvergence(suite.collectionID)
+	suite.True(ok)
+	suite.Equal(2, convergence.SegmentsRemaining)
+	suite.Equal(2, convergence.ChannelsRemaining)
+
+	suite.distMgr.SegmentDistManager.Update(2, &meta.Segment{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:            11,
+			CollectionID:  suite.collectionID,
+			PartitionID:   suite.partitionID,
+			InsertChannel: "channel-1",
+		},
+		Node: 2,
+	})
+	suite.observer.updateConvergence(ctx, suite.collectionID)
+	convergence, ok = suite.observer.GetConvergence(suite.collectionID)
+	suite.True(ok)
+	suite.Equal(1, convergence.SegmentsRemaining)
+	suite.GreaterOrEqual(convergence.ETA, time.Duration(0))
+
+	_, ok = suite.observer.GetConvergence(suite.collectionID + 1)
+	suite.False(ok)
+}
+
 func (suite *TargetObserverSuite) TestTriggerRelease() {
 	ctx := suite.ctx
 	// Manually update next target
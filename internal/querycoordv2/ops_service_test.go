@@ -520,6 +520,19 @@ func (suite *OpsServiceSuite) TestTransferSegment() {
 		Hostname: "localhost",
 	}))
 
+	// test target node not in replica
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   5,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	resp, err = suite.server.TransferSegment(ctx, &querypb.TransferSegmentRequest{
+		SourceNodeID: nodes[0],
+		TargetNodeID: 5,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrNodeNotAvailable)
+
 	// test segment not exist in node
 	resp, err = suite.server.TransferSegment(ctx, &querypb.TransferSegmentRequest{
 		SourceNodeID: nodes[0],
@@ -769,6 +782,19 @@ func (suite *OpsServiceSuite) TestTransferChannel() {
 		Hostname: "localhost",
 	}))
 
+	// test target node not in replica
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   5,
+		Address:  "localhost",
+		Hostname: "localhost",
+	}))
+	resp, err = suite.server.TransferChannel(ctx, &querypb.TransferChannelRequest{
+		SourceNodeID: nodes[0],
+		TargetNodeID: 5,
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrNodeNotAvailable)
+
 	segments := []*datapb.SegmentInfo{
 		{
 			ID:            segmentIDs[0],
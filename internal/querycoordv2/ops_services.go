@@ -18,6 +18,7 @@ package querycoordv2
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -396,11 +397,17 @@ func (s *Server) TransferSegment(ctx context.Context, req *querypb.TransferSegme
 		// when no dst node specified, default to use all other nodes in same
 		dstNodeSet := typeutil.NewUniqueSet()
 		if req.GetToAllNodes() {
-			dstNodeSet.Insert(replica.GetRWNodes()...)
+			dstNodeSet.Insert(s.nodeMgr.FilterOverloadedNodes(replica.GetRWNodes())...)
 		} else {
 			// check whether dstNode is healthy
 			if err := s.isStoppingNode(ctx, req.GetTargetNodeID()); err != nil {
-				err := merr.WrapErrNodeNotAvailable(srcNode, "the target node is invalid")
+				err := merr.WrapErrNodeNotAvailable(req.GetTargetNodeID(), "the target node is invalid")
+				return merr.Status(err), nil
+			}
+			// the target node shall serve the same replica, otherwise the segment would be released by checker
+			if !replica.ContainRWNode(req.GetTargetNodeID()) {
+				err := merr.WrapErrNodeNotAvailable(req.GetTargetNodeID(),
+					fmt.Sprintf("the target node is not a rw node of replica %d", replica.GetID()))
 				return merr.Status(err), nil
 			}
 			if streamingutil.IsStreamingServiceEnabled() {
@@ -476,14 +483,24 @@ func (s *Server) TransferChannel(ctx context.Context, req *querypb.TransferChann
 		dstNodeSet := typeutil.NewUniqueSet()
 		if req.GetToAllNodes() {
 			if streamingutil.IsStreamingServiceEnabled() {
-				dstNodeSet.Insert(replica.GetRWSQNodes()...)
+				dstNodeSet.Insert(s.nodeMgr.FilterOverloadedNodes(replica.GetRWSQNodes())...)
 			} else {
-				dstNodeSet.Insert(replica.GetRWNodes()...)
+				dstNodeSet.Insert(s.nodeMgr.FilterOverloadedNodes(replica.GetRWNodes())...)
 			}
 		} else {
 			// check whether dstNode is healthy
 			if err := s.isStoppingNode(ctx, req.GetTargetNodeID()); err != nil {
-				err := merr.WrapErrNodeNotAvailable(srcNode, "the target node is invalid")
+				err := merr.WrapErrNodeNotAvailable(req.GetTargetNodeID(), "the target node is invalid")
+				return merr.Status(err), nil
+			}
+			// the target node shall serve the same replica, otherwise the channel would be released by checker
+			containTarget := replica.ContainRWNode(req.GetTargetNodeID())
+			if streamingutil.IsStreamingServiceEnabled() {
+				containTarget = replica.ContainRWSQNode(req.GetTargetNodeID())
+			}
+			if !containTarget {
+				err := merr.WrapErrNodeNotAvailable(req.GetTargetNodeID(),
+					fmt.Sprintf("the target node is not a rw node of replica %d", replica.GetID()))
 				return merr.Status(err), nil
 			}
 			dstNodeSet.Insert(req.GetTargetNodeID())
@@ -199,6 +199,10 @@ func (s *Server) registerMetricsRequest() {
 		return s.targetMgr.GetTargetJSON(ctx, scope, collectionID), nil
 	}
 
+	QueryTargetDiffAction := func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+		return s.getTargetDiffJSON(ctx, jsonReq)
+	}
+
 	QueryReplicasAction := func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
 		return s.meta.GetReplicasJSON(ctx, s.meta), nil
 	}
@@ -211,6 +215,18 @@ func (s *Server) registerMetricsRequest() {
 		return s.getSegmentsJSON(ctx, req, jsonReq)
 	}
 
+	QueryConfigDriftAction := func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+		return s.getConfigDriftJSON(ctx)
+	}
+
+	QueryTimeTravelWatermarkAction := func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+		return s.getTimeTravelWatermarkJSON()
+	}
+
+	QueryNodeHeartbeatsAction := func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+		return s.getNodeHeartbeatsJSON()
+	}
+
 	QueryChannelsAction := func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
 		return s.getChannelsFromQueryNode(ctx, req)
 	}
@@ -220,12 +236,16 @@ func (s *Server) registerMetricsRequest() {
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.AllTaskKey, QueryTasksAction)
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.DistKey, QueryDistAction)
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.TargetKey, QueryTargetAction)
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.TargetDiffKey, QueryTargetDiffAction)
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.ReplicaKey, QueryReplicasAction)
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.ResourceGroupKey, QueryResourceGroupsAction)
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.NodeHeartbeatKey, QueryNodeHeartbeatsAction)
 
 	// register actions that requests are processed in querynode
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.SegmentKey, QuerySegmentsAction)
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.ChannelKey, QueryChannelsAction)
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.ConfigDriftKey, QueryConfigDriftAction)
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.TimeTravelWatermarkKey, QueryTimeTravelWatermarkAction)
 	log.Ctx(s.ctx).Info("register metrics actions finished")
 }
 
@@ -268,7 +288,8 @@ func (s *Server) initQueryCoord() error {
 		idAllocatorKV = tsoutil.NewTSOTiKVBase(s.tikvCli, Params.TiKVCfg.KvRootPath.GetValue(), "querycoord-id-allocator")
 	} else if metaType == util.MetaStoreTypeEtcd {
 		s.kv = etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue(),
-			etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+			etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)),
+			etcdkv.WithSharedBulkClient(&paramtable.Get().ServiceParam.EtcdCfg))
 		idAllocatorKV = tsoutil.NewTSOKVBase(s.etcdCli, Params.EtcdCfg.KvRootPath.GetValue(), "querycoord-id-allocator")
 	} else {
 		return fmt.Errorf("not supported meta store: %s", metaType)
@@ -380,6 +401,10 @@ func (s *Server) initQueryCoord() error {
 
 	// Init load status cache
 	meta.GlobalFailedLoadCache = meta.NewFailedLoadCache()
+	for _, collection := range s.meta.CollectionManager.GetAllCollections(s.ctx) {
+		meta.GlobalFailedLoadCache.RecoverQuarantine(collection.GetCollectionID(), collection.GetQuarantinedSegments())
+	}
+	meta.GlobalAutoReleasedCache = meta.NewAutoReleasedCache()
 
 	RegisterDDLCallbacks(s)
 	log.Info("init querycoord done", zap.Int64("nodeID", paramtable.GetNodeID()), zap.String("Address", s.address))
@@ -501,6 +526,7 @@ func (s *Server) startQueryCoord() error {
 	}
 
 	s.startServerLoop()
+	s.autoReleaseLoop(s.ctx)
 	s.afterStart()
 	s.UpdateStateCode(commonpb.StateCode_Healthy)
 	sessionutil.SaveServerInfo(typeutil.MixCoordRole, s.session.GetServerID())
@@ -775,6 +801,7 @@ func (s *Server) handleNodeDown(node int64) {
 
 	// clean node's metrics
 	metrics.QueryCoordLastHeartbeatTimeStamp.DeleteLabelValues(fmt.Sprint(node))
+	metrics.QueryCoordNodeLoadScore.DeleteLabelValues(fmt.Sprint(node))
 	s.metricsCacheManager.InvalidateSystemInfoMetrics()
 }
 
@@ -25,6 +25,7 @@ import (
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -34,8 +35,10 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/internal/util/componentutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
@@ -237,6 +240,9 @@ func (s *Server) LoadCollection(ctx context.Context, req *querypb.LoadCollection
 		metrics.QueryCoordLoadCount.WithLabelValues(metrics.FailLabel).Inc()
 		return merr.Status(err), nil
 	}
+	if err := s.dropAutoReleasedMarker(ctx, req.GetCollectionID()); err != nil {
+		logger.Warn("failed to drop the auto release marker of loaded collection", zap.Error(err))
+	}
 
 	logger.Info("load collection done")
 	metrics.QueryCoordLoadCount.WithLabelValues(metrics.SuccessLabel).Inc()
@@ -255,6 +261,13 @@ func (s *Server) ReleaseCollection(ctx context.Context, req *querypb.ReleaseColl
 		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
 		return merr.Status(err), nil
 	}
+	// the collection released by user shall not be reloaded automatically
+	meta.GlobalAutoReleasedCache.Remove(req.GetCollectionID())
+	if err := s.dropAutoReleasedMarker(ctx, req.GetCollectionID()); err != nil && !errors.Is(err, merr.ErrCollectionNotFound) {
+		logger.Warn("failed to drop the auto release marker", zap.Error(err))
+		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
 
 	if err := s.broadcastDropLoadConfigCollectionV2ForReleaseCollection(ctx, req); err != nil {
 		if errors.Is(err, errReleaseCollectionNotLoaded) {
@@ -706,18 +719,9 @@ func (s *Server) ShowConfigurations(ctx context.Context, req *internalpb.ShowCon
 			Status: merr.Status(errors.Wrap(err, msg)),
 		}, nil
 	}
-	configList := make([]*commonpb.KeyValuePair, 0)
-	for key, value := range Params.GetComponentConfigurations("querycoord", req.Pattern) {
-		configList = append(configList,
-			&commonpb.KeyValuePair{
-				Key:   key,
-				Value: value,
-			})
-	}
-
 	return &internalpb.ShowConfigurationsResponse{
 		Status:        merr.Success(),
-		Configuations: configList,
+		Configuations: componentutil.ShowConfigurations("querycoord", req),
 	}, nil
 }
 
@@ -797,12 +801,56 @@ func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeade
 	}
 
 	leaders, err := utils.GetShardLeaders(ctx, s.meta, s.targetMgr, s.dist, s.nodeMgr, req.GetCollectionID(), req.GetWithUnserviceableShards())
+	if errors.Is(err, merr.ErrCollectionNotLoaded) || errors.Is(err, merr.ErrCollectionNotFullyLoaded) {
+		err = s.reloadAutoReleasedCollection(ctx, req.GetCollectionID(), err)
+	} else if err == nil {
+		meta.GlobalAutoReleasedCache.Remove(req.GetCollectionID())
+	}
+	status := merr.Status(err)
+	if err == nil && meta.GlobalFailedLoadCache.IsPartialLoaded(req.GetCollectionID()) {
+		status.ExtraInfo = map[string]string{common.PartialLoadedKey: "true"}
+	}
 	return &querypb.GetShardLeadersResponse{
-		Status: merr.Status(err),
+		Status: status,
 		Shards: leaders,
 	}, nil
 }
 
+// ListBackgroundJobs lists the load, release and balance jobs of the querycoord,
+// the load job keeps running until the collection is fully loaded.
+// It's not a rpc of the querycoord, the mixcoord merges the jobs with the ones of the datacoord.
+func (s *Server) ListBackgroundJobs(ctx context.Context, req *datapb.ListBackgroundJobsRequest) (*datapb.ListBackgroundJobsResponse, error) {
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		return &datapb.ListBackgroundJobsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	jobs := s.jobScheduler.GetJobStats()
+	for i, job := range jobs {
+		if job.GetType() != metricsinfo.BackgroundJobLoad || job.GetState() != metricsinfo.BackgroundJobCompleted {
+			continue
+		}
+		collection := s.meta.CollectionManager.GetCollection(ctx, job.GetCollectionID())
+		if collection != nil && collection.GetStatus() == querypb.LoadStatus_Loading {
+			loading := proto.Clone(job).(*datapb.BackgroundJob)
+			loading.State = metricsinfo.BackgroundJobRunning
+			loading.Progress = int64(collection.LoadPercentage)
+			jobs[i] = loading
+		}
+	}
+	jobs = append(jobs, s.taskScheduler.GetBalanceTasks()...)
+
+	jobs = lo.Filter(jobs, func(job *datapb.BackgroundJob, _ int) bool {
+		return (req.GetCollectionID() <= 0 || job.GetCollectionID() == req.GetCollectionID()) &&
+			(req.GetState() == "" || job.GetState() == req.GetState())
+	})
+	return &datapb.ListBackgroundJobsResponse{
+		Status: merr.Success(),
+		Jobs:   jobs,
+	}, nil
+}
+
 func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	if err := merr.CheckHealthy(s.State()); err != nil {
 		return &milvuspb.CheckHealthResponse{Status: merr.Status(err), IsHealthy: false, Reasons: []string{err.Error()}}, nil
@@ -7,6 +7,8 @@ import (
 
 	commonpb "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 
+	internalpb "github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 
 	mock "github.com/stretchr/testify/mock"
@@ -566,6 +568,66 @@ func (_c *MockCluster_RunAnalyzer_Call) RunAndReturn(run func(context.Context, i
 	return _c
 }
 
+// ShowConfigurations provides a mock function with given fields: ctx, nodeID, req
+func (_m *MockCluster) ShowConfigurations(ctx context.Context, nodeID int64, req *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error) {
+	ret := _m.Called(ctx, nodeID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShowConfigurations")
+	}
+
+	var r0 *internalpb.ShowConfigurationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)); ok {
+		return rf(ctx, nodeID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *internalpb.ShowConfigurationsRequest) *internalpb.ShowConfigurationsResponse); ok {
+		r0 = rf(ctx, nodeID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ShowConfigurationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *internalpb.ShowConfigurationsRequest) error); ok {
+		r1 = rf(ctx, nodeID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCluster_ShowConfigurations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowConfigurations'
+type MockCluster_ShowConfigurations_Call struct {
+	*mock.Call
+}
+
+// ShowConfigurations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int64
+//   - req *internalpb.ShowConfigurationsRequest
+func (_e *MockCluster_Expecter) ShowConfigurations(ctx interface{}, nodeID interface{}, req interface{}) *MockCluster_ShowConfigurations_Call {
+	return &MockCluster_ShowConfigurations_Call{Call: _e.mock.On("ShowConfigurations", ctx, nodeID, req)}
+}
+
+func (_c *MockCluster_ShowConfigurations_Call) Run(run func(ctx context.Context, nodeID int64, req *internalpb.ShowConfigurationsRequest)) *MockCluster_ShowConfigurations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*internalpb.ShowConfigurationsRequest))
+	})
+	return _c
+}
+
+func (_c *MockCluster_ShowConfigurations_Call) Return(_a0 *internalpb.ShowConfigurationsResponse, _a1 error) *MockCluster_ShowConfigurations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCluster_ShowConfigurations_Call) RunAndReturn(run func(context.Context, int64, *internalpb.ShowConfigurationsRequest) (*internalpb.ShowConfigurationsResponse, error)) *MockCluster_ShowConfigurations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with no fields
 func (_m *MockCluster) Start() {
 	_m.Called()
@@ -0,0 +1,184 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// the nq waiting in the read queue which is regarded as fully loaded
+const loadScoreQueueNQ = 1024
+
+// ParseNodeHeartbeat parses the heartbeat carried by the status of the data distribution response,
+// it returns nil if the querynode doesn't report it, e.g. the querynode of older version.
+func ParseNodeHeartbeat(status *commonpb.Status) *metricsinfo.NodeHeartbeat {
+	value, ok := status.GetExtraInfo()[metricsinfo.NodeHeartbeatExtraInfoKey]
+	if !ok {
+		return nil
+	}
+	heartbeat := &metricsinfo.NodeHeartbeat{}
+	if err := json.Unmarshal([]byte(value), heartbeat); err != nil {
+		log.RatedWarn(60, "failed to unmarshal node heartbeat", zap.String("heartbeat", value), zap.Error(err))
+		return nil
+	}
+	return heartbeat
+}
+
+// ComputeLoadScore computes the load score of the heartbeat in [0, 1],
+// which is the average of the usage of cpu, memory, io and read queue.
+func ComputeLoadScore(heartbeat *metricsinfo.NodeHeartbeat) float64 {
+	clamp := func(v float64) float64 {
+		return min(max(v, 0), 1)
+	}
+	return (clamp(heartbeat.CPUUsage/100) +
+		clamp(heartbeat.MemoryUsage) +
+		clamp(heartbeat.IOWait) +
+		clamp(float64(heartbeat.ReadQueueNQ)/loadScoreQueueNQ)) / 4
+}
+
+type loadSample struct {
+	ts        time.Time
+	score     float64
+	heartbeat *metricsinfo.NodeHeartbeat
+}
+
+// heartbeats keeps the build info of the node and the load samples within the window.
+type heartbeats struct {
+	buildVersion        string
+	featureFlags        []string
+	timeTravelWatermark uint64
+	samples             []loadSample
+}
+
+func loadScoreWindow() time.Duration {
+	return paramtable.Get().QueryCoordCfg.NodeLoadScoreWindow.GetAsDuration(time.Second)
+}
+
+// UpdateHeartbeat records the heartbeat of the node, the samples out of the window are dropped.
+func (n *NodeInfo) UpdateHeartbeat(heartbeat *metricsinfo.NodeHeartbeat, now time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.heartbeats.buildVersion = heartbeat.BuildVersion
+	n.heartbeats.featureFlags = heartbeat.FeatureFlags
+	n.heartbeats.timeTravelWatermark = heartbeat.TimeTravelWatermark
+	n.heartbeats.samples = append(n.heartbeats.samples, loadSample{
+		ts:        now,
+		score:     ComputeLoadScore(heartbeat),
+		heartbeat: heartbeat,
+	})
+	expired := 0
+	for expired < len(n.heartbeats.samples) && now.Sub(n.heartbeats.samples[expired].ts) > loadScoreWindow() {
+		expired++
+	}
+	n.heartbeats.samples = n.heartbeats.samples[expired:]
+}
+
+// LoadScore returns the average load score of the node within the window, 0 if there is no recent heartbeat.
+func (n *NodeInfo) LoadScore() float64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	window := loadScoreWindow()
+	total, count := float64(0), 0
+	for _, sample := range n.heartbeats.samples {
+		if time.Since(sample.ts) <= window {
+			total += sample.score
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / float64(count)
+}
+
+// FilterOverloadedNodes filters out the nodes whose load score exceeds queryCoord.stoppingBalanceMaxLoadScore,
+// so the segments and channels of the stopping nodes are not moved onto the busy ones.
+// The nodes are kept as is if all of them are overloaded, the stopping nodes must be drained anyway.
+func (m *NodeManager) FilterOverloadedNodes(nodes []int64) []int64 {
+	maxLoadScore := paramtable.Get().QueryCoordCfg.StoppingBalanceMaxLoadScore.GetAsFloat()
+	if maxLoadScore >= 1 {
+		return nodes
+	}
+	filtered := make([]int64, 0, len(nodes))
+	for _, nodeID := range nodes {
+		if node := m.Get(nodeID); node != nil && node.LoadScore() > maxLoadScore {
+			continue
+		}
+		filtered = append(filtered, nodeID)
+	}
+	if len(filtered) == 0 {
+		return nodes
+	}
+	if len(filtered) < len(nodes) {
+		log.RatedInfo(60, "skip the overloaded nodes for stopping balance",
+			zap.Int64s("nodes", nodes), zap.Int64s("filtered", filtered), zap.Float64("maxLoadScore", maxLoadScore))
+	}
+	return filtered
+}
+
+// BuildVersion returns the git commit of the querynode reported by the heartbeat.
+func (n *NodeInfo) BuildVersion() string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.heartbeats.buildVersion
+}
+
+// FeatureFlags returns the capabilities of the querynode reported by the heartbeat.
+func (n *NodeInfo) FeatureFlags() []string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.heartbeats.featureFlags
+}
+
+// TimeTravelWatermark returns the oldest timestamp pinned by the running requests on the querynode,
+// reported by the last heartbeat, zero if there is none.
+func (n *NodeInfo) TimeTravelWatermark() uint64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.heartbeats.timeTravelWatermark
+}
+
+// HeartbeatInfo returns the heartbeats of the node kept by the querycoord.
+func (n *NodeInfo) HeartbeatInfo() *metricsinfo.NodeHeartbeatInfo {
+	info := &metricsinfo.NodeHeartbeatInfo{
+		NodeID:        n.ID(),
+		Address:       n.Addr(),
+		State:         n.GetState().String(),
+		LastHeartbeat: n.LastHeartbeat().Format(time.RFC3339),
+		LoadScore:     n.LoadScore(),
+	}
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	info.BuildVersion = n.heartbeats.buildVersion
+	info.FeatureFlags = n.heartbeats.featureFlags
+	for _, sample := range n.heartbeats.samples {
+		info.Samples = append(info.Samples, &metricsinfo.NodeLoadSample{
+			Timestamp: sample.ts.Format(time.RFC3339Nano),
+			Score:     sample.score,
+			Heartbeat: sample.heartbeat,
+		})
+	}
+	return info
+}
@@ -135,6 +135,7 @@ type NodeInfo struct {
 	immutableInfo ImmutableNodeInfo
 	state         State
 	lastHeartbeat *atomic.Int64
+	heartbeats    heartbeats
 }
 
 func (n *NodeInfo) ID() int64 {
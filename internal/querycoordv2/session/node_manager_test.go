@@ -21,6 +21,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
 type NodeManagerSuite struct {
@@ -140,6 +144,57 @@ func (s *NodeManagerSuite) TestMemCapacityFunctionality() {
 	s.Equal(2048.75, node.MemCapacity())
 }
 
+func (s *NodeManagerSuite) TestLoadScore() {
+	paramtable.Init()
+	node := NewNodeInfo(ImmutableNodeInfo{NodeID: 1})
+	s.Zero(node.LoadScore())
+
+	// the querynode of older version doesn't report the heartbeat
+	s.Nil(ParseNodeHeartbeat(merr.Success()))
+	status := merr.Success()
+	status.ExtraInfo = map[string]string{
+		metricsinfo.NodeHeartbeatExtraInfoKey: `{"cpu_usage": 100, "memory_usage": 0.5, "io_wait": 0, "read_queue_nq": 4096, "build_version": "abc", "feature_flags": ["storage_v2"], "time_travel_watermark": "100"}`,
+	}
+	heartbeat := ParseNodeHeartbeat(status)
+	s.Require().NotNil(heartbeat)
+	s.InDelta(0.625, ComputeLoadScore(heartbeat), 1e-9)
+
+	now := time.Now()
+	node.UpdateHeartbeat(heartbeat, now.Add(-time.Hour))
+	node.UpdateHeartbeat(&metricsinfo.NodeHeartbeat{MemoryUsage: 1}, now)
+	// the sample out of the window is dropped
+	s.InDelta(0.25, node.LoadScore(), 1e-9)
+	s.Len(node.HeartbeatInfo().Samples, 1)
+	s.Equal("", node.BuildVersion())
+
+	node.UpdateHeartbeat(heartbeat, now)
+	s.InDelta(0.4375, node.LoadScore(), 1e-9)
+	s.Equal("abc", node.BuildVersion())
+	s.Equal([]string{"storage_v2"}, node.FeatureFlags())
+	s.EqualValues(100, node.TimeTravelWatermark())
+}
+
+func (s *NodeManagerSuite) TestFilterOverloadedNodes() {
+	paramtable.Init()
+	params := paramtable.Get()
+	defer params.Reset(params.QueryCoordCfg.StoppingBalanceMaxLoadScore.Key)
+
+	now := time.Now()
+	s.nodeManager.Add(NewNodeInfo(ImmutableNodeInfo{NodeID: 1}))
+	s.nodeManager.Add(NewNodeInfo(ImmutableNodeInfo{NodeID: 2}))
+	// full load of cpu, memory, io and read queue
+	s.nodeManager.Get(2).UpdateHeartbeat(&metricsinfo.NodeHeartbeat{CPUUsage: 100, MemoryUsage: 1, IOWait: 1, ReadQueueNQ: 4096}, now)
+
+	s.Equal([]int64{1}, s.nodeManager.FilterOverloadedNodes([]int64{1, 2}))
+	// the unknown nodes are kept
+	s.Equal([]int64{1, 3}, s.nodeManager.FilterOverloadedNodes([]int64{1, 2, 3}))
+	// kept as is if all of them are overloaded
+	s.Equal([]int64{2}, s.nodeManager.FilterOverloadedNodes([]int64{2}))
+
+	params.Save(params.QueryCoordCfg.StoppingBalanceMaxLoadScore.Key, "1")
+	s.Equal([]int64{1, 2}, s.nodeManager.FilterOverloadedNodes([]int64{1, 2}))
+}
+
 func TestNodeManagerSuite(t *testing.T) {
 	suite.Run(t, new(NodeManagerSuite))
 }
@@ -217,6 +217,7 @@ func (ex *Executor) loadSegment(task *SegmentTask, step int) error {
 	if err != nil {
 		return err
 	}
+	loadInfo.Prefetch = ex.shouldPrefetch(task)
 	req := packLoadSegmentRequest(
 		task,
 		action,
@@ -270,6 +271,23 @@ func (ex *Executor) loadSegment(task *SegmentTask, step int) error {
 	return nil
 }
 
+// shouldPrefetch checks whether the segment of the task should be prefetched by the target node.
+// The segment moved off a stopping node is prefetched, the source node keeps serving it
+// until the target node reports it loaded, so the switch-over doesn't wait for the cache misses.
+func (ex *Executor) shouldPrefetch(task *SegmentTask) bool {
+	if GetTaskType(task) != TaskTypeMove || !Params.QueryCoordCfg.EnableStoppingPrefetch.GetAsBool() {
+		return false
+	}
+	for _, action := range task.Actions() {
+		if action.Type() != ActionTypeReduce {
+			continue
+		}
+		node := ex.nodeMgr.Get(action.Node())
+		return node != nil && node.IsStoppingState()
+	}
+	return false
+}
+
 // checkIfShardLeaderIsStreamingNode checks if the shard leader is a streamingnode.
 // Because the L0 management at 2.6 and 2.5 is different, so when upgrading mixcoord,
 // the new mixcoord will make a wrong plan when balancing a segment from one query node to another by 2.5 delegator.
@@ -2,7 +2,10 @@
 
 package task
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	datapb "github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockScheduler is an autogenerated mock type for the Scheduler type
 type MockScheduler struct {
@@ -129,6 +132,53 @@ func (_c *MockScheduler_Dispatch_Call) RunAndReturn(run func(int64)) *MockSchedu
 	return _c
 }
 
+// GetBalanceTasks provides a mock function with no fields
+func (_m *MockScheduler) GetBalanceTasks() []*datapb.BackgroundJob {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalanceTasks")
+	}
+
+	var r0 []*datapb.BackgroundJob
+	if rf, ok := ret.Get(0).(func() []*datapb.BackgroundJob); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.BackgroundJob)
+		}
+	}
+
+	return r0
+}
+
+// MockScheduler_GetBalanceTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalanceTasks'
+type MockScheduler_GetBalanceTasks_Call struct {
+	*mock.Call
+}
+
+// GetBalanceTasks is a helper method to define mock.On call
+func (_e *MockScheduler_Expecter) GetBalanceTasks() *MockScheduler_GetBalanceTasks_Call {
+	return &MockScheduler_GetBalanceTasks_Call{Call: _e.mock.On("GetBalanceTasks")}
+}
+
+func (_c *MockScheduler_GetBalanceTasks_Call) Run(run func()) *MockScheduler_GetBalanceTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockScheduler_GetBalanceTasks_Call) Return(_a0 []*datapb.BackgroundJob) *MockScheduler_GetBalanceTasks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockScheduler_GetBalanceTasks_Call) RunAndReturn(run func() []*datapb.BackgroundJob) *MockScheduler_GetBalanceTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetChannelTaskDelta provides a mock function with given fields: nodeID, collectionID
 func (_m *MockScheduler) GetChannelTaskDelta(nodeID int64, collectionID int64) int {
 	ret := _m.Called(nodeID, collectionID)
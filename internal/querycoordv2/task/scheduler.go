@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/hardware"
 	"github.com/milvus-io/milvus/pkg/v2/util/lock"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
 	. "github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -275,6 +276,7 @@ type Scheduler interface {
 	GetChannelTaskNum(filters ...TaskFilter) int
 	GetSegmentTaskNum(filters ...TaskFilter) int
 	GetTasksJSON() string
+	GetBalanceTasks() []*datapb.BackgroundJob
 
 	GetSegmentTaskDelta(nodeID int64, collectionID int64) int
 	GetChannelTaskDelta(nodeID int64, collectionID int64) int
@@ -761,6 +763,42 @@ func (scheduler *taskScheduler) GetTasksJSON() string {
 	return string(ret)
 }
 
+// GetBalanceTasks returns the tasks generated by the balance checker recently.
+func (scheduler *taskScheduler) GetBalanceTasks() []*datapb.BackgroundJob {
+	var ret []*datapb.BackgroundJob
+	for _, task := range scheduler.taskStats.Values() {
+		if task.Source() != utils.BalanceChecker {
+			continue
+		}
+		job := &datapb.BackgroundJob{
+			Type:         metricsinfo.BackgroundJobBalance,
+			JobID:        task.ID(),
+			CollectionID: task.CollectionID(),
+			StartTime:    TimestampToString(uint64(task.GetStartTime().UnixMilli())),
+			Detail:       task.Name(),
+		}
+		if actions := len(task.Actions()); actions > 0 {
+			job.Progress = int64(task.Step() * 100 / actions)
+		}
+		switch task.Status() {
+		case TaskStatusCreated:
+			job.State = metricsinfo.BackgroundJobPending
+		case TaskStatusSucceeded:
+			job.State = metricsinfo.BackgroundJobCompleted
+			job.Progress = 100
+		case TaskStatusCanceled, TaskStatusFailed:
+			job.State = metricsinfo.BackgroundJobFailed
+			if err := task.Err(); err != nil {
+				job.Error = err.Error()
+			}
+		default:
+			job.State = metricsinfo.BackgroundJobRunning
+		}
+		ret = append(ret, job)
+	}
+	return ret
+}
+
 // schedule selects some tasks to execute, follow these steps for each started selected tasks:
 // 1. check whether this task is stale, set status to canceled if stale
 // 2. step up the task's actions, set status to succeeded if all actions finished
@@ -976,6 +1014,9 @@ func (scheduler *taskScheduler) recordSegmentTaskError(task *SegmentTask) {
 		zap.Error(task.err),
 	)
 	meta.GlobalFailedLoadCache.Put(task.collectionID, task.Err())
+	if GetTaskType(task) == TaskTypeGrow {
+		meta.GlobalFailedLoadCache.PutSegment(task.collectionID, task.SegmentID(), task.Err())
+	}
 }
 
 func (scheduler *taskScheduler) remove(task Task) {
@@ -1017,6 +1058,12 @@ func (scheduler *taskScheduler) remove(task Task) {
 			task.Err() != nil &&
 			!errors.IsAny(task.Err(), merr.ErrChannelNotFound, merr.ErrServiceTooManyRequests) {
 			scheduler.recordSegmentTaskError(task)
+		} else if task.Status() == TaskStatusSucceeded && GetTaskType(task) != TaskTypeReduce {
+			if meta.GlobalFailedLoadCache.RemoveSegment(task.CollectionID(), task.SegmentID()) {
+				if err := scheduler.meta.CollectionManager.SyncQuarantinedSegments(scheduler.ctx, task.CollectionID()); err != nil {
+					log.Warn("failed to save quarantined segments", zap.Error(err))
+				}
+			}
 		}
 
 	case *ChannelTask:
@@ -108,6 +108,7 @@ type Task interface {
 
 	RecordStartTs()
 	GetTaskLatency() int64
+	GetStartTime() time.Time
 }
 
 type baseTask struct {
@@ -227,6 +228,10 @@ func (task *baseTask) GetTaskLatency() int64 {
 	return time.Since(task.startTs.Load()).Milliseconds()
 }
 
+func (task *baseTask) GetStartTime() time.Time {
+	return task.startTs.Load()
+}
+
 func (task *baseTask) Err() error {
 	select {
 	case <-task.doneCh:
@@ -2064,6 +2064,54 @@ func (suite *TaskSuite) TestSegmentTaskShardLeaderID() {
 	suite.Equal(int64(0), segmentTask.ShardLeaderID())
 }
 
+func (suite *TaskSuite) TestExecutor_ShouldPrefetch() {
+	ctx := context.Background()
+	timeout := 10 * time.Second
+	sourceNode := int64(100)
+	targetNode := int64(101)
+	channel := Params.CommonCfg.RootCoordDml.GetValue() + "-test"
+	segmentID := suite.loadSegments[0]
+	for _, node := range []int64{sourceNode, targetNode} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+		defer suite.nodeMgr.Remove(node)
+	}
+
+	executor := NewExecutor(suite.meta,
+		suite.dist,
+		suite.broker,
+		suite.target,
+		suite.cluster,
+		suite.nodeMgr)
+
+	growTask, err := NewSegmentTask(ctx, timeout, WrapIDSource(0), suite.collection, suite.replica, commonpb.LoadPriority_LOW,
+		NewSegmentAction(targetNode, ActionTypeGrow, channel, segmentID),
+	)
+	suite.NoError(err)
+	moveTask, err := NewSegmentTask(ctx, timeout, WrapIDSource(0), suite.collection, suite.replica, commonpb.LoadPriority_LOW,
+		NewSegmentAction(targetNode, ActionTypeGrow, channel, segmentID),
+		NewSegmentAction(sourceNode, ActionTypeReduce, channel, segmentID),
+	)
+	suite.NoError(err)
+
+	// the source node is not stopping
+	suite.False(executor.shouldPrefetch(growTask))
+	suite.False(executor.shouldPrefetch(moveTask))
+
+	// the segment moved off the stopping node is prefetched
+	suite.nodeMgr.Stopping(sourceNode)
+	suite.False(executor.shouldPrefetch(growTask))
+	suite.True(executor.shouldPrefetch(moveTask))
+
+	// the prefetch is disabled
+	paramtable.Get().Save(Params.QueryCoordCfg.EnableStoppingPrefetch.Key, "false")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.EnableStoppingPrefetch.Key)
+	suite.False(executor.shouldPrefetch(moveTask))
+}
+
 func (suite *TaskSuite) TestExecutor_MoveSegmentTask() {
 	ctx := context.Background()
 	timeout := 10 * time.Second
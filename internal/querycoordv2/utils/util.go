@@ -61,6 +61,10 @@ func CheckDelegatorDataReady(nodeMgr *session.NodeManager, targetMgr meta.Target
 	// Check whether segments are fully loaded
 	for segmentID := range segmentDist {
 		version, exist := leader.Segments[segmentID]
+		if !exist && meta.GlobalFailedLoadCache.IsSegmentExcluded(leader.CollectionID, segmentID) {
+			// quarantined segment is excluded from the readable target of partially loaded collection
+			continue
+		}
 		if !exist {
 			log.RatedInfo(10, "leader is not available due to lack of segment", zap.Int64("segmentID", segmentID))
 			return merr.WrapErrSegmentLack(segmentID)
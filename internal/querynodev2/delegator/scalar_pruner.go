@@ -1,6 +1,8 @@
 package delegator
 
 import (
+	"sort"
+
 	"github.com/bits-and-blooms/bitset"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
@@ -171,7 +173,12 @@ type TermExpr struct {
 	vals []storage.ScalarFieldValue
 }
 
+// NewTermExpr sorts the values, so that each segment could be checked by binary search
+// instead of comparing with all the values, which is costly for large in-lists.
 func NewTermExpr(values []storage.ScalarFieldValue) *TermExpr {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].LT(values[j])
+	})
 	return &TermExpr{vals: values}
 }
 
@@ -179,16 +186,12 @@ func (te *TermExpr) Eval(evalCtx *EvalCtx) *bitset.BitSet {
 	localBst := bitset.New(evalCtx.size)
 	for i, segStat := range evalCtx.segmentStats {
 		fieldStat := &(segStat.FieldStats[0])
-		for _, val := range te.vals {
-			if val.GT(fieldStat.Max) {
-				// as the vals inside expr has been sorted before executed, if current val has exceeded the max, then
-				// no need to iterate over other values
-				break
-			}
-			if fieldStat.Min.LE(val) && (val).LE(fieldStat.Max) {
-				localBst.Set(uint(i))
-				break
-			}
+		// find the first value which is not less than the min of segment
+		idx := sort.Search(len(te.vals), func(j int) bool {
+			return fieldStat.Min.LE(te.vals[j])
+		})
+		if idx < len(te.vals) && te.vals[idx].LE(fieldStat.Max) {
+			localBst.Set(uint(i))
 		}
 	}
 	return localBst
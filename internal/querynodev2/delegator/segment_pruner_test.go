@@ -2,6 +2,9 @@ package delegator
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -277,6 +280,28 @@ func (sps *SegmentPrunerSuite) TestPruneSegmentsByScalarIntField() {
 		sps.Equal(2, len(testSegments[0].Segments))
 		sps.Equal(0, len(testSegments[1].Segments))
 	}
+	{
+		// test for term operator with large unsorted in-list
+		testSegments := make([]SnapshotItem, len(sps.sealedSegments))
+		copy(testSegments, sps.sealedSegments)
+		values := make([]string, 0, 2000)
+		for i := 2000; i > 0; i-- {
+			values = append(values, strconv.Itoa(i*1000+1))
+		}
+		values = append(values, "300", "200", "100")
+		exprStr := fmt.Sprintf("age in [%s]", strings.Join(values, ","))
+		schemaHelper, _ := typeutil.CreateSchemaHelper(sps.schema)
+		planNode, err := planparserv2.CreateRetrievePlan(schemaHelper, exprStr, nil)
+		sps.NoError(err)
+		serializedPlan, _ := proto.Marshal(planNode)
+		queryReq := &internalpb.RetrieveRequest{
+			SerializedExprPlan: serializedPlan,
+			PartitionIDs:       targetPartitions,
+		}
+		PruneSegments(context.TODO(), sps.partitionStats, nil, queryReq, sps.schema, testSegments, PruneInfo{paramtable.Get().QueryNodeCfg.DefaultSegmentFilterRatio.GetAsFloat()})
+		sps.Equal(2, len(testSegments[0].Segments))
+		sps.Equal(0, len(testSegments[1].Segments))
+	}
 	{
 		// test for not operator, segment prune don't support not operator
 		// so it's expected to get all segments here
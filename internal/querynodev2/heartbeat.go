@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynodev2
+
+import (
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/hardware"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+)
+
+// ioWaitSampler computes the ratio of the cpu time waiting for io since last sample.
+type ioWaitSampler struct {
+	mu         sync.Mutex
+	lastIOWait float64
+	lastTime   time.Time
+}
+
+func (s *ioWaitSampler) sample() float64 {
+	ioWait, err := hardware.GetIOWait()
+	if err != nil {
+		log.RatedWarn(60, "failed to get iowait", zap.Error(err))
+		return 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	ratio := float64(0)
+	// the iowait is the cumulative seconds of all the cpus
+	if elapsed := now.Sub(s.lastTime).Seconds() * float64(runtime.NumCPU()); !s.lastTime.IsZero() && elapsed > 0 {
+		ratio = min(max((ioWait-s.lastIOWait)/elapsed, 0), 1)
+	}
+	s.lastIOWait, s.lastTime = ioWait, now
+	return ratio
+}
+
+// heartbeatStatus returns the success status which carries the load and build info of the node in the extra info,
+// the querycoord computes the load score of the node from it.
+func (node *QueryNode) heartbeatStatus() *commonpb.Status {
+	status := merr.Success()
+	heartbeat := &metricsinfo.NodeHeartbeat{
+		CPUUsage:            hardware.GetCPUUsage(),
+		MemoryUsage:         hardware.GetMemoryUseRatio(),
+		IOWait:              node.ioWaitSampler.sample(),
+		BuildVersion:        os.Getenv(metricsinfo.GitCommitEnvKey),
+		FeatureFlags:        sessionutil.LocalCapabilities(),
+		TimeTravelWatermark: node.timeTravelWatermark(),
+	}
+	if node.scheduler != nil {
+		heartbeat.ReadQueueTasks = node.scheduler.GetWaitingTaskTotal()
+		heartbeat.ReadQueueNQ = node.scheduler.GetWaitingTaskTotalNQ()
+	}
+	bs, err := json.Marshal(heartbeat)
+	if err != nil {
+		log.Warn("failed to marshal node heartbeat", zap.Error(err))
+		return status
+	}
+	status.ExtraInfo = map[string]string{metricsinfo.NodeHeartbeatExtraInfoKey: string(bs)}
+	return status
+}
@@ -20,8 +20,10 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/samber/lo"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -37,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/ratelimitutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
@@ -211,6 +214,86 @@ func getChannelJSON(node *QueryNode, collectionID int64) string {
 	return string(ret)
 }
 
+// collectionAccess is the access state of a collection on the querynode.
+type collectionAccess struct {
+	// the last time the collection is searched or queried in milliseconds
+	lastAccessTime atomic.Int64
+	// the number of the running search and query requests
+	inFlight atomic.Int64
+}
+
+// recordCollectionAccess records the collection is searched or queried now,
+// the returned func shall be called when the request is done.
+func (node *QueryNode) recordCollectionAccess(collectionID int64) func() {
+	access, _ := node.collectionAccess.GetOrInsert(collectionID, &collectionAccess{})
+	access.inFlight.Inc()
+	access.lastAccessTime.Store(time.Now().UnixMilli())
+	return func() {
+		access.lastAccessTime.Store(time.Now().UnixMilli())
+		access.inFlight.Dec()
+	}
+}
+
+// getCollectionAccessJSON returns the JSON string of the last access time of the loaded collections
+func getCollectionAccessJSON(node *QueryNode) string {
+	var accesses []*metricsinfo.CollectionAccess
+	node.collectionAccess.Range(func(collectionID int64, access *collectionAccess) bool {
+		inFlight := access.inFlight.Load()
+		if node.manager.Collection.Get(collectionID) == nil && inFlight == 0 {
+			node.collectionAccess.Remove(collectionID)
+			return true
+		}
+		accesses = append(accesses, &metricsinfo.CollectionAccess{
+			CollectionID:   collectionID,
+			LastAccessTime: access.lastAccessTime.Load(),
+			InFlight:       inFlight,
+		})
+		return true
+	})
+	ret, err := json.Marshal(accesses)
+	if err != nil {
+		log.Warn("failed to marshal collection accesses", zap.Error(err))
+		return ""
+	}
+	return string(ret)
+}
+
+type mvccTimestampGetter interface {
+	GetMvccTimestamp() uint64
+	GetGuaranteeTimestamp() uint64
+}
+
+// pinTimestamp records the timestamp read by the search or query request until the returned func is called.
+// The mvcc timestamp is not assigned yet when the request arrives at the delegator, the guarantee timestamp is pinned
+// then, or the current time if the request is not guaranteed, e.g. eventually consistency.
+func (node *QueryNode) pinTimestamp(req mvccTimestampGetter) func() {
+	ts := req.GetMvccTimestamp()
+	if ts == 0 {
+		ts = req.GetGuaranteeTimestamp()
+	}
+	if ts <= 1 {
+		ts = tsoutil.ComposeTSByTime(time.Now(), 0)
+	}
+	seq := node.pinSeq.Inc()
+	node.pinnedTimestamps.Insert(seq, ts)
+	return func() {
+		node.pinnedTimestamps.Remove(seq)
+	}
+}
+
+// timeTravelWatermark returns the oldest timestamp pinned by the running requests, zero if there is none.
+// It's reported to the querycoord by the heartbeat.
+func (node *QueryNode) timeTravelWatermark() uint64 {
+	var watermark uint64
+	node.pinnedTimestamps.Range(func(_ int64, ts uint64) bool {
+		if watermark == 0 || ts < watermark {
+			watermark = ts
+		}
+		return true
+	})
+	return watermark
+}
+
 // getSegmentJSON returns the JSON string of segments
 func getSegmentJSON(node *QueryNode, collectionID int64) string {
 	allSegments := node.manager.Segment.GetBy()
@@ -268,15 +351,25 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 		log.Ctx(ctx).Warn("get iowait failed", zap.Error(err))
 	}
 
+	ioStats, err := hardware.GetIOStats()
+	if err != nil {
+		log.Ctx(ctx).Warn("get io stats failed", zap.Error(err))
+		ioStats = &hardware.ContainerIOStats{}
+	}
+
 	hardwareInfos := metricsinfo.HardwareMetrics{
-		IP:               node.session.Address,
-		CPUCoreCount:     hardware.GetCPUNum(),
-		CPUCoreUsage:     hardware.GetCPUUsage(),
-		Memory:           totalMem,
-		MemoryUsage:      usedMem,
-		Disk:             totalDiskGB,
-		DiskUsage:        usedDiskGB,
-		IOWaitPercentage: ioWait,
+		IP:                node.session.Address,
+		CPUCoreCount:      hardware.GetCPUNum(),
+		CPUCoreUsage:      hardware.GetCPUUsage(),
+		Memory:            totalMem,
+		MemoryUsage:       usedMem,
+		Disk:              totalDiskGB,
+		DiskUsage:         usedDiskGB,
+		IOWaitPercentage:  ioWait,
+		CPULimit:          hardware.GetCPULimit(),
+		CPUThrottledRatio: hardware.GetCPUThrottledRatio(),
+		IOReadBytes:       ioStats.ReadBytes,
+		IOWriteBytes:      ioStats.WriteBytes,
 	}
 
 	quotaMetrics, err := getQuotaMetrics(node)
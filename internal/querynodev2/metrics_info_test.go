@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querynodev2/pipeline"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgdispatcher"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
@@ -135,6 +136,55 @@ func TestGetSegmentJSON(t *testing.T) {
 	assert.Equal(t, int64(100), segments[0].LoadedInsertRowCount)
 }
 
+func TestGetCollectionAccessJSON(t *testing.T) {
+	collectionManager := segments.NewMockCollectionManager(t)
+	collectionManager.EXPECT().Get(int64(1001)).Return(&segments.Collection{})
+	collectionManager.EXPECT().Get(int64(1002)).Return(nil)
+	node := &QueryNode{
+		manager:          &segments.Manager{Collection: collectionManager},
+		collectionAccess: typeutil.NewConcurrentMap[int64, *collectionAccess](),
+	}
+	done := node.recordCollectionAccess(1001)
+	node.recordCollectionAccess(1002)()
+
+	var accesses []*metricsinfo.CollectionAccess
+	err := json.Unmarshal([]byte(getCollectionAccessJSON(node)), &accesses)
+	assert.NoError(t, err)
+	assert.Len(t, accesses, 1)
+	assert.Equal(t, int64(1001), accesses[0].CollectionID)
+	assert.Equal(t, int64(1), accesses[0].InFlight)
+	assert.InDelta(t, time.Now().UnixMilli(), accesses[0].LastAccessTime, float64(time.Minute.Milliseconds()))
+
+	// the access of the released collection is removed
+	_, ok := node.collectionAccess.Get(1002)
+	assert.False(t, ok)
+
+	done()
+	accesses = nil
+	err = json.Unmarshal([]byte(getCollectionAccessJSON(node)), &accesses)
+	assert.NoError(t, err)
+	assert.Len(t, accesses, 1)
+	assert.Equal(t, int64(0), accesses[0].InFlight)
+}
+
+func TestTimeTravelWatermark(t *testing.T) {
+	node := &QueryNode{pinnedTimestamps: typeutil.NewConcurrentMap[int64, uint64]()}
+	watermark := node.timeTravelWatermark
+	assert.EqualValues(t, 0, watermark())
+
+	unpin1 := node.pinTimestamp(&internalpb.SearchRequest{MvccTimestamp: 100, GuaranteeTimestamp: 200})
+	unpin2 := node.pinTimestamp(&internalpb.RetrieveRequest{GuaranteeTimestamp: 50})
+	// the request without guarantee timestamp is pinned at now
+	unpin3 := node.pinTimestamp(&internalpb.RetrieveRequest{GuaranteeTimestamp: 1})
+	assert.EqualValues(t, 50, watermark())
+	unpin2()
+	assert.EqualValues(t, 100, watermark())
+	unpin1()
+	assert.InDelta(t, time.Now().UnixMilli(), tsoutil.PhysicalTime(watermark()).UnixMilli(), float64(time.Minute.Milliseconds()))
+	unpin3()
+	assert.EqualValues(t, 0, watermark())
+}
+
 func TestStreamingQuotaMetrics(t *testing.T) {
 	paramtable.Init()
 
@@ -0,0 +1,172 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// lazyIndex is the vector index of a sealed segment which is not loaded at the segment loading,
+// only the index meta is kept until the index is loaded on the first search or by the background warmup.
+type lazyIndex struct {
+	mu   sync.Mutex
+	info *querypb.FieldIndexInfo
+}
+
+// isIndexLazyLoad checks if the vector indexes of the segment are lazy load,
+// it doesn't work with the segment level lazy load, which defers the whole segment.
+func isIndexLazyLoad(collection *Collection, segmentType SegmentType) bool {
+	return segmentType == SegmentTypeSealed &&
+		!isLazyLoad(collection, segmentType) &&
+		paramtable.Get().QueryNodeCfg.LazyLoadIndexEnabled.GetAsBool()
+}
+
+// splitLazyIndexes returns a copy of the load info without the vector indexes, and the vector indexes to load lazily.
+// The prefetched segments keep all the indexes, they're expected to be ready to serve once loaded.
+func splitLazyIndexes(loadInfo *querypb.SegmentLoadInfo, schema *schemapb.CollectionSchema) (*querypb.SegmentLoadInfo, []*querypb.FieldIndexInfo) {
+	if loadInfo.GetPrefetch() {
+		return loadInfo, nil
+	}
+	var lazyIndexes, indexInfos []*querypb.FieldIndexInfo
+	for _, info := range loadInfo.GetIndexInfos() {
+		field := typeutil.GetField(schema, info.GetFieldID())
+		if field != nil && typeutil.IsVectorType(field.GetDataType()) && len(info.GetIndexFilePaths()) > 0 {
+			lazyIndexes = append(lazyIndexes, info)
+		} else {
+			indexInfos = append(indexInfos, info)
+		}
+	}
+	if len(lazyIndexes) == 0 {
+		return loadInfo, nil
+	}
+	loadInfo = typeutil.Clone(loadInfo)
+	loadInfo.IndexInfos = indexInfos
+	return loadInfo, lazyIndexes
+}
+
+// LazyIndexFields returns the fields whose indexes are not loaded yet.
+func (s *LocalSegment) LazyIndexFields() []int64 {
+	fieldIDs := make([]int64, 0)
+	s.lazyIndexes.Range(func(fieldID int64, li *lazyIndex) bool {
+		if !s.isLazyIndexLoaded(li) {
+			fieldIDs = append(fieldIDs, fieldID)
+		}
+		return true
+	})
+	return fieldIDs
+}
+
+func (s *LocalSegment) isLazyIndexLoaded(li *lazyIndex) bool {
+	info := s.GetIndexByID(li.info.GetIndexID())
+	return info != nil && info.IsLoaded
+}
+
+// LoadLazyIndex loads the index of the field which is deferred at the segment loading,
+// it's a no-op if the field has no lazy index or the index has been loaded.
+func (loader *segmentLoader) LoadLazyIndex(ctx context.Context, seg Segment, fieldID int64) error {
+	segment, ok := seg.(*LocalSegment)
+	if !ok {
+		return nil
+	}
+	li, ok := segment.lazyIndexes.Get(fieldID)
+	if !ok || segment.isLazyIndexLoaded(li) {
+		return nil
+	}
+
+	li.mu.Lock()
+	defer li.mu.Unlock()
+	if segment.isLazyIndexLoaded(li) {
+		return nil
+	}
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", segment.Collection()),
+		zap.Int64("segmentID", segment.ID()),
+		zap.Int64("fieldID", fieldID),
+		zap.Int64("indexID", li.info.GetIndexID()),
+	)
+
+	// estimate the resource usage with the index and the binlogs of the field only
+	loadInfo := typeutil.Clone(segment.LoadInfo())
+	loadInfo.IndexInfos = []*querypb.FieldIndexInfo{li.info}
+	loadInfo.BinlogPaths = lo.Filter(loadInfo.GetBinlogPaths(), func(binlog *datapb.FieldBinlog, _ int) bool {
+		return binlog.GetFieldID() == fieldID
+	})
+	loadInfo.Deltalogs = nil
+	loadInfo.Statslogs = nil
+	requestResourceResult, err := loader.requestResource(ctx, loadInfo)
+	if err != nil {
+		log.Warn("request resource failed for lazy index", zap.Error(err))
+		return err
+	}
+	defer loader.freeRequestResource(requestResourceResult)
+
+	tr := timerecord.NewTimeRecorder("loadLazyIndex")
+	if err := loader.loadFieldIndex(ctx, segment, typeutil.Clone(li.info)); err != nil {
+		log.Warn("failed to load lazy index", zap.Error(err))
+		return err
+	}
+	log.Info("lazy index loaded", zap.Duration("elapse", tr.ElapseSpan()))
+	return nil
+}
+
+// warmupLazyIndexes loads the lazy indexes of the segment in background.
+func (loader *segmentLoader) warmupLazyIndexes(segment *LocalSegment) {
+	if paramtable.Get().QueryNodeCfg.LazyLoadIndexWarmup.GetValue() != "async" {
+		return
+	}
+	for _, fieldID := range segment.LazyIndexFields() {
+		GetWarmupPool().Submit(func() (any, error) {
+			if err := loader.LoadLazyIndex(context.Background(), segment, fieldID); err != nil {
+				log.Warn("failed to warmup lazy index",
+					zap.Int64("segmentID", segment.ID()),
+					zap.Int64("fieldID", fieldID),
+					zap.Error(err))
+			}
+			return nil, nil
+		})
+	}
+}
+
+// loadLazyIndex makes sure the index of the search field is loaded before searching the segment.
+func loadLazyIndex(ctx context.Context, mgr *Manager, segment Segment, fieldID int64) error {
+	s, ok := segment.(*LocalSegment)
+	if !ok || mgr == nil || mgr.Loader == nil {
+		return nil
+	}
+	if li, ok := s.lazyIndexes.Get(fieldID); !ok || s.isLazyIndexLoaded(li) {
+		return nil
+	}
+	ctx, cancel := withLazyLoadTimeoutContext(ctx)
+	defer cancel()
+	if err := mgr.Loader.LoadLazyIndex(ctx, segment, fieldID); err != nil {
+		return errors.Wrapf(err, "failed to load lazy index of field %d", fieldID)
+	}
+	return nil
+}
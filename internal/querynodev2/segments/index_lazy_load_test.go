@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+)
+
+func TestSplitLazyIndexes(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, DataType: schemapb.DataType_VarChar},
+		},
+	}
+	loadInfo := &querypb.SegmentLoadInfo{
+		SegmentID: 1,
+		IndexInfos: []*querypb.FieldIndexInfo{
+			{FieldID: 101, IndexID: 1, IndexFilePaths: []string{"vector"}},
+			{FieldID: 102, IndexID: 2, IndexFilePaths: []string{"scalar"}},
+		},
+	}
+
+	cLoadInfo, lazyIndexes := splitLazyIndexes(loadInfo, schema)
+	assert.Len(t, lazyIndexes, 1)
+	assert.Equal(t, int64(101), lazyIndexes[0].GetFieldID())
+	assert.Len(t, cLoadInfo.GetIndexInfos(), 1)
+	assert.Equal(t, int64(102), cLoadInfo.GetIndexInfos()[0].GetFieldID())
+	// the original load info is kept
+	assert.Len(t, loadInfo.GetIndexInfos(), 2)
+
+	// the vector index without files is not lazy
+	loadInfo.IndexInfos[0].IndexFilePaths = nil
+	cLoadInfo, lazyIndexes = splitLazyIndexes(loadInfo, schema)
+	assert.Empty(t, lazyIndexes)
+	assert.Same(t, loadInfo, cLoadInfo)
+
+	// the prefetched segment keeps all the indexes
+	loadInfo.IndexInfos[0].IndexFilePaths = []string{"vector"}
+	loadInfo.Prefetch = true
+	cLoadInfo, lazyIndexes = splitLazyIndexes(loadInfo, schema)
+	assert.Empty(t, lazyIndexes)
+	assert.Same(t, loadInfo, cLoadInfo)
+}
@@ -402,6 +402,54 @@ func (_c *MockLoader_LoadJSONIndex_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// LoadLazyIndex provides a mock function with given fields: ctx, segment, fieldID
+func (_m *MockLoader) LoadLazyIndex(ctx context.Context, segment Segment, fieldID int64) error {
+	ret := _m.Called(ctx, segment, fieldID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadLazyIndex")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, Segment, int64) error); ok {
+		r0 = rf(ctx, segment, fieldID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLoader_LoadLazyIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadLazyIndex'
+type MockLoader_LoadLazyIndex_Call struct {
+	*mock.Call
+}
+
+// LoadLazyIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - segment Segment
+//   - fieldID int64
+func (_e *MockLoader_Expecter) LoadLazyIndex(ctx interface{}, segment interface{}, fieldID interface{}) *MockLoader_LoadLazyIndex_Call {
+	return &MockLoader_LoadLazyIndex_Call{Call: _e.mock.On("LoadLazyIndex", ctx, segment, fieldID)}
+}
+
+func (_c *MockLoader_LoadLazyIndex_Call) Run(run func(ctx context.Context, segment Segment, fieldID int64)) *MockLoader_LoadLazyIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(Segment), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLoader_LoadLazyIndex_Call) Return(_a0 error) *MockLoader_LoadLazyIndex_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLoader_LoadLazyIndex_Call) RunAndReturn(run func(context.Context, Segment, int64) error) *MockLoader_LoadLazyIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadLazySegment provides a mock function with given fields: ctx, segment, loadInfo
 func (_m *MockLoader) LoadLazySegment(ctx context.Context, segment Segment, loadInfo *querypb.SegmentLoadInfo) error {
 	ret := _m.Called(ctx, segment, loadInfo)
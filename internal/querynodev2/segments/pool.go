@@ -62,6 +62,9 @@ var (
 	bfPool      atomic.Pointer[conc.Pool[any]]
 	bfApplyOnce sync.Once
 
+	reducePool     atomic.Pointer[conc.Pool[any]]
+	reducePoolOnce sync.Once
+
 	// intentionally leaked CGO tag names
 	cgoTagSQ      = C.CString("CGO_SQ")
 	cgoTagLoad    = C.CString("CGO_LOAD")
@@ -170,6 +173,13 @@ func initDeletePool() {
 	})
 }
 
+func initReducePool() {
+	reducePoolOnce.Do(func() {
+		pool := conc.NewPool[any](runtime.GOMAXPROCS(0))
+		reducePool.Store(pool)
+	})
+}
+
 // GetSQPool returns the singleton pool instance for search/query operations.
 func GetSQPool() *conc.Pool[any] {
 	initSQPool()
@@ -202,6 +212,12 @@ func GetDeletePool() *conc.Pool[struct{}] {
 	return deletePool.Load()
 }
 
+// GetReducePool returns the singleton pool for the parallel reduce of search results.
+func GetReducePool() *conc.Pool[any] {
+	initReducePool()
+	return reducePool.Load()
+}
+
 func ResizeSQPool(evt *config.Event) {
 	if evt.HasUpdated {
 		pt := paramtable.Get()
@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"os"
+	"path/filepath"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// spillableResult is an intermediate search result of the parallel reduce,
+// which is either held in memory or spilled into a local file.
+type spillableResult struct {
+	data *schemapb.SearchResultData
+	path string
+	size int64 // the size accounted into the memory budget, 0 if not accounted.
+}
+
+// reduceSpiller keeps the intermediate results of a parallel reduce within the memory budget.
+type reduceSpiller struct {
+	threshold int64
+	dir       string
+	inMemory  *atomic.Int64
+	spilled   *atomic.Int64
+	paths     *typeutil.ConcurrentSet[string]
+}
+
+// newReduceSpiller creates a spiller with the memory budget, threshold <= 0 means never spill.
+func newReduceSpiller(threshold int64) *reduceSpiller {
+	return &reduceSpiller{
+		threshold: threshold,
+		dir:       filepath.Join(paramtable.Get().LocalStorageCfg.Path.GetValue(), typeutil.QueryNodeRole, "reduce_spill"),
+		inMemory:  atomic.NewInt64(0),
+		spilled:   atomic.NewInt64(0),
+		paths:     typeutil.NewConcurrentSet[string](),
+	}
+}
+
+// Hold keeps the result in memory if the budget allows, otherwise spills it into the local storage.
+func (s *reduceSpiller) Hold(data *schemapb.SearchResultData) (*spillableResult, error) {
+	if s.threshold <= 0 {
+		return &spillableResult{data: data}, nil
+	}
+	size := int64(proto.Size(data))
+	if s.inMemory.Add(size) <= s.threshold {
+		return &spillableResult{data: data, size: size}, nil
+	}
+	s.inMemory.Sub(size)
+
+	bs, err := proto.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(s.dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	f, err := os.CreateTemp(s.dir, "reduce-*")
+	if err != nil {
+		return nil, err
+	}
+	s.paths.Insert(f.Name())
+	defer f.Close()
+	if _, err := f.Write(bs); err != nil {
+		return nil, err
+	}
+	s.spilled.Inc()
+	return &spillableResult{path: f.Name()}, nil
+}
+
+// Load returns the data of the result, the spilled result is read from the local storage.
+func (s *reduceSpiller) Load(result *spillableResult) (*schemapb.SearchResultData, error) {
+	if result.data != nil {
+		return result.data, nil
+	}
+	bs, err := os.ReadFile(result.path)
+	if err != nil {
+		return nil, err
+	}
+	data := &schemapb.SearchResultData{}
+	if err := proto.Unmarshal(bs, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Release releases the memory budget or the local file of the result once it's merged.
+func (s *reduceSpiller) Release(result *spillableResult) {
+	if result.data != nil {
+		s.inMemory.Sub(result.size)
+		return
+	}
+	s.remove(result.path)
+}
+
+// SpilledNum returns the number of the spilled results.
+func (s *reduceSpiller) SpilledNum() int64 {
+	return s.spilled.Load()
+}
+
+// Close removes all the remaining spilled files.
+func (s *reduceSpiller) Close() {
+	for _, path := range s.paths.Collect() {
+		s.remove(path)
+	}
+}
+
+func (s *reduceSpiller) remove(path string) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		log.Warn("failed to remove spilled reduce result", zap.String("path", path), zap.Error(err))
+	}
+	s.paths.Remove(path)
+}
@@ -100,6 +100,12 @@ func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 			zap.Int("fieldsData.len", len(sData.FieldsData)))
 	}
 
+	if info.GetStableOrder() && info.GetGroupByFieldId() <= 0 {
+		for _, data := range searchResultData {
+			reduce.SortEqualScoresByPK(data)
+		}
+	}
+
 	searchReduce := InitSearchReducer(info)
 	reducedResultData, err := searchReduce.ReduceSearchResultData(ctx, searchResultData, info)
 	if err != nil {
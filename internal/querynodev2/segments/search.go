@@ -33,6 +33,9 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
 )
 
+// searchLogModule is the log module of querynode search, its level could be changed at runtime.
+const searchLogModule = "querynode.search"
+
 // searchOnSegments performs search on listed segments
 // all segment ids are validated before calling this function
 func searchSegments(ctx context.Context, mgr *Manager, segments []Segment, segType SegmentType, searchReq *SearchRequest) ([]*SearchResult, error) {
@@ -49,6 +52,13 @@ func searchSegments(ctx context.Context, mgr *Manager, segments []Segment, segTy
 		if err != nil {
 			return err
 		}
+		if ctx.Err() != nil {
+			// the request is cancelled while segcore is searching, the result is useless
+			metrics.QueryNodeCancelledSegmentSearchCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
+				metrics.SearchLabel, searchLabel).Inc()
+			DeleteSearchResults([]*SearchResult{searchResult})
+			return ctx.Err()
+		}
 		resultCh <- searchResult
 		// update metrics
 		elapsed := tr.ElapseSpan().Milliseconds()
@@ -92,6 +102,9 @@ func searchSegments(ctx context.Context, mgr *Manager, segments []Segment, segTy
 				}
 				return err
 			}
+			if err = loadLazyIndex(ctx, mgr, seg, searchReq.SearchFieldID()); err != nil {
+				return err
+			}
 			return searcher(ctx, seg)
 		})
 	}
@@ -109,7 +122,7 @@ func searchSegments(ctx context.Context, mgr *Manager, segments []Segment, segTy
 	}
 
 	if len(segmentsWithoutIndex) > 0 {
-		log.Ctx(ctx).Debug("search growing/sealed segments without indexes", zap.Int64s("segmentIDs", segmentsWithoutIndex))
+		log.Module(ctx, searchLogModule).Debug("search growing/sealed segments without indexes", zap.Int64s("segmentIDs", segmentsWithoutIndex))
 	}
 
 	return searchResults, nil
@@ -135,6 +148,13 @@ func searchSegmentsStreamly(ctx context.Context,
 		if searchErr != nil {
 			return searchErr
 		}
+		if ctx.Err() != nil {
+			// the request is cancelled while segcore is searching, skip the reduce
+			metrics.QueryNodeCancelledSegmentSearchCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
+				metrics.SearchLabel, searchLabel).Inc()
+			DeleteSearchResults([]*SearchResult{searchResult})
+			return ctx.Err()
+		}
 		reduceMutex.Lock()
 		searchResultsToClear = append(searchResultsToClear, searchResult)
 		reducedErr := streamReduce(searchResult)
@@ -154,7 +174,7 @@ func searchSegmentsStreamly(ctx context.Context,
 
 	// calling segment search in goroutines
 	errGroup, ctx := errgroup.WithContext(ctx)
-	log := log.Ctx(ctx)
+	log := log.Module(ctx, searchLogModule)
 	for _, segment := range segments {
 		seg := segment
 		errGroup.Go(func() error {
@@ -183,6 +203,9 @@ func searchSegmentsStreamly(ctx context.Context,
 				log.Debug("after doing stream search in DiskCache", zap.Int64("segID", seg.ID()), zap.Error(err))
 				return err
 			}
+			if err = loadLazyIndex(ctx, mgr, seg, searchReq.SearchFieldID()); err != nil {
+				return err
+			}
 			return searcher(ctx, seg)
 		})
 	}
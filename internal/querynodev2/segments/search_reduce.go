@@ -10,6 +10,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/conc"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -209,9 +210,81 @@ func (sbr *SearchGroupByReduce) ReduceSearchResultData(ctx context.Context, sear
 	return ret, nil
 }
 
+// SearchParallelReduce reduces the search results of very large topK in a merge tree,
+// the results are merged in pairs concurrently level by level rather than all at once.
+// The intermediate results exceeding the memory budget are spilled into the local storage.
+type SearchParallelReduce struct{}
+
+func (spr *SearchParallelReduce) ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, info *reduce.ResultInfo) (*schemapb.SearchResultData, error) {
+	if len(searchResultData) <= 2 {
+		return (&SearchCommonReduce{}).ReduceSearchResultData(ctx, searchResultData, info)
+	}
+	ctx, sp := otel.Tracer(typeutil.QueryNodeRole).Start(ctx, "ParallelReduceSearchResultData")
+	defer sp.End()
+
+	spiller := newReduceSpiller(paramtable.Get().QueryNodeCfg.ReduceSpillThreshold.GetAsSize())
+	defer spiller.Close()
+
+	level := make([]*spillableResult, 0, len(searchResultData))
+	for _, data := range searchResultData {
+		level = append(level, &spillableResult{data: data})
+	}
+	depth := 0
+	for len(level) > 1 {
+		next := make([]*spillableResult, (len(level)+1)/2)
+		futures := make([]*conc.Future[any], 0, len(level)/2)
+		for i := 0; i+1 < len(level); i += 2 {
+			left, right, idx := level[i], level[i+1], i/2
+			futures = append(futures, GetReducePool().Submit(func() (any, error) {
+				merged, err := spr.merge(ctx, spiller, left, right, info)
+				if err != nil {
+					return nil, err
+				}
+				next[idx] = merged
+				return nil, nil
+			}))
+		}
+		if len(level)%2 == 1 {
+			next[len(next)-1] = level[len(level)-1]
+		}
+		if err := conc.AwaitAll(futures...); err != nil {
+			return nil, err
+		}
+		level = next
+		depth++
+	}
+	log.Ctx(ctx).Debug("parallel reduce search results done",
+		zap.Int("resultNum", len(searchResultData)),
+		zap.Int("depth", depth),
+		zap.Int64("spilledNum", spiller.SpilledNum()))
+	return spiller.Load(level[0])
+}
+
+// merge reduces the two intermediate results into one.
+func (spr *SearchParallelReduce) merge(ctx context.Context, spiller *reduceSpiller, left, right *spillableResult, info *reduce.ResultInfo) (*spillableResult, error) {
+	leftData, err := spiller.Load(left)
+	if err != nil {
+		return nil, err
+	}
+	rightData, err := spiller.Load(right)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := (&SearchCommonReduce{}).ReduceSearchResultData(ctx, []*schemapb.SearchResultData{leftData, rightData}, info)
+	if err != nil {
+		return nil, err
+	}
+	spiller.Release(left)
+	spiller.Release(right)
+	return spiller.Hold(merged)
+}
+
 func InitSearchReducer(info *reduce.ResultInfo) SearchReduce {
 	if info.GetGroupByFieldId() > 0 {
 		return &SearchGroupByReduce{}
 	}
+	if threshold := paramtable.Get().QueryNodeCfg.ParallelReduceTopKThreshold.GetAsInt64(); threshold > 0 && info.GetTopK() >= threshold {
+		return &SearchParallelReduce{}
+	}
 	return &SearchCommonReduce{}
 }
@@ -2,6 +2,8 @@ package segments
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -10,6 +12,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mocks/util/mock_segcore"
 	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 type SearchReduceSuite struct {
@@ -254,6 +257,57 @@ func (suite *SearchReduceSuite) TestResult_SearchGroupByResult() {
 	})
 }
 
+func (suite *SearchReduceSuite) TestResult_ParallelReduceSearchResultData() {
+	const (
+		nq   = 1
+		topk = 4
+	)
+	pt := paramtable.Get()
+	pt.Save(pt.LocalStorageCfg.Path.Key, suite.T().TempDir())
+	pt.Save(pt.QueryNodeCfg.ParallelReduceTopKThreshold.Key, "4")
+	defer pt.Reset(pt.LocalStorageCfg.Path.Key)
+	defer pt.Reset(pt.QueryNodeCfg.ParallelReduceTopKThreshold.Key)
+
+	genDataArray := func() []*schemapb.SearchResultData {
+		return []*schemapb.SearchResultData{
+			mock_segcore.GenSearchResultData(nq, topk, []int64{1, 2, 3, 4}, []float32{-1.0, -2.0, -3.0, -4.0}, []int64{4}),
+			mock_segcore.GenSearchResultData(nq, topk, []int64{5, 1, 3, 4}, []float32{-1.0, -1.0, -3.0, -4.0}, []int64{4}),
+			mock_segcore.GenSearchResultData(nq, topk, []int64{6, 7}, []float32{-0.5, -2.5}, []int64{2}),
+			mock_segcore.GenSearchResultData(nq, topk, []int64{8, 9, 10}, []float32{-1.5, -3.5, -5.0}, []int64{3}),
+			mock_segcore.GenSearchResultData(nq, topk, []int64{11}, []float32{-0.1}, []int64{1}),
+		}
+	}
+	reduceInfo := reduce.NewReduceSearchResultInfo(nq, topk).WithGroupSize(1)
+	expected, err := (&SearchCommonReduce{}).ReduceSearchResultData(context.TODO(), genDataArray(), reduceInfo)
+	suite.Require().NoError(err)
+
+	suite.Run("in_memory", func() {
+		searchReduce := InitSearchReducer(reduceInfo)
+		suite.IsType(&SearchParallelReduce{}, searchReduce)
+		res, err := searchReduce.ReduceSearchResultData(context.TODO(), genDataArray(), reduceInfo)
+		suite.NoError(err)
+		suite.Equal([]int64{11, 6, 1, 5}, res.Ids.GetIntId().Data)
+		suite.Equal(expected.Ids.GetIntId().Data, res.Ids.GetIntId().Data)
+		suite.Equal(expected.Scores, res.Scores)
+		suite.Equal(expected.Topks, res.Topks)
+	})
+
+	suite.Run("spill", func() {
+		pt.Save(pt.QueryNodeCfg.ReduceSpillThreshold.Key, "1")
+		defer pt.Reset(pt.QueryNodeCfg.ReduceSpillThreshold.Key)
+
+		res, err := InitSearchReducer(reduceInfo).ReduceSearchResultData(context.TODO(), genDataArray(), reduceInfo)
+		suite.NoError(err)
+		suite.Equal(expected.Ids.GetIntId().Data, res.Ids.GetIntId().Data)
+		suite.Equal(expected.Scores, res.Scores)
+
+		// all the spilled files should be removed after reducing.
+		entries, err := os.ReadDir(filepath.Join(pt.LocalStorageCfg.Path.GetValue(), typeutil.QueryNodeRole, "reduce_spill"))
+		suite.NoError(err)
+		suite.Empty(entries)
+	})
+}
+
 func TestSearchReduce(t *testing.T) {
 	paramtable.Init()
 	suite.Run(t, new(SearchReduceSuite))
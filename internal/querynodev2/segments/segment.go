@@ -320,6 +320,7 @@ type LocalSegment struct {
 	lastDeltaTimestamp *atomic.Uint64
 	fields             *typeutil.ConcurrentMap[int64, *FieldInfo]
 	fieldIndexes       *typeutil.ConcurrentMap[int64, *IndexedFieldInfo] // indexID -> IndexedFieldInfo
+	lazyIndexes        *typeutil.ConcurrentMap[int64, *lazyIndex]        // fieldID -> lazyIndex
 	fieldJSONStats     map[int64]*querypb.JsonStatsInfo
 }
 
@@ -362,6 +363,13 @@ func NewSegment(ctx context.Context,
 		zap.String("level", loadInfo.GetLevel().String()),
 	)
 
+	// the lazy indexes are not passed to segcore, they're loaded by LoadLazyIndex later
+	cLoadInfo := loadInfo
+	var lazyIndexInfos []*querypb.FieldIndexInfo
+	if isIndexLazyLoad(collection, segmentType) {
+		cLoadInfo, lazyIndexInfos = splitLazyIndexes(loadInfo, collection.Schema())
+	}
+
 	var csegment segcore.CSegment
 	if _, err := GetDynamicPool().Submit(func() (any, error) {
 		var err error
@@ -370,7 +378,7 @@ func NewSegment(ctx context.Context,
 			SegmentID:   loadInfo.GetSegmentID(),
 			SegmentType: segmentType,
 			IsSorted:    loadInfo.GetIsSorted(),
-			LoadInfo:    loadInfo,
+			LoadInfo:    cLoadInfo,
 		})
 		return nil, err
 	}).Await(); err != nil {
@@ -388,6 +396,7 @@ func NewSegment(ctx context.Context,
 		lastDeltaTimestamp: atomic.NewUint64(0),
 		fields:             typeutil.NewConcurrentMap[int64, *FieldInfo](),
 		fieldIndexes:       typeutil.NewConcurrentMap[int64, *IndexedFieldInfo](),
+		lazyIndexes:        typeutil.NewConcurrentMap[int64, *lazyIndex](),
 		fieldJSONStats:     make(map[int64]*querypb.JsonStatsInfo),
 
 		memSize:     atomic.NewInt64(-1),
@@ -396,6 +405,9 @@ func NewSegment(ctx context.Context,
 		insertCount: atomic.NewInt64(0),
 	}
 
+	for _, info := range lazyIndexInfos {
+		segment.lazyIndexes.Insert(info.GetFieldID(), &lazyIndex{info: info})
+	}
 	if err := segment.initializeSegment(); err != nil {
 		csegment.Release()
 		return nil, err
@@ -822,6 +834,7 @@ func (s *LocalSegment) LoadMultiFieldData(ctx context.Context) error {
 	req := &segcore.LoadFieldDataRequest{
 		RowCount:       rowCount,
 		StorageVersion: loadInfo.StorageVersion,
+		WarmupPolicy:   prefetchWarmupPolicy(loadInfo),
 	}
 	for _, field := range fields {
 		req.Fields = append(req.Fields, segcore.LoadFieldDataInfo{
@@ -883,6 +896,7 @@ func (s *LocalSegment) LoadFieldData(ctx context.Context, fieldID int64, rowCoun
 		}},
 		RowCount:       rowCount,
 		StorageVersion: s.LoadInfo().GetStorageVersion(),
+		WarmupPolicy:   prefetchWarmupPolicy(s.LoadInfo()),
 	}
 
 	if len(warmupPolicy) > 0 {
@@ -1024,6 +1038,15 @@ func (s *LocalSegment) LoadDeltaData(ctx context.Context, deltaData *storage.Del
 	return nil
 }
 
+// prefetchWarmupPolicy returns the warmup policy overriding the configured one for the segment,
+// the prefetched segment fetches all its files into the cache synchronously while loading.
+func prefetchWarmupPolicy(loadInfo *querypb.SegmentLoadInfo) string {
+	if loadInfo.GetPrefetch() {
+		return "sync"
+	}
+	return ""
+}
+
 func GetCLoadInfoWithFunc(ctx context.Context,
 	fieldSchema *schemapb.FieldSchema,
 	loadInfo *querypb.SegmentLoadInfo,
@@ -1073,6 +1096,7 @@ func GetCLoadInfoWithFunc(ctx context.Context,
 		IndexStoreVersion:  indexInfo.GetIndexStoreVersion(),
 		IndexFileSize:      indexInfo.GetIndexSize(),
 		NumRows:            indexInfo.GetNumRows(),
+		WarmupPolicy:       prefetchWarmupPolicy(loadInfo),
 	}
 
 	// 2.
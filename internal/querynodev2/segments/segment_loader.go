@@ -55,6 +55,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v2/util/conc"
 	"github.com/milvus-io/milvus/pkg/v2/util/contextutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/faultinject"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/hardware"
 	"github.com/milvus-io/milvus/pkg/v2/util/indexparams"
@@ -103,6 +104,9 @@ type Loader interface {
 	LoadJSONIndex(ctx context.Context,
 		segment Segment,
 		info *querypb.SegmentLoadInfo) error
+
+	// LoadLazyIndex loads the index of the field which is deferred at the segment loading.
+	LoadLazyIndex(ctx context.Context, segment Segment, fieldID int64) error
 }
 
 type ResourceEstimate struct {
@@ -267,6 +271,10 @@ func (loader *segmentLoader) Load(ctx context.Context,
 	for _, segmentInfo := range segments {
 		addBucketNameStorageV2(segmentInfo)
 	}
+	if err := faultinject.Inject(ctx, faultinject.QueryNodeLoadSegment); err != nil {
+		log.Warn("failed to load segments", zap.Error(err))
+		return nil, err
+	}
 
 	collection := loader.manager.Collection.Get(collectionID)
 	if collection == nil {
@@ -292,7 +300,14 @@ func (loader *segmentLoader) Load(ctx context.Context,
 	if !isLazyLoad(collection, segmentType) {
 		// Check memory & storage limit
 		// no need to check resource for lazy load here
-		requestResourceResult, err = loader.requestResource(ctx, infos...)
+		resourceInfos := infos
+		if isIndexLazyLoad(collection, segmentType) {
+			resourceInfos = lo.Map(infos, func(info *querypb.SegmentLoadInfo, _ int) *querypb.SegmentLoadInfo {
+				info, _ = splitLazyIndexes(info, collection.Schema())
+				return info
+			})
+		}
+		requestResourceResult, err = loader.requestResource(ctx, resourceInfos...)
 		if err != nil {
 			log.Warn("request resource failed", zap.Error(err))
 			return nil, err
@@ -407,6 +422,9 @@ func (loader *segmentLoader) Load(ctx context.Context,
 		newSegments.GetAndRemove(segmentID)
 		loaded.Insert(segmentID, segment)
 		loader.notifyLoadFinish(loadInfo)
+		if s, ok := segment.(*LocalSegment); ok {
+			loader.warmupLazyIndexes(s)
+		}
 
 		metrics.QueryNodeLoadSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(tr.ElapseSpan().Milliseconds()))
 		return nil
@@ -1293,23 +1311,28 @@ func (loader *segmentLoader) loadBloomFilter(ctx context.Context, segmentID int6
 		blobs = append(blobs, &storage.Blob{Value: values[i]})
 	}
 
-	var stats []*storage.PrimaryKeyStats
+	// deserialize the stats one by one, so that each raw buffer could be released once parsed
+	var it *storage.StatsIterator
 	if logType == storage.CompoundStatsType {
-		stats, err = storage.DeserializeStatsList(blobs[0])
+		it, err = storage.NewStatsListIterator(blobs[0])
 		if err != nil {
 			log.Warn("failed to deserialize stats list", zap.Error(err))
 			return err
 		}
 	} else {
-		stats, err = storage.DeserializeStats(blobs)
+		it = storage.NewStatsIterator(blobs)
+	}
+
+	var size uint
+	for {
+		stat, err := it.Next()
+		if err == io.EOF {
+			break
+		}
 		if err != nil {
 			log.Warn("failed to deserialize stats", zap.Error(err))
 			return err
 		}
-	}
-
-	var size uint
-	for _, stat := range stats {
 		pkStat := &storage.PkStatistics{
 			PkFilter: stat.BF,
 			MinPK:    stat.MinPk,
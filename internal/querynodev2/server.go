@@ -42,6 +42,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/tidwall/gjson"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -69,6 +70,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgdispatcher"
+	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/util/expr"
@@ -143,6 +145,16 @@ type QueryNode struct {
 	lastModifyTs   int64
 
 	metricsRequest *metricsinfo.MetricsRequest
+
+	// collection id -> the access state of the collection
+	collectionAccess *typeutil.ConcurrentMap[int64, *collectionAccess]
+
+	// request seq -> the mvcc timestamp pinned by the running search or query request
+	pinnedTimestamps *typeutil.ConcurrentMap[int64, uint64]
+	pinSeq           atomic.Int64
+
+	// samples the io wait between the heartbeats
+	ioWaitSampler ioWaitSampler
 }
 
 // NewQueryNode will return a QueryNode with abnormal state.
@@ -154,6 +166,9 @@ func NewQueryNode(ctx context.Context, factory dependency.Factory) *QueryNode {
 		factory:        factory,
 		lifetime:       lifetime.NewLifetime(commonpb.StateCode_Abnormal),
 		metricsRequest: metricsinfo.NewMetricsRequest(),
+
+		collectionAccess: typeutil.NewConcurrentMap[int64, *collectionAccess](),
+		pinnedTimestamps: typeutil.NewConcurrentMap[int64, uint64](),
 	}
 
 	expr.Register("querynode", node)
@@ -272,6 +287,16 @@ func (node *QueryNode) registerMetricsRequest() {
 			collectionID := metricsinfo.GetCollectionIDFromRequest(jsonReq)
 			return getChannelJSON(node, collectionID), nil
 		})
+
+	node.metricsRequest.RegisterMetricsRequest(metricsinfo.CollectionAccessKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			return getCollectionAccessJSON(node), nil
+		})
+
+	node.metricsRequest.RegisterMetricsRequest(metricsinfo.ConsumerLagKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			return msgstream.GetConsumerLagsJSON()
+		})
 	log.Ctx(node.ctx).Info("register metrics actions finished")
 }
 
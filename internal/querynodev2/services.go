@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querynodev2/tasks"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/analyzer"
+	"github.com/milvus-io/milvus/internal/util/componentutil"
 	"github.com/milvus-io/milvus/internal/util/searchutil/scheduler"
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/v2/common"
@@ -758,6 +759,7 @@ func (node *QueryNode) SearchSegments(ctx context.Context, req *querypb.SearchRe
 		return resp, nil
 	}
 	defer node.lifetime.Done()
+	defer node.pinTimestamp(req.GetReq())()
 
 	metrics.QueryNodeSQCount.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.SearchLabel, metrics.TotalLabel, metrics.FromLeader, fmt.Sprint(req.GetReq().GetCollectionID())).Inc()
 	defer func() {
@@ -812,7 +814,7 @@ func (node *QueryNode) SearchSegments(ctx context.Context, req *querypb.SearchRe
 	))
 
 	latency := tr.ElapseSpan()
-	metrics.QueryNodeSQReqLatency.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.SearchLabel, metrics.FromLeader).Observe(float64(latency.Milliseconds()))
+	metrics.ObserveWithTrace(ctx, metrics.QueryNodeSQReqLatency.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.SearchLabel, metrics.FromLeader), float64(latency.Milliseconds()))
 	metrics.QueryNodeSQCount.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.SearchLabel, metrics.SuccessLabel, metrics.FromLeader, fmt.Sprint(req.GetReq().GetCollectionID())).Inc()
 
 	resp = task.SearchResult()
@@ -846,6 +848,7 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 		}, nil
 	}
 	defer node.lifetime.Done()
+	defer node.pinTimestamp(req.GetReq())()
 
 	resp := &internalpb.SearchResults{
 		Status: merr.Success(),
@@ -855,6 +858,7 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 		resp.Status = merr.Status(merr.WrapErrCollectionNotLoaded(req.GetReq().GetCollectionID()))
 		return resp, nil
 	}
+	defer node.recordCollectionAccess(req.GetReq().GetCollectionID())()
 
 	if len(req.GetDmlChannels()) != 1 {
 		err := merr.WrapErrParameterInvalid(1, len(req.GetDmlChannels()), "count of channel to be searched should only be 1, wrong code")
@@ -913,6 +917,7 @@ func (node *QueryNode) QuerySegments(ctx context.Context, req *querypb.QueryRequ
 		return resp, nil
 	}
 	defer node.lifetime.Done()
+	defer node.pinTimestamp(req.GetReq())()
 
 	metrics.QueryNodeSQCount.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.QueryLabel, metrics.TotalLabel, metrics.FromLeader, fmt.Sprint(req.GetReq().GetCollectionID())).Inc()
 	defer func() {
@@ -959,7 +964,7 @@ func (node *QueryNode) QuerySegments(ctx context.Context, req *querypb.QueryRequ
 
 	// TODO QueryNodeSQLatencyInQueue QueryNodeReduceLatency
 	latency := tr.ElapseSpan()
-	metrics.QueryNodeSQReqLatency.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.QueryLabel, metrics.FromLeader).Observe(float64(latency.Milliseconds()))
+	metrics.ObserveWithTrace(ctx, metrics.QueryNodeSQReqLatency.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.QueryLabel, metrics.FromLeader), float64(latency.Milliseconds()))
 	metrics.QueryNodeSQCount.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.QueryLabel, metrics.SuccessLabel, metrics.FromLeader, fmt.Sprint(req.GetReq().GetCollectionID())).Inc()
 	result := task.Result()
 	result.GetCostAggregation().ResponseTime = latency.Milliseconds()
@@ -989,6 +994,7 @@ func (node *QueryNode) Query(ctx context.Context, req *querypb.QueryRequest) (*i
 		}, nil
 	}
 	defer node.lifetime.Done()
+	defer node.pinTimestamp(req.GetReq())()
 
 	toMergeResults := make([]*internalpb.RetrieveResults, len(req.GetDmlChannels()))
 	runningGp, runningCtx := errgroup.WithContext(ctx)
@@ -999,6 +1005,7 @@ func (node *QueryNode) Query(ctx context.Context, req *querypb.QueryRequest) (*i
 			Status: merr.Status(err),
 		}, nil
 	}
+	defer node.recordCollectionAccess(req.GetReq().GetCollectionID())()
 	defer func() {
 		node.manager.Collection.Unref(req.GetReq().GetCollectionID(), 1)
 	}()
@@ -1078,6 +1085,8 @@ func (node *QueryNode) QueryStream(req *querypb.QueryRequest, srv querypb.QueryN
 		return nil
 	}
 	defer node.lifetime.Done()
+	defer node.pinTimestamp(req.GetReq())()
+	defer node.recordCollectionAccess(req.GetReq().GetCollectionID())()
 
 	runningGp, runningCtx := errgroup.WithContext(ctx)
 
@@ -1138,6 +1147,7 @@ func (node *QueryNode) QueryStreamSegments(req *querypb.QueryRequest, srv queryp
 		return nil
 	}
 	defer node.lifetime.Done()
+	defer node.pinTimestamp(req.GetReq())()
 
 	log.Debug("start do query with channel", zap.Int64s("segmentIDs", req.GetSegmentIDs()))
 
@@ -1158,7 +1168,7 @@ func (node *QueryNode) QueryStreamSegments(req *querypb.QueryRequest, srv queryp
 
 	// TODO QueryNodeSQLatencyInQueue QueryNodeReduceLatency
 	latency := tr.ElapseSpan()
-	metrics.QueryNodeSQReqLatency.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.QueryLabel, metrics.FromLeader).Observe(float64(latency.Milliseconds()))
+	metrics.ObserveWithTrace(ctx, metrics.QueryNodeSQReqLatency.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.QueryLabel, metrics.FromLeader), float64(latency.Milliseconds()))
 	metrics.QueryNodeSQCount.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.QueryLabel, metrics.SuccessLabel, metrics.FromLeader, fmt.Sprint(req.GetReq().GetCollectionID())).Inc()
 	return nil
 }
@@ -1183,18 +1193,9 @@ func (node *QueryNode) ShowConfigurations(ctx context.Context, req *internalpb.S
 	}
 	defer node.lifetime.Done()
 
-	configList := make([]*commonpb.KeyValuePair, 0)
-	for key, value := range paramtable.Get().GetComponentConfigurations("querynode", req.Pattern) {
-		configList = append(configList,
-			&commonpb.KeyValuePair{
-				Key:   key,
-				Value: value,
-			})
-	}
-
 	return &internalpb.ShowConfigurationsResponse{
 		Status:        merr.Success(),
-		Configuations: configList,
+		Configuations: componentutil.ShowConfigurations("querynode", req),
 	}, nil
 }
 
@@ -1254,7 +1255,7 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 
 	if !distributionChange() {
 		return &querypb.GetDataDistributionResponse{
-			Status:       merr.Success(),
+			Status:       node.heartbeatStatus(),
 			NodeID:       node.GetNodeID(),
 			LastModifyTs: lastModifyTs,
 		}, nil
@@ -1333,7 +1334,7 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 	})
 
 	return &querypb.GetDataDistributionResponse{
-		Status:          merr.Success(),
+		Status:          node.heartbeatStatus(),
 		NodeID:          node.GetNodeID(),
 		Segments:        segmentVersionInfos,
 		Channels:        channelVersionInfos,
@@ -0,0 +1,217 @@
+package tasks
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/querynodev2/segments"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/hardware"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	// the estimated size of an item of the search result, which is the id, the distance and the offset.
+	searchResultItemSize = 24
+)
+
+var (
+	memoryTracker     *MemoryTracker
+	memoryTrackerOnce sync.Once
+)
+
+// GetMemoryTracker returns the memory tracker of the search/query tasks of the node.
+func GetMemoryTracker() *MemoryTracker {
+	memoryTrackerOnce.Do(func() {
+		memoryTracker = NewMemoryTracker(int64(hardware.GetMemoryCount()))
+	})
+	return memoryTracker
+}
+
+// MemoryTracker tracks the estimated memory of the running search/query tasks,
+// and cancels the most expensive task if the total exceeds the limit of the node,
+// so that the node is not OOM killed by a few heavy requests.
+type MemoryTracker struct {
+	mu          sync.Mutex
+	totalMemory int64
+	nextID      int64
+	used        int64
+	accounts    map[int64]*MemoryAccount
+}
+
+// NewMemoryTracker creates a memory tracker with the total memory of the node.
+func NewMemoryTracker(totalMemory int64) *MemoryTracker {
+	return &MemoryTracker{
+		totalMemory: totalMemory,
+		accounts:    make(map[int64]*MemoryAccount),
+	}
+}
+
+// Register registers a running task, the cancel function is invoked with the cause if the task is killed.
+func (m *MemoryTracker) Register(name string, cancel context.CancelCauseFunc) *MemoryAccount {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextID++
+	account := &MemoryAccount{
+		tracker: m,
+		id:      m.nextID,
+		name:    name,
+		cancel:  cancel,
+	}
+	m.accounts[account.id] = account
+	return account
+}
+
+// Used returns the estimated memory of all the running tasks.
+func (m *MemoryTracker) Used() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.used
+}
+
+func (m *MemoryTracker) limit() int64 {
+	ratio := paramtable.Get().QueryNodeCfg.QueryMemoryLimitRatio.GetAsFloat()
+	if ratio <= 0 {
+		return 0
+	}
+	return int64(float64(m.totalMemory) * ratio)
+}
+
+// killExpensive cancels the most expensive tasks until the used memory is under the limit.
+func (m *MemoryTracker) killExpensive() {
+	limit := m.limit()
+	if limit <= 0 {
+		return
+	}
+	for m.used > limit {
+		var victim *MemoryAccount
+		for _, account := range m.accounts {
+			if victim == nil || account.size > victim.size {
+				victim = account
+			}
+		}
+		if victim == nil {
+			return
+		}
+		victim.killed = merr.WrapErrServiceQueryMemoryExceeded(victim.name, victim.size, m.used, limit)
+		log.Warn("cancel the most expensive task since the query memory exceeds the limit",
+			zap.String("task", victim.name),
+			zap.Int64("taskMemory", victim.size),
+			zap.Int64("usedMemory", m.used),
+			zap.Int64("limit", limit))
+		// the memory of the killed task is going to be released
+		m.remove(victim)
+		victim.cancel(victim.killed)
+	}
+}
+
+func (m *MemoryTracker) remove(account *MemoryAccount) {
+	if _, ok := m.accounts[account.id]; !ok {
+		return
+	}
+	delete(m.accounts, account.id)
+	m.used -= account.size
+}
+
+// MemoryAccount is the estimated memory of a running task.
+type MemoryAccount struct {
+	tracker *MemoryTracker
+	id      int64
+	name    string
+	cancel  context.CancelCauseFunc
+	size    int64
+	killed  error
+}
+
+// Grow adds the estimated memory to the task, it returns error if the task is killed.
+func (a *MemoryAccount) Grow(size int64) error {
+	m := a.tracker
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if a.killed != nil {
+		return a.killed
+	}
+	if _, ok := m.accounts[a.id]; !ok {
+		return nil
+	}
+	a.size += size
+	m.used += size
+	m.killExpensive()
+	return a.killed
+}
+
+// Reconcile replaces the reserved memory of the task with the actual one once it's known,
+// it returns error if the task is killed.
+func (a *MemoryAccount) Reconcile(size int64) error {
+	m := a.tracker
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if a.killed != nil {
+		return a.killed
+	}
+	if _, ok := m.accounts[a.id]; !ok {
+		return nil
+	}
+	delta := size - a.size
+	a.size = size
+	m.used += delta
+	if delta > 0 {
+		m.killExpensive()
+	}
+	return a.killed
+}
+
+// Err returns the error if the task is killed.
+func (a *MemoryAccount) Err() error {
+	a.tracker.mu.Lock()
+	defer a.tracker.mu.Unlock()
+	return a.killed
+}
+
+// Cause returns the error of the kill if the task is killed, otherwise the err itself.
+func (a *MemoryAccount) Cause(err error) error {
+	if killed := a.Err(); killed != nil {
+		return killed
+	}
+	return err
+}
+
+// Release releases the memory of the task once it's done.
+func (a *MemoryAccount) Release() {
+	a.tracker.mu.Lock()
+	defer a.tracker.mu.Unlock()
+	a.tracker.remove(a)
+}
+
+// candidateSegments returns the segments that the request is going to read,
+// which are used to reserve the memory of the task before the execution.
+func candidateSegments(manager *segments.Manager, collectionID int64, scope querypb.DataScope, segmentIDs []int64) []segments.Segment {
+	filters := []segments.SegmentFilter{segments.WithType(segments.SegmentTypeSealed)}
+	switch scope {
+	case querypb.DataScope_Streaming:
+		filters = []segments.SegmentFilter{segments.WithType(segments.SegmentTypeGrowing)}
+	case querypb.DataScope_All:
+		filters = nil
+	}
+	if len(segmentIDs) > 0 {
+		filters = append(filters, segments.WithIDs(segmentIDs...))
+	} else {
+		filters = append(filters, segments.SegmentFilterFunc(func(s segments.Segment) bool {
+			return s.Collection() == collectionID
+		}))
+	}
+	return manager.Segment.GetBy(filters...)
+}
+
+// estimateBitsetSize returns the estimated size of the bitsets to filter the segments.
+func estimateBitsetSize(segs []segments.Segment) int64 {
+	var size int64
+	for _, seg := range segs {
+		size += (seg.RowNum() + 7) / 8
+	}
+	return size
+}
@@ -0,0 +1,107 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type MemoryTrackerSuite struct {
+	suite.Suite
+}
+
+func (s *MemoryTrackerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *MemoryTrackerSuite) TearDownTest() {
+	paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.QueryMemoryLimitRatio.Key)
+}
+
+func (s *MemoryTrackerSuite) TestNoLimit() {
+	tracker := NewMemoryTracker(1000)
+	ctx, cancel := context.WithCancelCause(context.Background())
+	account := tracker.Register("search-1", cancel)
+
+	s.NoError(account.Grow(2000))
+	s.EqualValues(2000, tracker.Used())
+	s.NoError(ctx.Err())
+
+	account.Release()
+	s.EqualValues(0, tracker.Used())
+}
+
+func (s *MemoryTrackerSuite) TestKillExpensive() {
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.QueryMemoryLimitRatio.Key, "0.5")
+	tracker := NewMemoryTracker(1000)
+
+	ctx1, cancel1 := context.WithCancelCause(context.Background())
+	account1 := tracker.Register("search-1", cancel1)
+	ctx2, cancel2 := context.WithCancelCause(context.Background())
+	account2 := tracker.Register("query-2", cancel2)
+
+	s.NoError(account1.Grow(300))
+	s.NoError(account2.Grow(100))
+	s.EqualValues(400, tracker.Used())
+
+	// the most expensive task is killed even though the other one grows
+	s.NoError(account2.Grow(200))
+	s.ErrorIs(account1.Err(), merr.ErrServiceQueryMemoryExceeded)
+	s.ErrorIs(context.Cause(ctx1), merr.ErrServiceQueryMemoryExceeded)
+	s.ErrorIs(account1.Cause(context.Canceled), merr.ErrServiceQueryMemoryExceeded)
+	s.ErrorIs(account1.Grow(100), merr.ErrServiceQueryMemoryExceeded)
+	s.NoError(ctx2.Err())
+	s.EqualValues(300, tracker.Used())
+
+	// release of the killed task is a no-op
+	account1.Release()
+	s.EqualValues(300, tracker.Used())
+
+	s.ErrorIs(account2.Grow(300), merr.ErrServiceQueryMemoryExceeded)
+	s.ErrorIs(context.Cause(ctx2), merr.ErrServiceQueryMemoryExceeded)
+	s.EqualValues(0, tracker.Used())
+	account2.Release()
+	s.EqualValues(0, tracker.Used())
+}
+
+func (s *MemoryTrackerSuite) TestReserveAndReconcile() {
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.QueryMemoryLimitRatio.Key, "0.5")
+	tracker := NewMemoryTracker(1000)
+
+	ctx1, cancel1 := context.WithCancelCause(context.Background())
+	account1 := tracker.Register("search-1", cancel1)
+	s.NoError(account1.Grow(300))
+
+	// the oversized reservation is killed before the execution
+	ctx2, cancel2 := context.WithCancelCause(context.Background())
+	account2 := tracker.Register("search-2", cancel2)
+	s.ErrorIs(account2.Grow(600), merr.ErrServiceQueryMemoryExceeded)
+	s.ErrorIs(context.Cause(ctx2), merr.ErrServiceQueryMemoryExceeded)
+	s.NoError(ctx1.Err())
+	s.EqualValues(300, tracker.Used())
+
+	// shrinking never kills
+	s.NoError(account1.Reconcile(100))
+	s.EqualValues(100, tracker.Used())
+
+	// growing beyond the limit kills the most expensive task
+	_, cancel3 := context.WithCancelCause(context.Background())
+	account3 := tracker.Register("query-3", cancel3)
+	s.NoError(account3.Grow(200))
+	s.ErrorIs(account1.Reconcile(400), merr.ErrServiceQueryMemoryExceeded)
+	s.ErrorIs(context.Cause(ctx1), merr.ErrServiceQueryMemoryExceeded)
+	s.EqualValues(200, tracker.Used())
+
+	account1.Release()
+	account2.Release()
+	account3.Release()
+	s.EqualValues(0, tracker.Used())
+}
+
+func TestMemoryTracker(t *testing.T) {
+	suite.Run(t, new(MemoryTrackerSuite))
+}
@@ -108,6 +108,11 @@ func (t *QueryTask) Execute() error {
 	}
 	tr := timerecord.NewTimeRecorderWithTrace(t.ctx, "QueryTask")
 
+	ctx, cancel := context.WithCancelCause(t.ctx)
+	defer cancel(nil)
+	account := GetMemoryTracker().Register(fmt.Sprintf("query-%d", t.req.GetReq().GetBase().GetMsgID()), cancel)
+	defer account.Release()
+
 	retrievePlan, err := segcore.NewRetrievePlan(
 		t.collection.GetCCollection(),
 		t.req.Req.GetSerializedExprPlan(),
@@ -121,9 +126,23 @@ func (t *QueryTask) Execute() error {
 	}
 	defer retrievePlan.Delete()
 
-	results, pinnedSegments, err := segments.Retrieve(t.ctx, t.segmentManager, retrievePlan, t.req, t.plan)
+	// reserve the bitsets of each segment before the execution,
+	// so that an oversized request is stopped before segcore allocates the memory
+	candidates := candidateSegments(t.segmentManager, t.req.GetReq().GetCollectionID(), t.req.GetScope(), t.req.GetSegmentIDs())
+	if err := account.Grow(estimateBitsetSize(candidates)); err != nil {
+		return err
+	}
+
+	results, pinnedSegments, err := segments.Retrieve(ctx, t.segmentManager, retrievePlan, t.req, t.plan)
 	defer t.segmentManager.Segment.Unpin(pinnedSegments)
 	if err != nil {
+		return account.Cause(err)
+	}
+	// reconcile the reservation with the bitsets and the retrieve results of each segment
+	estimatedSize := lo.SumBy(results, func(result segments.RetrieveSegmentResult) int64 {
+		return int64(proto.Size(result.Result)) + estimateBitsetSize([]segments.Segment{result.Segment})
+	})
+	if err := account.Reconcile(estimatedSize); err != nil {
 		return err
 	}
 
@@ -140,7 +159,7 @@ func (t *QueryTask) Execute() error {
 		reduceResults = append(reduceResults, result.Result)
 		querySegments = append(querySegments, result.Segment)
 	}
-	reducedResult, err := reducer.Reduce(t.ctx, reduceResults, querySegments, retrievePlan)
+	reducedResult, err := reducer.Reduce(ctx, reduceResults, querySegments, retrievePlan)
 
 	metrics.QueryNodeReduceLatency.WithLabelValues(
 		fmt.Sprint(paramtable.GetNodeID()),
@@ -148,7 +167,7 @@ func (t *QueryTask) Execute() error {
 		metrics.ReduceSegments,
 		metrics.BatchReduce).Observe(float64(time.Since(beforeReduce).Milliseconds()))
 	if err != nil {
-		return err
+		return account.Cause(err)
 	}
 
 	relatedDataSize := lo.Reduce(querySegments, func(acc int64, seg segments.Segment, _ int) int64 {
@@ -104,14 +104,14 @@ func (t *SearchTask) PreExecute() error {
 	inQueueDurationMS := inQueueDuration.Seconds() * 1000
 
 	// Update in queue metric for prometheus.
-	metrics.QueryNodeSQLatencyInQueue.WithLabelValues(
+	metrics.ObserveWithTrace(t.ctx, metrics.QueryNodeSQLatencyInQueue.WithLabelValues(
 		nodeID,
 		metrics.SearchLabel,
 		t.collection.GetDBName(),
 		t.collection.GetResourceGroup(),
 		// TODO: resource group and db name may be removed at runtime,
 		// should be refactor into metricsutil.observer in the future.
-	).Observe(inQueueDurationMS)
+	), inQueueDurationMS)
 
 	username := t.Username()
 	metrics.QueryNodeSQPerUserLatencyInQueue.WithLabelValues(
@@ -141,6 +141,11 @@ func (t *SearchTask) Execute() error {
 	}
 	tr := timerecord.NewTimeRecorderWithTrace(t.ctx, "SearchTask")
 
+	ctx, cancel := context.WithCancelCause(t.ctx)
+	defer cancel(nil)
+	account := GetMemoryTracker().Register(fmt.Sprintf("search-%d", t.req.GetReq().GetBase().GetMsgID()), cancel)
+	defer account.Release()
+
 	req := t.req
 	err := t.combinePlaceHolderGroups()
 	if err != nil {
@@ -152,13 +157,20 @@ func (t *SearchTask) Execute() error {
 	}
 	defer searchReq.Delete()
 
+	// reserve the bitsets and the search results of each segment before the execution,
+	// so that an oversized request is stopped before segcore allocates the memory
+	candidates := candidateSegments(t.segmentManager, req.GetReq().GetCollectionID(), req.GetScope(), req.GetSegmentIDs())
+	if err := account.Grow(estimateBitsetSize(candidates) + int64(len(candidates))*t.nq*t.topk*searchResultItemSize); err != nil {
+		return err
+	}
+
 	var (
 		results          []*segments.SearchResult
 		searchedSegments []segments.Segment
 	)
 	if req.GetScope() == querypb.DataScope_Historical {
 		results, searchedSegments, err = segments.SearchHistorical(
-			t.ctx,
+			ctx,
 			t.segmentManager,
 			searchReq,
 			req.GetReq().GetCollectionID(),
@@ -167,7 +179,7 @@ func (t *SearchTask) Execute() error {
 		)
 	} else if req.GetScope() == querypb.DataScope_Streaming {
 		results, searchedSegments, err = segments.SearchStreaming(
-			t.ctx,
+			ctx,
 			t.segmentManager,
 			searchReq,
 			req.GetReq().GetCollectionID(),
@@ -177,9 +189,13 @@ func (t *SearchTask) Execute() error {
 	}
 	defer t.segmentManager.Segment.Unpin(searchedSegments)
 	if err != nil {
-		return err
+		return account.Cause(err)
 	}
 	defer segments.DeleteSearchResults(results)
+	// reconcile the reservation with the segments actually searched
+	if err := account.Reconcile(estimateBitsetSize(searchedSegments) + int64(len(results))*t.nq*t.topk*searchResultItemSize); err != nil {
+		return err
+	}
 
 	// plan.MetricType is accurate, though req.MetricType may be empty
 	metricType := searchReq.Plan().GetMetricType()
@@ -215,9 +231,14 @@ func (t *SearchTask) Execute() error {
 		return acc + segments.GetSegmentRelatedDataSize(seg)
 	}, 0)
 
+	// the segment search may complete after the request is cancelled, skip the reduce then
+	if ctx.Err() != nil {
+		return account.Cause(ctx.Err())
+	}
+
 	tr.RecordSpan()
 	blobs, err := segcore.ReduceSearchResultsAndFillData(
-		t.ctx,
+		ctx,
 		searchReq.Plan(),
 		results,
 		int64(len(results)),
@@ -226,7 +247,7 @@ func (t *SearchTask) Execute() error {
 	)
 	if err != nil {
 		log.Warn("failed to reduce search results", zap.Error(err))
-		return err
+		return account.Cause(err)
 	}
 	defer segcore.DeleteSearchResultDataBlobs(blobs)
 	metrics.QueryNodeReduceLatency.WithLabelValues(
@@ -248,6 +269,9 @@ func (t *SearchTask) Execute() error {
 			task = t.others[i-1]
 		}
 
+		if err := account.Grow(int64(len(blob))); err != nil {
+			return err
+		}
 		// Note: blob is unsafe because get from C
 		bs := make([]byte, len(blob))
 		copy(bs, blob)
@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	kvmetastore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// CollectionTemplatePrefix prefix for the collection templates
+const CollectionTemplatePrefix = kvmetastore.ComponentPrefix + "/collection-template"
+
+const maxCollectionTemplateNameLength = 255
+
+// collectionTemplateRegistry keeps the named collection templates, which are persisted in the metastore.
+type collectionTemplateRegistry struct {
+	ctx       context.Context
+	kv        kv.TxnKV
+	mu        sync.RWMutex
+	templates map[string]*rootcoordpb.CollectionTemplate
+}
+
+func newCollectionTemplateRegistry(ctx context.Context, kv kv.TxnKV) *collectionTemplateRegistry {
+	return &collectionTemplateRegistry{
+		ctx:       ctx,
+		kv:        kv,
+		templates: make(map[string]*rootcoordpb.CollectionTemplate),
+	}
+}
+
+// recover loads the persisted collection templates.
+func (r *collectionTemplateRegistry) recover() error {
+	_, values, err := r.kv.LoadWithPrefix(r.ctx, CollectionTemplatePrefix)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, value := range values {
+		template := &rootcoordpb.CollectionTemplate{}
+		if err := proto.Unmarshal([]byte(value), template); err != nil {
+			log.Ctx(r.ctx).Warn("failed to unmarshal collection template, skip it", zap.Error(err))
+			continue
+		}
+		r.templates[template.GetName()] = template
+	}
+	log.Ctx(r.ctx).Info("recover collection templates done", zap.Int("num", len(r.templates)))
+	return nil
+}
+
+// Create validates and persists a new collection template.
+func (r *collectionTemplateRegistry) Create(ctx context.Context, template *rootcoordpb.CollectionTemplate) error {
+	if err := validateCollectionTemplate(template); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.templates[template.GetName()]; ok {
+		return merr.WrapErrParameterInvalidMsg("collection template %s already exists", template.GetName())
+	}
+	now := time.Now().UnixMilli()
+	template.CreateTime = now
+	template.UpdateTime = now
+	value, err := proto.Marshal(template)
+	if err != nil {
+		return err
+	}
+	if err := r.kv.Save(ctx, buildCollectionTemplateKey(template.GetName()), string(value)); err != nil {
+		return err
+	}
+	r.templates[template.GetName()] = template
+	return nil
+}
+
+// Get returns the collection template by name.
+func (r *collectionTemplateRegistry) Get(name string) (*rootcoordpb.CollectionTemplate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	template, ok := r.templates[name]
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("collection template %s not found", name)
+	}
+	return template, nil
+}
+
+// List returns all the collection templates ordered by name.
+func (r *collectionTemplateRegistry) List() []*rootcoordpb.CollectionTemplate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	templates := lo.Values(r.templates)
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].GetName() < templates[j].GetName()
+	})
+	return templates
+}
+
+// Drop removes the collection template, the collections created from it are not affected.
+func (r *collectionTemplateRegistry) Drop(ctx context.Context, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.templates[name]; !ok {
+		return merr.WrapErrParameterInvalidMsg("collection template %s not found", name)
+	}
+	if err := r.kv.Remove(ctx, buildCollectionTemplateKey(name)); err != nil {
+		return err
+	}
+	delete(r.templates, name)
+	return nil
+}
+
+// validateCollectionTemplate checks the name, the schema skeleton and the default indexes of the template.
+func validateCollectionTemplate(template *rootcoordpb.CollectionTemplate) error {
+	if template.GetName() == "" || len(template.GetName()) > maxCollectionTemplateNameLength {
+		return merr.WrapErrParameterInvalidMsg("the length of collection template name should be in (0, %d]", maxCollectionTemplateNameLength)
+	}
+	for _, ch := range template.GetName() {
+		if ch != '_' && ch != '-' && !(ch >= '0' && ch <= '9') && !(ch >= 'a' && ch <= 'z') && !(ch >= 'A' && ch <= 'Z') {
+			return merr.WrapErrParameterInvalidMsg("collection template name %s can only contain letters, numbers, underscores and dashes", template.GetName())
+		}
+	}
+
+	fieldNames := typeutil.NewSet[string]()
+	schema := template.GetSchema()
+	if schema != nil {
+		if len(schema.GetFields()) == 0 {
+			return merr.WrapErrParameterInvalidMsg("schema of collection template %s has no field", template.GetName())
+		}
+		for _, field := range typeutil.GetAllFieldSchemas(schema) {
+			fieldNames.Insert(field.GetName())
+		}
+	}
+	for _, index := range template.GetIndexParams() {
+		if index.GetFieldName() == "" {
+			return merr.WrapErrParameterInvalidMsg("field name of the index of collection template %s is empty", template.GetName())
+		}
+		if schema != nil && !fieldNames.Contain(index.GetFieldName()) {
+			return merr.WrapErrParameterInvalidMsg("index field %s not found in the schema of collection template %s", index.GetFieldName(), template.GetName())
+		}
+	}
+	return nil
+}
+
+func buildCollectionTemplateKey(name string) string {
+	return fmt.Sprintf("%s/%s", CollectionTemplatePrefix, name)
+}
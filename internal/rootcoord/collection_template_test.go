@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func newTestCollectionTemplate(name string) *rootcoordpb.CollectionTemplate {
+	return &rootcoordpb.CollectionTemplate{
+		Name: name,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			},
+		},
+		IndexParams: []*rootcoordpb.CollectionTemplateIndex{
+			{FieldName: "vec", Params: []*commonpb.KeyValuePair{{Key: "index_type", Value: "HNSW"}, {Key: "metric_type", Value: "L2"}}},
+		},
+		Properties: []*commonpb.KeyValuePair{{Key: "mmap.enabled", Value: "true"}},
+	}
+}
+
+func TestCollectionTemplateRegistry(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	t.Run("create get list and drop", func(t *testing.T) {
+		kv := memkv.NewMemoryKV()
+		r := newCollectionTemplateRegistry(ctx, kv)
+		require.NoError(t, r.recover())
+
+		assert.NoError(t, r.Create(ctx, newTestCollectionTemplate("t2")))
+		assert.NoError(t, r.Create(ctx, newTestCollectionTemplate("t1")))
+		err := r.Create(ctx, newTestCollectionTemplate("t1"))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		template, err := r.Get("t1")
+		assert.NoError(t, err)
+		assert.Equal(t, "true", template.GetProperties()[0].GetValue())
+		assert.NotZero(t, template.GetCreateTime())
+
+		templates := r.List()
+		assert.Len(t, templates, 2)
+		assert.Equal(t, "t1", templates[0].GetName())
+		assert.Equal(t, "t2", templates[1].GetName())
+
+		// templates survive the restart of rootcoord.
+		r2 := newCollectionTemplateRegistry(ctx, kv)
+		require.NoError(t, r2.recover())
+		assert.Len(t, r2.List(), 2)
+		template, err = r2.Get("t1")
+		assert.NoError(t, err)
+		assert.Len(t, template.GetSchema().GetFields(), 2)
+
+		assert.NoError(t, r.Drop(ctx, "t1"))
+		_, err = r.Get("t1")
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		assert.ErrorIs(t, r.Drop(ctx, "t1"), merr.ErrParameterInvalid)
+
+		r3 := newCollectionTemplateRegistry(ctx, kv)
+		require.NoError(t, r3.recover())
+		assert.Len(t, r3.List(), 1)
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		r := newCollectionTemplateRegistry(ctx, memkv.NewMemoryKV())
+
+		assert.Error(t, r.Create(ctx, newTestCollectionTemplate("")))
+		assert.Error(t, r.Create(ctx, newTestCollectionTemplate("bad name")))
+
+		template := newTestCollectionTemplate("t")
+		template.Schema = &schemapb.CollectionSchema{}
+		assert.Error(t, r.Create(ctx, template))
+
+		template = newTestCollectionTemplate("t")
+		template.IndexParams[0].FieldName = "not_exist"
+		assert.Error(t, r.Create(ctx, template))
+
+		// a template without schema only carries the index defaults and properties.
+		template = newTestCollectionTemplate("t")
+		template.Schema = nil
+		assert.NoError(t, r.Create(ctx, template))
+	})
+}
+
+func TestRootCoord_CollectionTemplate(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		status, err := c.CreateCollectionTemplate(ctx, &rootcoordpb.CreateCollectionTemplateRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, status.GetErrorCode())
+		status, err = c.DropCollectionTemplate(ctx, &rootcoordpb.DropCollectionTemplateRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, status.GetErrorCode())
+		resp, err := c.ListCollectionTemplates(ctx, &rootcoordpb.ListCollectionTemplatesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		c.collectionTemplates = newCollectionTemplateRegistry(ctx, memkv.NewMemoryKV())
+
+		status, err := c.CreateCollectionTemplate(ctx, &rootcoordpb.CreateCollectionTemplateRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterMissing)
+
+		status, err = c.CreateCollectionTemplate(ctx, &rootcoordpb.CreateCollectionTemplateRequest{Template: newTestCollectionTemplate("t1")})
+		assert.NoError(t, merr.CheckRPCCall(status, err))
+		status, err = c.CreateCollectionTemplate(ctx, &rootcoordpb.CreateCollectionTemplateRequest{Template: newTestCollectionTemplate("t2")})
+		assert.NoError(t, merr.CheckRPCCall(status, err))
+
+		resp, err := c.ListCollectionTemplates(ctx, &rootcoordpb.ListCollectionTemplatesRequest{})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Len(t, resp.GetTemplates(), 2)
+
+		resp, err = c.ListCollectionTemplates(ctx, &rootcoordpb.ListCollectionTemplatesRequest{Name: "t2"})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Len(t, resp.GetTemplates(), 1)
+		assert.Equal(t, "t2", resp.GetTemplates()[0].GetName())
+
+		status, err = c.DropCollectionTemplate(ctx, &rootcoordpb.DropCollectionTemplateRequest{Name: "t2"})
+		assert.NoError(t, merr.CheckRPCCall(status, err))
+		resp, err = c.ListCollectionTemplates(ctx, &rootcoordpb.ListCollectionTemplatesRequest{Name: "t2"})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+
+		status, err = c.DropCollectionTemplate(ctx, &rootcoordpb.DropCollectionTemplateRequest{Name: "t2"})
+		assert.ErrorIs(t, merr.CheckRPCCall(status, err), merr.ErrParameterInvalid)
+	})
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/broadcast"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/registry"
 	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/v2/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/ce"
@@ -96,6 +97,18 @@ type CacheExpirationsGetter interface {
 	GetCacheExpirations() *message.CacheExpirations
 }
 
+// publishDDLEvent publishes the ddl event if the ddl event stream is enabled,
+// the ack callback fails on error and is retried, so the event is not lost.
+func (c *DDLCallback) publishDDLEvent(ctx context.Context, event msgstream.TsMsg) error {
+	if c.ddlEventPublisher == nil {
+		return nil
+	}
+	if err := c.ddlEventPublisher.Publish(ctx, event); err != nil {
+		return errors.Wrap(err, "failed to publish ddl event")
+	}
+	return nil
+}
+
 // ExpireCaches handles the cache
 func (c *DDLCallback) ExpireCaches(ctx context.Context, expirations any, timetick uint64) error {
 	var cacheExpirations *message.CacheExpirations
@@ -23,6 +23,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/rootcoord/ddlevent"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/ce"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -58,6 +59,7 @@ func (c *Core) broadcastCreateAlias(ctx context.Context, req *milvuspb.CreateAli
 			CollectionId:   collection.CollectionID,
 			Alias:          req.GetAlias(),
 			CollectionName: req.GetCollectionName(),
+			Created:        true,
 		}).
 		WithBody(&message.AlterAliasMessageBody{}).
 		WithBroadcast([]string{streaming.WAL().ControlChannel()}).
@@ -108,6 +110,13 @@ func (c *DDLCallback) alterAliasV2AckCallback(ctx context.Context, result messag
 	if err := c.meta.AlterAlias(ctx, result); err != nil {
 		return err
 	}
+	event := ddlevent.NewAlterAliasEvent(result.Message.Header(), result.GetControlChannelResult().TimeTick)
+	if result.Message.Header().GetCreated() {
+		event = ddlevent.NewCreateAliasEvent(result.Message.Header(), result.GetControlChannelResult().TimeTick)
+	}
+	if err := c.publishDDLEvent(ctx, event); err != nil {
+		return err
+	}
 	return c.ExpireCaches(ctx,
 		ce.NewBuilder().WithLegacyProxyCollectionMetaCache(
 			ce.OptLPCMDBName(result.Message.Header().DbName),
@@ -12,6 +12,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/distributed/streaming"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/rootcoord/ddlevent"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
@@ -296,5 +297,19 @@ func (c *DDLCallback) alterCollectionV2AckCallback(ctx context.Context, result m
 	if err := c.broker.BroadcastAlteredCollection(ctx, header.CollectionId); err != nil {
 		return errors.Wrap(err, "failed to broadcast altered collection")
 	}
+	if c.ddlEventPublisher != nil {
+		coll, err := c.meta.GetCollectionByID(ctx, "", header.CollectionId, typeutil.MaxTimestamp, true)
+		if err != nil {
+			return errors.Wrap(err, "failed to get altered collection")
+		}
+		schema := convertModelToDesc(coll, nil, coll.DBName).GetSchema()
+		event, err := ddlevent.NewAlterCollectionEvent(coll.DBName, coll.Name, coll.CollectionID, coll.Properties, schema, result.GetControlChannelResult().TimeTick)
+		if err != nil {
+			return errors.Wrap(err, "failed to create alter collection event")
+		}
+		if err := c.publishDDLEvent(ctx, event); err != nil {
+			return err
+		}
+	}
 	return c.ExpireCaches(ctx, header, result.GetControlChannelResult().TimeTick)
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/distributed/streaming"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/rootcoord/ddlevent"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
@@ -113,6 +114,9 @@ func (c *DDLCallback) createCollectionV1AckCallback(ctx context.Context, result
 	if err := c.meta.AddCollection(ctx, newCollInfo); err != nil {
 		return errors.Wrap(err, "failed to add collection to meta table")
 	}
+	if err := c.publishDDLEvent(ctx, ddlevent.NewCreateCollectionEvent(header, body, newCollInfo.UpdateTimestamp)); err != nil {
+		return err
+	}
 	return c.ExpireCaches(ctx, ce.NewBuilder().WithLegacyProxyCollectionMetaCache(
 		ce.OptLPCMDBName(body.DbName),
 		ce.OptLPCMCollectionName(body.CollectionName),
@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/distributed/streaming"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/rootcoord/ddlevent"
 	pb "github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/ce"
@@ -102,6 +103,9 @@ func (c *DDLCallback) createPartitionV1AckCallback(ctx context.Context, result m
 	if err := c.meta.AddPartition(ctx, partition); err != nil {
 		return errors.Wrap(err, "failed to add partition meta")
 	}
+	if err := c.publishDDLEvent(ctx, ddlevent.NewCreatePartitionEvent(header, body, result.GetControlChannelResult().TimeTick)); err != nil {
+		return err
+	}
 	return c.ExpireCaches(ctx, ce.NewBuilder().
 		WithLegacyProxyCollectionMetaCache(
 			ce.OptLPCMDBName(body.DbName),
@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/rootcoord/ddlevent"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/ce"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -63,6 +64,9 @@ func (c *DDLCallback) dropAliasV2AckCallback(ctx context.Context, result message
 	if err := c.meta.DropAlias(ctx, result); err != nil {
 		return errors.Wrap(err, "failed to drop alias")
 	}
+	if err := c.publishDDLEvent(ctx, ddlevent.NewDropAliasEvent(result.Message.Header(), result.GetControlChannelResult().TimeTick)); err != nil {
+		return err
+	}
 	return c.ExpireCaches(ctx,
 		ce.NewBuilder().WithLegacyProxyCollectionMetaCache(
 			ce.OptLPCMDBName(result.Message.Header().DbName),
@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/rootcoord/ddlevent"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/registry"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
@@ -124,6 +125,9 @@ func (c *DDLCallback) dropCollectionV1AckCallback(ctx context.Context, result me
 			return errors.Wrap(err, "failed to drop virtual channel")
 		}
 	}
+	if err := c.publishDDLEvent(ctx, ddlevent.NewDropCollectionEvent(header, body, result.GetControlChannelResult().TimeTick)); err != nil {
+		return err
+	}
 	// add the collection tombstone to the sweeper.
 	c.tombstoneSweeper.AddTombstone(newCollectionTombstone(c.meta, c.broker, header.CollectionId))
 	// expire the collection meta cache on proxy.
@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/rootcoord/ddlevent"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/ce"
@@ -104,6 +105,9 @@ func (c *DDLCallback) dropPartitionV1AckCallback(ctx context.Context, result mes
 	if err := c.meta.DropPartition(ctx, header.CollectionId, header.PartitionId, result.GetControlChannelResult().TimeTick); err != nil {
 		return err
 	}
+	if err := c.publishDDLEvent(ctx, ddlevent.NewDropPartitionEvent(header, body, result.GetControlChannelResult().TimeTick)); err != nil {
+		return err
+	}
 	// add the partition tombstone to the sweeper.
 	c.tombstoneSweeper.AddTombstone(newPartitionTombstone(c.meta, c.broker, header.CollectionId, header.PartitionId))
 	// expire the partition meta cache on proxy.
@@ -0,0 +1,326 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	kvmetastore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/kv"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// DdlTaskPrefix prefix for the states of the asynchronous ddl tasks
+const DdlTaskPrefix = kvmetastore.ComponentPrefix + "/ddl-task"
+
+const (
+	DdlTaskStateRunning   = "running"
+	DdlTaskStateCompleted = "completed"
+	DdlTaskStateFailed    = "failed"
+)
+
+type ddlTaskCtxKey struct{}
+
+// isAsyncDdl returns whether the ddl request asks to be executed asynchronously,
+// it's always false for the ddl executed by the registry.
+func (c *Core) isAsyncDdl(ctx context.Context, base *commonpb.MsgBase) bool {
+	if c.ddlTasks == nil || ctx.Value(ddlTaskCtxKey{}) != nil {
+		return false
+	}
+	enabled, _ := strconv.ParseBool(base.GetProperties()[common.DdlAsyncKey])
+	return enabled
+}
+
+// submitAsyncDdl executes the ddl in background and returns the task id in the status extra info.
+func (c *Core) submitAsyncDdl(ctx context.Context, name, dbName, collectionName string,
+	fn func(ctx context.Context) (*commonpb.Status, error),
+) *commonpb.Status {
+	taskID, err := c.idAllocator.AllocOne()
+	if err != nil {
+		return merr.Status(err)
+	}
+	task := &metricsinfo.DdlTask{
+		TaskID:         taskID,
+		Name:           name,
+		DBName:         dbName,
+		CollectionName: collectionName,
+	}
+	if err := c.ddlTasks.Submit(ctx, task, func(ctx context.Context) error {
+		return merr.CheckRPCCall(fn(ctx))
+	}); err != nil {
+		return merr.Status(err)
+	}
+	log.Ctx(ctx).Info("asynchronous ddl task submitted", zap.Int64("taskID", taskID), zap.String("name", name),
+		zap.String("dbName", dbName), zap.String("collectionName", collectionName))
+	status := merr.Success()
+	status.ExtraInfo = map[string]string{common.DdlTaskIDKey: strconv.FormatInt(taskID, 10)}
+	return status
+}
+
+// getDdlTasksJSON returns the state of the task if the task id is specified, otherwise all the tasks.
+func (c *Core) getDdlTasksJSON(ctx context.Context, jsonReq gjson.Result) (string, error) {
+	if c.ddlTasks == nil {
+		return "", merr.WrapErrServiceNotReady(typeutil.RootCoordRole, paramtable.GetNodeID(), "ddl task registry not initialized")
+	}
+	v := jsonReq.Get(metricsinfo.MetricRequestParamTaskIDKey)
+	if !v.Exists() {
+		return metricsinfo.MarshalGetMetricsValues(c.ddlTasks.List(), nil)
+	}
+	taskID, err := strconv.ParseInt(v.String(), 10, 64)
+	if err != nil {
+		return "", merr.WrapErrParameterInvalidMsg("invalid task id %s", v.String())
+	}
+	wait := time.Duration(jsonReq.Get(metricsinfo.MetricRequestParamWaitKey).Int()) * time.Millisecond
+	task, err := c.ddlTasks.Get(ctx, taskID, wait)
+	if err != nil {
+		return "", err
+	}
+	bs, err := json.Marshal(task)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// asyncDdlTask executes the asynchronous ddl through the scheduler,
+// so that it's tracked by the scheduler like the other tasks, e.g. the min ddl ts.
+type asyncDdlTask struct {
+	baseTask
+	fn func(ctx context.Context) error
+}
+
+func (t *asyncDdlTask) Execute(ctx context.Context) error {
+	return t.fn(ctx)
+}
+
+func (t *asyncDdlTask) GetLockerKey() LockerKey {
+	// the ddl itself acquires the resource keys of the broadcaster,
+	// the cluster read lock only makes the lock scheduler execute the task in the caller goroutine.
+	return NewClusterLockerKey(false)
+}
+
+type ddlTaskEntry struct {
+	task *metricsinfo.DdlTask
+	done chan struct{}
+}
+
+// ddlTaskRegistry submits the asynchronous ddl to the scheduler in background and keeps the task states,
+// the states are persisted so that they could still be polled after rootcoord restarts.
+type ddlTaskRegistry struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	kv        kv.TxnKV
+	scheduler IScheduler
+	mu        sync.RWMutex
+	tasks     map[int64]*ddlTaskEntry
+}
+
+func newDdlTaskRegistry(ctx context.Context, kv kv.TxnKV, scheduler IScheduler) *ddlTaskRegistry {
+	ctx, cancel := context.WithCancel(ctx)
+	return &ddlTaskRegistry{
+		ctx:       ctx,
+		cancel:    cancel,
+		kv:        kv,
+		scheduler: scheduler,
+		tasks:     make(map[int64]*ddlTaskEntry),
+	}
+}
+
+// Stop cancels the running ddl tasks and waits for them to exit,
+// it must be called before the scheduler is stopped.
+func (r *ddlTaskRegistry) Stop() {
+	r.mu.Lock()
+	r.cancel()
+	r.mu.Unlock()
+	r.wg.Wait()
+}
+
+// recover loads the persisted task states, the tasks interrupted by the restart are marked as failed
+// since the ddl is not resumed by the registry.
+func (r *ddlTaskRegistry) recover() error {
+	_, values, err := r.kv.LoadWithPrefix(r.ctx, DdlTaskPrefix)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, value := range values {
+		task := &metricsinfo.DdlTask{}
+		if err := json.Unmarshal([]byte(value), task); err != nil {
+			log.Ctx(r.ctx).Warn("failed to unmarshal ddl task state, skip it", zap.Error(err))
+			continue
+		}
+		if task.State == DdlTaskStateRunning {
+			task.State = DdlTaskStateFailed
+			task.Reason = "interrupted by rootcoord restart, please check the result and retry if necessary"
+			task.UpdateTime = time.Now().UnixMilli()
+			if err := r.save(task); err != nil {
+				return err
+			}
+		}
+		done := make(chan struct{})
+		close(done)
+		r.tasks[task.TaskID] = &ddlTaskEntry{task: task, done: done}
+	}
+	log.Ctx(r.ctx).Info("recover ddl task states done", zap.Int("num", len(r.tasks)))
+	return nil
+}
+
+func (r *ddlTaskRegistry) save(task *metricsinfo.DdlTask) error {
+	value, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return r.kv.Save(r.ctx, buildDdlTaskKey(task.TaskID), string(value))
+}
+
+// Submit persists the task and executes the ddl in background.
+func (r *ddlTaskRegistry) Submit(ctx context.Context, task *metricsinfo.DdlTask, fn func(ctx context.Context) error) error {
+	now := time.Now().UnixMilli()
+	task.State = DdlTaskStateRunning
+	task.CreateTime = now
+	task.UpdateTime = now
+	if err := r.save(task); err != nil {
+		return err
+	}
+	entry := &ddlTaskEntry{task: task, done: make(chan struct{})}
+	r.mu.Lock()
+	if r.ctx.Err() != nil {
+		r.mu.Unlock()
+		return merr.WrapErrServiceNotReady(typeutil.RootCoordRole, paramtable.GetNodeID(), "ddl task registry stopped")
+	}
+	r.tasks[task.TaskID] = entry
+	r.wg.Add(1)
+	r.mu.Unlock()
+	r.removeExpired()
+
+	// the ddl is detached from the cancellation of the request but canceled once the registry stops,
+	// and it keeps the trace and log fields of the request.
+	ctx, cancel := context.WithCancel(context.WithValue(context.WithoutCancel(ctx), ddlTaskCtxKey{}, task.TaskID))
+	stop := context.AfterFunc(r.ctx, cancel)
+	go func() {
+		defer r.wg.Done()
+		defer cancel()
+		defer stop()
+		t := &asyncDdlTask{baseTask: newBaseTask(ctx, nil), fn: fn}
+		err := r.scheduler.AddTask(t)
+		if err == nil {
+			err = t.WaitToFinish()
+		}
+		r.finish(entry, err)
+	}()
+	return nil
+}
+
+func (r *ddlTaskRegistry) finish(entry *ddlTaskEntry, err error) {
+	r.mu.Lock()
+	task := *entry.task
+	task.UpdateTime = time.Now().UnixMilli()
+	if err != nil {
+		task.State = DdlTaskStateFailed
+		task.Reason = err.Error()
+	} else {
+		task.State = DdlTaskStateCompleted
+	}
+	entry.task = &task
+	r.mu.Unlock()
+	close(entry.done)
+
+	if err := r.save(&task); err != nil {
+		log.Ctx(r.ctx).Warn("failed to save ddl task state", zap.Int64("taskID", task.TaskID), zap.Error(err))
+	}
+	log.Ctx(r.ctx).Info("asynchronous ddl task finished", zap.Int64("taskID", task.TaskID),
+		zap.String("name", task.Name), zap.String("state", task.State), zap.String("reason", task.Reason))
+}
+
+// Get returns the state of the task, it waits for the task to finish at most the given duration.
+func (r *ddlTaskRegistry) Get(ctx context.Context, taskID int64, wait time.Duration) (*metricsinfo.DdlTask, error) {
+	r.mu.RLock()
+	entry, ok := r.tasks[taskID]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("ddl task %d not found", taskID)
+	}
+	if wait > 0 {
+		ctx, cancel := context.WithTimeout(ctx, wait)
+		defer cancel()
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+		}
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	task := *entry.task
+	return &task, nil
+}
+
+// List returns the states of all the tasks.
+func (r *ddlTaskRegistry) List() []*metricsinfo.DdlTask {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return lo.MapToSlice(r.tasks, func(_ int64, entry *ddlTaskEntry) *metricsinfo.DdlTask {
+		task := *entry.task
+		return &task
+	})
+}
+
+// removeExpired removes the finished tasks older than the retention.
+func (r *ddlTaskRegistry) removeExpired() {
+	retention := Params.RootCoordCfg.DdlTaskRetention.GetAsDuration(time.Second)
+	expireTime := time.Now().Add(-retention).UnixMilli()
+
+	r.mu.Lock()
+	expired := make([]int64, 0)
+	for taskID, entry := range r.tasks {
+		if entry.task.State != DdlTaskStateRunning && entry.task.UpdateTime < expireTime {
+			expired = append(expired, taskID)
+		}
+	}
+	for _, taskID := range expired {
+		delete(r.tasks, taskID)
+	}
+	r.mu.Unlock()
+
+	if len(expired) == 0 {
+		return
+	}
+	keys := lo.Map(expired, func(taskID int64, _ int) string { return buildDdlTaskKey(taskID) })
+	if err := r.kv.MultiRemove(r.ctx, keys); err != nil {
+		log.Ctx(r.ctx).Warn("failed to remove expired ddl task states", zap.Int64s("taskIDs", expired), zap.Error(err))
+	}
+}
+
+func buildDdlTaskKey(taskID int64) string {
+	return fmt.Sprintf("%s/%d", DdlTaskPrefix, taskID)
+}
@@ -0,0 +1,175 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func newExecutingScheduler() *mockScheduler {
+	sched := newMockScheduler()
+	sched.AddTaskFunc = func(t task) error {
+		t.NotifyDone(t.Execute(t.GetCtx()))
+		return nil
+	}
+	return sched
+}
+
+func TestDdlTaskRegistry(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	t.Run("submit and wait", func(t *testing.T) {
+		r := newDdlTaskRegistry(ctx, memkv.NewMemoryKV(), newExecutingScheduler())
+		ch := make(chan struct{})
+		err := r.Submit(ctx, &metricsinfo.DdlTask{TaskID: 1, Name: "CreateCollection"}, func(ctx context.Context) error {
+			assert.Equal(t, int64(1), ctx.Value(ddlTaskCtxKey{}))
+			<-ch
+			return nil
+		})
+		require.NoError(t, err)
+
+		task, err := r.Get(ctx, 1, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, DdlTaskStateRunning, task.State)
+
+		task, err = r.Get(ctx, 1, 10*time.Millisecond)
+		assert.NoError(t, err)
+		assert.Equal(t, DdlTaskStateRunning, task.State)
+
+		close(ch)
+		task, err = r.Get(ctx, 1, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, DdlTaskStateCompleted, task.State)
+		assert.Len(t, r.List(), 1)
+
+		_, err = r.Get(ctx, 2, 0)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		r := newDdlTaskRegistry(ctx, memkv.NewMemoryKV(), newExecutingScheduler())
+		err := r.Submit(ctx, &metricsinfo.DdlTask{TaskID: 1}, func(ctx context.Context) error {
+			return errors.New("mock error")
+		})
+		require.NoError(t, err)
+
+		state, err := r.Get(ctx, 1, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, DdlTaskStateFailed, state.State)
+		assert.Equal(t, "mock error", state.Reason)
+
+		sched := newMockScheduler()
+		sched.AddTaskFunc = func(t task) error {
+			return errors.New("mock add task error")
+		}
+		r = newDdlTaskRegistry(ctx, memkv.NewMemoryKV(), sched)
+		err = r.Submit(ctx, &metricsinfo.DdlTask{TaskID: 1}, func(ctx context.Context) error {
+			return nil
+		})
+		require.NoError(t, err)
+		state, err = r.Get(ctx, 1, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, DdlTaskStateFailed, state.State)
+		assert.Equal(t, "mock add task error", state.Reason)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		r := newDdlTaskRegistry(ctx, memkv.NewMemoryKV(), newExecutingScheduler())
+		err := r.Submit(ctx, &metricsinfo.DdlTask{TaskID: 1}, func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		require.NoError(t, err)
+
+		r.Stop()
+		task, err := r.Get(ctx, 1, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, DdlTaskStateFailed, task.State)
+
+		err = r.Submit(ctx, &metricsinfo.DdlTask{TaskID: 2}, func(ctx context.Context) error {
+			return nil
+		})
+		assert.ErrorIs(t, err, merr.ErrServiceNotReady)
+	})
+
+	t.Run("recover", func(t *testing.T) {
+		kv := memkv.NewMemoryKV()
+		running, _ := json.Marshal(&metricsinfo.DdlTask{TaskID: 1, State: DdlTaskStateRunning})
+		completed, _ := json.Marshal(&metricsinfo.DdlTask{TaskID: 2, State: DdlTaskStateCompleted})
+		kv.Save(ctx, buildDdlTaskKey(1), string(running))
+		kv.Save(ctx, buildDdlTaskKey(2), string(completed))
+		kv.Save(ctx, buildDdlTaskKey(3), "invalid")
+
+		r := newDdlTaskRegistry(ctx, kv, newExecutingScheduler())
+		require.NoError(t, r.recover())
+		assert.Len(t, r.List(), 2)
+
+		task, err := r.Get(ctx, 1, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, DdlTaskStateFailed, task.State)
+		task, err = r.Get(ctx, 2, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, DdlTaskStateCompleted, task.State)
+	})
+
+	t.Run("remove expired", func(t *testing.T) {
+		kv := memkv.NewMemoryKV()
+		expired, _ := json.Marshal(&metricsinfo.DdlTask{TaskID: 1, State: DdlTaskStateCompleted, UpdateTime: 1})
+		kv.Save(ctx, buildDdlTaskKey(1), string(expired))
+
+		r := newDdlTaskRegistry(ctx, kv, newExecutingScheduler())
+		require.NoError(t, r.recover())
+		err := r.Submit(ctx, &metricsinfo.DdlTask{TaskID: 2}, func(ctx context.Context) error {
+			return nil
+		})
+		require.NoError(t, err)
+
+		_, err = r.Get(ctx, 1, 0)
+		assert.Error(t, err)
+		_, err = kv.Load(ctx, buildDdlTaskKey(1))
+		assert.Error(t, err)
+		_, err = r.Get(ctx, 2, time.Minute)
+		assert.NoError(t, err)
+	})
+}
+
+func TestCore_isAsyncDdl(t *testing.T) {
+	ctx := context.Background()
+	c := &Core{}
+	base := &commonpb.MsgBase{Properties: map[string]string{common.DdlAsyncKey: "true"}}
+	assert.False(t, c.isAsyncDdl(ctx, base))
+
+	c.ddlTasks = newDdlTaskRegistry(ctx, memkv.NewMemoryKV(), newMockScheduler())
+	assert.True(t, c.isAsyncDdl(ctx, base))
+	assert.False(t, c.isAsyncDdl(ctx, nil))
+	assert.False(t, c.isAsyncDdl(context.WithValue(ctx, ddlTaskCtxKey{}, int64(1)), base))
+}
@@ -0,0 +1,312 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ddlevent implements the ddl event stream of rootcoord, which allows the external
+// subscribers (cdc, caches, catalogs) to follow the ddl changes without polling DescribeCollection.
+//
+// The events are the legacy ddl TsMsgs (CreateCollectionMsg, DropCollectionMsg, CreatePartitionMsg,
+// DropPartitionMsg, AlterCollectionMsg, CreateAliasMsg, AlterAliasMsg, DropAliasMsg), so the schema of an event
+// is the proto defined in msgpb and milvuspb, and it could be decoded by the default unmarshal dispatcher
+// of msgstream. The timestamp of an event is the time tick of the ddl on the control channel.
+// The AlterCollectionMsg carries the collection schema after the alteration in the property SchemaPropertyKey,
+// which is the base64 encoded schemapb.CollectionSchema.
+//
+// The events are published synchronously in the ack callback of the ddl after it's applied to the meta,
+// the ack callback is retried until the event is published, even across the restart of rootcoord,
+// so no event is lost. An event may be published more than once on the retry of the ddl ack,
+// the subscribers shall dedup the events by the msg type and timestamp.
+package ddlevent
+
+import (
+	"context"
+	"encoding/base64"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/mq/common"
+	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// SchemaPropertyKey is the property of the AlterCollectionMsg event which carries the collection schema.
+const SchemaPropertyKey = "ddl_event.schema"
+
+// Channel returns the name of the ddl event channel.
+func Channel() string {
+	return paramtable.Get().CommonCfg.RootCoordDDLEvent.GetValue()
+}
+
+// Publisher publishes the ddl events to the ddl event channel.
+type Publisher struct {
+	// mu serializes the produce, so the events are published in the order of the ddl acks
+	mu     sync.Mutex
+	stream msgstream.MsgStream
+}
+
+// NewPublisher creates a publisher of the ddl event channel.
+func NewPublisher(ctx context.Context, factory msgstream.Factory) (*Publisher, error) {
+	stream, err := factory.NewMsgStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stream.AsProducer(ctx, []string{Channel()})
+	log.Ctx(ctx).Info("ddl event publisher created", zap.String("channel", Channel()))
+	return &Publisher{stream: stream}, nil
+}
+
+// Publish publishes the ddl event, the error is returned to retry the ddl ack callback.
+func (p *Publisher) Publish(ctx context.Context, event msgstream.TsMsg) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	err := p.stream.Produce(ctx, &msgstream.MsgPack{
+		BeginTs: event.BeginTs(),
+		EndTs:   event.EndTs(),
+		Msgs:    []msgstream.TsMsg{event},
+	})
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to publish ddl event", zap.Stringer("msgType", event.Type()), zap.Uint64("ts", event.BeginTs()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Close closes the publisher.
+func (p *Publisher) Close() {
+	p.stream.Close()
+}
+
+// Subscriber consumes the ddl events from the ddl event channel.
+type Subscriber struct {
+	stream msgstream.MsgStream
+	ch     chan msgstream.TsMsg
+	closed chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewSubscriber subscribes the ddl event channel from the earliest position with the subscription name,
+// the events with timestamp before fromTs are skipped, so the subscriber could replay from any timestamp
+// kept by the retention of the channel.
+func NewSubscriber(ctx context.Context, factory msgstream.Factory, subName string, fromTs uint64) (*Subscriber, error) {
+	stream, err := factory.NewMsgStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.AsConsumer(ctx, []string{Channel()}, subName, common.SubscriptionPositionEarliest); err != nil {
+		stream.Close()
+		return nil, err
+	}
+	s := &Subscriber{
+		stream: stream,
+		ch:     make(chan msgstream.TsMsg),
+		closed: make(chan struct{}),
+	}
+	s.wg.Add(1)
+	go s.work(fromTs)
+	return s, nil
+}
+
+func (s *Subscriber) work(fromTs uint64) {
+	defer s.wg.Done()
+	defer close(s.ch)
+	dispatcher := s.stream.GetUnmarshalDispatcher()
+	for {
+		select {
+		case <-s.closed:
+			return
+		case pack, ok := <-s.stream.Chan():
+			if !ok {
+				return
+			}
+			for _, msg := range pack.Msgs {
+				if msg.GetTimestamp() < fromTs {
+					continue
+				}
+				event, err := msg.Unmarshal(dispatcher)
+				if err != nil {
+					log.Warn("failed to unmarshal ddl event, skip it", zap.Stringer("msgType", msg.GetType()), zap.Error(err))
+					continue
+				}
+				select {
+				case s.ch <- event:
+				case <-s.closed:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Chan returns the channel of the ddl events, it's closed after the subscriber is closed.
+func (s *Subscriber) Chan() <-chan msgstream.TsMsg {
+	return s.ch
+}
+
+// Close closes the subscriber.
+func (s *Subscriber) Close() {
+	close(s.closed)
+	s.wg.Wait()
+	s.stream.Close()
+}
+
+func newBaseMsg(ts uint64) msgstream.BaseMsg {
+	return msgstream.BaseMsg{
+		BeginTimestamp: ts,
+		EndTimestamp:   ts,
+		HashValues:     []uint32{0},
+	}
+}
+
+func newMsgBase(msgType commonpb.MsgType, ts uint64) *commonpb.MsgBase {
+	return commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(msgType),
+		commonpbutil.WithTimeStamp(ts),
+		commonpbutil.WithSourceID(paramtable.GetNodeID()),
+	)
+}
+
+// NewCreateCollectionEvent creates the event of create collection.
+func NewCreateCollectionEvent(header *message.CreateCollectionMessageHeader, body *message.CreateCollectionRequest, ts uint64) msgstream.TsMsg {
+	req := proto.Clone(body).(*message.CreateCollectionRequest)
+	req.Base = newMsgBase(commonpb.MsgType_CreateCollection, ts)
+	req.DbID = header.GetDbId()
+	req.CollectionID = header.GetCollectionId()
+	req.PartitionIDs = header.GetPartitionIds()
+	return &msgstream.CreateCollectionMsg{
+		BaseMsg:                 newBaseMsg(ts),
+		CreateCollectionRequest: req,
+	}
+}
+
+// NewDropCollectionEvent creates the event of drop collection.
+func NewDropCollectionEvent(header *message.DropCollectionMessageHeader, body *message.DropCollectionRequest, ts uint64) msgstream.TsMsg {
+	req := proto.Clone(body).(*message.DropCollectionRequest)
+	req.Base = newMsgBase(commonpb.MsgType_DropCollection, ts)
+	req.DbID = header.GetDbId()
+	req.CollectionID = header.GetCollectionId()
+	return &msgstream.DropCollectionMsg{
+		BaseMsg:               newBaseMsg(ts),
+		DropCollectionRequest: req,
+	}
+}
+
+// NewCreatePartitionEvent creates the event of create partition.
+func NewCreatePartitionEvent(header *message.CreatePartitionMessageHeader, body *message.CreatePartitionRequest, ts uint64) msgstream.TsMsg {
+	req := proto.Clone(body).(*message.CreatePartitionRequest)
+	req.Base = newMsgBase(commonpb.MsgType_CreatePartition, ts)
+	req.CollectionID = header.GetCollectionId()
+	req.PartitionID = header.GetPartitionId()
+	return &msgstream.CreatePartitionMsg{
+		BaseMsg:                newBaseMsg(ts),
+		CreatePartitionRequest: req,
+	}
+}
+
+// NewDropPartitionEvent creates the event of drop partition.
+func NewDropPartitionEvent(header *message.DropPartitionMessageHeader, body *message.DropPartitionRequest, ts uint64) msgstream.TsMsg {
+	req := proto.Clone(body).(*message.DropPartitionRequest)
+	req.Base = newMsgBase(commonpb.MsgType_DropPartition, ts)
+	req.CollectionID = header.GetCollectionId()
+	req.PartitionID = header.GetPartitionId()
+	return &msgstream.DropPartitionMsg{
+		BaseMsg:              newBaseMsg(ts),
+		DropPartitionRequest: req,
+	}
+}
+
+// NewAlterCollectionEvent creates the event of alter collection, the properties and schema are the ones after the alteration.
+func NewAlterCollectionEvent(dbName string, collectionName string, collectionID int64, properties []*commonpb.KeyValuePair, schema *schemapb.CollectionSchema, ts uint64) (msgstream.TsMsg, error) {
+	bs, err := proto.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	properties = append(append(make([]*commonpb.KeyValuePair, 0, len(properties)+1), properties...),
+		&commonpb.KeyValuePair{Key: SchemaPropertyKey, Value: base64.StdEncoding.EncodeToString(bs)})
+	return &msgstream.AlterCollectionMsg{
+		BaseMsg: newBaseMsg(ts),
+		AlterCollectionRequest: &milvuspb.AlterCollectionRequest{
+			Base:           newMsgBase(commonpb.MsgType_AlterCollection, ts),
+			DbName:         dbName,
+			CollectionName: collectionName,
+			CollectionID:   collectionID,
+			Properties:     properties,
+		},
+	}, nil
+}
+
+// GetSchema returns the collection schema carried by the event of alter collection.
+func GetSchema(event *msgstream.AlterCollectionMsg) (*schemapb.CollectionSchema, error) {
+	for _, kv := range event.GetProperties() {
+		if kv.GetKey() != SchemaPropertyKey {
+			continue
+		}
+		bs, err := base64.StdEncoding.DecodeString(kv.GetValue())
+		if err != nil {
+			return nil, err
+		}
+		schema := &schemapb.CollectionSchema{}
+		if err := proto.Unmarshal(bs, schema); err != nil {
+			return nil, err
+		}
+		return schema, nil
+	}
+	return nil, nil
+}
+
+// NewCreateAliasEvent creates the event of create alias.
+func NewCreateAliasEvent(header *message.AlterAliasMessageHeader, ts uint64) msgstream.TsMsg {
+	return &msgstream.CreateAliasMsg{
+		BaseMsg: newBaseMsg(ts),
+		CreateAliasRequest: &milvuspb.CreateAliasRequest{
+			Base:           newMsgBase(commonpb.MsgType_CreateAlias, ts),
+			DbName:         header.GetDbName(),
+			CollectionName: header.GetCollectionName(),
+			Alias:          header.GetAlias(),
+		},
+	}
+}
+
+// NewAlterAliasEvent creates the event of alter alias.
+func NewAlterAliasEvent(header *message.AlterAliasMessageHeader, ts uint64) msgstream.TsMsg {
+	return &msgstream.AlterAliasMsg{
+		BaseMsg: newBaseMsg(ts),
+		AlterAliasRequest: &milvuspb.AlterAliasRequest{
+			Base:           newMsgBase(commonpb.MsgType_AlterAlias, ts),
+			DbName:         header.GetDbName(),
+			CollectionName: header.GetCollectionName(),
+			Alias:          header.GetAlias(),
+		},
+	}
+}
+
+// NewDropAliasEvent creates the event of drop alias.
+func NewDropAliasEvent(header *message.DropAliasMessageHeader, ts uint64) msgstream.TsMsg {
+	return &msgstream.DropAliasMsg{
+		BaseMsg: newBaseMsg(ts),
+		DropAliasRequest: &milvuspb.DropAliasRequest{
+			Base:   newMsgBase(commonpb.MsgType_DropAlias, ts),
+			DbName: header.GetDbName(),
+			Alias:  header.GetAlias(),
+		},
+	}
+}
@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddlevent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/mq/common"
+	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	m.Run()
+}
+
+func TestNewEvents(t *testing.T) {
+	event := NewCreateCollectionEvent(
+		&message.CreateCollectionMessageHeader{CollectionId: 1, PartitionIds: []int64{2}, DbId: 3},
+		&message.CreateCollectionRequest{DbName: "db", CollectionName: "coll"},
+		100,
+	)
+	assert.Equal(t, commonpb.MsgType_CreateCollection, event.Type())
+	assert.EqualValues(t, 100, event.BeginTs())
+	createColl := event.(*msgstream.CreateCollectionMsg)
+	assert.EqualValues(t, 1, createColl.GetCollectionID())
+	assert.Equal(t, []int64{2}, createColl.GetPartitionIDs())
+	assert.EqualValues(t, 100, createColl.GetBase().GetTimestamp())
+
+	event = NewDropCollectionEvent(&message.DropCollectionMessageHeader{CollectionId: 1}, &message.DropCollectionRequest{CollectionName: "coll"}, 101)
+	assert.Equal(t, commonpb.MsgType_DropCollection, event.Type())
+	assert.EqualValues(t, 1, event.(*msgstream.DropCollectionMsg).GetCollectionID())
+
+	event = NewCreatePartitionEvent(&message.CreatePartitionMessageHeader{CollectionId: 1, PartitionId: 2}, &message.CreatePartitionRequest{PartitionName: "p"}, 102)
+	assert.Equal(t, commonpb.MsgType_CreatePartition, event.Type())
+	assert.EqualValues(t, 2, event.(*msgstream.CreatePartitionMsg).GetPartitionID())
+
+	event = NewDropPartitionEvent(&message.DropPartitionMessageHeader{CollectionId: 1, PartitionId: 2}, &message.DropPartitionRequest{PartitionName: "p"}, 103)
+	assert.Equal(t, commonpb.MsgType_DropPartition, event.Type())
+	assert.EqualValues(t, 2, event.(*msgstream.DropPartitionMsg).GetPartitionID())
+
+	schema := &schemapb.CollectionSchema{Name: "coll", Fields: []*schemapb.FieldSchema{{FieldID: 100, Name: "pk", IsPrimaryKey: true}}}
+	event, err := NewAlterCollectionEvent("db", "coll", 1, []*commonpb.KeyValuePair{{Key: "k", Value: "v"}}, schema, 104)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.MsgType_AlterCollection, event.Type())
+	assert.Len(t, event.(*msgstream.AlterCollectionMsg).GetProperties(), 2)
+	gotSchema, err := GetSchema(event.(*msgstream.AlterCollectionMsg))
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(schema, gotSchema))
+
+	event = NewCreateAliasEvent(&message.AlterAliasMessageHeader{DbName: "db", CollectionName: "coll", Alias: "a", Created: true}, 105)
+	assert.Equal(t, commonpb.MsgType_CreateAlias, event.Type())
+	assert.Equal(t, "coll", event.(*msgstream.CreateAliasMsg).GetCollectionName())
+
+	event = NewAlterAliasEvent(&message.AlterAliasMessageHeader{DbName: "db", CollectionName: "coll", Alias: "a"}, 105)
+	assert.Equal(t, commonpb.MsgType_AlterAlias, event.Type())
+	assert.Equal(t, "a", event.(*msgstream.AlterAliasMsg).GetAlias())
+
+	event = NewDropAliasEvent(&message.DropAliasMessageHeader{DbName: "db", Alias: "a"}, 106)
+	assert.Equal(t, commonpb.MsgType_DropAlias, event.Type())
+	assert.Equal(t, "a", event.(*msgstream.DropAliasMsg).GetAlias())
+}
+
+func TestPublisher(t *testing.T) {
+	stream := msgstream.NewMockMsgStream(t)
+	stream.EXPECT().AsProducer(mock.Anything, []string{Channel()}).Return()
+	var produced []*msgstream.MsgPack
+	stream.EXPECT().Produce(mock.Anything, mock.Anything).Return(errors.New("mock error")).Once()
+	stream.EXPECT().Produce(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, pack *msgstream.MsgPack) error {
+		produced = append(produced, pack)
+		return nil
+	})
+	stream.EXPECT().Close().Return()
+	factory := msgstream.NewMockMqFactory()
+	factory.NewMsgStreamFunc = func(ctx context.Context) (msgstream.MsgStream, error) {
+		return stream, nil
+	}
+
+	publisher, err := NewPublisher(context.Background(), factory)
+	assert.NoError(t, err)
+	// the error is returned to retry the ddl ack callback
+	err = publisher.Publish(context.Background(), NewDropAliasEvent(&message.DropAliasMessageHeader{Alias: "a"}, 100))
+	assert.Error(t, err)
+	assert.Empty(t, produced)
+
+	assert.NoError(t, publisher.Publish(context.Background(), NewDropAliasEvent(&message.DropAliasMessageHeader{Alias: "a"}, 100)))
+	assert.NoError(t, publisher.Publish(context.Background(), NewDropAliasEvent(&message.DropAliasMessageHeader{Alias: "b"}, 101)))
+	assert.Len(t, produced, 2)
+	assert.EqualValues(t, 100, produced[0].BeginTs)
+	assert.EqualValues(t, 101, produced[1].BeginTs)
+	publisher.Close()
+}
+
+func TestSubscriber(t *testing.T) {
+	ch := make(chan *msgstream.ConsumeMsgPack, 1)
+	stream := msgstream.NewMockMsgStream(t)
+	stream.EXPECT().AsConsumer(mock.Anything, []string{Channel()}, "sub", common.SubscriptionPositionEarliest).Return(nil)
+	stream.EXPECT().GetUnmarshalDispatcher().Return((&msgstream.ProtoUDFactory{}).NewUnmarshalDispatcher())
+	stream.EXPECT().Chan().Return(ch)
+	stream.EXPECT().Close().Return()
+	factory := msgstream.NewMockMqFactory()
+	factory.NewMsgStreamFunc = func(ctx context.Context) (msgstream.MsgStream, error) {
+		return stream, nil
+	}
+
+	subscriber, err := NewSubscriber(context.Background(), factory, "sub", 101)
+	assert.NoError(t, err)
+	ch <- msgstream.BuildConsumeMsgPack(&msgstream.MsgPack{
+		Msgs: []msgstream.TsMsg{
+			NewAlterAliasEvent(&message.AlterAliasMessageHeader{Alias: "a"}, 100),
+			NewDropAliasEvent(&message.DropAliasMessageHeader{Alias: "a"}, 102),
+		},
+	})
+	event := <-subscriber.Chan()
+	assert.Equal(t, commonpb.MsgType_DropAlias, event.Type())
+	assert.EqualValues(t, 102, event.BeginTs())
+	assert.Equal(t, "a", event.(*msgstream.DropAliasMsg).GetAlias())
+	subscriber.Close()
+	_, ok := <-subscriber.Chan()
+	assert.False(t, ok)
+}
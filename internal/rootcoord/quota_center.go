@@ -152,8 +152,10 @@ type QuotaCenter struct {
 	dataNodeMetrics  map[UniqueID]*metricsinfo.DataNodeQuotaMetrics
 	proxyMetrics     map[UniqueID]*metricsinfo.ProxyQuotaMetrics
 	dataCoordMetrics *metricsinfo.DataCoordQuotaMetrics
-	diskMu           sync.Mutex // guards dataCoordMetrics and totalBinlogSize
-	totalBinlogSize  int64
+	// collection id -> the stored rows and index size reported by datacoord
+	collectionMetrics map[int64]*metricsinfo.DataCoordCollectionInfo
+	diskMu            sync.Mutex // guards dataCoordMetrics and totalBinlogSize
+	totalBinlogSize   int64
 
 	readableCollections map[int64]map[int64][]int64            // db id -> collection id -> partition id
 	writableCollections map[int64]map[int64][]int64            // db id -> collection id -> partition id
@@ -170,6 +172,8 @@ type QuotaCenter struct {
 
 	rateAllocateStrategy RateAllocateStrategy
 
+	trends *trendTracker // samples for the trend projection
+
 	stopOnce sync.Once
 	stopChan chan struct{}
 	wg       sync.WaitGroup
@@ -192,6 +196,7 @@ func NewQuotaCenter(proxies proxyutil.ProxyClientManagerInterface, mixCoord type
 		writableCollections:  make(map[int64]map[int64][]int64, 0),
 		rateLimiter:          rlinternal.NewRateLimiterTree(initInfLimiter(internalpb.RateScope_Cluster, allOps)),
 		rateAllocateStrategy: DefaultRateAllocateStrategy,
+		trends:               newTrendTracker(),
 		stopChan:             make(chan struct{}),
 	}
 	q.clearMetrics()
@@ -482,6 +487,7 @@ func (q *QuotaCenter) collectMetrics() error {
 		if cm != nil {
 			collectionMetrics = cm.Collections
 		}
+		q.collectionMetrics = collectionMetrics
 
 		collections.Range(func(collectionID int64) bool {
 			coll, getErr := q.meta.GetCollectionByIDWithMaxTs(context.TODO(), collectionID)
@@ -572,6 +578,7 @@ func (q *QuotaCenter) collectMetrics() error {
 		metrics.RootCoordTtDelay.DeleteLabelValues(typeutil.QueryNodeRole, strconv.FormatInt(oldQN, 10))
 		metrics.RootCoordTtDelay.DeleteLabelValues(typeutil.StreamingNodeRole, strconv.FormatInt(oldQN, 10))
 	}
+	q.observeTrends(time.Now())
 	return nil
 }
 
@@ -712,7 +719,7 @@ func (q *QuotaCenter) forceDenyWriting(errorCode commonpb.ErrorCode, cluster boo
 }
 
 // forceDenyReading sets dql rates to 0 to reject all dql requests.
-func (q *QuotaCenter) forceDenyReading(errorCode commonpb.ErrorCode, cluster bool, dbIDs []int64, mlog *log.MLogger) {
+func (q *QuotaCenter) forceDenyReading(errorCode commonpb.ErrorCode, cluster bool, dbIDs, collectionIDs []int64, mlog *log.MLogger) {
 	if cluster {
 		var collectionIDs []int64
 		for dbID, collectionIDToPartIDs := range q.readableCollections {
@@ -749,6 +756,31 @@ func (q *QuotaCenter) forceDenyReading(errorCode commonpb.ErrorCode, cluster boo
 				zap.String("reason", errorCode.String()))
 		}
 	}
+
+	for _, collectionID := range collectionIDs {
+		dbID, ok := q.collectionIDToDBID.Get(collectionID)
+		if !ok {
+			log.Warn("cannot find db for collection", zap.Int64("collection", collectionID))
+			continue
+		}
+		collectionLimiter := q.rateLimiter.GetCollectionLimiters(dbID, collectionID)
+		if collectionLimiter == nil {
+			log.Warn("collection limiter not found of collection ID",
+				zap.Int64("dbID", dbID),
+				zap.Int64("collectionID", collectionID))
+			continue
+		}
+		updateLimiter(collectionLimiter, GetEarliestLimiter(), &LimiterRange{
+			RateScope: internalpb.RateScope_Collection,
+			OpType:    dql,
+		})
+		collectionLimiter.GetQuotaStates().Insert(milvuspb.QuotaState_DenyToRead, errorCode)
+	}
+	if len(collectionIDs) > 0 {
+		mlog.RatedWarn(10, "QuotaCenter force to deny reading",
+			zap.Int64s("collectionIDs", collectionIDs),
+			zap.String("reason", errorCode.String()))
+	}
 }
 
 // getRealTimeRate return real time rate in Proxy.
@@ -796,14 +828,46 @@ func (q *QuotaCenter) getDenyReadingDBs() map[int64]struct{} {
 // calculateReadRates calculates and sets dql rates.
 func (q *QuotaCenter) calculateReadRates() error {
 	log := log.Ctx(context.Background()).WithRateGroup("rootcoord.QuotaCenter", 1.0, 60.0)
+	deniedCollectionIDs := typeutil.NewSet(q.getForcedCollections(common.CollectionForceDenyReadingKey)...)
+	// the collection force deny takes precedence over the collection force allow
+	allowedCollectionIDs := typeutil.NewSet(q.getForcedCollections(common.CollectionForceAllowReadingKey)...).Complement(deniedCollectionIDs)
+
 	if Params.QuotaConfig.ForceDenyReading.GetAsBool() {
-		q.forceDenyReading(commonpb.ErrorCode_ForceDeny, true, []int64{}, log)
+		if allowedCollectionIDs.Len() == 0 {
+			q.forceDenyReading(commonpb.ErrorCode_ForceDeny, true, []int64{}, nil, log)
+			return nil
+		}
+		for _, collectionIDToPartIDs := range q.readableCollections {
+			for collectionID := range collectionIDToPartIDs {
+				if !allowedCollectionIDs.Contain(collectionID) {
+					deniedCollectionIDs.Insert(collectionID)
+				}
+			}
+		}
+		q.forceDenyReading(commonpb.ErrorCode_ForceDeny, false, nil, deniedCollectionIDs.Collect(), log)
 		return nil
 	}
 
 	deniedDatabaseIDs := q.getDenyReadingDBs()
-	if len(deniedDatabaseIDs) != 0 {
-		q.forceDenyReading(commonpb.ErrorCode_ForceDeny, false, maps.Keys(deniedDatabaseIDs), log)
+	for dbID, collectionIDToPartIDs := range q.readableCollections {
+		if _, ok := deniedDatabaseIDs[dbID]; !ok {
+			continue
+		}
+		if !lo.SomeBy(maps.Keys(collectionIDToPartIDs), func(collectionID int64) bool {
+			return allowedCollectionIDs.Contain(collectionID)
+		}) {
+			continue
+		}
+		// deny the collections of the database one by one to keep the force allowed ones readable
+		delete(deniedDatabaseIDs, dbID)
+		for collectionID := range collectionIDToPartIDs {
+			if !allowedCollectionIDs.Contain(collectionID) {
+				deniedCollectionIDs.Insert(collectionID)
+			}
+		}
+	}
+	if len(deniedDatabaseIDs) != 0 || deniedCollectionIDs.Len() != 0 {
+		q.forceDenyReading(commonpb.ErrorCode_ForceDeny, false, maps.Keys(deniedDatabaseIDs), deniedCollectionIDs.Collect(), log)
 	}
 	return nil
 }
@@ -828,6 +892,32 @@ func (q *QuotaCenter) getDenyWritingDBs() map[int64]struct{} {
 	return dbIDs
 }
 
+// getForcedCollections returns the collections whose force deny/allow property is enabled.
+func (q *QuotaCenter) getForcedCollections(key string) []int64 {
+	collectionIDs := make([]int64, 0)
+	for _, collectionID := range q.collectionIDToDBID.Keys() {
+		if isCollectionForced(q.getCollectionLimitProperties(collectionID), key) {
+			collectionIDs = append(collectionIDs, collectionID)
+		}
+	}
+	return collectionIDs
+}
+
+func isCollectionForced(properties map[string]string, key string) bool {
+	v, ok := properties[key]
+	if !ok || v == "" {
+		return false
+	}
+	enabled, err := strconv.ParseBool(v)
+	if err != nil {
+		log.Warn("invalid configuration for collection force deny/allow",
+			zap.String("config item", key),
+			zap.String("config value", v))
+		return false
+	}
+	return enabled
+}
+
 // calculateWriteRates calculates and sets dml rates.
 func (q *QuotaCenter) calculateWriteRates() error {
 	log := log.Ctx(context.Background()).WithRateGroup("rootcoord.QuotaCenter", 1.0, 60.0)
@@ -838,8 +928,10 @@ func (q *QuotaCenter) calculateWriteRates() error {
 
 	// check force deny writing of db level
 	dbIDs := q.getDenyWritingDBs()
-	if len(dbIDs) != 0 {
-		if err := q.forceDenyWriting(commonpb.ErrorCode_ForceDeny, false, maps.Keys(dbIDs), nil, nil); err != nil {
+	// check force deny writing of collection level
+	deniedCollectionIDs := q.getForcedCollections(common.CollectionForceDenyWritingKey)
+	if len(dbIDs) != 0 || len(deniedCollectionIDs) != 0 {
+		if err := q.forceDenyWriting(commonpb.ErrorCode_ForceDeny, false, maps.Keys(dbIDs), deniedCollectionIDs, nil); err != nil {
 			return err
 		}
 	}
@@ -869,6 +961,10 @@ func (q *QuotaCenter) calculateWriteRates() error {
 	updateCollectionFactor(memFactors)
 	growingSegFactors := q.getGrowingSegmentsSizeFactor()
 	updateCollectionFactor(growingSegFactors)
+	cpuThrottlingFactors := q.getCPUThrottlingFactor()
+	updateCollectionFactor(cpuThrottlingFactors)
+	trendFactors := q.getTrendFactor()
+	updateCollectionFactor(trendFactors)
 	l0Factors := q.getL0SegmentsSizeFactor()
 	updateCollectionFactor(l0Factors)
 	deleteBufferRowCountFactors := q.getDeleteBufferRowCountFactor()
@@ -880,6 +976,14 @@ func (q *QuotaCenter) calculateWriteRates() error {
 	memoryCollections := make([]int64, 0)
 
 	for collection, factor := range collectionFactors {
+		if lo.Contains(deniedCollectionIDs, collection) {
+			continue
+		}
+		collectionProps := q.getCollectionLimitProperties(collection)
+		if isCollectionForced(collectionProps, common.CollectionForceAllowWritingKey) {
+			metrics.RootCoordRateLimitRatio.WithLabelValues(fmt.Sprint(collection)).Set(0)
+			continue
+		}
 		metrics.RootCoordRateLimitRatio.WithLabelValues(fmt.Sprint(collection)).Set(1 - factor)
 		if factor <= 0 {
 			if _, ok := ttFactors[collection]; ok && factor == ttFactors[collection] {
@@ -914,7 +1018,6 @@ func (q *QuotaCenter) calculateWriteRates() error {
 			}
 		}
 
-		collectionProps := q.getCollectionLimitProperties(collection)
 		q.guaranteeMinRate(getCollectionRateLimitConfig(collectionProps, common.CollectionInsertRateMinKey),
 			internalpb.RateType_DMLInsert, collectionLimiter)
 		q.guaranteeMinRate(getCollectionRateLimitConfig(collectionProps, common.CollectionUpsertRateMinKey),
@@ -1154,6 +1257,52 @@ func (q *QuotaCenter) getGrowingSegmentsSizeFactor() map[int64]float64 {
 	return collectionFactor
 }
 
+// getCPUThrottlingFactor checks whether any node is throttled by the cgroup cpu quota,
+// and return the factor according to the cpu throttled ratio.
+func (q *QuotaCenter) getCPUThrottlingFactor() map[int64]float64 {
+	log := log.Ctx(context.Background()).WithRateGroup("rootcoord.QuotaCenter", 1.0, 60.0)
+	if !Params.QuotaConfig.CPUThrottlingProtectionEnabled.GetAsBool() {
+		return make(map[int64]float64)
+	}
+
+	low := Params.QuotaConfig.CPUThrottlingLowWaterLevel.GetAsFloat()
+	high := Params.QuotaConfig.CPUThrottlingHighWaterLevel.GetAsFloat()
+	minRateRatio := Params.QuotaConfig.CPUThrottlingMinRateRatio.GetAsFloat()
+
+	collectionFactor := make(map[int64]float64)
+	updateCollectionFactor := func(role string, nodeID int64, hms metricsinfo.HardwareMetrics, collections []int64) {
+		cur := hms.CPUThrottledRatio
+		if cur <= low {
+			return
+		}
+		factor := (high - cur) / (high - low)
+		if factor < minRateRatio {
+			factor = minRateRatio
+		}
+		for _, collection := range collections {
+			_, ok := collectionFactor[collection]
+			if !ok || collectionFactor[collection] > factor {
+				collectionFactor[collection] = factor
+			}
+		}
+		log.RatedWarn(10, "QuotaCenter: cpu throttled ratio exceeds watermark, limit writing rate",
+			zap.String("Node", fmt.Sprintf("%s-%d", role, nodeID)),
+			zap.Int64s("collections", collections),
+			zap.Float64("CPULimit", hms.CPULimit),
+			zap.Float64("curWatermark", cur),
+			zap.Float64("lowWatermark", low),
+			zap.Float64("highWatermark", high),
+			zap.Float64("factor", factor))
+	}
+	for nodeID, metric := range q.queryNodeMetrics {
+		updateCollectionFactor(typeutil.QueryNodeRole, nodeID, metric.Hms, metric.Effect.CollectionIDs)
+	}
+	for nodeID, metric := range q.dataNodeMetrics {
+		updateCollectionFactor(typeutil.DataNodeRole, nodeID, metric.Hms, metric.Effect.CollectionIDs)
+	}
+	return collectionFactor
+}
+
 // getL0SegmentsSizeFactor checks wether any collection
 func (q *QuotaCenter) getL0SegmentsSizeFactor() map[int64]float64 {
 	if !Params.QuotaConfig.L0SegmentRowCountProtectionEnabled.GetAsBool() {
@@ -1400,7 +1549,7 @@ func (q *QuotaCenter) checkDiskQuota(denyWritingDBs map[int64]struct{}) error {
 	for collection, binlogSize := range q.dataCoordMetrics.CollectionBinlogSize {
 		collectionProps := q.getCollectionLimitProperties(collection)
 		colDiskQuota := getRateLimitConfig(collectionProps, common.CollectionDiskQuotaKey, collectionDiskQuota)
-		if float64(binlogSize) >= colDiskQuota {
+		if float64(binlogSize) >= colDiskQuota && !isCollectionForced(collectionProps, common.CollectionForceAllowWritingKey) {
 			log.RatedWarn(10, "collection disk quota exceeded",
 				zap.Int64("collection", collection),
 				zap.Int64("coll disk usage", binlogSize),
@@ -1425,6 +1574,9 @@ func (q *QuotaCenter) checkDiskQuota(denyWritingDBs map[int64]struct{}) error {
 	col2partitions := make(map[int64][]int64)
 	partitionDiskQuota := Params.QuotaConfig.DiskQuotaPerPartition.GetAsFloat()
 	for collection, partitions := range q.dataCoordMetrics.PartitionsBinlogSize {
+		if isCollectionForced(q.getCollectionLimitProperties(collection), common.CollectionForceAllowWritingKey) {
+			continue
+		}
 		for partition, binlogSize := range partitions {
 			if float64(binlogSize) >= partitionDiskQuota {
 				log.RatedWarn(10, "partition disk quota exceeded",
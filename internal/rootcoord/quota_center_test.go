@@ -763,6 +763,138 @@ func TestQuotaCenter(t *testing.T) {
 		paramtable.Get().Save(Params.QuotaConfig.TtProtectionEnabled.Key, disableTtBak)
 	})
 
+	t.Run("test collection force deny and allow", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByID(mock.Anything, mock.Anything, mock.Anything).Return(nil, merr.ErrDatabaseNotFound).Maybe()
+		meta.EXPECT().GetCollectionByIDWithMaxTs(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, collectionID int64) (*model.Collection, error) {
+				switch collectionID {
+				case 1:
+					return &model.Collection{CollectionID: 1, Properties: []*commonpb.KeyValuePair{
+						{Key: common.CollectionForceDenyWritingKey, Value: "true"},
+						{Key: common.CollectionForceDenyReadingKey, Value: "true"},
+						{Key: common.CollectionForceAllowWritingKey, Value: "true"},
+					}}, nil
+				case 2:
+					return &model.Collection{CollectionID: 2, Properties: []*commonpb.KeyValuePair{
+						{Key: common.CollectionForceAllowWritingKey, Value: "true"},
+						{Key: common.CollectionForceDenyReadingKey, Value: "invalid"},
+					}}, nil
+				}
+				return nil, merr.ErrCollectionNotFound
+			}).Maybe()
+		quotaCenter := NewQuotaCenter(pcm, dc, core.tsoAllocator, meta)
+		quotaCenter.writableCollections = map[int64]map[int64][]int64{
+			0: collectionIDToPartitionIDs,
+		}
+		quotaCenter.readableCollections = quotaCenter.writableCollections
+		meta.EXPECT().ListAllAvailPartitions(mock.Anything).Return(quotaCenter.writableCollections).Maybe()
+		quotaCenter.collectionIDToDBID = collectionIDToDBID
+		assert.NoError(t, quotaCenter.resetAllCurrentRates())
+
+		paramtable.Get().Save(Params.QuotaConfig.TtProtectionEnabled.Key, "false")
+		defer paramtable.Get().Reset(Params.QuotaConfig.TtProtectionEnabled.Key)
+		quotaCenter.queryNodeMetrics = map[UniqueID]*metricsinfo.QueryNodeQuotaMetrics{
+			0: {
+				Hms: metricsinfo.HardwareMetrics{
+					Memory:      100,
+					MemoryUsage: 100,
+				},
+				Effect: metricsinfo.NodeEffect{CollectionIDs: []int64{1, 2, 3}},
+			},
+		}
+		assert.NoError(t, quotaCenter.calculateWriteRates())
+		assert.NoError(t, quotaCenter.calculateReadRates())
+
+		expectedWriteStates := map[int64]commonpb.ErrorCode{
+			1: commonpb.ErrorCode_ForceDeny,
+			2: commonpb.ErrorCode_Success,
+			3: commonpb.ErrorCode_MemoryQuotaExhausted,
+		}
+		for collectionID, expected := range expectedWriteStates {
+			rln := quotaCenter.rateLimiter.GetCollectionLimiters(0, collectionID)
+			code, _ := rln.GetQuotaStates().Get(milvuspb.QuotaState_DenyToWrite)
+			assert.Equal(t, expected, code, "collection %d", collectionID)
+
+			code, _ = rln.GetQuotaStates().Get(milvuspb.QuotaState_DenyToRead)
+			search, _ := rln.GetLimiters().Get(internalpb.RateType_DQLSearch)
+			if collectionID == 1 {
+				assert.Equal(t, commonpb.ErrorCode_ForceDeny, code)
+				assert.Equal(t, Limit(0), search.Limit())
+			} else {
+				assert.Equal(t, commonpb.ErrorCode_Success, code)
+				assert.NotEqual(t, Limit(0), search.Limit())
+			}
+		}
+	})
+
+	t.Run("test collection force allow reading", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByID(mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, dbID int64, ts uint64) (*model.Database, error) {
+				return &model.Database{ID: dbID, Properties: []*commonpb.KeyValuePair{
+					{Key: common.DatabaseForceDenyReadingKey, Value: "true"},
+				}}, nil
+			}).Maybe()
+		meta.EXPECT().GetCollectionByIDWithMaxTs(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, collectionID int64) (*model.Collection, error) {
+				switch collectionID {
+				case 1:
+					return &model.Collection{CollectionID: 1, Properties: []*commonpb.KeyValuePair{
+						{Key: common.CollectionForceDenyReadingKey, Value: "true"},
+						{Key: common.CollectionForceAllowReadingKey, Value: "true"},
+					}}, nil
+				case 2:
+					return &model.Collection{CollectionID: 2, Properties: []*commonpb.KeyValuePair{
+						{Key: common.CollectionForceAllowReadingKey, Value: "true"},
+					}}, nil
+				}
+				return nil, merr.ErrCollectionNotFound
+			}).Maybe()
+		quotaCenter := NewQuotaCenter(pcm, dc, core.tsoAllocator, meta)
+		quotaCenter.readableCollections = map[int64]map[int64][]int64{
+			0: collectionIDToPartitionIDs,
+			1: {4: {}},
+		}
+		meta.EXPECT().ListAllAvailPartitions(mock.Anything).Return(quotaCenter.readableCollections).Maybe()
+		quotaCenter.collectionIDToDBID = collectionIDToDBID
+
+		checkReadStates := func() {
+			expected := map[int64]bool{1: false, 2: true, 3: false}
+			for collectionID, readable := range expected {
+				rln := quotaCenter.rateLimiter.GetCollectionLimiters(0, collectionID)
+				code, _ := rln.GetQuotaStates().Get(milvuspb.QuotaState_DenyToRead)
+				search, _ := rln.GetLimiters().Get(internalpb.RateType_DQLSearch)
+				if readable {
+					assert.Equal(t, commonpb.ErrorCode_Success, code, "collection %d", collectionID)
+					assert.NotEqual(t, Limit(0), search.Limit(), "collection %d", collectionID)
+				} else {
+					assert.Equal(t, commonpb.ErrorCode_ForceDeny, code, "collection %d", collectionID)
+					assert.Equal(t, Limit(0), search.Limit(), "collection %d", collectionID)
+				}
+			}
+		}
+
+		// database force deny reading
+		assert.NoError(t, quotaCenter.resetAllCurrentRates())
+		assert.NoError(t, quotaCenter.calculateReadRates())
+		checkReadStates()
+		// the database without force allowed collections is still denied at the database level
+		search, _ := quotaCenter.rateLimiter.GetDatabaseLimiters(1).GetLimiters().Get(internalpb.RateType_DQLSearch)
+		assert.Equal(t, Limit(0), search.Limit())
+		search, _ = quotaCenter.rateLimiter.GetDatabaseLimiters(0).GetLimiters().Get(internalpb.RateType_DQLSearch)
+		assert.NotEqual(t, Limit(0), search.Limit())
+
+		// cluster force deny reading
+		paramtable.Get().Save(Params.QuotaConfig.ForceDenyReading.Key, "true")
+		defer paramtable.Get().Reset(Params.QuotaConfig.ForceDenyReading.Key)
+		assert.NoError(t, quotaCenter.resetAllCurrentRates())
+		assert.NoError(t, quotaCenter.calculateReadRates())
+		checkReadStates()
+		code, _ := quotaCenter.rateLimiter.GetCollectionLimiters(1, 4).GetQuotaStates().Get(milvuspb.QuotaState_DenyToRead)
+		assert.Equal(t, commonpb.ErrorCode_ForceDeny, code)
+	})
+
 	t.Run("test MemoryFactor factors", func(t *testing.T) {
 		meta := mockrootcoord.NewIMetaTable(t)
 		meta.EXPECT().GetCollectionByIDWithMaxTs(mock.Anything, mock.Anything).Return(nil, merr.ErrCollectionNotFound).Maybe()
@@ -877,6 +1009,64 @@ func TestQuotaCenter(t *testing.T) {
 		paramtable.Get().Reset(Params.QuotaConfig.GrowingSegmentsSizeHighWaterLevel.Key)
 	})
 
+	t.Run("test CPUThrottling factors", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByIDWithMaxTs(mock.Anything, mock.Anything).Return(nil, merr.ErrCollectionNotFound).Maybe()
+		quotaCenter := NewQuotaCenter(pcm, dc, core.tsoAllocator, meta)
+		defaultRatio := Params.QuotaConfig.CPUThrottlingMinRateRatio.GetAsFloat()
+		tests := []struct {
+			throttledRatio float64
+			expectedFactor float64
+		}{
+			{0.1, 1},
+			{0.2, 1},
+			{0.25, 0.833},
+			{0.3, 0.667},
+			{0.4, defaultRatio},
+			{0.9, defaultRatio},
+		}
+
+		factors := quotaCenter.getCPUThrottlingFactor()
+		assert.Empty(t, factors)
+
+		paramtable.Get().Save(Params.QuotaConfig.CPUThrottlingProtectionEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.QuotaConfig.CPUThrottlingProtectionEnabled.Key)
+		paramtable.Get().Save(Params.QuotaConfig.CPUThrottlingLowWaterLevel.Key, "0.2")
+		defer paramtable.Get().Reset(Params.QuotaConfig.CPUThrottlingLowWaterLevel.Key)
+		paramtable.Get().Save(Params.QuotaConfig.CPUThrottlingHighWaterLevel.Key, "0.35")
+		defer paramtable.Get().Reset(Params.QuotaConfig.CPUThrottlingHighWaterLevel.Key)
+		for _, test := range tests {
+			quotaCenter.queryNodeMetrics = map[UniqueID]*metricsinfo.QueryNodeQuotaMetrics{
+				1: {
+					Hms: metricsinfo.HardwareMetrics{
+						CPUThrottledRatio: test.throttledRatio,
+					},
+					Effect: metricsinfo.NodeEffect{
+						NodeID:        1,
+						CollectionIDs: []int64{1, 2},
+					},
+				},
+			}
+			quotaCenter.dataNodeMetrics = map[UniqueID]*metricsinfo.DataNodeQuotaMetrics{
+				2: {
+					Effect: metricsinfo.NodeEffect{
+						NodeID:        2,
+						CollectionIDs: []int64{3},
+					},
+				},
+			}
+			factors := quotaCenter.getCPUThrottlingFactor()
+			if test.expectedFactor == 1 {
+				assert.Empty(t, factors)
+				continue
+			}
+			assert.Len(t, factors, 2)
+			for _, factor := range factors {
+				assert.InDelta(t, test.expectedFactor, factor, 0.01)
+			}
+		}
+	})
+
 	t.Run("test checkDiskQuota", func(t *testing.T) {
 		meta := mockrootcoord.NewIMetaTable(t)
 		meta.EXPECT().GetCollectionByIDWithMaxTs(mock.Anything, mock.Anything).Return(nil, merr.ErrCollectionNotFound).Maybe()
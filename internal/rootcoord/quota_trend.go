@@ -0,0 +1,197 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const trendKeyClusterBinlogSize = "disk/cluster"
+
+func trendKeyCollectionBinlogSize(collectionID int64) string {
+	return fmt.Sprintf("disk/collection/%d", collectionID)
+}
+
+func trendKeyNodeMemory(role string, nodeID int64) string {
+	return fmt.Sprintf("memory/%s/%d", role, nodeID)
+}
+
+// trendSample is the value observed at the time.
+type trendSample struct {
+	value float64
+	ts    time.Time
+}
+
+// trendTracker keeps the samples of the values in the window to estimate the growth rates of them.
+type trendTracker struct {
+	samples map[string][]trendSample
+}
+
+func newTrendTracker() *trendTracker {
+	return &trendTracker{
+		samples: make(map[string][]trendSample),
+	}
+}
+
+// observe records the values at the time, the samples out of the window and the keys not observed anymore are removed.
+func (t *trendTracker) observe(values map[string]float64, now time.Time, window time.Duration) {
+	for key := range t.samples {
+		if _, ok := values[key]; !ok {
+			delete(t.samples, key)
+		}
+	}
+	for key, value := range values {
+		samples := append(t.samples[key], trendSample{value: value, ts: now})
+		i := 0
+		for i < len(samples)-1 && now.Sub(samples[i].ts) > window {
+			i++
+		}
+		t.samples[key] = samples[i:]
+	}
+}
+
+// rate returns the growth rate per second of the value, which is estimated by the oldest and the latest samples in the window.
+func (t *trendTracker) rate(key string) (float64, bool) {
+	samples := t.samples[key]
+	if len(samples) < 2 {
+		return 0, false
+	}
+	first, last := samples[0], samples[len(samples)-1]
+	elapsed := last.ts.Sub(first.ts).Seconds()
+	if elapsed <= 0 {
+		return 0, false
+	}
+	return (last.value - first.value) / elapsed, true
+}
+
+// trendFactor returns the factor to taper the rate if the value is projected to hit the limit within the horizon,
+// the factor is in proportion to the time left before the limit is hit, but not lower than minRateRatio.
+// The value which already hits the limit is left to the hard protections.
+func trendFactor(cur, limit, rate float64, horizon time.Duration, minRateRatio float64) float64 {
+	if rate <= 0 || cur >= limit || horizon <= 0 {
+		return 1
+	}
+	timeToLimit := (limit - cur) / rate
+	if timeToLimit >= horizon.Seconds() {
+		return 1
+	}
+	return math.Max(timeToLimit/horizon.Seconds(), minRateRatio)
+}
+
+// observeTrends records the binlog size and the memory water levels for the trend projection.
+func (q *QuotaCenter) observeTrends(now time.Time) {
+	if !Params.QuotaConfig.TrendProtectionEnabled.GetAsBool() {
+		return
+	}
+	values := make(map[string]float64)
+	q.diskMu.Lock()
+	if q.dataCoordMetrics != nil {
+		values[trendKeyClusterBinlogSize] = float64(q.dataCoordMetrics.TotalBinlogSize)
+		for collectionID, binlogSize := range q.dataCoordMetrics.CollectionBinlogSize {
+			values[trendKeyCollectionBinlogSize(collectionID)] = float64(binlogSize)
+		}
+	}
+	q.diskMu.Unlock()
+	for nodeID, metric := range q.queryNodeMetrics {
+		if metric.Hms.Memory > 0 {
+			values[trendKeyNodeMemory(typeutil.QueryNodeRole, nodeID)] = float64(metric.Hms.MemoryUsage) / float64(metric.Hms.Memory)
+		}
+	}
+	for nodeID, metric := range q.dataNodeMetrics {
+		if metric.Hms.Memory > 0 {
+			values[trendKeyNodeMemory(typeutil.DataNodeRole, nodeID)] = float64(metric.Hms.MemoryUsage) / float64(metric.Hms.Memory)
+		}
+	}
+	q.trends.observe(values, now, Params.QuotaConfig.TrendProtectionWindow.GetAsDuration(time.Second))
+}
+
+// getTrendFactor projects the binlog size and the memory water levels with their growth rates,
+// and return the factor to taper the writing before the disk quota or the memory high water level is hit.
+func (q *QuotaCenter) getTrendFactor() map[int64]float64 {
+	log := log.Ctx(context.Background()).WithRateGroup("rootcoord.QuotaCenter", 1.0, 60.0)
+	collectionFactor := make(map[int64]float64)
+	if !Params.QuotaConfig.TrendProtectionEnabled.GetAsBool() {
+		return collectionFactor
+	}
+
+	horizon := Params.QuotaConfig.TrendProtectionHorizon.GetAsDuration(time.Second)
+	minRateRatio := Params.QuotaConfig.TrendProtectionMinRateRatio.GetAsFloat()
+	updateCollectionFactor := func(key string, cur, limit float64, collections []int64) {
+		rate, ok := q.trends.rate(key)
+		if !ok {
+			return
+		}
+		factor := trendFactor(cur, limit, rate, horizon, minRateRatio)
+		if factor >= 1 {
+			return
+		}
+		for _, collection := range collections {
+			_, ok := collectionFactor[collection]
+			if !ok || collectionFactor[collection] > factor {
+				collectionFactor[collection] = factor
+			}
+		}
+		log.RatedWarn(10, "QuotaCenter: projected to hit the limit within the horizon, limit writing rate",
+			zap.String("trend", key),
+			zap.Float64("current", cur),
+			zap.Float64("limit", limit),
+			zap.Float64("ratePerSecond", rate),
+			zap.Duration("horizon", horizon),
+			zap.Float64("factor", factor))
+	}
+
+	if Params.QuotaConfig.DiskProtectionEnabled.GetAsBool() {
+		q.diskMu.Lock()
+		if q.dataCoordMetrics != nil {
+			updateCollectionFactor(trendKeyClusterBinlogSize, float64(q.dataCoordMetrics.TotalBinlogSize),
+				Params.QuotaConfig.DiskQuota.GetAsFloat(), q.collectionIDToDBID.Keys())
+			collectionDiskQuota := Params.QuotaConfig.DiskQuotaPerCollection.GetAsFloat()
+			for collection, binlogSize := range q.dataCoordMetrics.CollectionBinlogSize {
+				colDiskQuota := getRateLimitConfig(q.getCollectionLimitProperties(collection), common.CollectionDiskQuotaKey, collectionDiskQuota)
+				updateCollectionFactor(trendKeyCollectionBinlogSize(collection), float64(binlogSize), colDiskQuota, []int64{collection})
+			}
+		}
+		q.diskMu.Unlock()
+	}
+
+	if Params.QuotaConfig.MemProtectionEnabled.GetAsBool() {
+		queryNodeMemoryHighWaterLevel := Params.QuotaConfig.QueryNodeMemoryHighWaterLevel.GetAsFloat()
+		for nodeID, metric := range q.queryNodeMetrics {
+			if metric.Hms.Memory > 0 {
+				updateCollectionFactor(trendKeyNodeMemory(typeutil.QueryNodeRole, nodeID),
+					float64(metric.Hms.MemoryUsage)/float64(metric.Hms.Memory), queryNodeMemoryHighWaterLevel, metric.Effect.CollectionIDs)
+			}
+		}
+		dataNodeMemoryHighWaterLevel := Params.QuotaConfig.DataNodeMemoryHighWaterLevel.GetAsFloat()
+		for nodeID, metric := range q.dataNodeMetrics {
+			if metric.Hms.Memory > 0 {
+				updateCollectionFactor(trendKeyNodeMemory(typeutil.DataNodeRole, nodeID),
+					float64(metric.Hms.MemoryUsage)/float64(metric.Hms.Memory), dataNodeMemoryHighWaterLevel, metric.Effect.CollectionIDs)
+			}
+		}
+	}
+	return collectionFactor
+}
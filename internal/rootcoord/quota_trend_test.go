@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestTrendTracker(t *testing.T) {
+	tracker := newTrendTracker()
+	now := time.Now()
+	window := 100 * time.Second
+
+	tracker.observe(map[string]float64{"a": 10, "b": 1}, now, window)
+	_, ok := tracker.rate("a")
+	assert.False(t, ok)
+
+	tracker.observe(map[string]float64{"a": 20, "b": 1}, now.Add(10*time.Second), window)
+	rate, ok := tracker.rate("a")
+	assert.True(t, ok)
+	assert.InDelta(t, 1, rate, 1e-6)
+
+	// the samples out of the window are removed
+	tracker.observe(map[string]float64{"a": 30}, now.Add(110*time.Second), window)
+	rate, ok = tracker.rate("a")
+	assert.True(t, ok)
+	assert.InDelta(t, 0.1, rate, 1e-6)
+
+	// the keys not observed are removed
+	_, ok = tracker.rate("b")
+	assert.False(t, ok)
+	assert.NotContains(t, tracker.samples, "b")
+}
+
+func TestTrendFactor(t *testing.T) {
+	horizon := 100 * time.Second
+	assert.Equal(t, 1.0, trendFactor(50, 100, 0, horizon, 0.5))
+	assert.Equal(t, 1.0, trendFactor(50, 100, -1, horizon, 0.5))
+	assert.Equal(t, 1.0, trendFactor(100, 100, 1, horizon, 0.5))
+	assert.Equal(t, 1.0, trendFactor(50, 100, 0.5, horizon, 0.5))
+	assert.InDelta(t, 0.8, trendFactor(20, 100, 1, horizon, 0.5), 1e-6)
+	assert.InDelta(t, 0.5, trendFactor(90, 100, 1, horizon, 0.5), 1e-6)
+}
+
+func TestQuotaCenterTrendFactor(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	q := &QuotaCenter{
+		queryNodeMetrics:   make(map[int64]*metricsinfo.QueryNodeQuotaMetrics),
+		dataNodeMetrics:    make(map[int64]*metricsinfo.DataNodeQuotaMetrics),
+		collectionIDToDBID: typeutil.NewConcurrentMap[int64, int64](),
+		trends:             newTrendTracker(),
+	}
+	setMemory := func(used uint64) {
+		q.queryNodeMetrics[1] = &metricsinfo.QueryNodeQuotaMetrics{
+			Hms:    metricsinfo.HardwareMetrics{Memory: 100, MemoryUsage: used},
+			Effect: metricsinfo.NodeEffect{NodeID: 1, CollectionIDs: []int64{1}},
+		}
+	}
+
+	// disabled
+	setMemory(50)
+	q.observeTrends(time.Now())
+	assert.Empty(t, q.trends.samples)
+	assert.Empty(t, q.getTrendFactor())
+
+	params.Save(params.QuotaConfig.TrendProtectionEnabled.Key, "true")
+	defer params.Reset(params.QuotaConfig.TrendProtectionEnabled.Key)
+	params.Save(params.QuotaConfig.TrendProtectionHorizon.Key, "100")
+	defer params.Reset(params.QuotaConfig.TrendProtectionHorizon.Key)
+	params.Save(params.QuotaConfig.QueryNodeMemoryHighWaterLevel.Key, "0.95")
+	defer params.Reset(params.QuotaConfig.QueryNodeMemoryHighWaterLevel.Key)
+
+	now := time.Now()
+	q.observeTrends(now)
+	assert.Empty(t, q.getTrendFactor())
+
+	// the water level grows 0.5% per second, the high water level is hit in 80 seconds
+	setMemory(55)
+	q.observeTrends(now.Add(10 * time.Second))
+	factors := q.getTrendFactor()
+	assert.Len(t, factors, 1)
+	assert.InDelta(t, 0.8, factors[1], 1e-6)
+
+	// the growth slows down, no projected hit within the horizon
+	setMemory(56)
+	q.observeTrends(now.Add(500 * time.Second))
+	assert.Empty(t, q.getTrendFactor())
+}
@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"sort"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/ratelimitutil"
+)
+
+// getUsage summarizes the usage of the collections and users by the metrics collected in last round,
+// collectionID and user filter the report if they are not zero value.
+func (q *QuotaCenter) getUsage(collectionID int64, user string) *rootcoordpb.GetUsageResponse {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+
+	// the rates of the sub labels are summed up from all proxies
+	collectionRates := make(map[lo.Tuple2[string, string]]map[string]float64)
+	userRates := make(map[string]map[string]float64)
+	addRate := func(rates map[string]float64, label string, rate float64) map[string]float64 {
+		if rates == nil {
+			rates = make(map[string]float64)
+		}
+		rates[label] += rate
+		return rates
+	}
+	for _, metric := range q.proxyMetrics {
+		for _, r := range metric.Rms {
+			if label, dbName, collectionName, ok := ratelimitutil.SplitCollectionSubLabel(r.Label); ok {
+				key := lo.T2(dbName, collectionName)
+				collectionRates[key] = addRate(collectionRates[key], label, r.Rate)
+			} else if label, userName, ok := ratelimitutil.SplitUserSubLabel(r.Label); ok {
+				userRates[userName] = addRate(userRates[userName], label, r.Rate)
+			}
+		}
+	}
+
+	usage := &rootcoordpb.GetUsageResponse{Status: merr.Success()}
+	if collectionID != 0 || user == "" {
+		usage.Collections = q.getCollectionUsages(collectionID, collectionRates)
+	}
+	if collectionID == 0 {
+		for userName, rates := range userRates {
+			if user != "" && user != userName {
+				continue
+			}
+			usage.Users = append(usage.Users, &rootcoordpb.UserUsage{User: userName, Rates: rates})
+		}
+		sort.Slice(usage.Users, func(i, j int) bool { return usage.Users[i].User < usage.Users[j].User })
+	}
+	return usage
+}
+
+func (q *QuotaCenter) getCollectionUsages(collectionID int64, collectionRates map[lo.Tuple2[string, string]]map[string]float64) []*rootcoordpb.CollectionUsage {
+	dbNames := make(map[int64]string, q.dbs.Len())
+	q.dbs.Range(func(name string, id int64) bool {
+		dbNames[id] = name
+		return true
+	})
+	var binlogSize map[int64]int64
+	q.diskMu.Lock()
+	if q.dataCoordMetrics != nil {
+		binlogSize = q.dataCoordMetrics.CollectionBinlogSize
+	}
+	q.diskMu.Unlock()
+
+	usages := make([]*rootcoordpb.CollectionUsage, 0)
+	q.collections.Range(func(key string, id int64) bool {
+		if collectionID != 0 && collectionID != id {
+			return true
+		}
+		dbID, collectionName := SplitCollectionKey(key)
+		usage := &rootcoordpb.CollectionUsage{
+			CollectionID:   id,
+			DbName:         dbNames[dbID],
+			CollectionName: collectionName,
+			BinlogBytes:    binlogSize[id],
+			Rates:          collectionRates[lo.T2(dbNames[dbID], collectionName)],
+		}
+		if info, ok := q.collectionMetrics[id]; ok {
+			usage.StoredRows = info.NumEntitiesTotal
+			usage.IndexBytes = info.IndexSize
+		}
+		if limiter := q.rateLimiter.GetCollectionLimiters(dbID, id); limiter != nil {
+			limiter.GetLimiters().Range(func(rt internalpb.RateType, l *ratelimitutil.Limiter) bool {
+				if l.Limit() != Inf {
+					if usage.Limits == nil {
+						usage.Limits = make(map[string]float64)
+					}
+					usage.Limits[rt.String()] = float64(l.Limit())
+				}
+				return true
+			})
+			limiter.GetQuotaStates().Range(func(state milvuspb.QuotaState, code commonpb.ErrorCode) bool {
+				usage.QuotaStates = append(usage.QuotaStates, state.String())
+				return true
+			})
+			sort.Strings(usage.QuotaStates)
+		}
+		usages = append(usages, usage)
+		return true
+	})
+	sort.Slice(usages, func(i, j int) bool { return usages[i].CollectionID < usages[j].CollectionID })
+	return usages
+}
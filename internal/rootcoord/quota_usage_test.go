@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	rlinternal "github.com/milvus-io/milvus/internal/util/ratelimitutil"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/ratelimitutil"
+)
+
+func TestQuotaCenterGetUsage(t *testing.T) {
+	paramtable.Init()
+	q := &QuotaCenter{
+		rateLimiter: rlinternal.NewRateLimiterTree(initInfLimiter(internalpb.RateScope_Cluster, allOps)),
+	}
+	q.clearMetrics()
+	q.dbs.Insert("db1", 1)
+	q.collections.Insert(FormatCollectionKey(1, "col1"), 100)
+	q.collections.Insert(FormatCollectionKey(1, "col2"), 200)
+	q.dataCoordMetrics = &metricsinfo.DataCoordQuotaMetrics{
+		CollectionBinlogSize: map[int64]int64{100: 1024},
+	}
+	q.collectionMetrics = map[int64]*metricsinfo.DataCoordCollectionInfo{
+		100: {NumEntitiesTotal: 10, IndexSize: 512},
+	}
+
+	insert := internalpb.RateType_DMLInsert.String()
+	search := internalpb.RateType_DQLSearch.String()
+	collectionLabel := ratelimitutil.GetCollectionSubLabel("db1", "col1")
+	for id := int64(1); id <= 2; id++ {
+		q.proxyMetrics[id] = &metricsinfo.ProxyQuotaMetrics{
+			Rms: []metricsinfo.RateMetric{
+				{Label: insert, Rate: 100},
+				{Label: ratelimitutil.FormatSubLabel(insert, collectionLabel), Rate: 100},
+				{Label: ratelimitutil.FormatSubLabel(search, collectionLabel), Rate: 5},
+				{Label: ratelimitutil.FormatSubLabel(insert, ratelimitutil.GetUserSubLabel("root")), Rate: 100},
+				{Label: ratelimitutil.FormatSubLabel(search, ratelimitutil.GetDBSubLabel("db1")), Rate: 5},
+			},
+		}
+	}
+
+	limiter := q.rateLimiter.GetOrCreateCollectionLimiters(1, 100,
+		newParamLimiterFunc(internalpb.RateScope_Database, allOps),
+		newParamLimiterFunc(internalpb.RateScope_Collection, allOps))
+	limiter.GetLimiters().Insert(internalpb.RateType_DMLInsert, ratelimitutil.NewLimiter(1000, 1000))
+	limiter.GetQuotaStates().Insert(milvuspb.QuotaState_DenyToWrite, commonpb.ErrorCode_DiskQuotaExhausted)
+
+	usage := q.getUsage(0, "")
+	assert.Len(t, usage.Collections, 2)
+	col1 := usage.Collections[0]
+	assert.Equal(t, int64(100), col1.CollectionID)
+	assert.Equal(t, "db1", col1.DbName)
+	assert.Equal(t, "col1", col1.CollectionName)
+	assert.Equal(t, int64(10), col1.StoredRows)
+	assert.Equal(t, int64(1024), col1.BinlogBytes)
+	assert.Equal(t, int64(512), col1.IndexBytes)
+	assert.Equal(t, map[string]float64{insert: 200, search: 10}, col1.Rates)
+	assert.Equal(t, float64(1000), col1.Limits[insert])
+	assert.Equal(t, []string{milvuspb.QuotaState_DenyToWrite.String()}, col1.QuotaStates)
+	assert.Empty(t, usage.Collections[1].Rates)
+	assert.Len(t, usage.Users, 1)
+	assert.Equal(t, "root", usage.Users[0].User)
+	assert.Equal(t, map[string]float64{insert: 200}, usage.Users[0].Rates)
+
+	usage = q.getUsage(200, "")
+	assert.Len(t, usage.Collections, 1)
+	assert.Empty(t, usage.Users)
+
+	usage = q.getUsage(0, "foo")
+	assert.Empty(t, usage.Collections)
+	assert.Empty(t, usage.Users)
+}
+
+func TestCore_GetUsage(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCore(withAbnormalCode())
+	resp, err := c.GetUsage(ctx, &rootcoordpb.GetUsageRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetStatus().GetErrorCode())
+
+	c = newTestCore(withHealthyCode())
+	resp, err = c.GetUsage(ctx, &rootcoordpb.GetUsageRequest{})
+	assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrServiceNotReady)
+
+	c.quotaCenter = &QuotaCenter{
+		rateLimiter: rlinternal.NewRateLimiterTree(initInfLimiter(internalpb.RateScope_Cluster, allOps)),
+	}
+	c.quotaCenter.clearMetrics()
+	c.quotaCenter.dbs.Insert("db1", 1)
+	c.quotaCenter.collections.Insert(FormatCollectionKey(1, "col1"), 100)
+	resp, err = c.GetUsage(ctx, &rootcoordpb.GetUsageRequest{CollectionID: 100})
+	assert.NoError(t, merr.CheckRPCCall(resp, err))
+	assert.Len(t, resp.GetCollections(), 1)
+	assert.Equal(t, "col1", resp.GetCollections()[0].GetCollectionName())
+}
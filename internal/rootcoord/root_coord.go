@@ -43,10 +43,12 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore"
 	kvmetastore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/rootcoord/ddlevent"
 	"github.com/milvus-io/milvus/internal/rootcoord/tombstone"
 	streamingcoord "github.com/milvus-io/milvus/internal/streamingcoord/server"
 	tso2 "github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/componentutil"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/proxyutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -130,6 +132,12 @@ type Core struct {
 	metricsRequest *metricsinfo.MetricsRequest
 
 	tombstoneSweeper tombstone.TombstoneSweeper
+
+	ddlEventPublisher *ddlevent.Publisher
+
+	ddlTasks *ddlTaskRegistry
+
+	collectionTemplates *collectionTemplateRegistry
 }
 
 // --------------------- function --------------------------
@@ -316,7 +324,8 @@ func (c *Core) initKVCreator() {
 		} else {
 			c.metaKVCreator = func() kv.MetaKv {
 				return etcdkv.NewEtcdKV(c.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue(),
-					etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+					etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)),
+					etcdkv.WithSharedBulkClient(&paramtable.Get().ServiceParam.EtcdCfg))
 			}
 		}
 	}
@@ -430,8 +439,17 @@ func (c *Core) initInternal() error {
 	}
 
 	c.scheduler = newScheduler(c.ctx, c.idAllocator, c.tsoAllocator)
+	c.ddlTasks = newDdlTaskRegistry(c.ctx, c.metaKVCreator(), c.scheduler)
+	c.collectionTemplates = newCollectionTemplateRegistry(c.ctx, c.metaKVCreator())
 
 	c.factory.Init(Params)
+	if Params.RootCoordCfg.DDLEventEnabled.GetAsBool() {
+		publisher, err := ddlevent.NewPublisher(initCtx, c.factory)
+		if err != nil {
+			return err
+		}
+		c.ddlEventPublisher = publisher
+	}
 	chanMap := c.meta.ListCollectionPhysicalChannels(c.ctx)
 	c.chanTimeTick = newTimeTickSync(initCtx, c.ctx, c.session.GetServerID(), c.factory, chanMap)
 	log.Info("create TimeTick sync done")
@@ -473,6 +491,10 @@ func (c *Core) registerMetricsRequest() {
 		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
 			return c.getSystemInfoMetrics(ctx, req)
 		})
+	c.metricsRequest.RegisterMetricsRequest(metricsinfo.DdlTaskKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			return c.getDdlTasksJSON(ctx, jsonReq)
+		})
 	log.Ctx(c.ctx).Info("register metrics actions finished")
 }
 
@@ -605,6 +627,17 @@ func (c *Core) restore(ctx context.Context) error {
 		return err
 	}
 
+	if c.ddlTasks != nil {
+		if err := c.ddlTasks.recover(); err != nil {
+			return err
+		}
+	}
+	if c.collectionTemplates != nil {
+		if err := c.collectionTemplates.recover(); err != nil {
+			return err
+		}
+	}
+
 	c.tombstoneSweeper = tombstone.NewTombstoneSweeper()
 	for _, db := range dbs {
 		colls, err := c.meta.ListCollections(ctx, db.Name, typeutil.MaxTimestamp, false)
@@ -716,6 +749,9 @@ func (c *Core) Stop() error {
 	if c.tombstoneSweeper != nil {
 		c.tombstoneSweeper.Close()
 	}
+	if c.ddlTasks != nil {
+		c.ddlTasks.Stop()
+	}
 	c.stopScheduler()
 
 	if c.proxyWatcher != nil {
@@ -728,6 +764,9 @@ func (c *Core) Stop() error {
 	c.revokeSession()
 	c.cancelIfNotNil()
 	c.wg.Wait()
+	if c.ddlEventPublisher != nil {
+		c.ddlEventPublisher.Close()
+	}
 	return nil
 }
 
@@ -885,6 +924,11 @@ func (c *Core) CreateCollection(ctx context.Context, in *milvuspb.CreateCollecti
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
+	if c.isAsyncDdl(ctx, in.GetBase()) {
+		return c.submitAsyncDdl(ctx, "CreateCollection", in.GetDbName(), in.GetCollectionName(), func(ctx context.Context) (*commonpb.Status, error) {
+			return c.CreateCollection(ctx, in)
+		}), nil
+	}
 	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateCollection", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("CreateCollection")
 
@@ -941,6 +985,11 @@ func (c *Core) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRe
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
+	if c.isAsyncDdl(ctx, in.GetBase()) {
+		return c.submitAsyncDdl(ctx, "DropCollection", in.GetDbName(), in.GetCollectionName(), func(ctx context.Context) (*commonpb.Status, error) {
+			return c.DropCollection(ctx, in)
+		}), nil
+	}
 	metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollection", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("DropCollection")
 
@@ -1339,6 +1388,123 @@ func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollection
 	return merr.Success(), nil
 }
 
+// SetCollectionQuotaOverride enables or disables a force deny/allow property of the collection,
+// the override is persisted as a collection property so it survives the restart of rootcoord.
+func (c *Core) SetCollectionQuotaOverride(ctx context.Context, in *rootcoordpb.SetCollectionQuotaOverrideRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()),
+		zap.String("collectionName", in.GetCollectionName()),
+		zap.String("key", in.GetKey()),
+		zap.Bool("enabled", in.GetEnabled()),
+	)
+	log.Info("received request to set collection quota override")
+
+	switch in.GetKey() {
+	case common.CollectionForceDenyWritingKey, common.CollectionForceDenyReadingKey,
+		common.CollectionForceAllowWritingKey, common.CollectionForceAllowReadingKey:
+	default:
+		return merr.Status(merr.WrapErrParameterInvalidMsg("invalid collection quota override key %s", in.GetKey())), nil
+	}
+
+	req := &milvuspb.AlterCollectionRequest{
+		Base:           in.GetBase(),
+		DbName:         in.GetDbName(),
+		CollectionName: in.GetCollectionName(),
+	}
+	if in.GetEnabled() {
+		req.Properties = []*commonpb.KeyValuePair{{Key: in.GetKey(), Value: "true"}}
+	} else {
+		req.DeleteKeys = []string{in.GetKey()}
+	}
+	status, err := c.AlterCollection(ctx, req)
+	if err := merr.CheckRPCCall(status, err); err != nil {
+		log.Warn("failed to set collection quota override", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("done to set collection quota override")
+	return merr.Success(), nil
+}
+
+// CreateCollectionTemplate creates a named preset of the schema skeleton, default indexes and properties.
+func (c *Core) CreateCollectionTemplate(ctx context.Context, in *rootcoordpb.CreateCollectionTemplateRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("name", in.GetTemplate().GetName()))
+	log.Info("received request to create collection template")
+	if in.GetTemplate() == nil {
+		return merr.Status(merr.WrapErrParameterMissing("template")), nil
+	}
+	if err := c.collectionTemplates.Create(ctx, in.GetTemplate()); err != nil {
+		log.Warn("failed to create collection template", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("done to create collection template")
+	return merr.Success(), nil
+}
+
+// DropCollectionTemplate drops the collection template, the collections created from it are not affected.
+func (c *Core) DropCollectionTemplate(ctx context.Context, in *rootcoordpb.DropCollectionTemplateRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("name", in.GetName()))
+	log.Info("received request to drop collection template")
+	if err := c.collectionTemplates.Drop(ctx, in.GetName()); err != nil {
+		log.Warn("failed to drop collection template", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("done to drop collection template")
+	return merr.Success(), nil
+}
+
+// ListCollectionTemplates lists all the collection templates, or the given one if the name is specified.
+func (c *Core) ListCollectionTemplates(ctx context.Context, in *rootcoordpb.ListCollectionTemplatesRequest) (*rootcoordpb.ListCollectionTemplatesResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &rootcoordpb.ListCollectionTemplatesResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if in.GetName() == "" {
+		return &rootcoordpb.ListCollectionTemplatesResponse{
+			Status:    merr.Success(),
+			Templates: c.collectionTemplates.List(),
+		}, nil
+	}
+	template, err := c.collectionTemplates.Get(in.GetName())
+	if err != nil {
+		return &rootcoordpb.ListCollectionTemplatesResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return &rootcoordpb.ListCollectionTemplatesResponse{
+		Status:    merr.Success(),
+		Templates: []*rootcoordpb.CollectionTemplate{template},
+	}, nil
+}
+
+// GetUsage returns the storage, the recent request rates and the applied rate limits of the collections and users.
+func (c *Core) GetUsage(ctx context.Context, in *rootcoordpb.GetUsageRequest) (*rootcoordpb.GetUsageResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &rootcoordpb.GetUsageResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if c.quotaCenter == nil {
+		return &rootcoordpb.GetUsageResponse{
+			Status: merr.Status(merr.WrapErrServiceNotReady(typeutil.RootCoordRole, paramtable.GetNodeID(), "quota center not initialized")),
+		}, nil
+	}
+	return c.quotaCenter.getUsage(in.GetCollectionID(), in.GetUser()), nil
+}
+
 func (c *Core) AddCollectionFunction(ctx context.Context, in *milvuspb.AddCollectionFunctionRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
@@ -1504,6 +1670,11 @@ func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartition
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
+	if c.isAsyncDdl(ctx, in.GetBase()) {
+		return c.submitAsyncDdl(ctx, "CreatePartition", in.GetDbName(), in.GetCollectionName(), func(ctx context.Context) (*commonpb.Status, error) {
+			return c.CreatePartition(ctx, in)
+		}), nil
+	}
 	metrics.RootCoordDDLReqCounter.WithLabelValues("CreatePartition", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("CreatePartition")
 
@@ -1535,6 +1706,11 @@ func (c *Core) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequ
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
+	if c.isAsyncDdl(ctx, in.GetBase()) {
+		return c.submitAsyncDdl(ctx, "DropPartition", in.GetDbName(), in.GetCollectionName(), func(ctx context.Context) (*commonpb.Status, error) {
+			return c.DropPartition(ctx, in)
+		}), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("DropPartition", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("DropPartition")
@@ -1792,18 +1968,9 @@ func (c *Core) ShowConfigurations(ctx context.Context, req *internalpb.ShowConfi
 		}, nil
 	}
 
-	configList := make([]*commonpb.KeyValuePair, 0)
-	for key, value := range Params.GetComponentConfigurations("rootcoord", req.Pattern) {
-		configList = append(configList,
-			&commonpb.KeyValuePair{
-				Key:   key,
-				Value: value,
-			})
-	}
-
 	return &internalpb.ShowConfigurationsResponse{
 		Status:        merr.Success(),
-		Configuations: configList,
+		Configuations: componentutil.ShowConfigurations("rootcoord", req),
 	}, nil
 }
 
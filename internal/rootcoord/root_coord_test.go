@@ -49,6 +49,7 @@ import (
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/registry"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
@@ -179,6 +180,28 @@ func TestRootCoord_DropDatabase(t *testing.T) {
 	})
 }
 
+func TestRootCoord_SetCollectionQuotaOverride(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		ctx := context.Background()
+		resp, err := c.SetCollectionQuotaOverride(ctx, &rootcoordpb.SetCollectionQuotaOverrideRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		ctx := context.Background()
+		resp, err := c.SetCollectionQuotaOverride(ctx, &rootcoordpb.SetCollectionQuotaOverrideRequest{
+			CollectionName: "coll",
+			Key:            common.CollectionQueryRateMaxKey,
+			Enabled:        true,
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+}
+
 func TestRootCoord_ListDatabases(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		c := newTestCore(withAbnormalCode())
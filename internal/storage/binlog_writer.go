@@ -19,6 +19,7 @@ package storage
 import (
 	"bytes"
 	"encoding/binary"
+	"strconv"
 
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
@@ -311,3 +312,10 @@ func WithWriterEncryptionContext(ezID int64, edek []byte, encryptor hook.Encrypt
 		base.encryptor = encryptor
 	}
 }
+
+// WithWriterInsertChecksum records the combined checksum of the insert batches in the descriptor event.
+func WithWriterInsertChecksum(checksum uint32) BinlogWriterOptions {
+	return func(base *baseBinlogWriter) {
+		base.AddExtra(insertChecksumKey, strconv.FormatUint(uint64(checksum), 10))
+	}
+}
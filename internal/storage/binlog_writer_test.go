@@ -93,6 +93,31 @@ func TestBinlogReaderWriterCipher(t *testing.T) {
 	assert.Nil(t, reader)
 }
 
+func TestBinlogWriterInsertChecksum(t *testing.T) {
+	binlogWriter := NewInsertBinlogWriter(schemapb.DataType_Int32, 10, 20, 30, 40, false, WithWriterInsertChecksum(3735928559))
+	binlogWriter.SetEventTimeStamp(1000, 2000)
+	eventWriter, err := binlogWriter.NextInsertEventWriter()
+	require.NoError(t, err)
+	require.NoError(t, eventWriter.AddInt32ToPayload([]int32{1, 2, 3}, nil))
+	eventWriter.SetEventTimestamp(1000, 2000)
+	binlogWriter.baseBinlogWriter.descriptorEventData.AddExtra(originalSizeKey, "12")
+	require.NoError(t, binlogWriter.Finish())
+	buffer, err := binlogWriter.GetBuffer()
+	require.NoError(t, err)
+	binlogWriter.Close()
+
+	binlogReader, err := NewBinlogReader(buffer)
+	require.NoError(t, err)
+	defer binlogReader.Close()
+	checksum, ok := binlogReader.descriptorEvent.GetInsertChecksum()
+	assert.True(t, ok)
+	assert.EqualValues(t, 3735928559, checksum)
+
+	// absent in the binlogs written without checksum
+	_, ok = (&descriptorEventData{Extras: map[string]interface{}{}}).GetInsertChecksum()
+	assert.False(t, ok)
+}
+
 func TestBinlogWriterReader(t *testing.T) {
 	binlogWriter := NewInsertBinlogWriter(schemapb.DataType_Int32, 10, 20, 30, 40, false)
 	tp := binlogWriter.GetBinlogType()
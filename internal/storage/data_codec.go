@@ -225,6 +225,11 @@ func (insertCodec *InsertCodec) SerializePkStatsByData(data *InsertData) (*Blob,
 // For each field, it will create a binlog writer, and write an event to the binlog.
 // It returns binlog buffer in the end.
 func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID UniqueID, data ...*InsertData) ([]*Blob, error) {
+	return insertCodec.SerializeWithOptions(partitionID, segmentID, nil, data...)
+}
+
+// SerializeWithOptions is the same as Serialize, and applies the opts to the binlog writer of each field.
+func (insertCodec *InsertCodec) SerializeWithOptions(partitionID UniqueID, segmentID UniqueID, opts []BinlogWriterOptions, data ...*InsertData) ([]*Blob, error) {
 	blobs := make([]*Blob, 0)
 	var writer *InsertBinlogWriter
 	if insertCodec.Schema == nil {
@@ -256,7 +261,8 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		}
 	}
 
-	binlogWriterOpts := []BinlogWriterOptions{}
+	compressions := getFieldCompressions(insertCodec.Schema.GetSchema())
+	binlogWriterOpts := append([]BinlogWriterOptions{}, opts...)
 	if hookutil.IsClusterEncyptionEnabled() {
 		if ez := hookutil.GetEzByCollProperties(insertCodec.Schema.GetSchema().GetProperties(), insertCodec.Schema.ID); ez != nil {
 			encryptor, safeKey, err := hookutil.GetCipher().GetEncryptor(ez.EzID, ez.CollectionID)
@@ -295,7 +301,7 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		writer = NewInsertBinlogWriter(field.DataType, insertCodec.Schema.ID, partitionID, segmentID, field.FieldID, field.GetNullable(), binlogWriterOpts...)
 
 		// get payload writing configs, including nullable and fallback encoding method
-		payloadWriterOpts := []PayloadWriterOptions{WithNullable(field.GetNullable()), WithWriterProps(getFieldWriterProps(field))}
+		payloadWriterOpts := []PayloadWriterOptions{WithNullable(field.GetNullable()), WithWriterProps(getFieldWriterProps(field, compressions.get(field.FieldID)))}
 		if typeutil.IsVectorType(field.DataType) && !typeutil.IsSparseFloatVectorType(field.DataType) {
 			dim, err := typeutil.GetDim(field)
 			if err != nil {
@@ -870,7 +876,7 @@ func NewDeleteCodec() *DeleteCodec {
 func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID, data *DeleteData) (*Blob, error) {
 	binlogWriter := NewDeleteBinlogWriter(schemapb.DataType_String, collectionID, partitionID, segmentID)
 	field := &schemapb.FieldSchema{IsPrimaryKey: true, DataType: schemapb.DataType_String}
-	opts := []PayloadWriterOptions{WithWriterProps(getFieldWriterProps(field))}
+	opts := []PayloadWriterOptions{WithWriterProps(getFieldWriterProps(field, defaultFieldCompression))}
 	eventWriter, err := binlogWriter.NextDeleteEventWriter(opts...)
 	if err != nil {
 		binlogWriter.Close()
@@ -37,6 +37,8 @@ const (
 	nullableKey     = "nullable"
 	edekKey         = "edek"
 	ezIDKey         = "encryption_zone"
+	// the combined checksum of the insert batches computed by the proxy, see msgstream.CombineChecksum
+	insertChecksumKey = "insert_checksum"
 
 	// mark useMultiFieldFormat if there are multi fields in a log file
 	MultiField = "MULTI_FIELD"
@@ -110,6 +112,21 @@ func (data *descriptorEventData) GetEzID() (int64, bool) {
 	return ezid, true
 }
 
+// GetInsertChecksum returns the combined checksum of the insert batches written into the binlog,
+// it's absent if any of the batches is not checksummed.
+func (data *descriptorEventData) GetInsertChecksum() (uint32, bool) {
+	checksumStored, ok := data.Extras[insertChecksumKey]
+	if !ok {
+		return 0, false
+	}
+	checksumStr, _ := checksumStored.(string)
+	checksum, err := strconv.ParseUint(checksumStr, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(checksum), true
+}
+
 // GetMemoryUsageInBytes returns the memory size of DescriptorEventDataFixPart.
 func (data *descriptorEventData) GetMemoryUsageInBytes() int32 {
 	return data.GetEventDataFixPartSize() + int32(binary.Size(data.PostHeaderLengths)) + int32(binary.Size(data.ExtraLength)) + data.ExtraLength
@@ -19,8 +19,10 @@ package storage
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/minio/minio-go/v7"
+	minioTags "github.com/minio/minio-go/v7/pkg/tags"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/v2/log"
@@ -108,3 +110,19 @@ func (minioObjectStorage *MinioObjectStorage) RemoveObject(ctx context.Context,
 	err := minioObjectStorage.Client.RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{})
 	return checkObjectStorageError(objectName, err)
 }
+
+func (minioObjectStorage *MinioObjectStorage) PresignGetObject(ctx context.Context, bucketName, objectName string, expiry time.Duration) (string, error) {
+	url, err := minioObjectStorage.Client.PresignedGetObject(ctx, bucketName, objectName, expiry, nil)
+	if err != nil {
+		return "", err
+	}
+	return url.String(), nil
+}
+
+func (minioObjectStorage *MinioObjectStorage) PutObjectTagging(ctx context.Context, bucketName, objectName string, tags map[string]string) error {
+	otags, err := minioTags.NewTags(tags, true)
+	if err != nil {
+		return err
+	}
+	return minioObjectStorage.Client.PutObjectTagging(ctx, bucketName, objectName, otags, minio.PutObjectTaggingOptions{})
+}
@@ -0,0 +1,90 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// The keys of the object tags, which could be used by the bucket lifecycle policies.
+const (
+	ObjectTagCollectionID = "milvus-collection-id"
+	ObjectTagSegmentID    = "milvus-segment-id"
+	ObjectTagLogType      = "milvus-log-type"
+)
+
+// objectLogTypes are the log types which are tagged by the path of the object, e.g. {root}/insert_log/...
+var objectLogTypes = []string{
+	common.SegmentInsertLogPath,
+	common.SegmentDeltaLogPath,
+	common.SegmentStatslogPath,
+	common.SegmentBm25LogPath,
+	common.SegmentIndexPath,
+	common.TextIndexPath,
+	common.JSONIndexPath,
+	common.JSONStatsPath,
+}
+
+// SegmentObjectTags returns the tags of the objects of the segment,
+// it returns nil if minio.objectTagging is disabled.
+func SegmentObjectTags(collectionID, segmentID int64) map[string]string {
+	if !paramtable.Get().MinioCfg.ObjectTagging.GetAsBool() {
+		return nil
+	}
+	return map[string]string{
+		ObjectTagCollectionID: strconv.FormatInt(collectionID, 10),
+		ObjectTagSegmentID:    strconv.FormatInt(segmentID, 10),
+	}
+}
+
+// TagObjects tags each of @filePaths with @tags and the log type parsed from its path.
+// It's a no-op if @tags is empty or @tagger is nil, e.g. the ChunkManager doesn't support tagging.
+func TagObjects(ctx context.Context, tagger ObjectTagger, filePaths []string, tags map[string]string) error {
+	if tagger == nil || len(tags) == 0 {
+		return nil
+	}
+	var el error
+	for _, filePath := range filePaths {
+		if err := tagger.TagObject(ctx, filePath, objectTagsOf(filePath, tags)); err != nil {
+			el = merr.Combine(el, errors.Wrapf(err, "failed to tag %s", filePath))
+		}
+	}
+	return el
+}
+
+// objectTagsOf returns @tags with the log type of the object appended.
+func objectTagsOf(objectName string, tags map[string]string) map[string]string {
+	ret := make(map[string]string, len(tags)+1)
+	for k, v := range tags {
+		ret[k] = v
+	}
+	for _, logType := range objectLogTypes {
+		if strings.HasPrefix(objectName, logType+"/") || strings.Contains(objectName, "/"+logType+"/") {
+			ret[ObjectTagLogType] = logType
+			break
+		}
+	}
+	return ret
+}
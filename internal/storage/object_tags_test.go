@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type mockObjectTagger struct {
+	tags map[string]map[string]string
+}
+
+func (m *mockObjectTagger) TagObject(ctx context.Context, filePath string, tags map[string]string) error {
+	m.tags[filePath] = tags
+	return nil
+}
+
+func TestObjectTags(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	objectName := "files/insert_log/1/3/2/100/1000"
+	tagger := &mockObjectTagger{tags: make(map[string]map[string]string)}
+
+	// disabled by default
+	assert.Nil(t, SegmentObjectTags(1, 2))
+	assert.NoError(t, TagObjects(ctx, tagger, []string{objectName}, SegmentObjectTags(1, 2)))
+	assert.Empty(t, tagger.tags)
+
+	paramtable.Get().Save(paramtable.Get().MinioCfg.ObjectTagging.Key, "true")
+	defer paramtable.Get().Reset(paramtable.Get().MinioCfg.ObjectTagging.Key)
+	tags := SegmentObjectTags(1, 2)
+	assert.NoError(t, TagObjects(ctx, tagger, []string{objectName, "files/unknown/1"}, tags))
+	assert.Equal(t, map[string]string{
+		ObjectTagCollectionID: "1",
+		ObjectTagSegmentID:    "2",
+		ObjectTagLogType:      "insert_log",
+	}, tagger.tags[objectName])
+	assert.Equal(t, map[string]string{
+		ObjectTagCollectionID: "1",
+		ObjectTagSegmentID:    "2",
+	}, tagger.tags["files/unknown/1"])
+
+	// the chunk manager doesn't support tagging
+	assert.NoError(t, TagObjects(ctx, nil, []string{objectName}, tags))
+}
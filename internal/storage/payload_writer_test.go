@@ -647,7 +647,7 @@ func TestParquetEncoding(t *testing.T) {
 	t.Run("test int64 pk", func(t *testing.T) {
 		field := &schemapb.FieldSchema{IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
 
-		w, err := NewPayloadWriter(schemapb.DataType_Int64, WithWriterProps(getFieldWriterProps(field)))
+		w, err := NewPayloadWriter(schemapb.DataType_Int64, WithWriterProps(getFieldWriterProps(field, defaultFieldCompression)))
 
 		assert.NoError(t, err)
 		err = w.AddDataToPayloadForUT([]int64{1, 2, 3}, nil)
@@ -661,7 +661,7 @@ func TestParquetEncoding(t *testing.T) {
 	t.Run("test string pk", func(t *testing.T) {
 		field := &schemapb.FieldSchema{IsPrimaryKey: true, DataType: schemapb.DataType_String}
 
-		w, err := NewPayloadWriter(schemapb.DataType_String, WithWriterProps(getFieldWriterProps(field)))
+		w, err := NewPayloadWriter(schemapb.DataType_String, WithWriterProps(getFieldWriterProps(field, defaultFieldCompression)))
 
 		assert.NoError(t, err)
 		err = w.AddOneStringToPayload("1", true)
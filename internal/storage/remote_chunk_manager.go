@@ -22,6 +22,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
@@ -57,6 +58,16 @@ type ObjectStorage interface {
 	RemoveObject(ctx context.Context, bucketName, objectName string) error
 }
 
+// objectURLPresigner is implemented by the ObjectStorage which supports presigned url.
+type objectURLPresigner interface {
+	PresignGetObject(ctx context.Context, bucketName, objectName string, expiry time.Duration) (string, error)
+}
+
+// objectTagger is implemented by the ObjectStorage which supports object tagging.
+type objectTagger interface {
+	PutObjectTagging(ctx context.Context, bucketName, objectName string, tags map[string]string) error
+}
+
 // RemoteChunkManager is responsible for read and write data stored in mminio.
 type RemoteChunkManager struct {
 	client ObjectStorage
@@ -66,7 +77,11 @@ type RemoteChunkManager struct {
 	rootPath   string
 }
 
-var _ ChunkManager = (*RemoteChunkManager)(nil)
+var (
+	_ ChunkManager = (*RemoteChunkManager)(nil)
+	_ URLSigner    = (*RemoteChunkManager)(nil)
+	_ ObjectTagger = (*RemoteChunkManager)(nil)
+)
 
 func NewRemoteChunkManager(ctx context.Context, c *objectstorage.Config) (*RemoteChunkManager, error) {
 	var client ObjectStorage
@@ -122,6 +137,33 @@ func (mcm *RemoteChunkManager) Path(ctx context.Context, filePath string) (strin
 	return filePath, nil
 }
 
+// SignURL returns a presigned url to read the file, it's supported only when the object storage is able to presign.
+func (mcm *RemoteChunkManager) SignURL(ctx context.Context, filePath string, expiry time.Duration) (string, error) {
+	presigner, ok := mcm.client.(objectURLPresigner)
+	if !ok {
+		return "", merr.WrapErrServiceUnavailable("presigned url is not supported by the object storage")
+	}
+	url, err := presigner.PresignGetObject(ctx, mcm.bucketName, filePath, expiry)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to presign object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
+		return "", checkObjectStorageError(filePath, err)
+	}
+	return url, nil
+}
+
+// TagObject replaces the tags of the object, it's a no-op if the object storage doesn't support tagging.
+func (mcm *RemoteChunkManager) TagObject(ctx context.Context, filePath string, tags map[string]string) error {
+	tagger, ok := mcm.client.(objectTagger)
+	if !ok {
+		return nil
+	}
+	if err := tagger.PutObjectTagging(ctx, mcm.bucketName, filePath, tags); err != nil {
+		log.Ctx(ctx).Warn("failed to tag object", zap.String("bucket", mcm.bucketName), zap.String("path", filePath), zap.Error(err))
+		return checkObjectStorageError(filePath, err)
+	}
+	return nil
+}
+
 // Reader returns the path of minio data if exists.
 func (mcm *RemoteChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
 	reader, err := mcm.getObject(ctx, mcm.bucketName, filePath, int64(0), int64(0))
@@ -66,6 +66,7 @@ type rwOptions struct {
 	collectionID        int64
 	storageConfig       *indexpb.StorageConfig
 	neededFields        typeutil.Set[int64]
+	objectTagger        ObjectTagger
 }
 
 func (o *rwOptions) validate() error {
@@ -156,12 +157,34 @@ func WithStorageConfig(storageConfig *indexpb.StorageConfig) RwOption {
 	}
 }
 
+// WithObjectTagger sets the tagger to tag the written objects with the collection and segment,
+// including the storage v2 packed binlogs which are not written by the uploader.
+func WithObjectTagger(tagger ObjectTagger) RwOption {
+	return func(options *rwOptions) {
+		options.objectTagger = tagger
+	}
+}
+
 func WithNeededFields(neededFields typeutil.Set[int64]) RwOption {
 	return func(options *rwOptions) {
 		options.neededFields = neededFields
 	}
 }
 
+// segmentUploader returns the uploader which tags the uploaded objects of the segment.
+func (o *rwOptions) segmentUploader(collectionID, segmentID UniqueID) uploaderFn {
+	tags := SegmentObjectTags(collectionID, segmentID)
+	if o.objectTagger == nil || len(tags) == 0 {
+		return o.uploader
+	}
+	return func(ctx context.Context, kvs map[string][]byte) error {
+		if err := o.uploader(ctx, kvs); err != nil {
+			return err
+		}
+		return TagObjects(ctx, o.objectTagger, lo.Keys(kvs), tags)
+	}
+}
+
 func makeBlobsReader(ctx context.Context, binlogs []*datapb.FieldBinlog, downloader downloaderFn) (ChunkedBlobsReader, error) {
 	if len(binlogs) == 0 {
 		return func() ([]*Blob, error) {
@@ -302,12 +325,13 @@ func NewBinlogRecordWriter(ctx context.Context, collectionID, partitionID, segme
 		return nil, err
 	}
 
+	uploader := rwOptions.segmentUploader(collectionID, segmentID)
 	blobsWriter := func(blobs []*Blob) error {
 		kvs := make(map[string][]byte, len(blobs))
 		for _, blob := range blobs {
 			kvs[blob.Key] = blob.Value
 		}
-		return rwOptions.uploader(ctx, kvs)
+		return uploader(ctx, kvs)
 	}
 
 	opts := []StreamWriterOption{}
@@ -344,6 +368,7 @@ func NewBinlogRecordWriter(ctx context.Context, collectionID, partitionID, segme
 			rwOptions.bufferSize, rwOptions.multiPartUploadSize, rwOptions.columnGroups,
 			rwOptions.storageConfig,
 			pluginContext,
+			rwOptions.objectTagger,
 		)
 	}
 	return nil, merr.WrapErrServiceInternal(fmt.Sprintf("unsupported storage version %d", rwOptions.version))
@@ -363,7 +388,7 @@ func NewDeltalogWriter(
 	if err := rwOptions.validate(); err != nil {
 		return nil, err
 	}
-	return NewLegacyDeltalogWriter(collectionID, partitionID, segmentID, logID, pkType, rwOptions.uploader, path)
+	return NewLegacyDeltalogWriter(collectionID, partitionID, segmentID, logID, pkType, rwOptions.segmentUploader(collectionID, segmentID), path)
 }
 
 func NewDeltalogReader(
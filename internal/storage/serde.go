@@ -30,10 +30,12 @@ import (
 	"github.com/apache/arrow/go/v17/parquet"
 	"github.com/apache/arrow/go/v17/parquet/compress"
 	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -668,21 +670,86 @@ var serdeMap = func() map[schemapb.DataType]serdeEntry {
 	return m
 }()
 
+// fieldCompression is the compression codec of a field in binlog.
+type fieldCompression struct {
+	codec compress.Compression
+	level int
+}
+
+var defaultFieldCompression = fieldCompression{
+	codec: compress.Codecs.Zstd,
+	level: common.DefaultBinlogCompressionLevel,
+}
+
+func (c fieldCompression) writerProps() []parquet.WriterProperty {
+	if c.codec == compress.Codecs.Uncompressed {
+		return []parquet.WriterProperty{parquet.WithCompression(c.codec)}
+	}
+	return []parquet.WriterProperty{
+		parquet.WithCompression(c.codec),
+		parquet.WithCompressionLevel(c.level),
+	}
+}
+
+// fieldCompressions is the binlog compression of the fields, the fields not configured use the default one.
+type fieldCompressions map[FieldID]fieldCompression
+
+func (c fieldCompressions) get(fieldID FieldID) fieldCompression {
+	if compression, ok := c[fieldID]; ok {
+		return compression
+	}
+	return defaultFieldCompression
+}
+
+// getFieldCompressions returns the binlog compression of the fields configured in the collection properties,
+// the invalid values are validated by proxy already, they are just ignored here.
+func getFieldCompressions(schema *schemapb.CollectionSchema) fieldCompressions {
+	compressions := make(fieldCompressions)
+	for _, kv := range schema.GetProperties() {
+		fieldName, ok := strings.CutPrefix(kv.GetKey(), common.CollectionBinlogCompressionKeyPrefix)
+		if !ok {
+			continue
+		}
+		codec, level, err := common.ParseBinlogCompression(kv.GetValue())
+		if err != nil {
+			log.Warn("ignore invalid binlog compression", zap.String("key", kv.GetKey()), zap.Error(err))
+			continue
+		}
+		compression := fieldCompression{codec: compress.Codecs.Zstd, level: level}
+		if codec == common.BinlogCompressionNone {
+			compression = fieldCompression{codec: compress.Codecs.Uncompressed}
+		}
+		for _, field := range typeutil.GetAllFieldSchemas(schema) {
+			if field.GetName() == fieldName {
+				compressions[field.GetFieldID()] = compression
+			}
+		}
+	}
+	return compressions
+}
+
+// getColumnCompressions returns the zstd level of the columns configured in the collection properties
+// for the packed writer of storage v2, 0 means uncompressed.
+func getColumnCompressions(schema *schemapb.CollectionSchema) map[string]int {
+	compressions := getFieldCompressions(schema)
+	columns := make(map[string]int, len(compressions))
+	for _, field := range typeutil.GetAllFieldSchemas(schema) {
+		if compression, ok := compressions[field.GetFieldID()]; ok {
+			columns[field.GetName()] = compression.level
+		}
+	}
+	return columns
+}
+
 // Since parquet does not support custom fallback encoding for now,
 // we disable dict encoding for primary key.
 // It can be scale to all fields once parquet fallback encoding is available.
-func getFieldWriterProps(field *schemapb.FieldSchema) *parquet.WriterProperties {
+func getFieldWriterProps(field *schemapb.FieldSchema, compression fieldCompression) *parquet.WriterProperties {
+	props := compression.writerProps()
 	if field.GetIsPrimaryKey() {
-		return parquet.NewWriterProperties(
-			parquet.WithCompression(compress.Codecs.Zstd),
-			parquet.WithCompressionLevel(3),
-			parquet.WithDictionaryDefault(false),
-		)
+		props = append(props, parquet.WithDictionaryDefault(false))
 	}
-	return parquet.NewWriterProperties(
-		parquet.WithCompression(compress.Codecs.Zstd),
-		parquet.WithCompressionLevel(3),
-	)
+	return parquet.NewWriterProperties(props...)
 }
 
 type DeserializeReader[T any] interface {
@@ -1177,6 +1244,87 @@ func NewSimpleArrowRecord(r arrow.Record, field2Col map[FieldID]int) *simpleArro
 	}
 }
 
+// appendColumn appends the whole column of the fixed width and string types to the builder at once,
+// it returns false if the field data is not supported, then the rows are serialized one by one.
+func appendColumn(b array.Builder, fieldData FieldData) bool {
+	valid := getValidDataRows(fieldData)
+	if len(valid) != 0 && len(valid) != fieldData.RowNum() {
+		return false
+	}
+	// the dense vectors are stored as fixed size binary in little endian, which is the same as the memory layout
+	appendFixedSize := func(data []byte, width int) bool {
+		builder, ok := b.(*array.FixedSizeBinaryBuilder)
+		if !ok || width <= 0 || len(data)%width != 0 {
+			return false
+		}
+		rows := make([][]byte, len(data)/width)
+		for i := range rows {
+			rows[i] = data[i*width : (i+1)*width]
+		}
+		builder.AppendValues(rows, nil)
+		return true
+	}
+
+	switch fd := fieldData.(type) {
+	case *BoolFieldData:
+		if builder, ok := b.(*array.BooleanBuilder); ok {
+			builder.AppendValues(fd.Data, valid)
+			return true
+		}
+	case *Int8FieldData:
+		if builder, ok := b.(*array.Int8Builder); ok {
+			builder.AppendValues(fd.Data, valid)
+			return true
+		}
+	case *Int16FieldData:
+		if builder, ok := b.(*array.Int16Builder); ok {
+			builder.AppendValues(fd.Data, valid)
+			return true
+		}
+	case *Int32FieldData:
+		if builder, ok := b.(*array.Int32Builder); ok {
+			builder.AppendValues(fd.Data, valid)
+			return true
+		}
+	case *Int64FieldData:
+		if builder, ok := b.(*array.Int64Builder); ok {
+			builder.AppendValues(fd.Data, valid)
+			return true
+		}
+	case *TimestamptzFieldData:
+		if builder, ok := b.(*array.Int64Builder); ok {
+			builder.AppendValues(fd.Data, valid)
+			return true
+		}
+	case *FloatFieldData:
+		if builder, ok := b.(*array.Float32Builder); ok {
+			builder.AppendValues(fd.Data, valid)
+			return true
+		}
+	case *DoubleFieldData:
+		if builder, ok := b.(*array.Float64Builder); ok {
+			builder.AppendValues(fd.Data, valid)
+			return true
+		}
+	case *StringFieldData:
+		if builder, ok := b.(*array.StringBuilder); ok {
+			builder.AppendValues(fd.Data, valid)
+			return true
+		}
+	case *FloatVectorFieldData:
+		return appendFixedSize(arrow.Float32Traits.CastToBytes(fd.Data), fd.Dim*4)
+	case *BinaryVectorFieldData:
+		return appendFixedSize(fd.Data, (fd.Dim+7)/8)
+	case *Float16VectorFieldData:
+		return appendFixedSize(fd.Data, fd.Dim*2)
+	case *BFloat16VectorFieldData:
+		return appendFixedSize(fd.Data, fd.Dim*2)
+	case *Int8VectorFieldData:
+		return appendFixedSize(arrow.Int8Traits.CastToBytes(fd.Data), fd.Dim)
+	}
+	return false
+}
+
 func BuildRecord(b *array.RecordBuilder, data *InsertData, schema *schemapb.CollectionSchema) error {
 	if data == nil {
 		return nil
@@ -1204,6 +1352,9 @@ func BuildRecord(b *array.RecordBuilder, data *InsertData, schema *schemapb.Coll
 			elementType = field.GetElementType()
 		}
 
+		if appendColumn(fBuilder, fieldData) {
+			return nil
+		}
 		for j := 0; j < fieldData.RowNum(); j++ {
 			ok = typeEntry.serialize(fBuilder, fieldData.GetRow(j), elementType)
 			if !ok {
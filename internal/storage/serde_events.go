@@ -540,6 +540,7 @@ type BinlogStreamWriter struct {
 	partitionID  UniqueID
 	segmentID    UniqueID
 	fieldSchema  *schemapb.FieldSchema
+	compression  fieldCompression
 
 	buf       bytes.Buffer
 	rw        *singleFieldRecordWriter
@@ -552,7 +553,7 @@ func (bsw *BinlogStreamWriter) GetRecordWriter() (RecordWriter, error) {
 		return bsw.rw, nil
 	}
 
-	rw, err := newSingleFieldRecordWriter(bsw.fieldSchema, &bsw.buf, WithRecordWriterProps(getFieldWriterProps(bsw.fieldSchema)))
+	rw, err := newSingleFieldRecordWriter(bsw.fieldSchema, &bsw.buf, WithRecordWriterProps(getFieldWriterProps(bsw.fieldSchema, bsw.compression)))
 	if err != nil {
 		return nil, err
 	}
@@ -649,13 +650,14 @@ func (bsw *BinlogStreamWriter) writeBinlogHeaders(w io.Writer) error {
 }
 
 func newBinlogWriter(collectionID, partitionID, segmentID UniqueID,
-	field *schemapb.FieldSchema,
+	field *schemapb.FieldSchema, compression fieldCompression,
 ) *BinlogStreamWriter {
 	return &BinlogStreamWriter{
 		collectionID: collectionID,
 		partitionID:  partitionID,
 		segmentID:    segmentID,
 		fieldSchema:  field,
+		compression:  compression,
 	}
 }
 
@@ -664,9 +666,10 @@ func NewBinlogStreamWriters(collectionID, partitionID, segmentID UniqueID,
 	writerOptions ...StreamWriterOption,
 ) map[FieldID]*BinlogStreamWriter {
 	bws := make(map[FieldID]*BinlogStreamWriter)
+	compressions := getFieldCompressions(schema)
 
 	for _, f := range schema.Fields {
-		writer := newBinlogWriter(collectionID, partitionID, segmentID, f)
+		writer := newBinlogWriter(collectionID, partitionID, segmentID, f, compressions.get(f.FieldID))
 		for _, writerOption := range writerOptions {
 			writerOption(writer)
 		}
@@ -675,7 +678,7 @@ func NewBinlogStreamWriters(collectionID, partitionID, segmentID UniqueID,
 
 	for _, structField := range schema.StructArrayFields {
 		for _, subField := range structField.Fields {
-			writer := newBinlogWriter(collectionID, partitionID, segmentID, subField)
+			writer := newBinlogWriter(collectionID, partitionID, segmentID, subField, compressions.get(subField.FieldID))
 			for _, writerOption := range writerOptions {
 				writerOption(writer)
 			}
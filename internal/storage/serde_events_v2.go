@@ -17,6 +17,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"path"
@@ -235,7 +236,7 @@ func NewPackedRecordWriter(
 		}
 		return path.Join(bucketName, p)
 	})
-	writer, err := packed.NewPackedWriter(truePaths, arrowSchema, bufferSize, multiPartUploadSize, columnGroups, storageConfig, storagePluginContext)
+	writer, err := packed.NewPackedWriter(truePaths, arrowSchema, bufferSize, multiPartUploadSize, columnGroups, getColumnCompressions(schema), storageConfig, storagePluginContext)
 	if err != nil {
 		return nil, merr.WrapErrServiceInternal(
 			fmt.Sprintf("can not new packed record writer %s", err.Error()))
@@ -297,6 +298,7 @@ type PackedBinlogRecordWriter struct {
 	columnGroups         []storagecommon.ColumnGroup
 	storageConfig        *indexpb.StorageConfig
 	storagePluginContext *indexcgopb.StoragePluginContext
+	objectTagger         ObjectTagger
 
 	// writer and stats generated at runtime
 	writer              *packedRecordWriter
@@ -394,12 +396,27 @@ func (pw *PackedBinlogRecordWriter) Close() error {
 		}
 	}
 	pw.finalizeBinlogs()
+	if err := pw.tagBinlogs(); err != nil {
+		return err
+	}
 	if err := pw.writeStats(); err != nil {
 		return err
 	}
 	return nil
 }
 
+// tagBinlogs tags the packed binlogs, which are written by the packed writer instead of the uploader.
+func (pw *PackedBinlogRecordWriter) tagBinlogs() error {
+	if pw.writer == nil {
+		return nil
+	}
+	paths := make([]string, 0, len(pw.columnGroups))
+	for _, columnGroup := range pw.columnGroups {
+		paths = append(paths, pw.writer.GetWrittenPaths(columnGroup.GroupID))
+	}
+	return TagObjects(context.Background(), pw.objectTagger, paths, SegmentObjectTags(pw.collectionID, pw.segmentID))
+}
+
 func (pw *PackedBinlogRecordWriter) finalizeBinlogs() {
 	if pw.writer == nil {
 		return
@@ -493,6 +510,7 @@ func newPackedBinlogRecordWriter(collectionID, partitionID, segmentID UniqueID,
 	blobsWriter ChunkedBlobsWriter, allocator allocator.Interface, maxRowNum int64, bufferSize, multiPartUploadSize int64, columnGroups []storagecommon.ColumnGroup,
 	storageConfig *indexpb.StorageConfig,
 	storagePluginContext *indexcgopb.StoragePluginContext,
+	objectTagger ObjectTagger,
 ) (*PackedBinlogRecordWriter, error) {
 	arrowSchema, err := ConvertToArrowSchema(schema)
 	if err != nil {
@@ -513,6 +531,7 @@ func newPackedBinlogRecordWriter(collectionID, partitionID, segmentID UniqueID,
 		columnGroups:         columnGroups,
 		storageConfig:        storageConfig,
 		storagePluginContext: storagePluginContext,
+		objectTagger:         objectTagger,
 		tsFrom:               typeutil.MaxTimestamp,
 		tsTo:                 0,
 	}
@@ -26,10 +26,12 @@ import (
 	"github.com/apache/arrow/go/v17/arrow/array"
 	"github.com/apache/arrow/go/v17/arrow/bitutil"
 	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet/compress"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 )
 
 type MockRecordWriter struct {
@@ -856,3 +858,73 @@ func TestActualSizeInBytesCompareWithDataSizeInBytes(t *testing.T) {
 		assert.Less(t, actualSize, uint64(totalRows*byteWidth))
 	})
 }
+
+func TestGetFieldCompressions(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "str", DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, Name: "bad", DataType: schemapb.DataType_VarChar},
+		},
+		Properties: []*commonpb.KeyValuePair{
+			{Key: common.CollectionBinlogCompressionKeyPrefix + "vec", Value: "none"},
+			{Key: common.CollectionBinlogCompressionKeyPrefix + "str", Value: "zstd:9"},
+			{Key: common.CollectionBinlogCompressionKeyPrefix + "bad", Value: "gzip"},
+		},
+	}
+
+	compressions := getFieldCompressions(schema)
+	assert.Equal(t, defaultFieldCompression, compressions.get(100))
+	assert.Equal(t, fieldCompression{codec: compress.Codecs.Uncompressed}, compressions.get(101))
+	assert.Equal(t, fieldCompression{codec: compress.Codecs.Zstd, level: 9}, compressions.get(102))
+	assert.Equal(t, defaultFieldCompression, compressions.get(103))
+
+	props := getFieldWriterProps(schema.Fields[1], compressions.get(101))
+	assert.Equal(t, compress.Codecs.Uncompressed, props.Compression())
+	props = getFieldWriterProps(schema.Fields[0], compressions.get(100))
+	assert.Equal(t, compress.Codecs.Zstd, props.Compression())
+	assert.Equal(t, 3, props.CompressionLevel())
+	assert.False(t, props.DictionaryEnabled())
+
+	// the packed writer of storage v2 gets the same compression of the columns
+	assert.Equal(t, map[string]int{"vec": 0, "str": 9}, getColumnCompressions(schema))
+}
+
+func TestAppendColumn(t *testing.T) {
+	cases := []struct {
+		dataType  arrow.DataType
+		entry     schemapb.DataType
+		fieldData FieldData
+	}{
+		{arrow.PrimitiveTypes.Int64, schemapb.DataType_Int64, &Int64FieldData{Data: []int64{1, 0, 3}, ValidData: []bool{true, false, true}, Nullable: true}},
+		{arrow.FixedWidthTypes.Boolean, schemapb.DataType_Bool, &BoolFieldData{Data: []bool{true, false}}},
+		{arrow.PrimitiveTypes.Float64, schemapb.DataType_Double, &DoubleFieldData{Data: []float64{1.5, 2.5}}},
+		{arrow.BinaryTypes.String, schemapb.DataType_VarChar, &StringFieldData{Data: []string{"a", "", "c"}, ValidData: []bool{true, false, true}, Nullable: true}},
+		{&arrow.FixedSizeBinaryType{ByteWidth: 8}, schemapb.DataType_FloatVector, &FloatVectorFieldData{Data: []float32{1, 2, 3, 4}, Dim: 2}},
+		{&arrow.FixedSizeBinaryType{ByteWidth: 1}, schemapb.DataType_BinaryVector, &BinaryVectorFieldData{Data: []byte{1, 2}, Dim: 8}},
+		{&arrow.FixedSizeBinaryType{ByteWidth: 2}, schemapb.DataType_Int8Vector, &Int8VectorFieldData{Data: []int8{1, -2, 3, -4}, Dim: 2}},
+	}
+	for _, c := range cases {
+		t.Run(c.entry.String(), func(t *testing.T) {
+			vectorized := array.NewBuilder(memory.DefaultAllocator, c.dataType)
+			defer vectorized.Release()
+			assert.True(t, appendColumn(vectorized, c.fieldData))
+			expected := array.NewBuilder(memory.DefaultAllocator, c.dataType)
+			defer expected.Release()
+			for i := 0; i < c.fieldData.RowNum(); i++ {
+				assert.True(t, serdeMap[c.entry].serialize(expected, c.fieldData.GetRow(i), schemapb.DataType_None))
+			}
+
+			actualArr, expectedArr := vectorized.NewArray(), expected.NewArray()
+			defer actualArr.Release()
+			defer expectedArr.Release()
+			assert.True(t, array.Equal(expectedArr, actualArr))
+		})
+	}
+
+	// fallback to serialize row by row
+	builder := array.NewBinaryBuilder(memory.DefaultAllocator, arrow.BinaryTypes.Binary)
+	defer builder.Release()
+	assert.False(t, appendColumn(builder, &JSONFieldData{Data: [][]byte{[]byte("{}")}}))
+}
@@ -56,7 +56,11 @@ func (stats *PrimaryKeyStats) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	err = json.Unmarshal(*messageMap["fieldID"], &stats.FieldID)
+	fieldIDMessage, ok := messageMap["fieldID"]
+	if !ok || fieldIDMessage == nil {
+		return errors.New("fieldID not found in pk stats")
+	}
+	err = json.Unmarshal(*fieldIDMessage, &stats.FieldID)
 	if err != nil {
 		return err
 	}
@@ -293,16 +297,84 @@ func (sr *StatsReader) GetPrimaryKeyStats() (*PrimaryKeyStats, error) {
 
 // GetInt64Stats returns buffer as PrimaryKeyStats
 func (sr *StatsReader) GetPrimaryKeyStatsList() ([]*PrimaryKeyStats, error) {
-	stats := []*PrimaryKeyStats{}
-	err := json.Unmarshal(sr.buffer, &stats)
+	it, err := sr.newStatsListIterator()
+	if err != nil {
+		return nil, err
+	}
+	return it.collect()
+}
+
+func (sr *StatsReader) newStatsListIterator() (*StatsIterator, error) {
+	raws := []json.RawMessage{}
+	err := json.Unmarshal(sr.buffer, &raws)
 	if err != nil {
 		return nil, merr.WrapErrParameterInvalid(
 			"valid JSON",
 			string(sr.buffer),
 			err.Error())
 	}
+	buffers := make([][]byte, 0, len(raws))
+	for _, raw := range raws {
+		buffers = append(buffers, raw)
+	}
+	return &StatsIterator{buffers: buffers}, nil
+}
 
-	return stats, nil
+// StatsIterator deserializes the PrimaryKeyStats lazily one at a time,
+// the buffer is released once deserialized, so that the consumers could handle the stats one by one
+// without holding all the raw buffers and parsed stats at the same time.
+type StatsIterator struct {
+	buffers [][]byte
+	idx     int
+}
+
+// NewStatsIterator returns an iterator over the stats blobs, each blob contains one PrimaryKeyStats.
+func NewStatsIterator(blobs []*Blob) *StatsIterator {
+	buffers := make([][]byte, 0, len(blobs))
+	for _, blob := range blobs {
+		buffers = append(buffers, blob.GetValue())
+	}
+	return &StatsIterator{buffers: buffers}
+}
+
+// NewStatsListIterator returns an iterator over the compound stats blob, which contains a list of PrimaryKeyStats.
+func NewStatsListIterator(blob *Blob) (*StatsIterator, error) {
+	if len(blob.GetValue()) == 0 {
+		return &StatsIterator{}, nil
+	}
+	sr := &StatsReader{}
+	sr.SetBuffer(blob.GetValue())
+	return sr.newStatsListIterator()
+}
+
+// Next returns the next PrimaryKeyStats, io.EOF is returned if there is no more stats.
+func (it *StatsIterator) Next() (*PrimaryKeyStats, error) {
+	for it.idx < len(it.buffers) {
+		buffer := it.buffers[it.idx]
+		it.buffers[it.idx] = nil
+		it.idx++
+		if len(buffer) == 0 {
+			continue
+		}
+		sr := &StatsReader{}
+		sr.SetBuffer(buffer)
+		return sr.GetPrimaryKeyStats()
+	}
+	return nil, io.EOF
+}
+
+func (it *StatsIterator) collect() ([]*PrimaryKeyStats, error) {
+	results := make([]*PrimaryKeyStats, 0, len(it.buffers)-it.idx)
+	for {
+		stats, err := it.Next()
+		if err == io.EOF {
+			return results, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, stats)
+	}
 }
 
 type BM25Stats struct {
@@ -505,31 +577,13 @@ func (m *BM25Stats) GetAvgdl() float64 {
 
 // DeserializeStats deserialize @blobs as []*PrimaryKeyStats
 func DeserializeStats(blobs []*Blob) ([]*PrimaryKeyStats, error) {
-	results := make([]*PrimaryKeyStats, 0, len(blobs))
-	for _, blob := range blobs {
-		if len(blob.Value) == 0 {
-			continue
-		}
-		sr := &StatsReader{}
-		sr.SetBuffer(blob.Value)
-		stats, err := sr.GetPrimaryKeyStats()
-		if err != nil {
-			return nil, err
-		}
-		results = append(results, stats)
-	}
-	return results, nil
+	return NewStatsIterator(blobs).collect()
 }
 
 func DeserializeStatsList(blob *Blob) ([]*PrimaryKeyStats, error) {
-	if len(blob.Value) == 0 {
-		return []*PrimaryKeyStats{}, nil
-	}
-	sr := &StatsReader{}
-	sr.SetBuffer(blob.Value)
-	stats, err := sr.GetPrimaryKeyStatsList()
+	it, err := NewStatsListIterator(blob)
 	if err != nil {
 		return nil, err
 	}
-	return stats, nil
+	return it.collect()
 }
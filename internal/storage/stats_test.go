@@ -17,6 +17,7 @@
 package storage
 
 import (
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -204,3 +205,34 @@ func TestMarshalStats(t *testing.T) {
 		assert.True(t, stat1[0].BF.Test(b))
 	}
 }
+
+func TestStatsIterator(t *testing.T) {
+	stat1, err := NewPrimaryKeyStats(1, int64(schemapb.DataType_VarChar), 100)
+	assert.NoError(t, err)
+	stat1.Update(NewVarCharPrimaryKey("a"))
+	stat1.Update(NewVarCharPrimaryKey("c"))
+	stat2, err := NewPrimaryKeyStats(1, int64(schemapb.DataType_VarChar), 100)
+	assert.NoError(t, err)
+	stat2.Update(NewVarCharPrimaryKey("x"))
+
+	sw := &StatsWriter{}
+	assert.NoError(t, sw.GenerateList([]*PrimaryKeyStats{stat1, stat2}))
+
+	it, err := NewStatsListIterator(&Blob{Value: sw.GetBuffer()})
+	assert.NoError(t, err)
+	first, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", first.MinPk.GetValue())
+	assert.Equal(t, "c", first.MaxPk.GetValue())
+	assert.True(t, first.BF.TestString("c"))
+	second, err := it.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "x", second.MinPk.GetValue())
+	_, err = it.Next()
+	assert.ErrorIs(t, err, io.EOF)
+
+	it, err = NewStatsListIterator(&Blob{})
+	assert.NoError(t, err)
+	_, err = it.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
@@ -86,6 +86,20 @@ type ChunkManager interface {
 	RemoveWithPrefix(ctx context.Context, prefix string) error
 }
 
+// URLSigner is implemented by the ChunkManager which is able to generate presigned url,
+// so that the external reader could access the file directly.
+type URLSigner interface {
+	// SignURL returns a presigned url to read @filePath, which expires after @expiry.
+	SignURL(ctx context.Context, filePath string, expiry time.Duration) (string, error)
+}
+
+// ObjectTagger is implemented by the ChunkManager which is able to tag the objects,
+// so that the bucket lifecycle policies could be applied by the tags.
+type ObjectTagger interface {
+	// TagObject replaces the tags of @filePath with @tags.
+	TagObject(ctx context.Context, filePath string, tags map[string]string) error
+}
+
 // ListAllChunkWithPrefix is a helper function to list all objects with same @prefix by using `ListWithPrefix`.
 // `ListWithPrefix` is more efficient way to call if you don't need all chunk at same time.
 func ListAllChunkWithPrefix(ctx context.Context, manager ChunkManager, prefix string, recursive bool) ([]string, []time.Time, error) {
@@ -24,6 +24,7 @@ import (
 	"io"
 	"io/fs"
 	"os"
+	"reflect"
 	"sort"
 	"strconv"
 
@@ -1106,6 +1107,107 @@ func MergeInsertData(buffer *InsertData, datas ...*InsertData) {
 	}
 }
 
+// AppendInsertData appends all rows of src to dst column by column, both of them must carry the same fields.
+// Nothing is appended if the fields don't match or any of the columns fails to be appended.
+func AppendInsertData(dst, src *InsertData) error {
+	if len(dst.Data) != len(src.Data) {
+		return merr.WrapErrParameterInvalidMsg("field number mismatch, expected %d, got %d", len(dst.Data), len(src.Data))
+	}
+	for fieldID, srcField := range src.Data {
+		dstField, ok := dst.Data[fieldID]
+		if !ok {
+			return merr.WrapErrFieldNotFound(fieldID)
+		}
+		if fmt.Sprintf("%T", dstField) != fmt.Sprintf("%T", srcField) || dstField.GetNullable() != srcField.GetNullable() ||
+			getVectorDim(dstField) != getVectorDim(srcField) {
+			return merr.WrapErrParameterInvalidMsg("field %d mismatch, expected %T(nullable=%t, dim=%d), got %T(nullable=%t, dim=%d)",
+				fieldID, dstField, dstField.GetNullable(), getVectorDim(dstField), srcField, srcField.GetNullable(), getVectorDim(srcField))
+		}
+	}
+	// append into the scratch copies first, so the columns of dst never diverge in length on failure
+	appended := make(map[FieldID]FieldData, len(src.Data))
+	for fieldID, srcField := range src.Data {
+		dstField := shallowCopyFieldData(dst.Data[fieldID])
+		var err error
+		if sparse, ok := srcField.(*SparseFloatVectorFieldData); ok {
+			err = dstField.AppendDataRows(sparse)
+		} else {
+			err = dstField.AppendRows(srcField.GetDataRows(), getValidDataRows(srcField))
+		}
+		if err != nil {
+			return err
+		}
+		appended[fieldID] = dstField
+	}
+	for fieldID, dstField := range appended {
+		dst.Data[fieldID] = dstField
+	}
+	dst.Infos = append(dst.Infos, src.Infos...)
+	return nil
+}
+
+// shallowCopyFieldData returns a copy of the field data sharing the underlying arrays,
+// the rows appended to the copy are invisible to the original one.
+func shallowCopyFieldData(fieldData FieldData) FieldData {
+	v := reflect.ValueOf(fieldData).Elem()
+	copied := reflect.New(v.Type())
+	copied.Elem().Set(v)
+	return copied.Interface().(FieldData)
+}
+
+// getVectorDim returns the dim of the dense vector field data, 0 for the others.
+func getVectorDim(fieldData FieldData) int {
+	switch fieldData := fieldData.(type) {
+	case *BinaryVectorFieldData:
+		return fieldData.Dim
+	case *FloatVectorFieldData:
+		return fieldData.Dim
+	case *Float16VectorFieldData:
+		return fieldData.Dim
+	case *BFloat16VectorFieldData:
+		return fieldData.Dim
+	case *Int8VectorFieldData:
+		return fieldData.Dim
+	case *VectorArrayFieldData:
+		return int(fieldData.Dim)
+	}
+	return 0
+}
+
+// getValidDataRows returns the valid flags of the nullable field data, nil for the others.
+func getValidDataRows(fieldData FieldData) []bool {
+	if !fieldData.GetNullable() {
+		return nil
+	}
+	switch fieldData := fieldData.(type) {
+	case *BoolFieldData:
+		return fieldData.ValidData
+	case *Int8FieldData:
+		return fieldData.ValidData
+	case *Int16FieldData:
+		return fieldData.ValidData
+	case *Int32FieldData:
+		return fieldData.ValidData
+	case *Int64FieldData:
+		return fieldData.ValidData
+	case *FloatFieldData:
+		return fieldData.ValidData
+	case *DoubleFieldData:
+		return fieldData.ValidData
+	case *TimestamptzFieldData:
+		return fieldData.ValidData
+	case *StringFieldData:
+		return fieldData.ValidData
+	case *ArrayFieldData:
+		return fieldData.ValidData
+	case *JSONFieldData:
+		return fieldData.ValidData
+	case *GeometryFieldData:
+		return fieldData.ValidData
+	}
+	return nil
+}
+
 // TODO: string type.
 func GetPkFromInsertData(collSchema *schemapb.CollectionSchema, data *InsertData) (FieldData, error) {
 	helper, err := typeutil.CreateSchemaHelper(collSchema)
@@ -2558,3 +2558,52 @@ func TestFillMissingFields(t *testing.T) {
 		assert.Equal(t, 0, field101.RowNum())
 	})
 }
+
+func TestAppendInsertData(t *testing.T) {
+	newData := func(pks []int64, vectors []float32, nullable []bool) *InsertData {
+		return &InsertData{
+			Data: map[FieldID]FieldData{
+				common.RowIDField:      &Int64FieldData{Data: pks},
+				Int64Field:             &Int64FieldData{Data: pks, ValidData: nullable, Nullable: true},
+				FloatVectorField:       &FloatVectorFieldData{Data: vectors, Dim: 2},
+				SparseFloatVectorField: &SparseFloatVectorFieldData{SparseFloatArray: schemapb.SparseFloatArray{Dim: int64(len(pks))}},
+				common.TimeStampField:  &Int64FieldData{Data: pks},
+				StringField:            &StringFieldData{Data: lo.Map(pks, func(pk int64, _ int) string { return strconv.FormatInt(pk, 10) })},
+				BinaryVectorField:      &BinaryVectorFieldData{Data: lo.Map(pks, func(pk int64, _ int) byte { return byte(pk) }), Dim: 8},
+			},
+		}
+	}
+
+	dst := newData([]int64{1}, []float32{1, 1}, []bool{true})
+	err := AppendInsertData(dst, newData([]int64{2, 3}, []float32{2, 2, 3, 3}, []bool{false, true}))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, dst.GetRowNum())
+	assert.Equal(t, []int64{1, 2, 3}, dst.Data[Int64Field].(*Int64FieldData).Data)
+	assert.Equal(t, []bool{true, false, true}, dst.Data[Int64Field].(*Int64FieldData).ValidData)
+	assert.Equal(t, []float32{1, 1, 2, 2, 3, 3}, dst.Data[FloatVectorField].(*FloatVectorFieldData).Data)
+	assert.Equal(t, []string{"1", "2", "3"}, dst.Data[StringField].(*StringFieldData).Data)
+	assert.EqualValues(t, 2, dst.Data[SparseFloatVectorField].(*SparseFloatVectorFieldData).Dim)
+
+	// nothing is appended if the fields mismatch
+	src := newData([]int64{4}, []float32{4, 4, 4}, []bool{true})
+	src.Data[FloatVectorField].(*FloatVectorFieldData).Dim = 3
+	assert.Error(t, AppendInsertData(dst, src))
+	src = newData([]int64{4}, []float32{4, 4}, []bool{true})
+	delete(src.Data, StringField)
+	assert.Error(t, AppendInsertData(dst, src))
+	src = newData([]int64{4}, []float32{4, 4}, nil)
+	src.Data[Int64Field].(*Int64FieldData).Nullable = false
+	assert.Error(t, AppendInsertData(dst, src))
+	assert.Equal(t, 3, dst.GetRowNum())
+
+	// nothing is appended if any column fails to be appended
+	src = newData([]int64{4}, []float32{4, 4, 4}, []bool{true})
+	assert.Error(t, AppendInsertData(dst, src))
+	for fieldID, fieldData := range dst.Data {
+		if fieldID != SparseFloatVectorField {
+			assert.Equal(t, 3, fieldData.RowNum(), "field %d", fieldID)
+		}
+	}
+	assert.Equal(t, []int64{1, 2, 3}, dst.Data[Int64Field].(*Int64FieldData).Data)
+	assert.Equal(t, []bool{true, false, true}, dst.Data[Int64Field].(*Int64FieldData).ValidData)
+}
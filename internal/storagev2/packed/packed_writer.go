@@ -37,7 +37,9 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 )
 
-func NewPackedWriter(filePaths []string, schema *arrow.Schema, bufferSize int64, multiPartUploadSize int64, columnGroups []storagecommon.ColumnGroup, storageConfig *indexpb.StorageConfig, storagePluginContext *indexcgopb.StoragePluginContext) (*PackedWriter, error) {
+// NewPackedWriter creates a packed writer, columnCompressions is the zstd level of the top level columns,
+// 0 means uncompressed, the columns not listed use the default compression.
+func NewPackedWriter(filePaths []string, schema *arrow.Schema, bufferSize int64, multiPartUploadSize int64, columnGroups []storagecommon.ColumnGroup, columnCompressions map[string]int, storageConfig *indexpb.StorageConfig, storagePluginContext *indexcgopb.StoragePluginContext) (*PackedWriter, error) {
 	cFilePaths := make([]*C.char, len(filePaths))
 	for i, path := range filePaths {
 		cFilePaths[i] = C.CString(path)
@@ -69,6 +71,26 @@ func NewPackedWriter(filePaths []string, schema *arrow.Schema, bufferSize int64,
 		C.free(cGroup)
 	}
 
+	var cColumnCompressions C.CColumnCompressions
+	if len(columnCompressions) > 0 {
+		cColumns := (**C.char)(C.malloc(C.size_t(len(columnCompressions)) * C.size_t(unsafe.Sizeof((*C.char)(nil)))))
+		defer C.free(unsafe.Pointer(cColumns))
+		cLevels := (*C.int32_t)(C.malloc(C.size_t(len(columnCompressions)) * C.size_t(unsafe.Sizeof(C.int32_t(0)))))
+		defer C.free(unsafe.Pointer(cLevels))
+		cColumnSlice := unsafe.Slice(cColumns, len(columnCompressions))
+		cLevelSlice := unsafe.Slice(cLevels, len(columnCompressions))
+		i := 0
+		for column, level := range columnCompressions {
+			cColumnSlice[i] = C.CString(column)
+			defer C.free(unsafe.Pointer(cColumnSlice[i]))
+			cLevelSlice[i] = C.int32_t(level)
+			i++
+		}
+		cColumnCompressions.columns = cColumns
+		cColumnCompressions.levels = cLevels
+		cColumnCompressions.num_columns = C.int64_t(len(columnCompressions))
+	}
+
 	var cPackedWriter C.CPackedWriter
 	var status C.CStatus
 
@@ -117,9 +139,9 @@ func NewPackedWriter(filePaths []string, schema *arrow.Schema, bufferSize int64,
 		defer C.free(unsafe.Pointer(cStorageConfig.sslCACert))
 		defer C.free(unsafe.Pointer(cStorageConfig.region))
 		defer C.free(unsafe.Pointer(cStorageConfig.gcp_credential_json))
-		status = C.NewPackedWriterWithStorageConfig(cSchema, cBufferSize, cFilePathsArray, cNumPaths, cMultiPartUploadSize, cColumnGroups, cStorageConfig, &cPackedWriter, pluginContextPtr)
+		status = C.NewPackedWriterWithStorageConfig(cSchema, cBufferSize, cFilePathsArray, cNumPaths, cMultiPartUploadSize, cColumnGroups, cColumnCompressions, cStorageConfig, &cPackedWriter, pluginContextPtr)
 	} else {
-		status = C.NewPackedWriter(cSchema, cBufferSize, cFilePathsArray, cNumPaths, cMultiPartUploadSize, cColumnGroups, &cPackedWriter, pluginContextPtr)
+		status = C.NewPackedWriter(cSchema, cBufferSize, cFilePathsArray, cNumPaths, cMultiPartUploadSize, cColumnGroups, cColumnCompressions, &cPackedWriter, pluginContextPtr)
 	}
 	if err := ConsumeCStatusIntoError(&status); err != nil {
 		return nil, err
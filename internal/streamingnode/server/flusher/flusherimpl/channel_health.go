@@ -0,0 +1,175 @@
+package flusherimpl
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const (
+	channelHealthReportTimeout       = 5 * time.Second
+	channelHealthReportRetryInterval = time.Second
+)
+
+// newChannelHealth creates a new channel health.
+func newChannelHealth() *channelHealth {
+	return &channelHealth{
+		failures:    make(map[string]int),
+		quarantined: typeutil.NewSet[string](),
+		degraded:    typeutil.NewSet[string](),
+		reporter:    newChannelHealthReporter(),
+	}
+}
+
+// channelHealth tracks the failures of the vchannel flowgraphs across the restarts of the flusher.
+// A vchannel which fails too many times is quarantined, so a poisoned vchannel only degrades itself.
+// The health changes are reported to the datacoord asynchronously by the reporter.
+// It's only accessed by the executing goroutine of the flusher, so no lock is required.
+type channelHealth struct {
+	failures    map[string]int
+	quarantined typeutil.Set[string]
+	degraded    typeutil.Set[string] // the vchannels reported as unhealthy to datacoord
+	reporter    *channelHealthReporter
+}
+
+// ObserveFailure records a failure of the vchannel and returns whether the vchannel is quarantined.
+func (h *channelHealth) ObserveFailure(vchannel string, reason error) bool {
+	h.failures[vchannel]++
+	state := datapb.ChannelHealthState_ChannelDegraded
+	if h.failures[vchannel] > paramtable.Get().StreamingCfg.FlushRestartMaxTimes.GetAsInt() {
+		h.quarantined.Insert(vchannel)
+		state = datapb.ChannelHealthState_ChannelQuarantined
+	}
+	h.degraded.Insert(vchannel)
+	h.reporter.Report(vchannel, state, h.failures[vchannel], reason)
+	return h.quarantined.Contain(vchannel)
+}
+
+// ObserveRecovered reports the degraded vchannels as healthy after their data sync services are rebuilt.
+// The failures are kept, so a vchannel which keeps failing is still quarantined at last.
+func (h *channelHealth) ObserveRecovered(vchannels []string) {
+	for _, vchannel := range vchannels {
+		if !h.degraded.Contain(vchannel) || h.quarantined.Contain(vchannel) {
+			continue
+		}
+		h.degraded.Remove(vchannel)
+		h.reporter.Report(vchannel, datapb.ChannelHealthState_ChannelHealthy, h.failures[vchannel], nil)
+	}
+}
+
+// IsQuarantined returns whether the vchannel is quarantined.
+func (h *channelHealth) IsQuarantined(vchannel string) bool {
+	return h.quarantined.Contain(vchannel)
+}
+
+// QuarantinedNum returns the number of the quarantined vchannels.
+func (h *channelHealth) QuarantinedNum() int {
+	return h.quarantined.Len()
+}
+
+// newChannelHealthReporter creates a new channel health reporter.
+func newChannelHealthReporter() *channelHealthReporter {
+	return &channelHealthReporter{
+		pending: make(map[string]*datapb.ReportChannelHealthRequest),
+		notify:  make(chan struct{}, 1),
+	}
+}
+
+// channelHealthReporter reports the health of the vchannels to the datacoord in background,
+// so the consuming of the flusher is never blocked by the datacoord.
+// Only the latest health of a vchannel is kept if it's not reported yet.
+type channelHealthReporter struct {
+	mu      sync.Mutex
+	pending map[string]*datapb.ReportChannelHealthRequest
+	notify  chan struct{}
+}
+
+// Report adds the health of the vchannel into the pending reports.
+func (r *channelHealthReporter) Report(vchannel string, state datapb.ChannelHealthState, failures int, reason error) {
+	req := &datapb.ReportChannelHealthRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		Vchannel: vchannel,
+		State:    state,
+		Failures: int64(failures),
+	}
+	if reason != nil {
+		req.Reason = reason.Error()
+	}
+	r.mu.Lock()
+	r.pending[vchannel] = req
+	r.mu.Unlock()
+	r.wakeup()
+}
+
+// Run reports the pending health to the datacoord until the context is done.
+func (r *channelHealthReporter) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.notify:
+		}
+		if !r.reportPending(ctx) {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(channelHealthReportRetryInterval):
+				r.wakeup()
+			}
+		}
+	}
+}
+
+// reportPending reports all the pending health, the failed ones are kept for retrying.
+func (r *channelHealthReporter) reportPending(ctx context.Context) bool {
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = make(map[string]*datapb.ReportChannelHealthRequest)
+	r.mu.Unlock()
+
+	allReported := true
+	for vchannel, req := range pending {
+		if err := r.report(ctx, req); err != nil {
+			log.Ctx(ctx).Warn("failed to report channel health to datacoord", zap.String("vchannel", vchannel), zap.Error(err))
+			allReported = false
+			r.mu.Lock()
+			if _, ok := r.pending[vchannel]; !ok {
+				// keep the failed report only if there's no newer one.
+				r.pending[vchannel] = req
+			}
+			r.mu.Unlock()
+		}
+	}
+	return allReported
+}
+
+func (r *channelHealthReporter) report(ctx context.Context, req *datapb.ReportChannelHealthRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, channelHealthReportTimeout)
+	defer cancel()
+	mixc, err := resource.Resource().MixCoordClient().GetWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := mixc.ReportChannelHealth(ctx, req)
+	return merr.CheckRPCCall(resp, err)
+}
+
+// wakeup notifies the background reporter to report the pending health.
+func (r *channelHealthReporter) wakeup() {
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,92 @@
+package flusherimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
+	internaltypes "github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/syncutil"
+)
+
+func TestChannelHealth(t *testing.T) {
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.FlushRestartMaxTimes.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.FlushRestartMaxTimes.Key)
+
+	h := newChannelHealth()
+	assert.False(t, h.IsQuarantined("vchannel-1"))
+	assert.False(t, h.ObserveFailure("vchannel-1", errors.New("failed")))
+	assert.False(t, h.IsQuarantined("vchannel-1"))
+	assert.Equal(t, 0, h.QuarantinedNum())
+	assert.Equal(t, datapb.ChannelHealthState_ChannelDegraded, h.reporter.pending["vchannel-1"].GetState())
+	assert.Equal(t, "failed", h.reporter.pending["vchannel-1"].GetReason())
+
+	h.ObserveRecovered([]string{"vchannel-1", "vchannel-2"})
+	assert.Equal(t, datapb.ChannelHealthState_ChannelHealthy, h.reporter.pending["vchannel-1"].GetState())
+	assert.Nil(t, h.reporter.pending["vchannel-2"])
+
+	assert.True(t, h.ObserveFailure("vchannel-1", errors.New("failed")))
+	assert.True(t, h.IsQuarantined("vchannel-1"))
+	assert.False(t, h.IsQuarantined("vchannel-2"))
+	assert.Equal(t, 1, h.QuarantinedNum())
+	assert.Equal(t, datapb.ChannelHealthState_ChannelQuarantined, h.reporter.pending["vchannel-1"].GetState())
+	assert.Equal(t, int64(2), h.reporter.pending["vchannel-1"].GetFailures())
+
+	// the quarantined vchannel is never reported as healthy.
+	h.ObserveRecovered([]string{"vchannel-1"})
+	assert.Equal(t, datapb.ChannelHealthState_ChannelQuarantined, h.reporter.pending["vchannel-1"].GetState())
+}
+
+func TestChannelHealthReporter(t *testing.T) {
+	reported := make(chan *datapb.ReportChannelHealthRequest, 10)
+	mixcoord := mocks.NewMockMixCoordClient(t)
+	mixcoord.EXPECT().ReportChannelHealth(mock.Anything, mock.Anything).Return(nil, errors.New("unavailable")).Once()
+	mixcoord.EXPECT().ReportChannelHealth(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *datapb.ReportChannelHealthRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			reported <- req
+			return merr.Success(), nil
+		})
+	fMixcoord := syncutil.NewFuture[internaltypes.MixCoordClient]()
+	fMixcoord.Set(mixcoord)
+	resource.InitForTest(t, resource.OptMixCoordClient(fMixcoord))
+
+	r := newChannelHealthReporter()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.Run(ctx)
+		close(done)
+	}()
+
+	// the failed report is retried.
+	r.Report("vchannel-1", datapb.ChannelHealthState_ChannelDegraded, 1, errors.New("failed"))
+	select {
+	case req := <-reported:
+		assert.Equal(t, "vchannel-1", req.GetVchannel())
+		assert.Equal(t, datapb.ChannelHealthState_ChannelDegraded, req.GetState())
+	case <-time.After(10 * time.Second):
+		t.Fatal("channel health is not reported")
+	}
+
+	r.Report("vchannel-1", datapb.ChannelHealthState_ChannelHealthy, 1, nil)
+	select {
+	case req := <-reported:
+		assert.Equal(t, datapb.ChannelHealthState_ChannelHealthy, req.GetState())
+	case <-time.After(10 * time.Second):
+		t.Fatal("channel health is not reported")
+	}
+
+	cancel()
+	<-done
+}
@@ -3,6 +3,8 @@ package flusherimpl
 import (
 	"context"
 
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/flushcommon/pipeline"
@@ -10,14 +12,18 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
+var errDataSyncServiceFailed = errors.New("data sync service failed")
+
 // newDataSyncServiceWrapper creates a new data sync service wrapper.
 func newDataSyncServiceWrapper(
 	channelName string,
 	input chan<- *msgstream.MsgPack,
 	ds *pipeline.DataSyncService,
 	channelCheckpointTimeTick uint64,
+	reflushingSegmentIDs []int64,
 ) *dataSyncServiceWrapper {
 	handler := adaptor.NewBaseMsgPackAdaptorHandler()
 	return &dataSyncServiceWrapper{
@@ -26,6 +32,7 @@ func newDataSyncServiceWrapper(
 		handler:                   handler,
 		ds:                        ds,
 		channelCheckpointTimeTick: channelCheckpointTimeTick,
+		reflushingSegments:        typeutil.NewUniqueSet(reflushingSegmentIDs...),
 	}
 }
 
@@ -33,6 +40,7 @@ func newDataSyncServiceWrapper(
 type dataSyncServiceWrapper struct {
 	channelName               string
 	channelCheckpointTimeTick uint64
+	reflushingSegments        typeutil.UniqueSet // the segments reset by the segment repair, re-consumed before the checkpoint
 	input                     chan<- *msgstream.MsgPack
 	handler                   *adaptor.BaseMsgPackAdaptorHandler
 	ds                        *pipeline.DataSyncService
@@ -44,24 +52,37 @@ func (ds *dataSyncServiceWrapper) Start() {
 }
 
 // HandleMessage handles the incoming message.
+// errDataSyncServiceFailed is returned if the flowgraph of the data sync service is failed.
 func (ds *dataSyncServiceWrapper) HandleMessage(ctx context.Context, msg message.ImmutableMessage) error {
+	if err := ds.Err(); err != nil {
+		return err
+	}
 	ds.handler.GenerateMsgPack(msg)
 	for ds.handler.PendingMsgPack.Len() > 0 {
 		next := ds.handler.PendingMsgPack.Next()
 		nextTsMsg := msgstream.MustBuildMsgPackFromConsumeMsgPack(next, adaptor.UnmashalerDispatcher)
 
-		// filter out the message less than vchannel level checkpoint.
+		// filter out the message less than vchannel level checkpoint,
+		// except the inserts of the reflushing segments.
 		if nextTsMsg.EndTs < ds.channelCheckpointTimeTick {
-			ds.handler.Logger.Debug("skip the message less than vchannel checkpoint",
-				zap.Uint64("timestamp", nextTsMsg.EndTs),
-				zap.Uint64("checkpoint", ds.channelCheckpointTimeTick),
-			)
-			ds.handler.PendingMsgPack.UnsafeAdvance()
-			continue
+			nextTsMsg.Msgs = lo.Filter(nextTsMsg.Msgs, func(msg msgstream.TsMsg, _ int) bool {
+				insertMsg, ok := msg.(*msgstream.InsertMsg)
+				return ok && ds.reflushingSegments.Contain(insertMsg.GetSegmentID())
+			})
+			if len(nextTsMsg.Msgs) == 0 {
+				ds.handler.Logger.Debug("skip the message less than vchannel checkpoint",
+					zap.Uint64("timestamp", nextTsMsg.EndTs),
+					zap.Uint64("checkpoint", ds.channelCheckpointTimeTick),
+				)
+				ds.handler.PendingMsgPack.UnsafeAdvance()
+				continue
+			}
 		}
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
+		case <-ds.ds.Failed():
+			return ds.Err()
 		case ds.input <- nextTsMsg:
 			// The input channel will never get stuck because the data sync service will consume the message continuously.
 			ds.handler.PendingMsgPack.UnsafeAdvance()
@@ -70,6 +91,14 @@ func (ds *dataSyncServiceWrapper) HandleMessage(ctx context.Context, msg message
 	return nil
 }
 
+// Err returns the error marked with errDataSyncServiceFailed if the flowgraph is failed.
+func (ds *dataSyncServiceWrapper) Err() error {
+	if err := ds.ds.Err(); err != nil {
+		return errors.Mark(errors.Wrapf(err, "vchannel %s", ds.channelName), errDataSyncServiceFailed)
+	}
+	return nil
+}
+
 // Close close the input channel and gracefully close the data sync service.
 func (ds *dataSyncServiceWrapper) Close() {
 	// The input channel should be closed first, otherwise the flowgraph in datasync service will be blocked.
@@ -3,6 +3,8 @@ package flusherimpl
 import (
 	"context"
 
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
@@ -24,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message/adaptor"
 	"github.com/milvus-io/milvus/pkg/v2/util/conc"
 	"github.com/milvus-io/milvus/pkg/v2/util/retry"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 // flusherComponents is the components of the flusher.
@@ -36,6 +39,9 @@ type flusherComponents struct {
 	logger                     *log.MLogger
 	recoveryCheckPointTimeTick uint64 // The time tick of the recovery storage.
 	rs                         recovery.RecoveryStorage
+	health                     *channelHealth
+	metrics                    *flusherMetrics
+	failedVChannels            typeutil.Set[string] // The vchannels whose data sync service is failed since the components built.
 }
 
 // WhenCreateCollection handles the create collection message.
@@ -47,6 +53,10 @@ func (impl *flusherComponents) WhenCreateCollection(createCollectionMsg message.
 		// May repeated consumed, so we ignore the message.
 		return
 	}
+	if impl.failedVChannels.Contain(createCollectionMsg.VChannel()) || impl.health.IsQuarantined(createCollectionMsg.VChannel()) {
+		impl.logger.Warn("the data sync service of current vchannel is failed, skip it", zap.String("vchannel", createCollectionMsg.VChannel()))
+		return
+	}
 	if createCollectionMsg.TimeTick() <= impl.recoveryCheckPointTimeTick {
 		// It should already be recovered from the recovery storage.
 		// if it's not in recovery storage, it means the createCollection is already dropped.
@@ -120,17 +130,62 @@ func (impl *flusherComponents) HandleMessage(ctx context.Context, msg message.Im
 	if _, ok := impl.dataServices[vchannel]; !ok {
 		return nil
 	}
-	return impl.dataServices[vchannel].HandleMessage(ctx, msg)
+	return impl.handleMessageOfDataSyncService(ctx, vchannel, msg)
 }
 
 // broadcastToAllDataSyncService broadcasts the message to all data sync services.
 func (impl *flusherComponents) broadcastToAllDataSyncService(ctx context.Context, msg message.ImmutableMessage) error {
-	for _, ds := range impl.dataServices {
-		if err := ds.HandleMessage(ctx, msg); err != nil {
-			return err
+	var failedErr error
+	for vchannel := range impl.dataServices {
+		if err := impl.handleMessageOfDataSyncService(ctx, vchannel, msg); err != nil {
+			if !errors.Is(err, errDataSyncServiceFailed) {
+				return err
+			}
+			// keep delivering the message to other data sync services.
+			failedErr = err
 		}
 	}
-	return nil
+	return failedErr
+}
+
+// handleMessageOfDataSyncService delivers the message to the data sync service of the vchannel,
+// the data sync service will be removed if its flowgraph is failed.
+func (impl *flusherComponents) handleMessageOfDataSyncService(ctx context.Context, vchannel string, msg message.ImmutableMessage) error {
+	ds := impl.dataServices[vchannel]
+	err := ds.HandleMessage(ctx, msg)
+	if err == nil || !errors.Is(err, errDataSyncServiceFailed) {
+		return err
+	}
+	// The failed data sync service is removed, so the messages of the vchannel will be dropped until the flusher restarts.
+	// The checkpoint of the vchannel will not be advanced, so the dropped messages will be consumed again after restarting.
+	ds.Close()
+	delete(impl.dataServices, vchannel)
+	impl.failedVChannels.Insert(vchannel)
+	quarantined := impl.health.ObserveFailure(vchannel, err)
+	impl.metrics.ObserveChannelFailure(impl.health.QuarantinedNum())
+	impl.logger.Warn("data sync service failed, the vchannel is degraded",
+		zap.String("vchannel", vchannel),
+		zap.Bool("quarantined", quarantined),
+		zap.Error(err))
+	return err
+}
+
+// NeedRestart returns whether the flusher should be restarted to recover the failed data sync services.
+func (impl *flusherComponents) NeedRestart() bool {
+	for vchannel := range impl.failedVChannels {
+		if !impl.health.IsQuarantined(vchannel) {
+			return true
+		}
+	}
+	return false
+}
+
+// RecoverableVChannels returns the vchannels which should be recovered when the flusher restarts.
+func (impl *flusherComponents) RecoverableVChannels() []string {
+	vchannels := append(lo.Keys(impl.dataServices), impl.failedVChannels.Collect()...)
+	return lo.Filter(vchannels, func(vchannel string, _ int) bool {
+		return !impl.health.IsQuarantined(vchannel)
+	})
 }
 
 // addNewDataSyncService adds a new data sync service to the components when new collection is created.
@@ -139,7 +194,7 @@ func (impl *flusherComponents) addNewDataSyncService(
 	input chan<- *msgstream.MsgPack,
 	ds *pipeline.DataSyncService,
 ) {
-	newDS := newDataSyncServiceWrapper(createCollectionMsg.VChannel(), input, ds, createCollectionMsg.TimeTick())
+	newDS := newDataSyncServiceWrapper(createCollectionMsg.VChannel(), input, ds, createCollectionMsg.TimeTick(), nil)
 	newDS.Start()
 	impl.dataServices[createCollectionMsg.VChannel()] = newDS
 	impl.logger.Info("create data sync service done", zap.String("vchannel", createCollectionMsg.VChannel()))
@@ -271,5 +326,5 @@ func (impl *flusherComponents) buildDataSyncService(ctx context.Context, recover
 	if err != nil {
 		return nil, err
 	}
-	return newDataSyncServiceWrapper(recoverInfo.Info.ChannelName, input, ds, recoverInfo.Info.GetSeekPosition().GetTimestamp()), nil
+	return newDataSyncServiceWrapper(recoverInfo.Info.ChannelName, input, ds, recoverInfo.Info.GetSeekPosition().GetTimestamp(), recoverInfo.GetReflushingSegmentIds()), nil
 }
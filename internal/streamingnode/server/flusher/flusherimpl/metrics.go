@@ -29,6 +29,8 @@ func newFlusherMetrics(pchannel types.PChannelInfo) *flusherMetrics {
 		constLabels: constLabels,
 		info:        metrics.WALFlusherInfo.MustCurryWith(constLabels),
 		timetick:    metrics.WALFlusherTimeTick.With(constLabels),
+		failure:     metrics.WALFlusherChannelFailureTotal.With(constLabels),
+		quarantined: metrics.WALFlusherQuarantinedChannelNum.With(constLabels),
 		state:       flusherStateInRecovering,
 	}
 	m.info.WithLabelValues(flusherStateInRecovering).Set(1)
@@ -39,6 +41,8 @@ type flusherMetrics struct {
 	constLabels prometheus.Labels
 	info        *prometheus.GaugeVec
 	timetick    prometheus.Gauge
+	failure     prometheus.Counter
+	quarantined prometheus.Gauge
 	state       flusherState
 }
 
@@ -52,7 +56,15 @@ func (m *flusherMetrics) ObserveMetrics(tickTime uint64) {
 	m.timetick.Set(tsoutil.PhysicalTimeSeconds(tickTime))
 }
 
+// ObserveChannelFailure observes a failure of the vchannel flowgraph.
+func (m *flusherMetrics) ObserveChannelFailure(quarantinedNum int) {
+	m.failure.Inc()
+	m.quarantined.Set(float64(quarantinedNum))
+}
+
 func (m *flusherMetrics) Close() {
 	metrics.WALFlusherInfo.DeletePartialMatch(m.constLabels)
 	metrics.WALFlusherTimeTick.DeletePartialMatch(m.constLabels)
+	metrics.WALFlusherChannelFailureTotal.DeletePartialMatch(m.constLabels)
+	metrics.WALFlusherQuarantinedChannelNum.DeletePartialMatch(m.constLabels)
 }
@@ -48,6 +48,12 @@ func (impl *WALFlusherImpl) getRecoveryInfos(ctx context.Context, vchannel []str
 		if checkpoint == nil || messageID.LT(checkpoint) {
 			checkpoint = messageID
 		}
+		// the data of the reflushing segments is consumed again from their start position.
+		if len(info.GetReflushPosition().GetMsgID()) > 0 {
+			if reflushID := adaptor.MustGetMessageIDFromMQWrapperIDBytes(info.GetReflushPosition().GetMsgID()); reflushID.LT(checkpoint) {
+				checkpoint = reflushID
+			}
+		}
 	}
 	return recoveryInfos, checkpoint, nil
 }
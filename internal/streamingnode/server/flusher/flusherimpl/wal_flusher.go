@@ -2,7 +2,9 @@ package flusherimpl
 
 import (
 	"context"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
@@ -22,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/syncutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 var errChannelLifetimeUnrecoverable = errors.New("channel lifetime unrecoverable")
@@ -43,6 +46,7 @@ func RecoverWALFlusher(param *RecoverWALFlusherParam) *WALFlusherImpl {
 			log.FieldComponent("flusher"),
 			zap.String("pchannel", param.ChannelInfo.String())),
 		metrics:         newFlusherMetrics(param.ChannelInfo),
+		health:          newChannelHealth(),
 		RecoveryStorage: param.RecoveryStorage,
 	}
 	go flusher.Execute(param.RecoverySnapshot)
@@ -55,6 +59,9 @@ type WALFlusherImpl struct {
 	flusherComponents *flusherComponents
 	logger            *log.MLogger
 	metrics           *flusherMetrics
+	health            *channelHealth
+	restartBackoff    *backoff.ExponentialBackOff
+	lastObserved      message.MessageID // The last message observed by recovery storage, used to skip the repeated messages after restarting.
 	recovery.RecoveryStorage
 }
 
@@ -79,42 +86,85 @@ func (impl *WALFlusherImpl) Execute(recoverSnapshot *recovery.RecoverySnapshot)
 		return errors.Wrap(err, "when get wal from future")
 	}
 	impl.logger.Info("wal ready for flusher recovery")
+	go impl.health.reporter.Run(impl.notifier.Context())
+	defer impl.metrics.IntoState(flusherStateOnClosing)
 
+	vchannels := lo.Keys(recoverSnapshot.VChannels)
+	for {
+		restart, err := impl.execute(l, vchannels, recoverSnapshot.Checkpoint)
+		if err != nil || !restart {
+			return err
+		}
+		// Only the vchannels that are still alive and not quarantined should be recovered,
+		// the recovery info is fetched again so the failed vchannels restart from their own checkpoint.
+		vchannels = impl.flusherComponents.RecoverableVChannels()
+		impl.logger.Info("wal flusher restart to recover the failed data sync services", zap.Int("vchannelNum", len(vchannels)))
+		impl.metrics.IntoState(flusherStateInRecovering)
+	}
+}
+
+// execute builds the flusher components and consumes the wal until the flusher is closed,
+// it returns true if the flusher should be restarted to recover the failed data sync services.
+func (impl *WALFlusherImpl) execute(l wal.WAL, vchannels []string, snapshotCheckpoint *recovery.WALCheckpoint) (bool, error) {
 	var checkpoint message.MessageID
-	impl.flusherComponents, checkpoint, err = impl.buildFlusherComponents(impl.notifier.Context(), l, recoverSnapshot)
+	var err error
+	impl.flusherComponents, checkpoint, err = impl.buildFlusherComponents(impl.notifier.Context(), l, vchannels, snapshotCheckpoint)
 	if err != nil {
-		return errors.Wrap(err, "when build flusher components")
+		return false, errors.Wrap(err, "when build flusher components")
 	}
 	defer impl.flusherComponents.Close()
+	impl.health.ObserveRecovered(lo.Keys(impl.flusherComponents.dataServices))
 
-	scanner, err := impl.generateScanner(impl.notifier.Context(), impl.wal.Get(), checkpoint)
+	scanner, err := impl.generateScanner(impl.notifier.Context(), l, checkpoint)
 	if err != nil {
-		return errors.Wrap(err, "when generate scanner")
+		return false, errors.Wrap(err, "when generate scanner")
 	}
 	defer scanner.Close()
 
 	impl.logger.Info("wal flusher start to work")
 	impl.metrics.IntoState(flusherStateInWorking)
-	defer impl.metrics.IntoState(flusherStateOnClosing)
 
+	// The healthy vchannels keep working until the restart timer is fired.
+	var restartTimer <-chan time.Time
 	for {
 		select {
 		case <-impl.notifier.Context().Done():
-			return nil
+			return false, nil
+		case <-restartTimer:
+			return true, nil
 		case msg, ok := <-scanner.Chan():
 			if !ok {
 				impl.logger.Warn("wal flusher is closing for closed scanner channel, which is unexpected at graceful way")
-				return nil
+				return false, nil
 			}
 			impl.metrics.ObserveMetrics(msg.TimeTick())
 			if err := impl.dispatch(msg); err != nil {
-				// The error is always context canceled.
-				return nil
+				if !errors.Is(err, errDataSyncServiceFailed) {
+					// The error is always context canceled.
+					return false, nil
+				}
+				if restartTimer == nil && impl.flusherComponents.NeedRestart() {
+					interval := impl.nextRestartInterval()
+					impl.logger.Warn("data sync service failed, wal flusher will restart after backoff", zap.Duration("backoff", interval), zap.Error(err))
+					restartTimer = time.After(interval)
+				}
 			}
 		}
 	}
 }
 
+// nextRestartInterval returns the next backoff interval of the flusher restarting.
+func (impl *WALFlusherImpl) nextRestartInterval() time.Duration {
+	if impl.restartBackoff == nil {
+		impl.restartBackoff = backoff.NewExponentialBackOff()
+		impl.restartBackoff.InitialInterval = paramtable.Get().StreamingCfg.FlushRestartBackoffInitialInterval.GetAsDurationByParse()
+		impl.restartBackoff.MaxInterval = paramtable.Get().StreamingCfg.FlushRestartBackoffMaxInterval.GetAsDurationByParse()
+		impl.restartBackoff.MaxElapsedTime = 0
+		impl.restartBackoff.Reset()
+	}
+	return impl.restartBackoff.NextBackOff()
+}
+
 // Close closes the wal flusher and release all related resources for it.
 func (impl *WALFlusherImpl) Close() {
 	impl.notifier.Cancel()
@@ -128,9 +178,7 @@ func (impl *WALFlusherImpl) Close() {
 }
 
 // buildFlusherComponents builds the components of the flusher.
-func (impl *WALFlusherImpl) buildFlusherComponents(ctx context.Context, l wal.WAL, snapshot *recovery.RecoverySnapshot) (*flusherComponents, message.MessageID, error) {
-	// Get all existed vchannels of the pchannel.
-	vchannels := lo.Keys(snapshot.VChannels)
+func (impl *WALFlusherImpl) buildFlusherComponents(ctx context.Context, l wal.WAL, vchannels []string, snapshotCheckpoint *recovery.WALCheckpoint) (*flusherComponents, message.MessageID, error) {
 	impl.logger.Info("fetch vchannel done", zap.Int("vchannelNum", len(vchannels)))
 
 	// Get all the recovery info of the recoverable vchannels.
@@ -141,8 +189,8 @@ func (impl *WALFlusherImpl) buildFlusherComponents(ctx context.Context, l wal.WA
 	}
 	impl.logger.Info("fetch recovery info done", zap.Int("recoveryInfoNum", len(recoverInfos)))
 	if len(vchannels) == 0 && checkpoint == nil {
-		impl.logger.Info("no vchannel to recover, use the snapshot checkpoint", zap.Stringer("checkpoint", snapshot.Checkpoint.MessageID))
-		checkpoint = snapshot.Checkpoint.MessageID
+		impl.logger.Info("no vchannel to recover, use the snapshot checkpoint", zap.Stringer("checkpoint", snapshotCheckpoint.MessageID))
+		checkpoint = snapshotCheckpoint.MessageID
 	}
 
 	mixc, err := resource.Resource().MixCoordClient().GetWithContext(ctx)
@@ -173,8 +221,11 @@ func (impl *WALFlusherImpl) buildFlusherComponents(ctx context.Context, l wal.WA
 		chunkManager:               chunkManager,
 		dataServices:               make(map[string]*dataSyncServiceWrapper),
 		logger:                     impl.logger,
-		recoveryCheckPointTimeTick: snapshot.Checkpoint.TimeTick,
+		recoveryCheckPointTimeTick: snapshotCheckpoint.TimeTick,
 		rs:                         impl.RecoveryStorage,
+		health:                     impl.health,
+		metrics:                    impl.metrics,
+		failedVChannels:            typeutil.NewSet[string](),
 	}
 	impl.logger.Info("flusher components intiailizing done")
 	if err := fc.recover(ctx, recoverInfos); err != nil {
@@ -209,9 +260,16 @@ func (impl *WALFlusherImpl) dispatch(msg message.ImmutableMessage) (err error) {
 	// TODO: We will merge the flusher into recovery storage in future.
 	// Currently, flusher works as a separate component.
 	defer func() {
-		if err = impl.RecoveryStorage.ObserveMessage(impl.notifier.Context(), msg); err != nil {
-			impl.logger.Warn("failed to observe message", zap.Error(err))
+		if impl.lastObserved != nil && msg.MessageID().LTE(impl.lastObserved) {
+			// The message is consumed again after the flusher restarts, it's already observed by the recovery storage.
+			return
+		}
+		if observeErr := impl.RecoveryStorage.ObserveMessage(impl.notifier.Context(), msg); observeErr != nil {
+			impl.logger.Warn("failed to observe message", zap.Error(observeErr))
+			err = observeErr
+			return
 		}
+		impl.lastObserved = msg.MessageID()
 	}()
 
 	// wal flusher will not handle the control channel message.
@@ -6,6 +6,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/redo"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/shard/shards"
@@ -140,9 +141,12 @@ func (impl *shardInterceptor) handleDropPartition(ctx context.Context, msg messa
 // handleInsertMessage handles the insert message.
 func (impl *shardInterceptor) handleInsertMessage(ctx context.Context, msg message.MutableMessage, appendOp interceptors.Append) (message.MessageID, error) {
 	insertMsg := message.MustAsMutableInsertMessageV1(msg)
+	header := insertMsg.Header()
+	if err := impl.checkPrimaryKeys(ctx, insertMsg, header); err != nil {
+		return nil, err
+	}
 	// Assign segment for insert message.
 	// !!! Current implementation a insert message only has one parition, but we need to merge the message for partition-key in future.
-	header := insertMsg.Header()
 	for _, partition := range header.GetPartitions() {
 		if partition.BinarySize == 0 {
 			// binary size should be set at proxy with estimate, but we don't implement it right now.
@@ -197,6 +201,30 @@ func (impl *shardInterceptor) handleInsertMessage(ctx context.Context, msg messa
 	return appendOp(ctx, msg)
 }
 
+// checkPrimaryKeys checks the duplicate primary keys of the insert message before it's appended into wal,
+// so all the consumers of the wal see the same rows when the strict pk mode drops the duplicates.
+func (impl *shardInterceptor) checkPrimaryKeys(ctx context.Context, insertMsg message.MutableInsertMessageV1, header *message.InsertMessageHeader) error {
+	body, err := insertMsg.Body()
+	if err != nil {
+		return err
+	}
+	checked, err := resource.Resource().WriteBufferManager().CheckPrimaryKeys(ctx, insertMsg.VChannel(), body, insertMsg.TimeTick())
+	if err != nil {
+		impl.shardManager.Logger().Warn("insert message rejected by strict pk check", zap.Object("message", insertMsg), zap.Error(err))
+		return status.NewUnrecoverableError("fail to check primary keys, %s", err.Error())
+	}
+	if checked == body {
+		return nil
+	}
+	insertMsg.OverwriteBody(checked)
+	for _, partition := range header.GetPartitions() {
+		// the binary size is re-estimated with the payload size of the deduplicated message.
+		partition.Rows = checked.GetNumRows()
+		partition.BinarySize = 0
+	}
+	return nil
+}
+
 // handleDeleteMessage handles the delete message.
 func (impl *shardInterceptor) handleDeleteMessage(ctx context.Context, msg message.MutableMessage, appendOp interceptors.Append) (message.MessageID, error) {
 	deleteMessage := message.MustAsMutableDeleteMessageV1(msg)
@@ -47,6 +47,7 @@ func newPartitionSegmentManager(
 		segments:             segments,
 		fencedAssignTimeTick: fencedAssignTimeTick,
 		metrics:              metrics,
+		preSplit:             newPreSplitState(),
 	}
 	m.SetLogger(logger.With(zap.String("vchannel", vchannel), zap.Int64("collectionID", collectionID), zap.Int64("partitionID", paritionID)))
 	return m
@@ -67,15 +68,21 @@ type partitionManager struct {
 	segments             map[int64]*segmentAllocManager // there will be very few segments in this list.
 	fencedAssignTimeTick uint64                         // the time tick that the assign operation is fenced.
 	metrics              *metricsutil.SegmentAssignMetrics
+	onPreSplitting       bool           // indicates that if the partition manager is pre-splitting a new segment, no insert waits for it.
+	preSplit             *preSplitState // the insert rate and the round-robin cursor of the pre-split growing segments.
 }
 
 // AddSegment adds a segment to the partition segment manager.
 func (m *partitionManager) AddSegment(s *segmentAllocManager) {
-	if m.onAllocating == nil {
+	switch {
+	case m.onAllocating != nil:
+		close(m.onAllocating)
+		m.onAllocating = nil
+	case m.onPreSplitting:
+		m.onPreSplitting = false
+	default:
 		panic("critical bug: onAllocating is nil when receive a create segment message")
 	}
-	close(m.onAllocating)
-	m.onAllocating = nil
 	if s.CreateSegmentTimeTick() <= m.fencedAssignTimeTick {
 		panic("critical bug: create segment time tick is less than fenced assign time tick")
 	}
@@ -118,6 +125,7 @@ func (m *partitionManager) FlushAndDropPartition(policy policy.SealPolicy) []int
 		close(m.onAllocating)
 		m.onAllocating = nil
 	}
+	m.onPreSplitting = false
 
 	segmentIDs := make([]int64, 0, len(m.segments))
 	for _, segment := range m.segments {
@@ -194,9 +202,10 @@ func (m *partitionManager) MustRemoveFlushedSegment(segmentID int64) {
 func (m *partitionManager) assignSegment(req *AssignSegmentRequest) (*AssignSegmentResult, error) {
 	// Alloc segment for insert at allocated segments.
 	var lastErr error
-	for _, segment := range m.segments {
+	for _, segment := range m.orderedSegmentsToAssign(req) {
 		result, err := segment.AllocRows(req)
 		if err == nil {
+			m.asyncPreSplitSegmentIfNeeded()
 			return result, nil
 		}
 		if errors.IsAny(err, ErrTooLargeInsert, ErrTimeTickTooOld) {
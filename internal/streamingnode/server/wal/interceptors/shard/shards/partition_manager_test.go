@@ -192,3 +192,111 @@ func (m *mockedTxnManager) RecoverDone() <-chan struct{} {
 	close(ch)
 	return ch
 }
+
+func TestPartitionManagerPreSplit(t *testing.T) {
+	paramtable.Init()
+	resource.InitForTest(t)
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.SegmentPreSplitNum.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.SegmentPreSplitNum.Key)
+	channel := types.PChannelInfo{
+		Name: "test_channel",
+		Term: 1,
+	}
+	o := mock_utils.NewMockSealOperator(t)
+	o.EXPECT().Channel().Return(channel)
+	o.EXPECT().AsyncFlushSegment(mock.Anything).Return().Maybe()
+	resource.Resource().SegmentStatsManager().RegisterSealOperator(o, nil, nil)
+
+	m1 := newTestSegmentAllocManager(channel, &messagespb.CreateSegmentMessageHeader{
+		CollectionId:   1,
+		PartitionId:    2,
+		SegmentId:      1003,
+		StorageVersion: 2,
+		MaxSegmentSize: 1500,
+	}, 120)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := mock_wal.NewMockWAL(t)
+	w.EXPECT().Available().RunAndReturn(func() <-chan struct{} {
+		return make(chan struct{})
+	}).Maybe()
+	f := syncutil.NewFuture[wal.WAL]()
+	f.Set(w)
+	m := newPartitionSegmentManager(ctx,
+		log.With(),
+		f,
+		channel,
+		"v1",
+		1,
+		2,
+		map[int64]*segmentAllocManager{
+			m1.GetSegmentID(): m1,
+		},
+		&mockedTxnManager{},
+		100,
+		metricsutil.NewSegmentAssignMetrics(channel.Name),
+	)
+	createSegmentDone := make(chan *segmentAllocManager, 1)
+	w.EXPECT().Append(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, msg message.MutableMessage) (*types.AppendResult, error) {
+			msg2 := msg.WithTimeTick(200).WithLastConfirmedUseMessageID().IntoImmutableMessage(rmq.NewRmqID(4))
+			createSegmentDone <- newSegmentAllocManager(channel, message.MustAsImmutableCreateSegmentMessageV2(msg2))
+			return &types.AppendResult{
+				MessageID: rmq.NewRmqID(20),
+				TimeTick:  200,
+			}, nil
+		}).Once()
+
+	req := &AssignSegmentRequest{
+		TimeTick: 150,
+		ModifiedMetrics: stats.ModifiedMetrics{
+			Rows:       1,
+			BinarySize: 10,
+		},
+	}
+	// the busy partition keeps a pre-splitting segment in background.
+	result, err := m.AssignSegment(req)
+	assert.NoError(t, err)
+	assert.Equal(t, m1.GetSegmentID(), result.SegmentID)
+	result.Ack()
+	assert.True(t, m.onPreSplitting)
+
+	// the inserts never wait for the pre-splitting segment.
+	select {
+	case <-m.WaitPendingGrowingSegmentReady():
+	default:
+		t.Fatal("insert should not wait for the pre-splitting segment")
+	}
+	result, err = m.AssignSegment(req)
+	assert.NoError(t, err)
+	assert.Equal(t, m1.GetSegmentID(), result.SegmentID)
+	result.Ack()
+
+	m.AddSegment(<-createSegmentDone)
+	assert.False(t, m.onPreSplitting)
+	assert.Len(t, m.segments, 2)
+
+	// the inserts are round-robined among the growing segments.
+	req.TimeTick = 210
+	assigned := make(map[int64]int)
+	for i := 0; i < 4; i++ {
+		result, err := m.AssignSegment(req)
+		assert.NoError(t, err)
+		assigned[result.SegmentID]++
+		result.Ack()
+	}
+	assert.Len(t, assigned, 2)
+	for _, cnt := range assigned {
+		assert.Equal(t, 2, cnt)
+	}
+	assert.False(t, m.onPreSplitting)
+
+	// a pending pre-splitting segment is taken over by the allocation on demand.
+	m.onPreSplitting = true
+	m.asyncAllocSegment()
+	assert.False(t, m.onPreSplitting)
+	assert.NotNil(t, m.onAllocating)
+	m.FlushAndDropPartition(policy.PolicyPartitionRemoved())
+	<-m.WaitPendingGrowingSegmentReady()
+}
@@ -26,6 +26,16 @@ func (m *partitionManager) asyncAllocSegment() {
 	}
 	// Create a notifier to notify the waiter when the allocation is done.
 	m.onAllocating = make(chan struct{})
+	if m.onPreSplitting {
+		// the pre-splitting segment is on the way, the waiter can just wait for it.
+		m.onPreSplitting = false
+		return
+	}
+	m.startSegmentAllocWorker()
+}
+
+// startSegmentAllocWorker starts a segment alloc worker to create a new growing segment.
+func (m *partitionManager) startSegmentAllocWorker() {
 	w := &segmentAllocWorker{
 		ctx:          m.ctx,
 		collectionID: m.collectionID,
@@ -0,0 +1,83 @@
+package shards
+
+import (
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// newPreSplitState creates a new pre-split state.
+func newPreSplitState() *preSplitState {
+	return &preSplitState{windowStart: time.Now()}
+}
+
+// preSplitState records the insert rate and the round-robin cursor of a partition.
+type preSplitState struct {
+	cursor      int
+	windowStart time.Time
+	windowRows  uint64
+	rate        float64 // rows per second of the last window
+}
+
+// observe observes the rows of a incoming insert and refreshes the rate every second.
+func (st *preSplitState) observe(rows uint64, now time.Time) {
+	st.windowRows += rows
+	if elapsed := now.Sub(st.windowStart); elapsed >= time.Second {
+		st.rate = float64(st.windowRows) / elapsed.Seconds()
+		st.windowStart, st.windowRows = now, 0
+	}
+}
+
+// isPreSplitEnabled returns if the partition is busy enough to keep multiple growing segments.
+func (m *partitionManager) isPreSplitEnabled() bool {
+	if paramtable.Get().DataCoordCfg.SegmentPreSplitNum.GetAsInt() <= 1 {
+		return false
+	}
+	return m.preSplit.rate >= paramtable.Get().DataCoordCfg.SegmentPreSplitMinRowsPerSec.GetAsFloat()
+}
+
+// orderedSegmentsToAssign returns the segments in the order to try the assignment.
+// The inserts are round-robined among the growing segments if the pre-splitting is enabled,
+// so the growing segments are filled up and sealed at different time.
+func (m *partitionManager) orderedSegmentsToAssign(req *AssignSegmentRequest) []*segmentAllocManager {
+	m.preSplit.observe(req.ModifiedMetrics.Rows, time.Now())
+
+	segments := make([]*segmentAllocManager, 0, len(m.segments))
+	for _, segment := range m.segments {
+		segments = append(segments, segment)
+	}
+	if len(segments) <= 1 || !m.isPreSplitEnabled() {
+		return segments
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetSegmentID() < segments[j].GetSegmentID()
+	})
+	m.preSplit.cursor = (m.preSplit.cursor + 1) % len(segments)
+	rotated := make([]*segmentAllocManager, 0, len(segments))
+	rotated = append(rotated, segments[m.preSplit.cursor:]...)
+	return append(rotated, segments[:m.preSplit.cursor]...)
+}
+
+// asyncPreSplitSegmentIfNeeded creates a new growing segment in background
+// if the partition is busy and keeps less growing segments than dataCoord.segment.preSplit.num.
+// Unlike asyncAllocSegment, the incoming inserts never wait for the pre-splitting segment.
+func (m *partitionManager) asyncPreSplitSegmentIfNeeded() {
+	if m.onAllocating != nil || m.onPreSplitting || !m.isPreSplitEnabled() {
+		return
+	}
+	growing := 0
+	for _, segment := range m.segments {
+		if !segment.IsFlushed() {
+			growing++
+		}
+	}
+	if growing >= paramtable.Get().DataCoordCfg.SegmentPreSplitNum.GetAsInt() {
+		return
+	}
+	m.Logger().Debug("pre-split a new growing segment", zap.Int("growing", growing))
+	m.onPreSplitting = true
+	m.startSegmentAllocWorker()
+}
@@ -0,0 +1,107 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package componentutil
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/config"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// ShowConfigurationsDetailKey is the property of the request base to show the effective configurations including
+// the defaults, the source of each value is attached as value[source], e.g. 1024[FileSource].
+const ShowConfigurationsDetailKey = "show_configurations_detail"
+
+// NewShowConfigurationsDetailRequest creates a request to show the effective configurations matching the pattern.
+func NewShowConfigurationsDetailRequest(pattern string) *internalpb.ShowConfigurationsRequest {
+	return &internalpb.ShowConfigurationsRequest{
+		Base:    &commonpb.MsgBase{Properties: map[string]string{ShowConfigurationsDetailKey: "true"}},
+		Pattern: pattern,
+	}
+}
+
+// ShowConfigurations returns the configurations of the component matching req.Pattern,
+// it's shared by the ShowConfigurations of all the components.
+func ShowConfigurations(componentName string, req *internalpb.ShowConfigurationsRequest) []*commonpb.KeyValuePair {
+	configList := make([]*commonpb.KeyValuePair, 0)
+	if detail, _ := strconv.ParseBool(req.GetBase().GetProperties()[ShowConfigurationsDetailKey]); detail {
+		for key, value := range paramtable.Get().GetComponentEffectiveConfigurations(componentName, req.GetPattern()) {
+			configList = append(configList, &commonpb.KeyValuePair{Key: key, Value: value.String()})
+		}
+		return configList
+	}
+	for key, value := range paramtable.Get().GetComponentConfigurations(componentName, req.GetPattern()) {
+		configList = append(configList, &commonpb.KeyValuePair{Key: key, Value: value})
+	}
+	return configList
+}
+
+// DiffConfigurations compares the configurations shown by the nodes of the role, and returns the ones which differ.
+// The source of the values is reported but not compared.
+func DiffConfigurations(role string, nodeConfigs map[int64][]*commonpb.KeyValuePair, failedNodes []int64) *metricsinfo.ConfigDrift {
+	nodes := lo.Keys(nodeConfigs)
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	sort.Slice(failedNodes, func(i, j int) bool { return failedNodes[i] < failedNodes[j] })
+
+	keys := typeutil.NewSet[string]()
+	configs := make(map[int64]map[string]config.ConfigValue, len(nodeConfigs))
+	for nodeID, kvs := range nodeConfigs {
+		values := make(map[string]config.ConfigValue, len(kvs))
+		for _, kv := range kvs {
+			values[kv.GetKey()] = config.ParseConfigValue(kv.GetValue())
+			keys.Insert(kv.GetKey())
+		}
+		configs[nodeID] = values
+	}
+
+	drift := &metricsinfo.ConfigDrift{
+		Role:        role,
+		Nodes:       nodes,
+		FailedNodes: failedNodes,
+	}
+	sortedKeys := keys.Collect()
+	sort.Strings(sortedKeys)
+	for _, key := range sortedKeys {
+		values := make([]*metricsinfo.ConfigDriftNodeValue, 0, len(nodes))
+		differs := false
+		for _, nodeID := range nodes {
+			value, ok := configs[nodeID][key]
+			nodeValue := &metricsinfo.ConfigDriftNodeValue{
+				NodeID:  nodeID,
+				Value:   value.Value,
+				Source:  value.Source,
+				Missing: !ok,
+			}
+			if len(values) > 0 && (nodeValue.Missing != values[0].Missing || nodeValue.Value != values[0].Value) {
+				differs = true
+			}
+			values = append(values, nodeValue)
+		}
+		if differs {
+			drift.Items = append(drift.Items, &metricsinfo.ConfigDriftItem{Key: key, Values: values})
+		}
+	}
+	return drift
+}
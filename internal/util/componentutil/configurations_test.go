@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package componentutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/config"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestShowConfigurations(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	key := "datacoord.segment.maxsizebyindextype"
+
+	configs := ShowConfigurations("datacoord", &internalpb.ShowConfigurationsRequest{Pattern: "segment.maxSizeByIndexType"})
+	assert.Empty(t, configs)
+
+	configs = ShowConfigurations("datacoord", NewShowConfigurationsDetailRequest("segment.maxSizeByIndexType"))
+	assert.Equal(t, []*commonpb.KeyValuePair{{Key: key, Value: "{}[DefaultSource]"}}, configs)
+
+	params.Save(params.DataCoordCfg.SegmentMaxSizeByIndexType.Key, `{"HNSW": 512}`)
+	defer params.Reset(params.DataCoordCfg.SegmentMaxSizeByIndexType.Key)
+	configs = ShowConfigurations("datacoord", NewShowConfigurationsDetailRequest("segment.maxSizeByIndexType"))
+	assert.Equal(t, []*commonpb.KeyValuePair{{Key: key, Value: `{"HNSW": 512}[` + config.RuntimeSource + `]`}}, configs)
+}
+
+func TestDiffConfigurations(t *testing.T) {
+	drift := DiffConfigurations("querynode", map[int64][]*commonpb.KeyValuePair{
+		2: {
+			{Key: "a", Value: "1[FileSource]"},
+			{Key: "b", Value: "2[DefaultSource]"},
+			{Key: "c", Value: "3[DefaultSource]"},
+		},
+		1: {
+			{Key: "a", Value: "1[DefaultSource]"},
+			{Key: "b", Value: "4[EtcdSource]"},
+		},
+	}, []int64{4, 3})
+
+	assert.Equal(t, "querynode", drift.Role)
+	assert.Equal(t, []int64{1, 2}, drift.Nodes)
+	assert.Equal(t, []int64{3, 4}, drift.FailedNodes)
+	assert.Equal(t, []*metricsinfo.ConfigDriftItem{
+		{
+			Key: "b",
+			Values: []*metricsinfo.ConfigDriftNodeValue{
+				{NodeID: 1, Value: "4", Source: "EtcdSource"},
+				{NodeID: 2, Value: "2", Source: "DefaultSource"},
+			},
+		},
+		{
+			Key: "c",
+			Values: []*metricsinfo.ConfigDriftNodeValue{
+				{NodeID: 1, Missing: true},
+				{NodeID: 2, Value: "3", Source: "DefaultSource"},
+			},
+		},
+	}, drift.Items)
+
+	drift = DiffConfigurations("querynode", map[int64][]*commonpb.KeyValuePair{
+		1: {{Key: "a", Value: "1"}},
+		2: {{Key: "a", Value: "1"}},
+	}, nil)
+	assert.Empty(t, drift.Items)
+}
@@ -130,9 +130,22 @@ func (fg *TimeTickedFlowGraph) Close() {
 	})
 }
 
+// Failed returns a channel which is closed once the flowgraph fails, e.g. a node panics.
+func (fg *TimeTickedFlowGraph) Failed() <-chan struct{} {
+	return fg.nodeCtxManager.failedCh
+}
+
+// Err returns the error which fails the flowgraph, nil if it's working.
+func (fg *TimeTickedFlowGraph) Err() error {
+	return fg.nodeCtxManager.failure.Load()
+}
+
 // Status returns the status of the pipeline, it will return "Healthy" if the input node
 // has received any msg in the last nodeTtInterval
 func (fg *TimeTickedFlowGraph) Status() string {
+	if err := fg.Err(); err != nil {
+		return fmt.Sprintf("Failed: %s", err.Error())
+	}
 	diff := time.Since(fg.nodeCtxManager.lastAccessTime.Load())
 	if diff > nodeCtxTtInterval {
 		return fmt.Sprintf("input node hasn't received any msg in the last %s", diff.String())
@@ -144,7 +157,7 @@ func (fg *TimeTickedFlowGraph) Status() string {
 func NewTimeTickedFlowGraph(ctx context.Context) *TimeTickedFlowGraph {
 	flowGraph := TimeTickedFlowGraph{
 		nodeCtx:         make(map[string]*nodeCtx),
-		nodeCtxManager:  &nodeCtxManager{lastAccessTime: atomic.NewTime(time.Now())},
+		nodeCtxManager:  &nodeCtxManager{lastAccessTime: atomic.NewTime(time.Now()), failure: atomic.NewError(nil), failedCh: make(chan struct{})},
 		closeWg:         &sync.WaitGroup{},
 		closeGracefully: atomic.NewBool(CloseImmediately),
 	}
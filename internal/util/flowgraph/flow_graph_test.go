@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
@@ -254,3 +255,101 @@ func TestBlockAll(t *testing.T) {
 		}
 	}
 }
+
+type panicNode struct {
+	BaseNode
+}
+
+func (n *panicNode) Name() string {
+	return "PanicNode"
+}
+
+func (n *panicNode) Operate(in []Msg) []Msg {
+	panic("mock panic")
+}
+
+func TestTimeTickedFlowGraph_Failed(t *testing.T) {
+	const MaxQueueLength = 1024
+
+	inputChan := make(chan float64, MaxQueueLength)
+	fg := NewTimeTickedFlowGraph(context.Background())
+	var a Node = &nodeA{
+		InputNode: InputNode{
+			BaseNode: BaseNode{
+				maxQueueLength: MaxQueueLength,
+			},
+		},
+		inputChan: inputChan,
+	}
+	var p Node = &panicNode{
+		BaseNode: BaseNode{
+			maxQueueLength: MaxQueueLength,
+		},
+	}
+	fg.AddNode(a)
+	fg.AddNode(p)
+	assert.NoError(t, fg.SetEdges(a.Name(), []string{p.Name()}))
+	assert.NoError(t, fg.SetEdges(p.Name(), []string{}))
+	assert.NoError(t, fg.Err())
+
+	fg.Start()
+	inputChan <- 1
+	select {
+	case <-fg.Failed():
+	case <-time.After(10 * time.Second):
+		t.Fatal("flow graph should be failed")
+	}
+	assert.ErrorContains(t, fg.Err(), "PanicNode panicked")
+	assert.Contains(t, fg.Status(), "Failed")
+}
+
+type failableNode struct {
+	BaseNode
+	err error
+}
+
+func (n *failableNode) Name() string {
+	return "FailableNode"
+}
+
+func (n *failableNode) Operate(in []Msg) []Msg {
+	n.err = errors.New("mock error")
+	return []Msg{}
+}
+
+func (n *failableNode) Err() error {
+	return n.err
+}
+
+func TestTimeTickedFlowGraph_FailableNode(t *testing.T) {
+	const MaxQueueLength = 1024
+
+	inputChan := make(chan float64, MaxQueueLength)
+	fg := NewTimeTickedFlowGraph(context.Background())
+	var a Node = &nodeA{
+		InputNode: InputNode{
+			BaseNode: BaseNode{
+				maxQueueLength: MaxQueueLength,
+			},
+		},
+		inputChan: inputChan,
+	}
+	var f Node = &failableNode{
+		BaseNode: BaseNode{
+			maxQueueLength: MaxQueueLength,
+		},
+	}
+	fg.AddNode(a)
+	fg.AddNode(f)
+	assert.NoError(t, fg.SetEdges(a.Name(), []string{f.Name()}))
+	assert.NoError(t, fg.SetEdges(f.Name(), []string{}))
+
+	fg.Start()
+	inputChan <- 1
+	select {
+	case <-fg.Failed():
+	case <-time.After(10 * time.Second):
+		t.Fatal("flow graph should be failed")
+	}
+	assert.ErrorContains(t, fg.Err(), "mock error")
+}
@@ -50,6 +50,12 @@ type Node interface {
 	Free()
 }
 
+// FailableNode is the node which fails the flowgraph with an error rather than a panic,
+// the error is checked after each Operate and the flowgraph stops once it's not nil.
+type FailableNode interface {
+	Err() error
+}
+
 // BaseNode defines some common node attributes and behavior
 type BaseNode struct {
 	maxQueueLength int32
@@ -64,6 +70,11 @@ type nodeCtxManager struct {
 	closeCh      chan struct{} // notify nodes to exit
 
 	lastAccessTime *atomic.Time
+
+	// the flowgraph stops working once a node panics, the other flowgraphs are not affected.
+	failure  *atomic.Error
+	failOnce sync.Once
+	failedCh chan struct{}
 }
 
 // NewNodeCtxManager init with the inputNode and fg.closeWg
@@ -73,6 +84,8 @@ func NewNodeCtxManager(nodeCtx *nodeCtx, closeWg *sync.WaitGroup) *nodeCtxManage
 		closeWg:        closeWg,
 		closeCh:        make(chan struct{}),
 		lastAccessTime: atomic.NewTime(time.Now()),
+		failure:        atomic.NewError(nil),
+		failedCh:       make(chan struct{}),
 	}
 }
 
@@ -129,8 +142,12 @@ func (nodeCtxManager *nodeCtxManager) workNodeStart() {
 					nodeCtxManager.lastAccessTime.Store(time.Now())
 				}
 
-				output = n.Operate(input)
+				output, err := operate(n, input)
 				curNode.blockMutex.RUnlock()
+				if err != nil {
+					nodeCtxManager.fail(err)
+					return
+				}
 				// the output decide whether the node should be closed.
 				if isCloseMsg(output) {
 					nodeCtxManager.closeOnce.Do(func() {
@@ -153,6 +170,29 @@ func (nodeCtxManager *nodeCtxManager) workNodeStart() {
 	}
 }
 
+// operate runs the node, the panic is recovered as an error to fail the flowgraph rather than the process.
+func operate(n Node, input []Msg) (output []Msg, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("flow graph node panicked", zap.String("nodeName", n.Name()), zap.Any("panic", r), zap.Stack("stack"))
+			err = fmt.Errorf("flow graph node %s panicked: %v", n.Name(), r)
+		}
+	}()
+	output = n.Operate(input)
+	if failable, ok := n.(FailableNode); ok {
+		err = failable.Err()
+	}
+	return output, err
+}
+
+// fail stops the worker with the error.
+func (nodeCtxManager *nodeCtxManager) fail(err error) {
+	nodeCtxManager.failOnce.Do(func() {
+		nodeCtxManager.failure.Store(err)
+		close(nodeCtxManager.failedCh)
+	})
+}
+
 // Close handles cleanup logic and notify worker to quit
 func (nodeCtxManager *nodeCtxManager) Close() {
 	nodeCtx := nodeCtxManager.inputNodeCtx
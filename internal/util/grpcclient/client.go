@@ -116,6 +116,7 @@ type ClientBase[T interface {
 	ClientMaxSendSize      int
 	ClientMaxRecvSize      int
 	CompressionEnabled     bool
+	CompressionType        string
 	RetryServiceNameConfig string
 
 	DialTimeout      time.Duration
@@ -136,8 +137,16 @@ type ClientBase[T interface {
 	ctxCounter     atomic.Int32
 	maxCancelError int32
 
+	// deadlineReserveRatio is the ratio of the remaining time reserved for the caller
+	deadlineReserveRatio float64
+
 	NodeID atomic.Int64
 	sess   sessionutil.SessionInterface
+
+	// watchCoordSession enables watching the session of the coordinator to follow the leader change
+	watchCoordSession bool
+	watchOnce         sync.Once
+	watchCancel       context.CancelFunc
 }
 
 func NewClientBase[T interface {
@@ -154,10 +163,13 @@ func NewClientBase[T interface {
 		MaxAttempts:             config.MaxAttempts.GetAsInt(),
 		InitialBackoff:          config.InitialBackoff.GetAsFloat(),
 		MaxBackoff:              config.MaxBackoff.GetAsFloat(),
-		CompressionEnabled:      config.CompressionEnabled.GetAsBool(),
+		CompressionEnabled:      config.GetCompressor() != None,
+		CompressionType:         config.GetCompressor(),
 		minResetInterval:        config.MinResetInterval.GetAsDuration(time.Millisecond),
 		minSessionCheckInterval: config.MinSessionCheckInterval.GetAsDuration(time.Millisecond),
 		maxCancelError:          config.MaxCancelError.GetAsInt32(),
+		deadlineReserveRatio:    config.DeadlineReserveRatio.GetAsFloat(),
+		watchCoordSession:       config.WatchCoordSession.GetAsBool(),
 	}
 }
 
@@ -267,6 +279,9 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 	compress := None
 	if c.CompressionEnabled {
 		compress = Zstd
+		if c.CompressionType != None {
+			compress = c.CompressionType
+		}
 	}
 	if c.encryption {
 		log.Ctx(ctx).Debug("Running in internalTLS mode with encryption enabled")
@@ -364,6 +379,7 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 		client: c.newGrpcClient(conn),
 		conn:   conn,
 	}
+	c.startWatchCoordSession()
 	return nil
 }
 
@@ -477,7 +493,7 @@ func (c *ClientBase[T]) call(ctx context.Context, caller func(client T) (any, er
 		}
 	}
 
-	ctx, cancel := context.WithCancel(ctx)
+	ctx, cancel := c.withDeadlineBudget(ctx)
 	defer cancel()
 	err := retry.Handle(ctx, func() (bool, error) {
 		if wrapper == nil {
@@ -537,6 +553,11 @@ func (c *ClientBase[T]) call(ctx context.Context, caller func(client T) (any, er
 		}
 
 		err = merr.Error(status)
+		if c.isNotLeaderErr(ctx, err) {
+			log.Warn("coordinator is not the leader, reset connection", zap.Error(err))
+			resetClientFunc(true)
+			return true, err
+		}
 		if err != nil && merr.IsRetryableErr(err) {
 			return true, err
 		}
@@ -553,6 +574,11 @@ func (c *ClientBase[T]) call(ctx context.Context, caller func(client T) (any, er
 		if IsCrossClusterRoutingErr(err) {
 			err = merr.ErrServiceUnavailable
 		}
+		// distinguish the deadline exceeded from the internal errors
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) || errors.Is(err, context.DeadlineExceeded) ||
+			funcutil.IsGrpcErr(err, codes.DeadlineExceeded) {
+			err = merr.WrapErrServiceDeadlineExceeded(c.GetRole(), err)
+		}
 
 		return generic.Zero[T](), err
 	}
@@ -560,6 +586,21 @@ func (c *ClientBase[T]) call(ctx context.Context, caller func(client T) (any, er
 	return ret, nil
 }
 
+// withDeadlineBudget derives the deadline of the call from the deadline of the caller,
+// a part of the remaining time is reserved for the caller to handle the result,
+// so that the callee gives up before the caller instead of outliving it.
+func (c *ClientBase[T]) withDeadlineBudget(ctx context.Context) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if !ok || c.deadlineReserveRatio <= 0 {
+		return context.WithCancel(ctx)
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, time.Duration(float64(remaining)*(1-c.deadlineReserveRatio)))
+}
+
 // Call does a grpc call
 func (c *ClientBase[T]) Call(ctx context.Context, caller func(client T) (any, error)) (any, error) {
 	if !funcutil.CheckCtxValid(ctx) {
@@ -587,6 +628,7 @@ func (c *ClientBase[T]) ReCall(ctx context.Context, caller func(client T) (any,
 
 // Close close the client connection
 func (c *ClientBase[T]) Close() error {
+	c.stopWatchCoordSession()
 	c.grpcClientMtx.Lock()
 	defer c.grpcClientMtx.Unlock()
 	if c.grpcClient != nil {
@@ -138,6 +138,31 @@ func TestClientBase_NodeSessionNotExist(t *testing.T) {
 	assert.True(t, errors.Is(err, merr.ErrNodeNotFound))
 }
 
+func TestClientBase_DeadlineBudget(t *testing.T) {
+	base := ClientBase[*mockClient]{deadlineReserveRatio: 0.5}
+
+	ctx, cancel := base.withDeadlineBudget(context.Background())
+	defer cancel()
+	_, ok := ctx.Deadline()
+	assert.False(t, ok)
+
+	parent, parentCancel := context.WithTimeout(context.Background(), time.Minute)
+	defer parentCancel()
+	parentDeadline, _ := parent.Deadline()
+	ctx, cancel = base.withDeadlineBudget(parent)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.True(t, deadline.Before(parentDeadline))
+	assert.LessOrEqual(t, time.Until(deadline), 30*time.Second)
+
+	base.deadlineReserveRatio = 0
+	ctx, cancel = base.withDeadlineBudget(parent)
+	defer cancel()
+	deadline, _ = ctx.Deadline()
+	assert.Equal(t, parentDeadline, deadline)
+}
+
 func TestClientBase_Call(t *testing.T) {
 	testCall(t, false)
 }
@@ -180,6 +205,15 @@ func testCall(t *testing.T, compressed bool) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Call exceeds deadline", func(t *testing.T) {
+		initClient()
+		_, err := base.Call(context.Background(), func(client *mockClient) (any, error) {
+			return nil, status.Error(codes.DeadlineExceeded, "fake deadline exceeded")
+		})
+		assert.True(t, errors.Is(err, merr.ErrServiceDeadlineExceeded))
+		assert.True(t, merr.IsCanceledOrTimeout(err))
+	})
+
 	t.Run("Call with canceled context", func(t *testing.T) {
 		initClient()
 		ctx, cancel := context.WithCancel(context.Background())
@@ -588,3 +622,61 @@ func TestVerifySession(t *testing.T) {
 	err = base.verifySession(ctx)
 	assert.ErrorIs(t, err, merr.ErrNodeNotFound)
 }
+
+func TestClientBase_WatchCoordSession(t *testing.T) {
+	role := typeutil.MixCoordRole
+	newSession := func(serverID int64) *sessionutil.Session {
+		return &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: serverID, ServerName: role}}
+	}
+	eventCh := make(chan *sessionutil.SessionEvent, 1)
+	mockSession := sessionutil.NewMockSession(t)
+	mockSession.EXPECT().GetSessions(role).Return(map[string]*sessionutil.Session{role: newSession(1)}, 10, nil)
+	mockSession.EXPECT().WatchServices(role, int64(11), mock.Anything).Return((<-chan *sessionutil.SessionEvent)(eventCh))
+
+	base := &ClientBase[*mockClient]{watchCoordSession: true}
+	base.SetRole(role)
+	base.SetNodeID(1)
+	base.sess = mockSession
+	base.grpcClient = &clientConnWrapper[*mockClient]{client: &mockClient{}}
+	base.startWatchCoordSession()
+	defer base.Close()
+
+	hasClient := func() bool {
+		base.grpcClientMtx.RLock()
+		defer base.grpcClientMtx.RUnlock()
+		return base.grpcClient != nil
+	}
+
+	// the session of the connected server
+	eventCh <- &sessionutil.SessionEvent{EventType: sessionutil.SessionUpdateEvent, Session: newSession(1)}
+	time.Sleep(50 * time.Millisecond)
+	assert.True(t, hasClient())
+
+	// leader changed
+	eventCh <- &sessionutil.SessionEvent{EventType: sessionutil.SessionAddEvent, Session: newSession(2)}
+	assert.Eventually(t, func() bool { return !hasClient() }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestClientBase_isNotLeaderErr(t *testing.T) {
+	ctx := context.Background()
+	mockSession := sessionutil.NewMockSession(t)
+	mockSession.EXPECT().GetSessions(mock.Anything).Return(map[string]*sessionutil.Session{
+		typeutil.MixCoordRole: {SessionRaw: sessionutil.SessionRaw{ServerID: 2}},
+	}, 0, nil)
+	base := &ClientBase[*mockClient]{}
+	base.SetRole(typeutil.MixCoordRole)
+	base.SetNodeID(1)
+	base.sess = mockSession
+
+	assert.False(t, base.isNotLeaderErr(ctx, nil))
+	assert.False(t, base.isNotLeaderErr(ctx, merr.ErrServiceInternal))
+	assert.True(t, base.isNotLeaderErr(ctx, merr.WrapErrServiceNotReady(typeutil.MixCoordRole, 1, "StandBy")))
+
+	base.SetNodeID(2)
+	base.lastSessionCheck.Store(time.Time{})
+	assert.False(t, base.isNotLeaderErr(ctx, merr.WrapErrServiceNotReady(typeutil.MixCoordRole, 2, "Initializing")))
+
+	node := &ClientBase[*mockClient]{}
+	node.SetRole(typeutil.QueryNodeRole)
+	assert.False(t, node.isNotLeaderErr(ctx, merr.ErrServiceNotReady))
+}
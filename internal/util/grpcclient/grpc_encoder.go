@@ -21,13 +21,17 @@ import (
 	"bytes"
 	"io"
 
+	"github.com/klauspost/compress/snappy"
 	"github.com/klauspost/compress/zstd"
 	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
 )
 
 const (
-	None = ""
-	Zstd = "zstd"
+	None   = ""
+	Zstd   = "zstd"
+	Gzip   = gzip.Name
+	Snappy = "snappy"
 )
 
 type grpcCompressor struct {
@@ -43,6 +47,7 @@ func init() {
 		decoder: dec,
 	}
 	encoding.RegisterCompressor(c)
+	encoding.RegisterCompressor(&snappyCompressor{})
 }
 
 func (c *grpcCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
@@ -84,3 +89,41 @@ func (c *grpcCompressor) Decompress(r io.Reader) (io.Reader, error) {
 func (c *grpcCompressor) Name() string {
 	return Zstd
 }
+
+// snappyCompressor trades the compression ratio for less cpu than zstd.
+type snappyCompressor struct{}
+
+func (c *snappyCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return &snappyWriteCloser{writer: w}, nil
+}
+
+type snappyWriteCloser struct {
+	writer io.Writer    // Compressed data will be written here.
+	buf    bytes.Buffer // Buffer uncompressed data here, compress on Close.
+}
+
+func (s *snappyWriteCloser) Write(p []byte) (int, error) {
+	return s.buf.Write(p)
+}
+
+func (s *snappyWriteCloser) Close() error {
+	_, err := s.writer.Write(snappy.Encode(nil, s.buf.Bytes()))
+	return err
+}
+
+func (c *snappyCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	compressed, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	uncompressed, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(uncompressed), nil
+}
+
+func (c *snappyCompressor) Name() string {
+	return Snappy
+}
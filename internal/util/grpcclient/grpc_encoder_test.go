@@ -17,6 +17,7 @@ package grpcclient
 
 import (
 	"bytes"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -42,3 +43,27 @@ func TestGrpcEncoder(t *testing.T) {
 	reader.Read(result)
 	assert.Equal(t, data, string(result))
 }
+
+func TestGrpcEncoder_Compressors(t *testing.T) {
+	data := bytes.Repeat([]byte("hello compression algorithm!"), 100)
+	for _, name := range []string{Zstd, Gzip, Snappy} {
+		t.Run(name, func(t *testing.T) {
+			compressor := encoding.GetCompressor(name)
+			assert.NotNil(t, compressor)
+
+			var buf bytes.Buffer
+			writer, err := compressor.Compress(&buf)
+			assert.NoError(t, err)
+			_, err = writer.Write(data)
+			assert.NoError(t, err)
+			assert.NoError(t, writer.Close())
+			assert.Less(t, buf.Len(), len(data))
+
+			reader, err := compressor.Decompress(bytes.NewReader(buf.Bytes()))
+			assert.NoError(t, err)
+			result, err := io.ReadAll(reader)
+			assert.NoError(t, err)
+			assert.Equal(t, data, result)
+		})
+	}
+}
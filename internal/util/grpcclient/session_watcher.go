@@ -0,0 +1,142 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// rewatchCoordSessionInterval is the interval to watch the session again after the watch channel is closed.
+var rewatchCoordSessionInterval = time.Second
+
+// startWatchCoordSession starts watching the session of the coordinator once the client connects to it,
+// the connection is reset as soon as the session points to another server,
+// so that the next call re-discovers the address of the new leader instead of failing on the old one.
+func (c *ClientBase[T]) startWatchCoordSession() {
+	if c.isNode || c.sess == nil || !c.watchCoordSession {
+		return
+	}
+	c.watchOnce.Do(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		c.watchCancel = cancel
+		go c.watchCoordSessionLoop(ctx)
+	})
+}
+
+func (c *ClientBase[T]) stopWatchCoordSession() {
+	// prevent the watch from being started after close
+	c.watchOnce.Do(func() {})
+	if c.watchCancel != nil {
+		c.watchCancel()
+	}
+}
+
+func (c *ClientBase[T]) watchCoordSessionLoop(ctx context.Context) {
+	role := c.GetRole()
+	log := log.Ctx(ctx).With(zap.String("clientRole", role))
+	log.Info("start to watch coordinator session")
+	defer log.Info("stop watching coordinator session")
+
+	for {
+		sessions, revision, err := c.sess.GetSessions(role)
+		if err == nil {
+			c.checkCoordSession(ctx, sessions[role])
+			eventCh := c.sess.WatchServices(role, revision+1, func(sessions map[string]*sessionutil.Session) error {
+				c.checkCoordSession(ctx, sessions[role])
+				return nil
+			})
+			if !c.handleCoordSessionEvents(ctx, eventCh) {
+				return
+			}
+			log.Warn("coordinator session watch channel closed, rewatch later")
+		} else {
+			log.Warn("failed to get coordinator session, rewatch later", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rewatchCoordSessionInterval):
+		}
+	}
+}
+
+// handleCoordSessionEvents handles the session events until the channel is closed, returns false if the context is done.
+func (c *ClientBase[T]) handleCoordSessionEvents(ctx context.Context, eventCh <-chan *sessionutil.SessionEvent) bool {
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case event, ok := <-eventCh:
+			if !ok {
+				return true
+			}
+			if event.Session == nil || event.Session.ServerName != c.GetRole() {
+				continue
+			}
+			switch event.EventType {
+			case sessionutil.SessionDelEvent:
+				if event.Session.ServerID == c.GetNodeID() {
+					c.resetCoordConnection(ctx, event.Session.ServerID, "coordinator session removed")
+				}
+			default:
+				c.checkCoordSession(ctx, event.Session)
+			}
+		}
+	}
+}
+
+// checkCoordSession resets the connection if the client is connected to a server other than the one in session.
+func (c *ClientBase[T]) checkCoordSession(ctx context.Context, session *sessionutil.Session) {
+	if session == nil || session.ServerID == c.GetNodeID() {
+		return
+	}
+	c.resetCoordConnection(ctx, session.ServerID, "coordinator leader changed")
+}
+
+func (c *ClientBase[T]) resetCoordConnection(ctx context.Context, serverID int64, reason string) {
+	c.grpcClientMtx.RLock()
+	wrapper := c.grpcClient
+	c.grpcClientMtx.RUnlock()
+	if wrapper == nil {
+		return
+	}
+	log.Ctx(ctx).Info("reset the connection to coordinator",
+		zap.String("clientRole", c.GetRole()),
+		zap.String("reason", reason),
+		zap.String("addr", c.GetAddr()),
+		zap.Int64("connectedServerID", c.GetNodeID()),
+		zap.Int64("serverID", serverID))
+	c.resetConnection(wrapper, true)
+}
+
+// isNotLeaderErr returns whether the coordinator replies not ready because it's not the leader,
+// e.g. the client is still connected to the standby or the stale coordinator after failover.
+func (c *ClientBase[T]) isNotLeaderErr(ctx context.Context, err error) bool {
+	if c.isNode || !errors.Is(err, merr.ErrServiceNotReady) {
+		return false
+	}
+	return errors.Is(c.verifySession(ctx), merr.ErrNodeNotMatch)
+}
@@ -44,6 +44,10 @@ const (
 
 	// CSVNullKey specifies the null key used when importing CSV files.
 	CSVNullKey = "nullkey"
+
+	// DirectSealed indicates whether to register the imported segments as sealed directly once the binlogs are validated,
+	// the positions of the segments are not aligned to the channel checkpoints, default to false.
+	DirectSealed = "direct_sealed"
 )
 
 // Options for backup-restore mode.
@@ -134,6 +138,14 @@ func IsL0Import(options Options) bool {
 	return true
 }
 
+func IsDirectSealed(options Options) bool {
+	directSealed, err := funcutil.GetAttrByKeyFromRepeatedKV(DirectSealed, options)
+	if err != nil || strings.ToLower(directSealed) != "true" {
+		return false
+	}
+	return true
+}
+
 func GetStorageVersion(options Options) (int64, error) {
 	storageVersion, err := funcutil.GetAttrByKeyFromRepeatedKV(StorageVersion, options)
 	if err != nil {
@@ -208,6 +208,12 @@ func TestOption_GetStorageVersion(t *testing.T) {
 	assert.Equal(t, int64(2), version) // StorageV2 = 2
 }
 
+func TestOption_IsDirectSealed(t *testing.T) {
+	assert.False(t, IsDirectSealed(nil))
+	assert.True(t, IsDirectSealed([]*commonpb.KeyValuePair{{Key: DirectSealed, Value: "True"}}))
+	assert.False(t, IsDirectSealed([]*commonpb.KeyValuePair{{Key: DirectSealed, Value: "false"}}))
+}
+
 func TestSimple(t *testing.T) {
 	// Simple test to verify the test environment works
 	assert.Equal(t, 1, 1)
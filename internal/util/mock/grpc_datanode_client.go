@@ -46,6 +46,10 @@ func (m *GrpcDataNodeClient) DropTask(ctx context.Context, in *workerpb.DropTask
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcDataNodeClient) EstimateJob(ctx context.Context, in *workerpb.EstimateJobRequest, opts ...grpc.CallOption) (*workerpb.EstimateJobResponse, error) {
+	return &workerpb.EstimateJobResponse{}, m.Err
+}
+
 func (m *GrpcDataNodeClient) CreateJob(ctx context.Context, in *workerpb.CreateJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
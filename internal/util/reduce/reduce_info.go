@@ -13,6 +13,7 @@ type ResultInfo struct {
 	groupByFieldId int64
 	groupSize      int64
 	isAdvance      bool
+	stableOrder    bool
 }
 
 func NewReduceSearchResultInfo(
@@ -55,6 +56,12 @@ func (r *ResultInfo) WithAdvance(advance bool) *ResultInfo {
 	return r
 }
 
+// WithStableOrder makes the hits with equal scores ordered by pk.
+func (r *ResultInfo) WithStableOrder(stableOrder bool) *ResultInfo {
+	r.stableOrder = stableOrder
+	return r
+}
+
 func (r *ResultInfo) GetNq() int64 {
 	return r.nq
 }
@@ -87,6 +94,10 @@ func (r *ResultInfo) GetIsAdvance() bool {
 	return r.isAdvance
 }
 
+func (r *ResultInfo) GetStableOrder() bool {
+	return r.stableOrder
+}
+
 func (r *ResultInfo) SetMetricType(metricType string) {
 	r.metricType = metricType
 }
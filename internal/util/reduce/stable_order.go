@@ -0,0 +1,78 @@
+package reduce
+
+import (
+	"sort"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// SortEqualScoresByPK reorders the hits with equal scores of each query by pk ascending in place,
+// so that the order of the search result is deterministic across replicas.
+// The hits of each query are expected to be sorted by score descending already.
+func SortEqualScoresByPK(data *schemapb.SearchResultData) {
+	total := int64(len(data.GetScores()))
+	if total == 0 {
+		return
+	}
+
+	order := make([]int64, total)
+	for i := range order {
+		order[i] = int64(i)
+	}
+	reordered := false
+	var start int64
+	for _, topk := range data.GetTopks() {
+		end := start + topk
+		if end > total {
+			return
+		}
+		hits := order[start:end]
+		sort.SliceStable(hits, func(i, j int) bool {
+			a, b := hits[i], hits[j]
+			if data.Scores[a] != data.Scores[b] {
+				return data.Scores[a] > data.Scores[b]
+			}
+			return typeutil.ComparePKInSlice(data.GetIds(), int(a), int(b))
+		})
+		for i, idx := range hits {
+			if idx != start+int64(i) {
+				reordered = true
+				break
+			}
+		}
+		start = end
+	}
+	if !reordered {
+		return
+	}
+
+	scores := make([]float32, 0, total)
+	ids := &schemapb.IDs{}
+	var distances []float32
+	if len(data.GetDistances()) == int(total) {
+		distances = make([]float32, 0, total)
+	}
+	var fields []*schemapb.FieldData
+	if len(data.GetFieldsData()) > 0 {
+		fields = typeutil.PrepareResultFieldData(data.GetFieldsData(), total)
+	}
+	for _, idx := range order {
+		scores = append(scores, data.Scores[idx])
+		typeutil.AppendPKs(ids, typeutil.GetPK(data.GetIds(), idx))
+		if distances != nil {
+			distances = append(distances, data.Distances[idx])
+		}
+		if fields != nil {
+			typeutil.AppendFieldData(fields, data.GetFieldsData(), idx)
+		}
+	}
+	data.Scores = scores
+	data.Ids = ids
+	if distances != nil {
+		data.Distances = distances
+	}
+	if fields != nil {
+		data.FieldsData = fields
+	}
+}
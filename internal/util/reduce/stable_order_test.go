@@ -0,0 +1,47 @@
+package reduce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/testutils"
+)
+
+func TestSortEqualScoresByPK(t *testing.T) {
+	data := &schemapb.SearchResultData{
+		NumQueries: 2,
+		TopK:       3,
+		Topks:      []int64{3, 2},
+		Scores:     []float32{0.9, 0.5, 0.5, 0.8, 0.8},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{7, 3, 1, 5, 2}}},
+		},
+		FieldsData: []*schemapb.FieldData{
+			testutils.GenerateScalarFieldData(schemapb.DataType_Int64, "int64", 5),
+		},
+	}
+	data.FieldsData[0].GetScalars().GetLongData().Data = []int64{70, 30, 10, 50, 20}
+
+	SortEqualScoresByPK(data)
+	assert.Equal(t, []float32{0.9, 0.5, 0.5, 0.8, 0.8}, data.GetScores())
+	assert.Equal(t, []int64{7, 1, 3, 2, 5}, data.GetIds().GetIntId().GetData())
+	assert.Equal(t, []int64{70, 10, 30, 20, 50}, data.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+	// already in stable order
+	SortEqualScoresByPK(data)
+	assert.Equal(t, []int64{7, 1, 3, 2, 5}, data.GetIds().GetIntId().GetData())
+
+	strData := &schemapb.SearchResultData{
+		NumQueries: 1,
+		TopK:       3,
+		Topks:      []int64{3},
+		Scores:     []float32{0.5, 0.5, 0.5},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"c", "a", "b"}}},
+		},
+	}
+	SortEqualScoresByPK(strData)
+	assert.Equal(t, []string{"a", "b", "c"}, strData.GetIds().GetStrId().GetData())
+}
@@ -3,6 +3,7 @@ package scheduler
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -54,6 +55,11 @@ type scheduler struct {
 	// lifetime controls scheduler State & make sure all requests accepted will be processed
 	lifetime lifetime.Lifetime[lifetime.State]
 
+	// mergeHolding is the small nq task held for the merge window to merge the following tasks,
+	// only accessed by the schedule goroutine.
+	mergeHolding  MergeTask
+	mergeDeadline time.Time
+
 	schedulerCounter
 }
 
@@ -122,13 +128,21 @@ func (s *scheduler) schedule() {
 		nq := int64(0)
 		task, nq, execChan = s.setupExecListener(task)
 
+		// wake up to send the held task once the merge window passes
+		var mergeTimer *time.Timer
+		var mergeTimeout <-chan time.Time
+		if execChan == nil && task != nil {
+			mergeTimer = time.NewTimer(time.Until(s.mergeDeadline))
+			mergeTimeout = mergeTimer.C
+		}
+
 		select {
 		case req, ok := <-s.receiveChan:
 			if !ok {
 				log.Info("receiveChan closed, processing remaining request")
 				// drain policy maintained task
 				for task != nil {
-					execChan <- task
+					s.execChan <- task
 					s.updateWaitingTaskCounter(-1, -nq)
 					task = s.produceExecChan()
 				}
@@ -145,6 +159,11 @@ func (s *scheduler) schedule() {
 			s.updateWaitingTaskCounter(-1, -nq)
 			// And produce new task into execChan as much as possible.
 			task = s.produceExecChan()
+		case <-mergeTimeout:
+			// The merge window passed, the held task will be sent in next loop.
+		}
+		if mergeTimer != nil {
+			mergeTimer.Stop()
 		}
 	}
 }
@@ -179,6 +198,10 @@ func (s *scheduler) handleAddTaskRequest(req addTaskReq, maxWaitTaskNum int64) b
 	if err := req.task.Canceled(); err != nil {
 		log.Warn("task canceled before enqueue", zap.Error(err))
 		req.err <- err
+	} else if s.tryMergeIntoHolding(req.task) {
+		// The task is merged into the held task, which is counted already.
+		s.updateWaitingTaskCounter(0, req.task.NQ())
+		req.err <- nil
 	} else {
 		// Push the task into the policy to schedule and update the counter of the ready queue.
 		nq := req.task.NQ()
@@ -268,16 +291,62 @@ func (s *scheduler) setupExecListener(lastWaitingTask Task) (Task, int64, chan T
 	if lastWaitingTask == nil {
 		// No task is waiting to send to execChan, schedule a new one from queue.
 		lastWaitingTask = s.policy.Pop()
+		s.startMergeWindow(lastWaitingTask)
 	}
-	if lastWaitingTask != nil {
+	if lastWaitingTask != nil && !s.isMergeHolding(lastWaitingTask) {
 		// Try to sent task to execChan if there is a task ready to run.
 		execChan = s.execChan
+	}
+	if lastWaitingTask != nil {
 		nq = lastWaitingTask.NQ()
 	}
 
 	return lastWaitingTask, nq, execChan
 }
 
+// startMergeWindow holds the task for the merge window if its nq is small,
+// so that the concurrent small nq tasks arriving later could be merged into it.
+func (s *scheduler) startMergeWindow(task Task) {
+	s.mergeHolding = nil
+	window := paramtable.Get().QueryNodeCfg.GroupingMergeWindow.GetAsDuration(time.Millisecond)
+	if task == nil || window <= 0 {
+		return
+	}
+	mt := tryIntoMergeTask(task)
+	if mt == nil || mt.NQ() >= paramtable.Get().QueryNodeCfg.MaxGroupNQ.GetAsInt64() {
+		return
+	}
+	s.mergeHolding = mt
+	s.mergeDeadline = time.Now().Add(window)
+}
+
+// isMergeHolding returns whether the task is still held for merging,
+// the task is released once the window passes or the merged nq reaches the limit.
+func (s *scheduler) isMergeHolding(task Task) bool {
+	if s.mergeHolding == nil || Task(s.mergeHolding) != task {
+		return false
+	}
+	if time.Now().Before(s.mergeDeadline) &&
+		s.mergeHolding.NQ() < paramtable.Get().QueryNodeCfg.MaxGroupNQ.GetAsInt64() {
+		return true
+	}
+	s.mergeHolding = nil
+	return false
+}
+
+// tryMergeIntoHolding tries to merge the new task into the held task.
+func (s *scheduler) tryMergeIntoHolding(task Task) bool {
+	if s.mergeHolding == nil || !time.Now().Before(s.mergeDeadline) {
+		return false
+	}
+	mt := tryIntoMergeTask(task)
+	if mt == nil {
+		return false
+	}
+	maxNQ := paramtable.Get().QueryNodeCfg.MaxGroupNQ.GetAsInt64()
+	return s.mergeHolding.NQ()+mt.NQ() <= maxNQ && s.mergeHolding.MergeWith(mt)
+}
+
 // setupReadyLenMetric update the read task ready len metric.
 func (s *scheduler) setupReadyLenMetric() {
 	waitingTaskCount := s.GetWaitingTaskTotal()
@@ -137,3 +137,40 @@ func (s *SchedulerSuite) TestConsumeRecvChan() {
 		})
 	})
 }
+
+func (s *SchedulerSuite) TestMergeWindow() {
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.GroupingMergeWindow.Key, "200")
+	defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.GroupingMergeWindow.Key)
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.MaxGroupNQ.Key, "4")
+	defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.MaxGroupNQ.Key)
+
+	scheduler := newScheduler(newFIFOPolicy())
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	var executed atomic.Int32
+	tasks := make([]Task, 0, 6)
+	for i := 0; i < 6; i++ {
+		task := newMockTask(mockTaskConfig{
+			nq:          1,
+			mergeAble:   true,
+			executeCost: time.Millisecond,
+			execution: func(ctx context.Context) error {
+				executed.Inc()
+				return nil
+			},
+		})
+		tasks = append(tasks, task)
+		s.NoError(scheduler.Add(task))
+	}
+
+	// the first 4 tasks are merged until reaching the max nq,
+	// and the rest 2 tasks are merged and executed once the window passes.
+	s.Eventually(func() bool {
+		return executed.Load() == 2
+	}, time.Second, 10*time.Millisecond)
+	s.EqualValues(4, tasks[0].NQ())
+	s.EqualValues(2, tasks[4].NQ())
+	s.Equal(0, int(scheduler.GetWaitingTaskTotal()))
+	s.Equal(0, int(scheduler.GetWaitingTaskTotalNQ()))
+}
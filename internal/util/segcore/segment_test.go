@@ -276,6 +276,7 @@ func TestConvertToSegcoreSegmentLoadInfo(t *testing.T) {
 				},
 			},
 			Priority: commonpb.LoadPriority_HIGH,
+			Prefetch: true,
 		}
 
 		// Convert to segcorepb.SegmentLoadInfo
@@ -295,6 +296,7 @@ func TestConvertToSegcoreSegmentLoadInfo(t *testing.T) {
 		assert.Equal(t, src.StorageVersion, result.StorageVersion)
 		assert.Equal(t, src.IsSorted, result.IsSorted)
 		assert.Equal(t, src.Priority, result.Priority)
+		assert.Equal(t, src.Prefetch, result.Prefetch)
 		assert.Equal(t, src.CompactionFrom, result.CompactionFrom)
 
 		// Validate BinlogPaths conversion
@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionutil
+
+import (
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// The protocol features which are gated by the capabilities of the nodes.
+// A new capability shall be added here once a feature can't be understood by the nodes of the older version.
+const (
+	// CapabilityStorageV2 indicates the node is able to read and write the segments of storage v2 format.
+	CapabilityStorageV2 = "storage_v2"
+)
+
+// LocalCapabilities returns the capabilities supported by the current server.
+func LocalCapabilities() []string {
+	return []string{
+		CapabilityStorageV2,
+	}
+}
+
+var (
+	featureGate     *FeatureGate
+	featureGateOnce sync.Once
+)
+
+// GetFeatureGate returns the feature gate of the coordinator.
+func GetFeatureGate() *FeatureGate {
+	featureGateOnce.Do(func() {
+		featureGate = NewFeatureGate()
+	})
+	return featureGate
+}
+
+type nodeCapabilities struct {
+	role         string
+	version      string
+	capabilities typeutil.Set[string]
+}
+
+// FeatureGate collects the capabilities of the nodes registered in the session.
+// A capability is enabled only if all the nodes support it, so that the coordinators
+// don't use the features which are not supported by the nodes of older version during rolling upgrade.
+type FeatureGate struct {
+	mu    sync.RWMutex
+	nodes map[int64]*nodeCapabilities // serverID -> capabilities
+}
+
+// NewFeatureGate creates an empty feature gate.
+func NewFeatureGate() *FeatureGate {
+	return &FeatureGate{
+		nodes: make(map[int64]*nodeCapabilities),
+	}
+}
+
+// Startup resets the nodes of the role with the sessions, it's invoked on watching or rewatching the sessions.
+func (g *FeatureGate) Startup(role string, sessions map[string]*Session) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for serverID, node := range g.nodes {
+		if node.role == role {
+			delete(g.nodes, serverID)
+		}
+	}
+	for _, session := range sessions {
+		g.addOrUpdate(session)
+	}
+}
+
+// AddOrUpdate adds the node of the session, or updates its capabilities.
+func (g *FeatureGate) AddOrUpdate(session *Session) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.addOrUpdate(session)
+}
+
+func (g *FeatureGate) addOrUpdate(session *Session) {
+	node := &nodeCapabilities{
+		role:         session.ServerName,
+		version:      session.Version.String(),
+		capabilities: typeutil.NewSet(session.Capabilities...),
+	}
+	if _, ok := g.nodes[session.ServerID]; !ok {
+		log.Info("node capabilities registered", zap.Int64("serverID", session.ServerID), zap.String("role", node.role),
+			zap.String("version", node.version), zap.Strings("capabilities", session.Capabilities))
+	}
+	g.nodes[session.ServerID] = node
+}
+
+// Remove removes the node of the session.
+func (g *FeatureGate) Remove(session *Session) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.nodes, session.ServerID)
+}
+
+// IsEnabled returns whether the capability is supported by all the nodes.
+func (g *FeatureGate) IsEnabled(capability string) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	for _, node := range g.nodes {
+		if !node.capabilities.Contain(capability) {
+			return false
+		}
+	}
+	return true
+}
+
+// View returns the cluster-wide view of the feature gates.
+func (g *FeatureGate) View() *metricsinfo.FeatureGateView {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	view := &metricsinfo.FeatureGateView{
+		Gates: make([]*metricsinfo.FeatureGate, 0),
+		Nodes: make([]*metricsinfo.FeatureGateNode, 0, len(g.nodes)),
+	}
+	for _, capability := range LocalCapabilities() {
+		gate := &metricsinfo.FeatureGate{Capability: capability, Enabled: true}
+		for serverID, node := range g.nodes {
+			if !node.capabilities.Contain(capability) {
+				gate.Enabled = false
+				gate.UnsupportedNodes = append(gate.UnsupportedNodes, serverID)
+			}
+		}
+		sort.Slice(gate.UnsupportedNodes, func(i, j int) bool { return gate.UnsupportedNodes[i] < gate.UnsupportedNodes[j] })
+		view.Gates = append(view.Gates, gate)
+	}
+	for serverID, node := range g.nodes {
+		capabilities := node.capabilities.Collect()
+		sort.Strings(capabilities)
+		view.Nodes = append(view.Nodes, &metricsinfo.FeatureGateNode{
+			ServerID:     serverID,
+			Role:         node.role,
+			Version:      node.version,
+			Capabilities: capabilities,
+		})
+	}
+	sort.Slice(view.Nodes, func(i, j int) bool { return view.Nodes[i].ServerID < view.Nodes[j].ServerID })
+	return view
+}
@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionutil
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestFeatureGate(t *testing.T) {
+	newSession := func(serverID int64, role string, capabilities ...string) *Session {
+		return &Session{
+			SessionRaw: SessionRaw{
+				ServerID:     serverID,
+				ServerName:   role,
+				Capabilities: capabilities,
+			},
+			Version: semver.MustParse("2.6.6"),
+		}
+	}
+
+	gate := NewFeatureGate()
+	// enabled if there's no node
+	assert.True(t, gate.IsEnabled(CapabilityStorageV2))
+
+	gate.Startup(typeutil.QueryNodeRole, map[string]*Session{
+		"1": newSession(1, typeutil.QueryNodeRole, LocalCapabilities()...),
+	})
+	gate.AddOrUpdate(newSession(2, typeutil.DataNodeRole, LocalCapabilities()...))
+	assert.True(t, gate.IsEnabled(CapabilityStorageV2))
+
+	// the node of older version joins
+	legacy := newSession(3, typeutil.DataNodeRole)
+	gate.AddOrUpdate(legacy)
+	assert.False(t, gate.IsEnabled(CapabilityStorageV2))
+
+	view := gate.View()
+	assert.Len(t, view.Nodes, 3)
+	assert.Len(t, view.Gates, len(LocalCapabilities()))
+	assert.Equal(t, CapabilityStorageV2, view.Gates[0].Capability)
+	assert.False(t, view.Gates[0].Enabled)
+	assert.Equal(t, []int64{3}, view.Gates[0].UnsupportedNodes)
+	assert.Equal(t, "2.6.6", view.Nodes[0].Version)
+
+	// the legacy node is upgraded
+	gate.Remove(legacy)
+	assert.True(t, gate.IsEnabled(CapabilityStorageV2))
+
+	// rewatch removes the offline nodes of the role only
+	gate.AddOrUpdate(legacy)
+	gate.Startup(typeutil.DataNodeRole, map[string]*Session{})
+	assert.True(t, gate.IsEnabled(CapabilityStorageV2))
+	assert.Len(t, gate.View().Nodes, 1)
+}
@@ -120,6 +120,8 @@ type SessionRaw struct {
 
 	HostName     string            `json:"HostName,omitempty"`
 	ServerLabels map[string]string `json:"ServerLabels,omitempty"`
+	// Capabilities is the protocol features supported by the server, see FeatureGate.
+	Capabilities []string `json:"Capabilities,omitempty"`
 }
 
 func (s *SessionRaw) GetAddress() string {
@@ -264,7 +266,8 @@ func NewSessionWithEtcd(ctx context.Context, metaRoot string, client *clientv3.C
 		Version:  common.Version,
 
 		SessionRaw: SessionRaw{
-			HostName: hostName,
+			HostName:     hostName,
+			Capabilities: LocalCapabilities(),
 		},
 
 		// options
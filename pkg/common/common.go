@@ -22,6 +22,7 @@ import (
 	"math/bits"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -172,11 +173,26 @@ const (
 	JSONCastFunctionKey = "json_cast_function"
 )
 
+// search preset params, the name of the preset referenced by search request
+const (
+	SearchPresetKey = "search_preset"
+)
+
+// StableOrderKey is the search param to order the hits with equal scores by pk,
+// it's also passed to querynode by the properties of the msg base.
+const StableOrderKey = "stable_order"
+
 // expr query params
 const (
 	ExprUseJSONStatsKey = "expr_use_json_stats"
 )
 
+// partial load keys, carried by the extra info of status
+const (
+	PartialLoadedKey = "partial_loaded"
+	WarningKey       = "warning"
+)
+
 // Doc-in-doc-out
 const (
 	EnableAnalyzerKey = `enable_analyzer`
@@ -189,6 +205,21 @@ const (
 	CollectionTTLConfigKey      = "collection.ttl.seconds"
 	CollectionAutoCompactionKey = "collection.autocompaction.enabled"
 	CollectionDescription       = "collection.description"
+	// CollectionInsertBufferMaxAgeKey is the max age of the insert buffer in datanode,
+	// the buffer is flushed once it's older than the age even if it's small.
+	CollectionInsertBufferMaxAgeKey = "collection.insertBuffer.maxAge.seconds"
+	// CollectionAutoReleaseIdleHoursKey opts the collection in the idle auto release of querycoord,
+	// the collection is released once it's not searched or queried for the hours, and reloaded on the next search.
+	CollectionAutoReleaseIdleHoursKey = "collection.autoRelease.idle.hours"
+	// CollectionAutoReleasedKey marks the collection released for being idle by querycoord,
+	// the value is the load config to reload the collection with, which is kept across the restart of querycoord.
+	CollectionAutoReleasedKey = "collection.autoRelease.released"
+	// CollectionSegmentMaxSizeKey overrides the target segment size of the collection in MB,
+	// which is used to seal the growing segments and to size the compaction output.
+	CollectionSegmentMaxSizeKey = "collection.segment.maxSize"
+	// CollectionStrictPKModeKey enables the duplicate primary key detection on ingest,
+	// the value is one of StrictPKModeDetect, StrictPKModeDedup and StrictPKModeReject.
+	CollectionStrictPKModeKey = "collection.strictPK.mode"
 
 	// Note:
 	// Function output fields cannot be included in inserted data.
@@ -211,6 +242,15 @@ const (
 	CollectionSearchRateMinKey   = "collection.searchRate.min.vps"
 	CollectionDiskQuotaKey       = "collection.diskProtection.diskQuota.mb"
 
+	// collection level force deny/allow, the deny takes precedence over the allow.
+	CollectionForceDenyWritingKey = "collection.force.deny.writing"
+	CollectionForceDenyReadingKey = "collection.force.deny.reading"
+	// CollectionForceAllowWritingKey exempts the collection from the automatic write protections,
+	// e.g. time tick delay, memory, growing segments and disk quota.
+	CollectionForceAllowWritingKey = "collection.force.allow.writing"
+	// CollectionForceAllowReadingKey exempts the collection from the cluster and database level force deny reading.
+	CollectionForceAllowReadingKey = "collection.force.allow.reading"
+
 	PartitionDiskQuotaKey = "partition.diskProtection.diskQuota.mb"
 
 	// database level properties
@@ -231,6 +271,34 @@ const (
 	// collection level load properties
 	CollectionReplicaNumber  = "collection.replica.number"
 	CollectionResourceGroups = "collection.resource_groups"
+
+	// collection level search parameter presets, the full key is `collection.searchPreset.<name>`
+	CollectionSearchPresetKeyPrefix = "collection.searchPreset."
+
+	// field level binlog compression codec, the full key is `collection.binlogCompression.<field name>`,
+	// the value is `none`, `zstd` or `zstd:<level>`
+	CollectionBinlogCompressionKeyPrefix = "collection.binlogCompression."
+
+	// the name of the collection template which the collection is created from,
+	// the schema, default indexes and properties of the template are applied when creating the collection.
+	CollectionTemplateKey = "collection.template"
+)
+
+// binlog compression codecs
+const (
+	BinlogCompressionNone = "none"
+	BinlogCompressionZstd = "zstd"
+
+	DefaultBinlogCompressionLevel = 3
+)
+
+// asynchronous ddl
+const (
+	// DdlAsyncKey in the msg base properties asks rootcoord to return once the ddl is accepted,
+	// instead of blocking until the ddl is done.
+	DdlAsyncKey = "ddl_async"
+	// DdlTaskIDKey in the status extra info is the id to poll the state of the asynchronous ddl.
+	DdlTaskIDKey = "ddl_task_id"
 )
 
 // common properties
@@ -256,6 +324,9 @@ const (
 	PropertiesKey        string = "properties"
 	TraceIDKey           string = "uber-trace-id"
 	ClientRequestMsecKey string = "client-request-unixmsec"
+	// ClientTagKeyPrefix is the prefix of the grpc metadata keys of the user-defined request tags,
+	// e.g. "client-tag-app: recommender".
+	ClientTagKeyPrefix string = "client-tag-"
 )
 
 // Timestamptz field
@@ -457,6 +528,29 @@ func CollectionLevelResourceGroups(kvs []*commonpb.KeyValuePair) ([]string, erro
 	return nil, fmt.Errorf("collection property not found: %s", CollectionReplicaNumber)
 }
 
+// ParseBinlogCompression parses the binlog compression codec and level of a field from the property value.
+func ParseBinlogCompression(value string) (string, int, error) {
+	codec, levelStr, hasLevel := strings.Cut(strings.TrimSpace(value), ":")
+	switch codec {
+	case BinlogCompressionNone:
+		if hasLevel {
+			return "", 0, fmt.Errorf("invalid binlog compression %s, level is not supported by %s", value, codec)
+		}
+		return codec, 0, nil
+	case BinlogCompressionZstd:
+		if !hasLevel {
+			return codec, DefaultBinlogCompressionLevel, nil
+		}
+		level, err := strconv.Atoi(levelStr)
+		if err != nil || level < 1 || level > 22 {
+			return "", 0, fmt.Errorf("invalid binlog compression %s, zstd level should be in [1, 22]", value)
+		}
+		return codec, level, nil
+	default:
+		return "", 0, fmt.Errorf("invalid binlog compression %s, supported codecs: %s, %s", value, BinlogCompressionNone, BinlogCompressionZstd)
+	}
+}
+
 // GetCollectionLoadFields returns the load field ids according to the type params.
 func GetCollectionLoadFields(schema *schemapb.CollectionSchema, skipDynamicField bool) []int64 {
 	filter := func(field *schemapb.FieldSchema, _ int) (int64, bool) {
@@ -530,6 +624,80 @@ func GetReplicateEndTS(kvs []*commonpb.KeyValuePair) (uint64, bool) {
 	return 0, false
 }
 
+// GetCollectionInsertBufferMaxAge returns the max age of the insert buffer, 0 means not set.
+func GetCollectionInsertBufferMaxAge(kvs []*commonpb.KeyValuePair) (time.Duration, error) {
+	for _, kv := range kvs {
+		if kv.GetKey() == CollectionInsertBufferMaxAgeKey {
+			seconds, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+			if err != nil {
+				return 0, err
+			}
+			if seconds < 0 {
+				return 0, fmt.Errorf("invalid %s value: %s", CollectionInsertBufferMaxAgeKey, kv.GetValue())
+			}
+			return time.Duration(seconds) * time.Second, nil
+		}
+	}
+	return 0, nil
+}
+
+// GetCollectionAutoReleaseIdle returns the idle duration to release the collection, 0 means not set.
+func GetCollectionAutoReleaseIdle(kvs []*commonpb.KeyValuePair) (time.Duration, error) {
+	for _, kv := range kvs {
+		if kv.GetKey() == CollectionAutoReleaseIdleHoursKey {
+			hours, err := strconv.ParseFloat(kv.GetValue(), 64)
+			if err != nil {
+				return 0, err
+			}
+			if hours < 0 {
+				return 0, fmt.Errorf("invalid %s value: %s", CollectionAutoReleaseIdleHoursKey, kv.GetValue())
+			}
+			return time.Duration(hours * float64(time.Hour)), nil
+		}
+	}
+	return 0, nil
+}
+
+// GetCollectionSegmentMaxSize returns the target segment size of the collection in MB, 0 means not set.
+func GetCollectionSegmentMaxSize(kvs []*commonpb.KeyValuePair) (int64, error) {
+	for _, kv := range kvs {
+		if kv.GetKey() == CollectionSegmentMaxSizeKey {
+			size, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+			if err != nil {
+				return 0, err
+			}
+			if size <= 0 {
+				return 0, fmt.Errorf("invalid %s value: %s", CollectionSegmentMaxSizeKey, kv.GetValue())
+			}
+			return size, nil
+		}
+	}
+	return 0, nil
+}
+
+const (
+	// StrictPKModeDetect reports the duplicate primary keys by metrics and logs, the rows are kept.
+	StrictPKModeDetect = "detect"
+	// StrictPKModeDedup drops the rows whose primary key is verified to be duplicate, the first written row is kept.
+	StrictPKModeDedup = "dedup"
+	// StrictPKModeReject rejects the whole insert if any primary key of it is verified to be duplicate.
+	StrictPKModeReject = "reject"
+)
+
+// GetCollectionStrictPKMode returns the strict primary key mode of the collection, empty means not enabled.
+func GetCollectionStrictPKMode(kvs []*commonpb.KeyValuePair) (string, error) {
+	for _, kv := range kvs {
+		if kv.GetKey() == CollectionStrictPKModeKey {
+			mode := strings.ToLower(kv.GetValue())
+			if mode != StrictPKModeDetect && mode != StrictPKModeDedup && mode != StrictPKModeReject {
+				return "", fmt.Errorf("invalid %s value: %s", CollectionStrictPKModeKey, kv.GetValue())
+			}
+			return mode, nil
+		}
+	}
+	return "", nil
+}
+
 func IsEnableDynamicSchema(kvs []*commonpb.KeyValuePair) (found bool, value bool, err error) {
 	for _, kv := range kvs {
 		if kv.GetKey() == EnableDynamicSchemaKey {
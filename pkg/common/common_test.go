@@ -3,6 +3,7 @@ package common
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -289,6 +290,71 @@ func TestIsEnableDynamicSchema(t *testing.T) {
 	}
 }
 
+func TestGetCollectionInsertBufferMaxAge(t *testing.T) {
+	age, err := GetCollectionInsertBufferMaxAge(nil)
+	assert.NoError(t, err)
+	assert.Zero(t, age)
+
+	age, err = GetCollectionInsertBufferMaxAge([]*commonpb.KeyValuePair{{Key: CollectionInsertBufferMaxAgeKey, Value: "30"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, age)
+
+	_, err = GetCollectionInsertBufferMaxAge([]*commonpb.KeyValuePair{{Key: CollectionInsertBufferMaxAgeKey, Value: "abc"}})
+	assert.Error(t, err)
+
+	_, err = GetCollectionInsertBufferMaxAge([]*commonpb.KeyValuePair{{Key: CollectionInsertBufferMaxAgeKey, Value: "-1"}})
+	assert.Error(t, err)
+}
+
+func TestGetCollectionAutoReleaseIdle(t *testing.T) {
+	idle, err := GetCollectionAutoReleaseIdle(nil)
+	assert.NoError(t, err)
+	assert.Zero(t, idle)
+
+	idle, err = GetCollectionAutoReleaseIdle([]*commonpb.KeyValuePair{{Key: CollectionAutoReleaseIdleHoursKey, Value: "0.5"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, idle)
+
+	_, err = GetCollectionAutoReleaseIdle([]*commonpb.KeyValuePair{{Key: CollectionAutoReleaseIdleHoursKey, Value: "abc"}})
+	assert.Error(t, err)
+
+	_, err = GetCollectionAutoReleaseIdle([]*commonpb.KeyValuePair{{Key: CollectionAutoReleaseIdleHoursKey, Value: "-1"}})
+	assert.Error(t, err)
+}
+
+func TestGetCollectionSegmentMaxSize(t *testing.T) {
+	size, err := GetCollectionSegmentMaxSize(nil)
+	assert.NoError(t, err)
+	assert.Zero(t, size)
+
+	size, err = GetCollectionSegmentMaxSize([]*commonpb.KeyValuePair{{Key: CollectionSegmentMaxSizeKey, Value: "512"}})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 512, size)
+
+	_, err = GetCollectionSegmentMaxSize([]*commonpb.KeyValuePair{{Key: CollectionSegmentMaxSizeKey, Value: "abc"}})
+	assert.Error(t, err)
+
+	_, err = GetCollectionSegmentMaxSize([]*commonpb.KeyValuePair{{Key: CollectionSegmentMaxSizeKey, Value: "0"}})
+	assert.Error(t, err)
+}
+
+func TestGetCollectionStrictPKMode(t *testing.T) {
+	mode, err := GetCollectionStrictPKMode(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, mode)
+
+	mode, err = GetCollectionStrictPKMode([]*commonpb.KeyValuePair{{Key: CollectionStrictPKModeKey, Value: "Dedup"}})
+	assert.NoError(t, err)
+	assert.Equal(t, StrictPKModeDedup, mode)
+
+	mode, err = GetCollectionStrictPKMode([]*commonpb.KeyValuePair{{Key: CollectionStrictPKModeKey, Value: "reject"}})
+	assert.NoError(t, err)
+	assert.Equal(t, StrictPKModeReject, mode)
+
+	_, err = GetCollectionStrictPKMode([]*commonpb.KeyValuePair{{Key: CollectionStrictPKModeKey, Value: "unknown"}})
+	assert.Error(t, err)
+}
+
 func TestAllocAutoID(t *testing.T) {
 	start, end, err := AllocAutoID(func(n uint32) (int64, int64, error) {
 		return 100, 110, nil
@@ -313,3 +379,25 @@ func TestFunctionProperty(t *testing.T) {
 		[]*commonpb.KeyValuePair{{Key: CollectionAllowInsertNonBM25FunctionOutputs, Value: "true"}}),
 	)
 }
+
+func TestParseBinlogCompression(t *testing.T) {
+	codec, level, err := ParseBinlogCompression("none")
+	assert.NoError(t, err)
+	assert.Equal(t, BinlogCompressionNone, codec)
+	assert.Equal(t, 0, level)
+
+	codec, level, err = ParseBinlogCompression("zstd")
+	assert.NoError(t, err)
+	assert.Equal(t, BinlogCompressionZstd, codec)
+	assert.Equal(t, DefaultBinlogCompressionLevel, level)
+
+	codec, level, err = ParseBinlogCompression(" zstd:9 ")
+	assert.NoError(t, err)
+	assert.Equal(t, BinlogCompressionZstd, codec)
+	assert.Equal(t, 9, level)
+
+	for _, value := range []string{"", "gzip", "none:1", "zstd:0", "zstd:23", "zstd:abc"} {
+		_, _, err = ParseBinlogCompression(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -30,8 +30,30 @@ import (
 const (
 	TombValue     = "TOMB_VAULE"
 	RuntimeSource = "RuntimeSource"
+	// DefaultSource is the source of the configs which are not set by any source but have a default value.
+	DefaultSource = "DefaultSource"
 )
 
+// ConfigValue is an effective config value along with the source which sets it.
+type ConfigValue struct {
+	Value  string
+	Source string
+}
+
+// String formats the config value as value[source].
+func (v ConfigValue) String() string {
+	return fmt.Sprintf("%s[%s]", v.Value, v.Source)
+}
+
+// ParseConfigValue parses the config value formatted by ConfigValue.String.
+func ParseConfigValue(s string) ConfigValue {
+	idx := strings.LastIndex(s, "[")
+	if idx < 0 || !strings.HasSuffix(s, "]") {
+		return ConfigValue{Value: s}
+	}
+	return ConfigValue{Value: s[:idx], Source: s[idx+1 : len(s)-1]}
+}
+
 type Filter func(key string) (string, bool)
 
 func WithSubstr(substring string) Filter {
@@ -85,6 +107,7 @@ type Manager struct {
 	sources       *typeutil.ConcurrentMap[string, Source]
 	keySourceMap  *typeutil.ConcurrentMap[string, string] // store the key to config source, example: key is A.B.C and source is file which means the A.B.C's value is from file
 	overlays      *typeutil.ConcurrentMap[string, string] // store the highest priority configs which modified at runtime
+	defaults      *typeutil.ConcurrentMap[string, string] // store the default values of the registered params, which are used if no source sets them
 	forbiddenKeys *typeutil.ConcurrentSet[string]
 
 	cacheMutex  sync.RWMutex
@@ -98,6 +121,7 @@ func NewManager() *Manager {
 		sources:       typeutil.NewConcurrentMap[string, Source](),
 		keySourceMap:  typeutil.NewConcurrentMap[string, string](),
 		overlays:      typeutil.NewConcurrentMap[string, string](),
+		defaults:      typeutil.NewConcurrentMap[string, string](),
 		forbiddenKeys: typeutil.NewConcurrentSet[string](),
 		configCache:   make(map[string]any),
 	}
@@ -191,7 +215,7 @@ func (m *Manager) GetConfigsView() map[string]string {
 	config := make(map[string]string)
 
 	valueFmt := func(source, value string) string {
-		return fmt.Sprintf("%s[%s]", value, source)
+		return ConfigValue{Value: value, Source: source}.String()
 	}
 
 	m.keySourceMap.Range(func(key, value string) bool {
@@ -241,6 +265,42 @@ func (m *Manager) GetBy(filters ...Filter) map[string]string {
 	return matchedConfig
 }
 
+// GetEffectiveBy returns the effective configs matching the filters, along with the source of each value.
+// Unlike GetBy, the default values of the registered params are included if no source sets them.
+func (m *Manager) GetEffectiveBy(filters ...Filter) map[string]ConfigValue {
+	// the same config may be stored in both the original and the formatted key, keep the original one
+	keys := make(map[string]string)
+	collect := func(key string, _ string) bool {
+		formattedKey := formatKey(key)
+		if current, ok := keys[formattedKey]; !ok || len(key) > len(current) {
+			keys[formattedKey] = key
+		}
+		return true
+	}
+	m.keySourceMap.Range(collect)
+	m.defaults.Range(collect)
+	m.overlays.Range(collect)
+
+	matchedConfig := make(map[string]ConfigValue)
+	for _, key := range keys {
+		newkey, ok := filterate(key, filters...)
+		if !ok {
+			continue
+		}
+		if source, value, err := m.GetConfig(key); err == nil {
+			matchedConfig[newkey] = ConfigValue{Value: value, Source: source}
+		} else if defaultValue, ok := m.defaults.Get(strings.ToLower(key)); ok {
+			matchedConfig[newkey] = ConfigValue{Value: defaultValue, Source: DefaultSource}
+		}
+	}
+	return matchedConfig
+}
+
+// SetDefault registers the default value of the param, which is reported by GetEffectiveBy if no source sets it.
+func (m *Manager) SetDefault(key, value string) {
+	m.defaults.Insert(strings.ToLower(key), value)
+}
+
 func (m *Manager) FileConfigs() map[string]string {
 	config := make(map[string]string)
 	m.sources.Range(func(key string, value Source) bool {
@@ -214,6 +214,41 @@ func TestGetConfigAndSource(t *testing.T) {
 	assert.Contains(t, v, RuntimeSource)
 }
 
+func TestGetEffectiveBy(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(path.Join(dir, "milvus.yaml"), []byte("a.b: 1\na.c: 2"), 0o600)
+	mgr, _ := Init()
+	err := mgr.AddSource(NewFileSource(&FileInfo{[]string{path.Join(dir, "milvus.yaml")}, -1}))
+	assert.NoError(t, err)
+
+	mgr.SetDefault("a.b", "10")
+	mgr.SetDefault("a.D", "20")
+	mgr.SetDefault("a.e", "30")
+	mgr.SetDefault("x.y", "40")
+	mgr.SetConfig("a.c", "3")
+	mgr.SetConfig("a.e", "5")
+
+	configs := mgr.GetEffectiveBy(WithPrefix("a."))
+	assert.Equal(t, map[string]ConfigValue{
+		"a.b": {Value: "1", Source: "FileSource"},
+		"a.c": {Value: "3", Source: RuntimeSource},
+		"a.d": {Value: "20", Source: DefaultSource},
+		"a.e": {Value: "5", Source: RuntimeSource},
+	}, configs)
+
+	// the default value takes effect once the config is deleted
+	mgr.DeleteConfig("a.b")
+	configs = mgr.GetEffectiveBy(WithPrefix("a."))
+	assert.Equal(t, ConfigValue{Value: "10", Source: DefaultSource}, configs["a.b"])
+}
+
+func TestParseConfigValue(t *testing.T) {
+	v := ConfigValue{Value: "[1, 2]", Source: "FileSource"}
+	assert.Equal(t, "[1, 2][FileSource]", v.String())
+	assert.Equal(t, v, ParseConfigValue(v.String()))
+	assert.Equal(t, ConfigValue{Value: "abc"}, ParseConfigValue("abc"))
+}
+
 func TestDeadlock(t *testing.T) {
 	mgr, _ := Init()
 
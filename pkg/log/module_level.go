@@ -0,0 +1,159 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// module name -> zap.AtomicLevel
+var _moduleLevels sync.Map
+
+// SetModuleLevel overrides the logging level of the module, e.g. `datacoord.meta`,
+// the loggers created by Module with the same name follow the module level instead of the global level.
+func SetModuleLevel(module string, level zapcore.Level) {
+	if v, ok := _moduleLevels.Load(module); ok {
+		v.(zap.AtomicLevel).SetLevel(level)
+		return
+	}
+	al := zap.NewAtomicLevelAt(level)
+	if v, loaded := _moduleLevels.LoadOrStore(module, al); loaded {
+		v.(zap.AtomicLevel).SetLevel(level)
+	}
+}
+
+// ResetModuleLevel removes the level override of the module, the module follows the global level then.
+func ResetModuleLevel(module string) {
+	_moduleLevels.Delete(module)
+}
+
+// GetModuleLevels returns all the module level overrides.
+func GetModuleLevels() map[string]zapcore.Level {
+	levels := make(map[string]zapcore.Level)
+	_moduleLevels.Range(func(key, value any) bool {
+		levels[key.(string)] = value.(zap.AtomicLevel).Level()
+		return true
+	})
+	return levels
+}
+
+func getModuleLevel(module string) (zapcore.Level, bool) {
+	v, ok := _moduleLevels.Load(module)
+	if !ok {
+		return zapcore.InvalidLevel, false
+	}
+	return v.(zap.AtomicLevel).Level(), true
+}
+
+// Module returns the contextual logger of the module, whose level could be changed at runtime by SetModuleLevel.
+func Module(ctx context.Context, module string) *MLogger {
+	logger := Ctx(ctx).Logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &moduleLevelCore{Core: core, module: module}
+	}))
+	return &MLogger{Logger: logger.With(FieldModule(module))}
+}
+
+// moduleLevelCore checks the entry level with the module level if it's overridden,
+// otherwise the check is delegated to the underlying core.
+type moduleLevelCore struct {
+	zapcore.Core
+	module string
+}
+
+func (c *moduleLevelCore) Enabled(level zapcore.Level) bool {
+	if moduleLevel, ok := getModuleLevel(c.module); ok {
+		return moduleLevel.Enabled(level)
+	}
+	return c.Core.Enabled(level)
+}
+
+func (c *moduleLevelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &moduleLevelCore{Core: c.Core.With(fields), module: c.module}
+}
+
+func (c *moduleLevelCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	moduleLevel, ok := getModuleLevel(c.module)
+	if !ok {
+		return c.Core.Check(ent, ce)
+	}
+	// the underlying core may filter the entry by global level, so bypass its check here.
+	if moduleLevel.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+type moduleLevelPayload struct {
+	Module string `json:"module"`
+	Level  string `json:"level"`
+}
+
+// ModuleLevelHandler serves the module level overrides,
+// GET returns all the overrides, PUT sets the level of a module and an empty level resets the module.
+func ModuleLevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		writeError := func(code int, err error) {
+			bs, _ := json.Marshal(map[string]string{"msg": err.Error()})
+			w.WriteHeader(code)
+			w.Write(bs)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			payload := &moduleLevelPayload{}
+			if err := json.NewDecoder(req.Body).Decode(payload); err != nil {
+				writeError(http.StatusBadRequest, err)
+				return
+			}
+			if payload.Module == "" {
+				writeError(http.StatusBadRequest, fmt.Errorf("module must be specified"))
+				return
+			}
+			if payload.Level == "" {
+				ResetModuleLevel(payload.Module)
+				Info("module log level reset", FieldModule(payload.Module))
+				break
+			}
+			var level zapcore.Level
+			if err := level.UnmarshalText([]byte(payload.Level)); err != nil {
+				writeError(http.StatusBadRequest, err)
+				return
+			}
+			SetModuleLevel(payload.Module, level)
+			Info("module log level changed", FieldModule(payload.Module), zap.Stringer("level", level))
+		default:
+			writeError(http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", req.Method))
+			return
+		}
+
+		levels := make(map[string]string)
+		for module, level := range GetModuleLevels() {
+			levels[module] = level.String()
+		}
+		bs, _ := json.Marshal(levels)
+		w.WriteHeader(http.StatusOK)
+		w.Write(bs)
+	})
+}
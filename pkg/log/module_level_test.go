@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestModuleLevel(t *testing.T) {
+	ts := newTestLogSpy(t)
+	conf := &Config{Level: "info", DisableTimestamp: true, DisableCaller: true}
+	logger, p, _ := InitTestLogger(ts, conf)
+	ReplaceGlobals(logger, p)
+	replaceLeveledLoggers(logger)
+	defer ResetModuleLevel("test.module")
+
+	ctx := context.Background()
+	Module(ctx, "test.module").Debug("DEBUG LOG")
+	Module(ctx, "test.module").Info("INFO LOG")
+	ts.assertMessagesNotContains(`[DEBUG] ["DEBUG LOG"]`)
+	ts.assertMessageContainAny(`[INFO] ["INFO LOG"] [module=test.module]`)
+	ts.CleanBuffer()
+
+	SetModuleLevel("test.module", zapcore.DebugLevel)
+	Module(ctx, "test.module").With(FieldComponent("c")).Debug("DEBUG LOG")
+	Module(ctx, "other.module").Debug("OTHER DEBUG LOG")
+	ts.assertMessageContainAny(`[DEBUG] ["DEBUG LOG"] [module=test.module] [component=c]`)
+	ts.assertMessagesNotContains(`OTHER DEBUG LOG`)
+	assert.Equal(t, map[string]zapcore.Level{"test.module": zapcore.DebugLevel}, GetModuleLevels())
+	ts.CleanBuffer()
+
+	SetModuleLevel("test.module", zapcore.WarnLevel)
+	Module(ctx, "test.module").Info("INFO LOG")
+	ts.assertMessagesNotContains(`[INFO] ["INFO LOG"]`)
+	ts.CleanBuffer()
+
+	ResetModuleLevel("test.module")
+	Module(ctx, "test.module").Info("INFO LOG")
+	ts.assertMessageContainAny(`[INFO] ["INFO LOG"]`)
+	assert.Empty(t, GetModuleLevels())
+}
+
+func TestModuleLevelHandler(t *testing.T) {
+	defer ResetModuleLevel("test.module")
+	handler := ModuleLevelHandler()
+
+	serve := func(method string, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, "/log/level/module", strings.NewReader(body)))
+		return w
+	}
+
+	w := serve(http.MethodPut, `{"module": "test.module", "level": "debug"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"test.module": "debug"}`, w.Body.String())
+
+	w = serve(http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"test.module": "debug"}`, w.Body.String())
+
+	w = serve(http.MethodPut, `{"module": "test.module", "level": ""}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{}`, w.Body.String())
+
+	assert.Equal(t, http.StatusBadRequest, serve(http.MethodPut, `{"level": "debug"}`).Code)
+	w = serve(http.MethodPut, `{"module": "test.module", "level": "invalid"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"msg": "unrecognized level: \"invalid\""}`, w.Body.String())
+	assert.Equal(t, http.StatusBadRequest, serve(http.MethodPut, `invalid`).Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(http.MethodDelete, "").Code)
+}
@@ -383,6 +383,15 @@ var (
 			Name:      "task_num_in_scheduler",
 			Help:      "number of tasks in global scheduler",
 		}, []string{TaskTypeLabel, TaskStateLabel})
+
+	// TaskParallelismLimit records the auto tuned limit of the concurrent tasks on each worker.
+	TaskParallelismLimit = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "task_parallelism_limit",
+			Help:      "limit of the concurrent tasks on each worker",
+		}, []string{nodeIDLabelName, TaskTypeLabel})
 )
 
 // RegisterDataCoord registers DataCoord metrics
@@ -417,6 +426,7 @@ func RegisterDataCoord(registry *prometheus.Registry) {
 	registry.MustRegister(IndexStatsTaskNum)
 	registry.MustRegister(TaskVersion)
 	registry.MustRegister(TaskNumInGlobalScheduler)
+	registry.MustRegister(TaskParallelismLimit)
 	registerStreamingCoord(registry)
 }
 
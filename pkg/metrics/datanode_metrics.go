@@ -83,6 +83,18 @@ var (
 			dataSourceLabelName,
 		})
 
+	DataNodeDuplicatePKRows = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "duplicate_pk_rows",
+			Help:      "num of ingested rows whose primary key may already exist, detected by the strict pk mode",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+			duplicateTypeLabelName,
+		})
+
 	DataNodeNumProducers = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -117,6 +129,17 @@ var (
 			collectionIDLabelName,
 		})
 
+	DataNodeInsertChecksumMismatchCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "insert_checksum_mismatch_count",
+			Help:      "count of consumed insert msg whose rows mismatch the checksum computed by the proxy",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+		})
+
 	DataNodeSave2StorageLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -228,6 +251,43 @@ var (
 			channelNameLabelName,
 		})
 
+	DataNodeChannelFlushLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "channel_flush_latency",
+			Help:      "latency of sync task including uploading binlogs and saving meta, in milliseconds",
+			Buckets:   longTaskBuckets,
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+			segmentLevelLabelName,
+		})
+
+	DataNodeChannelBinlogUploadSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "channel_binlog_upload_size",
+			Help:      "byte size of binlogs uploaded to object storage by a sync task",
+			Buckets:   sizeBuckets,
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+		})
+
+	DataNodeChannelStatsLogLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "channel_statslog_latency",
+			Help:      "latency of generating and uploading statslogs in sync task, in milliseconds",
+			Buckets:   buckets,
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+		})
+
 	DataNodeCompactionDeleteCount = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: milvusNamespace,
@@ -244,6 +304,17 @@ var (
 			Help:      "Number of missing deletes in compaction",
 		}, []string{collectionIDLabelName})
 
+	DataNodeStatsLogRebuildCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "statslog_rebuild_count",
+			Help:      "count of segment statslogs rebuilt from pk binlogs during recovery",
+		}, []string{
+			nodeIDLabelName,
+			statusLabelName,
+		})
+
 	// index service metrics
 	// unit second, from 1ms to 2hrs
 	indexBucket = []float64{0.001, 0.1, 0.5, 1, 5, 10, 20, 50, 100, 250, 500, 1000, 3600, 5000, 10000}
@@ -355,8 +426,10 @@ func registerDataNodeOnce(registry *prometheus.Registry) {
 	registry.MustRegister(DataNodeMsgDispatcherTtLag)
 	registry.MustRegister(DataNodeConsumeMsgCount)
 	registry.MustRegister(DataNodeConsumeBytesCount)
+	registry.MustRegister(DataNodeInsertChecksumMismatchCount)
 	// in memory
 	registry.MustRegister(DataNodeFlowGraphBufferDataSize)
+	registry.MustRegister(DataNodeDuplicatePKRows)
 	// output related
 	registry.MustRegister(DataNodeAutoFlushBufferCount)
 	registry.MustRegister(DataNodeSave2StorageLatency)
@@ -365,6 +438,10 @@ func registerDataNodeOnce(registry *prometheus.Registry) {
 	registry.MustRegister(DataNodeFlushedSize)
 	registry.MustRegister(DataNodeFlushedRows)
 	registry.MustRegister(DataNodeWriteDataCount)
+	registry.MustRegister(DataNodeStatsLogRebuildCount)
+	registry.MustRegister(DataNodeChannelFlushLatency)
+	registry.MustRegister(DataNodeChannelBinlogUploadSize)
+	registry.MustRegister(DataNodeChannelStatsLogLatency)
 	// compaction related
 	registry.MustRegister(DataNodeCompactionLatency)
 	registry.MustRegister(DataNodeCompactionLatencyInQueue)
@@ -406,11 +483,21 @@ func CleanupDataNodeCollectionMetrics(nodeID int64, collectionID int64, channel
 				})
 	}
 
+	DataNodeInsertChecksumMismatchCount.Delete(prometheus.Labels{
+		nodeIDLabelName:       fmt.Sprint(nodeID),
+		collectionIDLabelName: fmt.Sprint(collectionID),
+	})
+
 	DataNodeFlowGraphBufferDataSize.Delete(prometheus.Labels{
 		nodeIDLabelName:       fmt.Sprint(nodeID),
 		collectionIDLabelName: fmt.Sprint(collectionID),
 	})
 
+	DataNodeDuplicatePKRows.DeletePartialMatch(prometheus.Labels{
+		nodeIDLabelName:       fmt.Sprint(nodeID),
+		collectionIDLabelName: fmt.Sprint(collectionID),
+	})
+
 	DataNodeCompactionDeleteCount.Delete(prometheus.Labels{
 		collectionIDLabelName: fmt.Sprint(collectionID),
 	})
@@ -423,3 +510,14 @@ func CleanupDataNodeCollectionMetrics(nodeID int64, collectionID int64, channel
 		collectionIDLabelName: fmt.Sprint(collectionID),
 	})
 }
+
+// CleanupDataNodeChannelMetrics removes the metrics labeled by the virtual channel.
+func CleanupDataNodeChannelMetrics(nodeID int64, channel string) {
+	labels := prometheus.Labels{
+		nodeIDLabelName:      fmt.Sprint(nodeID),
+		channelNameLabelName: channel,
+	}
+	DataNodeChannelFlushLatency.DeletePartialMatch(labels)
+	DataNodeChannelBinlogUploadSize.Delete(labels)
+	DataNodeChannelStatsLogLatency.Delete(labels)
+}
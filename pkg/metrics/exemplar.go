@@ -0,0 +1,39 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ExemplarTraceIDLabel is the exemplar label name of trace id, which is recognized by grafana.
+const ExemplarTraceIDLabel = "trace_id"
+
+// ObserveWithTrace observes the value with the trace id of ctx as exemplar if the trace is sampled,
+// so that a latency spike could be linked to a representative trace.
+// Exemplars are only exposed when the metrics are scraped in OpenMetrics format.
+func ObserveWithTrace(ctx context.Context, observer prometheus.Observer, value float64) {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if exemplarObserver, ok := observer.(prometheus.ExemplarObserver); ok && spanCtx.IsSampled() {
+		exemplarObserver.ObserveWithExemplar(value, prometheus.Labels{ExemplarTraceIDLabel: spanCtx.TraceID().String()})
+		return
+	}
+	observer.Observe(value)
+}
@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestObserveWithTrace(t *testing.T) {
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "test_exemplar",
+		Buckets: []float64{1, 10},
+	})
+	getExemplars := func() []*dto.Exemplar {
+		m := &dto.Metric{}
+		require.NoError(t, histogram.Write(m))
+		exemplars := make([]*dto.Exemplar, 0)
+		for _, bucket := range m.GetHistogram().GetBucket() {
+			if bucket.GetExemplar() != nil {
+				exemplars = append(exemplars, bucket.GetExemplar())
+			}
+		}
+		return exemplars
+	}
+
+	// no trace
+	ObserveWithTrace(context.Background(), histogram, 5)
+	assert.Empty(t, getExemplars())
+
+	traceID := trace.TraceID{1, 2, 3}
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanCtx)
+	ObserveWithTrace(ctx, histogram, 5)
+	exemplars := getExemplars()
+	require.Len(t, exemplars, 1)
+	assert.Equal(t, ExemplarTraceIDLabel, exemplars[0].GetLabel()[0].GetName())
+	assert.Equal(t, traceID.String(), exemplars[0].GetLabel()[0].GetValue())
+}
@@ -160,3 +160,58 @@ func (h *grpcSizeStatsHandler) HandleRPC(ctx context.Context, rs stats.RPCStats)
 	default:
 	}
 }
+
+const (
+	inboundLabel           = "inbound"
+	outboundLabel          = "outbound"
+	rawPayloadLabel        = "raw"
+	compressedPayloadLabel = "compressed"
+)
+
+// rpcMethodKey is the context key of the full method name of the rpc.
+type rpcMethodKey struct{}
+
+// grpcPayloadStatsHandler implementing stats.Handler
+// this handler records the raw and compressed size of the payloads of the internal rpcs,
+// so the effect of the compression on the bandwidth could be observed.
+type grpcPayloadStatsHandler struct {
+	role string
+}
+
+func NewGRPCPayloadStatsHandler(role string) *grpcPayloadStatsHandler {
+	return &grpcPayloadStatsHandler{role: role}
+}
+
+// TagConn exists to satisfy gRPC stats.Handler interface.
+func (h *grpcPayloadStatsHandler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn exists to satisfy gRPC stats.Handler interface.
+func (h *grpcPayloadStatsHandler) HandleConn(_ context.Context, _ stats.ConnStats) {}
+
+func (h *grpcPayloadStatsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, rpcMethodKey{}, info.FullMethodName)
+}
+
+// HandleRPC implements per-RPC stats instrumentation.
+func (h *grpcPayloadStatsHandler) HandleRPC(ctx context.Context, rs stats.RPCStats) {
+	method, ok := ctx.Value(rpcMethodKey{}).(string)
+	if !ok {
+		return
+	}
+
+	switch rs := rs.(type) {
+	case *stats.InPayload:
+		h.observe(method, inboundLabel, rs.Length, rs.CompressedLength)
+		InternalRPCRequestSize.WithLabelValues(h.role, method).Observe(float64(rs.Length))
+	case *stats.OutPayload:
+		h.observe(method, outboundLabel, rs.Length, rs.CompressedLength)
+	default:
+	}
+}
+
+func (h *grpcPayloadStatsHandler) observe(method string, direction string, raw int, compressed int) {
+	InternalRPCPayloadBytes.WithLabelValues(h.role, method, direction, rawPayloadLabel).Add(float64(raw))
+	InternalRPCPayloadBytes.WithLabelValues(h.role, method, direction, compressedPayloadLabel).Add(float64(compressed))
+}
@@ -0,0 +1,45 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/stats"
+)
+
+func TestGRPCPayloadStatsHandler(t *testing.T) {
+	const method = "/milvus.proto.data.DataNode/SyncSegments"
+	h := NewGRPCPayloadStatsHandler("datanode")
+
+	// the rpcs not tagged are ignored
+	h.HandleRPC(context.Background(), &stats.InPayload{Length: 1})
+
+	ctx := h.TagRPC(context.Background(), &stats.RPCTagInfo{FullMethodName: method})
+	h.HandleRPC(ctx, &stats.InPayload{Length: 1000, CompressedLength: 100})
+	h.HandleRPC(ctx, &stats.OutPayload{Length: 10, CompressedLength: 10})
+	h.HandleRPC(ctx, &stats.End{})
+
+	assert.Equal(t, 1000.0, testutil.ToFloat64(InternalRPCPayloadBytes.WithLabelValues("datanode", method, inboundLabel, rawPayloadLabel)))
+	assert.Equal(t, 100.0, testutil.ToFloat64(InternalRPCPayloadBytes.WithLabelValues("datanode", method, inboundLabel, compressedPayloadLabel)))
+	assert.Equal(t, 10.0, testutil.ToFloat64(InternalRPCPayloadBytes.WithLabelValues("datanode", method, outboundLabel, rawPayloadLabel)))
+	assert.Equal(t, 10.0, testutil.ToFloat64(InternalRPCPayloadBytes.WithLabelValues("datanode", method, outboundLabel, compressedPayloadLabel)))
+	assert.Equal(t, 1, testutil.CollectAndCount(InternalRPCRequestSize))
+}
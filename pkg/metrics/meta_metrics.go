@@ -17,6 +17,10 @@
 package metrics
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -27,6 +31,16 @@ const (
 	MetaTxnLabel    = "txn"
 
 	metaOpType = "meta_op_type"
+
+	MetaBulkRequestLabel             = "bulk"
+	MetaLatencySensitiveRequestLabel = "latency_sensitive"
+
+	metaRequestClass = "meta_request_class"
+
+	metaKeyPrefix = "meta_key_prefix"
+
+	// MetaUnknownKeyPrefix is the key prefix label of the operations without a known key.
+	MetaUnknownKeyPrefix = "unknown"
 )
 
 var (
@@ -48,6 +62,42 @@ var (
 			Buckets:   buckets,
 		}, []string{metaOpType})
 
+	MetaClassRequestLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "meta",
+			Name:      "class_request_latency",
+			Help:      "request latency on the client side grouped by request class",
+			Buckets:   buckets,
+		}, []string{metaRequestClass, metaOpType})
+
+	MetaPrefixRequestLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "meta",
+			Name:      "prefix_request_latency",
+			Help:      "request latency on the client side grouped by key prefix",
+			Buckets:   buckets,
+		}, []string{metaKeyPrefix, metaOpType})
+
+	MetaPrefixKvSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "meta",
+			Name:      "prefix_kv_size",
+			Help:      "payload bytes of the meta operation grouped by key prefix",
+			Buckets:   prometheus.ExponentialBuckets(64, 4, 10), // 64B ~ 16MB
+		}, []string{metaKeyPrefix, metaOpType})
+
+	MetaTxnOpCount = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "meta",
+			Name:      "txn_op_count",
+			Help:      "count of the operations in one meta txn",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 12), // 1 ~ 2048
+		}, []string{metaKeyPrefix})
+
 	MetaOpCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: milvusNamespace,
@@ -61,5 +111,37 @@ var (
 func RegisterMetaMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(MetaKvSize)
 	registry.MustRegister(MetaRequestLatency)
+	registry.MustRegister(MetaClassRequestLatency)
 	registry.MustRegister(MetaOpCounter)
+	registry.MustRegister(MetaPrefixRequestLatency)
+	registry.MustRegister(MetaPrefixKvSize)
+	registry.MustRegister(MetaTxnOpCount)
+}
+
+// MetaKeyPrefix returns the prefix class of the meta key relative to the root path,
+// which is at most the first two segments of the key, the numeric segments are replaced by "*"
+// to keep the cardinality of the label bounded.
+func MetaKeyPrefix(rootPath, key string) string {
+	key = strings.Trim(strings.TrimPrefix(strings.Trim(key, "/"), strings.Trim(rootPath, "/")), "/")
+	if key == "" {
+		return MetaUnknownKeyPrefix
+	}
+	segments := strings.SplitN(key, "/", 3)
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+	for i, segment := range segments {
+		if _, err := strconv.ParseInt(segment, 10, 64); err == nil {
+			segments[i] = "*"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// ObserveMetaOpByPrefix observes the latency and the payload bytes of a succeeded meta operation.
+func ObserveMetaOpByPrefix(prefix string, opType string, elapsed time.Duration, size int) {
+	MetaPrefixRequestLatency.WithLabelValues(prefix, opType).Observe(float64(elapsed.Milliseconds()))
+	if size > 0 {
+		MetaPrefixKvSize.WithLabelValues(prefix, opType).Observe(float64(size))
+	}
 }
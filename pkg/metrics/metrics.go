@@ -87,6 +87,17 @@ const (
 	ImportStageBuildIndex   = "build_index"
 	ImportStageWaitL0Import = "wait_l0_import"
 
+	VerifiedDuplicateLabel = "verified"
+	ProbableDuplicateLabel = "probable"
+
+	// the divergence types of the shard leader view
+	MissingRouteLabel  = "missing_route"
+	StaleRouteLabel    = "stale_route"
+	ReleasedRouteLabel = "released_route"
+
+	ShadowCollectionMirrorLabel = "shadow_collection"
+	EndpointMirrorLabel         = "endpoint"
+
 	compactionTypeLabelName  = "compaction_type"
 	isVectorFieldLabelName   = "is_vector_field"
 	segmentPruneLabelName    = "segment_prune_label"
@@ -115,6 +126,7 @@ const (
 	cacheStateLabelName      = "cache_state"
 	dataSourceLabelName      = "data_source"
 	dataTypeLabelName        = "data_type"
+	mirrorTargetLabelName    = "mirror_target"
 	importStageLabelName     = "import_stage"
 	requestScope             = "scope"
 	fullMethodLabelName      = "full_method"
@@ -129,6 +141,10 @@ const (
 	cgoNameLabelName         = `cgo_name`
 	cgoTypeLabelName         = `cgo_type`
 	queueTypeLabelName       = `queue_type`
+	duplicateTypeLabelName   = "duplicate_type"
+	divergenceTypeLabelName  = "divergence_type"
+	rpcDirectionLabelName    = "direction"
+	payloadTypeLabelName     = "payload_type"
 
 	// model function/UDF labels
 	functionTypeName = "function_type_name"
@@ -173,6 +189,23 @@ var (
 			lockOp,
 		})
 
+	// InternalRPCPayloadBytes records the raw and compressed bytes of the payloads of the internal rpcs.
+	InternalRPCPayloadBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Name:      "internal_rpc_payload_bytes",
+			Help:      "raw and compressed bytes of the payloads of the internal rpcs",
+		}, []string{roleNameLabelName, fullMethodLabelName, rpcDirectionLabelName, payloadTypeLabelName})
+
+	// InternalRPCRequestSize records the raw size of the requests of the internal rpcs.
+	InternalRPCRequestSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Name:      "internal_rpc_request_size",
+			Help:      "raw size of the requests of the internal rpcs in bytes",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 12), // 1KB ~ 4GB
+		}, []string{roleNameLabelName, fullMethodLabelName})
+
 	metricRegisterer prometheus.Registerer
 )
 
@@ -193,5 +226,7 @@ func Register(r prometheus.Registerer) {
 	r.MustRegister(BuildInfo)
 	r.MustRegister(RuntimeInfo)
 	r.MustRegister(ThreadNum)
+	r.MustRegister(InternalRPCPayloadBytes)
+	r.MustRegister(InternalRPCRequestSize)
 	metricRegisterer = r
 }
@@ -18,6 +18,7 @@ package metrics
 
 import (
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
@@ -135,3 +136,16 @@ func TestDeletePartialMatch(t *testing.T) {
 	}
 	assert.Equal(t, 0, getMetricsCount())
 }
+
+func TestMetaKeyPrefix(t *testing.T) {
+	assert.Equal(t, "datacoord-meta/s", MetaKeyPrefix("by-dev/meta", "by-dev/meta/datacoord-meta/s/1/2/3"))
+	assert.Equal(t, "root-coord/database", MetaKeyPrefix("by-dev/meta", "by-dev/meta/root-coord/database/collection-info/1/2"))
+	assert.Equal(t, "session/*", MetaKeyPrefix("by-dev/meta", "/by-dev/meta/session/10"))
+	assert.Equal(t, "queryCoord-ReplicaMeta", MetaKeyPrefix("", "queryCoord-ReplicaMeta"))
+	assert.Equal(t, MetaUnknownKeyPrefix, MetaKeyPrefix("by-dev/meta", "by-dev/meta"))
+
+	assert.NotPanics(t, func() {
+		ObserveMetaOpByPrefix("session/*", MetaPutLabel, time.Millisecond, 100)
+		ObserveMetaOpByPrefix("session/*", MetaRemoveLabel, time.Millisecond, 0)
+	})
+}
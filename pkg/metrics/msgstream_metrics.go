@@ -26,6 +26,8 @@ const (
 	CreateConsumerLabel = "create_consumer"
 
 	msgStreamOpType = "message_op_type"
+
+	subscriptionLabelName = "subscription"
 )
 
 var (
@@ -56,6 +58,22 @@ var (
 			Name:      "op_count",
 			Help:      "count of stream message operation",
 		}, []string{msgStreamOpType, statusLabelName})
+
+	MsgStreamConsumerTimeLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "msgstream",
+			Name:      "consumer_time_lag_seconds",
+			Help:      "time since the last consumed message of the subscription was produced",
+		}, []string{channelNameLabelName, subscriptionLabelName})
+
+	MsgStreamConsumerMsgLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "msgstream",
+			Name:      "consumer_msg_lag",
+			Help:      "number of messages not consumed by the subscription yet, only for the mq with offsets",
+		}, []string{channelNameLabelName, subscriptionLabelName})
 )
 
 // RegisterMsgStreamMetrics registers msg stream metrics
@@ -63,4 +81,6 @@ func RegisterMsgStreamMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(NumConsumers)
 	registry.MustRegister(MsgStreamRequestLatency)
 	registry.MustRegister(MsgStreamOpCounter)
+	registry.MustRegister(MsgStreamConsumerTimeLag)
+	registry.MustRegister(MsgStreamConsumerMsgLag)
 }
@@ -480,6 +480,15 @@ var (
 			Name:      "scanned_total_mb",
 			Help:      "the scanned total megabytes",
 		}, []string{nodeIDLabelName, msgTypeLabelName, databaseLabelName, collectionName})
+
+	// ProxyMirroredRequestCount records the search and query requests mirrored to the shadow collection or the mirror endpoint
+	ProxyMirroredRequestCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "mirrored_request_cnt",
+			Help:      "counter of the requests mirrored to the shadow target, the results are discarded",
+		}, []string{nodeIDLabelName, queryTypeLabelName, mirrorTargetLabelName, statusLabelName})
 )
 
 // RegisterProxy registers Proxy metrics
@@ -552,6 +561,7 @@ func RegisterProxy(registry *prometheus.Registry) {
 
 	registry.MustRegister(ProxyScannedRemoteMB)
 	registry.MustRegister(ProxyScannedTotalMB)
+	registry.MustRegister(ProxyMirroredRequestCount)
 	RegisterStreamingServiceClient(registry)
 }
 
@@ -165,6 +165,46 @@ var (
 			Name:      "last_heartbeat_timestamp",
 			Help:      "heartbeat timestamp of query node",
 		}, []string{nodeIDLabelName})
+
+	QueryCoordNodeLoadScore = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "node_load_score",
+			Help:      "average load score of query node computed from the recent heartbeats, in [0, 1]",
+		}, []string{nodeIDLabelName})
+
+	QueryCoordTargetSegmentsRemaining = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "target_segments_remaining",
+			Help:      "number of segments in next target which are not loaded by all replicas yet",
+		}, []string{collectionIDLabelName})
+
+	QueryCoordTargetConvergenceETASeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "target_convergence_eta_seconds",
+			Help:      "estimated seconds for the distribution to converge to next target, -1 means unknown",
+		}, []string{collectionIDLabelName})
+
+	QueryCoordLeaderViewDivergence = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "leader_view_divergence",
+			Help:      "number of segments whose routes in the shard leader views diverge from the distribution and target",
+		}, []string{collectionIDLabelName, divergenceTypeLabelName})
+
+	QueryCoordLeaderViewRepairCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "leader_view_repair_count",
+			Help:      "number of repair tasks generated for the diverged shard leader views",
+		}, []string{collectionIDLabelName, divergenceTypeLabelName})
 )
 
 // RegisterQueryCoord registers QueryCoord metrics
@@ -183,10 +223,23 @@ func RegisterQueryCoord(registry *prometheus.Registry) {
 	registry.MustRegister(QueryCoordResourceGroupReplicaTotal)
 	registry.MustRegister(QueryCoordReplicaRONodeTotal)
 	registry.MustRegister(QueryCoordLastHeartbeatTimeStamp)
+	registry.MustRegister(QueryCoordNodeLoadScore)
+	registry.MustRegister(QueryCoordTargetSegmentsRemaining)
+	registry.MustRegister(QueryCoordTargetConvergenceETASeconds)
+	registry.MustRegister(QueryCoordLeaderViewDivergence)
+	registry.MustRegister(QueryCoordLeaderViewRepairCount)
 }
 
 func CleanQueryCoordMetricsWithCollectionID(collectionID int64) {
 	QueryCoordTaskLatency.DeletePartialMatch(prometheus.Labels{
 		collectionIDLabelName: fmt.Sprint(collectionID),
 	})
+	QueryCoordTargetSegmentsRemaining.DeleteLabelValues(fmt.Sprint(collectionID))
+	QueryCoordTargetConvergenceETASeconds.DeleteLabelValues(fmt.Sprint(collectionID))
+	QueryCoordLeaderViewDivergence.DeletePartialMatch(prometheus.Labels{
+		collectionIDLabelName: fmt.Sprint(collectionID),
+	})
+	QueryCoordLeaderViewRepairCount.DeletePartialMatch(prometheus.Labels{
+		collectionIDLabelName: fmt.Sprint(collectionID),
+	})
 }
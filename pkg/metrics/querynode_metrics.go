@@ -826,6 +826,18 @@ var (
 			queryTypeLabelName,
 			collectionIDLabelName,
 		})
+
+	QueryNodeCancelledSegmentSearchCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "cancelled_segment_search_count",
+			Help:      "count of segment search which is completed after the request is cancelled, the result is discarded",
+		}, []string{
+			nodeIDLabelName,
+			queryTypeLabelName,
+			segmentStateLabelName,
+		})
 )
 
 // RegisterQueryNode registers QueryNode metrics
@@ -898,6 +910,7 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeDeleteBufferRowNum)
 	registry.MustRegister(QueryNodeCGOCallLatency)
 	registry.MustRegister(QueryNodePartialResultCount)
+	registry.MustRegister(QueryNodeCancelledSegmentSearchCount)
 	// Add cgo metrics
 	RegisterCGOMetrics(registry)
 
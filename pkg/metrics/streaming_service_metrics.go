@@ -437,6 +437,16 @@ var (
 		Help: "the final timetick tick of flusher seen",
 	}, WALChannelLabelName, WALChannelTermLabelName)
 
+	WALFlusherChannelFailureTotal = newWALCounterVec(prometheus.CounterOpts{
+		Name: "flusher_channel_failure_total",
+		Help: "Total of the failures of the vchannel flowgraphs on current wal",
+	}, WALChannelLabelName, WALChannelTermLabelName)
+
+	WALFlusherQuarantinedChannelNum = newWALGaugeVec(prometheus.GaugeOpts{
+		Name: "flusher_quarantined_channel_num",
+		Help: "the number of the vchannels quarantined by flusher for failing too many times",
+	}, WALChannelLabelName, WALChannelTermLabelName)
+
 	WALRecoveryInfo = newWALGaugeVec(prometheus.GaugeOpts{
 		Name: "recovery_info",
 		Help: "Current info of recovery storage on current wal",
@@ -561,6 +571,8 @@ func registerWAL(registry *prometheus.Registry) {
 	registry.MustRegister(WALScannerTxnBufBytes)
 	registry.MustRegister(WALFlusherInfo)
 	registry.MustRegister(WALFlusherTimeTick)
+	registry.MustRegister(WALFlusherChannelFailureTotal)
+	registry.MustRegister(WALFlusherQuarantinedChannelNum)
 	registry.MustRegister(WALRecoveryInfo)
 	registry.MustRegister(WALRecoveryInMemTimeTick)
 	registry.MustRegister(WALRecoveryPersistedTimeTick)
@@ -27,3 +27,11 @@ type MessageID interface {
 
 	Equal(msgID []byte) (bool, error)
 }
+
+// OffsetMessageID is the message id which is the offset of the message in the topic,
+// the lag of the consumer in messages is computed by the offsets.
+type OffsetMessageID interface {
+	MessageID
+
+	Offset() int64
+}
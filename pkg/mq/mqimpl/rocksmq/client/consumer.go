@@ -64,6 +64,9 @@ type Consumer interface {
 	// GetLatestMsgID get the latest msgID
 	GetLatestMsgID() (int64, error)
 
+	// GetEarliestMsgID get the earliest msgID retained in the topic
+	GetEarliestMsgID() (int64, error)
+
 	// check created topic whether vaild or not
 	CheckTopicValid(topic string) error
 }
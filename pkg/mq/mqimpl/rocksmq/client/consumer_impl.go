@@ -165,6 +165,10 @@ func (c *consumer) GetLatestMsgID() (int64, error) {
 	return msgID, nil
 }
 
+func (c *consumer) GetEarliestMsgID() (int64, error) {
+	return c.client.server.GetEarliestMsg(c.topic)
+}
+
 func (c *consumer) CheckTopicValid(topic string) error {
 	err := c.client.server.CheckTopicValid(topic)
 	return err
@@ -333,6 +333,50 @@ func (_c *MockRocksMQ_ExistConsumerGroup_Call) Return(_a0 bool, _a1 *Consumer, _
 	return _c
 }
 
+// GetEarliestMsg provides a mock function with given fields: topicName
+func (_m *MockRocksMQ) GetEarliestMsg(topicName string) (int64, error) {
+	ret := _m.Called(topicName)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(topicName)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(topicName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRocksMQ_GetEarliestMsg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEarliestMsg'
+type MockRocksMQ_GetEarliestMsg_Call struct {
+	*mock.Call
+}
+
+// GetEarliestMsg is a helper method to define mock.On call
+//   - topicName string
+func (_e *MockRocksMQ_Expecter) GetEarliestMsg(topicName interface{}) *MockRocksMQ_GetEarliestMsg_Call {
+	return &MockRocksMQ_GetEarliestMsg_Call{Call: _e.mock.On("GetEarliestMsg", topicName)}
+}
+
+func (_c *MockRocksMQ_GetEarliestMsg_Call) Run(run func(topicName string)) *MockRocksMQ_GetEarliestMsg_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRocksMQ_GetEarliestMsg_Call) Return(_a0 int64, _a1 error) *MockRocksMQ_GetEarliestMsg_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetLatestMsg provides a mock function with given fields: topicName
 func (_m *MockRocksMQ) GetLatestMsg(topicName string) (int64, error) {
 	ret := _m.Called(topicName)
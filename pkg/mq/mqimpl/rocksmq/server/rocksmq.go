@@ -40,6 +40,7 @@ type RocksMQ interface {
 
 	RegisterConsumer(consumer *Consumer) error
 	GetLatestMsg(topicName string) (int64, error)
+	GetEarliestMsg(topicName string) (int64, error)
 	CheckTopicValid(topicName string) error
 
 	Produce(topicName string, messages []ProducerMessage) ([]UniqueID, error)
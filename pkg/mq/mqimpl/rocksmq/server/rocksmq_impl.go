@@ -668,6 +668,34 @@ func (rmq *rocksmq) GetLatestMsg(topicName string) (int64, error) {
 	return msgID, nil
 }
 
+// GetEarliestMsg returns the earliest message id retained in the topic, DefaultMessageID if the topic is empty
+func (rmq *rocksmq) GetEarliestMsg(topicName string) (int64, error) {
+	if rmq.isClosed() {
+		return DefaultMessageID, errors.New(RmqNotServingErrMsg)
+	}
+	readOpts := gorocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	iter := rocksdb.NewRocksIteratorCF(rmq.store, rmq.cfh[0], readOpts)
+	defer iter.Close()
+
+	prefix := topicName + "/"
+	iter.Seek([]byte(prefix))
+	if err := iter.Err(); err != nil {
+		return DefaultMessageID, err
+	}
+	if !iter.Valid() {
+		return DefaultMessageID, nil
+	}
+
+	iKey := iter.Key()
+	key := string(iKey.Data())
+	iKey.Free()
+	if !strings.HasPrefix(key, prefix) {
+		return DefaultMessageID, nil
+	}
+	return strconv.ParseInt(key[len(prefix):], 10, 64)
+}
+
 // DestroyConsumerGroup removes a consumer group from rocksdb_kv
 func (rmq *rocksmq) DestroyConsumerGroup(topicName, groupName string) error {
 	if rmq.isClosed() {
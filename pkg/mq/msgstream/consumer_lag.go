@@ -0,0 +1,207 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/mq/common"
+	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// msgLagCheckInterval is the interval to fetch the latest message id of the topic to compute the lag in messages.
+const msgLagCheckInterval = 10 * time.Second
+
+// consumerLags are the lag trackers of all the consumers of the msgstreams in this process.
+var consumerLags = typeutil.NewConcurrentMap[*consumerLag, struct{}]()
+
+// consumerLag tracks how far the consumer falls behind the producers of the channel.
+type consumerLag struct {
+	channel      string
+	subscription string
+	consumer     mqwrapper.Consumer
+
+	mu              sync.Mutex
+	lastTs          uint64
+	timeLag         time.Duration
+	msgLag          int64
+	lastMsgLagCheck time.Time
+	closed          bool
+	// the latest msg id is fetched from the broker in background, the consume loop never waits for it
+	checking sync.WaitGroup
+}
+
+func newConsumerLag(channel string, consumer mqwrapper.Consumer) *consumerLag {
+	lag := &consumerLag{
+		channel:      channel,
+		subscription: consumer.Subscription(),
+		consumer:     consumer,
+		msgLag:       -1,
+	}
+	consumerLags.Insert(lag, struct{}{})
+	return lag
+}
+
+// observe updates the lag by the consumed message.
+func (l *consumerLag) observe(msgID common.MessageID, ts uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.lastTs = ts
+	l.timeLag = time.Since(tsoutil.PhysicalTime(ts))
+	metrics.MsgStreamConsumerTimeLag.WithLabelValues(l.channel, l.subscription).Set(l.timeLag.Seconds())
+
+	if time.Since(l.lastMsgLagCheck) < msgLagCheckInterval {
+		return
+	}
+	consumed, ok := msgID.(common.OffsetMessageID)
+	if !ok || l.closed {
+		return
+	}
+	l.lastMsgLagCheck = time.Now()
+	l.checking.Add(1)
+	go l.updateMsgLag(consumed)
+}
+
+// updateMsgLag fetches the latest msg id from the broker and computes the lag in messages.
+func (l *consumerLag) updateMsgLag(consumed common.OffsetMessageID) {
+	defer l.checking.Done()
+	latestID, err := l.consumer.GetLatestMsgID()
+	if err != nil {
+		log.Warn("failed to get latest msg id to compute consumer lag", zap.String("channel", l.channel), zap.Error(err))
+		return
+	}
+	latest, ok := latestID.(common.OffsetMessageID)
+	if !ok {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return
+	}
+	// the latest message id is the offset of the next message to be produced
+	l.msgLag = max(latest.Offset()-consumed.Offset()-1, 0)
+	metrics.MsgStreamConsumerMsgLag.WithLabelValues(l.channel, l.subscription).Set(float64(l.msgLag))
+}
+
+// close stops tracking the lag, it waits for the in-flight fetch of the latest msg id
+// so that the consumer is not used after it's closed.
+func (l *consumerLag) close() {
+	l.mu.Lock()
+	l.closed = true
+	l.mu.Unlock()
+	l.checking.Wait()
+
+	consumerLags.Remove(l)
+	metrics.MsgStreamConsumerTimeLag.DeleteLabelValues(l.channel, l.subscription)
+	metrics.MsgStreamConsumerMsgLag.DeleteLabelValues(l.channel, l.subscription)
+}
+
+// GetConsumerLags returns the lag of all the msgstream consumers in this process.
+func GetConsumerLags() []*metricsinfo.ConsumerLag {
+	ret := make([]*metricsinfo.ConsumerLag, 0, consumerLags.Len())
+	consumerLags.Range(func(l *consumerLag, _ struct{}) bool {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		ret = append(ret, &metricsinfo.ConsumerLag{
+			Channel:       l.channel,
+			Subscription:  l.subscription,
+			LastTimestamp: l.lastTs,
+			TimeLag:       l.timeLag.String(),
+			MsgLag:        l.msgLag,
+		})
+		return true
+	})
+	return ret
+}
+
+// GetConsumerLagsJSON returns the JSON string of the lag of all the msgstream consumers in this process.
+func GetConsumerLagsJSON() (string, error) {
+	bs, err := json.Marshal(GetConsumerLags())
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// checkSeekRetention refuses the seek if the position is already removed by the retention of the mq,
+// otherwise the mq consumes from the earliest retained message silently and the data in between is lost.
+func checkSeekRetention(consumer mqwrapper.Consumer, channel string, seekID common.MessageID, inclusive bool) error {
+	if !paramtable.Get().MQCfg.CheckSeekRetention.GetAsBool() {
+		return nil
+	}
+	rc, ok := consumer.(mqwrapper.RetentionConsumer)
+	if !ok {
+		return nil
+	}
+	earliest, err := rc.GetEarliestMsgID()
+	if err != nil {
+		log.Warn("failed to get earliest msg id, skip the seek retention check", zap.String("channel", channel), zap.Error(err))
+		return nil
+	}
+	if earliest.AtEarliestPosition() {
+		return nil
+	}
+	expired, err := isSeekExpired(earliest, seekID, inclusive)
+	if err != nil {
+		log.Warn("failed to compare the seek position with the earliest msg id", zap.String("channel", channel), zap.Error(err))
+		return nil
+	}
+	if expired {
+		return merr.WrapErrMqSeekExpired(channel, msgIDString(seekID), msgIDString(earliest))
+	}
+	return nil
+}
+
+func isSeekExpired(earliest common.MessageID, seekID common.MessageID, inclusive bool) (bool, error) {
+	if !inclusive {
+		// the message of the seek position itself is not consumed, so it's fine if it's the only one removed
+		earliestOffset, ok1 := earliest.(common.OffsetMessageID)
+		seekOffset, ok2 := seekID.(common.OffsetMessageID)
+		if !ok1 || !ok2 {
+			return false, nil
+		}
+		return earliestOffset.Offset() > seekOffset.Offset()+1, nil
+	}
+	notExpired, err := earliest.LessOrEqualThan(seekID.Serialize())
+	if err != nil {
+		return false, err
+	}
+	return !notExpired, nil
+}
+
+func msgIDString(msgID common.MessageID) string {
+	if id, ok := msgID.(common.OffsetMessageID); ok {
+		return strconv.FormatInt(id.Offset(), 10)
+	}
+	return fmt.Sprintf("%x", msgID.Serialize())
+}
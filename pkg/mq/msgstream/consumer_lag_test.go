@@ -0,0 +1,99 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/mq/common"
+	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream/mqwrapper"
+	kafkawrapper "github.com/milvus-io/milvus/pkg/v2/mq/msgstream/mqwrapper/kafka"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+type mockRetentionConsumer struct {
+	mqwrapper.Consumer
+	earliest    int64
+	latest      int64
+	earliestErr error
+}
+
+func (c *mockRetentionConsumer) Subscription() string {
+	return "sub"
+}
+
+func (c *mockRetentionConsumer) GetEarliestMsgID() (common.MessageID, error) {
+	return &kafkawrapper.KafkaID{MessageID: c.earliest}, c.earliestErr
+}
+
+func (c *mockRetentionConsumer) GetLatestMsgID() (common.MessageID, error) {
+	return &kafkawrapper.KafkaID{MessageID: c.latest}, nil
+}
+
+func TestCheckSeekRetention(t *testing.T) {
+	consumer := &mockRetentionConsumer{earliest: 10}
+	seek := func(offset int64, inclusive bool) error {
+		return checkSeekRetention(consumer, "ch", &kafkawrapper.KafkaID{MessageID: offset}, inclusive)
+	}
+
+	assert.NoError(t, seek(10, true))
+	assert.NoError(t, seek(20, true))
+	assert.ErrorIs(t, seek(9, true), merr.ErrMqSeekExpired)
+	assert.NoError(t, seek(9, false))
+	assert.ErrorIs(t, seek(8, false), merr.ErrMqSeekExpired)
+
+	// the check is skipped if the earliest msg id is unknown
+	consumer.earliestErr = errors.New("mock error")
+	assert.NoError(t, seek(8, true))
+	consumer.earliestErr = nil
+
+	paramtable.Get().Save(paramtable.Get().MQCfg.CheckSeekRetention.Key, "false")
+	defer paramtable.Get().Reset(paramtable.Get().MQCfg.CheckSeekRetention.Key)
+	assert.NoError(t, seek(8, true))
+}
+
+func TestConsumerLag(t *testing.T) {
+	consumer := &mockRetentionConsumer{latest: 100}
+	lag := newConsumerLag("ch", consumer)
+
+	ts := tsoutil.ComposeTSByTime(time.Now().Add(-time.Minute), 0)
+	lag.observe(&kafkawrapper.KafkaID{MessageID: 89}, ts)
+	// the latest msg id is fetched in background
+	lag.checking.Wait()
+
+	var found bool
+	for _, l := range GetConsumerLags() {
+		if l.Channel == "ch" && l.Subscription == "sub" {
+			found = true
+			assert.EqualValues(t, 10, l.MsgLag)
+			assert.Equal(t, ts, l.LastTimestamp)
+		}
+	}
+	assert.True(t, found)
+	assert.GreaterOrEqual(t, lag.timeLag, time.Minute)
+
+	lag.close()
+	for _, l := range GetConsumerLags() {
+		assert.NotEqual(t, "ch", l.Channel)
+	}
+}
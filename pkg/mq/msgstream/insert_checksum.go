@@ -0,0 +1,263 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"fmt"
+	"hash/crc32"
+	"math"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// InsertChecksumKey is the key of the insert batch checksum in the properties of the msg base.
+const InsertChecksumKey = "insert_checksum"
+
+var checksumTable = crc32.MakeTable(crc32.Castagnoli)
+
+// ComputeChecksum computes the crc32c checksum of the rows carried by the insert message.
+// Only the number of rows and the field data are covered, the segment id and timestamps are
+// assigned after the message is built, so they are not part of the checksum.
+// The values are hashed in a fixed layout rather than the serialized bytes, which are not
+// guaranteed to be stable across the versions of the proto library.
+func (it *InsertMsg) ComputeChecksum() (uint32, error) {
+	w := &checksumWriter{buf: make([]byte, 8)}
+	w.writeUint64(it.GetNumRows())
+	for _, fieldData := range it.GetFieldsData() {
+		if err := w.writeFieldData(fieldData); err != nil {
+			return 0, err
+		}
+	}
+	return w.checksum, nil
+}
+
+// checksumWriter feeds the values into the checksum, the variable length values are prefixed by their length.
+type checksumWriter struct {
+	checksum uint32
+	buf      []byte
+}
+
+func (w *checksumWriter) writeUint64(v uint64) {
+	common.Endian.PutUint64(w.buf, v)
+	w.checksum = crc32.Update(w.checksum, checksumTable, w.buf)
+}
+
+func (w *checksumWriter) writeBytes(v []byte) {
+	w.writeUint64(uint64(len(v)))
+	w.checksum = crc32.Update(w.checksum, checksumTable, v)
+}
+
+func (w *checksumWriter) writeBools(data []bool) {
+	w.writeUint64(uint64(len(data)))
+	for _, v := range data {
+		if v {
+			w.writeUint64(1)
+		} else {
+			w.writeUint64(0)
+		}
+	}
+}
+
+func writeInts[T int32 | int64](w *checksumWriter, data []T) {
+	w.writeUint64(uint64(len(data)))
+	for _, v := range data {
+		w.writeUint64(uint64(v))
+	}
+}
+
+func (w *checksumWriter) writeFloats(data []float32) {
+	w.writeUint64(uint64(len(data)))
+	for _, v := range data {
+		w.writeUint64(uint64(math.Float32bits(v)))
+	}
+}
+
+func (w *checksumWriter) writeDoubles(data []float64) {
+	w.writeUint64(uint64(len(data)))
+	for _, v := range data {
+		w.writeUint64(math.Float64bits(v))
+	}
+}
+
+func (w *checksumWriter) writeStrings(data []string) {
+	w.writeUint64(uint64(len(data)))
+	for _, v := range data {
+		w.writeBytes([]byte(v))
+	}
+}
+
+func (w *checksumWriter) writeBytesArray(data [][]byte) {
+	w.writeUint64(uint64(len(data)))
+	for _, v := range data {
+		w.writeBytes(v)
+	}
+}
+
+func (w *checksumWriter) writeFieldData(fieldData *schemapb.FieldData) error {
+	w.writeUint64(uint64(fieldData.GetFieldId()))
+	w.writeUint64(uint64(fieldData.GetType()))
+	w.writeBools(fieldData.GetValidData())
+	switch field := fieldData.GetField().(type) {
+	case nil:
+		return nil
+	case *schemapb.FieldData_Scalars:
+		return w.writeScalarField(field.Scalars)
+	case *schemapb.FieldData_Vectors:
+		return w.writeVectorField(field.Vectors)
+	case *schemapb.FieldData_StructArrays:
+		fields := field.StructArrays.GetFields()
+		w.writeUint64(uint64(len(fields)))
+		for _, subField := range fields {
+			if err := w.writeFieldData(subField); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return merr.WrapErrParameterInvalidMsg(fmt.Sprintf("unsupported field data %T of field %d for checksum", field, fieldData.GetFieldId()))
+	}
+}
+
+func (w *checksumWriter) writeScalarField(scalars *schemapb.ScalarField) error {
+	switch data := scalars.GetData().(type) {
+	case nil:
+	case *schemapb.ScalarField_BoolData:
+		w.writeBools(data.BoolData.GetData())
+	case *schemapb.ScalarField_IntData:
+		writeInts(w, data.IntData.GetData())
+	case *schemapb.ScalarField_LongData:
+		writeInts(w, data.LongData.GetData())
+	case *schemapb.ScalarField_FloatData:
+		w.writeFloats(data.FloatData.GetData())
+	case *schemapb.ScalarField_DoubleData:
+		w.writeDoubles(data.DoubleData.GetData())
+	case *schemapb.ScalarField_StringData:
+		w.writeStrings(data.StringData.GetData())
+	case *schemapb.ScalarField_BytesData:
+		w.writeBytesArray(data.BytesData.GetData())
+	case *schemapb.ScalarField_JsonData:
+		w.writeBytesArray(data.JsonData.GetData())
+	case *schemapb.ScalarField_GeometryData:
+		w.writeBytesArray(data.GeometryData.GetData())
+	case *schemapb.ScalarField_GeometryWktData:
+		w.writeStrings(data.GeometryWktData.GetData())
+	case *schemapb.ScalarField_TimestamptzData:
+		writeInts(w, data.TimestamptzData.GetData())
+	case *schemapb.ScalarField_ArrayData:
+		w.writeUint64(uint64(data.ArrayData.GetElementType()))
+		w.writeUint64(uint64(len(data.ArrayData.GetData())))
+		for _, element := range data.ArrayData.GetData() {
+			if err := w.writeScalarField(element); err != nil {
+				return err
+			}
+		}
+	default:
+		return merr.WrapErrParameterInvalidMsg(fmt.Sprintf("unsupported scalar data %T for checksum", data))
+	}
+	return nil
+}
+
+func (w *checksumWriter) writeVectorField(vectors *schemapb.VectorField) error {
+	w.writeUint64(uint64(vectors.GetDim()))
+	switch data := vectors.GetData().(type) {
+	case nil:
+	case *schemapb.VectorField_FloatVector:
+		w.writeFloats(data.FloatVector.GetData())
+	case *schemapb.VectorField_BinaryVector:
+		w.writeBytes(data.BinaryVector)
+	case *schemapb.VectorField_Float16Vector:
+		w.writeBytes(data.Float16Vector)
+	case *schemapb.VectorField_Bfloat16Vector:
+		w.writeBytes(data.Bfloat16Vector)
+	case *schemapb.VectorField_Int8Vector:
+		w.writeBytes(data.Int8Vector)
+	case *schemapb.VectorField_SparseFloatVector:
+		w.writeUint64(uint64(data.SparseFloatVector.GetDim()))
+		w.writeBytesArray(data.SparseFloatVector.GetContents())
+	case *schemapb.VectorField_VectorArray:
+		w.writeUint64(uint64(data.VectorArray.GetDim()))
+		w.writeUint64(uint64(data.VectorArray.GetElementType()))
+		w.writeUint64(uint64(len(data.VectorArray.GetData())))
+		for _, element := range data.VectorArray.GetData() {
+			if err := w.writeVectorField(element); err != nil {
+				return err
+			}
+		}
+	default:
+		return merr.WrapErrParameterInvalidMsg(fmt.Sprintf("unsupported vector data %T for checksum", data))
+	}
+	return nil
+}
+
+// SetChecksum computes the checksum of the insert message and carries it in the msg base.
+func (it *InsertMsg) SetChecksum() error {
+	checksum, err := it.ComputeChecksum()
+	if err != nil {
+		return err
+	}
+	if it.Base == nil {
+		it.Base = &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert}
+	}
+	if it.Base.Properties == nil {
+		it.Base.Properties = make(map[string]string)
+	}
+	it.Base.Properties[InsertChecksumKey] = strconv.FormatUint(uint64(checksum), 10)
+	return nil
+}
+
+// GetChecksum returns the checksum carried by the insert message,
+// ok is false if the message has no checksum, e.g. it's written by the proxy with checksum disabled.
+func (it *InsertMsg) GetChecksum() (checksum uint32, ok bool, err error) {
+	value, ok := it.GetBase().GetProperties()[InsertChecksumKey]
+	if !ok {
+		return 0, false, nil
+	}
+	parsed, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, true, merr.WrapErrServiceInternal(fmt.Sprintf("invalid insert checksum %s", value))
+	}
+	return uint32(parsed), true, nil
+}
+
+// VerifyChecksum verifies the rows of the insert message against the checksum carried by it,
+// ok is false if the message has no checksum.
+func (it *InsertMsg) VerifyChecksum() (checksum uint32, ok bool, err error) {
+	expected, ok, err := it.GetChecksum()
+	if !ok || err != nil {
+		return 0, ok, err
+	}
+	checksum, err = it.ComputeChecksum()
+	if err != nil {
+		return 0, true, err
+	}
+	if checksum != expected {
+		return checksum, true, merr.WrapErrServiceInternal(fmt.Sprintf("insert checksum mismatch, expected %d, actual %d", expected, checksum))
+	}
+	return checksum, true, nil
+}
+
+// CombineChecksum folds the checksum of a batch into the checksum of the batches before it,
+// the result depends on the order of the batches.
+func CombineChecksum(combined uint32, checksum uint32) uint32 {
+	buf := make([]byte, 4)
+	common.Endian.PutUint32(buf, checksum)
+	return crc32.Update(combined, checksumTable, buf)
+}
@@ -59,6 +59,7 @@ type mqMsgStream struct {
 	producerChannels []string
 	consumers        map[string]mqwrapper.Consumer
 	consumerChannels []string
+	consumerLags     map[mqwrapper.Consumer]*consumerLag
 
 	repackFunc         RepackFunc
 	unmarshal          UnmarshalDispatcher
@@ -100,6 +101,7 @@ func NewMqMsgStream(initCtx context.Context,
 		producerChannels: producerChannels,
 		consumers:        consumers,
 		consumerChannels: consumerChannels,
+		consumerLags:     make(map[mqwrapper.Consumer]*consumerLag),
 
 		unmarshal:    unmarshal,
 		bufSize:      bufSize,
@@ -200,6 +202,7 @@ func (ms *mqMsgStream) AsConsumer(ctx context.Context, channels []string, subNam
 			defer ms.consumerLock.Unlock()
 			ms.consumers[channel] = pc
 			ms.consumerChannels = append(ms.consumerChannels, channel)
+			ms.trackConsumerLag(channel, pc)
 			return nil
 		}
 
@@ -217,6 +220,19 @@ func (ms *mqMsgStream) AsConsumer(ctx context.Context, channels []string, subNam
 	return nil
 }
 
+// trackConsumerLag starts to track the lag of the consumer, the caller must hold the consumerLock.
+func (ms *mqMsgStream) trackConsumerLag(channel string, consumer mqwrapper.Consumer) {
+	if _, ok := ms.consumerLags[consumer]; !ok {
+		ms.consumerLags[consumer] = newConsumerLag(channel, consumer)
+	}
+}
+
+func (ms *mqMsgStream) getConsumerLag(consumer mqwrapper.Consumer) *consumerLag {
+	ms.consumerLock.Lock()
+	defer ms.consumerLock.Unlock()
+	return ms.consumerLags[consumer]
+}
+
 func (ms *mqMsgStream) SetRepackFunc(repackFunc RepackFunc) {
 	ms.repackFunc = repackFunc
 }
@@ -236,6 +252,10 @@ func (ms *mqMsgStream) Close() {
 			producer.Close()
 		}
 	}
+	// stop tracking the lag before the consumers are closed, the lag tracker may be fetching the latest msg id
+	for _, lag := range ms.consumerLags {
+		lag.close()
+	}
 	for _, consumer := range ms.consumers {
 		if consumer != nil {
 			consumer.Close()
@@ -447,6 +467,7 @@ func (ms *mqMsgStream) receiveMsg(consumer mqwrapper.Consumer) {
 		return
 	}
 
+	lag := ms.getConsumerLag(consumer)
 	for {
 		select {
 		case <-ms.ctx.Done():
@@ -476,6 +497,9 @@ func (ms *mqMsgStream) receiveMsg(consumer mqwrapper.Consumer) {
 					continue
 				}
 			}
+			if lag != nil {
+				lag.observe(msg.ID(), packMsg.GetTimestamp())
+			}
 
 			pos := &msgpb.MsgPosition{
 				ChannelName: filepath.Base(msg.Topic()),
@@ -538,6 +562,11 @@ func (ms *mqMsgStream) Seek(ctx context.Context, msgPositions []*MsgPosition, in
 			}
 		}
 
+		if err := checkSeekRetention(consumer, mp.ChannelName, messageID, includeCurrentMsg); err != nil {
+			log.Ctx(ctx).Warn("Refuse to seek", zap.String("channel", mp.ChannelName), zap.Error(err))
+			return err
+		}
+
 		log.Ctx(ctx).Info("MsgStream seek begin", zap.String("channel", mp.ChannelName), zap.Any("MessageID", mp.MsgID), zap.Bool("includeCurrentMsg", includeCurrentMsg))
 		err = consumer.Seek(messageID, includeCurrentMsg)
 		if err != nil {
@@ -609,6 +638,7 @@ func (ms *MqTtMsgStream) addConsumer(consumer mqwrapper.Consumer, channel string
 	}
 	ms.chanStopChan[consumer] = make(chan bool)
 	ms.chanTtMsgTime[consumer] = 0
+	ms.trackConsumerLag(channel, consumer)
 }
 
 // AsConsumerWithPosition subscribes channels as consumer for a MsgStream and seeks to a certain position.
@@ -831,6 +861,8 @@ func (ms *MqTtMsgStream) bufMsgPackToChannel() {
 func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqwrapper.Consumer) {
 	log := log.Ctx(ms.ctx)
 	defer ms.chanWaitGroup.Done()
+	// the consumerLock is held by bufMsgPackToChannel until this returns
+	lag := ms.consumerLags[consumer]
 	msgTick := time.NewTimer(3 * time.Second)
 	defer msgTick.Stop()
 	for {
@@ -870,6 +902,9 @@ func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqwrapper.Consumer) {
 					continue
 				}
 			}
+			if lag != nil {
+				lag.observe(msg.ID(), packMsg.GetTimestamp())
+			}
 
 			ms.chanMsgBufMutex.Lock()
 			ms.chanMsgBuf[consumer] = append(ms.chanMsgBuf[consumer], packMsg)
@@ -942,6 +977,12 @@ func (ms *MqTtMsgStream) Seek(ctx context.Context, msgPositions []*MsgPosition,
 			}
 		}
 
+		// the position removed by the retention never comes back, retrying doesn't help
+		if err := checkSeekRetention(consumer, mp.ChannelName, seekMsgID, true); err != nil {
+			log.Warn("Refuse to seek", zap.String("channel", mp.ChannelName), zap.Error(err))
+			return false, err
+		}
+
 		log.Info("MsgStream begin to seek start msg: ", zap.String("channel", mp.ChannelName), zap.Any("MessageID", mp.MsgID))
 		err = consumer.Seek(seekMsgID, true)
 		if err != nil {
@@ -971,7 +1012,7 @@ func (ms *MqTtMsgStream) Seek(ctx context.Context, msgPositions []*MsgPosition,
 		err = retry.Handle(ctx, fn, retry.Attempts(20), retry.Sleep(time.Millisecond*200), retry.MaxSleepTime(5*time.Second))
 		// err = retry.Do(ctx, fn, retry.Attempts(20), retry.Sleep(time.Millisecond*200), retry.MaxSleepTime(5*time.Second))
 		if err != nil {
-			return fmt.Errorf("failed to seek, error %s", err.Error())
+			return errors.Wrap(err, "failed to seek")
 		}
 		ms.addConsumer(consumer, mp.ChannelName)
 		ms.chanMsgPos[consumer] = (proto.Clone(mp)).(*MsgPosition)
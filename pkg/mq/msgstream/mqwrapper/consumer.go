@@ -62,3 +62,12 @@ type Consumer interface {
 	// check created topic whether vaild or not
 	CheckTopicValid(channel string) error
 }
+
+// RetentionConsumer is the consumer which knows the earliest message retained in the topic,
+// the seek to a position removed by the retention is refused instead of consuming from the earliest silently.
+type RetentionConsumer interface {
+	Consumer
+
+	// GetEarliestMsgID returns the earliest message ID retained in the topic
+	GetEarliestMsgID() (common.MessageID, error)
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
+var _ mqwrapper.RetentionConsumer = (*Consumer)(nil)
+
 type Consumer struct {
 	c          *kafka.Consumer
 	config     *kafka.ConfigMap
@@ -222,6 +224,15 @@ func (kc *Consumer) GetLatestMsgID() (common.MessageID, error) {
 	return &KafkaID{MessageID: high}, nil
 }
 
+// GetEarliestMsgID returns the low watermark of the topic, the messages before it are removed by the retention.
+func (kc *Consumer) GetEarliestMsgID() (common.MessageID, error) {
+	low, _, err := kc.c.QueryWatermarkOffsets(kc.topic, mqwrapper.DefaultPartitionIdx, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaID{MessageID: low}, nil
+}
+
 func (kc *Consumer) CheckTopicValid(topic string) error {
 	_, err := kc.GetLatestMsgID()
 	log.With(zap.String("topic", kc.topic))
@@ -15,7 +15,7 @@ type KafkaID struct {
 	MessageID int64
 }
 
-var _ mqcommon.MessageID = &KafkaID{}
+var _ mqcommon.OffsetMessageID = &KafkaID{}
 
 func (kid *KafkaID) Serialize() []byte {
 	return SerializeKafkaID(kid.MessageID)
@@ -33,6 +33,11 @@ func (kid *KafkaID) LessOrEqualThan(msgID []byte) (bool, error) {
 	return kid.MessageID <= DeserializeKafkaID(msgID), nil
 }
 
+// Offset returns the offset of the message in the partition
+func (kid *KafkaID) Offset() int64 {
+	return kid.MessageID
+}
+
 func SerializeKafkaID(messageID int64) []byte {
 	b := make([]byte, 8)
 	common.Endian.PutUint64(b, uint64(messageID))
@@ -23,8 +23,11 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/mq/common"
 	"github.com/milvus-io/milvus/pkg/v2/mq/mqimpl/rocksmq/client"
 	"github.com/milvus-io/milvus/pkg/v2/mq/mqimpl/rocksmq/server"
+	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream/mqwrapper"
 )
 
+var _ mqwrapper.RetentionConsumer = (*Consumer)(nil)
+
 // Consumer is a client that used to consume messages from rocksmq
 type Consumer struct {
 	c          client.Consumer
@@ -102,6 +105,11 @@ func (rc *Consumer) GetLatestMsgID() (common.MessageID, error) {
 	return &server.RmqID{MessageID: msgID}, err
 }
 
+func (rc *Consumer) GetEarliestMsgID() (common.MessageID, error) {
+	msgID, err := rc.c.GetEarliestMsgID()
+	return &server.RmqID{MessageID: msgID}, err
+}
+
 func (rc *Consumer) CheckTopicValid(topic string) error {
 	return rc.c.CheckTopicValid(topic)
 }
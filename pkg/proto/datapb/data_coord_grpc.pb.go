@@ -25,6 +25,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	DataCoord_Flush_FullMethodName                       = "/milvus.proto.data.DataCoord/Flush"
 	DataCoord_FlushAll_FullMethodName                    = "/milvus.proto.data.DataCoord/FlushAll"
+	DataCoord_FlushAndSeal_FullMethodName                = "/milvus.proto.data.DataCoord/FlushAndSeal"
 	DataCoord_AllocSegment_FullMethodName                = "/milvus.proto.data.DataCoord/AllocSegment"
 	DataCoord_AssignSegmentID_FullMethodName             = "/milvus.proto.data.DataCoord/AssignSegmentID"
 	DataCoord_GetSegmentInfo_FullMethodName              = "/milvus.proto.data.DataCoord/GetSegmentInfo"
@@ -52,6 +53,7 @@ const (
 	DataCoord_UpdateSegmentStatistics_FullMethodName     = "/milvus.proto.data.DataCoord/UpdateSegmentStatistics"
 	DataCoord_UpdateChannelCheckpoint_FullMethodName     = "/milvus.proto.data.DataCoord/UpdateChannelCheckpoint"
 	DataCoord_MarkSegmentsDropped_FullMethodName         = "/milvus.proto.data.DataCoord/MarkSegmentsDropped"
+	DataCoord_UpdateSegmentStatslogs_FullMethodName      = "/milvus.proto.data.DataCoord/UpdateSegmentStatslogs"
 	DataCoord_BroadcastAlteredCollection_FullMethodName  = "/milvus.proto.data.DataCoord/BroadcastAlteredCollection"
 	DataCoord_CheckHealth_FullMethodName                 = "/milvus.proto.data.DataCoord/CheckHealth"
 	DataCoord_CreateIndex_FullMethodName                 = "/milvus.proto.data.DataCoord/CreateIndex"
@@ -67,6 +69,12 @@ const (
 	DataCoord_GcConfirm_FullMethodName                   = "/milvus.proto.data.DataCoord/GcConfirm"
 	DataCoord_ReportDataNodeTtMsgs_FullMethodName        = "/milvus.proto.data.DataCoord/ReportDataNodeTtMsgs"
 	DataCoord_GcControl_FullMethodName                   = "/milvus.proto.data.DataCoord/GcControl"
+	DataCoord_ListQuarantinedSegments_FullMethodName     = "/milvus.proto.data.DataCoord/ListQuarantinedSegments"
+	DataCoord_OperateQuarantinedSegment_FullMethodName   = "/milvus.proto.data.DataCoord/OperateQuarantinedSegment"
+	DataCoord_ReportChannelHealth_FullMethodName         = "/milvus.proto.data.DataCoord/ReportChannelHealth"
+	DataCoord_RebuildStatslogs_FullMethodName            = "/milvus.proto.data.DataCoord/RebuildStatslogs"
+	DataCoord_GetStatslogRebuildJob_FullMethodName       = "/milvus.proto.data.DataCoord/GetStatslogRebuildJob"
+	DataCoord_ListBackgroundJobs_FullMethodName          = "/milvus.proto.data.DataCoord/ListBackgroundJobs"
 	DataCoord_ImportV2_FullMethodName                    = "/milvus.proto.data.DataCoord/ImportV2"
 	DataCoord_GetImportProgress_FullMethodName           = "/milvus.proto.data.DataCoord/GetImportProgress"
 	DataCoord_ListImports_FullMethodName                 = "/milvus.proto.data.DataCoord/ListImports"
@@ -81,6 +89,9 @@ const (
 type DataCoordClient interface {
 	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
 	FlushAll(ctx context.Context, in *FlushAllRequest, opts ...grpc.CallOption) (*FlushAllResponse, error)
+	// FlushAndSeal seals all the growing segments of the collection and waits for them to be flushed,
+	// so that the backup tools could get a consistent cut at the barrier ts.
+	FlushAndSeal(ctx context.Context, in *FlushAndSealRequest, opts ...grpc.CallOption) (*FlushAndSealResponse, error)
 	// AllocSegment alloc a new growing segment, add it into segment meta.
 	AllocSegment(ctx context.Context, in *AllocSegmentRequest, opts ...grpc.CallOption) (*AllocSegmentResponse, error)
 	// Deprecated: Do not use.
@@ -113,6 +124,7 @@ type DataCoordClient interface {
 	UpdateSegmentStatistics(ctx context.Context, in *UpdateSegmentStatisticsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UpdateChannelCheckpoint(ctx context.Context, in *UpdateChannelCheckpointRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	MarkSegmentsDropped(ctx context.Context, in *MarkSegmentsDroppedRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateSegmentStatslogs(ctx context.Context, in *UpdateSegmentStatslogsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
 	CreateIndex(ctx context.Context, in *indexpb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -130,6 +142,18 @@ type DataCoordClient interface {
 	GcConfirm(ctx context.Context, in *GcConfirmRequest, opts ...grpc.CallOption) (*GcConfirmResponse, error)
 	ReportDataNodeTtMsgs(ctx context.Context, in *ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GcControl(ctx context.Context, in *GcControlRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// ListQuarantinedSegments lists the segments excluded from load, compaction and index building for unreadable logs.
+	ListQuarantinedSegments(ctx context.Context, in *ListQuarantinedSegmentsRequest, opts ...grpc.CallOption) (*ListQuarantinedSegmentsResponse, error)
+	// OperateQuarantinedSegment validates, restores, repairs or drops a quarantined segment.
+	OperateQuarantinedSegment(ctx context.Context, in *OperateQuarantinedSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// ReportChannelHealth reports the health of the vchannel flowgraph from the streaming node.
+	ReportChannelHealth(ctx context.Context, in *ReportChannelHealthRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// RebuildStatslogs regenerates the statslogs of the flushed segments from their binlogs by the stats tasks.
+	RebuildStatslogs(ctx context.Context, in *RebuildStatslogsRequest, opts ...grpc.CallOption) (*RebuildStatslogsResponse, error)
+	// GetStatslogRebuildJob gets the progress of the statslog rebuild job.
+	GetStatslogRebuildJob(ctx context.Context, in *GetStatslogRebuildJobRequest, opts ...grpc.CallOption) (*GetStatslogRebuildJobResponse, error)
+	// ListBackgroundJobs lists the background jobs of the coordinators, e.g. compactions, index builds, imports and loads.
+	ListBackgroundJobs(ctx context.Context, in *ListBackgroundJobsRequest, opts ...grpc.CallOption) (*ListBackgroundJobsResponse, error)
 	// importV2
 	ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error)
 	GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)
@@ -166,6 +190,15 @@ func (c *dataCoordClient) FlushAll(ctx context.Context, in *FlushAllRequest, opt
 	return out, nil
 }
 
+func (c *dataCoordClient) FlushAndSeal(ctx context.Context, in *FlushAndSealRequest, opts ...grpc.CallOption) (*FlushAndSealResponse, error) {
+	out := new(FlushAndSealResponse)
+	err := c.cc.Invoke(ctx, DataCoord_FlushAndSeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) AllocSegment(ctx context.Context, in *AllocSegmentRequest, opts ...grpc.CallOption) (*AllocSegmentResponse, error) {
 	out := new(AllocSegmentResponse)
 	err := c.cc.Invoke(ctx, DataCoord_AllocSegment_FullMethodName, in, out, opts...)
@@ -411,6 +444,15 @@ func (c *dataCoordClient) MarkSegmentsDropped(ctx context.Context, in *MarkSegme
 	return out, nil
 }
 
+func (c *dataCoordClient) UpdateSegmentStatslogs(ctx context.Context, in *UpdateSegmentStatslogsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, DataCoord_UpdateSegmentStatslogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, DataCoord_BroadcastAlteredCollection_FullMethodName, in, out, opts...)
@@ -546,6 +588,60 @@ func (c *dataCoordClient) GcControl(ctx context.Context, in *GcControlRequest, o
 	return out, nil
 }
 
+func (c *dataCoordClient) ListQuarantinedSegments(ctx context.Context, in *ListQuarantinedSegmentsRequest, opts ...grpc.CallOption) (*ListQuarantinedSegmentsResponse, error) {
+	out := new(ListQuarantinedSegmentsResponse)
+	err := c.cc.Invoke(ctx, DataCoord_ListQuarantinedSegments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) OperateQuarantinedSegment(ctx context.Context, in *OperateQuarantinedSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, DataCoord_OperateQuarantinedSegment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReportChannelHealth(ctx context.Context, in *ReportChannelHealthRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, DataCoord_ReportChannelHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) RebuildStatslogs(ctx context.Context, in *RebuildStatslogsRequest, opts ...grpc.CallOption) (*RebuildStatslogsResponse, error) {
+	out := new(RebuildStatslogsResponse)
+	err := c.cc.Invoke(ctx, DataCoord_RebuildStatslogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetStatslogRebuildJob(ctx context.Context, in *GetStatslogRebuildJobRequest, opts ...grpc.CallOption) (*GetStatslogRebuildJobResponse, error) {
+	out := new(GetStatslogRebuildJobResponse)
+	err := c.cc.Invoke(ctx, DataCoord_GetStatslogRebuildJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ListBackgroundJobs(ctx context.Context, in *ListBackgroundJobsRequest, opts ...grpc.CallOption) (*ListBackgroundJobsResponse, error) {
+	out := new(ListBackgroundJobsResponse)
+	err := c.cc.Invoke(ctx, DataCoord_ListBackgroundJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
 	out := new(internalpb.ImportResponse)
 	err := c.cc.Invoke(ctx, DataCoord_ImportV2_FullMethodName, in, out, opts...)
@@ -606,6 +702,9 @@ func (c *dataCoordClient) ListFileResources(ctx context.Context, in *milvuspb.Li
 type DataCoordServer interface {
 	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
 	FlushAll(context.Context, *FlushAllRequest) (*FlushAllResponse, error)
+	// FlushAndSeal seals all the growing segments of the collection and waits for them to be flushed,
+	// so that the backup tools could get a consistent cut at the barrier ts.
+	FlushAndSeal(context.Context, *FlushAndSealRequest) (*FlushAndSealResponse, error)
 	// AllocSegment alloc a new growing segment, add it into segment meta.
 	AllocSegment(context.Context, *AllocSegmentRequest) (*AllocSegmentResponse, error)
 	// Deprecated: Do not use.
@@ -638,6 +737,7 @@ type DataCoordServer interface {
 	UpdateSegmentStatistics(context.Context, *UpdateSegmentStatisticsRequest) (*commonpb.Status, error)
 	UpdateChannelCheckpoint(context.Context, *UpdateChannelCheckpointRequest) (*commonpb.Status, error)
 	MarkSegmentsDropped(context.Context, *MarkSegmentsDroppedRequest) (*commonpb.Status, error)
+	UpdateSegmentStatslogs(context.Context, *UpdateSegmentStatslogsRequest) (*commonpb.Status, error)
 	BroadcastAlteredCollection(context.Context, *AlterCollectionRequest) (*commonpb.Status, error)
 	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
 	CreateIndex(context.Context, *indexpb.CreateIndexRequest) (*commonpb.Status, error)
@@ -655,6 +755,18 @@ type DataCoordServer interface {
 	GcConfirm(context.Context, *GcConfirmRequest) (*GcConfirmResponse, error)
 	ReportDataNodeTtMsgs(context.Context, *ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)
 	GcControl(context.Context, *GcControlRequest) (*commonpb.Status, error)
+	// ListQuarantinedSegments lists the segments excluded from load, compaction and index building for unreadable logs.
+	ListQuarantinedSegments(context.Context, *ListQuarantinedSegmentsRequest) (*ListQuarantinedSegmentsResponse, error)
+	// OperateQuarantinedSegment validates, restores, repairs or drops a quarantined segment.
+	OperateQuarantinedSegment(context.Context, *OperateQuarantinedSegmentRequest) (*commonpb.Status, error)
+	// ReportChannelHealth reports the health of the vchannel flowgraph from the streaming node.
+	ReportChannelHealth(context.Context, *ReportChannelHealthRequest) (*commonpb.Status, error)
+	// RebuildStatslogs regenerates the statslogs of the flushed segments from their binlogs by the stats tasks.
+	RebuildStatslogs(context.Context, *RebuildStatslogsRequest) (*RebuildStatslogsResponse, error)
+	// GetStatslogRebuildJob gets the progress of the statslog rebuild job.
+	GetStatslogRebuildJob(context.Context, *GetStatslogRebuildJobRequest) (*GetStatslogRebuildJobResponse, error)
+	// ListBackgroundJobs lists the background jobs of the coordinators, e.g. compactions, index builds, imports and loads.
+	ListBackgroundJobs(context.Context, *ListBackgroundJobsRequest) (*ListBackgroundJobsResponse, error)
 	// importV2
 	ImportV2(context.Context, *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error)
 	GetImportProgress(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)
@@ -675,6 +787,9 @@ func (UnimplementedDataCoordServer) Flush(context.Context, *FlushRequest) (*Flus
 func (UnimplementedDataCoordServer) FlushAll(context.Context, *FlushAllRequest) (*FlushAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FlushAll not implemented")
 }
+func (UnimplementedDataCoordServer) FlushAndSeal(context.Context, *FlushAndSealRequest) (*FlushAndSealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushAndSeal not implemented")
+}
 func (UnimplementedDataCoordServer) AllocSegment(context.Context, *AllocSegmentRequest) (*AllocSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllocSegment not implemented")
 }
@@ -756,6 +871,9 @@ func (UnimplementedDataCoordServer) UpdateChannelCheckpoint(context.Context, *Up
 func (UnimplementedDataCoordServer) MarkSegmentsDropped(context.Context, *MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkSegmentsDropped not implemented")
 }
+func (UnimplementedDataCoordServer) UpdateSegmentStatslogs(context.Context, *UpdateSegmentStatslogsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSegmentStatslogs not implemented")
+}
 func (UnimplementedDataCoordServer) BroadcastAlteredCollection(context.Context, *AlterCollectionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BroadcastAlteredCollection not implemented")
 }
@@ -801,6 +919,24 @@ func (UnimplementedDataCoordServer) ReportDataNodeTtMsgs(context.Context, *Repor
 func (UnimplementedDataCoordServer) GcControl(context.Context, *GcControlRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GcControl not implemented")
 }
+func (UnimplementedDataCoordServer) ListQuarantinedSegments(context.Context, *ListQuarantinedSegmentsRequest) (*ListQuarantinedSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuarantinedSegments not implemented")
+}
+func (UnimplementedDataCoordServer) OperateQuarantinedSegment(context.Context, *OperateQuarantinedSegmentRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateQuarantinedSegment not implemented")
+}
+func (UnimplementedDataCoordServer) ReportChannelHealth(context.Context, *ReportChannelHealthRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportChannelHealth not implemented")
+}
+func (UnimplementedDataCoordServer) RebuildStatslogs(context.Context, *RebuildStatslogsRequest) (*RebuildStatslogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildStatslogs not implemented")
+}
+func (UnimplementedDataCoordServer) GetStatslogRebuildJob(context.Context, *GetStatslogRebuildJobRequest) (*GetStatslogRebuildJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatslogRebuildJob not implemented")
+}
+func (UnimplementedDataCoordServer) ListBackgroundJobs(context.Context, *ListBackgroundJobsRequest) (*ListBackgroundJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackgroundJobs not implemented")
+}
 func (UnimplementedDataCoordServer) ImportV2(context.Context, *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportV2 not implemented")
 }
@@ -867,6 +1003,24 @@ func _DataCoord_FlushAll_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_FlushAndSeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushAndSealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).FlushAndSeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_FlushAndSeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).FlushAndSeal(ctx, req.(*FlushAndSealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_AllocSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AllocSegmentRequest)
 	if err := dec(in); err != nil {
@@ -1353,6 +1507,24 @@ func _DataCoord_MarkSegmentsDropped_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_UpdateSegmentStatslogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSegmentStatslogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).UpdateSegmentStatslogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_UpdateSegmentStatslogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).UpdateSegmentStatslogs(ctx, req.(*UpdateSegmentStatslogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_BroadcastAlteredCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AlterCollectionRequest)
 	if err := dec(in); err != nil {
@@ -1623,6 +1795,114 @@ func _DataCoord_GcControl_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_ListQuarantinedSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuarantinedSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ListQuarantinedSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_ListQuarantinedSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ListQuarantinedSegments(ctx, req.(*ListQuarantinedSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_OperateQuarantinedSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateQuarantinedSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).OperateQuarantinedSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_OperateQuarantinedSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).OperateQuarantinedSegment(ctx, req.(*OperateQuarantinedSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ReportChannelHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportChannelHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ReportChannelHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_ReportChannelHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ReportChannelHealth(ctx, req.(*ReportChannelHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_RebuildStatslogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildStatslogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).RebuildStatslogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_RebuildStatslogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).RebuildStatslogs(ctx, req.(*RebuildStatslogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetStatslogRebuildJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatslogRebuildJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetStatslogRebuildJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_GetStatslogRebuildJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetStatslogRebuildJob(ctx, req.(*GetStatslogRebuildJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ListBackgroundJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackgroundJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ListBackgroundJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataCoord_ListBackgroundJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ListBackgroundJobs(ctx, req.(*ListBackgroundJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_ImportV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(internalpb.ImportRequestInternal)
 	if err := dec(in); err != nil {
@@ -1746,6 +2026,10 @@ var DataCoord_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FlushAll",
 			Handler:    _DataCoord_FlushAll_Handler,
 		},
+		{
+			MethodName: "FlushAndSeal",
+			Handler:    _DataCoord_FlushAndSeal_Handler,
+		},
 		{
 			MethodName: "AllocSegment",
 			Handler:    _DataCoord_AllocSegment_Handler,
@@ -1854,6 +2138,10 @@ var DataCoord_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkSegmentsDropped",
 			Handler:    _DataCoord_MarkSegmentsDropped_Handler,
 		},
+		{
+			MethodName: "UpdateSegmentStatslogs",
+			Handler:    _DataCoord_UpdateSegmentStatslogs_Handler,
+		},
 		{
 			MethodName: "BroadcastAlteredCollection",
 			Handler:    _DataCoord_BroadcastAlteredCollection_Handler,
@@ -1914,6 +2202,30 @@ var DataCoord_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GcControl",
 			Handler:    _DataCoord_GcControl_Handler,
 		},
+		{
+			MethodName: "ListQuarantinedSegments",
+			Handler:    _DataCoord_ListQuarantinedSegments_Handler,
+		},
+		{
+			MethodName: "OperateQuarantinedSegment",
+			Handler:    _DataCoord_OperateQuarantinedSegment_Handler,
+		},
+		{
+			MethodName: "ReportChannelHealth",
+			Handler:    _DataCoord_ReportChannelHealth_Handler,
+		},
+		{
+			MethodName: "RebuildStatslogs",
+			Handler:    _DataCoord_RebuildStatslogs_Handler,
+		},
+		{
+			MethodName: "GetStatslogRebuildJob",
+			Handler:    _DataCoord_GetStatslogRebuildJob_Handler,
+		},
+		{
+			MethodName: "ListBackgroundJobs",
+			Handler:    _DataCoord_ListBackgroundJobs_Handler,
+		},
 		{
 			MethodName: "ImportV2",
 			Handler:    _DataCoord_ImportV2_Handler,
@@ -665,3 +665,131 @@ var Proxy_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proxy.proto",
 }
+
+const (
+	StreamInsertService_StreamInsert_FullMethodName = "/milvus.proto.proxy.StreamInsertService/StreamInsert"
+)
+
+// StreamInsertServiceClient is the client API for StreamInsertService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StreamInsertServiceClient interface {
+	// StreamInsert inserts the rows streamed by the client chunk by chunk,
+	// every chunk is an insert request which is authorized, throttled and inserted like a single Insert call.
+	// The db, collection and partition names of the first chunk are applied to the following chunks which leave them empty.
+	StreamInsert(ctx context.Context, opts ...grpc.CallOption) (StreamInsertService_StreamInsertClient, error)
+}
+
+type streamInsertServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStreamInsertServiceClient(cc grpc.ClientConnInterface) StreamInsertServiceClient {
+	return &streamInsertServiceClient{cc}
+}
+
+func (c *streamInsertServiceClient) StreamInsert(ctx context.Context, opts ...grpc.CallOption) (StreamInsertService_StreamInsertClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StreamInsertService_ServiceDesc.Streams[0], StreamInsertService_StreamInsert_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamInsertServiceStreamInsertClient{stream}
+	return x, nil
+}
+
+type StreamInsertService_StreamInsertClient interface {
+	Send(*milvuspb.InsertRequest) error
+	CloseAndRecv() (*StreamInsertResponse, error)
+	grpc.ClientStream
+}
+
+type streamInsertServiceStreamInsertClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamInsertServiceStreamInsertClient) Send(m *milvuspb.InsertRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamInsertServiceStreamInsertClient) CloseAndRecv() (*StreamInsertResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(StreamInsertResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamInsertServiceServer is the server API for StreamInsertService service.
+// All implementations should embed UnimplementedStreamInsertServiceServer
+// for forward compatibility
+type StreamInsertServiceServer interface {
+	// StreamInsert inserts the rows streamed by the client chunk by chunk,
+	// every chunk is an insert request which is authorized, throttled and inserted like a single Insert call.
+	// The db, collection and partition names of the first chunk are applied to the following chunks which leave them empty.
+	StreamInsert(StreamInsertService_StreamInsertServer) error
+}
+
+// UnimplementedStreamInsertServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedStreamInsertServiceServer struct {
+}
+
+func (UnimplementedStreamInsertServiceServer) StreamInsert(StreamInsertService_StreamInsertServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamInsert not implemented")
+}
+
+// UnsafeStreamInsertServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreamInsertServiceServer will
+// result in compilation errors.
+type UnsafeStreamInsertServiceServer interface {
+	mustEmbedUnimplementedStreamInsertServiceServer()
+}
+
+func RegisterStreamInsertServiceServer(s grpc.ServiceRegistrar, srv StreamInsertServiceServer) {
+	s.RegisterService(&StreamInsertService_ServiceDesc, srv)
+}
+
+func _StreamInsertService_StreamInsert_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamInsertServiceServer).StreamInsert(&streamInsertServiceStreamInsertServer{stream})
+}
+
+type StreamInsertService_StreamInsertServer interface {
+	SendAndClose(*StreamInsertResponse) error
+	Recv() (*milvuspb.InsertRequest, error)
+	grpc.ServerStream
+}
+
+type streamInsertServiceStreamInsertServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamInsertServiceStreamInsertServer) SendAndClose(m *StreamInsertResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamInsertServiceStreamInsertServer) Recv() (*milvuspb.InsertRequest, error) {
+	m := new(milvuspb.InsertRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamInsertService_ServiceDesc is the grpc.ServiceDesc for StreamInsertService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StreamInsertService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.proxy.StreamInsertService",
+	HandlerType: (*StreamInsertServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamInsert",
+			Handler:       _StreamInsertService_StreamInsert_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proxy.proto",
+}
@@ -39,6 +39,11 @@ const (
 	RootCoord_ListAliases_FullMethodName                   = "/milvus.proto.rootcoord.RootCoord/ListAliases"
 	RootCoord_ShowCollections_FullMethodName               = "/milvus.proto.rootcoord.RootCoord/ShowCollections"
 	RootCoord_ShowCollectionIDs_FullMethodName             = "/milvus.proto.rootcoord.RootCoord/ShowCollectionIDs"
+	RootCoord_SetCollectionQuotaOverride_FullMethodName    = "/milvus.proto.rootcoord.RootCoord/SetCollectionQuotaOverride"
+	RootCoord_CreateCollectionTemplate_FullMethodName      = "/milvus.proto.rootcoord.RootCoord/CreateCollectionTemplate"
+	RootCoord_DropCollectionTemplate_FullMethodName        = "/milvus.proto.rootcoord.RootCoord/DropCollectionTemplate"
+	RootCoord_ListCollectionTemplates_FullMethodName       = "/milvus.proto.rootcoord.RootCoord/ListCollectionTemplates"
+	RootCoord_GetUsage_FullMethodName                      = "/milvus.proto.rootcoord.RootCoord/GetUsage"
 	RootCoord_AlterCollection_FullMethodName               = "/milvus.proto.rootcoord.RootCoord/AlterCollection"
 	RootCoord_AlterCollectionField_FullMethodName          = "/milvus.proto.rootcoord.RootCoord/AlterCollectionField"
 	RootCoord_AddCollectionFunction_FullMethodName         = "/milvus.proto.rootcoord.RootCoord/AddCollectionFunction"
@@ -140,6 +145,14 @@ type RootCoordClient interface {
 	// @return StringListResponse, collection name list
 	ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error)
 	ShowCollectionIDs(ctx context.Context, in *ShowCollectionIDsRequest, opts ...grpc.CallOption) (*ShowCollectionIDsResponse, error)
+	SetCollectionQuotaOverride(ctx context.Context, in *SetCollectionQuotaOverrideRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// CreateCollectionTemplate creates a named preset of the schema skeleton, default indexes and properties.
+	CreateCollectionTemplate(ctx context.Context, in *CreateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// DropCollectionTemplate drops the collection template, the collections created from it are not affected.
+	DropCollectionTemplate(ctx context.Context, in *DropCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListCollectionTemplates(ctx context.Context, in *ListCollectionTemplatesRequest, opts ...grpc.CallOption) (*ListCollectionTemplatesResponse, error)
+	// GetUsage returns the storage, the recent request rates and the applied rate limits of the collections and users.
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
 	AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterCollectionField(ctx context.Context, in *milvuspb.AlterCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AddCollectionFunction(ctx context.Context, in *milvuspb.AddCollectionFunctionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -361,6 +374,51 @@ func (c *rootCoordClient) ShowCollectionIDs(ctx context.Context, in *ShowCollect
 	return out, nil
 }
 
+func (c *rootCoordClient) SetCollectionQuotaOverride(ctx context.Context, in *SetCollectionQuotaOverrideRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, RootCoord_SetCollectionQuotaOverride_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateCollectionTemplate(ctx context.Context, in *CreateCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, RootCoord_CreateCollectionTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropCollectionTemplate(ctx context.Context, in *DropCollectionTemplateRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, RootCoord_DropCollectionTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListCollectionTemplates(ctx context.Context, in *ListCollectionTemplatesRequest, opts ...grpc.CallOption) (*ListCollectionTemplatesResponse, error) {
+	out := new(ListCollectionTemplatesResponse)
+	err := c.cc.Invoke(ctx, RootCoord_ListCollectionTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, RootCoord_GetUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, RootCoord_AlterCollection_FullMethodName, in, out, opts...)
@@ -820,6 +878,14 @@ type RootCoordServer interface {
 	// @return StringListResponse, collection name list
 	ShowCollections(context.Context, *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
 	ShowCollectionIDs(context.Context, *ShowCollectionIDsRequest) (*ShowCollectionIDsResponse, error)
+	SetCollectionQuotaOverride(context.Context, *SetCollectionQuotaOverrideRequest) (*commonpb.Status, error)
+	// CreateCollectionTemplate creates a named preset of the schema skeleton, default indexes and properties.
+	CreateCollectionTemplate(context.Context, *CreateCollectionTemplateRequest) (*commonpb.Status, error)
+	// DropCollectionTemplate drops the collection template, the collections created from it are not affected.
+	DropCollectionTemplate(context.Context, *DropCollectionTemplateRequest) (*commonpb.Status, error)
+	ListCollectionTemplates(context.Context, *ListCollectionTemplatesRequest) (*ListCollectionTemplatesResponse, error)
+	// GetUsage returns the storage, the recent request rates and the applied rate limits of the collections and users.
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
 	AlterCollection(context.Context, *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
 	AlterCollectionField(context.Context, *milvuspb.AlterCollectionFieldRequest) (*commonpb.Status, error)
 	AddCollectionFunction(context.Context, *milvuspb.AddCollectionFunctionRequest) (*commonpb.Status, error)
@@ -941,6 +1007,21 @@ func (UnimplementedRootCoordServer) ShowCollections(context.Context, *milvuspb.S
 func (UnimplementedRootCoordServer) ShowCollectionIDs(context.Context, *ShowCollectionIDsRequest) (*ShowCollectionIDsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollectionIDs not implemented")
 }
+func (UnimplementedRootCoordServer) SetCollectionQuotaOverride(context.Context, *SetCollectionQuotaOverrideRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionQuotaOverride not implemented")
+}
+func (UnimplementedRootCoordServer) CreateCollectionTemplate(context.Context, *CreateCollectionTemplateRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollectionTemplate not implemented")
+}
+func (UnimplementedRootCoordServer) DropCollectionTemplate(context.Context, *DropCollectionTemplateRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropCollectionTemplate not implemented")
+}
+func (UnimplementedRootCoordServer) ListCollectionTemplates(context.Context, *ListCollectionTemplatesRequest) (*ListCollectionTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionTemplates not implemented")
+}
+func (UnimplementedRootCoordServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
 func (UnimplementedRootCoordServer) AlterCollection(context.Context, *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AlterCollection not implemented")
 }
@@ -1376,6 +1457,96 @@ func _RootCoord_ShowCollectionIDs_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_SetCollectionQuotaOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionQuotaOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).SetCollectionQuotaOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_SetCollectionQuotaOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).SetCollectionQuotaOverride(ctx, req.(*SetCollectionQuotaOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateCollectionTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateCollectionTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_CreateCollectionTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateCollectionTemplate(ctx, req.(*CreateCollectionTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropCollectionTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropCollectionTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropCollectionTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_DropCollectionTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropCollectionTemplate(ctx, req.(*DropCollectionTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListCollectionTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListCollectionTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_ListCollectionTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListCollectionTemplates(ctx, req.(*ListCollectionTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RootCoord_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.AlterCollectionRequest)
 	if err := dec(in); err != nil {
@@ -2257,6 +2428,26 @@ var RootCoord_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShowCollectionIDs",
 			Handler:    _RootCoord_ShowCollectionIDs_Handler,
 		},
+		{
+			MethodName: "SetCollectionQuotaOverride",
+			Handler:    _RootCoord_SetCollectionQuotaOverride_Handler,
+		},
+		{
+			MethodName: "CreateCollectionTemplate",
+			Handler:    _RootCoord_CreateCollectionTemplate_Handler,
+		},
+		{
+			MethodName: "DropCollectionTemplate",
+			Handler:    _RootCoord_DropCollectionTemplate_Handler,
+		},
+		{
+			MethodName: "ListCollectionTemplates",
+			Handler:    _RootCoord_ListCollectionTemplates_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _RootCoord_GetUsage_Handler,
+		},
 		{
 			MethodName: "AlterCollection",
 			Handler:    _RootCoord_AlterCollection_Handler,
@@ -32,6 +32,7 @@ const (
 	IndexNode_CreateTask_FullMethodName  = "/milvus.proto.index.IndexNode/CreateTask"
 	IndexNode_QueryTask_FullMethodName   = "/milvus.proto.index.IndexNode/QueryTask"
 	IndexNode_DropTask_FullMethodName    = "/milvus.proto.index.IndexNode/DropTask"
+	IndexNode_EstimateJob_FullMethodName = "/milvus.proto.index.IndexNode/EstimateJob"
 )
 
 // IndexNodeClient is the client API for IndexNode service.
@@ -57,6 +58,9 @@ type IndexNodeClient interface {
 	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	QueryTask(ctx context.Context, in *QueryTaskRequest, opts ...grpc.CallOption) (*QueryTaskResponse, error)
 	DropTask(ctx context.Context, in *DropTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// EstimateJob returns the projected resource of an index build job,
+	// and whether the job fits the node.
+	EstimateJob(ctx context.Context, in *EstimateJobRequest, opts ...grpc.CallOption) (*EstimateJobResponse, error)
 }
 
 type indexNodeClient struct {
@@ -166,6 +170,15 @@ func (c *indexNodeClient) DropTask(ctx context.Context, in *DropTaskRequest, opt
 	return out, nil
 }
 
+func (c *indexNodeClient) EstimateJob(ctx context.Context, in *EstimateJobRequest, opts ...grpc.CallOption) (*EstimateJobResponse, error) {
+	out := new(EstimateJobResponse)
+	err := c.cc.Invoke(ctx, IndexNode_EstimateJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IndexNodeServer is the server API for IndexNode service.
 // All implementations should embed UnimplementedIndexNodeServer
 // for forward compatibility
@@ -189,6 +202,9 @@ type IndexNodeServer interface {
 	CreateTask(context.Context, *CreateTaskRequest) (*commonpb.Status, error)
 	QueryTask(context.Context, *QueryTaskRequest) (*QueryTaskResponse, error)
 	DropTask(context.Context, *DropTaskRequest) (*commonpb.Status, error)
+	// EstimateJob returns the projected resource of an index build job,
+	// and whether the job fits the node.
+	EstimateJob(context.Context, *EstimateJobRequest) (*EstimateJobResponse, error)
 }
 
 // UnimplementedIndexNodeServer should be embedded to have forward compatible implementations.
@@ -228,6 +244,9 @@ func (UnimplementedIndexNodeServer) QueryTask(context.Context, *QueryTaskRequest
 func (UnimplementedIndexNodeServer) DropTask(context.Context, *DropTaskRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropTask not implemented")
 }
+func (UnimplementedIndexNodeServer) EstimateJob(context.Context, *EstimateJobRequest) (*EstimateJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateJob not implemented")
+}
 
 // UnsafeIndexNodeServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to IndexNodeServer will
@@ -438,6 +457,24 @@ func _IndexNode_DropTask_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IndexNode_EstimateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexNodeServer).EstimateJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IndexNode_EstimateJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexNodeServer).EstimateJob(ctx, req.(*EstimateJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IndexNode_ServiceDesc is the grpc.ServiceDesc for IndexNode service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -489,6 +526,10 @@ var IndexNode_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DropTask",
 			Handler:    _IndexNode_DropTask_Handler,
 		},
+		{
+			MethodName: "EstimateJob",
+			Handler:    _IndexNode_EstimateJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "worker.proto",
@@ -0,0 +1,42 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package faultinject provides the failpoints to inject faults into the components for chaos testing.
+// The failpoints are only evaluated in the binaries built with the faultinject tag,
+// they are no-op in the production build.
+package faultinject
+
+const (
+	// FaultError makes the failpoint return an error
+	FaultError = "error"
+	// FaultPanic makes the failpoint panic
+	FaultPanic = "panic"
+	// FaultDelay makes the failpoint sleep for a while before returning
+	FaultDelay = "delay"
+)
+
+// The failpoints registered by default.
+const (
+	DataCoordMetaWrite   = "datacoord.meta.write"
+	DataNodeFlushUpload  = "datanode.flush.upload"
+	QueryNodeLoadSegment = "querynode.load.segment"
+)
+
+func init() {
+	Register(DataCoordMetaWrite, "before the segment meta is written to the catalog by datacoord")
+	Register(DataNodeFlushUpload, "before the binlog is uploaded to the object storage by the flush of datanode or streamingnode")
+	Register(QueryNodeLoadSegment, "before the segments are loaded by querynode")
+}
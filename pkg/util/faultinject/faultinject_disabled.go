@@ -0,0 +1,52 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !faultinject && !test
+
+package faultinject
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+)
+
+// Enabled tells whether the failpoints are evaluated in this build.
+const Enabled = false
+
+// Register is a no-op without the faultinject build tag.
+func Register(name string, description string) {}
+
+// Enable always fails without the faultinject build tag.
+func Enable(name string, fault string, delayMs int64, probability float64, count int64) error {
+	return merr.WrapErrServiceUnavailable("fault injection is not supported, rebuild with the faultinject tag")
+}
+
+// Disable always fails without the faultinject build tag.
+func Disable(name string) error {
+	return merr.WrapErrServiceUnavailable("fault injection is not supported, rebuild with the faultinject tag")
+}
+
+// List returns nothing without the faultinject build tag.
+func List() []*metricsinfo.FailPoint {
+	return nil
+}
+
+// Inject never injects faults without the faultinject build tag.
+func Inject(ctx context.Context, name string) error {
+	return nil
+}
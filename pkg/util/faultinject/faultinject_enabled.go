@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build faultinject || test
+
+package faultinject
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+)
+
+// Enabled tells whether the failpoints are evaluated in this build.
+const Enabled = true
+
+type failPoint struct {
+	description string
+	enabled     bool
+	fault       string
+	delay       time.Duration
+	probability float64
+	remaining   int64
+	hits        int64
+}
+
+var (
+	mu         sync.Mutex
+	failPoints = make(map[string]*failPoint)
+)
+
+// Register adds a failpoint which could be enabled later, it's a no-op if the failpoint exists.
+func Register(name string, description string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := failPoints[name]; !ok {
+		failPoints[name] = &failPoint{description: description}
+	}
+}
+
+// Enable makes the failpoint inject the fault with the probability,
+// count limits the times the fault is injected, 0 means unlimited.
+func Enable(name string, fault string, delayMs int64, probability float64, count int64) error {
+	switch fault {
+	case FaultError, FaultPanic:
+	case FaultDelay:
+		if delayMs <= 0 {
+			return merr.WrapErrParameterInvalidMsg("delay_ms should be positive for the delay fault")
+		}
+	default:
+		return merr.WrapErrParameterInvalidMsg("invalid fault %s, it should be error, panic or delay", fault)
+	}
+	if probability <= 0 || probability > 1 {
+		return merr.WrapErrParameterInvalidMsg("probability should be in (0, 1], but got %v", probability)
+	}
+	if count < 0 {
+		return merr.WrapErrParameterInvalidMsg("count should not be negative, but got %d", count)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	fp, ok := failPoints[name]
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("failpoint %s not found", name)
+	}
+	fp.enabled = true
+	fp.fault = fault
+	fp.delay = time.Duration(delayMs) * time.Millisecond
+	fp.probability = probability
+	fp.remaining = count
+	fp.hits = 0
+	log.Info("failpoint enabled",
+		zap.String("name", name),
+		zap.String("fault", fault),
+		zap.Duration("delay", fp.delay),
+		zap.Float64("probability", probability),
+		zap.Int64("count", count))
+	return nil
+}
+
+// Disable stops the failpoint injecting faults.
+func Disable(name string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	fp, ok := failPoints[name]
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("failpoint %s not found", name)
+	}
+	fp.enabled = false
+	log.Info("failpoint disabled", zap.String("name", name), zap.Int64("hits", fp.hits))
+	return nil
+}
+
+// List returns the states of all the failpoints ordered by name.
+func List() []*metricsinfo.FailPoint {
+	mu.Lock()
+	defer mu.Unlock()
+	result := make([]*metricsinfo.FailPoint, 0, len(failPoints))
+	for name, fp := range failPoints {
+		result = append(result, &metricsinfo.FailPoint{
+			Name:        name,
+			Description: fp.description,
+			Enabled:     fp.enabled,
+			Fault:       fp.fault,
+			DelayMs:     fp.delay.Milliseconds(),
+			Probability: fp.probability,
+			Remaining:   fp.remaining,
+			Hits:        fp.hits,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// trigger decides whether the failpoint fires this time and returns the fault to inject.
+func trigger(name string) (string, time.Duration, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	fp, ok := failPoints[name]
+	if !ok || !fp.enabled {
+		return "", 0, false
+	}
+	if fp.probability < 1 && rand.Float64() >= fp.probability {
+		return "", 0, false
+	}
+	fp.hits++
+	if fp.remaining > 0 {
+		fp.remaining--
+		if fp.remaining == 0 {
+			fp.enabled = false
+		}
+	}
+	return fp.fault, fp.delay, true
+}
+
+// Inject injects the fault if the failpoint is enabled, it returns an error for the error fault,
+// panics for the panic fault and sleeps for the delay fault.
+func Inject(ctx context.Context, name string) error {
+	fault, delay, ok := trigger(name)
+	if !ok {
+		return nil
+	}
+	log.Ctx(ctx).Warn("fault injected", zap.String("failpoint", name), zap.String("fault", fault))
+	switch fault {
+	case FaultError:
+		return merr.WrapErrServiceInternal(fmt.Sprintf("fault injected at %s", name))
+	case FaultPanic:
+		panic(fmt.Sprintf("fault injected at %s", name))
+	case FaultDelay:
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+	return nil
+}
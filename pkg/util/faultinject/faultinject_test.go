@@ -0,0 +1,117 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faultinject
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+)
+
+func TestFaultInject(t *testing.T) {
+	ctx := context.Background()
+	const name = "test.failpoint"
+	Register(name, "for test")
+	defer Disable(name)
+
+	// not enabled
+	assert.NoError(t, Inject(ctx, name))
+	assert.NoError(t, Inject(ctx, "not.registered"))
+
+	assert.Error(t, Enable("not.registered", FaultError, 0, 1, 0))
+	assert.Error(t, Enable(name, "unknown", 0, 1, 0))
+	assert.Error(t, Enable(name, FaultDelay, 0, 1, 0))
+	assert.Error(t, Enable(name, FaultError, 0, 0, 0))
+	assert.Error(t, Enable(name, FaultError, 0, 1, -1))
+	assert.Error(t, Disable("not.registered"))
+
+	t.Run("error", func(t *testing.T) {
+		require.NoError(t, Enable(name, FaultError, 0, 1, 2))
+		assert.ErrorIs(t, Inject(ctx, name), merr.ErrServiceInternal)
+		assert.Error(t, Inject(ctx, name))
+		// disabled after triggered count times
+		assert.NoError(t, Inject(ctx, name))
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		require.NoError(t, Enable(name, FaultPanic, 0, 1, 0))
+		assert.Panics(t, func() { Inject(ctx, name) })
+		require.NoError(t, Disable(name))
+		assert.NotPanics(t, func() { Inject(ctx, name) })
+	})
+
+	t.Run("delay", func(t *testing.T) {
+		require.NoError(t, Enable(name, FaultDelay, 50, 1, 0))
+		start := time.Now()
+		assert.NoError(t, Inject(ctx, name))
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+		require.NoError(t, Enable(name, FaultDelay, time.Hour.Milliseconds(), 1, 0))
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, Inject(cancelCtx, name), context.Canceled)
+	})
+
+	t.Run("handler", func(t *testing.T) {
+		serve := func(method string, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(method, "/management/fault_injection", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			recorder := httptest.NewRecorder()
+			Handler().ServeHTTP(recorder, req)
+			return recorder
+		}
+
+		recorder := serve(http.MethodPost, "action=enable&name=test.failpoint&fault=error&count=3")
+		require.Equal(t, http.StatusOK, recorder.Code)
+		var failPoints []*metricsinfo.FailPoint
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &failPoints))
+		for _, fp := range failPoints {
+			if fp.Name == name {
+				assert.True(t, fp.Enabled)
+				assert.Equal(t, FaultError, fp.Fault)
+				assert.Equal(t, 1.0, fp.Probability)
+				assert.Equal(t, int64(3), fp.Remaining)
+			}
+		}
+		assert.Contains(t, recorder.Body.String(), DataCoordMetaWrite)
+
+		assert.Error(t, Inject(ctx, name))
+		recorder = serve(http.MethodPost, "action=disable&name=test.failpoint")
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"hits":1`)
+		assert.NoError(t, Inject(ctx, name))
+
+		recorder = serve(http.MethodGet, "")
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), name)
+
+		assert.Equal(t, http.StatusMethodNotAllowed, serve(http.MethodDelete, "").Code)
+		assert.Equal(t, http.StatusBadRequest, serve(http.MethodPost, "action=unknown").Code)
+		assert.Equal(t, http.StatusBadRequest, serve(http.MethodPost, "action=enable&name=test.failpoint&fault=panic&probability=2").Code)
+		assert.Equal(t, http.StatusBadRequest, serve(http.MethodPost, "action=enable&name=test.failpoint&fault=delay&delay_ms=a").Code)
+	})
+}
@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faultinject
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+const (
+	actionEnable  = "enable"
+	actionDisable = "disable"
+)
+
+// Handler returns the admin handler of the failpoints in this process.
+// GET lists the failpoints, POST enables or disables the failpoint by the form
+// action, name, fault, delay_ms, probability and count.
+func Handler() http.Handler {
+	return http.HandlerFunc(serveHTTP)
+}
+
+func serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if !Enabled {
+		writeError(w, http.StatusServiceUnavailable, merr.WrapErrServiceUnavailable("fault injection is not supported, rebuild with the faultinject tag"))
+		return
+	}
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		if err := req.ParseForm(); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		if err := operate(req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	default:
+		writeError(w, http.StatusMethodNotAllowed, merr.WrapErrParameterInvalidMsg("only GET and POST are allowed"))
+		return
+	}
+	bs, err := json.Marshal(List())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(bs)
+}
+
+func operate(req *http.Request) error {
+	name := req.FormValue("name")
+	switch action := req.FormValue("action"); action {
+	case actionEnable:
+		var (
+			delayMs     int64
+			probability = 1.0
+			count       int64
+			err         error
+		)
+		if value := req.FormValue("delay_ms"); value != "" {
+			if delayMs, err = strconv.ParseInt(value, 10, 64); err != nil {
+				return merr.WrapErrParameterInvalidMsg("invalid delay_ms %s", value)
+			}
+		}
+		if value := req.FormValue("probability"); value != "" {
+			if probability, err = strconv.ParseFloat(value, 64); err != nil {
+				return merr.WrapErrParameterInvalidMsg("invalid probability %s", value)
+			}
+		}
+		if value := req.FormValue("count"); value != "" {
+			if count, err = strconv.ParseInt(value, 10, 64); err != nil {
+				return merr.WrapErrParameterInvalidMsg("invalid count %s", value)
+			}
+		}
+		return Enable(name, req.FormValue("fault"), delayMs, probability, count)
+	case actionDisable:
+		return Disable(name)
+	default:
+		return merr.WrapErrParameterInvalidMsg("invalid action %s, it should be enable or disable", action)
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	w.WriteHeader(code)
+	w.Write([]byte(fmt.Sprintf(`{"msg": "failed to operate failpoint, %s"}`, err.Error())))
+}
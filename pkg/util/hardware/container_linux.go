@@ -13,6 +13,8 @@ package hardware
 
 import (
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/containerd/cgroups/v3"
@@ -111,6 +113,133 @@ func getContainerMemUsed() (uint64, error) {
 	return used, nil
 }
 
+const (
+	cgroupV2CPUMaxPath      = "/sys/fs/cgroup/cpu.max"
+	cgroupV1CPUQuotaPath    = "/sys/fs/cgroup/cpu/cpu.cfs_quota_us"
+	cgroupV1CPUPeriodPath   = "/sys/fs/cgroup/cpu/cpu.cfs_period_us"
+	cgroupV2CPUMaxUnlimited = "max"
+)
+
+// getContainerCPULimit returns the cpu limit in cores, 0 means no limit.
+func getContainerCPULimit() (float64, error) {
+	if cgroups.Mode() == cgroups.Unified {
+		content, err := os.ReadFile(cgroupV2CPUMaxPath)
+		if err != nil {
+			return 0, err
+		}
+		return parseCgroupV2CPUMax(string(content))
+	}
+	quota, err := os.ReadFile(cgroupV1CPUQuotaPath)
+	if err != nil {
+		return 0, err
+	}
+	period, err := os.ReadFile(cgroupV1CPUPeriodPath)
+	if err != nil {
+		return 0, err
+	}
+	return parseCgroupV1CPUQuota(string(quota), string(period))
+}
+
+// parseCgroupV2CPUMax parses the content of cpu.max, which is `$MAX $PERIOD`.
+func parseCgroupV2CPUMax(content string) (float64, error) {
+	fields := strings.Fields(content)
+	if len(fields) != 2 {
+		return 0, errors.Newf("invalid cpu.max content: %s", content)
+	}
+	if fields[0] == cgroupV2CPUMaxUnlimited {
+		return 0, nil
+	}
+	return parseCPUQuota(fields[0], fields[1])
+}
+
+// parseCgroupV1CPUQuota parses the content of cpu.cfs_quota_us and cpu.cfs_period_us, the quota is -1 if no limit.
+func parseCgroupV1CPUQuota(quota string, period string) (float64, error) {
+	quota, period = strings.TrimSpace(quota), strings.TrimSpace(period)
+	if quota == "-1" {
+		return 0, nil
+	}
+	return parseCPUQuota(quota, period)
+}
+
+func parseCPUQuota(quota string, period string) (float64, error) {
+	q, err := strconv.ParseInt(quota, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	p, err := strconv.ParseInt(period, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if q <= 0 || p <= 0 {
+		return 0, errors.Newf("invalid cpu quota %s and period %s", quota, period)
+	}
+	return float64(q) / float64(p), nil
+}
+
+// getContainerCPUStats returns the cumulative cpu usage and throttling stats of the container.
+func getContainerCPUStats() (*ContainerCPUStats, error) {
+	if cgroups.Mode() == cgroups.Unified {
+		stats, err := getCgroupV2Stats()
+		if err != nil {
+			return nil, err
+		}
+		cpu := stats.GetCPU()
+		return &ContainerCPUStats{
+			UsageNanos:       cpu.GetUsageUsec() * 1000,
+			Periods:          cpu.GetNrPeriods(),
+			ThrottledPeriods: cpu.GetNrThrottled(),
+			ThrottledNanos:   cpu.GetThrottledUsec() * 1000,
+		}, nil
+	}
+	stats, err := getCgroupV1Stats()
+	if err != nil {
+		return nil, err
+	}
+	cpu := stats.GetCPU()
+	return &ContainerCPUStats{
+		UsageNanos:       cpu.GetUsage().GetTotal(),
+		Periods:          cpu.GetThrottling().GetPeriods(),
+		ThrottledPeriods: cpu.GetThrottling().GetThrottledPeriods(),
+		ThrottledNanos:   cpu.GetThrottling().GetThrottledTime(),
+	}, nil
+}
+
+// getContainerIOStats returns the cumulative io stats of all the devices of the container.
+func getContainerIOStats() (*ContainerIOStats, error) {
+	ioStats := &ContainerIOStats{}
+	if cgroups.Mode() == cgroups.Unified {
+		stats, err := getCgroupV2Stats()
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range stats.GetIo().GetUsage() {
+			ioStats.ReadBytes += entry.GetRbytes()
+			ioStats.WriteBytes += entry.GetWbytes()
+			ioStats.ReadOps += entry.GetRios()
+			ioStats.WriteOps += entry.GetWios()
+		}
+		return ioStats, nil
+	}
+	stats, err := getCgroupV1Stats()
+	if err != nil {
+		return nil, err
+	}
+	sumBlkIO := func(entries []*statsv1.BlkIOEntry) (read uint64, write uint64) {
+		for _, entry := range entries {
+			switch {
+			case strings.EqualFold(entry.GetOp(), "read"):
+				read += entry.GetValue()
+			case strings.EqualFold(entry.GetOp(), "write"):
+				write += entry.GetValue()
+			}
+		}
+		return read, write
+	}
+	ioStats.ReadBytes, ioStats.WriteBytes = sumBlkIO(stats.GetBlkio().GetIoServiceBytesRecursive())
+	ioStats.ReadOps, ioStats.WriteOps = sumBlkIO(stats.GetBlkio().GetIoServicedRecursive())
+	return ioStats, nil
+}
+
 // fileExists checks if a file or directory exists at the given path
 func fileExists(path string) bool {
 	_, err := os.Stat(path)
@@ -0,0 +1,50 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package hardware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCgroupCPUQuota(t *testing.T) {
+	limit, err := parseCgroupV2CPUMax("max 100000\n")
+	assert.NoError(t, err)
+	assert.Zero(t, limit)
+
+	limit, err = parseCgroupV2CPUMax("250000 100000\n")
+	assert.NoError(t, err)
+	assert.Equal(t, 2.5, limit)
+
+	_, err = parseCgroupV2CPUMax("250000")
+	assert.Error(t, err)
+
+	limit, err = parseCgroupV1CPUQuota("-1\n", "100000\n")
+	assert.NoError(t, err)
+	assert.Zero(t, limit)
+
+	limit, err = parseCgroupV1CPUQuota("50000\n", "100000\n")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.5, limit)
+
+	_, err = parseCgroupV1CPUQuota("abc", "100000")
+	assert.Error(t, err)
+}
+
+func TestGetContainerIOStats(t *testing.T) {
+	stats, err := GetIOStats()
+	if err != nil {
+		t.Skip("no cgroup io stats:", err)
+	}
+	assert.NotNil(t, stats)
+}
@@ -24,3 +24,18 @@ func getContainerMemLimit() (uint64, error) {
 func getContainerMemUsed() (uint64, error) {
 	return 0, errors.New("Not supported")
 }
+
+// getContainerCPULimit returns the cpu limit in cores and error
+func getContainerCPULimit() (float64, error) {
+	return 0, errors.New("Not supported")
+}
+
+// getContainerCPUStats returns the cpu stats and error
+func getContainerCPUStats() (*ContainerCPUStats, error) {
+	return nil, errors.New("Not supported")
+}
+
+// getContainerIOStats returns the io stats and error
+func getContainerIOStats() (*ContainerIOStats, error) {
+	return nil, errors.New("Not supported")
+}
@@ -16,6 +16,7 @@ import (
 	syslog "log"
 	"runtime"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/errors/oserror"
@@ -33,8 +34,80 @@ var (
 	icOnce sync.Once
 	ic     bool
 	icErr  error
+
+	cpuSampler = &containerCPUSampler{}
 )
 
+// minCPUSampleInterval is the minimal interval between two samples of the cgroup cpu stats,
+// the cached result is returned if sampled again within the interval.
+const minCPUSampleInterval = time.Second
+
+// ContainerCPUStats is the cumulative cpu stats of the container read from cgroup.
+type ContainerCPUStats struct {
+	UsageNanos       uint64
+	Periods          uint64
+	ThrottledPeriods uint64
+	ThrottledNanos   uint64
+}
+
+// ContainerIOStats is the cumulative io stats of the container read from cgroup.
+type ContainerIOStats struct {
+	ReadBytes  uint64
+	WriteBytes uint64
+	ReadOps    uint64
+	WriteOps   uint64
+}
+
+// containerCPUSampler computes the cpu usage and throttled ratio of the container
+// by the delta of the cgroup cpu stats between two samples.
+type containerCPUSampler struct {
+	mu             sync.Mutex
+	last           *ContainerCPUStats
+	lastTime       time.Time
+	usage          float64
+	throttledRatio float64
+}
+
+func (s *containerCPUSampler) sample(limit float64) (float64, float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if s.last != nil && now.Sub(s.lastTime) < minCPUSampleInterval {
+		return s.usage, s.throttledRatio, nil
+	}
+	stats, err := getContainerCPUStats()
+	if err != nil {
+		return 0, 0, err
+	}
+	if s.last != nil {
+		s.usage, s.throttledRatio = calcContainerCPUUsage(s.last, stats, now.Sub(s.lastTime), limit)
+	}
+	s.last, s.lastTime = stats, now
+	return s.usage, s.throttledRatio, nil
+}
+
+// calcContainerCPUUsage returns the cpu usage in percentage of the limit and the ratio of throttled periods between two stats.
+func calcContainerCPUUsage(prev, cur *ContainerCPUStats, elapsed time.Duration, limit float64) (float64, float64) {
+	var usage, throttledRatio float64
+	if elapsed > 0 && limit > 0 && cur.UsageNanos >= prev.UsageNanos {
+		usage = float64(cur.UsageNanos-prev.UsageNanos) / (float64(elapsed.Nanoseconds()) * limit) * 100
+	}
+	if cur.Periods > prev.Periods && cur.ThrottledPeriods >= prev.ThrottledPeriods {
+		throttledRatio = float64(cur.ThrottledPeriods-prev.ThrottledPeriods) / float64(cur.Periods-prev.Periods)
+	}
+	return usage, throttledRatio
+}
+
+// getLimitedContainerCPU returns the cgroup cpu limit in cores if it's less than the host cpu cores.
+func getLimitedContainerCPU() (float64, bool) {
+	limit, err := getContainerCPULimit()
+	if err != nil || limit <= 0 {
+		return 0, false
+	}
+	//nolint
+	return limit, limit < float64(runtime.NumCPU())
+}
+
 // Initialize maxprocs
 func InitMaxprocs(serverType string, flags *flag.FlagSet) {
 	if serverType == typeutil.EmbeddedRole {
@@ -57,8 +130,27 @@ func GetCPUNum() int {
 	return cur
 }
 
+// GetCPULimit returns the cpu limit in cores, which is the cgroup cpu quota in container,
+// or the count of cpu core of the host.
+func GetCPULimit() float64 {
+	if limit, ok := getLimitedContainerCPU(); ok {
+		return limit
+	}
+	//nolint
+	return float64(runtime.NumCPU())
+}
+
 // GetCPUUsage returns the cpu usage in percentage.
+// In container with cpu limit, it's the usage of the container in percentage of the limit.
 func GetCPUUsage() float64 {
+	if limit, ok := getLimitedContainerCPU(); ok {
+		usage, _, err := cpuSampler.sample(limit)
+		if err == nil {
+			return usage
+		}
+		log.RatedWarn(3600, "failed to get container cpu usage, use the host cpu usage", zap.Error(err))
+	}
+
 	percents, err := cpu.Percent(0, false)
 	if err != nil {
 		log.Warn("failed to get cpu usage",
@@ -75,6 +167,26 @@ func GetCPUUsage() float64 {
 	return percents[0]
 }
 
+// GetCPUThrottledRatio returns the ratio of the cfs periods throttled by the cgroup cpu quota recently,
+// it's 0 if the cpu is not limited.
+func GetCPUThrottledRatio() float64 {
+	limit, ok := getLimitedContainerCPU()
+	if !ok {
+		return 0
+	}
+	_, throttledRatio, err := cpuSampler.sample(limit)
+	if err != nil {
+		log.RatedWarn(3600, "failed to get container cpu throttling stats", zap.Error(err))
+		return 0
+	}
+	return throttledRatio
+}
+
+// GetIOStats returns the cumulative io stats of the container.
+func GetIOStats() (*ContainerIOStats, error) {
+	return getContainerIOStats()
+}
+
 // GetMemoryCount returns the memory count in bytes.
 func GetMemoryCount() uint64 {
 	// get host memory by `gopsutil`
@@ -13,6 +13,7 @@ package hardware
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
@@ -30,6 +31,26 @@ func Test_GetCPUUsage(t *testing.T) {
 		zap.Float64("CPUUsage", GetCPUUsage()))
 }
 
+func Test_GetCPULimit(t *testing.T) {
+	assert.Greater(t, GetCPULimit(), float64(0))
+	log.Info("TestGetCPULimit",
+		zap.Float64("CPULimit", GetCPULimit()),
+		zap.Float64("CPUThrottledRatio", GetCPUThrottledRatio()))
+}
+
+func Test_calcContainerCPUUsage(t *testing.T) {
+	prev := &ContainerCPUStats{UsageNanos: 1e9, Periods: 100, ThrottledPeriods: 10}
+	cur := &ContainerCPUStats{UsageNanos: 2e9, Periods: 200, ThrottledPeriods: 60}
+	usage, throttledRatio := calcContainerCPUUsage(prev, cur, time.Second, 2)
+	assert.InDelta(t, 50, usage, 1e-6)
+	assert.InDelta(t, 0.5, throttledRatio, 1e-6)
+
+	// the stats are reset
+	usage, throttledRatio = calcContainerCPUUsage(cur, prev, time.Second, 2)
+	assert.Zero(t, usage)
+	assert.Zero(t, throttledRatio)
+}
+
 func Test_GetMemoryCount(t *testing.T) {
 	log.Info("TestGetMemoryCount",
 		zap.Uint64("MemoryCount", GetMemoryCount()))
@@ -0,0 +1,109 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexparams
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v2/common"
+)
+
+// JobEstimate is the projected resource usage of an index build job.
+type JobEstimate struct {
+	MemoryBytes int64
+	DiskBytes   int64
+	Duration    time.Duration
+}
+
+// buildCostModel is the calibrated cost model of an index type,
+// the costs are in proportion to the raw data size plus the extra bytes of each row, e.g. the graph links.
+type buildCostModel struct {
+	memoryRatio    float64
+	memoryPerRow   func(params map[string]string) int64
+	diskRatio      float64
+	diskPerRow     func(params map[string]string) int64
+	bytesPerSecond float64 // the build throughput of the raw data
+}
+
+const mb = 1024 * 1024
+
+var (
+	defaultBuildCostModel = buildCostModel{memoryRatio: 1.0, bytesPerSecond: 64 * mb}
+
+	buildCostModels = map[string]buildCostModel{
+		"FLAT":     {memoryRatio: 1.0, bytesPerSecond: 1024 * mb},
+		"BIN_FLAT": {memoryRatio: 1.0, bytesPerSecond: 1024 * mb},
+		"IVF_FLAT": {memoryRatio: 2.0, bytesPerSecond: 64 * mb},
+		"IVF_SQ8":  {memoryRatio: 1.25, bytesPerSecond: 64 * mb},
+		"IVF_PQ": {
+			memoryRatio:    1.0,
+			memoryPerRow:   intParam("m", 32),
+			bytesPerSecond: 32 * mb,
+		},
+		"SCANN": {memoryRatio: 1.25, bytesPerSecond: 32 * mb},
+		"HNSW": {
+			memoryRatio: 1.0,
+			// the links of level 0 are 2*M int32 per row
+			memoryPerRow:   func(params map[string]string) int64 { return intParam("M", 16)(params) * 2 * 4 },
+			bytesPerSecond: 4 * mb,
+		},
+		"DISKANN": {
+			memoryRatio: 1.0,
+			diskRatio:   1.0,
+			// the graph on disk keeps max_degree int32 neighbors per row
+			diskPerRow:     func(params map[string]string) int64 { return intParam(MaxDegreeKey, 56)(params) * 4 },
+			bytesPerSecond: 2 * mb,
+		},
+	}
+)
+
+func intParam(key string, defaultValue int64) func(params map[string]string) int64 {
+	return func(params map[string]string) int64 {
+		if v, err := strconv.ParseInt(params[key], 10, 64); err == nil && v > 0 {
+			return v
+		}
+		return defaultValue
+	}
+}
+
+// EstimateJob returns the projected memory, disk and duration of building the index on the float vectors,
+// it's used to check whether the build job fits the node before it's started.
+func EstimateJob(indexParams map[string]string, numRows int64, dim int64) (*JobEstimate, error) {
+	if numRows < 0 || dim <= 0 {
+		return nil, fmt.Errorf("invalid numRows %d or dim %d to estimate the index build job", numRows, dim)
+	}
+	model, ok := buildCostModels[indexParams[common.IndexTypeKey]]
+	if !ok {
+		model = defaultBuildCostModel
+	}
+
+	rawSize := getRowDataSizeOfFloatVector(numRows, dim)
+	estimate := &JobEstimate{
+		MemoryBytes: int64(float64(rawSize) * model.memoryRatio),
+		DiskBytes:   int64(float64(rawSize) * model.diskRatio),
+		Duration:    time.Duration(float64(rawSize) / model.bytesPerSecond * float64(time.Second)),
+	}
+	if model.memoryPerRow != nil {
+		estimate.MemoryBytes += model.memoryPerRow(indexParams) * numRows
+	}
+	if model.diskPerRow != nil {
+		estimate.DiskBytes += model.diskPerRow(indexParams) * numRows
+	}
+	return estimate, nil
+}
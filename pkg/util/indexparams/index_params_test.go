@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -620,3 +621,25 @@ func TestAppendPrepareInfo_parse(t *testing.T) {
 		assert.Equal(t, indexParams["adapt_for_cpu"], "true")
 	})
 }
+
+func TestEstimateJob(t *testing.T) {
+	_, err := EstimateJob(map[string]string{common.IndexTypeKey: "HNSW"}, 100, 0)
+	assert.Error(t, err)
+
+	// raw data is 1000 * 128 * 4 bytes
+	estimate, err := EstimateJob(map[string]string{common.IndexTypeKey: "HNSW", "M": "8"}, 1000, 128)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 512000+1000*8*2*4, estimate.MemoryBytes)
+	assert.EqualValues(t, 0, estimate.DiskBytes)
+	assert.Greater(t, estimate.Duration, time.Duration(0))
+
+	estimate, err = EstimateJob(map[string]string{common.IndexTypeKey: "DISKANN"}, 1000, 128)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 512000, estimate.MemoryBytes)
+	assert.EqualValues(t, 512000+1000*56*4, estimate.DiskBytes)
+
+	// unknown index type falls back to the raw data size
+	estimate, err = EstimateJob(map[string]string{common.IndexTypeKey: "UNKNOWN"}, 1000, 128)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 512000, estimate.MemoryBytes)
+}
@@ -2,9 +2,13 @@ package logutil
 
 import (
 	"context"
+	"sort"
 	"strconv"
+	"strings"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -20,6 +24,10 @@ const (
 	logLevelRPCMetaKey       = "log-level"
 	clientRequestIDKeyLegacy = "client-request-id"
 	clientRequestIDKey       = "client_request_id"
+
+	maxClientTagNum      = 8
+	maxClientTagValueLen = 256
+	clientTagAttrPrefix  = "client.tag."
 )
 
 // UnaryTraceLoggerInterceptor adds a traced logger in unary rpc call ctx
@@ -80,6 +88,7 @@ func withLevelAndTrace(ctx context.Context) context.Context {
 			}
 		}
 	}
+	newctx = withClientTags(newctx)
 	// client request unixsecs
 	requestUnixmsec, ok := GetClientReqUnixmsecGrpc(newctx)
 	if ok {
@@ -122,3 +131,57 @@ func GetMetadata(md metadata.MD, keys ...string) []string {
 	}
 	return result
 }
+
+// withClientTags puts the user-defined tags passed by the client into the tracing baggage,
+// so they are propagated to the downstream nodes, and attaches them to the span and the logger of the request.
+func withClientTags(ctx context.Context) context.Context {
+	bag := baggage.FromContext(ctx)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		keys := make([]string, 0)
+		for key := range md {
+			if strings.HasPrefix(key, common.ClientTagKeyPrefix) && len(key) > len(common.ClientTagKeyPrefix) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		if len(keys) > maxClientTagNum {
+			keys = keys[:maxClientTagNum]
+		}
+		for _, key := range keys {
+			value := md.Get(key)[0]
+			if len(value) > maxClientTagValueLen {
+				value = value[:maxClientTagValueLen]
+			}
+			member, err := baggage.NewMemberRaw(key, value)
+			if err != nil {
+				continue
+			}
+			if newBag, err := bag.SetMember(member); err == nil {
+				bag = newBag
+			}
+		}
+		ctx = baggage.ContextWithBaggage(ctx, bag)
+	}
+
+	tags := GetClientTags(ctx)
+	if len(tags) == 0 {
+		return ctx
+	}
+	attrs := make([]attribute.KeyValue, 0, len(tags))
+	for key, value := range tags {
+		attrs = append(attrs, attribute.String(clientTagAttrPrefix+key, value))
+	}
+	trace.SpanFromContext(ctx).SetAttributes(attrs...)
+	return log.WithFields(ctx, zap.Any("clientTags", tags))
+}
+
+// GetClientTags returns the user-defined tags of the request, the keys are without the ClientTagKeyPrefix.
+func GetClientTags(ctx context.Context) map[string]string {
+	tags := make(map[string]string)
+	for _, member := range baggage.FromContext(ctx).Members() {
+		if key, ok := strings.CutPrefix(member.Key(), common.ClientTagKeyPrefix); ok {
+			tags[key] = member.Value()
+		}
+	}
+	return tags
+}
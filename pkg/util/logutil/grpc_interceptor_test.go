@@ -2,9 +2,12 @@ package logutil
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/baggage"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc/metadata"
 
@@ -61,6 +64,35 @@ func TestCtxWithLevelAndTrace(t *testing.T) {
 	})
 }
 
+func TestWithClientTags(t *testing.T) {
+	md := metadata.New(map[string]string{
+		"client-tag-app":  "recommender",
+		"client-tag-team": strings.Repeat("a", maxClientTagValueLen+1),
+		"client-tag-":     "empty",
+		"other":           "value",
+	})
+	ctx := withLevelAndTrace(metadata.NewIncomingContext(context.TODO(), md))
+	tags := GetClientTags(ctx)
+	assert.Equal(t, map[string]string{
+		"app":  "recommender",
+		"team": strings.Repeat("a", maxClientTagValueLen),
+	}, tags)
+
+	// the tags are carried by the baggage to the downstream
+	downstream := baggage.ContextWithBaggage(context.TODO(), baggage.FromContext(ctx))
+	assert.Equal(t, tags, GetClientTags(withClientTags(downstream)))
+
+	// the number of tags is limited
+	md = metadata.MD{}
+	for i := 0; i < maxClientTagNum+2; i++ {
+		md.Set(fmt.Sprintf("client-tag-%d", i), "v")
+	}
+	ctx = withClientTags(metadata.NewIncomingContext(context.TODO(), md))
+	assert.Len(t, GetClientTags(ctx), maxClientTagNum)
+
+	assert.Empty(t, GetClientTags(context.TODO()))
+}
+
 func withMetaData(ctx context.Context, level zapcore.Level) context.Context {
 	md := metadata.New(map[string]string{
 		logLevelRPCMetaKey: level.String(),
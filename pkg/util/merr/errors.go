@@ -60,6 +60,9 @@ var (
 	ErrServiceUnimplemented        = newMilvusError("service unimplemented", 10, false)
 	ErrServiceTimeTickLongDelay    = newMilvusError("time tick long delay", 11, false)
 	ErrServiceResourceInsufficient = newMilvusError("service resource insufficient", 12, true)
+	ErrServiceDeadlineExceeded     = newMilvusError("deadline exceeded", 13, false)
+	ErrServiceQueryMemoryExceeded  = newMilvusError("query memory limit exceeded", 14, false)
+	ErrServiceSessionKilled        = newMilvusError("client session killed", 15, false)
 
 	// Collection related
 	ErrCollectionNotFound                      = newMilvusError("collection not found", 100, false)
@@ -144,6 +147,7 @@ var (
 	ErrMqTopicNotEmpty = newMilvusError("topic not empty", 1301, false)
 	ErrMqInternal      = newMilvusError("message queue internal error", 1302, false)
 	ErrDenyProduceMsg  = newMilvusError("deny to write the message to mq", 1303, false)
+	ErrMqSeekExpired   = newMilvusError("seek position is removed by the retention of mq", 1304, false)
 
 	// Privilege related
 	// this operation is denied because the user not authorized, user need to login in first
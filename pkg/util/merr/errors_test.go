@@ -80,6 +80,10 @@ func (s *ErrSuite) TestWrap() {
 	s.ErrorIs(WrapErrServiceDiskLimitExceeded(110, 100, "DLE"), ErrServiceDiskLimitExceeded)
 	s.ErrorIs(WrapErrNodeNotMatch(0, 1, "SIM"), ErrNodeNotMatch)
 	s.ErrorIs(WrapErrServiceUnimplemented(errors.New("mock grpc err")), ErrServiceUnimplemented)
+	s.ErrorIs(WrapErrServiceDeadlineExceeded("querynode", context.DeadlineExceeded), ErrServiceDeadlineExceeded)
+	s.True(IsCanceledOrTimeout(WrapErrServiceDeadlineExceeded("querynode", context.DeadlineExceeded)))
+	s.ErrorIs(WrapErrServiceQueryMemoryExceeded("search", 1024, 2048, 1024), ErrServiceQueryMemoryExceeded)
+	s.ErrorIs(WrapErrServiceSessionKilled(1), ErrServiceSessionKilled)
 
 	// Collection related
 	s.ErrorIs(WrapErrCollectionNotFound("test_collection", "failed to get collection"), ErrCollectionNotFound)
@@ -147,6 +151,7 @@ func (s *ErrSuite) TestWrap() {
 	s.ErrorIs(WrapErrMqTopicNotFound("unknown", "failed to get topic"), ErrMqTopicNotFound)
 	s.ErrorIs(WrapErrMqTopicNotEmpty("unknown", "topic is not empty"), ErrMqTopicNotEmpty)
 	s.ErrorIs(WrapErrMqInternal(errors.New("unknown"), "failed to consume"), ErrMqInternal)
+	s.ErrorIs(WrapErrMqSeekExpired("unknown", "1", "2", "failed to seek"), ErrMqSeekExpired)
 
 	// field related
 	s.ErrorIs(WrapErrFieldNotFound("meta", "failed to get field"), ErrFieldNotFound)
@@ -425,6 +425,28 @@ func WrapErrServiceUnimplemented(grpcErr error) error {
 	return wrapFieldsWithDesc(ErrServiceUnimplemented, grpcErr.Error())
 }
 
+// WrapErrServiceDeadlineExceeded wraps the error of the call to the role which exceeds the deadline,
+// the error is marked as context.DeadlineExceeded so that IsCanceledOrTimeout still reports it.
+func WrapErrServiceDeadlineExceeded(role string, err error) error {
+	return errors.Mark(wrapFields(ErrServiceDeadlineExceeded, value("role", role), value("reason", err.Error())), context.DeadlineExceeded)
+}
+
+// WrapErrServiceQueryMemoryExceeded wraps the error of the search/query task which is canceled
+// since the estimated memory of the running tasks exceeds the limit.
+func WrapErrServiceQueryMemoryExceeded(task string, used, total, limit int64) error {
+	return wrapFields(ErrServiceQueryMemoryExceeded,
+		value("task", task),
+		value("used(MB)", logutil.ToMB(float64(used))),
+		value("total(MB)", logutil.ToMB(float64(total))),
+		value("limit(MB)", logutil.ToMB(float64(limit))),
+	)
+}
+
+// WrapErrServiceSessionKilled wraps the error of the request from the client session killed by the admin.
+func WrapErrServiceSessionKilled(identifier int64) error {
+	return wrapFields(ErrServiceSessionKilled, value("identifier", identifier))
+}
+
 // database related
 func WrapErrDatabaseNotFound(database any, msg ...string) error {
 	err := wrapFields(ErrDatabaseNotFound, value("database", database))
@@ -996,6 +1018,18 @@ func WrapErrMqTopicNotEmpty(name string, msg ...string) error {
 	return err
 }
 
+func WrapErrMqSeekExpired(topic string, position string, earliest string, msg ...string) error {
+	err := wrapFields(ErrMqSeekExpired,
+		value("topic", topic),
+		value("position", position),
+		value("earliest", earliest),
+	)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
 func WrapErrMqInternal(err error, msg ...string) error {
 	err = wrapFieldsWithDesc(ErrMqInternal, err.Error())
 	if len(msg) > 0 {
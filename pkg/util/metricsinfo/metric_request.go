@@ -58,6 +58,9 @@ const (
 	// TargetKey request for segment/channel target on the querycoord
 	TargetKey = "qc_target"
 
+	// TargetDiffKey request for the diff between current and next target and the convergence progress on the querycoord
+	TargetDiffKey = "qc_target_diff"
+
 	// AllTaskKey request for get all tasks on the querycoord
 	AllTaskKey = "tasks_all"
 
@@ -70,6 +73,9 @@ const (
 	// ImportTaskKey request for get import tasks from the datacoord
 	ImportTaskKey = "import_tasks"
 
+	// ImportJobKey request for get import jobs from the datacoord
+	ImportJobKey = "import_jobs"
+
 	// CompactionTaskKey request for get compaction tasks from the datacoord
 	CompactionTaskKey = "compaction_tasks"
 
@@ -82,6 +88,45 @@ const (
 	// SyncTaskKey request for get sync tasks from the datanode
 	SyncTaskKey = "sync_tasks"
 
+	// SlowSyncTaskKey request for get the slowest recent sync tasks from the datanode
+	SlowSyncTaskKey = "slow_sync_tasks"
+
+	// SegmentManifestKey request for get the segment manifest of collection from the datacoord
+	SegmentManifestKey = "segment_manifest"
+
+	// FeatureGateKey request for get the feature gates of the cluster from the datacoord
+	FeatureGateKey = "feature_gates"
+
+	// SegmentCandidateKey request for get the segments selected or rejected by compaction and garbage collection from the datacoord
+	SegmentCandidateKey = "segment_candidates"
+
+	// QuarantinedSegmentKey request for list or plan the repair of the quarantined segments on the datacoord
+	QuarantinedSegmentKey = "quarantined_segments"
+
+	// ConsumerLagKey request for get the lag of the msgstream consumers on the node
+	ConsumerLagKey = "consumer_lags"
+
+	// DdlTaskKey request for get the state of the asynchronous ddl tasks from the rootcoord
+	DdlTaskKey = "ddl_tasks"
+
+	// CollectionAccessKey request for get the last search/query time of the collections from the querynode
+	CollectionAccessKey = "collection_access"
+
+	// IndexGCReportKey request for get the report of the last index garbage collection from the datacoord
+	IndexGCReportKey = "index_gc_report"
+
+	// ConfigDriftKey request for get the configurations which differ across the nodes managed by the coordinator
+	ConfigDriftKey = "config_drift"
+
+	// IndexCompletenessKey request for get the flushed segments of the collection which lack the declared indexes from the datacoord
+	IndexCompletenessKey = "index_completeness"
+
+	// TimeTravelWatermarkKey request for get the oldest timestamp which may still be read by the running search and query requests
+	TimeTravelWatermarkKey = "time_travel_watermark"
+
+	// NodeHeartbeatKey request for get the build version, feature flags and recent load samples of the querynodes from the querycoord
+	NodeHeartbeatKey = "node_heartbeats"
+
 	// MetricRequestParamVerboseKey as a request parameter decide to whether return verbose value
 	MetricRequestParamVerboseKey = "verbose"
 
@@ -89,6 +134,24 @@ const (
 
 	MetricRequestParamCollectionIDKey = "collection_id"
 
+	MetricRequestParamTsKey = "ts"
+
+	MetricRequestParamSignedURLKey = "signed_url"
+
+	MetricRequestParamTaskIDKey = "task_id"
+
+	MetricRequestParamSegmentIDKey = "segment_id"
+
+	MetricRequestParamStateKey = "state"
+
+	// MetricRequestParamActionKey is the operation on the requested object, e.g. plan the repair of a quarantined segment
+	MetricRequestParamActionKey = "action"
+
+	MetricRequestParamNameKey = "name"
+
+	// MetricRequestParamWaitKey is the max duration in milliseconds to wait for the task to finish
+	MetricRequestParamWaitKey = "wait_ms"
+
 	MetricRequestParamINKey  = "in"
 	MetricsRequestParamsInDC = "dc"
 	MetricsRequestParamsInQC = "qc"
@@ -106,6 +169,7 @@ var (
 
 	RequestProcessInDCRole = &commonpb.KeyValuePair{Key: MetricRequestProcessInRoleKey, Value: typeutil.DataCoordRole}
 	RequestProcessInQCRole = &commonpb.KeyValuePair{Key: MetricRequestProcessInRoleKey, Value: typeutil.QueryCoordRole}
+	RequestProcessInRCRole = &commonpb.KeyValuePair{Key: MetricRequestProcessInRoleKey, Value: typeutil.RootCoordRole}
 )
 
 type MetricsRequestAction func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error)
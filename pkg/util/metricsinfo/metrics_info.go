@@ -44,6 +44,12 @@ type HardwareMetrics struct {
 	DiskUsage float64 `json:"disk_usage"`
 
 	IOWaitPercentage float64 `json:"io_wait_percentage"` // IO Wait in %
+
+	// cgroup aware cpu & io stats, CPUCoreUsage is in percentage of CPULimit in container
+	CPULimit          float64 `json:"cpu_limit"` // cpu limit in cores
+	CPUThrottledRatio float64 `json:"cpu_throttled_ratio"`
+	IOReadBytes       uint64  `json:"io_read_bytes"`
+	IOWriteBytes      uint64  `json:"io_write_bytes"`
 }
 
 type TaskQueueMetrics struct {
@@ -100,19 +106,20 @@ type QueryParams struct {
 }
 
 type SlowQuery struct {
-	Time                  string       `json:"time,omitempty"`
-	Role                  string       `json:"role,omitempty"`
-	Database              string       `json:"database,omitempty"`
-	Collection            string       `json:"collection,omitempty"`
-	Partitions            string       `json:"partitions,omitempty"`
-	ConsistencyLevel      string       `json:"consistency_level,omitempty"`
-	UseDefaultConsistency bool         `json:"use_default_consistency,omitempty"`
-	GuaranteeTimestamp    uint64       `json:"guarantee_timestamp,omitempty,string"`
-	Duration              string       `json:"duration,omitempty"`
-	User                  string       `json:"user,omitempty"`
-	QueryParams           *QueryParams `json:"query_params,omitempty"`
-	Type                  string       `json:"type,omitempty"`
-	TraceID               string       `json:"trace_id,omitempty"`
+	Time                  string            `json:"time,omitempty"`
+	Role                  string            `json:"role,omitempty"`
+	Database              string            `json:"database,omitempty"`
+	Collection            string            `json:"collection,omitempty"`
+	Partitions            string            `json:"partitions,omitempty"`
+	ConsistencyLevel      string            `json:"consistency_level,omitempty"`
+	UseDefaultConsistency bool              `json:"use_default_consistency,omitempty"`
+	GuaranteeTimestamp    uint64            `json:"guarantee_timestamp,omitempty,string"`
+	Duration              string            `json:"duration,omitempty"`
+	User                  string            `json:"user,omitempty"`
+	QueryParams           *QueryParams      `json:"query_params,omitempty"`
+	Type                  string            `json:"type,omitempty"`
+	TraceID               string            `json:"trace_id,omitempty"`
+	Tags                  map[string]string `json:"tags,omitempty"`
 }
 
 type DmChannel struct {
@@ -157,6 +164,295 @@ type Segment struct {
 	IsIndexed bool `json:"is_indexed,omitempty"` // indicate whether the segment is indexed
 }
 
+// SegmentManifest is the list of flushed segments and their log files of a collection at barrier ts,
+// rows with timestamp greater than barrier ts may exist in the log files and shall be filtered by reader.
+type SegmentManifest struct {
+	CollectionID int64                   `json:"collection_id,omitempty,string"`
+	BarrierTs    uint64                  `json:"barrier_ts,omitempty,string"`
+	Segments     []*SegmentManifestEntry `json:"segments,omitempty"`
+}
+
+// FeatureGateView is the cluster-wide view of the protocol features gated by the capabilities of the nodes.
+type FeatureGateView struct {
+	Gates []*FeatureGate     `json:"gates,omitempty"`
+	Nodes []*FeatureGateNode `json:"nodes,omitempty"`
+}
+
+// FeatureGate is enabled only if all the nodes support the capability.
+type FeatureGate struct {
+	Capability       string  `json:"capability,omitempty"`
+	Enabled          bool    `json:"enabled"`
+	UnsupportedNodes []int64 `json:"unsupported_nodes,omitempty"`
+}
+
+type FeatureGateNode struct {
+	ServerID     int64    `json:"server_id,omitempty,string"`
+	Role         string   `json:"role,omitempty"`
+	Version      string   `json:"version,omitempty"`
+	Capabilities []string `json:"capabilities,omitempty"`
+}
+
+// NodeHeartbeatExtraInfoKey is the key of the status extra info which carries the NodeHeartbeat
+// in the data distribution response of the querynode.
+const NodeHeartbeatExtraInfoKey = "node_heartbeat"
+
+// NodeHeartbeat is the load and build info reported by the querynode along with its data distribution.
+type NodeHeartbeat struct {
+	// CPUUsage is the cpu usage in percentage, MemoryUsage and IOWait are the ratios in [0, 1].
+	CPUUsage    float64 `json:"cpu_usage"`
+	MemoryUsage float64 `json:"memory_usage"`
+	IOWait      float64 `json:"io_wait"`
+	// ReadQueueTasks and ReadQueueNQ are the depths of the search and query tasks waiting in the scheduler.
+	ReadQueueTasks int64    `json:"read_queue_tasks"`
+	ReadQueueNQ    int64    `json:"read_queue_nq"`
+	BuildVersion   string   `json:"build_version,omitempty"`
+	FeatureFlags   []string `json:"feature_flags,omitempty"`
+	// TimeTravelWatermark is the oldest mvcc timestamp of the running search and query requests, zero if there is none.
+	TimeTravelWatermark uint64 `json:"time_travel_watermark,omitempty,string"`
+}
+
+// NodeLoadSample is the load of the querynode reported by one heartbeat.
+type NodeLoadSample struct {
+	Timestamp string         `json:"timestamp,omitempty"`
+	Score     float64        `json:"score"`
+	Heartbeat *NodeHeartbeat `json:"heartbeat,omitempty"`
+}
+
+// NodeHeartbeatInfo is the heartbeats of the querynode kept by the querycoord.
+type NodeHeartbeatInfo struct {
+	NodeID        int64             `json:"node_id,omitempty,string"`
+	Address       string            `json:"address,omitempty"`
+	State         string            `json:"state,omitempty"`
+	LastHeartbeat string            `json:"last_heartbeat,omitempty"`
+	BuildVersion  string            `json:"build_version,omitempty"`
+	FeatureFlags  []string          `json:"feature_flags,omitempty"`
+	LoadScore     float64           `json:"load_score"`
+	Samples       []*NodeLoadSample `json:"samples,omitempty"`
+}
+
+type SegmentManifestEntry struct {
+	SegmentID   int64                `json:"segment_id,omitempty,string"`
+	PartitionID int64                `json:"partition_id,omitempty,string"`
+	Channel     string               `json:"channel,omitempty"`
+	NumOfRows   int64                `json:"num_of_rows,omitempty,string"`
+	Level       string               `json:"level,omitempty"`
+	IsSorted    bool                 `json:"is_sorted,omitempty"`
+	Binlogs     []*ManifestFieldLogs `json:"binlogs,omitempty"`
+	Deltalogs   []*ManifestLogFile   `json:"deltalogs,omitempty"`
+	Statslogs   []*ManifestFieldLogs `json:"statslogs,omitempty"`
+	Bm25Logs    []*ManifestFieldLogs `json:"bm25logs,omitempty"`
+}
+
+type ManifestFieldLogs struct {
+	FieldID int64              `json:"field_id,omitempty,string"`
+	Logs    []*ManifestLogFile `json:"logs,omitempty"`
+}
+
+type ManifestLogFile struct {
+	Path          string `json:"path,omitempty"`
+	SignedURL     string `json:"signed_url,omitempty"`
+	EntriesNum    int64  `json:"entries_num,omitempty,string"`
+	LogSize       int64  `json:"log_size,omitempty,string"`
+	TimestampFrom uint64 `json:"timestamp_from,omitempty,string"`
+	TimestampTo   uint64 `json:"timestamp_to,omitempty,string"`
+}
+
+// SegmentCandidates explains which segments of a collection are selected by compaction and garbage collection,
+// along with the reasons and the thresholds evaluated.
+type SegmentCandidates struct {
+	CollectionID int64               `json:"collection_id,omitempty,string"`
+	Compaction   []*SegmentCandidate `json:"compaction,omitempty"`
+	GC           []*SegmentCandidate `json:"gc,omitempty"`
+}
+
+type SegmentCandidate struct {
+	SegmentID   int64    `json:"segment_id,omitempty,string"`
+	PartitionID int64    `json:"partition_id,omitempty,string"`
+	Channel     string   `json:"channel,omitempty"`
+	State       string   `json:"state,omitempty"`
+	Level       string   `json:"level,omitempty"`
+	NumOfRows   int64    `json:"num_of_rows,omitempty,string"`
+	Size        int64    `json:"size,omitempty,string"`
+	Selected    bool     `json:"selected"`
+	Reasons     []string `json:"reasons,omitempty"`
+}
+
+// QuarantinedSegment is a segment excluded from load, compaction and index building
+// since its binlogs or stats are found unreadable.
+type QuarantinedSegment struct {
+	SegmentID      int64  `json:"segment_id,omitempty,string"`
+	CollectionID   int64  `json:"collection_id,omitempty,string"`
+	PartitionID    int64  `json:"partition_id,omitempty,string"`
+	Channel        string `json:"channel,omitempty"`
+	State          string `json:"state,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+	QuarantineTime string `json:"quarantine_time,omitempty"`
+}
+
+// SegmentRepairPlan is the alternative source found to repair a quarantined segment.
+type SegmentRepairPlan struct {
+	SegmentID int64  `json:"segment_id,omitempty,string"`
+	Source    string `json:"source,omitempty"`
+	// the pre-compaction parents to restore in place of the segment
+	SourceSegmentIDs []int64  `json:"source_segment_ids,omitempty"`
+	Repairable       bool     `json:"repairable"`
+	Reasons          []string `json:"reasons,omitempty"`
+}
+
+// FailPoint is the state of a failpoint for fault injection.
+type FailPoint struct {
+	Name        string  `json:"name,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Enabled     bool    `json:"enabled"`
+	Fault       string  `json:"fault,omitempty"`
+	DelayMs     int64   `json:"delay_ms,omitempty"`
+	Probability float64 `json:"probability,omitempty"`
+	// the remaining times to trigger, 0 means unlimited
+	Remaining int64 `json:"remaining,omitempty"`
+	Hits      int64 `json:"hits,omitempty"`
+}
+
+// IndexCompleteness reports the flushed segments of the collection which still lack the declared indexes.
+type IndexCompleteness struct {
+	CollectionID      int64               `json:"collection_id,omitempty,string"`
+	Complete          bool                `json:"complete"`
+	TotalRows         int64               `json:"total_rows,omitempty,string"`
+	UnindexedRows     int64               `json:"unindexed_rows,omitempty,string"`
+	UnindexedSegments []*UnindexedSegment `json:"unindexed_segments,omitempty"`
+	// EstimatedTime is the estimated time to build the missing indexes, by the build speed of the finished ones,
+	// it's empty if unknown.
+	EstimatedTime string `json:"estimated_time,omitempty"`
+}
+
+// UnindexedSegment is a flushed segment which lacks some of the declared indexes.
+type UnindexedSegment struct {
+	SegmentID      int64    `json:"segment_id,omitempty,string"`
+	PartitionID    int64    `json:"partition_id,omitempty,string"`
+	NumRows        int64    `json:"num_rows,omitempty,string"`
+	MissingIndexes []string `json:"missing_indexes,omitempty"`
+}
+
+// The types of the background jobs.
+const (
+	BackgroundJobCompaction = "compaction"
+	BackgroundJobIndexBuild = "index_build"
+	BackgroundJobImport     = "import"
+	BackgroundJobLoad       = "load"
+	BackgroundJobRelease    = "release"
+	BackgroundJobBalance    = "balance"
+	BackgroundJobGC         = "gc"
+)
+
+// The uniform states of the background jobs, the original state of the job is kept in the detail.
+const (
+	BackgroundJobPending   = "pending"
+	BackgroundJobRunning   = "running"
+	BackgroundJobCompleted = "completed"
+	BackgroundJobFailed    = "failed"
+)
+
+// BackgroundJob is a job running in the background of the coordinators,
+// which is listed with the uniform fields regardless of its type.
+type BackgroundJob struct {
+	Type         string `json:"type,omitempty"`
+	JobID        int64  `json:"job_id,omitempty,string"`
+	CollectionID int64  `json:"collection_id,omitempty,string"`
+	State        string `json:"state,omitempty"`
+	// Progress is the percentage of the job, -1 if unknown.
+	Progress  int64  `json:"progress"`
+	StartTime string `json:"start_time,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Detail    string `json:"detail,omitempty"`
+}
+
+// DdlTask is the state of an asynchronous ddl on the rootcoord.
+type DdlTask struct {
+	TaskID         int64  `json:"task_id,omitempty,string"`
+	Name           string `json:"name,omitempty"`
+	DBName         string `json:"db_name,omitempty"`
+	CollectionName string `json:"collection_name,omitempty"`
+	State          string `json:"state,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+	CreateTime     int64  `json:"create_time,omitempty,string"`
+	UpdateTime     int64  `json:"update_time,omitempty,string"`
+}
+
+// ConsumerLag is the lag of a msgstream consumer, MsgLag is -1 if the mq doesn't support offsets.
+type ConsumerLag struct {
+	Channel       string `json:"channel,omitempty"`
+	Subscription  string `json:"subscription,omitempty"`
+	LastTimestamp uint64 `json:"last_timestamp,omitempty,string"`
+	TimeLag       string `json:"time_lag,omitempty"`
+	MsgLag        int64  `json:"msg_lag,string"`
+}
+
+// CollectionAccess is the last time the collection is searched or queried on the querynode.
+type CollectionAccess struct {
+	CollectionID   int64 `json:"collection_id,omitempty,string"`
+	LastAccessTime int64 `json:"last_access_time,omitempty,string"`
+	// InFlight is the number of the running search and query requests of the collection
+	InFlight int64 `json:"in_flight,omitempty,string"`
+}
+
+// TimeTravelWatermark is the oldest mvcc timestamp of the running search and query requests,
+// zero means there is no running request.
+type TimeTravelWatermark struct {
+	Watermark uint64 `json:"watermark,string"`
+	// UnknownNodes are the querynodes without a recent heartbeat, whose running requests are not counted.
+	UnknownNodes []int64 `json:"unknown_nodes,omitempty"`
+}
+
+// IndexGCReport is the result of the last index garbage collection on the datacoord,
+// the recycled items are only reported but not removed in dry run mode.
+type IndexGCReport struct {
+	DryRun bool `json:"dry_run"`
+	// SegmentIndexGCTime is the time of the last pruning of the segment index meta.
+	SegmentIndexGCTime int64                 `json:"segment_index_gc_time,omitempty,string"`
+	SegmentIndexes     []*IndexGCSegmentItem `json:"segment_indexes,omitempty"`
+	// IndexFileGCTime is the time of the last scanning of the index files.
+	IndexFileGCTime int64 `json:"index_file_gc_time,omitempty,string"`
+	// OrphanFiles is truncated if there are too many, OrphanFileNum is the total number.
+	OrphanFiles   []string `json:"orphan_files,omitempty"`
+	OrphanFileNum int64    `json:"orphan_file_num,omitempty,string"`
+	// SkippedFileNum is the number of the orphan files kept for the grace period.
+	SkippedFileNum int64 `json:"skipped_file_num,omitempty,string"`
+}
+
+// ConfigDrift is the configurations which differ across the nodes of a role, which is usually caused by
+// the partial restarts after the configurations are changed.
+type ConfigDrift struct {
+	Role  string  `json:"role,omitempty"`
+	Nodes []int64 `json:"nodes,omitempty"`
+	// FailedNodes are the nodes which fail to show the configurations, they are not compared.
+	FailedNodes []int64            `json:"failed_nodes,omitempty"`
+	Items       []*ConfigDriftItem `json:"items,omitempty"`
+}
+
+// ConfigDriftItem is a configuration which differs across the nodes.
+type ConfigDriftItem struct {
+	Key    string                  `json:"key,omitempty"`
+	Values []*ConfigDriftNodeValue `json:"values,omitempty"`
+}
+
+// ConfigDriftNodeValue is the effective value of a configuration on the node,
+// the value is missing if the configuration is not known by the node.
+type ConfigDriftNodeValue struct {
+	NodeID  int64  `json:"node_id,omitempty,string"`
+	Value   string `json:"value,omitempty"`
+	Source  string `json:"source,omitempty"`
+	Missing bool   `json:"missing,omitempty"`
+}
+
+// IndexGCSegmentItem is a segment index meta pruned by the index garbage collection.
+type IndexGCSegmentItem struct {
+	CollectionID int64  `json:"collection_id,omitempty,string"`
+	SegmentID    int64  `json:"segment_id,omitempty,string"`
+	IndexID      int64  `json:"index_id,omitempty,string"`
+	BuildID      int64  `json:"build_id,omitempty,string"`
+	State        string `json:"state,omitempty"`
+	Reason       string `json:"reason,omitempty"`
+}
+
 type IndexedField struct {
 	IndexFieldID int64 `json:"field_id,omitempty,string"`
 	IndexID      int64 `json:"index_id,omitempty,string"`
@@ -172,6 +468,23 @@ type QueryCoordTarget struct {
 	DMChannels   []*DmChannel `json:"dm_channels,omitempty"`
 }
 
+// QueryCoordTargetDiff is the diff from current target to next target which the checkers are converging toward.
+type QueryCoordTargetDiff struct {
+	CollectionID      int64    `json:"collection_id,omitempty,string"`
+	CurrentVersion    int64    `json:"current_version,omitempty,string"`
+	NextVersion       int64    `json:"next_version,omitempty,string"`
+	SegmentsToLoad    []int64  `json:"segments_to_load,omitempty"`
+	SegmentsToRelease []int64  `json:"segments_to_release,omitempty"`
+	ChannelsToWatch   []string `json:"channels_to_watch,omitempty"`
+	ChannelsToRelease []string `json:"channels_to_release,omitempty"`
+	// SegmentsRemaining is the number of segments in next target which are not loaded by all replicas yet.
+	SegmentsRemaining int `json:"segments_remaining"`
+	// ChannelsRemaining is the number of channels in next target which are not watched by all replicas yet.
+	ChannelsRemaining int `json:"channels_remaining"`
+	// EstimatedSeconds is the estimated time to converge, -1 means unknown.
+	EstimatedSeconds float64 `json:"estimated_seconds"`
+}
+
 type QueryCoordTask struct {
 	TaskName     string   `json:"task_name,omitempty"`
 	CollectionID int64    `json:"collection_id,omitempty,string"`
@@ -227,6 +540,9 @@ type Channel struct {
 	NodeID         int64  `json:"node_id,omitempty,string"`
 	CollectionID   int64  `json:"collection_id,omitempty,string"`
 	CheckpointTS   string `json:"check_point_ts,omitempty"` // a time string, format like "2006-01-02 15:04:05"
+	HealthState    string `json:"health_state,omitempty"`   // the flowgraph health reported by the streaming node, empty if healthy
+	HealthFailures int64  `json:"health_failures,omitempty"`
+	HealthReason   string `json:"health_reason,omitempty"`
 }
 
 // DeployMetrics records the deploy information of nodes.
@@ -318,6 +634,7 @@ type IndexTaskStats struct {
 
 type SyncTask struct {
 	SegmentID     int64  `json:"segment_id,omitempty,string"`
+	Channel       string `json:"channel,omitempty"`
 	BatchRows     int64  `json:"batch_rows,omitempty,string"`
 	SegmentLevel  string `json:"segment_level,omitempty,string"`
 	TSFrom        string `json:"ts_from,omitempty"`
@@ -353,6 +670,8 @@ type DataCoordIndexInfo struct {
 type DataCoordCollectionInfo struct {
 	NumEntitiesTotal int64
 	IndexInfo        []*DataCoordIndexInfo
+	// IndexSize is the serialized size of the finished indexes in bytes
+	IndexSize int64
 }
 
 type DataCoordCollectionMetrics struct {
@@ -379,6 +698,20 @@ type ImportTask struct {
 	CompleteTime string `json:"complete_time,omitempty"`
 }
 
+type ImportJob struct {
+	JobID          int64  `json:"job_id,omitempty,string"`
+	CollectionID   int64  `json:"collection_id,omitempty,string"`
+	CollectionName string `json:"collection_name,omitempty"`
+	State          string `json:"state,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+	Progress       int64  `json:"progress"`
+	ImportedRows   int64  `json:"imported_rows,string"`
+	TotalRows      int64  `json:"total_rows,string"`
+	Files          int    `json:"files"`
+	CreatedTime    string `json:"created_time,omitempty"`
+	CompleteTime   string `json:"complete_time,omitempty"`
+}
+
 type CompactionTask struct {
 	PlanID         int64    `json:"plan_id,omitempty,string"`
 	CollectionID   int64    `json:"collection_id,omitempty,string"`
@@ -18,6 +18,7 @@ package paramtable
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"path"
 	"strconv"
@@ -174,6 +175,13 @@ func (p *ComponentParam) GetComponentConfigurations(componentName string, sub st
 	return p.baseTable.mgr.GetBy(config.WithSubstr(sub), config.WithOneOfPrefixs(allownPrefixs...))
 }
 
+// GetComponentEffectiveConfigurations returns the effective configurations of the component including the defaults,
+// along with the source of each value.
+func (p *ComponentParam) GetComponentEffectiveConfigurations(componentName string, sub string) map[string]config.ConfigValue {
+	allownPrefixs := append(globalConfigPrefixs(), componentName+".")
+	return p.baseTable.mgr.GetEffectiveBy(config.WithSubstr(sub), config.WithOneOfPrefixs(allownPrefixs...))
+}
+
 func (p *ComponentParam) GetAll() map[string]string {
 	return p.baseTable.mgr.GetConfigs()
 }
@@ -209,6 +217,7 @@ type commonConfig struct {
 	RootCoordTimeTick   ParamItem `refreshable:"true"`
 	RootCoordStatistics ParamItem `refreshable:"true"`
 	RootCoordDml        ParamItem `refreshable:"false"`
+	RootCoordDDLEvent   ParamItem `refreshable:"false"`
 	ReplicateMsgChannel ParamItem `refreshable:"false"`
 
 	QueryCoordTimeTick ParamItem `refreshable:"true"`
@@ -406,6 +415,19 @@ It is recommended to change this parameter before starting Milvus for the first
 	}
 	p.RootCoordDml.Init(base.mgr)
 
+	p.RootCoordDDLEvent = ParamItem{
+		Key:          "msgChannel.chanNamePrefix.rootCoordDDLEvent",
+		DefaultValue: "rootcoord-ddl-event",
+		Version:      "2.6.6",
+		PanicIfEmpty: true,
+		Doc: `Sub-name of the message channel where the root coord publishes the DDL events for the external subscribers,
+it's used only if rootCoord.ddlEvent.enabled is true.
+The complete channel name is ${msgChannel.chanNamePrefix.cluster}-${msgChannel.chanNamePrefix.rootCoordDDLEvent}`,
+		Formatter: chanNamePrefix,
+		Export:    false,
+	}
+	p.RootCoordDDLEvent.Init(base.mgr)
+
 	p.ReplicateMsgChannel = ParamItem{
 		Key:          "msgChannel.chanNamePrefix.replicateMsg",
 		DefaultValue: "replicate-msg",
@@ -1331,6 +1353,7 @@ type traceConfig struct {
 	OtlpSecure         ParamItem `refreshable:"false"`
 	OtlpHeaders        ParamItem `refreshable:"false"`
 	InitTimeoutSeconds ParamItem `refreshable:"false"`
+	EnableExemplar     ParamItem `refreshable:"false"`
 }
 
 func (t *traceConfig) init(base *BaseTable) {
@@ -1405,6 +1428,15 @@ Fractions >= 1 will always sample. Fractions < 0 are treated as zero.`,
 		Doc:          "segcore initialization timeout in seconds, preventing otlp grpc hangs forever",
 	}
 	t.InitTimeoutSeconds.Init(base.mgr)
+
+	t.EnableExemplar = ParamItem{
+		Key:          "trace.exemplar.enabled",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc:          "whether to expose metrics in OpenMetrics format with trace id exemplars attached to the request latency histograms",
+		Export:       false,
+	}
+	t.EnableExemplar.Init(base.mgr)
 }
 
 type holmesConfig struct {
@@ -1619,6 +1651,8 @@ type rootCoordConfig struct {
 	GracefulStopTimeout         ParamItem `refreshable:"true"`
 	UseLockScheduler            ParamItem `refreshable:"true"`
 	DefaultDBProperties         ParamItem `refreshable:"false"`
+	DdlTaskRetention            ParamItem `refreshable:"true"`
+	DDLEventEnabled             ParamItem `refreshable:"false"`
 }
 
 func (p *rootCoordConfig) init(base *BaseTable) {
@@ -1711,6 +1745,25 @@ Segments with smaller size than this parameter will not be indexed, and will be
 		Export:       false,
 	}
 	p.DefaultDBProperties.Init(base.mgr)
+
+	p.DdlTaskRetention = ParamItem{
+		Key:          "rootCoord.ddlTaskRetention",
+		Version:      "2.6.6",
+		DefaultValue: "86400",
+		Doc:          "The retention in seconds of the finished asynchronous ddl task states, the expired states are removed.",
+		Export:       false,
+	}
+	p.DdlTaskRetention.Init(base.mgr)
+
+	p.DDLEventEnabled = ParamItem{
+		Key:          "rootCoord.ddlEvent.enabled",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc: `Whether to publish the DDL events of collections, partitions and aliases to the DDL event channel,
+see msgChannel.chanNamePrefix.rootCoordDDLEvent. The events are published after the DDL is applied and may be duplicated on retries.`,
+		Export: false,
+	}
+	p.DDLEventEnabled.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1778,6 +1831,7 @@ type proxyConfig struct {
 	ConnectionCheckIntervalSeconds ParamItem `refreshable:"true"`
 	ConnectionClientInfoTTLSeconds ParamItem `refreshable:"true"`
 	MaxConnectionNum               ParamItem `refreshable:"true"`
+	ConnectionCursorTTLSeconds     ParamItem `refreshable:"true"`
 
 	GracefulStopTimeout ParamItem `refreshable:"true"`
 
@@ -1786,6 +1840,23 @@ type proxyConfig struct {
 	QueryNodePoolingSize   ParamItem `refreshable:"false"`
 
 	HybridSearchRequeryPolicy ParamItem `refreshable:"true"`
+	PartitionNegativeCacheTTL ParamItem `refreshable:"true"`
+	MaxQueryResponseSize      ParamItem `refreshable:"true"`
+	MaxSearchResponseSize     ParamItem `refreshable:"true"`
+	MutationResultDetailLimit ParamItem `refreshable:"true"`
+	LoadStateRequireIndex     ParamItem `refreshable:"true"`
+	RetryOnStaleCollection    ParamItem `refreshable:"true"`
+
+	TrafficMirrorRatio          ParamItem `refreshable:"true"`
+	TrafficMirrorCollections    ParamItem `refreshable:"true"`
+	TrafficMirrorEndpoint       ParamItem `refreshable:"true"`
+	TrafficMirrorTLSEnabled     ParamItem `refreshable:"true"`
+	TrafficMirrorCaPemPath      ParamItem `refreshable:"true"`
+	TrafficMirrorToken          ParamItem `refreshable:"true"`
+	TrafficMirrorMaxConcurrency ParamItem `refreshable:"true"`
+	TrafficMirrorTimeout        ParamItem `refreshable:"true"`
+
+	InsertChecksumEnabled ParamItem `refreshable:"true"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -2265,6 +2336,15 @@ Disabled if the value is less or equal to 0.`,
 	}
 	p.MaxConnectionNum.Init(base.mgr)
 
+	p.ConnectionCursorTTLSeconds = ParamItem{
+		Key:          "proxy.connectionCursorTTLSeconds",
+		Version:      "2.6.6",
+		Doc:          "the iterator of the client is considered closed if it's not used within the TTL duration, in seconds",
+		DefaultValue: "600",
+		Export:       true,
+	}
+	p.ConnectionCursorTTLSeconds.Init(base.mgr)
+
 	p.SlowQuerySpanInSeconds = ParamItem{
 		Key:          "proxy.slowQuerySpanInSeconds",
 		Version:      "2.3.11",
@@ -2292,6 +2372,159 @@ Disabled if the value is less or equal to 0.`,
 		Export:       true,
 	}
 	p.QueryNodePoolingSize.Init(base.mgr)
+
+	p.PartitionNegativeCacheTTL = ParamItem{
+		Key:          "proxy.partitionNegativeCacheTTL",
+		Version:      "2.6.6",
+		DefaultValue: "10",
+		Doc: `The duration to cache the partition names confirmed not existing, unit: second.
+Within the duration the requests with the missing partition fail fast instead of refreshing the partitions from rootcoord.`,
+		Export: false,
+	}
+	p.PartitionNegativeCacheTTL.Init(base.mgr)
+
+	p.MaxQueryResponseSize = ParamItem{
+		Key:          "proxy.maxQueryResponseSize",
+		Version:      "2.6.6",
+		DefaultValue: "0",
+		Doc: `The max response size of query in bytes, the results beyond it are truncated,
+and the status extra info carries the truncated flag and the offset to continue with.
+0 means disabled, then the query fails once the results exceed quotaAndLimits.limits.maxOutputSize.`,
+		Export: false,
+	}
+	p.MaxQueryResponseSize.Init(base.mgr)
+
+	p.MaxSearchResponseSize = ParamItem{
+		Key:          "proxy.maxSearchResponseSize",
+		Version:      "2.6.6",
+		DefaultValue: "0",
+		Doc: `The max response size of search in bytes, the results of the trailing queries beyond it are truncated,
+and the status extra info carries the truncated flag and the index of the first query not returned.
+0 means disabled, then the search fails once the results exceed quotaAndLimits.limits.maxOutputSize.`,
+		Export: false,
+	}
+	p.MaxSearchResponseSize.Init(base.mgr)
+
+	p.MutationResultDetailLimit = ParamItem{
+		Key:          "proxy.mutationResultDetailLimit",
+		Version:      "2.6.6",
+		DefaultValue: "1000",
+		Doc: `The max number of primary keys carried in the detailed mutation result, which is returned only if
+the request asks for it by the mutation-result-detail header. 0 means the detailed result is disabled.`,
+		Export: false,
+	}
+	p.MutationResultDetailLimit.Init(base.mgr)
+
+	p.LoadStateRequireIndex = ParamItem{
+		Key:          "proxy.loadStateRequireIndex",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc: `Whether the load state of a loaded collection is reported as loading until all the flushed segments
+of the collection are built with the declared indexes.`,
+		Validator: IsBool,
+		Export:    false,
+	}
+	p.LoadStateRequireIndex.Init(base.mgr)
+
+	p.RetryOnStaleCollection = ParamItem{
+		Key:          "proxy.retryOnStaleCollection",
+		Version:      "2.6.6",
+		DefaultValue: "true",
+		Doc: `Whether the search and query are retried inside the proxy if they fail with collection not found,
+and the alias or collection name resolves to another collection now, e.g. the alias is altered while the request is in flight.`,
+		Validator: IsBool,
+		Export:    false,
+	}
+	p.RetryOnStaleCollection.Init(base.mgr)
+
+	p.TrafficMirrorRatio = ParamItem{
+		Key:          "proxy.trafficMirror.ratio",
+		Version:      "2.6.6",
+		DefaultValue: "0",
+		Doc: `The ratio of the search and query requests mirrored to the shadow targets, in [0, 1].
+The mirrored requests are sent in background and their results are discarded, 0 means the mirroring is disabled.`,
+		Validator: FloatRange(0, 1),
+		Export:    true,
+	}
+	p.TrafficMirrorRatio.Init(base.mgr)
+
+	p.TrafficMirrorCollections = ParamItem{
+		Key:          "proxy.trafficMirror.collections",
+		Version:      "2.6.6",
+		DefaultValue: "",
+		Doc: `The shadow collections of the mirrored requests, in the form of "db.collection:shadow_collection" separated by comma,
+the database could be omitted for the collections of the default database, the shadow collection is in the same database as the collection.`,
+		Export: true,
+	}
+	p.TrafficMirrorCollections.Init(base.mgr)
+
+	p.TrafficMirrorEndpoint = ParamItem{
+		Key:          "proxy.trafficMirror.endpoint",
+		Version:      "2.6.6",
+		DefaultValue: "",
+		Doc: `The address of the milvus cluster which receives the mirrored requests as they are, e.g. "localhost:19530".
+The authorization of the request is not forwarded, the requests are authorized by proxy.trafficMirror.token instead.`,
+		Export: true,
+	}
+	p.TrafficMirrorEndpoint.Init(base.mgr)
+
+	p.TrafficMirrorTLSEnabled = ParamItem{
+		Key:          "proxy.trafficMirror.tls.enabled",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc:          "Whether the connection to the traffic mirror endpoint is secured by TLS.",
+		Export:       true,
+	}
+	p.TrafficMirrorTLSEnabled.Init(base.mgr)
+
+	p.TrafficMirrorCaPemPath = ParamItem{
+		Key:          "proxy.trafficMirror.tls.caPemPath",
+		Version:      "2.6.6",
+		DefaultValue: "",
+		Doc:          "The CA certificate to verify the traffic mirror endpoint, the system roots are used if it's empty.",
+		Export:       true,
+	}
+	p.TrafficMirrorCaPemPath.Init(base.mgr)
+
+	p.TrafficMirrorToken = ParamItem{
+		Key:          "proxy.trafficMirror.token",
+		Version:      "2.6.6",
+		DefaultValue: "",
+		Doc: `The token to authorize the requests mirrored to the traffic mirror endpoint, in the form of "username:password" or an api key,
+it should be used with proxy.trafficMirror.tls.enabled, otherwise it's sent in plaintext.`,
+		Export: false,
+	}
+	p.TrafficMirrorToken.Init(base.mgr)
+
+	p.TrafficMirrorMaxConcurrency = ParamItem{
+		Key:          "proxy.trafficMirror.maxConcurrency",
+		Version:      "2.6.6",
+		DefaultValue: "16",
+		Doc:          "The max number of the mirrored requests in flight, the requests beyond it are not mirrored.",
+		Validator:    IntRange(1, 1024),
+		Export:       true,
+	}
+	p.TrafficMirrorMaxConcurrency.Init(base.mgr)
+
+	p.TrafficMirrorTimeout = ParamItem{
+		Key:          "proxy.trafficMirror.timeout",
+		Version:      "2.6.6",
+		DefaultValue: "10",
+		Doc:          "The timeout of the mirrored requests, in seconds.",
+		Export:       true,
+	}
+	p.TrafficMirrorTimeout.Init(base.mgr)
+
+	p.InsertChecksumEnabled = ParamItem{
+		Key:          "proxy.insertChecksum.enabled",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc: `Whether to compute the checksum of each insert batch and carry it in the insert message,
+the datanode verifies it before buffering, so the corruption in the message queue or serialization is detected at write time.`,
+		Validator: IsBool,
+		Export:    true,
+	}
+	p.InsertChecksumEnabled.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -2343,8 +2576,12 @@ type queryCoordConfig struct {
 	HeartbeatAvailableInterval ParamItem `refreshable:"true"`
 	LoadTimeoutSeconds         ParamItem `refreshable:"true"`
 
-	DistributionRequestTimeout ParamItem `refreshable:"true"`
-	HeartBeatWarningLag        ParamItem `refreshable:"true"`
+	DistributionRequestTimeout  ParamItem `refreshable:"true"`
+	HeartBeatWarningLag         ParamItem `refreshable:"true"`
+	NodeLoadScoreWindow         ParamItem `refreshable:"true"`
+	NodeLoadScoreFactor         ParamItem `refreshable:"true"`
+	StoppingBalanceMaxLoadScore ParamItem `refreshable:"true"`
+	EnableStoppingPrefetch      ParamItem `refreshable:"true"`
 
 	// Deprecated: Since 2.2.2, QueryCoord do not use HandOff logic anymore
 	CheckHandoffInterval ParamItem `refreshable:"true"`
@@ -2358,6 +2595,8 @@ type queryCoordConfig struct {
 	CheckNodeInReplicaInterval     ParamItem `refreshable:"false"`
 	CheckResourceGroupInterval     ParamItem `refreshable:"false"`
 	LeaderViewUpdateInterval       ParamItem `refreshable:"false"`
+	LeaderDivergenceRepair         ParamItem `refreshable:"true"`
+	LeaderDivergenceGracePeriod    ParamItem `refreshable:"true"`
 	EnableRGAutoRecover            ParamItem `refreshable:"true"`
 	CheckHealthInterval            ParamItem `refreshable:"false"`
 	CheckHealthRPCTimeout          ParamItem `refreshable:"true"`
@@ -2387,6 +2626,14 @@ type queryCoordConfig struct {
 	QueryNodeTaskParallelismFactor ParamItem `refreshable:"true"`
 
 	BalanceCheckCollectionMaxCount ParamItem `refreshable:"true"`
+
+	// segment load failure quarantine
+	SegmentQuarantineFailThreshold    ParamItem `refreshable:"true"`
+	SegmentQuarantineRetryBaseSeconds ParamItem `refreshable:"true"`
+	SegmentQuarantineRetryMaxSeconds  ParamItem `refreshable:"true"`
+	PartialLoadRatio                  ParamItem `refreshable:"true"`
+	AutoReleaseEnabled                ParamItem `refreshable:"true"`
+	AutoReleaseCheckInterval          ParamItem `refreshable:"false"`
 }
 
 func (p *queryCoordConfig) init(base *BaseTable) {
@@ -2784,6 +3031,25 @@ If this parameter is set false, Milvus simply searches the growing segments with
 	}
 	p.LeaderViewUpdateInterval.Init(base.mgr)
 
+	p.LeaderDivergenceRepair = ParamItem{
+		Key:          "queryCoord.leaderDivergence.repair",
+		Version:      "2.6.6",
+		DefaultValue: "true",
+		Validator:    IsBool,
+		Doc:          "whether to repair the shard leader view which routes a segment to a querynode not serving it, the divergence is only reported if disabled",
+		Export:       true,
+	}
+	p.LeaderDivergenceRepair.Init(base.mgr)
+
+	p.LeaderDivergenceGracePeriod = ParamItem{
+		Key:          "queryCoord.leaderDivergence.gracePeriod",
+		Version:      "2.6.6",
+		DefaultValue: "30",
+		Doc:          "the duration(in seconds) a shard leader view keeps diverging from the distribution before it's repaired, to tolerate the lag of the distribution",
+		Export:       true,
+	}
+	p.LeaderDivergenceGracePeriod.Init(base.mgr)
+
 	p.EnableRGAutoRecover = ParamItem{
 		Key:          "queryCoord.enableRGAutoRecover",
 		Version:      "2.2.3",
@@ -2891,6 +3157,50 @@ If this parameter is set false, Milvus simply searches the growing segments with
 	}
 	p.HeartBeatWarningLag.Init(base.mgr)
 
+	p.NodeLoadScoreWindow = ParamItem{
+		Key:          "queryCoord.nodeLoadScoreWindow",
+		Version:      "2.6.6",
+		DefaultValue: "60",
+		Doc: `The window of the load samples reported by the querynode heartbeats, in seconds.
+The load score of the querynode is the average of the samples within the window.`,
+		Export: false,
+	}
+	p.NodeLoadScoreWindow.Init(base.mgr)
+
+	p.NodeLoadScoreFactor = ParamItem{
+		Key:          "queryCoord.nodeLoadScoreFactor",
+		Version:      "2.6.6",
+		DefaultValue: "0",
+		Doc: `The weight of the load score of the querynode when balancing segments and channels,
+the score of the node is amplified by (1 + factor * loadScore), where the load score is in [0, 1].
+0 means the balancer only considers the row count and channel count of the nodes.`,
+		Validator: FloatRange(0, 100),
+		Export:    false,
+	}
+	p.NodeLoadScoreFactor.Init(base.mgr)
+
+	p.StoppingBalanceMaxLoadScore = ParamItem{
+		Key:          "queryCoord.stoppingBalanceMaxLoadScore",
+		Version:      "2.6.6",
+		DefaultValue: "0.9",
+		Doc: `The querynodes whose load score is above it don't receive the segments and channels of the stopping querynodes,
+unless all the querynodes are above it. The load score is in [0, 1], 1 means the load score is ignored by the stopping balance.`,
+		Validator: FloatRange(0, 1),
+		Export:    false,
+	}
+	p.StoppingBalanceMaxLoadScore.Init(base.mgr)
+
+	p.EnableStoppingPrefetch = ParamItem{
+		Key:          "queryCoord.enableStoppingPrefetch",
+		Version:      "2.6.6",
+		DefaultValue: "true",
+		Doc: `Whether the segments moved off the stopping querynodes are prefetched by the target querynodes,
+the target querynode fetches all the segment files into the cache before reporting the segment as loaded,
+so the source querynode keeps serving the segment until the switch-over.`,
+		Export: false,
+	}
+	p.EnableStoppingPrefetch.Init(base.mgr)
+
 	p.GracefulStopTimeout = ParamItem{
 		Key:          "queryCoord.gracefulStopTimeout",
 		Version:      "2.3.7",
@@ -3028,6 +3338,68 @@ If this parameter is set false, Milvus simply searches the growing segments with
 		Export:       false,
 	}
 	p.BalanceCheckCollectionMaxCount.Init(base.mgr)
+
+	p.SegmentQuarantineFailThreshold = ParamItem{
+		Key:          "queryCoord.segmentQuarantine.failThreshold",
+		Version:      "2.6.6",
+		DefaultValue: "3",
+		Doc:          "the number of consecutive load failures before a segment is quarantined",
+		Export:       false,
+	}
+	p.SegmentQuarantineFailThreshold.Init(base.mgr)
+
+	p.SegmentQuarantineRetryBaseSeconds = ParamItem{
+		Key:          "queryCoord.segmentQuarantine.retryBaseSeconds",
+		Version:      "2.6.6",
+		DefaultValue: "10",
+		Doc:          "the initial backoff interval in seconds before retrying to load a failed segment, doubled on each failure",
+		Export:       false,
+	}
+	p.SegmentQuarantineRetryBaseSeconds.Init(base.mgr)
+
+	p.SegmentQuarantineRetryMaxSeconds = ParamItem{
+		Key:          "queryCoord.segmentQuarantine.retryMaxSeconds",
+		Version:      "2.6.6",
+		DefaultValue: "600",
+		Doc:          "the max backoff interval in seconds before retrying to load a failed segment",
+		Export:       false,
+	}
+	p.SegmentQuarantineRetryMaxSeconds.Init(base.mgr)
+
+	p.PartialLoadRatio = ParamItem{
+		Key:          "queryCoord.partialLoadRatio",
+		Version:      "2.6.6",
+		DefaultValue: "1",
+		Doc: `the minimum ratio of loaded segments in target to mark a collection as partially loaded,
+when the remaining segments are all quarantined. 1 means partial load is disabled`,
+		Export: false,
+	}
+	p.PartialLoadRatio.Init(base.mgr)
+
+	p.AutoReleaseEnabled = ParamItem{
+		Key:          "queryCoord.autoRelease.enabled",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc: `whether to release the collections not searched or queried for the idle hours set in the collection property,
+the released collections are reloaded on the next search`,
+		Export: false,
+	}
+	p.AutoReleaseEnabled.Init(base.mgr)
+
+	p.AutoReleaseCheckInterval = ParamItem{
+		Key:          "queryCoord.autoRelease.checkInterval",
+		Version:      "2.6.6",
+		DefaultValue: "600",
+		Formatter: func(v string) string {
+			if getAsInt64(v) <= 0 {
+				return "600"
+			}
+			return v
+		},
+		Doc:    "the interval in seconds to check the idle collections to release, non-positive values fall back to the default",
+		Export: false,
+	}
+	p.AutoReleaseCheckInterval.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -3110,6 +3482,8 @@ type queryNodeConfig struct {
 	LazyLoadRequestResourceRetryInterval ParamItem `refreshable:"true"`
 	LazyLoadMaxRetryTimes                ParamItem `refreshable:"true"`
 	LazyLoadMaxEvictPerRetry             ParamItem `refreshable:"true"`
+	LazyLoadIndexEnabled                 ParamItem `refreshable:"false"`
+	LazyLoadIndexWarmup                  ParamItem `refreshable:"true"`
 
 	IndexOffsetCacheEnabled ParamItem `refreshable:"true"`
 
@@ -3120,8 +3494,10 @@ type queryNodeConfig struct {
 	MaxUnsolvedQueueSize  ParamItem `refreshable:"true"`
 	MaxReadConcurrency    ParamItem `refreshable:"true"`
 	MaxGpuReadConcurrency ParamItem `refreshable:"false"`
+	QueryMemoryLimitRatio ParamItem `refreshable:"true"`
 	MaxGroupNQ            ParamItem `refreshable:"true"`
 	TopKMergeRatio        ParamItem `refreshable:"true"`
+	GroupingMergeWindow   ParamItem `refreshable:"true"`
 	CPURatio              ParamItem `refreshable:"true"`
 	GracefulStopTimeout   ParamItem `refreshable:"false"`
 
@@ -3180,6 +3556,10 @@ type queryNodeConfig struct {
 	SkipGrowingSegmentBF           ParamItem `refreshable:"true"`
 	BloomFilterApplyParallelFactor ParamItem `refreshable:"true"`
 
+	// reduce
+	ParallelReduceTopKThreshold ParamItem `refreshable:"true"`
+	ReduceSpillThreshold        ParamItem `refreshable:"true"`
+
 	// worker
 	WorkerPoolingSize ParamItem `refreshable:"false"`
 
@@ -3248,6 +3628,7 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 		Key:          "queryNode.segcore.tieredStorage.warmup.scalarField",
 		Version:      "2.6.0",
 		DefaultValue: "sync",
+		Validator:    OneOf("sync", "disable"),
 		Doc: `options: sync, disable.
 Specifies the timing for warming up the Tiered Storage cache.
 - "sync": data will be loaded into the cache before a segment is considered loaded.
@@ -3261,6 +3642,7 @@ Defaults to "sync", except for vector field which defaults to "disable".`,
 		Key:          "queryNode.segcore.tieredStorage.warmup.scalarIndex",
 		Version:      "2.6.0",
 		DefaultValue: "sync",
+		Validator:    OneOf("sync", "disable"),
 		Export:       true,
 	}
 	p.TieredWarmupScalarIndex.Init(base.mgr)
@@ -3269,6 +3651,7 @@ Defaults to "sync", except for vector field which defaults to "disable".`,
 		Key:          "queryNode.segcore.tieredStorage.warmup.vectorField",
 		Version:      "2.6.0",
 		DefaultValue: "disable",
+		Validator:    OneOf("sync", "disable"),
 		Doc:          `cache warmup for vector field raw data is by default disabled.`,
 		Export:       true,
 	}
@@ -3278,6 +3661,7 @@ Defaults to "sync", except for vector field which defaults to "disable".`,
 		Key:          "queryNode.segcore.tieredStorage.warmup.vectorIndex",
 		Version:      "2.6.0",
 		DefaultValue: "sync",
+		Validator:    OneOf("sync", "disable"),
 		Export:       true,
 	}
 	p.TieredWarmupVectorIndex.Init(base.mgr)
@@ -3630,7 +4014,8 @@ This defaults to true, indicating that Milvus creates temporary index for growin
 		Key:          "queryNode.segcore.multipleChunkedEnable",
 		Version:      "2.0.0",
 		DefaultValue: "true",
-		Doc:          "Deprecated. Enable multiple chunked search",
+		Doc:          "Enable multiple chunked search",
+		Deprecated:   "it takes no effect",
 		Export:       true,
 	}
 	p.MultipleChunkedEnable.Init(base.mgr)
@@ -3728,7 +4113,8 @@ This defaults to true, indicating that Milvus creates temporary index for growin
 		Version:      "2.0.0",
 		DefaultValue: "2147483648",
 		PanicIfEmpty: true,
-		Doc:          "Deprecated: 2 GB, 2 * 1024 *1024 *1024",
+		Doc:          "2 GB, 2 * 1024 *1024 *1024",
+		Deprecated:   "it takes no effect",
 		Export:       true,
 	}
 	p.CacheMemoryLimit.Init(base.mgr)
@@ -3910,6 +4296,29 @@ However, this optimization may come at the cost of a slight decrease in query la
 	}
 	p.LazyLoadMaxEvictPerRetry.Init(base.mgr)
 
+	p.LazyLoadIndexEnabled = ParamItem{
+		Key:          "queryNode.lazyload.indexEnabled",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc: `Enable lazyload for the vector indexes of sealed segments.
+Only the index meta is kept when the segment is loaded, and the index data is loaded on the first search of the field.
+The loaded index data could be evicted under memory pressure once the eviction of tiered storage is enabled.`,
+		Export: true,
+	}
+	p.LazyLoadIndexEnabled.Init(base.mgr)
+
+	p.LazyLoadIndexWarmup = ParamItem{
+		Key:          "queryNode.lazyload.indexWarmup",
+		Version:      "2.6.6",
+		DefaultValue: "disable",
+		Validator:    OneOf("async", "disable"),
+		Doc: `options: async, disable.
+- "async": the lazyload indexes are loaded in background after the segment is loaded.
+- "disable": the lazyload indexes are loaded only if needed by search tasks.`,
+		Export: true,
+	}
+	p.LazyLoadIndexWarmup.Init(base.mgr)
+
 	p.ReadAheadPolicy = ParamItem{
 		Key:          "queryNode.cache.readAheadPolicy",
 		Version:      "2.3.2",
@@ -3967,6 +4376,16 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 	}
 	p.MaxGpuReadConcurrency.Init(base.mgr)
 
+	p.QueryMemoryLimitRatio = ParamItem{
+		Key:          "queryNode.scheduler.queryMemoryLimitRatio",
+		Version:      "2.6.6",
+		DefaultValue: "0",
+		Doc: `The ratio of the memory of the node could be used by the running search/query tasks, 0 means no limit.
+When the estimated memory of the running tasks exceeds the limit, the most expensive one is canceled.`,
+		Export: false,
+	}
+	p.QueryMemoryLimitRatio.Init(base.mgr)
+
 	p.MaxUnsolvedQueueSize = ParamItem{
 		Key:          "queryNode.scheduler.unsolvedQueueSize",
 		Version:      "2.0.0",
@@ -3991,6 +4410,16 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 	}
 	p.TopKMergeRatio.Init(base.mgr)
 
+	p.GroupingMergeWindow = ParamItem{
+		Key:          "queryNode.grouping.mergeWindow",
+		Version:      "2.6.6",
+		DefaultValue: "0",
+		Doc: `The time in milliseconds a small nq search task waits in the scheduler for the following tasks to merge with,
+the task is executed once the merged nq reaches queryNode.grouping.maxNQ or the window passes, 0 means no waiting.`,
+		Export: false,
+	}
+	p.GroupingMergeWindow.Init(base.mgr)
+
 	p.CPURatio = ParamItem{
 		Key:          "queryNode.scheduler.cpuRatio",
 		Version:      "2.0.0",
@@ -4310,6 +4739,27 @@ user-task-polling:
 	}
 	p.BloomFilterApplyParallelFactor.Init(base.mgr)
 
+	p.ParallelReduceTopKThreshold = ParamItem{
+		Key:          "queryNode.reduce.parallelTopKThreshold",
+		Version:      "2.6.6",
+		DefaultValue: "10000",
+		Doc: `The search results are reduced in a merge tree concurrently if the topK is not less than this threshold,
+which reduces the latency of reducing very large topK across many segments. 0 means disabled.`,
+		Validator: IntRange(0, math.MaxInt32),
+		Export:    true,
+	}
+	p.ParallelReduceTopKThreshold.Init(base.mgr)
+
+	p.ReduceSpillThreshold = ParamItem{
+		Key:          "queryNode.reduce.spillThreshold",
+		Version:      "2.6.6",
+		DefaultValue: "256m",
+		Doc: `The max memory size of the intermediate results of a parallel reduce,
+the intermediate results exceeding it will be spilled into the local storage. 0 means never spill.`,
+		Export: true,
+	}
+	p.ReduceSpillThreshold.Init(base.mgr)
+
 	p.EnableSparseFilterInQuery = ParamItem{
 		Key:          "queryNode.enableSparseFilterInQuery",
 		Version:      "2.6.2",
@@ -4359,9 +4809,12 @@ type dataCoordConfig struct {
 	// --- SEGMENTS ---
 	SegmentMaxSize                 ParamItem `refreshable:"false"`
 	DiskSegmentMaxSize             ParamItem `refreshable:"true"`
+	SegmentMaxSizeByIndexType      ParamItem `refreshable:"true"`
 	SegmentSealProportion          ParamItem `refreshable:"false"`
 	SegmentSealProportionJitter    ParamItem `refreshable:"true"`
 	SegAssignmentExpiration        ParamItem `refreshable:"false"`
+	SegmentPreSplitNum             ParamItem `refreshable:"true"`
+	SegmentPreSplitMinRowsPerSec   ParamItem `refreshable:"true"`
 	AllocLatestExpireAttempt       ParamItem `refreshable:"true"`
 	SegmentMaxLifetime             ParamItem `refreshable:"false"`
 	SegmentMaxIdleTime             ParamItem `refreshable:"false"`
@@ -4375,6 +4828,8 @@ type dataCoordConfig struct {
 	BlockingL0EntryNum             ParamItem `refreshable:"true"`
 	BlockingL0SizeInMB             ParamItem `refreshable:"true"`
 	DVForceAllIndexReady           ParamItem `refreshable:"true"`
+	QuarantineScanInterval         ParamItem `refreshable:"false"`
+	QuarantineScanBatchSize        ParamItem `refreshable:"true"`
 
 	// compaction
 	EnableCompaction                       ParamItem `refreshable:"false"`
@@ -4384,22 +4839,28 @@ type dataCoordConfig struct {
 	CompactionTaskQueueCapacity            ParamItem `refreshable:"false"`
 	CompactionPreAllocateIDExpansionFactor ParamItem `refreshable:"false"`
 
-	CompactionRPCTimeout             ParamItem `refreshable:"true"`
-	CompactionMaxParallelTasks       ParamItem `refreshable:"true"`
-	CompactionWorkerParallelTasks    ParamItem `refreshable:"true"`
-	MinSegmentToMerge                ParamItem `refreshable:"true"`
-	SegmentSmallProportion           ParamItem `refreshable:"true"`
-	SegmentCompactableProportion     ParamItem `refreshable:"true"`
-	SegmentExpansionRate             ParamItem `refreshable:"true"`
-	CompactionTimeoutInSeconds       ParamItem `refreshable:"true"` // deprecated
-	CompactionDropToleranceInSeconds ParamItem `refreshable:"true"`
-	CompactionGCIntervalInSeconds    ParamItem `refreshable:"true"`
-	CompactionCheckIntervalInSeconds ParamItem `refreshable:"false"` // deprecated
-	CompactionScheduleInterval       ParamItem `refreshable:"false"`
-	MixCompactionTriggerInterval     ParamItem `refreshable:"false"`
-	L0CompactionTriggerInterval      ParamItem `refreshable:"false"`
-	GlobalCompactionInterval         ParamItem `refreshable:"false"`
-	CompactionExpiryTolerance        ParamItem `refreshable:"true"`
+	CompactionRPCTimeout                ParamItem `refreshable:"true"`
+	CompactionMaxParallelTasks          ParamItem `refreshable:"true"`
+	CompactionWorkerParallelTasks       ParamItem `refreshable:"true"`
+	CompactionParallelismAutoTune       ParamItem `refreshable:"true"`
+	CompactionMinParallelTasks          ParamItem `refreshable:"true"`
+	CompactionMaxParallelTasksPerNode   ParamItem `refreshable:"true"`
+	CompactionTargetPlanDuration        ParamItem `refreshable:"true"`
+	CompactionMaxIngestLag              ParamItem `refreshable:"true"`
+	CompactionParallelismAdjustInterval ParamItem `refreshable:"true"`
+	MinSegmentToMerge                   ParamItem `refreshable:"true"`
+	SegmentSmallProportion              ParamItem `refreshable:"true"`
+	SegmentCompactableProportion        ParamItem `refreshable:"true"`
+	SegmentExpansionRate                ParamItem `refreshable:"true"`
+	CompactionTimeoutInSeconds          ParamItem `refreshable:"true"` // deprecated
+	CompactionDropToleranceInSeconds    ParamItem `refreshable:"true"`
+	CompactionGCIntervalInSeconds       ParamItem `refreshable:"true"`
+	CompactionCheckIntervalInSeconds    ParamItem `refreshable:"false"` // deprecated
+	CompactionScheduleInterval          ParamItem `refreshable:"false"`
+	MixCompactionTriggerInterval        ParamItem `refreshable:"false"`
+	L0CompactionTriggerInterval         ParamItem `refreshable:"false"`
+	GlobalCompactionInterval            ParamItem `refreshable:"false"`
+	CompactionExpiryTolerance           ParamItem `refreshable:"true"`
 
 	SingleCompactionRatioThreshold    ParamItem `refreshable:"true"`
 	SingleCompactionDeltaLogMaxSize   ParamItem `refreshable:"true"`
@@ -4436,14 +4897,17 @@ type dataCoordConfig struct {
 	LevelZeroCompactionTriggerDeltalogMaxNum ParamItem `refreshable:"true"`
 
 	// Garbage Collection
-	EnableGarbageCollection     ParamItem `refreshable:"false"`
-	GCInterval                  ParamItem `refreshable:"false"`
-	GCMissingTolerance          ParamItem `refreshable:"false"`
-	GCDropTolerance             ParamItem `refreshable:"false"`
-	GCRemoveConcurrent          ParamItem `refreshable:"false"`
-	GCScanIntervalInHour        ParamItem `refreshable:"false"`
-	GCSlowDownCPUUsageThreshold ParamItem `refreshable:"false"`
-	EnableActiveStandby         ParamItem `refreshable:"false"`
+	EnableGarbageCollection      ParamItem `refreshable:"false"`
+	GCInterval                   ParamItem `refreshable:"false"`
+	GCMissingTolerance           ParamItem `refreshable:"false"`
+	GCDropTolerance              ParamItem `refreshable:"false"`
+	GCRespectTimeTravelWatermark ParamItem `refreshable:"true"`
+	GCRemoveConcurrent           ParamItem `refreshable:"false"`
+	GCScanIntervalInHour         ParamItem `refreshable:"false"`
+	GCSlowDownCPUUsageThreshold  ParamItem `refreshable:"false"`
+	GCIndexOrphanGracePeriod     ParamItem `refreshable:"true"`
+	GCIndexDryRun                ParamItem `refreshable:"true"`
+	EnableActiveStandby          ParamItem `refreshable:"false"`
 
 	BindIndexNodeMode    ParamItem `refreshable:"false"`
 	IndexNodeAddress     ParamItem `refreshable:"false"`
@@ -4472,6 +4936,9 @@ type dataCoordConfig struct {
 	ImportPreAllocIDExpansionFactor ParamItem `refreshable:"true"`
 	ImportFileNumPerSlot            ParamItem `refreshable:"true"`
 	ImportMemoryLimitPerSlot        ParamItem `refreshable:"true"`
+	MaxImportJobNumPerCollection    ParamItem `refreshable:"true"`
+	ImportTaskRetryBackoffBase      ParamItem `refreshable:"true"`
+	ImportTaskRetryBackoffMax       ParamItem `refreshable:"true"`
 
 	GracefulStopTimeout ParamItem `refreshable:"true"`
 
@@ -4484,6 +4951,7 @@ type dataCoordConfig struct {
 
 	EnableSortCompaction             ParamItem `refreshable:"true"`
 	TaskCheckInterval                ParamItem `refreshable:"true"`
+	StatslogRebuildJobRetention      ParamItem `refreshable:"true"`
 	SortCompactionTriggerCount       ParamItem `refreshable:"true"`
 	JSONStatsTriggerCount            ParamItem `refreshable:"true"`
 	JSONStatsTriggerInterval         ParamItem `refreshable:"true"`
@@ -4491,7 +4959,9 @@ type dataCoordConfig struct {
 	JSONStatsShreddingRatioThreshold ParamItem `refreshable:"true"`
 	JSONStatsWriteBatchSize          ParamItem `refreshable:"true"`
 
-	RequestTimeoutSeconds ParamItem `refreshable:"true"`
+	RequestTimeoutSeconds          ParamItem `refreshable:"true"`
+	SegmentManifestSignedURLExpiry ParamItem `refreshable:"true"`
+	FlushAndSealTimeout            ParamItem `refreshable:"true"`
 }
 
 func (p *dataCoordConfig) init(base *BaseTable) {
@@ -4567,6 +5037,17 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	}
 	p.DiskSegmentMaxSize.Init(base.mgr)
 
+	p.SegmentMaxSizeByIndexType = ParamItem{
+		Key:          "dataCoord.segment.maxSizeByIndexType",
+		Version:      "2.6.6",
+		DefaultValue: "{}",
+		Doc: `The maximum size of a segment in MB by the index type of the vector fields, e.g. {"HNSW": 512, "DISKANN": 4096}.
+The smallest size of the vector fields is used, the fields with the index type not listed fall back to
+dataCoord.segment.diskSegmentMaxSize or dataCoord.segment.maxSize. It could be overridden by the collection property collection.segment.maxSize.`,
+		Export: false,
+	}
+	p.SegmentMaxSizeByIndexType.Init(base.mgr)
+
 	p.SegmentSealProportion = ParamItem{
 		Key:          "dataCoord.segment.sealProportion",
 		Version:      "2.0.0",
@@ -4594,6 +5075,26 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	}
 	p.SegAssignmentExpiration.Init(base.mgr)
 
+	p.SegmentPreSplitNum = ParamItem{
+		Key:          "dataCoord.segment.preSplit.num",
+		Version:      "2.6.6",
+		DefaultValue: "1",
+		Validator:    IntRange(1, 64),
+		Doc: `The number of growing segments kept open per vchannel and partition by the streaming node to round-robin the inserts among,
+for the partitions ingesting faster than a single growing segment can be sealed and flushed. 1 means disabled.`,
+		Export: true,
+	}
+	p.SegmentPreSplitNum.Init(base.mgr)
+
+	p.SegmentPreSplitMinRowsPerSec = ParamItem{
+		Key:          "dataCoord.segment.preSplit.minRowsPerSecond",
+		Version:      "2.6.6",
+		DefaultValue: "0",
+		Doc:          "The min rate of the rows inserted into a vchannel partition to pre-split its growing segments, 0 means pre-splitting all the partitions.",
+		Export:       true,
+	}
+	p.SegmentPreSplitMinRowsPerSec.Init(base.mgr)
+
 	p.AllocLatestExpireAttempt = ParamItem{
 		Key:          "dataCoord.segment.allocLatestExpireAttempt",
 		Version:      "2.2.0",
@@ -4681,6 +5182,25 @@ exceeds this threshold, the earliest growing segments will be sealed.`,
 	}
 	p.DVForceAllIndexReady.Init(base.mgr)
 
+	p.QuarantineScanInterval = ParamItem{
+		Key:          "dataCoord.segment.quarantine.scanInterval",
+		Version:      "2.6.6",
+		DefaultValue: "600",
+		Doc:          "The time interval in seconds to validate the logs of a batch of flushed segments, the segments with lost logs are quarantined",
+		Validator:    IntRange(1, math.MaxInt32),
+		Export:       true,
+	}
+	p.QuarantineScanInterval.Init(base.mgr)
+
+	p.QuarantineScanBatchSize = ParamItem{
+		Key:          "dataCoord.segment.quarantine.scanBatchSize",
+		Version:      "2.6.6",
+		DefaultValue: "100",
+		Doc:          "The number of flushed segments validated in each scan, 0 disables the scan and only the segments failed to be indexed or compacted are validated",
+		Export:       true,
+	}
+	p.QuarantineScanBatchSize.Init(base.mgr)
+
 	p.EnableCompaction = ParamItem{
 		Key:          "dataCoord.enableCompaction",
 		Version:      "2.0.0",
@@ -4750,11 +5270,74 @@ mix is prioritized by level: mix compactions first, then L0 compactions, then cl
 		Key:          "dataCoord.compaction.maxParallelTaskNum",
 		Version:      "2.2.12",
 		DefaultValue: "-1",
-		Doc:          "Deprecated, see datanode.slot.slotCap",
+		Deprecated:   "see dataNode.slot.slotCap",
 		Export:       true,
 	}
 	p.CompactionMaxParallelTasks.Init(base.mgr)
 
+	p.CompactionParallelismAutoTune = ParamItem{
+		Key:          "dataCoord.compaction.parallelism.autoTune",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc: `Whether to adjust the number of concurrent compaction tasks on each datanode by the feedback of the finished tasks.
+The parallelism is increased while the tasks finish in time and there are tasks queued,
+and decreased if the tasks get slow or the ingest of the vchannels being compacted on the datanode lags behind.
+It never exceeds the slots of datanode.`,
+		Validator: IsBool,
+		Export:    true,
+	}
+	p.CompactionParallelismAutoTune.Init(base.mgr)
+
+	p.CompactionMinParallelTasks = ParamItem{
+		Key:          "dataCoord.compaction.parallelism.minPerNode",
+		Version:      "2.6.6",
+		DefaultValue: "1",
+		Doc:          "The minimum number of concurrent compaction tasks on each datanode when the parallelism is auto tuned",
+		Validator:    IntRange(1, math.MaxInt32),
+		Export:       true,
+	}
+	p.CompactionMinParallelTasks.Init(base.mgr)
+
+	p.CompactionMaxParallelTasksPerNode = ParamItem{
+		Key:          "dataCoord.compaction.parallelism.maxPerNode",
+		Version:      "2.6.6",
+		DefaultValue: "16",
+		Doc:          "The maximum number of concurrent compaction tasks on each datanode when the parallelism is auto tuned",
+		Validator:    IntRange(1, math.MaxInt32),
+		Export:       true,
+	}
+	p.CompactionMaxParallelTasksPerNode.Init(base.mgr)
+
+	p.CompactionTargetPlanDuration = ParamItem{
+		Key:          "dataCoord.compaction.parallelism.targetPlanDuration",
+		Version:      "2.6.6",
+		DefaultValue: "600",
+		Doc:          "The parallelism of datanode is decreased if the average duration of its compaction tasks exceeds this time(in seconds)",
+		Validator:    IntRange(1, math.MaxInt32),
+		Export:       true,
+	}
+	p.CompactionTargetPlanDuration.Init(base.mgr)
+
+	p.CompactionMaxIngestLag = ParamItem{
+		Key:          "dataCoord.compaction.parallelism.maxIngestLag",
+		Version:      "2.6.6",
+		DefaultValue: "300",
+		Doc:          "The parallelism of a datanode is decreased if the checkpoint of a vchannel being compacted on it lags behind more than this time(in seconds)",
+		Validator:    IntRange(1, math.MaxInt32),
+		Export:       true,
+	}
+	p.CompactionMaxIngestLag.Init(base.mgr)
+
+	p.CompactionParallelismAdjustInterval = ParamItem{
+		Key:          "dataCoord.compaction.parallelism.adjustInterval",
+		Version:      "2.6.6",
+		DefaultValue: "30",
+		Doc:          "The time interval in seconds to adjust the parallelism of compaction",
+		Validator:    IntRange(1, math.MaxInt32),
+		Export:       true,
+	}
+	p.CompactionParallelismAdjustInterval.Init(base.mgr)
+
 	p.MinSegmentToMerge = ParamItem{
 		Key:          "dataCoord.compaction.min.segment",
 		Version:      "2.0.0",
@@ -4880,7 +5463,7 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 		Key:          "dataCoord.compaction.global.interval",
 		Version:      "2.0.0",
 		DefaultValue: "60",
-		Doc:          "deprecated",
+		Deprecated:   "it takes no effect",
 	}
 	p.GlobalCompactionInterval.Init(base.mgr)
 
@@ -5161,6 +5744,18 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.GCDropTolerance.Init(base.mgr)
 
+	p.GCRespectTimeTravelWatermark = ParamItem{
+		Key:          "dataCoord.gc.respectTimeTravelWatermark",
+		Version:      "2.6.6",
+		DefaultValue: "true",
+		Doc: `Whether to keep the dropped segments which may still be read by the running search and query requests,
+the oldest timestamp of the requests is reported by the querynode heartbeats to querycoord and fetched before the dropped segments are recycled.
+If it's unavailable, the dropped segments are only retained by dataCoord.gc.dropTolerance.`,
+		Validator: IsBool,
+		Export:    true,
+	}
+	p.GCRespectTimeTravelWatermark.Init(base.mgr)
+
 	p.GCRemoveConcurrent = ParamItem{
 		Key:          "dataCoord.gc.removeConcurrent",
 		Version:      "2.3.4",
@@ -5177,6 +5772,24 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.GCRemoveConcurrent.Init(base.mgr)
 
+	p.GCIndexOrphanGracePeriod = ParamItem{
+		Key:          "dataCoord.gc.index.orphanGracePeriod",
+		Version:      "2.6.6",
+		DefaultValue: "86400",
+		Doc:          "The index files unreferenced by the index meta are removed only if they are older than the grace period, unit: second",
+		Export:       false,
+	}
+	p.GCIndexOrphanGracePeriod.Init(base.mgr)
+
+	p.GCIndexDryRun = ParamItem{
+		Key:          "dataCoord.gc.index.dryRun",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc:          "Only report the index meta and index files to recycle without removing them",
+		Export:       false,
+	}
+	p.GCIndexDryRun.Init(base.mgr)
+
 	p.EnableActiveStandby = ParamItem{
 		Key:          "dataCoord.enableActiveStandby",
 		Version:      "2.0.0",
@@ -5433,6 +6046,39 @@ if param targetVecIndexVersion is not set, the default value is -1, which means
 	}
 	p.ImportMemoryLimitPerSlot.Init(base.mgr)
 
+	p.MaxImportJobNumPerCollection = ParamItem{
+		Key:          "dataCoord.import.maxExecutingJobNumPerCollection",
+		Version:      "2.6.6",
+		Doc:          "Maximum number of executing import jobs of a collection, the other jobs keep pending. 0 means no limit.",
+		DefaultValue: "0",
+		PanicIfEmpty: false,
+		Validator:    IntRange(0, math.MaxInt32),
+		Export:       true,
+	}
+	p.MaxImportJobNumPerCollection.Init(base.mgr)
+
+	p.ImportTaskRetryBackoffBase = ParamItem{
+		Key:          "dataCoord.import.taskRetryBackoffBase",
+		Version:      "2.6.6",
+		Doc:          "The initial backoff in seconds before retrying a failed pre-import/import task, it's doubled on each failure.",
+		DefaultValue: "2",
+		PanicIfEmpty: false,
+		Validator:    IntRange(0, math.MaxInt32),
+		Export:       true,
+	}
+	p.ImportTaskRetryBackoffBase.Init(base.mgr)
+
+	p.ImportTaskRetryBackoffMax = ParamItem{
+		Key:          "dataCoord.import.taskRetryBackoffMax",
+		Version:      "2.6.6",
+		Doc:          "The maximum backoff in seconds before retrying a failed pre-import/import task.",
+		DefaultValue: "120",
+		PanicIfEmpty: false,
+		Validator:    IntRange(0, math.MaxInt32),
+		Export:       true,
+	}
+	p.ImportTaskRetryBackoffMax.Init(base.mgr)
+
 	p.GracefulStopTimeout = ParamItem{
 		Key:          "dataCoord.gracefulStopTimeout",
 		Version:      "2.3.7",
@@ -5545,6 +6191,16 @@ if param targetVecIndexVersion is not set, the default value is -1, which means
 	}
 	p.TaskCheckInterval.Init(base.mgr)
 
+	p.StatslogRebuildJobRetention = ParamItem{
+		Key:          "dataCoord.statslogRebuild.jobRetention",
+		Version:      "2.6.6",
+		Doc:          "The retention period in seconds for the statslog rebuild jobs in the Finished or Failed state.",
+		DefaultValue: "86400",
+		PanicIfEmpty: false,
+		Export:       false,
+	}
+	p.StatslogRebuildJobRetention.Init(base.mgr)
+
 	p.SortCompactionTriggerCount = ParamItem{
 		Key:          "dataCoord.sortCompactionTriggerCount",
 		Version:      "2.5.5",
@@ -5624,6 +6280,24 @@ if param targetVecIndexVersion is not set, the default value is -1, which means
 		Export:       true,
 	}
 	p.JSONStatsWriteBatchSize.Init(base.mgr)
+
+	p.SegmentManifestSignedURLExpiry = ParamItem{
+		Key:          "dataCoord.segmentManifest.signedURLExpiry",
+		Version:      "2.6.6",
+		DefaultValue: "3600",
+		Doc:          "expiry in seconds of the presigned urls in the segment manifest",
+		Export:       false,
+	}
+	p.SegmentManifestSignedURLExpiry.Init(base.mgr)
+
+	p.FlushAndSealTimeout = ParamItem{
+		Key:          "dataCoord.segmentManifest.flushAndSealTimeout",
+		Version:      "2.6.6",
+		DefaultValue: "600",
+		Doc:          "timeout in seconds to wait for the sealed segments to be flushed on flush and seal request",
+		Export:       false,
+	}
+	p.FlushAndSealTimeout.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -5666,6 +6340,10 @@ type dataNodeConfig struct {
 	// Skip BF
 	SkipBFStatsLoad ParamItem `refreshable:"true"`
 
+	// statslog rebuild on recovery
+	StatsRebuildEnabled     ParamItem `refreshable:"true"`
+	StatsRebuildConcurrency ParamItem `refreshable:"true"`
+
 	// channel
 	ChannelWorkPoolSize ParamItem `refreshable:"true"`
 
@@ -5687,6 +6365,8 @@ type dataNodeConfig struct {
 	L0CompactionMaxBatchSize ParamItem `refreshable:"true"`
 	UseMergeSort             ParamItem `refreshable:"true"`
 	MaxSegmentMergeSort      ParamItem `refreshable:"true"`
+	ValidateResult           ParamItem `refreshable:"true"`
+	ValidateSamplePKNum      ParamItem `refreshable:"true"`
 	MaxCompactionConcurrency ParamItem `refreshable:"true"`
 
 	GracefulStopTimeout ParamItem `refreshable:"true"`
@@ -5703,10 +6383,18 @@ type dataNodeConfig struct {
 	DeltalogFormat ParamItem `refreshable:"false"`
 
 	// index services config
-	BuildParallel ParamItem `refreshable:"false"`
-
-	WorkerSlotUnit      ParamItem `refreshable:"true"`
-	StandaloneSlotRatio ParamItem `refreshable:"false"`
+	BuildParallel            ParamItem `refreshable:"false"`
+	IndexUploadRetryAttempts ParamItem `refreshable:"true"`
+	IndexUploadRetryInterval ParamItem `refreshable:"true"`
+	IndexRejectOversizedJob  ParamItem `refreshable:"true"`
+
+	WorkerSlotUnit         ParamItem `refreshable:"true"`
+	StandaloneSlotRatio    ParamItem `refreshable:"false"`
+	CheckpointReplayBudget ParamItem `refreshable:"true"`
+	DeltaWALEnabled        ParamItem `refreshable:"false"`
+	DeltaWALSyncWrite      ParamItem `refreshable:"true"`
+
+	InsertChecksumStopOnMismatch ParamItem `refreshable:"true"`
 }
 
 func (p *dataNodeConfig) init(base *BaseTable) {
@@ -5762,7 +6450,8 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 		Key:          "dataNode.dataSync.maxParallelSyncTaskNum",
 		Version:      "2.3.0",
 		DefaultValue: "6",
-		Doc:          "deprecated, legacy flush manager max conurrency number",
+		Doc:          "legacy flush manager max conurrency number",
+		Deprecated:   "it takes no effect",
 		Export:       false,
 	}
 	p.MaxParallelSyncTaskNum.Init(base.mgr)
@@ -5908,6 +6597,22 @@ Setting this parameter too small causes the system to store a small amount of da
 	}
 	p.SkipBFStatsLoad.Init(base.mgr)
 
+	p.StatsRebuildEnabled = ParamItem{
+		Key:          "dataNode.statsRebuild.enabled",
+		Version:      "2.6.6",
+		DefaultValue: "true",
+		Doc:          "rebuild the pk bloom filter from pk binlogs when the statslog of a segment is corrupted or missing during recovery",
+	}
+	p.StatsRebuildEnabled.Init(base.mgr)
+
+	p.StatsRebuildConcurrency = ParamItem{
+		Key:          "dataNode.statsRebuild.concurrency",
+		Version:      "2.6.6",
+		DefaultValue: "4",
+		Doc:          "max number of pk binlogs read concurrently when rebuilding the statslog of one segment",
+	}
+	p.StatsRebuildConcurrency.Init(base.mgr)
+
 	p.ChannelWorkPoolSize = ParamItem{
 		Key:          "dataNode.channel.workPoolSize",
 		Version:      "2.3.2",
@@ -6070,6 +6775,24 @@ if this parameter <= 0, will set it as 10`,
 	}
 	p.MaxSegmentMergeSort.Init(base.mgr)
 
+	p.ValidateResult = ParamItem{
+		Key:          "dataNode.compaction.validateResult",
+		Version:      "2.6.6",
+		Doc:          "Whether to validate the mixCompaction result before reporting success, the plan fails and the input segments are kept on mismatch.",
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.ValidateResult.Init(base.mgr)
+
+	p.ValidateSamplePKNum = ParamItem{
+		Key:          "dataNode.compaction.validateSamplePKNum",
+		Version:      "2.6.6",
+		Doc:          "The number of sampled pks of each result segment to check against the bloom filter of the statslog.",
+		DefaultValue: "100",
+		Export:       true,
+	}
+	p.ValidateSamplePKNum.Init(base.mgr)
+
 	p.MaxCompactionConcurrency = ParamItem{
 		Key:          "dataNode.compaction.maxConcurrency",
 		Version:      "2.6.0",
@@ -6144,6 +6867,33 @@ if this parameter <= 0, will set it as 10`,
 	}
 	p.BuildParallel.Init(base.mgr)
 
+	p.IndexUploadRetryAttempts = ParamItem{
+		Key:          "indexNode.upload.retryAttempts",
+		Version:      "2.6.6",
+		DefaultValue: "3",
+		Doc:          "max attempts to upload the built index, the built index is kept between attempts so that only the upload is redone",
+		Export:       false,
+	}
+	p.IndexUploadRetryAttempts.Init(base.mgr)
+
+	p.IndexUploadRetryInterval = ParamItem{
+		Key:          "indexNode.upload.retryInterval",
+		Version:      "2.6.6",
+		DefaultValue: "1000",
+		Doc:          "initial interval in milliseconds between attempts to upload the built index",
+		Export:       false,
+	}
+	p.IndexUploadRetryInterval.Init(base.mgr)
+
+	p.IndexRejectOversizedJob = ParamItem{
+		Key:          "indexNode.rejectOversizedJob",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc:          "reject the index build job if its estimated memory or disk exceeds the capacity of the node",
+		Export:       false,
+	}
+	p.IndexRejectOversizedJob.Init(base.mgr)
+
 	p.WorkerSlotUnit = ParamItem{
 		Key:          "dataNode.workerSlotUnit",
 		Version:      "2.5.7",
@@ -6159,6 +6909,48 @@ if this parameter <= 0, will set it as 10`,
 		Doc:          "Offline task slot ratio in standalone mode",
 	}
 	p.StandaloneSlotRatio.Init(base.mgr)
+
+	p.CheckpointReplayBudget = ParamItem{
+		Key:          "dataNode.segment.checkpointReplayBudget",
+		Version:      "2.6.6",
+		DefaultValue: "0",
+		Doc: `The max replay window of channel checkpoint in seconds, 0 means disabled.
+Once the earliest buffered data of a channel lags behind the latest time tick more than the budget,
+the lagging segments are forced to sync to advance the checkpoint, which bounds the recovery replay time.`,
+		Export: false,
+	}
+	p.CheckpointReplayBudget.Init(base.mgr)
+
+	p.DeltaWALEnabled = ParamItem{
+		Key:          "dataNode.deltaWAL.enabled",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc: `whether to write the buffered deletes to a local write-ahead log under localStorage.path,
+the log is replayed on recovery so that the deletes are not lost if the checkpoint was advanced before they are synced.
+localStorage.path shall be kept across restarts for the log to take effect`,
+		Export: false,
+	}
+	p.DeltaWALEnabled.Init(base.mgr)
+
+	p.DeltaWALSyncWrite = ParamItem{
+		Key:          "dataNode.deltaWAL.syncWrite",
+		Version:      "2.6.6",
+		DefaultValue: "true",
+		Doc:          "whether to fsync the delete write-ahead log on each write",
+		Export:       false,
+	}
+	p.DeltaWALSyncWrite.Init(base.mgr)
+
+	p.InsertChecksumStopOnMismatch = ParamItem{
+		Key:          "dataNode.insertChecksum.stopOnMismatch",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc: `Whether to stop consuming the channel if the checksum of the insert batch mismatches,
+otherwise the mismatch is only logged and counted, and the batch is buffered as is.`,
+		Validator: IsBool,
+		Export:    true,
+	}
+	p.InsertChecksumStopOnMismatch.Init(base.mgr)
 }
 
 type streamingConfig struct {
@@ -6209,6 +7001,11 @@ type streamingConfig struct {
 	FlushL0MaxRowNum   ParamItem `refreshable:"true"`
 	FlushL0MaxSize     ParamItem `refreshable:"true"`
 
+	// Flusher supervision
+	FlushRestartBackoffInitialInterval ParamItem `refreshable:"true"`
+	FlushRestartBackoffMaxInterval     ParamItem `refreshable:"true"`
+	FlushRestartMaxTimes               ParamItem `refreshable:"true"`
+
 	// recovery configuration.
 	WALRecoveryPersistInterval      ParamItem `refreshable:"true"`
 	WALRecoveryMaxDirtyMessage      ParamItem `refreshable:"true"`
@@ -6508,6 +7305,40 @@ If the binary size of l0 segment is greater than this size, it will be flushed.`
 	}
 	p.FlushL0MaxSize.Init(base.mgr)
 
+	p.FlushRestartBackoffInitialInterval = ParamItem{
+		Key:     "streaming.flush.restart.backoffInitialInterval",
+		Version: "2.6.6",
+		Doc: `The initial interval of flusher restart backoff, 1s by default.
+Once the flowgraph of a vchannel fails, e.g. panics, the flusher of the pchannel will be restarted after the backoff interval,
+the other vchannels keep working until the restart.`,
+		DefaultValue: "1s",
+		Validator:    IsDuration,
+		Export:       true,
+	}
+	p.FlushRestartBackoffInitialInterval.Init(base.mgr)
+
+	p.FlushRestartBackoffMaxInterval = ParamItem{
+		Key:          "streaming.flush.restart.backoffMaxInterval",
+		Version:      "2.6.6",
+		Doc:          "The max interval of flusher restart backoff, 1m by default.",
+		DefaultValue: "1m",
+		Validator:    IsDuration,
+		Export:       true,
+	}
+	p.FlushRestartBackoffMaxInterval.Init(base.mgr)
+
+	p.FlushRestartMaxTimes = ParamItem{
+		Key:     "streaming.flush.restart.maxTimes",
+		Version: "2.6.6",
+		Doc: `The max times of the flowgraph of a vchannel can fail, 3 by default.
+If a vchannel fails more than this times, it will be quarantined and not recovered by the flusher until the pchannel is reassigned,
+so a poisoned vchannel only degrades itself rather than the whole streaming node.`,
+		DefaultValue: "3",
+		Validator:    IntRange(0, math.MaxInt32),
+		Export:       true,
+	}
+	p.FlushRestartMaxTimes.Init(base.mgr)
+
 	p.WALRecoveryPersistInterval = ParamItem{
 		Key:     "streaming.walRecovery.persistInterval",
 		Version: "2.6.0",
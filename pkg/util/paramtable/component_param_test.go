@@ -77,6 +77,7 @@ func TestComponentParam(t *testing.T) {
 
 		assert.Equal(t, Params.RootCoordDml.GetValue(), "by-dev-rootcoord-dml")
 		t.Logf("rootcoord dml channel = %s", Params.RootCoordDml.GetValue())
+		assert.Equal(t, "by-dev-rootcoord-ddl-event", Params.RootCoordDDLEvent.GetValue())
 
 		// -- querycoord --
 		assert.Equal(t, Params.QueryCoordTimeTick.GetValue(), "by-dev-queryTimeTick")
@@ -155,6 +156,10 @@ func TestComponentParam(t *testing.T) {
 		params.Save("common.clusterID", "0")
 	})
 
+	t.Run("test traceConfig", func(t *testing.T) {
+		assert.False(t, params.TraceCfg.EnableExemplar.GetAsBool())
+	})
+
 	t.Run("test rootCoordConfig", func(t *testing.T) {
 		Params := &params.RootCoordCfg
 
@@ -172,6 +177,9 @@ func TestComponentParam(t *testing.T) {
 		params.Save("rootCoord.defaultDBProperties", "{\"key\":\"value\"}")
 		assert.Equal(t, "{\"key\":\"value\"}", Params.DefaultDBProperties.GetValue())
 
+		assert.Equal(t, 24*time.Hour, Params.DdlTaskRetention.GetAsDuration(time.Second))
+		assert.False(t, Params.DDLEventEnabled.GetAsBool())
+
 		SetCreateTime(time.Now())
 		SetUpdateTime(time.Now())
 	})
@@ -243,6 +251,23 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 72, Params.MaxPasswordLength.GetAsInt())
 		params.Save("proxy.maxPasswordLength", "-10")
 		assert.Equal(t, 72, Params.MaxPasswordLength.GetAsInt())
+
+		assert.Equal(t, 10*time.Second, Params.PartitionNegativeCacheTTL.GetAsDuration(time.Second))
+		assert.Equal(t, int64(0), Params.MaxQueryResponseSize.GetAsInt64())
+		assert.Equal(t, int64(0), Params.MaxSearchResponseSize.GetAsInt64())
+		assert.Equal(t, 1000, Params.MutationResultDetailLimit.GetAsInt())
+		assert.False(t, Params.LoadStateRequireIndex.GetAsBool())
+		assert.True(t, Params.RetryOnStaleCollection.GetAsBool())
+
+		assert.Equal(t, 0.0, Params.TrafficMirrorRatio.GetAsFloat())
+		assert.Equal(t, "", Params.TrafficMirrorCollections.GetValue())
+		assert.Equal(t, "", Params.TrafficMirrorEndpoint.GetValue())
+		assert.False(t, Params.TrafficMirrorTLSEnabled.GetAsBool())
+		assert.Equal(t, "", Params.TrafficMirrorCaPemPath.GetValue())
+		assert.Equal(t, "", Params.TrafficMirrorToken.GetValue())
+		assert.Equal(t, 16, Params.TrafficMirrorMaxConcurrency.GetAsInt())
+		assert.Equal(t, 10*time.Second, Params.TrafficMirrorTimeout.GetAsDuration(time.Second))
+		assert.False(t, Params.InsertChecksumEnabled.GetAsBool())
 	})
 
 	// t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -313,6 +338,8 @@ func TestComponentParam(t *testing.T) {
 		Params := &params.QueryCoordCfg
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("queryCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
+		assert.True(t, Params.LeaderDivergenceRepair.GetAsBool())
+		assert.Equal(t, 30*time.Second, Params.LeaderDivergenceGracePeriod.GetAsDuration(time.Second))
 
 		params.Save("queryCoord.NextTargetSurviveTime", "100")
 		NextTargetSurviveTime := &Params.NextTargetSurviveTime
@@ -402,6 +429,21 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 2, Params.QueryNodeTaskParallelismFactor.GetAsInt())
 
 		assert.Equal(t, 100, Params.BalanceCheckCollectionMaxCount.GetAsInt())
+
+		assert.Equal(t, 3, Params.SegmentQuarantineFailThreshold.GetAsInt())
+		assert.Equal(t, 10, Params.SegmentQuarantineRetryBaseSeconds.GetAsInt())
+		assert.Equal(t, 600, Params.SegmentQuarantineRetryMaxSeconds.GetAsInt())
+		assert.Equal(t, 1.0, Params.PartialLoadRatio.GetAsFloat())
+		assert.False(t, Params.AutoReleaseEnabled.GetAsBool())
+		assert.Equal(t, 600*time.Second, Params.AutoReleaseCheckInterval.GetAsDuration(time.Second))
+		params.Save("queryCoord.autoRelease.checkInterval", "0")
+		assert.Equal(t, 600*time.Second, Params.AutoReleaseCheckInterval.GetAsDuration(time.Second))
+		params.Save("queryCoord.autoRelease.checkInterval", "60")
+		assert.Equal(t, 60*time.Second, Params.AutoReleaseCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 60*time.Second, Params.NodeLoadScoreWindow.GetAsDuration(time.Second))
+		assert.Equal(t, 0.0, Params.NodeLoadScoreFactor.GetAsFloat())
+		assert.Equal(t, 0.9, Params.StoppingBalanceMaxLoadScore.GetAsFloat())
+		assert.True(t, Params.EnableStoppingPrefetch.GetAsBool())
 	})
 
 	t.Run("test queryNodeConfig", func(t *testing.T) {
@@ -428,7 +470,9 @@ func TestComponentParam(t *testing.T) {
 
 		assert.Equal(t, int32(10240), Params.MaxReceiveChanSize.GetAsInt32())
 		assert.Equal(t, int32(10240), Params.MaxUnsolvedQueueSize.GetAsInt32())
+		assert.Equal(t, 0.0, Params.QueryMemoryLimitRatio.GetAsFloat())
 		assert.Equal(t, 10.0, Params.CPURatio.GetAsFloat())
+		assert.Equal(t, int64(0), Params.GroupingMergeWindow.GetAsInt64())
 		assert.Equal(t, uint32(hardware.GetCPUNum()), Params.KnowhereThreadPoolSize.GetAsUint32())
 
 		// chunk cache
@@ -500,7 +544,14 @@ func TestComponentParam(t *testing.T) {
 		params.Save("queryNode.lazyload.requestResourceRetryInterval", "3000")
 		assert.Equal(t, 3*time.Second, Params.LazyLoadRequestResourceRetryInterval.GetAsDuration(time.Millisecond))
 
+		assert.False(t, Params.LazyLoadIndexEnabled.GetAsBool())
+		assert.Equal(t, "disable", Params.LazyLoadIndexWarmup.GetValue())
+		params.Save("queryNode.lazyload.indexWarmup", "async")
+		assert.Equal(t, "async", Params.LazyLoadIndexWarmup.GetValue())
+
 		assert.Equal(t, 2, Params.BloomFilterApplyParallelFactor.GetAsInt())
+		assert.Equal(t, 10000, Params.ParallelReduceTopKThreshold.GetAsInt())
+		assert.Equal(t, int64(256*1024*1024), Params.ReduceSpillThreshold.GetAsSize())
 		assert.Equal(t, true, Params.SkipGrowingSegmentBF.GetAsBool())
 		assert.Equal(t, true, Params.EnableSparseFilterInQuery.GetAsBool())
 
@@ -516,6 +567,10 @@ func TestComponentParam(t *testing.T) {
 	t.Run("test dataCoordConfig", func(t *testing.T) {
 		Params := &params.DataCoordCfg
 		assert.Equal(t, 24*60*60*time.Second, Params.SegmentMaxLifetime.GetAsDuration(time.Second))
+		assert.Equal(t, time.Hour, Params.SegmentManifestSignedURLExpiry.GetAsDuration(time.Second))
+		assert.Equal(t, 10*time.Minute, Params.FlushAndSealTimeout.GetAsDuration(time.Second))
+		assert.Equal(t, 1, Params.SegmentPreSplitNum.GetAsInt())
+		assert.Equal(t, 0.0, Params.SegmentPreSplitMinRowsPerSec.GetAsFloat())
 		assert.True(t, Params.EnableGarbageCollection.GetAsBool())
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("dataCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
@@ -535,6 +590,9 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, true, Params.WaitForIndex.GetAsBool())
 		assert.Equal(t, 1, Params.ImportFileNumPerSlot.GetAsInt())
 		assert.Equal(t, 160*1024*1024, Params.ImportMemoryLimitPerSlot.GetAsInt())
+		assert.Equal(t, 0, Params.MaxImportJobNumPerCollection.GetAsInt())
+		assert.Equal(t, 2*time.Second, Params.ImportTaskRetryBackoffBase.GetAsDuration(time.Second))
+		assert.Equal(t, 120*time.Second, Params.ImportTaskRetryBackoffMax.GetAsDuration(time.Second))
 
 		params.Save("datacoord.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
@@ -545,11 +603,22 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 0.6, Params.GCSlowDownCPUUsageThreshold.GetAsFloat())
 		params.Save("dataCoord.gc.slowDownCPUUsageThreshold", "0.5")
 		assert.Equal(t, 0.5, Params.GCSlowDownCPUUsageThreshold.GetAsFloat())
+		assert.Equal(t, 86400*time.Second, Params.GCIndexOrphanGracePeriod.GetAsDuration(time.Second))
+		assert.False(t, Params.GCIndexDryRun.GetAsBool())
+		assert.True(t, Params.GCRespectTimeTravelWatermark.GetAsBool())
+		params.Save("dataCoord.gc.respectTimeTravelWatermark", "false")
+		assert.False(t, Params.GCRespectTimeTravelWatermark.GetAsBool())
 		params.Save("dataCoord.compaction.gcInterval", "100")
 		assert.Equal(t, float64(100), Params.CompactionGCIntervalInSeconds.GetAsDuration(time.Second).Seconds())
 		params.Save("dataCoord.compaction.dropTolerance", "100")
 		assert.Equal(t, float64(100), Params.CompactionDropToleranceInSeconds.GetAsDuration(time.Second).Seconds())
 		assert.Equal(t, int64(10000), Params.CompactionPreAllocateIDExpansionFactor.GetAsInt64())
+		assert.False(t, Params.CompactionParallelismAutoTune.GetAsBool())
+		assert.Equal(t, 1, Params.CompactionMinParallelTasks.GetAsInt())
+		assert.Equal(t, 16, Params.CompactionMaxParallelTasksPerNode.GetAsInt())
+		assert.Equal(t, 10*time.Minute, Params.CompactionTargetPlanDuration.GetAsDuration(time.Second))
+		assert.Equal(t, 5*time.Minute, Params.CompactionMaxIngestLag.GetAsDuration(time.Second))
+		assert.Equal(t, 30*time.Second, Params.CompactionParallelismAdjustInterval.GetAsDuration(time.Second))
 
 		params.Save("dataCoord.compaction.clustering.enable", "true")
 		assert.Equal(t, true, Params.ClusteringCompactionEnable.GetAsBool())
@@ -578,6 +647,7 @@ func TestComponentParam(t *testing.T) {
 		assert.True(t, Params.EnableSortCompaction.GetAsBool())
 		params.Save("datacoord.taskCheckInterval", "500")
 		assert.Equal(t, 500*time.Second, Params.TaskCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 86400*time.Second, Params.StatslogRebuildJobRetention.GetAsDuration(time.Second))
 		params.Save("datacoord.statsTaskTriggerCount", "3")
 		assert.Equal(t, 3, Params.SortCompactionTriggerCount.GetAsInt())
 	})
@@ -618,6 +688,10 @@ func TestComponentParam(t *testing.T) {
 		period := &Params.SyncPeriod
 		t.Logf("SyncPeriod: %v", period)
 		assert.Equal(t, 10*time.Minute, Params.SyncPeriod.GetAsDuration(time.Second))
+		assert.Equal(t, time.Duration(0), Params.CheckpointReplayBudget.GetAsDuration(time.Second))
+		assert.False(t, Params.DeltaWALEnabled.GetAsBool())
+		assert.True(t, Params.DeltaWALSyncWrite.GetAsBool())
+		assert.False(t, Params.InsertChecksumStopOnMismatch.GetAsBool())
 
 		channelWorkPoolSize := Params.ChannelWorkPoolSize.GetAsInt()
 		t.Logf("channelWorkPoolSize: %d", channelWorkPoolSize)
@@ -637,6 +711,8 @@ func TestComponentParam(t *testing.T) {
 		params.Save("datanode.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
 		assert.Equal(t, 16, Params.SlotCap.GetAsInt())
+		assert.Equal(t, 3, Params.IndexUploadRetryAttempts.GetAsInt())
+		assert.Equal(t, time.Second, Params.IndexUploadRetryInterval.GetAsDuration(time.Millisecond))
 
 		// compaction
 		assert.Equal(t, 10, Params.MaxCompactionConcurrency.GetAsInt())
@@ -650,6 +726,9 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 2, Params.BloomFilterApplyParallelFactor.GetAsInt())
 		assert.Equal(t, 16, Params.WorkerSlotUnit.GetAsInt())
 		assert.Equal(t, 0.25, Params.StandaloneSlotRatio.GetAsFloat())
+
+		assert.True(t, Params.StatsRebuildEnabled.GetAsBool())
+		assert.Equal(t, 4, Params.StatsRebuildConcurrency.GetAsInt())
 	})
 
 	t.Run("test streamingConfig", func(t *testing.T) {
@@ -685,6 +764,9 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 10*time.Minute, params.StreamingCfg.FlushL0MaxLifetime.GetAsDurationByParse())
 		assert.Equal(t, 500000, params.StreamingCfg.FlushL0MaxRowNum.GetAsInt())
 		assert.Equal(t, int64(32*1024*1024), params.StreamingCfg.FlushL0MaxSize.GetAsSize())
+		assert.Equal(t, time.Second, params.StreamingCfg.FlushRestartBackoffInitialInterval.GetAsDurationByParse())
+		assert.Equal(t, time.Minute, params.StreamingCfg.FlushRestartBackoffMaxInterval.GetAsDurationByParse())
+		assert.Equal(t, 3, params.StreamingCfg.FlushRestartMaxTimes.GetAsInt())
 		assert.Equal(t, 30*time.Minute, params.StreamingCfg.WALTruncateSampleInterval.GetAsDurationByParse())
 		assert.Equal(t, 72*time.Hour, params.StreamingCfg.WALTruncateRetentionInterval.GetAsDurationByParse())
 
@@ -770,6 +852,22 @@ func TestForbiddenItem(t *testing.T) {
 	assert.Equal(t, "by-dev", params.CommonCfg.ClusterPrefix.GetValue())
 }
 
+func TestComponentEffectiveConfigurations(t *testing.T) {
+	Init()
+	params := Get()
+
+	configs := params.GetComponentEffectiveConfigurations("datacoord", "segment.maxSizeByIndexType")
+	assert.Equal(t, config.ConfigValue{Value: "{}", Source: config.DefaultSource}, configs["datacoord.segment.maxsizebyindextype"])
+
+	params.Save(params.DataCoordCfg.SegmentMaxSizeByIndexType.Key, `{"HNSW": 512}`)
+	defer params.Reset(params.DataCoordCfg.SegmentMaxSizeByIndexType.Key)
+	configs = params.GetComponentEffectiveConfigurations("datacoord", "segment.maxSizeByIndexType")
+	assert.Equal(t, config.ConfigValue{Value: `{"HNSW": 512}`, Source: config.RuntimeSource}, configs["datacoord.segment.maxsizebyindextype"])
+
+	configs = params.GetComponentEffectiveConfigurations("querynode", "segment.maxSizeByIndexType")
+	assert.Empty(t, configs)
+}
+
 func TestCachedParam(t *testing.T) {
 	Init()
 	params := Get()
@@ -790,6 +888,7 @@ func TestCachedParam(t *testing.T) {
 	assert.Equal(t, 60, params.ServiceParam.MQCfg.PursuitBufferTime.GetAsInt())
 
 	assert.Equal(t, int64(1024), params.DataCoordCfg.SegmentMaxSize.GetAsInt64())
+	assert.Equal(t, 0, len(params.DataCoordCfg.SegmentMaxSizeByIndexType.GetAsJSONMap()))
 	assert.Equal(t, int64(1024), params.DataCoordCfg.SegmentMaxSize.GetAsInt64())
 
 	assert.Equal(t, 0.85, params.QuotaConfig.DataNodeMemoryLowWaterLevel.GetAsFloat())
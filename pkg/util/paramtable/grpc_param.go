@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -31,6 +32,7 @@ import (
 
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 const (
@@ -217,7 +219,9 @@ func (p *GrpcServerConfig) Init(domain string, base *BaseTable) {
 type GrpcClientConfig struct {
 	grpcConfig
 
-	CompressionEnabled ParamItem `refreshable:"false"`
+	CompressionEnabled          ParamItem `refreshable:"false"`
+	CompressionType             ParamItem `refreshable:"false"`
+	DataPlaneCompressionEnabled ParamItem `refreshable:"false"`
 
 	ClientMaxSendSize ParamItem `refreshable:"false"`
 	ClientMaxRecvSize ParamItem `refreshable:"false"`
@@ -233,6 +237,8 @@ type GrpcClientConfig struct {
 	MinResetInterval        ParamItem `refreshable:"false"`
 	MaxCancelError          ParamItem `refreshable:"false"`
 	MinSessionCheckInterval ParamItem `refreshable:"false"`
+	DeadlineReserveRatio    ParamItem `refreshable:"false"`
+	WatchCoordSession       ParamItem `refreshable:"false"`
 }
 
 func (p *GrpcClientConfig) Init(domain string, base *BaseTable) {
@@ -439,6 +445,28 @@ func (p *GrpcClientConfig) Init(domain string, base *BaseTable) {
 	}
 	p.CompressionEnabled.Init(base.mgr)
 
+	p.CompressionType = ParamItem{
+		Key:          "grpc.client.compressionType",
+		Version:      "2.6.6",
+		DefaultValue: "zstd",
+		Validator:    OneOf("zstd", "gzip", "snappy"),
+		Doc: `The compressor of the compressed internal rpcs, one of zstd, gzip and snappy.
+The server replies with the same compressor as the request, so all the nodes should be upgraded before switching to gzip or snappy.`,
+		Export: true,
+	}
+	p.CompressionType.Init(base.mgr)
+
+	p.DataPlaneCompressionEnabled = ParamItem{
+		Key:          "grpc.client.dataPlaneCompressionEnabled",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Validator:    IsBool,
+		Doc: `Whether to compress the data-plane rpcs to the dataNode and queryNode only, e.g. FlushSegments, SyncSegments and the query results,
+which cuts the cross-AZ bandwidth with less cpu than compressionEnabled.`,
+		Export: true,
+	}
+	p.DataPlaneCompressionEnabled.Init(base.mgr)
+
 	p.MinResetInterval = ParamItem{
 		Key:          "grpc.client.minResetInterval",
 		DefaultValue: "1000",
@@ -498,14 +526,47 @@ func (p *GrpcClientConfig) Init(domain string, base *BaseTable) {
 		Export: true,
 	}
 	p.MaxCancelError.Init(base.mgr)
+
+	p.DeadlineReserveRatio = ParamItem{
+		Key:          "grpc.client.deadlineReserveRatio",
+		Version:      "2.6.6",
+		DefaultValue: "0.1",
+		Formatter: func(v string) string {
+			ratio, err := strconv.ParseFloat(v, 64)
+			if err != nil || ratio < 0 || ratio >= 1 {
+				return "0.1"
+			}
+			return v
+		},
+		Doc: `The ratio of the remaining time of the caller's deadline reserved in each internal grpc call,
+the callee gets the rest as its deadline so that it gives up before the caller. 0 means the caller's deadline is passed as is.`,
+		Export: false,
+	}
+	p.DeadlineReserveRatio.Init(base.mgr)
+
+	p.WatchCoordSession = ParamItem{
+		Key:          "grpc.client.watchCoordSession",
+		Version:      "2.6.6",
+		DefaultValue: "true",
+		Doc: `Whether the clients of the coordinators watch the session of the coordinator,
+and reconnect to the new coordinator once the leader changes instead of failing until the next reset.`,
+		Export: false,
+	}
+	p.WatchCoordSession.Init(base.mgr)
+}
+
+// GetCompressor returns the compressor of the rpcs to the domain, empty means no compression.
+func (p *GrpcClientConfig) GetCompressor() string {
+	isDataPlane := strings.EqualFold(p.Domain, typeutil.DataNodeRole) || strings.EqualFold(p.Domain, typeutil.QueryNodeRole)
+	if p.CompressionEnabled.GetAsBool() || (isDataPlane && p.DataPlaneCompressionEnabled.GetAsBool()) {
+		return p.CompressionType.GetValue()
+	}
+	return ""
 }
 
 // GetDialOptionsFromConfig returns grpc dial options from config.
 func (p *GrpcClientConfig) GetDialOptionsFromConfig() []grpc.DialOption {
-	compress := ""
-	if p.CompressionEnabled.GetAsBool() {
-		compress = "zstd"
-	}
+	compress := p.GetCompressor()
 	return []grpc.DialOption{
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(p.ClientMaxRecvSize.GetAsInt()),
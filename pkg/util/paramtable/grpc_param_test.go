@@ -147,8 +147,17 @@ func TestGrpcClientParams(t *testing.T) {
 	assert.Equal(t, clientConfig.CompressionEnabled.GetAsBool(), DefaultCompressionEnabled)
 	base.Save("grpc.client.CompressionEnabled", "a")
 	assert.Equal(t, clientConfig.CompressionEnabled.GetAsBool(), DefaultCompressionEnabled)
+	assert.Equal(t, "", clientConfig.GetCompressor())
+	base.Save(clientConfig.DataPlaneCompressionEnabled.Key, "true")
+	assert.Equal(t, "zstd", clientConfig.GetCompressor())
+	base.Save(clientConfig.CompressionType.Key, "snappy")
+	assert.Equal(t, "snappy", clientConfig.GetCompressor())
+	base.Save(clientConfig.DataPlaneCompressionEnabled.Key, "false")
+	assert.Equal(t, "", clientConfig.GetCompressor())
 	base.Save(clientConfig.CompressionEnabled.Key, "true")
 	assert.Equal(t, true, clientConfig.CompressionEnabled.GetAsBool())
+	assert.Equal(t, "snappy", clientConfig.GetCompressor())
+	base.Reset(clientConfig.CompressionType.Key)
 
 	assert.Equal(t, clientConfig.MinResetInterval.GetValue(), "1000")
 	base.Save("grpc.client.minResetInterval", "abc")
@@ -168,6 +177,14 @@ func TestGrpcClientParams(t *testing.T) {
 	base.Save("grpc.client.maxCancelError", "64")
 	assert.Equal(t, clientConfig.MaxCancelError.GetValue(), "64")
 
+	assert.Equal(t, 0.1, clientConfig.DeadlineReserveRatio.GetAsFloat())
+	base.Save("grpc.client.deadlineReserveRatio", "1.5")
+	assert.Equal(t, 0.1, clientConfig.DeadlineReserveRatio.GetAsFloat())
+	base.Save("grpc.client.deadlineReserveRatio", "0")
+	assert.Equal(t, 0.0, clientConfig.DeadlineReserveRatio.GetAsFloat())
+
+	assert.True(t, clientConfig.WatchCoordSession.GetAsBool())
+
 	base.Save("common.security.tlsMode", "1")
 	base.Save("tls.serverPemPath", "/pem")
 	base.Save("tls.serverKeyPath", "/key")
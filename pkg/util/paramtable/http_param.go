@@ -27,6 +27,7 @@ type httpConfig struct {
 	HSTSIncludeSubDomains ParamItem `refreshable:"false"`
 	EnableHSTS            ParamItem `refreshable:"false"`
 	EnableWebUI           ParamItem `refreshable:"false"`
+	StreamInsertChunkSize ParamItem `refreshable:"true"`
 }
 
 func (p *httpConfig) init(base *BaseTable) {
@@ -120,4 +121,14 @@ func (p *httpConfig) init(base *BaseTable) {
 		Export:       true,
 	}
 	p.EnableWebUI.Init(base.mgr)
+
+	p.StreamInsertChunkSize = ParamItem{
+		Key:          "proxy.http.streamInsertChunkSize",
+		DefaultValue: "16777216", // 16MB
+		Version:      "2.6.6",
+		Doc:          "The max size in bytes of the rows forwarded in one insert request by the streaming insert api, a single line larger than it is rejected",
+		Validator:    IntRange(1, 1<<30),
+		Export:       true,
+	}
+	p.StreamInsertChunkSize.Init(base.mgr)
 }
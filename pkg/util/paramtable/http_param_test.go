@@ -32,4 +32,5 @@ func TestHTTPConfig_Init(t *testing.T) {
 	assert.Equal(t, cfg.AcceptTypeAllowInt64.GetValue(), "true")
 	assert.Equal(t, cfg.EnablePprof.GetAsBool(), true)
 	assert.Equal(t, cfg.EnableWebUI.GetAsBool(), true)
+	assert.Equal(t, cfg.StreamInsertChunkSize.GetAsInt(), 16<<20)
 }
@@ -46,6 +46,11 @@ type ParamItem struct {
 	Formatter func(originValue string) string
 	Forbidden bool
 
+	// Validator checks the value set by the user, the invalid values are reported at startup.
+	Validator func(value string) error
+	// Deprecated is the reason and the replacement of the param, it's reported at startup if the param is set.
+	Deprecated string
+
 	manager *config.Manager
 
 	// for unittest.
@@ -53,13 +58,19 @@ type ParamItem struct {
 
 	callback  ParamChangeCallback
 	lastValue atomic.Pointer[string]
+
+	fallbackWarned atomic.Bool
 }
 
 func (pi *ParamItem) Init(manager *config.Manager) {
 	pi.manager = manager
+	registerParamItem(pi)
 	if pi.Forbidden {
 		pi.manager.ForbidUpdate(pi.Key)
 	}
+	if manager != nil && pi.Key != "" && pi.DefaultValue != "" {
+		manager.SetDefault(pi.Key, pi.DefaultValue)
+	}
 
 	currentValue := pi.GetValue()
 	pi.lastValue.Store(&currentValue)
@@ -140,6 +151,10 @@ func (pi *ParamItem) getWithRaw() (result, raw string, err error) {
 			_, fallbackRaw, err = pi.manager.GetConfig(key)
 			if err == nil {
 				raw = fallbackRaw
+				if pi.fallbackWarned.CompareAndSwap(false, true) {
+					log.Warn("config key is deprecated, use the new key instead",
+						zap.String("deprecatedKey", key), zap.String("key", pi.Key))
+				}
 				break
 			}
 		}
@@ -386,6 +401,7 @@ type ParamGroup struct {
 
 func (pg *ParamGroup) Init(manager *config.Manager) {
 	pg.manager = manager
+	registerParamGroup(pg)
 }
 
 func (pg *ParamGroup) GetValue() map[string]string {
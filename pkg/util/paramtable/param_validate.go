@@ -0,0 +1,239 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+)
+
+// maxTypoDistance is the max edit distance between an unknown config key and a known one to be reported as a typo.
+const maxTypoDistance = 2
+
+// registry of the initialized params, used to validate the configurations at startup.
+var (
+	registryMu   sync.RWMutex
+	paramItems   = make(map[string]*ParamItem)
+	paramGroups  = make(map[string]*ParamGroup)
+	fallbackKeys = make(map[string]string) // lower fallback key -> key
+)
+
+func registerParamItem(pi *ParamItem) {
+	if pi.Key == "" {
+		return
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	paramItems[strings.ToLower(pi.Key)] = pi
+	for _, key := range pi.FallbackKeys {
+		fallbackKeys[strings.ToLower(key)] = pi.Key
+	}
+}
+
+func registerParamGroup(pg *ParamGroup) {
+	if pg.KeyPrefix == "" {
+		return
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	paramGroups[strings.ToLower(pg.KeyPrefix)] = pg
+}
+
+// IntRange returns a validator which checks the value is an integer in [min, max].
+func IntRange(min, max int64) func(string) error {
+	return func(value string) error {
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return errors.Newf("%s is not an integer", value)
+		}
+		if v < min || v > max {
+			return errors.Newf("%d is out of range [%d, %d]", v, min, max)
+		}
+		return nil
+	}
+}
+
+// FloatRange returns a validator which checks the value is a float in [min, max].
+func FloatRange(min, max float64) func(string) error {
+	return func(value string) error {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return errors.Newf("%s is not a float", value)
+		}
+		if v < min || v > max {
+			return errors.Newf("%v is out of range [%v, %v]", v, min, max)
+		}
+		return nil
+	}
+}
+
+// OneOf returns a validator which checks the value is one of the options, case insensitive.
+func OneOf(options ...string) func(string) error {
+	return func(value string) error {
+		for _, option := range options {
+			if strings.EqualFold(value, option) {
+				return nil
+			}
+		}
+		return errors.Newf("%s is not one of %v", value, options)
+	}
+}
+
+// IsBool validates the value is a bool.
+func IsBool(value string) error {
+	if _, err := strconv.ParseBool(value); err != nil {
+		return errors.Newf("%s is not a bool", value)
+	}
+	return nil
+}
+
+// IsDuration validates the value is a duration, e.g. 10s.
+func IsDuration(value string) error {
+	if _, err := time.ParseDuration(value); err != nil {
+		return errors.Newf("%s is not a duration", value)
+	}
+	return nil
+}
+
+// ValidateConfigs checks the configurations set by the user, including:
+//   - the values which are rejected by the validator of the param.
+//   - the deprecated params and keys which are still set.
+//   - the unknown keys which are likely typos of the known ones.
+//
+// The problems are logged and returned, they don't fail the startup.
+func (p *ComponentParam) ValidateConfigs() []error {
+	mgr := p.baseTable.mgr
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	var errs []error
+	keys := lo.Keys(paramItems)
+	sort.Strings(keys)
+	for _, key := range keys {
+		pi := paramItems[key]
+		if pi.manager != mgr {
+			continue
+		}
+		source, raw, err := mgr.GetConfig(pi.Key)
+		if err != nil || source == "" {
+			continue
+		}
+		if pi.Deprecated != "" {
+			errs = append(errs, errors.Newf("config %s is deprecated, %s", pi.Key, pi.Deprecated))
+		}
+		if pi.Validator != nil {
+			if err := pi.Validator(raw); err != nil {
+				errs = append(errs, errors.Wrapf(err, "invalid value of config %s", pi.Key))
+			}
+		}
+	}
+
+	fileConfigs := mgr.FileConfigs()
+	fileKeys := lo.Filter(lo.Keys(fileConfigs), func(key string, _ int) bool {
+		return strings.Contains(key, ".")
+	})
+	sort.Strings(fileKeys)
+	for _, key := range fileKeys {
+		if newKey, ok := fallbackKeys[key]; ok {
+			errs = append(errs, errors.Newf("config %s is deprecated, use %s instead", key, newKey))
+			continue
+		}
+		if isKnownConfigKey(key) {
+			continue
+		}
+		if similar := similarConfigKey(key); similar != "" {
+			errs = append(errs, errors.Newf("unknown config %s, did you mean %s?", key, similar))
+		}
+	}
+
+	for _, err := range errs {
+		log.Warn("configuration problem found", zap.Error(err))
+	}
+	return errs
+}
+
+func isKnownConfigKey(key string) bool {
+	if _, ok := paramItems[key]; ok {
+		return true
+	}
+	for prefix := range paramGroups {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	// the map and list params are flattened by the file source, e.g. a.b.0 of the list a.b
+	for i := strings.LastIndex(key, "."); i > 0; i = strings.LastIndex(key[:i], ".") {
+		if _, ok := paramItems[key[:i]]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// similarConfigKey returns the known key which is within the maxTypoDistance of the key.
+func similarConfigKey(key string) string {
+	best, bestDistance := "", maxTypoDistance+1
+	for known := range paramItems {
+		// skip the keys whose length differs too much, the distance is no less than the difference
+		if abs(len(known)-len(key)) > maxTypoDistance {
+			continue
+		}
+		if d := editDistance(key, known); d < bestDistance || (d == bestDistance && known < best) {
+			best, bestDistance = known, d
+		}
+	}
+	if bestDistance > maxTypoDistance {
+		return ""
+	}
+	return paramItems[best].Key
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package paramtable
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/config"
+)
+
+func TestValidators(t *testing.T) {
+	assert.NoError(t, IntRange(0, 10)("10"))
+	assert.Error(t, IntRange(0, 10)("11"))
+	assert.Error(t, IntRange(0, 10)("a"))
+
+	assert.NoError(t, FloatRange(0, 1)("0.5"))
+	assert.Error(t, FloatRange(0, 1)("1.5"))
+	assert.Error(t, FloatRange(0, 1)("a"))
+
+	assert.NoError(t, OneOf("sync", "disable")("SYNC"))
+	assert.Error(t, OneOf("sync", "disable")("async"))
+
+	assert.NoError(t, IsBool("true"))
+	assert.Error(t, IsBool("yes"))
+
+	assert.NoError(t, IsDuration("10s"))
+	assert.Error(t, IsDuration("10"))
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("abc", "abc"))
+	assert.Equal(t, 1, editDistance("abc", "abd"))
+	assert.Equal(t, 1, editDistance("abc", "ab"))
+	assert.Equal(t, 2, editDistance("abc", "bca"))
+	assert.Equal(t, 3, editDistance("", "abc"))
+}
+
+func TestValidateConfigs(t *testing.T) {
+	var p ComponentParam
+	p.Init(NewBaseTable(SkipRemote(true)))
+
+	hasError := func(errs []error, substr string) bool {
+		return lo.ContainsBy(errs, func(err error) bool {
+			return strings.Contains(err.Error(), substr)
+		})
+	}
+
+	p.Save(p.MQCfg.Type.Key, "unknown")
+	defer p.Reset(p.MQCfg.Type.Key)
+	errs := p.ValidateConfigs()
+	assert.True(t, hasError(errs, "invalid value of config mq.type"))
+
+	p.Save(p.MQCfg.Type.Key, "kafka")
+	errs = p.ValidateConfigs()
+	assert.False(t, hasError(errs, "mq.type"))
+
+	p.Save(p.DataCoordCfg.CompactionMaxParallelTasks.Key, "10")
+	defer p.Reset(p.DataCoordCfg.CompactionMaxParallelTasks.Key)
+	errs = p.ValidateConfigs()
+	assert.True(t, hasError(errs, "config dataCoord.compaction.maxParallelTaskNum is deprecated, see dataNode.slot.slotCap"))
+
+	t.Run("deprecated", func(t *testing.T) {
+		mgr := config.NewManager()
+		pi := &ParamItem{
+			Key:          "test.validate.deprecated",
+			DefaultValue: "1",
+			FallbackKeys: []string{"test.validate.old"},
+			Deprecated:   "use test.validate.new instead",
+		}
+		pi.Init(mgr)
+		bt := &BaseTable{mgr: mgr}
+		cp := &ComponentParam{baseTable: bt}
+
+		assert.False(t, hasError(cp.ValidateConfigs(), pi.Key))
+		bt.Save(pi.Key, "2")
+		assert.True(t, hasError(cp.ValidateConfigs(), "config test.validate.deprecated is deprecated"))
+
+		// the fallback key is still readable
+		bt.Remove(pi.Key)
+		bt.Save("test.validate.old", "3")
+		assert.Equal(t, 3, pi.GetAsInt())
+	})
+
+	t.Run("similar key", func(t *testing.T) {
+		assert.Equal(t, p.MQCfg.Type.Key, similarConfigKey("mq.typ"))
+		assert.Equal(t, "", similarConfigKey("mq.unknownconfig"))
+		assert.True(t, isKnownConfigKey("mq.type"))
+		assert.False(t, isKnownConfigKey("mq.typ"))
+	})
+}
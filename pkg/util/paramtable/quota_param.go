@@ -151,6 +151,14 @@ type quotaConfig struct {
 	GrowingSegmentsSizeMinRateRatio       ParamItem `refreshable:"true"`
 	GrowingSegmentsSizeLowWaterLevel      ParamItem `refreshable:"true"`
 	GrowingSegmentsSizeHighWaterLevel     ParamItem `refreshable:"true"`
+	CPUThrottlingProtectionEnabled        ParamItem `refreshable:"true"`
+	CPUThrottlingMinRateRatio             ParamItem `refreshable:"true"`
+	CPUThrottlingLowWaterLevel            ParamItem `refreshable:"true"`
+	CPUThrottlingHighWaterLevel           ParamItem `refreshable:"true"`
+	TrendProtectionEnabled                ParamItem `refreshable:"true"`
+	TrendProtectionHorizon                ParamItem `refreshable:"true"`
+	TrendProtectionWindow                 ParamItem `refreshable:"true"`
+	TrendProtectionMinRateRatio           ParamItem `refreshable:"true"`
 	DiskProtectionEnabled                 ParamItem `refreshable:"true"`
 	DiskQuota                             ParamItem `refreshable:"true"`
 	LoadedDiskQuota                       ParamItem `refreshable:"true"`
@@ -1874,6 +1882,115 @@ but the rate will not be lower than minRateRatio * dmlRate.`,
 	}
 	p.GrowingSegmentsSizeHighWaterLevel.Init(base.mgr)
 
+	p.CPUThrottlingProtectionEnabled = ParamItem{
+		Key:          "quotaAndLimits.limitWriting.cpuThrottlingProtection.enabled",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc: `The cpu throttled ratio is the ratio of the cfs periods throttled by the cgroup cpu quota of DataNodes and QueryNodes.
+No action will be taken if the cpu throttled ratio is less than the low watermark.
+When the cpu throttled ratio exceeds the low watermark, the dml rate will be reduced,
+but the rate will not be lower than minRateRatio * dmlRate.`,
+		Export: true,
+	}
+	p.CPUThrottlingProtectionEnabled.Init(base.mgr)
+
+	defaultCPUThrottlingMinRateRatio := "0.5"
+	p.CPUThrottlingMinRateRatio = ParamItem{
+		Key:          "quotaAndLimits.limitWriting.cpuThrottlingProtection.minRateRatio",
+		Version:      "2.6.6",
+		DefaultValue: defaultCPUThrottlingMinRateRatio,
+		Formatter: func(v string) string {
+			level := getAsFloat(v)
+			if level <= 0 || level > 1 {
+				return defaultCPUThrottlingMinRateRatio
+			}
+			return v
+		},
+		Export: true,
+	}
+	p.CPUThrottlingMinRateRatio.Init(base.mgr)
+
+	defaultCPUThrottlingLowWaterLevel := "0.2"
+	p.CPUThrottlingLowWaterLevel = ParamItem{
+		Key:          "quotaAndLimits.limitWriting.cpuThrottlingProtection.lowWaterLevel",
+		Version:      "2.6.6",
+		DefaultValue: defaultCPUThrottlingLowWaterLevel,
+		Formatter: func(v string) string {
+			level := getAsFloat(v)
+			if level <= 0 || level > 1 {
+				return defaultCPUThrottlingLowWaterLevel
+			}
+			return v
+		},
+		Export: true,
+	}
+	p.CPUThrottlingLowWaterLevel.Init(base.mgr)
+
+	defaultCPUThrottlingHighWaterLevel := "0.5"
+	p.CPUThrottlingHighWaterLevel = ParamItem{
+		Key:          "quotaAndLimits.limitWriting.cpuThrottlingProtection.highWaterLevel",
+		Version:      "2.6.6",
+		DefaultValue: defaultCPUThrottlingHighWaterLevel,
+		Formatter: func(v string) string {
+			level := getAsFloat(v)
+			if level <= 0 || level > 1 {
+				return defaultCPUThrottlingHighWaterLevel
+			}
+			if !p.checkMinMaxLegal(p.CPUThrottlingLowWaterLevel.GetAsFloat(), getAsFloat(v)) {
+				return defaultCPUThrottlingHighWaterLevel
+			}
+			return v
+		},
+		Export: true,
+	}
+	p.CPUThrottlingHighWaterLevel.Init(base.mgr)
+
+	p.TrendProtectionEnabled = ParamItem{
+		Key:          "quotaAndLimits.limitWriting.trendProtection.enabled",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc: `The growth rates of the binlog size and the memory water level of DataNodes and QueryNodes are estimated in the window,
+if the disk quota or the memoryHighWaterLevel is projected to be hit within the horizon, the dml rate will be reduced
+in proportion to the time left before the limit is hit, but the rate will not be lower than minRateRatio * dmlRate.
+It takes effect only if the diskProtection or memProtection is enabled.`,
+		Export: true,
+	}
+	p.TrendProtectionEnabled.Init(base.mgr)
+
+	p.TrendProtectionHorizon = ParamItem{
+		Key:          "quotaAndLimits.limitWriting.trendProtection.horizon",
+		Version:      "2.6.6",
+		DefaultValue: "300",
+		Doc:          "seconds, the horizon of the projection",
+		Export:       true,
+	}
+	p.TrendProtectionHorizon.Init(base.mgr)
+
+	p.TrendProtectionWindow = ParamItem{
+		Key:          "quotaAndLimits.limitWriting.trendProtection.window",
+		Version:      "2.6.6",
+		DefaultValue: "600",
+		Doc:          "seconds, the window of the samples to estimate the growth rates",
+		Export:       true,
+	}
+	p.TrendProtectionWindow.Init(base.mgr)
+
+	defaultTrendProtectionMinRateRatio := "0.5"
+	p.TrendProtectionMinRateRatio = ParamItem{
+		Key:          "quotaAndLimits.limitWriting.trendProtection.minRateRatio",
+		Version:      "2.6.6",
+		DefaultValue: defaultTrendProtectionMinRateRatio,
+		Formatter: func(v string) string {
+			level := getAsFloat(v)
+			if level <= 0 || level > 1 {
+				return defaultTrendProtectionMinRateRatio
+			}
+			return v
+		},
+		Export: true,
+	}
+	p.TrendProtectionMinRateRatio.Init(base.mgr)
+
 	p.DiskProtectionEnabled = ParamItem{
 		Key:          "quotaAndLimits.limitWriting.diskProtection.enabled",
 		Version:      "2.2.0",
@@ -18,6 +18,7 @@ package paramtable
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -216,6 +217,14 @@ func TestQuotaParam(t *testing.T) {
 		assert.Equal(t, 0.5, qc.GrowingSegmentsSizeMinRateRatio.GetAsFloat())
 		assert.Equal(t, 0.2, qc.GrowingSegmentsSizeLowWaterLevel.GetAsFloat())
 		assert.Equal(t, 0.4, qc.GrowingSegmentsSizeHighWaterLevel.GetAsFloat())
+		assert.Equal(t, false, qc.CPUThrottlingProtectionEnabled.GetAsBool())
+		assert.Equal(t, 0.5, qc.CPUThrottlingMinRateRatio.GetAsFloat())
+		assert.Equal(t, 0.2, qc.CPUThrottlingLowWaterLevel.GetAsFloat())
+		assert.Equal(t, 0.5, qc.CPUThrottlingHighWaterLevel.GetAsFloat())
+		assert.Equal(t, false, qc.TrendProtectionEnabled.GetAsBool())
+		assert.Equal(t, 300*time.Second, qc.TrendProtectionHorizon.GetAsDuration(time.Second))
+		assert.Equal(t, 600*time.Second, qc.TrendProtectionWindow.GetAsDuration(time.Second))
+		assert.Equal(t, 0.5, qc.TrendProtectionMinRateRatio.GetAsFloat())
 		assert.Equal(t, true, qc.DiskProtectionEnabled.GetAsBool())
 		assert.Equal(t, defaultMax, qc.DiskQuota.GetAsFloat())
 		assert.Equal(t, defaultMax, qc.DiskQuotaPerCollection.GetAsFloat())
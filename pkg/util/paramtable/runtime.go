@@ -53,6 +53,7 @@ func Init() {
 		}
 		baseTable := NewBaseTable(opts...)
 		params.Init(baseTable)
+		params.ValidateConfigs()
 		hookBaseTable := NewBaseTableFromYamlOnly(hookYamlFile)
 		hookParams.init(hookBaseTable)
 		cipherParams.init(hookBaseTable)
@@ -62,6 +63,7 @@ func Init() {
 func InitWithBaseTable(baseTable *BaseTable) {
 	once.Do(func() {
 		params.Init(baseTable)
+		params.ValidateConfigs()
 		hookBaseTable := NewBaseTableFromYamlOnly(hookYamlFile)
 		hookParams.init(hookBaseTable)
 		cipherParams.init(hookBaseTable)
@@ -18,6 +18,7 @@ package paramtable
 
 import (
 	"encoding/json"
+	"math"
 	"net/url"
 	"os"
 	"path"
@@ -109,6 +110,10 @@ type EtcdConfig struct {
 	DialKeepAliveTime    ParamItem          `refreshable:"false"`
 	DialKeepAliveTimeout ParamItem          `refreshable:"false"`
 
+	// --- Bulk client ---
+	BulkClientEnabled        ParamItem `refreshable:"false"`
+	BulkClientMaxConcurrency ParamItem `refreshable:"false"`
+
 	// --- Embed ETCD ---
 	UseEmbedEtcd ParamItem `refreshable:"false"`
 	ConfigPath   ParamItem `refreshable:"false"`
@@ -290,6 +295,24 @@ We recommend using version 1.2 and above.`,
 	}
 	p.RequestTimeout.Init(base.mgr)
 
+	p.BulkClientEnabled = ParamItem{
+		Key:          "etcd.bulkClient.enabled",
+		DefaultValue: "false",
+		Version:      "2.6.6",
+		Doc:          `Whether to use a dedicated etcd client for bulk range reads, so that large range reads won't starve small latency-sensitive requests`,
+		Export:       false,
+	}
+	p.BulkClientEnabled.Init(base.mgr)
+
+	p.BulkClientMaxConcurrency = ParamItem{
+		Key:          "etcd.bulkClient.maxConcurrency",
+		DefaultValue: "4",
+		Version:      "2.6.6",
+		Doc:          `The max number of concurrent bulk range reads, 0 means unlimited`,
+		Export:       false,
+	}
+	p.BulkClientMaxConcurrency.Init(base.mgr)
+
 	p.DialKeepAliveTime = ParamItem{
 		Key:          "etcd.dialKeepAliveTime",
 		DefaultValue: "3000",
@@ -513,6 +536,7 @@ type MetaStoreConfig struct {
 	MetaStoreType              ParamItem `refreshable:"false"`
 	SnapshotTTLSeconds         ParamItem `refreshable:"true"`
 	SnapshotReserveTimeSeconds ParamItem `refreshable:"true"`
+	SnapshotGCInterval         ParamItem `refreshable:"true"`
 	PaginationSize             ParamItem `refreshable:"true"`
 	ReadConcurrency            ParamItem `refreshable:"true"`
 	MaxEtcdTxnNum              ParamItem `refreshable:"true"`
@@ -546,6 +570,22 @@ func (p *MetaStoreConfig) Init(base *BaseTable) {
 	}
 	p.SnapshotReserveTimeSeconds.Init(base.mgr)
 
+	p.SnapshotGCInterval = ParamItem{
+		Key:          "metastore.snapshot.gcInterval",
+		Version:      "2.6.6",
+		DefaultValue: "3600",
+		Doc:          `the interval of removing the expired snapshot versions in seconds, must be positive`,
+		Validator:    IntRange(1, math.MaxInt32),
+		Formatter: func(value string) string {
+			if getAsInt64(value) <= 0 {
+				return "3600"
+			}
+			return value
+		},
+		Export: false,
+	}
+	p.SnapshotGCInterval.Init(base.mgr)
+
 	p.PaginationSize = ParamItem{
 		Key:          "metastore.paginationSize",
 		Version:      "2.5.1",
@@ -587,9 +627,10 @@ type MQConfig struct {
 	PursuitBufferSize ParamItem `refreshable:"true"`
 	PursuitBufferTime ParamItem `refreshable:"true"`
 
-	MQBufSize         ParamItem `refreshable:"false"`
-	ReceiveBufSize    ParamItem `refreshable:"false"`
-	IgnoreBadPosition ParamItem `refreshable:"true"`
+	MQBufSize          ParamItem `refreshable:"false"`
+	ReceiveBufSize     ParamItem `refreshable:"false"`
+	IgnoreBadPosition  ParamItem `refreshable:"true"`
+	CheckSeekRetention ParamItem `refreshable:"true"`
 
 	// msgdispatcher
 	CheckInterval    ParamItem `refreshable:"false"`
@@ -606,7 +647,8 @@ func (p *MQConfig) Init(base *BaseTable) {
 		DefaultValue: "default",
 		Doc: `Default value: "default"
 Valid values: [default, pulsar, kafka, rocksmq, woodpecker]`,
-		Export: true,
+		Validator: OneOf("default", "pulsar", "kafka", "rocksmq", "woodpecker"),
+		Export:    true,
 	}
 	p.Type.Init(base.mgr)
 
@@ -705,6 +747,14 @@ Valid values: [default, pulsar, kafka, rocksmq, woodpecker]`,
 		Doc:          "A switch for ignoring message queue failing to parse message ID from checkpoint position. Usually caused by switching among different mq implementations. May caused data loss when used by mistake",
 	}
 	p.IgnoreBadPosition.Init(base.mgr)
+
+	p.CheckSeekRetention = ParamItem{
+		Key:          "mq.checkSeekRetention",
+		Version:      "2.6.6",
+		DefaultValue: "true",
+		Doc:          "Refuse to seek to the position which is already removed by the retention of the message queue instead of consuming from the earliest silently",
+	}
+	p.CheckSeekRetention.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1372,6 +1422,7 @@ type MinioConfig struct {
 	RequestTimeoutMs   ParamItem `refreshable:"false"`
 	MaxConnections     ParamItem `refreshable:"false"`
 	ListObjectsMaxKeys ParamItem `refreshable:"true"`
+	ObjectTagging      ParamItem `refreshable:"true"`
 }
 
 func (p *MinioConfig) Init(base *BaseTable) {
@@ -1591,6 +1642,18 @@ Leave it empty if you want to use AWS default endpoint`,
 		Export: true,
 	}
 	p.ListObjectsMaxKeys.Init(base.mgr)
+
+	p.ObjectTagging = ParamItem{
+		Key:          "minio.objectTagging",
+		Version:      "2.6.6",
+		DefaultValue: "false",
+		Doc: `Whether to tag the binlogs with the collection id, segment id and log type on write,
+so that the bucket lifecycle policies and the cost attribution could be applied by collection.
+Only the S3-compatible object storages support it.`,
+		Validator: IsBool,
+		Export:    true,
+	}
+	p.ObjectTagging.Init(base.mgr)
 }
 
 // profile config
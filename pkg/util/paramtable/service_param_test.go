@@ -56,6 +56,9 @@ func TestServiceParam(t *testing.T) {
 		assert.NotNil(t, Params.EtcdUseSSL.GetAsBool())
 		t.Logf("use ssl = %t", Params.EtcdUseSSL.GetAsBool())
 
+		assert.False(t, Params.BulkClientEnabled.GetAsBool())
+		assert.Equal(t, 4, Params.BulkClientMaxConcurrency.GetAsInt())
+
 		assert.NotEmpty(t, Params.EtcdTLSKey.GetValue())
 		t.Logf("tls key = %s", Params.EtcdTLSKey.GetValue())
 
@@ -251,6 +254,7 @@ func TestServiceParam(t *testing.T) {
 		assert.Equal(t, Params.IAMEndpoint.GetValue(), "")
 
 		assert.Equal(t, Params.GcpCredentialJSON.GetValue(), "")
+		assert.False(t, Params.ObjectTagging.GetAsBool())
 
 		t.Logf("Minio BucketName = %s", Params.BucketName.GetValue())
 
@@ -263,6 +267,11 @@ func TestServiceParam(t *testing.T) {
 		assert.Equal(t, util.MetaStoreTypeEtcd, Params.MetaStoreType.GetValue())
 		assert.Equal(t, 86400*time.Second, Params.SnapshotTTLSeconds.GetAsDuration(time.Second))
 		assert.Equal(t, 3600*time.Second, Params.SnapshotReserveTimeSeconds.GetAsDuration(time.Second))
+		assert.Equal(t, time.Hour, Params.SnapshotGCInterval.GetAsDuration(time.Second))
+		bt.Save(Params.SnapshotGCInterval.Key, "0")
+		assert.Equal(t, time.Hour, Params.SnapshotGCInterval.GetAsDuration(time.Second))
+		assert.Error(t, Params.SnapshotGCInterval.Validator("0"))
+		bt.Reset(Params.SnapshotGCInterval.Key)
 		assert.Equal(t, 100000, Params.PaginationSize.GetAsInt())
 		assert.Equal(t, 32, Params.ReadConcurrency.GetAsInt())
 	})
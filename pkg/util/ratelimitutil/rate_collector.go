@@ -136,6 +136,10 @@ func GetCollectionSubLabel(dbName, collectionName string) string {
 	return fmt.Sprintf("collection.%s.%s", dbName, collectionName)
 }
 
+func GetUserSubLabel(user string) string {
+	return fmt.Sprintf("user.%s", user)
+}
+
 func FormatSubLabel(label, subLabel string) string {
 	return fmt.Sprintf("%s-%s", label, subLabel)
 }
@@ -174,6 +178,22 @@ func GetCollectionFromSubLabel(label, fullLabel string) (string, string, bool) {
 	return subLabels[1], subLabels[2], true
 }
 
+func GetUserFromSubLabel(label, fullLabel string) (string, bool) {
+	if !strings.HasPrefix(fullLabel, FormatSubLabel(label, GetUserSubLabel(""))) {
+		return "", false
+	}
+	return fullLabel[len(FormatSubLabel(label, GetUserSubLabel(""))):], true
+}
+
+func SplitUserSubLabel(label string) (mainLabel, user string, ok bool) {
+	if !IsSubLabel(label) {
+		return
+	}
+	mainLabel = label[:strings.Index(label, "-")]
+	user, ok = GetUserFromSubLabel(mainLabel, label)
+	return
+}
+
 func (r *RateCollector) DeregisterSubLabel(label, subLabel string) {
 	r.Lock()
 	defer r.Unlock()
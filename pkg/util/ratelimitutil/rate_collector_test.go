@@ -265,4 +265,17 @@ func TestLabelUtil(t *testing.T) {
 		assert.Equal(t, "db1", db)
 		assert.Equal(t, "col1", col)
 	}
+
+	{
+		assert.Equal(t, "user.root", GetUserSubLabel("root"))
+		mainLabel, user, ok := SplitUserSubLabel(FormatSubLabel("foo", GetUserSubLabel("root")))
+		assert.True(t, ok)
+		assert.Equal(t, "foo", mainLabel)
+		assert.Equal(t, "root", user)
+
+		_, _, ok = SplitUserSubLabel(FormatSubLabel("foo", GetCollectionSubLabel("db1", "col1")))
+		assert.False(t, ok)
+		_, _, ok = SplitUserSubLabel("foo")
+		assert.False(t, ok)
+	}
 }